@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pixlcrashr/roomy/pkg/notification"
+	"github.com/pixlcrashr/roomy/pkg/reservation"
+	"github.com/pixlcrashr/roomy/pkg/scheduler"
+	"gorm.io/gorm"
+)
+
+// startBackground starts the background jobs, if jobs is set, and the mail
+// delivery worker, if mail is enabled. The returned channel is closed once
+// all of them have stopped after ctx is done.
+func startBackground(ctx context.Context, db *gorm.DB, location *time.Location, jobs bool) (<-chan struct{}, error) {
+	var runners []func(context.Context)
+
+	if jobs {
+		runners = append(runners, scheduler.New(db, config.Jobs.Interval,
+			notification.ReminderJob(),
+			reservation.NoShowJob(),
+		).Run)
+	}
+
+	if config.Mail.Enabled {
+		mailer, err := notification.NewMailer(config.Mail)
+		if err != nil {
+			return nil, fmt.Errorf("mail config: %w", err)
+		}
+		delivery, err := notification.NewWorker(db, mailer, location, config.Mail.From, config.Mail.PollInterval, config.Mail.MaxAttempts)
+		if err != nil {
+			return nil, fmt.Errorf("mail config: %w", err)
+		}
+		runners = append(runners, delivery.Run)
+	}
+
+	var wg sync.WaitGroup
+	for _, run := range runners {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			run(ctx)
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	return done, nil
+}
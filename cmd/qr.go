@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/pixlcrashr/roomy/pkg/auth"
+	database "github.com/pixlcrashr/roomy/pkg/db"
+	"github.com/pixlcrashr/roomy/pkg/qr"
+	"github.com/spf13/cobra"
+)
+
+// qrCmd represents the qr command
+var qrCmd = &cobra.Command{
+	Use:   "qr",
+	Short: "Manage place QR codes",
+	Long:  `Manage the QR code labels of places.`,
+}
+
+var qrExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the QR code labels of an area or building",
+	Long: `Export the QR code labels of all bookable places of an area or a building,
+sorted by place name, either as PDF print sheets or as ZIP archive of PNG images.
+Example: qr export --building <id> --template <id> -o labels.pdf`,
+	Run: func(cmd *cobra.Command, args []string) {
+		req := qr.ExportRequest{}
+		var err error
+		if req.AreaID, err = uuidFlag(cmd, "area"); err != nil {
+			fmt.Fprintf(os.Stderr, "Invalid area: %v\n", err)
+			os.Exit(1)
+		}
+		if req.BuildingID, err = uuidFlag(cmd, "building"); err != nil {
+			fmt.Fprintf(os.Stderr, "Invalid building: %v\n", err)
+			os.Exit(1)
+		}
+		if req.TemplateID, err = uuidFlag(cmd, "template"); err != nil {
+			fmt.Fprintf(os.Stderr, "Invalid template: %v\n", err)
+			os.Exit(1)
+		}
+		if (req.AreaID == nil) == (req.BuildingID == nil) {
+			fmt.Fprintln(os.Stderr, "Exactly one of --area and --building is required")
+			os.Exit(1)
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		req.Format = qr.ExportFormat(format)
+		if req.Format != qr.ExportPDF && req.Format != qr.ExportZIP {
+			fmt.Fprintf(os.Stderr, "Invalid format %q, expected pdf or zip\n", format)
+			os.Exit(1)
+		}
+		paper, _ := cmd.Flags().GetString("paper")
+		req.Paper = qr.Paper(paper)
+		if req.Paper != qr.PaperA4 && req.Paper != qr.PaperLetter {
+			fmt.Fprintf(os.Stderr, "Invalid paper %q, expected a4 or letter\n", paper)
+			os.Exit(1)
+		}
+
+		db, err := database.Connect(config.Database.URL)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Database error: %v\n", err)
+			os.Exit(1)
+		}
+		qrCodes, err := auth.NewQRSigner(config.CheckIn, config.Server.PublicURL)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Check-in config error: %v\n", err)
+			os.Exit(1)
+		}
+
+		output, _ := cmd.Flags().GetString("output")
+		var w io.Writer = os.Stdout
+		if output != "-" {
+			f, err := os.Create(output)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Output error: %v\n", err)
+				os.Exit(1)
+			}
+			defer f.Close()
+			w = f
+		}
+
+		if err := qr.NewExporter(db, qrCodes).Export(cmd.Context(), req, w); err != nil {
+			fmt.Fprintf(os.Stderr, "Export failed: %v\n", err)
+			if output != "-" {
+				os.Remove(output)
+			}
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(qrCmd)
+	qrCmd.AddCommand(qrExportCmd)
+
+	qrExportCmd.Flags().String("area", "", "ID of the area to export")
+	qrExportCmd.Flags().String("building", "", "ID of the building to export")
+	qrExportCmd.Flags().String("template", "", "ID of the QR template to render the labels with")
+	qrExportCmd.Flags().String("format", string(qr.ExportPDF), "Output format (pdf or zip)")
+	qrExportCmd.Flags().String("paper", string(qr.PaperA4), "Paper size of PDF sheets (a4 or letter)")
+	qrExportCmd.Flags().StringP("output", "o", "-", "Output file, - for stdout")
+}
+
+// uuidFlag returns the UUID of a string flag, nil if it is not set.
+func uuidFlag(cmd *cobra.Command, name string) (*uuid.UUID, error) {
+	value, _ := cmd.Flags().GetString(name)
+	if value == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
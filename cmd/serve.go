@@ -1,10 +1,20 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/pixlcrashr/roomy/pkg/api"
+	"github.com/pixlcrashr/roomy/pkg/api/ogen/gen"
+	"github.com/pixlcrashr/roomy/pkg/api/ogen/handler"
+	"github.com/pixlcrashr/roomy/pkg/auth"
+	"github.com/pixlcrashr/roomy/pkg/constraints"
+	database "github.com/pixlcrashr/roomy/pkg/db"
+	"github.com/pixlcrashr/roomy/pkg/reservation"
 	"github.com/spf13/cobra"
 )
 
@@ -13,12 +23,109 @@ var serveCmd = &cobra.Command{
 	Short: "Start the Roomy server",
 	Long:  `Start the Roomy HTTP server to serve the API and web interface.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		server := api.NewServer(nil)
+		db, err := database.Connect(config.Database.URL)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Database error: %v\n", err)
+			os.Exit(1)
+		}
+
+		tokens, err := auth.NewTokenManager(config.JWT)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "JWT config error: %v\n", err)
+			os.Exit(1)
+		}
+		gitlab := auth.NewGitLabProvider(config.GitLab)
+		apiKeyUsage := auth.NewAPIKeyUsageTracker(db, auth.DefaultUsageFlushInterval)
+
+		location, err := time.LoadLocation(config.Booking.Timezone)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Booking config error: %v\n", err)
+			os.Exit(1)
+		}
+		profileStrategy, err := constraints.ParseStrategy(config.Booking.ProfileStrategy)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Booking config error: %v\n", err)
+			os.Exit(1)
+		}
+		qrCodes, err := auth.NewQRSigner(config.CheckIn, config.Server.PublicURL)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Check-in config error: %v\n", err)
+			os.Exit(1)
+		}
+		reservations := reservation.NewService(db, location, reservation.CheckInPolicy{
+			QRCodes:     qrCodes,
+			EarlyWindow: config.CheckIn.EarlyWindow,
+			LateWindow:  config.CheckIn.LateWindow,
+		}, profileStrategy)
+
+		apiServer, err := gen.NewServer(
+			handler.NewHandler(db, gitlab, tokens, reservations, qrCodes),
+			handler.NewSecurityHandler(db, tokens, apiKeyUsage),
+			gen.WithPathPrefix(api.APIPrefix),
+			gen.WithNotFound(handler.NotFound),
+			gen.WithErrorHandler(handler.ErrorHandler),
+			gen.WithMiddleware(handler.Authorize),
+		)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to create API server: %v\n", err)
+			os.Exit(1)
+		}
 
-		fmt.Printf("Starting server on %s\n", config.Server.Address)
-		if err := server.Listen(config.Server.Address); err != nil {
+		server := api.NewServer(db, apiServer)
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		// Background work outlives the signal: it is stopped only after the
+		// server has drained, so that usage of the last requests is flushed.
+		backgroundCtx, stopBackground := context.WithCancel(context.WithoutCancel(ctx))
+		defer stopBackground()
+
+		usageDone := make(chan struct{})
+		go func() {
+			defer close(usageDone)
+			apiKeyUsage.Run(backgroundCtx)
+		}()
+
+		backgroundDone, err := startBackground(backgroundCtx, db, location, config.Jobs.Enabled)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Background error: %v\n", err)
+			os.Exit(1)
+		}
+
+		errCh := make(chan error, 1)
+		go func() {
+			fmt.Printf("Starting server on %s\n", config.Server.Address)
+			errCh <- server.Listen(config.Server.Address)
+		}()
+
+		select {
+		case err := <-errCh:
 			fmt.Fprintf(os.Stderr, "Server error: %v\n", err)
 			os.Exit(1)
+		case <-ctx.Done():
+		}
+		stop()
+
+		fmt.Println("Shutting down server...")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), config.Server.ShutdownTimeout)
+		defer cancel()
+		shutdownErr := server.Shutdown(shutdownCtx)
+
+		stopBackground()
+		<-usageDone
+		<-backgroundDone
+
+		// The pool is closed last, once nothing uses it anymore.
+		if sqlDB, err := db.DB(); err == nil {
+			if err := sqlDB.Close(); err != nil {
+				fmt.Fprintf(os.Stderr, "Database error: %v\n", err)
+			}
+		}
+
+		if shutdownErr != nil {
+			fmt.Fprintf(os.Stderr, "Shutdown error: %v\n", shutdownErr)
+			os.Exit(1)
 		}
 	},
 }
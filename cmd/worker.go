@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	database "github.com/pixlcrashr/roomy/pkg/db"
+	"github.com/spf13/cobra"
+)
+
+var workerCmd = &cobra.Command{
+	Use:   "worker",
+	Short: "Run the background jobs",
+	Long: `Run the background jobs and the mail delivery without serving HTTP.
+Any number of workers may run next to each other and next to servers; set
+jobs.enabled to false to run the jobs in workers only.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		db, err := database.Connect(config.Database.URL)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Database error: %v\n", err)
+			os.Exit(1)
+		}
+
+		location, err := time.LoadLocation(config.Booking.Timezone)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Booking config error: %v\n", err)
+			os.Exit(1)
+		}
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		done, err := startBackground(ctx, db, location, true)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Background error: %v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Println("Worker started")
+		<-ctx.Done()
+		<-done
+		fmt.Println("Worker stopped")
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(workerCmd)
+}
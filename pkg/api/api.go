@@ -1,9 +1,13 @@
 package api
 
 import (
+	"context"
+	"net/http"
+
 	"github.com/goccy/go-json"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/log"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/gofiber/fiber/v2/middleware/healthcheck"
 	"github.com/gofiber/fiber/v2/middleware/logger"
@@ -17,9 +21,12 @@ type Server struct {
 	db  *gorm.DB
 }
 
-func NewServer(db *gorm.DB) *Server {
+// APIPrefix is the path prefix under which the REST API is mounted.
+const APIPrefix = "/api/v1"
+
+func NewServer(db *gorm.DB, apiHandler http.Handler) *Server {
 	app := fiber.New(fiber.Config{
-		AppName:     "Accounter API",
+		AppName:     "Roomy API",
 		JSONEncoder: json.Marshal,
 		JSONDecoder: json.Unmarshal,
 	})
@@ -51,6 +58,10 @@ func NewServer(db *gorm.DB) *Server {
 		ReadinessEndpoint: "/readyz",
 	}))
 
+	// The API is registered in front of the SPA so that its fallback
+	// never answers API requests.
+	app.Use(APIPrefix, adaptor.HTTPHandler(apiHandler))
+
 	web.RegisterRoutes(app)
 
 	return &Server{
@@ -63,6 +74,9 @@ func (s *Server) Listen(addr string) error {
 	return s.app.Listen(addr)
 }
 
-func (s *Server) Shutdown() error {
-	return s.app.Shutdown()
+// Shutdown gracefully stops the server, waiting for in-flight requests until
+// ctx is done. The database connection pool is left open for the background
+// work that outlives the server.
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.app.ShutdownWithContext(ctx)
 }
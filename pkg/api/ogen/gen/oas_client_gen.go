@@ -84,13 +84,24 @@ type Invoker interface {
 	AddUserGroups(ctx context.Context, request *AddUserGroupsReq, params AddUserGroupsParams) (AddUserGroupsRes, error)
 	// CancelReservation invokes cancelReservation operation.
 	//
-	// Cancel reservation.
+	// Cancels the reservation. Holders of manage:reservations for the place may
+	// cancel reservations of other users; this is recorded in the audit log and
+	// the user is notified who cancelled it. Reservations that have been
+	// cancelled, including no-shows that have been released, or have already
+	// ended are rejected (`RESERVATION_NOT_MODIFIABLE`).
 	//
 	// DELETE /reservations/{reservationId}
 	CancelReservation(ctx context.Context, params CancelReservationParams) (CancelReservationRes, error)
 	// CheckInReservation invokes checkInReservation operation.
 	//
-	// Check in to reservation.
+	// Checks the holder of the reservation in. Checking in is possible from the configured
+	// time before the start until the configured time after the start, or the end of the
+	// reservation. Every check-in requires the scanned QR code of the place, which must belong
+	// to the reserved place and must not have been replaced by rotating the place's QR key,
+	// also for places that do not require a check-in. Failures carry one of the error codes
+	// QR_CODE_REQUIRED, QR_CODE_INVALID,
+	// QR_CODE_REVOKED, QR_CODE_WRONG_PLACE, CHECK_IN_TOO_EARLY, CHECK_IN_TOO_LATE (400),
+	// NOT_RESERVATION_OWNER (403), ALREADY_CHECKED_IN and RESERVATION_NOT_CHECKINABLE (409).
 	//
 	// POST /reservations/{reservationId}/checkIn
 	CheckInReservation(ctx context.Context, request OptCheckInReservationReq, params CheckInReservationParams) (CheckInReservationRes, error)
@@ -124,6 +135,12 @@ type Invoker interface {
 	//
 	// POST /groups
 	CreateGroup(ctx context.Context, request *CreateGroupRequest) (CreateGroupRes, error)
+	// CreateGroupConstraintProfile invokes createGroupConstraintProfile operation.
+	//
+	// Create constraint profile for group.
+	//
+	// POST /groups/{groupId}/constraintProfiles
+	CreateGroupConstraintProfile(ctx context.Context, request *ConstraintProfileRequest, params CreateGroupConstraintProfileParams) (CreateGroupConstraintProfileRes, error)
 	// CreatePlace invokes createPlace operation.
 	//
 	// Create a new place.
@@ -141,7 +158,17 @@ type Invoker interface {
 	// Creates a single reservation or multiple recurring reservations.
 	// For recurring reservations, provide the recurrence field with pattern details.
 	// Start/end times must align with the place's configured time slot intervals.
-	// Reservation will fail if any time slot is blocked or already reserved.
+	// A single reservation fails if its time slot is blocked or already reserved,
+	// or if it would exceed a quota of the place's constraints. Constraint profiles
+	// of the user's groups override the place's constraints; rejections name the
+	// profile a violated limit was taken from.
+	// Recurring reservations are created as a series; with mode skipConflicts,
+	// conflicting occurrences are skipped and reported instead.
+	// Holders of manage:reservations for the place may book on behalf of another
+	// user given by userId or userEmail, including places that can only be booked
+	// manually, and may bypass the user's quotas and blockings of the place. Such
+	// bookings are recorded in the audit log and the user is notified who booked
+	// for them.
 	//
 	// POST /reservations
 	CreateReservation(ctx context.Context, request *CreateReservationRequest) (CreateReservationRes, error)
@@ -175,6 +202,12 @@ type Invoker interface {
 	//
 	// DELETE /groups/{groupId}
 	DeleteGroup(ctx context.Context, params DeleteGroupParams) (DeleteGroupRes, error)
+	// DeleteGroupConstraintProfile invokes deleteGroupConstraintProfile operation.
+	//
+	// Delete constraint profile.
+	//
+	// DELETE /groups/{groupId}/constraintProfiles/{profileId}
+	DeleteGroupConstraintProfile(ctx context.Context, params DeleteGroupConstraintProfileParams) (DeleteGroupConstraintProfileRes, error)
 	// DeletePlace invokes deletePlace operation.
 	//
 	// Delete place.
@@ -199,6 +232,22 @@ type Invoker interface {
 	//
 	// POST /users/{userId}/enable
 	EnableUser(ctx context.Context, params EnableUserParams) (EnableUserRes, error)
+	// ExportAreaQrCodes invokes exportAreaQrCodes operation.
+	//
+	// Renders the labels of all bookable, enabled places in the area, sorted by place name,
+	// either as PDF print sheets with as many labels per sheet as fit on a grid, or as ZIP
+	// archive of PNG images. At most 2000 places are exported at once.
+	//
+	// GET /areas/{areaId}/qrCodes
+	ExportAreaQrCodes(ctx context.Context, params ExportAreaQrCodesParams) (ExportAreaQrCodesRes, error)
+	// ExportBuildingQrCodes invokes exportBuildingQrCodes operation.
+	//
+	// Renders the labels of all bookable, enabled places in the building, sorted by place name,
+	// either as PDF print sheets with as many labels per sheet as fit on a grid, or as ZIP
+	// archive of PNG images. At most 2000 places are exported at once.
+	//
+	// GET /buildings/{buildingId}/qrCodes
+	ExportBuildingQrCodes(ctx context.Context, params ExportBuildingQrCodesParams) (ExportBuildingQrCodesRes, error)
 	// ExportReservations invokes exportReservations operation.
 	//
 	// Export reservations as CSV.
@@ -216,6 +265,8 @@ type Invoker interface {
 	// Returns availability status for each place in the area within the given time range.
 	// Availability is calculated as: time slots NOT blocked (from place, area, or building)
 	// AND NOT reserved. Optimized for room plan visualization.
+	// The window defaults to the whole day; nextAvailableSlot is the first available
+	// slot on that day starting at or after the window start.
 	//
 	// GET /areas/{areaId}/availability
 	GetAreaAvailability(ctx context.Context, params GetAreaAvailabilityParams) (GetAreaAvailabilityRes, error)
@@ -229,9 +280,18 @@ type Invoker interface {
 	// GetAreaCalendar invokes getAreaCalendar operation.
 	//
 	// Returns .ics file with blocking periods and reservations for all places in area (next 3 months).
+	// Blockings of the area, its places and its building are included. Reservations never include
+	// details about the user holding them; recurring blockings are emitted as RRULE/EXDATE.
 	//
 	// GET /areas/{areaId}/calendar.ics
 	GetAreaCalendar(ctx context.Context, params GetAreaCalendarParams) (GetAreaCalendarRes, error)
+	// GetAreaConstraints invokes getAreaConstraints operation.
+	//
+	// Returns the default constraints of all places in the area. Places
+	// inherit every field they do not set themselves, falling back to the building.
+	//
+	// GET /areas/{areaId}/constraints
+	GetAreaConstraints(ctx context.Context, params GetAreaConstraintsParams) (GetAreaConstraintsRes, error)
 	// GetAreaRoomPlan invokes getAreaRoomPlan operation.
 	//
 	// Get room plan data.
@@ -254,6 +314,10 @@ type Invoker interface {
 	//
 	// Returns time slots that are NOT blocked for the building.
 	// Useful for seeing when the building is open/operational.
+	// Only building-level blockings are considered, with recurrences expanded.
+	// Besides the merged open ranges, the response summarizes the regular opening
+	// hours per weekday and lists the days that deviate from them.
+	// Ranges longer than 31 days are truncated.
 	//
 	// GET /buildings/{buildingId}/availability
 	GetBuildingAvailability(ctx context.Context, params GetBuildingAvailabilityParams) (GetBuildingAvailabilityRes, error)
@@ -267,9 +331,18 @@ type Invoker interface {
 	// GetBuildingCalendar invokes getBuildingCalendar operation.
 	//
 	// Returns .ics file with blocking periods and reservations for all places (next 3 months).
+	// Blockings of the building and of its areas and places are included. Reservations never
+	// include details about the user holding them; recurring blockings are emitted as RRULE/EXDATE.
 	//
 	// GET /buildings/{buildingId}/calendar.ics
 	GetBuildingCalendar(ctx context.Context, params GetBuildingCalendarParams) (GetBuildingCalendarRes, error)
+	// GetBuildingConstraints invokes getBuildingConstraints operation.
+	//
+	// Returns the default constraints of all places in the building. Places
+	// inherit every field they do not set themselves.
+	//
+	// GET /buildings/{buildingId}/constraints
+	GetBuildingConstraints(ctx context.Context, params GetBuildingConstraintsParams) (GetBuildingConstraintsRes, error)
 	// GetCurrentOccupancy invokes getCurrentOccupancy operation.
 	//
 	// Get current occupancy.
@@ -282,6 +355,13 @@ type Invoker interface {
 	//
 	// GET /auth/me
 	GetCurrentUser(ctx context.Context) (GetCurrentUserRes, error)
+	// GetCurrentUserCalendarToken invokes getCurrentUserCalendarToken operation.
+	//
+	// Returns when the current user's calendar feed token was created and last used. The token itself is
+	// only shown once when it is created.
+	//
+	// GET /users/me/calendar-token
+	GetCurrentUserCalendarToken(ctx context.Context) (GetCurrentUserCalendarTokenRes, error)
 	// GetCurrentUserFavorites invokes getCurrentUserFavorites operation.
 	//
 	// Get current user's favorite places.
@@ -300,6 +380,14 @@ type Invoker interface {
 	//
 	// GET /groups/defaultAssignment
 	GetDefaultGroupAssignment(ctx context.Context) (GetDefaultGroupAssignmentRes, error)
+	// GetEffectivePlaceConstraints invokes getEffectivePlaceConstraints operation.
+	//
+	// Returns the constraints that apply to bookings of the place. Every
+	// field is taken from the place if set there, otherwise from its area,
+	// otherwise from its building.
+	//
+	// GET /places/{placeId}/constraints/effective
+	GetEffectivePlaceConstraints(ctx context.Context, params GetEffectivePlaceConstraintsParams) (GetEffectivePlaceConstraintsRes, error)
 	// GetEquipment invokes getEquipment operation.
 	//
 	// Get equipment type details.
@@ -336,6 +424,8 @@ type Invoker interface {
 	// Availability is computed as: All valid time slot intervals
 	// MINUS blocking periods (own + inherited from area + building)
 	// MINUS existing reservations.
+	// Ranges longer than 31 days are truncated. Reserved slots never
+	// include details about the user holding the reservation.
 	//
 	// GET /places/{placeId}/availability
 	GetPlaceAvailability(ctx context.Context, params GetPlaceAvailabilityParams) (GetPlaceAvailabilityRes, error)
@@ -350,12 +440,16 @@ type Invoker interface {
 	// GetPlaceCalendar invokes getPlaceCalendar operation.
 	//
 	// Returns .ics file with blocking periods and reservations (next 3 months).
+	// Blockings inherited from the area and building are included. Reservations never include
+	// details about the user holding them; recurring blockings are emitted as RRULE/EXDATE.
 	//
 	// GET /places/{placeId}/calendar.ics
 	GetPlaceCalendar(ctx context.Context, params GetPlaceCalendarParams) (GetPlaceCalendarRes, error)
 	// GetPlaceConstraints invokes getPlaceConstraints operation.
 	//
-	// Get place constraints.
+	// Returns the constraints set on the place itself. Unset fields are
+	// inherited from the area and the building of the place; see
+	// getEffectivePlaceConstraints for the constraints that apply.
 	//
 	// GET /places/{placeId}/constraints
 	GetPlaceConstraints(ctx context.Context, params GetPlaceConstraintsParams) (GetPlaceConstraintsRes, error)
@@ -367,10 +461,19 @@ type Invoker interface {
 	GetPlaceEquipment(ctx context.Context, params GetPlaceEquipmentParams) (GetPlaceEquipmentRes, error)
 	// GetPlaceQrCode invokes getPlaceQrCode operation.
 	//
-	// Generate QR code.
+	// Renders the printable label of the place at 300 DPI. The QR symbol encodes the check-in
+	// URL of the place; the requested details are printed below it in the order name,
+	// areaName, buildingName. PNG and JPEG images carry their resolution, PDFs consist of a
+	// single page of the size of the label.
 	//
 	// GET /places/{placeId}/qrCode
 	GetPlaceQrCode(ctx context.Context, params GetPlaceQrCodeParams) (GetPlaceQrCodeRes, error)
+	// GetPlaceQrKey invokes getPlaceQrKey operation.
+	//
+	// Returns the key version and the check-in URL encoded in the place's QR code.
+	//
+	// GET /places/{placeId}/qrCode/key
+	GetPlaceQrKey(ctx context.Context, params GetPlaceQrKeyParams) (GetPlaceQrKeyRes, error)
 	// GetPlaceTimeSlots invokes getPlaceTimeSlots operation.
 	//
 	// Returns the booking grid configuration for this place.
@@ -422,6 +525,15 @@ type Invoker interface {
 	//
 	// GET /users/{userId}
 	GetUser(ctx context.Context, params GetUserParams) (GetUserRes, error)
+	// GetUserCalendar invokes getUserCalendar operation.
+	//
+	// Returns .ics file with the reservations of the user owning the token, from three months
+	// ago until three months ahead, including place, area and building names and whether a
+	// check-in is required. Cancelled reservations are included with STATUS:CANCELLED so that
+	// subscribed calendars remove them.
+	//
+	// GET /calendar/users/{token}.ics
+	GetUserCalendar(ctx context.Context, params GetUserCalendarParams) (GetUserCalendarRes, error)
 	// GetUserGroups invokes getUserGroups operation.
 	//
 	// Get user's groups.
@@ -430,16 +542,18 @@ type Invoker interface {
 	GetUserGroups(ctx context.Context, params GetUserGroupsParams) (GetUserGroupsRes, error)
 	// HandleOAuthCallback invokes handleOAuthCallback operation.
 	//
-	// Handles the OAuth callback from GitLab.
+	// Handles the OAuth callback from GitLab. The responses below expire the
+	// state cookie, since a state can only be used once.
 	//
 	// GET /auth/callback
 	HandleOAuthCallback(ctx context.Context, params HandleOAuthCallbackParams) (HandleOAuthCallbackRes, error)
 	// InitiateOAuthLogin invokes initiateOAuthLogin operation.
 	//
-	// Redirects to GitLab authorization URL.
+	// Redirects to GitLab authorization URL. The state is bound to the browser
+	// by a cookie, which the callback requires.
 	//
 	// GET /auth/login
-	InitiateOAuthLogin(ctx context.Context) error
+	InitiateOAuthLogin(ctx context.Context) (*InitiateOAuthLoginFound, error)
 	// ListApiKeys invokes listApiKeys operation.
 	//
 	// List user's API keys.
@@ -476,6 +590,12 @@ type Invoker interface {
 	//
 	// GET /equipment
 	ListEquipment(ctx context.Context) ([]Equipment, error)
+	// ListGroupConstraintProfiles invokes listGroupConstraintProfiles operation.
+	//
+	// List constraint profiles of group.
+	//
+	// GET /groups/{groupId}/constraintProfiles
+	ListGroupConstraintProfiles(ctx context.Context, params ListGroupConstraintProfilesParams) (ListGroupConstraintProfilesRes, error)
 	// ListGroups invokes listGroups operation.
 	//
 	// List all permission groups.
@@ -520,7 +640,8 @@ type Invoker interface {
 	Logout(ctx context.Context) error
 	// PreviewQrTemplate invokes previewQrTemplate operation.
 	//
-	// Preview template with sample data.
+	// Renders the template with the data of a sample place, either as the HTML shown in
+	// browsers or as the label image printed by getPlaceQrCode.
 	//
 	// GET /qrTemplates/{templateId}/preview
 	PreviewQrTemplate(ctx context.Context, params PreviewQrTemplateParams) (PreviewQrTemplateRes, error)
@@ -608,6 +729,26 @@ type Invoker interface {
 	//
 	// DELETE /apiKeys/{keyId}
 	RevokeApiKey(ctx context.Context, params RevokeApiKeyParams) (RevokeApiKeyRes, error)
+	// RevokeCurrentUserCalendarToken invokes revokeCurrentUserCalendarToken operation.
+	//
+	// Revoke personal calendar feed token.
+	//
+	// DELETE /users/me/calendar-token
+	RevokeCurrentUserCalendarToken(ctx context.Context) (RevokeCurrentUserCalendarTokenRes, error)
+	// RotateCurrentUserCalendarToken invokes rotateCurrentUserCalendarToken operation.
+	//
+	// Creates a new secret token for the current user's personal calendar feed.
+	// Any previous token is revoked, so subscriptions using the old feed URL stop working.
+	//
+	// POST /users/me/calendar-token
+	RotateCurrentUserCalendarToken(ctx context.Context) (RotateCurrentUserCalendarTokenRes, error)
+	// RotatePlaceQrKey invokes rotatePlaceQrKey operation.
+	//
+	// Increments the key version of the place. All QR codes printed before are rejected at
+	// check-in with QR_CODE_REVOKED and have to be replaced by newly generated ones.
+	//
+	// POST /places/{placeId}/qrCode/key
+	RotatePlaceQrKey(ctx context.Context, params RotatePlaceQrKeyParams) (RotatePlaceQrKeyRes, error)
 	// SetDefaultGroupAssignment invokes setDefaultGroupAssignment operation.
 	//
 	// Set default groups for new users.
@@ -620,6 +761,12 @@ type Invoker interface {
 	//
 	// PUT /areas/{areaId}
 	UpdateArea(ctx context.Context, request *UpdateAreaRequest, params UpdateAreaParams) (UpdateAreaRes, error)
+	// UpdateAreaConstraints invokes updateAreaConstraints operation.
+	//
+	// Update area constraints.
+	//
+	// PUT /areas/{areaId}/constraints
+	UpdateAreaConstraints(ctx context.Context, request *PlaceConstraints, params UpdateAreaConstraintsParams) (UpdateAreaConstraintsRes, error)
 	// UpdateAreaRoomPlan invokes updateAreaRoomPlan operation.
 	//
 	// Update room plan.
@@ -632,6 +779,12 @@ type Invoker interface {
 	//
 	// PUT /buildings/{buildingId}
 	UpdateBuilding(ctx context.Context, request *UpdateBuildingRequest, params UpdateBuildingParams) (UpdateBuildingRes, error)
+	// UpdateBuildingConstraints invokes updateBuildingConstraints operation.
+	//
+	// Update building constraints.
+	//
+	// PUT /buildings/{buildingId}/constraints
+	UpdateBuildingConstraints(ctx context.Context, request *PlaceConstraints, params UpdateBuildingConstraintsParams) (UpdateBuildingConstraintsRes, error)
 	// UpdateCurrentUserNotifications invokes updateCurrentUserNotifications operation.
 	//
 	// Update notification preferences.
@@ -650,6 +803,12 @@ type Invoker interface {
 	//
 	// PUT /groups/{groupId}
 	UpdateGroup(ctx context.Context, request *UpdateGroupRequest, params UpdateGroupParams) (UpdateGroupRes, error)
+	// UpdateGroupConstraintProfile invokes updateGroupConstraintProfile operation.
+	//
+	// Update constraint profile.
+	//
+	// PUT /groups/{groupId}/constraintProfiles/{profileId}
+	UpdateGroupConstraintProfile(ctx context.Context, request *ConstraintProfileRequest, params UpdateGroupConstraintProfileParams) (UpdateGroupConstraintProfileRes, error)
 	// UpdatePlace invokes updatePlace operation.
 	//
 	// Update place.
@@ -680,6 +839,10 @@ type Invoker interface {
 	// Extending is only allowed if the adjacent time slot is not blocked or reserved.
 	// Shortening is always allowed within minimum duration constraints.
 	// Times must align with the place's configured time slot intervals.
+	// Holders of manage:reservations for the place may move reservations of other
+	// users, also at places that can only be booked manually, and may bypass quotas
+	// and blockings. Such changes are recorded in the audit log and the user is
+	// notified who made them.
 	//
 	// PUT /reservations/{reservationId}
 	UpdateReservation(ctx context.Context, request *UpdateReservationRequest, params UpdateReservationParams) (UpdateReservationRes, error)
@@ -1979,7 +2142,11 @@ func (c *Client) sendAddUserGroups(ctx context.Context, request *AddUserGroupsRe
 
 // CancelReservation invokes cancelReservation operation.
 //
-// Cancel reservation.
+// Cancels the reservation. Holders of manage:reservations for the place may
+// cancel reservations of other users; this is recorded in the audit log and
+// the user is notified who cancelled it. Reservations that have been
+// cancelled, including no-shows that have been released, or have already
+// ended are rejected (`RESERVATION_NOT_MODIFIABLE`).
 //
 // DELETE /reservations/{reservationId}
 func (c *Client) CancelReservation(ctx context.Context, params CancelReservationParams) (CancelReservationRes, error) {
@@ -2046,6 +2213,27 @@ func (c *Client) sendCancelReservation(ctx context.Context, params CancelReserva
 	}
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "scope" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "scope",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Scope.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
@@ -2115,7 +2303,14 @@ func (c *Client) sendCancelReservation(ctx context.Context, params CancelReserva
 
 // CheckInReservation invokes checkInReservation operation.
 //
-// Check in to reservation.
+// Checks the holder of the reservation in. Checking in is possible from the configured
+// time before the start until the configured time after the start, or the end of the
+// reservation. Every check-in requires the scanned QR code of the place, which must belong
+// to the reserved place and must not have been replaced by rotating the place's QR key,
+// also for places that do not require a check-in. Failures carry one of the error codes
+// QR_CODE_REQUIRED, QR_CODE_INVALID,
+// QR_CODE_REVOKED, QR_CODE_WRONG_PLACE, CHECK_IN_TOO_EARLY, CHECK_IN_TOO_LATE (400),
+// NOT_RESERVATION_OWNER (403), ALREADY_CHECKED_IN and RESERVATION_NOT_CHECKINABLE (409).
 //
 // POST /reservations/{reservationId}/checkIn
 func (c *Client) CheckInReservation(ctx context.Context, request OptCheckInReservationReq, params CheckInReservationParams) (CheckInReservationRes, error) {
@@ -2858,6 +3053,146 @@ func (c *Client) sendCreateGroup(ctx context.Context, request *CreateGroupReques
 	return result, nil
 }
 
+// CreateGroupConstraintProfile invokes createGroupConstraintProfile operation.
+//
+// Create constraint profile for group.
+//
+// POST /groups/{groupId}/constraintProfiles
+func (c *Client) CreateGroupConstraintProfile(ctx context.Context, request *ConstraintProfileRequest, params CreateGroupConstraintProfileParams) (CreateGroupConstraintProfileRes, error) {
+	res, err := c.sendCreateGroupConstraintProfile(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendCreateGroupConstraintProfile(ctx context.Context, request *ConstraintProfileRequest, params CreateGroupConstraintProfileParams) (res CreateGroupConstraintProfileRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("createGroupConstraintProfile"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/groups/{groupId}/constraintProfiles"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateGroupConstraintProfileOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/groups/"
+	{
+		// Encode "groupId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "groupId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.GroupId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/constraintProfiles"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeCreateGroupConstraintProfileRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, CreateGroupConstraintProfileOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:ApiKeyAuth"
+			switch err := c.securityApiKeyAuth(ctx, CreateGroupConstraintProfileOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKeyAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeCreateGroupConstraintProfileResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // CreatePlace invokes createPlace operation.
 //
 // Create a new place.
@@ -3105,7 +3440,17 @@ func (c *Client) sendCreateQrTemplate(ctx context.Context, request *CreateQRTemp
 // Creates a single reservation or multiple recurring reservations.
 // For recurring reservations, provide the recurrence field with pattern details.
 // Start/end times must align with the place's configured time slot intervals.
-// Reservation will fail if any time slot is blocked or already reserved.
+// A single reservation fails if its time slot is blocked or already reserved,
+// or if it would exceed a quota of the place's constraints. Constraint profiles
+// of the user's groups override the place's constraints; rejections name the
+// profile a violated limit was taken from.
+// Recurring reservations are created as a series; with mode skipConflicts,
+// conflicting occurrences are skipped and reported instead.
+// Holders of manage:reservations for the place may book on behalf of another
+// user given by userId or userEmail, including places that can only be booked
+// manually, and may bypass the user's quotas and blockings of the place. Such
+// bookings are recorded in the audit log and the user is notified who booked
+// for them.
 //
 // POST /reservations
 func (c *Client) CreateReservation(ctx context.Context, request *CreateReservationRequest) (CreateReservationRes, error) {
@@ -3905,21 +4250,21 @@ func (c *Client) sendDeleteGroup(ctx context.Context, params DeleteGroupParams)
 	return result, nil
 }
 
-// DeletePlace invokes deletePlace operation.
+// DeleteGroupConstraintProfile invokes deleteGroupConstraintProfile operation.
 //
-// Delete place.
+// Delete constraint profile.
 //
-// DELETE /places/{placeId}
-func (c *Client) DeletePlace(ctx context.Context, params DeletePlaceParams) (DeletePlaceRes, error) {
-	res, err := c.sendDeletePlace(ctx, params)
+// DELETE /groups/{groupId}/constraintProfiles/{profileId}
+func (c *Client) DeleteGroupConstraintProfile(ctx context.Context, params DeleteGroupConstraintProfileParams) (DeleteGroupConstraintProfileRes, error) {
+	res, err := c.sendDeleteGroupConstraintProfile(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDeletePlace(ctx context.Context, params DeletePlaceParams) (res DeletePlaceRes, err error) {
+func (c *Client) sendDeleteGroupConstraintProfile(ctx context.Context, params DeleteGroupConstraintProfileParams) (res DeleteGroupConstraintProfileRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deletePlace"),
+		otelogen.OperationID("deleteGroupConstraintProfile"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/places/{placeId}"),
+		semconv.URLTemplateKey.String("/groups/{groupId}/constraintProfiles/{profileId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3935,7 +4280,7 @@ func (c *Client) sendDeletePlace(ctx context.Context, params DeletePlaceParams)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeletePlaceOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteGroupConstraintProfileOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3952,17 +4297,17 @@ func (c *Client) sendDeletePlace(ctx context.Context, params DeletePlaceParams)
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/places/"
+	var pathParts [4]string
+	pathParts[0] = "/groups/"
 	{
-		// Encode "placeId" parameter.
+		// Encode "groupId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "placeId",
+			Param:   "groupId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.PlaceId))
+			return e.EncodeValue(conv.UUIDToString(params.GroupId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -3972,6 +4317,25 @@ func (c *Client) sendDeletePlace(ctx context.Context, params DeletePlaceParams)
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/constraintProfiles/"
+	{
+		// Encode "profileId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "profileId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ProfileId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -3985,7 +4349,7 @@ func (c *Client) sendDeletePlace(ctx context.Context, params DeletePlaceParams)
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DeletePlaceOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteGroupConstraintProfileOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3996,7 +4360,7 @@ func (c *Client) sendDeletePlace(ctx context.Context, params DeletePlaceParams)
 		}
 		{
 			stage = "Security:ApiKeyAuth"
-			switch err := c.securityApiKeyAuth(ctx, DeletePlaceOperation, r); {
+			switch err := c.securityApiKeyAuth(ctx, DeleteGroupConstraintProfileOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4033,7 +4397,7 @@ func (c *Client) sendDeletePlace(ctx context.Context, params DeletePlaceParams)
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeletePlaceResponse(resp)
+	result, err := decodeDeleteGroupConstraintProfileResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4041,21 +4405,21 @@ func (c *Client) sendDeletePlace(ctx context.Context, params DeletePlaceParams)
 	return result, nil
 }
 
-// DeleteQrTemplate invokes deleteQrTemplate operation.
+// DeletePlace invokes deletePlace operation.
 //
-// Delete template.
+// Delete place.
 //
-// DELETE /qrTemplates/{templateId}
-func (c *Client) DeleteQrTemplate(ctx context.Context, params DeleteQrTemplateParams) (DeleteQrTemplateRes, error) {
-	res, err := c.sendDeleteQrTemplate(ctx, params)
+// DELETE /places/{placeId}
+func (c *Client) DeletePlace(ctx context.Context, params DeletePlaceParams) (DeletePlaceRes, error) {
+	res, err := c.sendDeletePlace(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDeleteQrTemplate(ctx context.Context, params DeleteQrTemplateParams) (res DeleteQrTemplateRes, err error) {
+func (c *Client) sendDeletePlace(ctx context.Context, params DeletePlaceParams) (res DeletePlaceRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteQrTemplate"),
+		otelogen.OperationID("deletePlace"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/qrTemplates/{templateId}"),
+		semconv.URLTemplateKey.String("/places/{placeId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -4071,7 +4435,7 @@ func (c *Client) sendDeleteQrTemplate(ctx context.Context, params DeleteQrTempla
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteQrTemplateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeletePlaceOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4089,16 +4453,16 @@ func (c *Client) sendDeleteQrTemplate(ctx context.Context, params DeleteQrTempla
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/qrTemplates/"
+	pathParts[0] = "/places/"
 	{
-		// Encode "templateId" parameter.
+		// Encode "placeId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "templateId",
+			Param:   "placeId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.TemplateId))
+			return e.EncodeValue(conv.UUIDToString(params.PlaceId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -4121,7 +4485,7 @@ func (c *Client) sendDeleteQrTemplate(ctx context.Context, params DeleteQrTempla
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DeleteQrTemplateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeletePlaceOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4132,7 +4496,7 @@ func (c *Client) sendDeleteQrTemplate(ctx context.Context, params DeleteQrTempla
 		}
 		{
 			stage = "Security:ApiKeyAuth"
-			switch err := c.securityApiKeyAuth(ctx, DeleteQrTemplateOperation, r); {
+			switch err := c.securityApiKeyAuth(ctx, DeletePlaceOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4169,7 +4533,7 @@ func (c *Client) sendDeleteQrTemplate(ctx context.Context, params DeleteQrTempla
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteQrTemplateResponse(resp)
+	result, err := decodeDeletePlaceResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4177,17 +4541,153 @@ func (c *Client) sendDeleteQrTemplate(ctx context.Context, params DeleteQrTempla
 	return result, nil
 }
 
-// DisableUser invokes disableUser operation.
+// DeleteQrTemplate invokes deleteQrTemplate operation.
 //
-// Disable user account.
+// Delete template.
 //
-// POST /users/{userId}/disable
-func (c *Client) DisableUser(ctx context.Context, params DisableUserParams) (DisableUserRes, error) {
-	res, err := c.sendDisableUser(ctx, params)
+// DELETE /qrTemplates/{templateId}
+func (c *Client) DeleteQrTemplate(ctx context.Context, params DeleteQrTemplateParams) (DeleteQrTemplateRes, error) {
+	res, err := c.sendDeleteQrTemplate(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDisableUser(ctx context.Context, params DisableUserParams) (res DisableUserRes, err error) {
+func (c *Client) sendDeleteQrTemplate(ctx context.Context, params DeleteQrTemplateParams) (res DeleteQrTemplateRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deleteQrTemplate"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/qrTemplates/{templateId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteQrTemplateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/qrTemplates/"
+	{
+		// Encode "templateId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "templateId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.TemplateId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DeleteQrTemplateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:ApiKeyAuth"
+			switch err := c.securityApiKeyAuth(ctx, DeleteQrTemplateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKeyAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDeleteQrTemplateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DisableUser invokes disableUser operation.
+//
+// Disable user account.
+//
+// POST /users/{userId}/disable
+func (c *Client) DisableUser(ctx context.Context, params DisableUserParams) (DisableUserRes, error) {
+	res, err := c.sendDisableUser(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDisableUser(ctx context.Context, params DisableUserParams) (res DisableUserRes, err error) {
 	otelAttrs := []attribute.KeyValue{
 		otelogen.OperationID("disableUser"),
 		semconv.HTTPRequestMethodKey.String("POST"),
@@ -4451,21 +4951,23 @@ func (c *Client) sendEnableUser(ctx context.Context, params EnableUserParams) (r
 	return result, nil
 }
 
-// ExportReservations invokes exportReservations operation.
+// ExportAreaQrCodes invokes exportAreaQrCodes operation.
 //
-// Export reservations as CSV.
+// Renders the labels of all bookable, enabled places in the area, sorted by place name,
+// either as PDF print sheets with as many labels per sheet as fit on a grid, or as ZIP
+// archive of PNG images. At most 2000 places are exported at once.
 //
-// GET /reservations/export
-func (c *Client) ExportReservations(ctx context.Context, params ExportReservationsParams) (ExportReservationsRes, error) {
-	res, err := c.sendExportReservations(ctx, params)
+// GET /areas/{areaId}/qrCodes
+func (c *Client) ExportAreaQrCodes(ctx context.Context, params ExportAreaQrCodesParams) (ExportAreaQrCodesRes, error) {
+	res, err := c.sendExportAreaQrCodes(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendExportReservations(ctx context.Context, params ExportReservationsParams) (res ExportReservationsRes, err error) {
+func (c *Client) sendExportAreaQrCodes(ctx context.Context, params ExportAreaQrCodesParams) (res ExportAreaQrCodesRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("exportReservations"),
+		otelogen.OperationID("exportAreaQrCodes"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/reservations/export"),
+		semconv.URLTemplateKey.String("/areas/{areaId}/qrCodes"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -4481,7 +4983,7 @@ func (c *Client) sendExportReservations(ctx context.Context, params ExportReserv
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ExportReservationsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ExportAreaQrCodesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4498,51 +5000,59 @@ func (c *Client) sendExportReservations(ctx context.Context, params ExportReserv
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/reservations/export"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+	var pathParts [3]string
+	pathParts[0] = "/areas/"
 	{
-		// Encode "startDate" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "startDate",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "areaId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "areaId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.AreaId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.DateToString(params.StartDate))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
+	pathParts[2] = "/qrCodes"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		// Encode "endDate" parameter.
+		// Encode "templateId" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "endDate",
+			Name:    "templateId",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.DateToString(params.EndDate))
+			if val, ok := params.TemplateId.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
 	{
-		// Encode "buildingId" parameter.
+		// Encode "format" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "buildingId",
+			Name:    "format",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.BuildingId.Get(); ok {
-				return e.EncodeValue(conv.UUIDToString(val))
+			if val, ok := params.Format.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
 			}
 			return nil
 		}); err != nil {
@@ -4550,16 +5060,16 @@ func (c *Client) sendExportReservations(ctx context.Context, params ExportReserv
 		}
 	}
 	{
-		// Encode "areaId" parameter.
+		// Encode "paper" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "areaId",
+			Name:    "paper",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.AreaId.Get(); ok {
-				return e.EncodeValue(conv.UUIDToString(val))
+			if val, ok := params.Paper.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
 			}
 			return nil
 		}); err != nil {
@@ -4579,7 +5089,7 @@ func (c *Client) sendExportReservations(ctx context.Context, params ExportReserv
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ExportReservationsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, ExportAreaQrCodesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4590,7 +5100,7 @@ func (c *Client) sendExportReservations(ctx context.Context, params ExportReserv
 		}
 		{
 			stage = "Security:ApiKeyAuth"
-			switch err := c.securityApiKeyAuth(ctx, ExportReservationsOperation, r); {
+			switch err := c.securityApiKeyAuth(ctx, ExportAreaQrCodesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4627,7 +5137,7 @@ func (c *Client) sendExportReservations(ctx context.Context, params ExportReserv
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeExportReservationsResponse(resp)
+	result, err := decodeExportAreaQrCodesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4635,21 +5145,23 @@ func (c *Client) sendExportReservations(ctx context.Context, params ExportReserv
 	return result, nil
 }
 
-// GetArea invokes getArea operation.
+// ExportBuildingQrCodes invokes exportBuildingQrCodes operation.
 //
-// Get area details.
+// Renders the labels of all bookable, enabled places in the building, sorted by place name,
+// either as PDF print sheets with as many labels per sheet as fit on a grid, or as ZIP
+// archive of PNG images. At most 2000 places are exported at once.
 //
-// GET /areas/{areaId}
-func (c *Client) GetArea(ctx context.Context, params GetAreaParams) (GetAreaRes, error) {
-	res, err := c.sendGetArea(ctx, params)
+// GET /buildings/{buildingId}/qrCodes
+func (c *Client) ExportBuildingQrCodes(ctx context.Context, params ExportBuildingQrCodesParams) (ExportBuildingQrCodesRes, error) {
+	res, err := c.sendExportBuildingQrCodes(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetArea(ctx context.Context, params GetAreaParams) (res GetAreaRes, err error) {
+func (c *Client) sendExportBuildingQrCodes(ctx context.Context, params ExportBuildingQrCodesParams) (res ExportBuildingQrCodesRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getArea"),
+		otelogen.OperationID("exportBuildingQrCodes"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/areas/{areaId}"),
+		semconv.URLTemplateKey.String("/buildings/{buildingId}/qrCodes"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -4665,7 +5177,7 @@ func (c *Client) sendGetArea(ctx context.Context, params GetAreaParams) (res Get
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetAreaOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ExportBuildingQrCodesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4682,17 +5194,17 @@ func (c *Client) sendGetArea(ctx context.Context, params GetAreaParams) (res Get
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/areas/"
+	var pathParts [3]string
+	pathParts[0] = "/buildings/"
 	{
-		// Encode "areaId" parameter.
+		// Encode "buildingId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "areaId",
+			Param:   "buildingId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.AreaId))
+			return e.EncodeValue(conv.UUIDToString(params.BuildingId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -4702,23 +5214,124 @@ func (c *Client) sendGetArea(ctx context.Context, params GetAreaParams) (res Get
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/qrCodes"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
-	}
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "templateId" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "templateId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
-	if err != nil {
-		return res, errors.Wrap(err, "do request")
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.TemplateId.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
 	}
-	defer resp.Body.Close()
-
-	stage = "DecodeResponse"
-	result, err := decodeGetAreaResponse(resp)
+	{
+		// Encode "format" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Format.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "paper" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "paper",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Paper.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ExportBuildingQrCodesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:ApiKeyAuth"
+			switch err := c.securityApiKeyAuth(ctx, ExportBuildingQrCodesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKeyAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeExportBuildingQrCodesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4726,23 +5339,21 @@ func (c *Client) sendGetArea(ctx context.Context, params GetAreaParams) (res Get
 	return result, nil
 }
 
-// GetAreaAvailability invokes getAreaAvailability operation.
+// ExportReservations invokes exportReservations operation.
 //
-// Returns availability status for each place in the area within the given time range.
-// Availability is calculated as: time slots NOT blocked (from place, area, or building)
-// AND NOT reserved. Optimized for room plan visualization.
+// Export reservations as CSV.
 //
-// GET /areas/{areaId}/availability
-func (c *Client) GetAreaAvailability(ctx context.Context, params GetAreaAvailabilityParams) (GetAreaAvailabilityRes, error) {
-	res, err := c.sendGetAreaAvailability(ctx, params)
+// GET /reservations/export
+func (c *Client) ExportReservations(ctx context.Context, params ExportReservationsParams) (ExportReservationsRes, error) {
+	res, err := c.sendExportReservations(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetAreaAvailability(ctx context.Context, params GetAreaAvailabilityParams) (res GetAreaAvailabilityRes, err error) {
+func (c *Client) sendExportReservations(ctx context.Context, params ExportReservationsParams) (res ExportReservationsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAreaAvailability"),
+		otelogen.OperationID("exportReservations"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/areas/{areaId}/availability"),
+		semconv.URLTemplateKey.String("/reservations/export"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -4758,7 +5369,7 @@ func (c *Client) sendGetAreaAvailability(ctx context.Context, params GetAreaAvai
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetAreaAvailabilityOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ExportReservationsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4775,56 +5386,51 @@ func (c *Client) sendGetAreaAvailability(ctx context.Context, params GetAreaAvai
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/areas/"
-	{
-		// Encode "areaId" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "areaId",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.AreaId))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/availability"
+	var pathParts [1]string
+	pathParts[0] = "/reservations/export"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "date" parameter.
+		// Encode "startDate" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "date",
+			Name:    "startDate",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.DateToString(params.Date))
+			return e.EncodeValue(conv.DateToString(params.StartDate))
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
 	{
-		// Encode "startTime" parameter.
+		// Encode "endDate" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "startTime",
+			Name:    "endDate",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.StartTime.Get(); ok {
-				return e.EncodeValue(conv.TimeToString(val))
+			return e.EncodeValue(conv.DateToString(params.EndDate))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "buildingId" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "buildingId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.BuildingId.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -4832,16 +5438,16 @@ func (c *Client) sendGetAreaAvailability(ctx context.Context, params GetAreaAvai
 		}
 	}
 	{
-		// Encode "endTime" parameter.
+		// Encode "areaId" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "endTime",
+			Name:    "areaId",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.EndTime.Get(); ok {
-				return e.EncodeValue(conv.TimeToString(val))
+			if val, ok := params.AreaId.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -4856,6 +5462,51 @@ func (c *Client) sendGetAreaAvailability(ctx context.Context, params GetAreaAvai
 		return res, errors.Wrap(err, "create request")
 	}
 
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ExportReservationsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:ApiKeyAuth"
+			switch err := c.securityApiKeyAuth(ctx, ExportReservationsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKeyAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -4864,7 +5515,7 @@ func (c *Client) sendGetAreaAvailability(ctx context.Context, params GetAreaAvai
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetAreaAvailabilityResponse(resp)
+	result, err := decodeExportReservationsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4872,22 +5523,21 @@ func (c *Client) sendGetAreaAvailability(ctx context.Context, params GetAreaAvai
 	return result, nil
 }
 
-// GetAreaBlocking invokes getAreaBlocking operation.
+// GetArea invokes getArea operation.
 //
-// Returns blocking periods defined at the area level.
-// Use includeInherited=true to also see blocking inherited from the building.
+// Get area details.
 //
-// GET /areas/{areaId}/blocking
-func (c *Client) GetAreaBlocking(ctx context.Context, params GetAreaBlockingParams) (GetAreaBlockingRes, error) {
-	res, err := c.sendGetAreaBlocking(ctx, params)
+// GET /areas/{areaId}
+func (c *Client) GetArea(ctx context.Context, params GetAreaParams) (GetAreaRes, error) {
+	res, err := c.sendGetArea(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetAreaBlocking(ctx context.Context, params GetAreaBlockingParams) (res GetAreaBlockingRes, err error) {
+func (c *Client) sendGetArea(ctx context.Context, params GetAreaParams) (res GetAreaRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAreaBlocking"),
+		otelogen.OperationID("getArea"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/areas/{areaId}/blocking"),
+		semconv.URLTemplateKey.String("/areas/{areaId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -4903,7 +5553,7 @@ func (c *Client) sendGetAreaBlocking(ctx context.Context, params GetAreaBlocking
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetAreaBlockingOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetAreaOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4920,7 +5570,7 @@ func (c *Client) sendGetAreaBlocking(ctx context.Context, params GetAreaBlocking
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
+	var pathParts [2]string
 	pathParts[0] = "/areas/"
 	{
 		// Encode "areaId" parameter.
@@ -4940,56 +5590,131 @@ func (c *Client) sendGetAreaBlocking(ctx context.Context, params GetAreaBlocking
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/blocking"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "includeInherited" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "includeInherited",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.IncludeInherited.Get(); ok {
-				return e.EncodeValue(conv.BoolToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetAreaResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetAreaAvailability invokes getAreaAvailability operation.
+//
+// Returns availability status for each place in the area within the given time range.
+// Availability is calculated as: time slots NOT blocked (from place, area, or building)
+// AND NOT reserved. Optimized for room plan visualization.
+// The window defaults to the whole day; nextAvailableSlot is the first available
+// slot on that day starting at or after the window start.
+//
+// GET /areas/{areaId}/availability
+func (c *Client) GetAreaAvailability(ctx context.Context, params GetAreaAvailabilityParams) (GetAreaAvailabilityRes, error) {
+	res, err := c.sendGetAreaAvailability(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetAreaAvailability(ctx context.Context, params GetAreaAvailabilityParams) (res GetAreaAvailabilityRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAreaAvailability"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/areas/{areaId}/availability"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetAreaAvailabilityOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/areas/"
+	{
+		// Encode "areaId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "areaId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.AreaId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
+	pathParts[2] = "/availability"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		// Encode "includeRecurring" parameter.
+		// Encode "date" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "includeRecurring",
+			Name:    "date",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.IncludeRecurring.Get(); ok {
-				return e.EncodeValue(conv.BoolToString(val))
-			}
-			return nil
+			return e.EncodeValue(conv.DateToString(params.Date))
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
 	{
-		// Encode "startDate" parameter.
+		// Encode "startTime" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "startDate",
+			Name:    "startTime",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.StartDate.Get(); ok {
-				return e.EncodeValue(conv.DateToString(val))
+			if val, ok := params.StartTime.Get(); ok {
+				return e.EncodeValue(conv.TimeToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -4997,16 +5722,16 @@ func (c *Client) sendGetAreaBlocking(ctx context.Context, params GetAreaBlocking
 		}
 	}
 	{
-		// Encode "endDate" parameter.
+		// Encode "endTime" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "endDate",
+			Name:    "endTime",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.EndDate.Get(); ok {
-				return e.EncodeValue(conv.DateToString(val))
+			if val, ok := params.EndTime.Get(); ok {
+				return e.EncodeValue(conv.TimeToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -5029,7 +5754,7 @@ func (c *Client) sendGetAreaBlocking(ctx context.Context, params GetAreaBlocking
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetAreaBlockingResponse(resp)
+	result, err := decodeGetAreaAvailabilityResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5037,21 +5762,22 @@ func (c *Client) sendGetAreaBlocking(ctx context.Context, params GetAreaBlocking
 	return result, nil
 }
 
-// GetAreaCalendar invokes getAreaCalendar operation.
+// GetAreaBlocking invokes getAreaBlocking operation.
 //
-// Returns .ics file with blocking periods and reservations for all places in area (next 3 months).
+// Returns blocking periods defined at the area level.
+// Use includeInherited=true to also see blocking inherited from the building.
 //
-// GET /areas/{areaId}/calendar.ics
-func (c *Client) GetAreaCalendar(ctx context.Context, params GetAreaCalendarParams) (GetAreaCalendarRes, error) {
-	res, err := c.sendGetAreaCalendar(ctx, params)
+// GET /areas/{areaId}/blocking
+func (c *Client) GetAreaBlocking(ctx context.Context, params GetAreaBlockingParams) (GetAreaBlockingRes, error) {
+	res, err := c.sendGetAreaBlocking(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetAreaCalendar(ctx context.Context, params GetAreaCalendarParams) (res GetAreaCalendarRes, err error) {
+func (c *Client) sendGetAreaBlocking(ctx context.Context, params GetAreaBlockingParams) (res GetAreaBlockingRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAreaCalendar"),
+		otelogen.OperationID("getAreaBlocking"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/areas/{areaId}/calendar.ics"),
+		semconv.URLTemplateKey.String("/areas/{areaId}/blocking"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -5067,7 +5793,7 @@ func (c *Client) sendGetAreaCalendar(ctx context.Context, params GetAreaCalendar
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetAreaCalendarOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetAreaBlockingOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5104,9 +5830,81 @@ func (c *Client) sendGetAreaCalendar(ctx context.Context, params GetAreaCalendar
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/calendar.ics"
+	pathParts[2] = "/blocking"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "includeInherited" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "includeInherited",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IncludeInherited.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "includeRecurring" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "includeRecurring",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IncludeRecurring.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "startDate" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "startDate",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.StartDate.Get(); ok {
+				return e.EncodeValue(conv.DateToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "endDate" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "endDate",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.EndDate.Get(); ok {
+				return e.EncodeValue(conv.DateToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -5121,7 +5919,7 @@ func (c *Client) sendGetAreaCalendar(ctx context.Context, params GetAreaCalendar
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetAreaCalendarResponse(resp)
+	result, err := decodeGetAreaBlockingResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5129,21 +5927,23 @@ func (c *Client) sendGetAreaCalendar(ctx context.Context, params GetAreaCalendar
 	return result, nil
 }
 
-// GetAreaRoomPlan invokes getAreaRoomPlan operation.
+// GetAreaCalendar invokes getAreaCalendar operation.
 //
-// Get room plan data.
+// Returns .ics file with blocking periods and reservations for all places in area (next 3 months).
+// Blockings of the area, its places and its building are included. Reservations never include
+// details about the user holding them; recurring blockings are emitted as RRULE/EXDATE.
 //
-// GET /areas/{areaId}/roomPlan
-func (c *Client) GetAreaRoomPlan(ctx context.Context, params GetAreaRoomPlanParams) (GetAreaRoomPlanRes, error) {
-	res, err := c.sendGetAreaRoomPlan(ctx, params)
+// GET /areas/{areaId}/calendar.ics
+func (c *Client) GetAreaCalendar(ctx context.Context, params GetAreaCalendarParams) (GetAreaCalendarRes, error) {
+	res, err := c.sendGetAreaCalendar(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetAreaRoomPlan(ctx context.Context, params GetAreaRoomPlanParams) (res GetAreaRoomPlanRes, err error) {
+func (c *Client) sendGetAreaCalendar(ctx context.Context, params GetAreaCalendarParams) (res GetAreaCalendarRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAreaRoomPlan"),
+		otelogen.OperationID("getAreaCalendar"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/areas/{areaId}/roomPlan"),
+		semconv.URLTemplateKey.String("/areas/{areaId}/calendar.ics"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -5159,7 +5959,7 @@ func (c *Client) sendGetAreaRoomPlan(ctx context.Context, params GetAreaRoomPlan
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetAreaRoomPlanOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetAreaCalendarOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5196,7 +5996,7 @@ func (c *Client) sendGetAreaRoomPlan(ctx context.Context, params GetAreaRoomPlan
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/roomPlan"
+	pathParts[2] = "/calendar.ics"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -5205,6 +6005,23 @@ func (c *Client) sendGetAreaRoomPlan(ctx context.Context, params GetAreaRoomPlan
 		return res, errors.Wrap(err, "create request")
 	}
 
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-None-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfNoneMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -5213,7 +6030,7 @@ func (c *Client) sendGetAreaRoomPlan(ctx context.Context, params GetAreaRoomPlan
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetAreaRoomPlanResponse(resp)
+	result, err := decodeGetAreaCalendarResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5221,21 +6038,22 @@ func (c *Client) sendGetAreaRoomPlan(ctx context.Context, params GetAreaRoomPlan
 	return result, nil
 }
 
-// GetAuditLog invokes getAuditLog operation.
+// GetAreaConstraints invokes getAreaConstraints operation.
 //
-// Get audit log entries.
+// Returns the default constraints of all places in the area. Places
+// inherit every field they do not set themselves, falling back to the building.
 //
-// GET /auditLog
-func (c *Client) GetAuditLog(ctx context.Context, params GetAuditLogParams) (GetAuditLogRes, error) {
-	res, err := c.sendGetAuditLog(ctx, params)
+// GET /areas/{areaId}/constraints
+func (c *Client) GetAreaConstraints(ctx context.Context, params GetAreaConstraintsParams) (GetAreaConstraintsRes, error) {
+	res, err := c.sendGetAreaConstraints(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetAuditLog(ctx context.Context, params GetAuditLogParams) (res GetAuditLogRes, err error) {
+func (c *Client) sendGetAreaConstraints(ctx context.Context, params GetAreaConstraintsParams) (res GetAreaConstraintsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAuditLog"),
+		otelogen.OperationID("getAreaConstraints"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/auditLog"),
+		semconv.URLTemplateKey.String("/areas/{areaId}/constraints"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -5251,7 +6069,7 @@ func (c *Client) sendGetAuditLog(ctx context.Context, params GetAuditLogParams)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetAuditLogOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetAreaConstraintsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5268,8 +6086,192 @@ func (c *Client) sendGetAuditLog(ctx context.Context, params GetAuditLogParams)
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/auditLog"
+	var pathParts [3]string
+	pathParts[0] = "/areas/"
+	{
+		// Encode "areaId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "areaId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.AreaId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/constraints"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetAreaConstraintsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetAreaRoomPlan invokes getAreaRoomPlan operation.
+//
+// Get room plan data.
+//
+// GET /areas/{areaId}/roomPlan
+func (c *Client) GetAreaRoomPlan(ctx context.Context, params GetAreaRoomPlanParams) (GetAreaRoomPlanRes, error) {
+	res, err := c.sendGetAreaRoomPlan(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetAreaRoomPlan(ctx context.Context, params GetAreaRoomPlanParams) (res GetAreaRoomPlanRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAreaRoomPlan"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/areas/{areaId}/roomPlan"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetAreaRoomPlanOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/areas/"
+	{
+		// Encode "areaId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "areaId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.AreaId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/roomPlan"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetAreaRoomPlanResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetAuditLog invokes getAuditLog operation.
+//
+// Get audit log entries.
+//
+// GET /auditLog
+func (c *Client) GetAuditLog(ctx context.Context, params GetAuditLogParams) (GetAuditLogRes, error) {
+	res, err := c.sendGetAuditLog(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetAuditLog(ctx context.Context, params GetAuditLogParams) (res GetAuditLogRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAuditLog"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/auditLog"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetAuditLogOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/auditLog"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
@@ -5574,6 +6576,10 @@ func (c *Client) sendGetBuilding(ctx context.Context, params GetBuildingParams)
 //
 // Returns time slots that are NOT blocked for the building.
 // Useful for seeing when the building is open/operational.
+// Only building-level blockings are considered, with recurrences expanded.
+// Besides the merged open ranges, the response summarizes the regular opening
+// hours per weekday and lists the days that deviate from them.
+// Ranges longer than 31 days are truncated.
 //
 // GET /buildings/{buildingId}/availability
 func (c *Client) GetBuildingAvailability(ctx context.Context, params GetBuildingAvailabilityParams) (GetBuildingAvailabilityRes, error) {
@@ -5846,6 +6852,8 @@ func (c *Client) sendGetBuildingBlocking(ctx context.Context, params GetBuilding
 // GetBuildingCalendar invokes getBuildingCalendar operation.
 //
 // Returns .ics file with blocking periods and reservations for all places (next 3 months).
+// Blockings of the building and of its areas and places are included. Reservations never
+// include details about the user holding them; recurring blockings are emitted as RRULE/EXDATE.
 //
 // GET /buildings/{buildingId}/calendar.ics
 func (c *Client) GetBuildingCalendar(ctx context.Context, params GetBuildingCalendarParams) (GetBuildingCalendarRes, error) {
@@ -5919,6 +6927,23 @@ func (c *Client) sendGetBuildingCalendar(ctx context.Context, params GetBuilding
 		return res, errors.Wrap(err, "create request")
 	}
 
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-None-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfNoneMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -5935,21 +6960,22 @@ func (c *Client) sendGetBuildingCalendar(ctx context.Context, params GetBuilding
 	return result, nil
 }
 
-// GetCurrentOccupancy invokes getCurrentOccupancy operation.
+// GetBuildingConstraints invokes getBuildingConstraints operation.
 //
-// Get current occupancy.
+// Returns the default constraints of all places in the building. Places
+// inherit every field they do not set themselves.
 //
-// GET /statistics/current
-func (c *Client) GetCurrentOccupancy(ctx context.Context, params GetCurrentOccupancyParams) (GetCurrentOccupancyRes, error) {
-	res, err := c.sendGetCurrentOccupancy(ctx, params)
+// GET /buildings/{buildingId}/constraints
+func (c *Client) GetBuildingConstraints(ctx context.Context, params GetBuildingConstraintsParams) (GetBuildingConstraintsRes, error) {
+	res, err := c.sendGetBuildingConstraints(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetCurrentOccupancy(ctx context.Context, params GetCurrentOccupancyParams) (res GetCurrentOccupancyRes, err error) {
+func (c *Client) sendGetBuildingConstraints(ctx context.Context, params GetBuildingConstraintsParams) (res GetBuildingConstraintsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getCurrentOccupancy"),
+		otelogen.OperationID("getBuildingConstraints"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/statistics/current"),
+		semconv.URLTemplateKey.String("/buildings/{buildingId}/constraints"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -5965,7 +6991,7 @@ func (c *Client) sendGetCurrentOccupancy(ctx context.Context, params GetCurrentO
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetCurrentOccupancyOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetBuildingConstraintsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5982,47 +7008,139 @@ func (c *Client) sendGetCurrentOccupancy(ctx context.Context, params GetCurrentO
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/statistics/current"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+	var pathParts [3]string
+	pathParts[0] = "/buildings/"
 	{
 		// Encode "buildingId" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "buildingId",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "buildingId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.BuildingId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.BuildingId.Get(); ok {
-				return e.EncodeValue(conv.UUIDToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	{
-		// Encode "areaId" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "areaId",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	pathParts[2] = "/constraints"
+	uri.AddPathParts(u, pathParts[:]...)
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.AreaId.Get(); ok {
-				return e.EncodeValue(conv.UUIDToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetBuildingConstraintsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetCurrentOccupancy invokes getCurrentOccupancy operation.
+//
+// Get current occupancy.
+//
+// GET /statistics/current
+func (c *Client) GetCurrentOccupancy(ctx context.Context, params GetCurrentOccupancyParams) (GetCurrentOccupancyRes, error) {
+	res, err := c.sendGetCurrentOccupancy(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetCurrentOccupancy(ctx context.Context, params GetCurrentOccupancyParams) (res GetCurrentOccupancyRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getCurrentOccupancy"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/statistics/current"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetCurrentOccupancyOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/statistics/current"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "buildingId" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "buildingId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.BuildingId.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "areaId" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "areaId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.AreaId.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -6209,6 +7327,125 @@ func (c *Client) sendGetCurrentUser(ctx context.Context) (res GetCurrentUserRes,
 	return result, nil
 }
 
+// GetCurrentUserCalendarToken invokes getCurrentUserCalendarToken operation.
+//
+// Returns when the current user's calendar feed token was created and last used. The token itself is
+// only shown once when it is created.
+//
+// GET /users/me/calendar-token
+func (c *Client) GetCurrentUserCalendarToken(ctx context.Context) (GetCurrentUserCalendarTokenRes, error) {
+	res, err := c.sendGetCurrentUserCalendarToken(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetCurrentUserCalendarToken(ctx context.Context) (res GetCurrentUserCalendarTokenRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getCurrentUserCalendarToken"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/users/me/calendar-token"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetCurrentUserCalendarTokenOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/users/me/calendar-token"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetCurrentUserCalendarTokenOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:ApiKeyAuth"
+			switch err := c.securityApiKeyAuth(ctx, GetCurrentUserCalendarTokenOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKeyAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetCurrentUserCalendarTokenResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // GetCurrentUserFavorites invokes getCurrentUserFavorites operation.
 //
 // Get current user's favorite places.
@@ -6563,21 +7800,23 @@ func (c *Client) sendGetDefaultGroupAssignment(ctx context.Context) (res GetDefa
 	return result, nil
 }
 
-// GetEquipment invokes getEquipment operation.
+// GetEffectivePlaceConstraints invokes getEffectivePlaceConstraints operation.
 //
-// Get equipment type details.
+// Returns the constraints that apply to bookings of the place. Every
+// field is taken from the place if set there, otherwise from its area,
+// otherwise from its building.
 //
-// GET /equipment/{equipmentId}
-func (c *Client) GetEquipment(ctx context.Context, params GetEquipmentParams) (GetEquipmentRes, error) {
-	res, err := c.sendGetEquipment(ctx, params)
+// GET /places/{placeId}/constraints/effective
+func (c *Client) GetEffectivePlaceConstraints(ctx context.Context, params GetEffectivePlaceConstraintsParams) (GetEffectivePlaceConstraintsRes, error) {
+	res, err := c.sendGetEffectivePlaceConstraints(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetEquipment(ctx context.Context, params GetEquipmentParams) (res GetEquipmentRes, err error) {
+func (c *Client) sendGetEffectivePlaceConstraints(ctx context.Context, params GetEffectivePlaceConstraintsParams) (res GetEffectivePlaceConstraintsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getEquipment"),
+		otelogen.OperationID("getEffectivePlaceConstraints"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/equipment/{equipmentId}"),
+		semconv.URLTemplateKey.String("/places/{placeId}/constraints/effective"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -6593,7 +7832,7 @@ func (c *Client) sendGetEquipment(ctx context.Context, params GetEquipmentParams
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetEquipmentOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetEffectivePlaceConstraintsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6610,17 +7849,17 @@ func (c *Client) sendGetEquipment(ctx context.Context, params GetEquipmentParams
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/equipment/"
+	var pathParts [3]string
+	pathParts[0] = "/places/"
 	{
-		// Encode "equipmentId" parameter.
+		// Encode "placeId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "equipmentId",
+			Param:   "placeId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.EquipmentId))
+			return e.EncodeValue(conv.UUIDToString(params.PlaceId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -6630,6 +7869,7 @@ func (c *Client) sendGetEquipment(ctx context.Context, params GetEquipmentParams
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/constraints/effective"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -6646,7 +7886,7 @@ func (c *Client) sendGetEquipment(ctx context.Context, params GetEquipmentParams
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetEquipmentResponse(resp)
+	result, err := decodeGetEffectivePlaceConstraintsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6654,21 +7894,21 @@ func (c *Client) sendGetEquipment(ctx context.Context, params GetEquipmentParams
 	return result, nil
 }
 
-// GetGroup invokes getGroup operation.
+// GetEquipment invokes getEquipment operation.
 //
-// Get group details.
+// Get equipment type details.
 //
-// GET /groups/{groupId}
-func (c *Client) GetGroup(ctx context.Context, params GetGroupParams) (GetGroupRes, error) {
-	res, err := c.sendGetGroup(ctx, params)
+// GET /equipment/{equipmentId}
+func (c *Client) GetEquipment(ctx context.Context, params GetEquipmentParams) (GetEquipmentRes, error) {
+	res, err := c.sendGetEquipment(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetGroup(ctx context.Context, params GetGroupParams) (res GetGroupRes, err error) {
+func (c *Client) sendGetEquipment(ctx context.Context, params GetEquipmentParams) (res GetEquipmentRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getGroup"),
+		otelogen.OperationID("getEquipment"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/groups/{groupId}"),
+		semconv.URLTemplateKey.String("/equipment/{equipmentId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -6684,7 +7924,7 @@ func (c *Client) sendGetGroup(ctx context.Context, params GetGroupParams) (res G
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetGroupOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetEquipmentOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6702,9 +7942,100 @@ func (c *Client) sendGetGroup(ctx context.Context, params GetGroupParams) (res G
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/groups/"
+	pathParts[0] = "/equipment/"
 	{
-		// Encode "groupId" parameter.
+		// Encode "equipmentId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "equipmentId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.EquipmentId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetEquipmentResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetGroup invokes getGroup operation.
+//
+// Get group details.
+//
+// GET /groups/{groupId}
+func (c *Client) GetGroup(ctx context.Context, params GetGroupParams) (GetGroupRes, error) {
+	res, err := c.sendGetGroup(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetGroup(ctx context.Context, params GetGroupParams) (res GetGroupRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getGroup"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/groups/{groupId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetGroupOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/groups/"
+	{
+		// Encode "groupId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
 			Param:   "groupId",
 			Style:   uri.PathStyleSimple,
@@ -7161,6 +8492,8 @@ func (c *Client) sendGetPlace(ctx context.Context, params GetPlaceParams) (res G
 // Availability is computed as: All valid time slot intervals
 // MINUS blocking periods (own + inherited from area + building)
 // MINUS existing reservations.
+// Ranges longer than 31 days are truncated. Reserved slots never
+// include details about the user holding the reservation.
 //
 // GET /places/{placeId}/availability
 func (c *Client) GetPlaceAvailability(ctx context.Context, params GetPlaceAvailabilityParams) (GetPlaceAvailabilityRes, error) {
@@ -7451,6 +8784,8 @@ func (c *Client) sendGetPlaceBlocking(ctx context.Context, params GetPlaceBlocki
 // GetPlaceCalendar invokes getPlaceCalendar operation.
 //
 // Returns .ics file with blocking periods and reservations (next 3 months).
+// Blockings inherited from the area and building are included. Reservations never include
+// details about the user holding them; recurring blockings are emitted as RRULE/EXDATE.
 //
 // GET /places/{placeId}/calendar.ics
 func (c *Client) GetPlaceCalendar(ctx context.Context, params GetPlaceCalendarParams) (GetPlaceCalendarRes, error) {
@@ -7524,6 +8859,23 @@ func (c *Client) sendGetPlaceCalendar(ctx context.Context, params GetPlaceCalend
 		return res, errors.Wrap(err, "create request")
 	}
 
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-None-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfNoneMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -7542,7 +8894,9 @@ func (c *Client) sendGetPlaceCalendar(ctx context.Context, params GetPlaceCalend
 
 // GetPlaceConstraints invokes getPlaceConstraints operation.
 //
-// Get place constraints.
+// Returns the constraints set on the place itself. Unset fields are
+// inherited from the area and the building of the place; see
+// getEffectivePlaceConstraints for the constraints that apply.
 //
 // GET /places/{placeId}/constraints
 func (c *Client) GetPlaceConstraints(ctx context.Context, params GetPlaceConstraintsParams) (GetPlaceConstraintsRes, error) {
@@ -7726,7 +9080,10 @@ func (c *Client) sendGetPlaceEquipment(ctx context.Context, params GetPlaceEquip
 
 // GetPlaceQrCode invokes getPlaceQrCode operation.
 //
-// Generate QR code.
+// Renders the printable label of the place at 300 DPI. The QR symbol encodes the check-in
+// URL of the place; the requested details are printed below it in the order name,
+// areaName, buildingName. PNG and JPEG images carry their resolution, PDFs consist of a
+// single page of the size of the label.
 //
 // GET /places/{placeId}/qrCode
 func (c *Client) GetPlaceQrCode(ctx context.Context, params GetPlaceQrCodeParams) (GetPlaceQrCodeRes, error) {
@@ -7925,6 +9282,143 @@ func (c *Client) sendGetPlaceQrCode(ctx context.Context, params GetPlaceQrCodePa
 	return result, nil
 }
 
+// GetPlaceQrKey invokes getPlaceQrKey operation.
+//
+// Returns the key version and the check-in URL encoded in the place's QR code.
+//
+// GET /places/{placeId}/qrCode/key
+func (c *Client) GetPlaceQrKey(ctx context.Context, params GetPlaceQrKeyParams) (GetPlaceQrKeyRes, error) {
+	res, err := c.sendGetPlaceQrKey(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetPlaceQrKey(ctx context.Context, params GetPlaceQrKeyParams) (res GetPlaceQrKeyRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getPlaceQrKey"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/places/{placeId}/qrCode/key"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetPlaceQrKeyOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/places/"
+	{
+		// Encode "placeId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "placeId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.PlaceId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/qrCode/key"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetPlaceQrKeyOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:ApiKeyAuth"
+			switch err := c.securityApiKeyAuth(ctx, GetPlaceQrKeyOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKeyAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetPlaceQrKeyResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // GetPlaceTimeSlots invokes getPlaceTimeSlots operation.
 //
 // Returns the booking grid configuration for this place.
@@ -9035,21 +10529,24 @@ func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res Get
 	return result, nil
 }
 
-// GetUserGroups invokes getUserGroups operation.
+// GetUserCalendar invokes getUserCalendar operation.
 //
-// Get user's groups.
+// Returns .ics file with the reservations of the user owning the token, from three months
+// ago until three months ahead, including place, area and building names and whether a
+// check-in is required. Cancelled reservations are included with STATUS:CANCELLED so that
+// subscribed calendars remove them.
 //
-// GET /users/{userId}/groups
-func (c *Client) GetUserGroups(ctx context.Context, params GetUserGroupsParams) (GetUserGroupsRes, error) {
-	res, err := c.sendGetUserGroups(ctx, params)
+// GET /calendar/users/{token}.ics
+func (c *Client) GetUserCalendar(ctx context.Context, params GetUserCalendarParams) (GetUserCalendarRes, error) {
+	res, err := c.sendGetUserCalendar(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetUserGroups(ctx context.Context, params GetUserGroupsParams) (res GetUserGroupsRes, err error) {
+func (c *Client) sendGetUserCalendar(ctx context.Context, params GetUserCalendarParams) (res GetUserCalendarRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getUserGroups"),
+		otelogen.OperationID("getUserCalendar"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/users/{userId}/groups"),
+		semconv.URLTemplateKey.String("/calendar/users/{token}.ics"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -9065,7 +10562,7 @@ func (c *Client) sendGetUserGroups(ctx context.Context, params GetUserGroupsPara
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetUserGroupsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetUserCalendarOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -9083,16 +10580,16 @@ func (c *Client) sendGetUserGroups(ctx context.Context, params GetUserGroupsPara
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/users/"
+	pathParts[0] = "/calendar/users/"
 	{
-		// Encode "userId" parameter.
+		// Encode "token" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "userId",
+			Param:   "token",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.UserId))
+			return e.EncodeValue(conv.StringToString(params.Token))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -9102,7 +10599,7 @@ func (c *Client) sendGetUserGroups(ctx context.Context, params GetUserGroupsPara
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/groups"
+	pathParts[2] = ".ics"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -9111,21 +10608,130 @@ func (c *Client) sendGetUserGroups(ctx context.Context, params GetUserGroupsPara
 		return res, errors.Wrap(err, "create request")
 	}
 
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
 	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetUserGroupsOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 0
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"BearerAuth\"")
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-None-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfNoneMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
 			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
 		}
-		{
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetUserCalendarResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetUserGroups invokes getUserGroups operation.
+//
+// Get user's groups.
+//
+// GET /users/{userId}/groups
+func (c *Client) GetUserGroups(ctx context.Context, params GetUserGroupsParams) (GetUserGroupsRes, error) {
+	res, err := c.sendGetUserGroups(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetUserGroups(ctx context.Context, params GetUserGroupsParams) (res GetUserGroupsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getUserGroups"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/users/{userId}/groups"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetUserGroupsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/users/"
+	{
+		// Encode "userId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "userId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.UserId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/groups"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetUserGroupsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
 			stage = "Security:ApiKeyAuth"
 			switch err := c.securityApiKeyAuth(ctx, GetUserGroupsOperation, r); {
 			case err == nil: // if NO error
@@ -9174,7 +10780,8 @@ func (c *Client) sendGetUserGroups(ctx context.Context, params GetUserGroupsPara
 
 // HandleOAuthCallback invokes handleOAuthCallback operation.
 //
-// Handles the OAuth callback from GitLab.
+// Handles the OAuth callback from GitLab. The responses below expire the
+// state cookie, since a state can only be used once.
 //
 // GET /auth/callback
 func (c *Client) HandleOAuthCallback(ctx context.Context, params HandleOAuthCallbackParams) (HandleOAuthCallbackRes, error) {
@@ -9261,6 +10868,25 @@ func (c *Client) sendHandleOAuthCallback(ctx context.Context, params HandleOAuth
 		return res, errors.Wrap(err, "create request")
 	}
 
+	stage = "EncodeCookieParams"
+	cookie := uri.NewCookieEncoder(r)
+	{
+		// Encode "roomy_oauth_state" parameter.
+		cfg := uri.CookieParameterEncodingConfig{
+			Name:    "roomy_oauth_state",
+			Explode: true,
+		}
+
+		if err := cookie.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.RoomyOAuthState.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode cookie")
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -9279,12 +10905,13 @@ func (c *Client) sendHandleOAuthCallback(ctx context.Context, params HandleOAuth
 
 // InitiateOAuthLogin invokes initiateOAuthLogin operation.
 //
-// Redirects to GitLab authorization URL.
+// Redirects to GitLab authorization URL. The state is bound to the browser
+// by a cookie, which the callback requires.
 //
 // GET /auth/login
-func (c *Client) InitiateOAuthLogin(ctx context.Context) error {
-	_, err := c.sendInitiateOAuthLogin(ctx)
-	return err
+func (c *Client) InitiateOAuthLogin(ctx context.Context) (*InitiateOAuthLoginFound, error) {
+	res, err := c.sendInitiateOAuthLogin(ctx)
+	return res, err
 }
 
 func (c *Client) sendInitiateOAuthLogin(ctx context.Context) (res *InitiateOAuthLoginFound, err error) {
@@ -9981,6 +11608,143 @@ func (c *Client) sendListEquipment(ctx context.Context) (res []Equipment, err er
 	return result, nil
 }
 
+// ListGroupConstraintProfiles invokes listGroupConstraintProfiles operation.
+//
+// List constraint profiles of group.
+//
+// GET /groups/{groupId}/constraintProfiles
+func (c *Client) ListGroupConstraintProfiles(ctx context.Context, params ListGroupConstraintProfilesParams) (ListGroupConstraintProfilesRes, error) {
+	res, err := c.sendListGroupConstraintProfiles(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListGroupConstraintProfiles(ctx context.Context, params ListGroupConstraintProfilesParams) (res ListGroupConstraintProfilesRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listGroupConstraintProfiles"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/groups/{groupId}/constraintProfiles"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListGroupConstraintProfilesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/groups/"
+	{
+		// Encode "groupId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "groupId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.GroupId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/constraintProfiles"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListGroupConstraintProfilesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:ApiKeyAuth"
+			switch err := c.securityApiKeyAuth(ctx, ListGroupConstraintProfilesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKeyAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListGroupConstraintProfilesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // ListGroups invokes listGroups operation.
 //
 // List all permission groups.
@@ -11020,30 +12784,663 @@ func (c *Client) sendListUsers(ctx context.Context, params ListUsersParams) (res
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListUsersOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:ApiKeyAuth"
+			switch err := c.securityApiKeyAuth(ctx, ListUsersOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKeyAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListUsersResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// Logout invokes logout operation.
+//
+// Invalidate current session.
+//
+// POST /auth/logout
+func (c *Client) Logout(ctx context.Context) error {
+	_, err := c.sendLogout(ctx)
+	return err
+}
+
+func (c *Client) sendLogout(ctx context.Context) (res *LogoutNoContent, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("logout"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/auth/logout"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, LogoutOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/auth/logout"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, LogoutOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:ApiKeyAuth"
+			switch err := c.securityApiKeyAuth(ctx, LogoutOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKeyAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeLogoutResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PreviewQrTemplate invokes previewQrTemplate operation.
+//
+// Renders the template with the data of a sample place, either as the HTML shown in
+// browsers or as the label image printed by getPlaceQrCode.
+//
+// GET /qrTemplates/{templateId}/preview
+func (c *Client) PreviewQrTemplate(ctx context.Context, params PreviewQrTemplateParams) (PreviewQrTemplateRes, error) {
+	res, err := c.sendPreviewQrTemplate(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendPreviewQrTemplate(ctx context.Context, params PreviewQrTemplateParams) (res PreviewQrTemplateRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("previewQrTemplate"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/qrTemplates/{templateId}/preview"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, PreviewQrTemplateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/qrTemplates/"
+	{
+		// Encode "templateId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "templateId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.TemplateId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/preview"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "format" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Format.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, PreviewQrTemplateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:ApiKeyAuth"
+			switch err := c.securityApiKeyAuth(ctx, PreviewQrTemplateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKeyAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodePreviewQrTemplateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RefreshToken invokes refreshToken operation.
+//
+// Refresh access token.
+//
+// POST /auth/refresh
+func (c *Client) RefreshToken(ctx context.Context, request *RefreshTokenReq) (RefreshTokenRes, error) {
+	res, err := c.sendRefreshToken(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendRefreshToken(ctx context.Context, request *RefreshTokenReq) (res RefreshTokenRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("refreshToken"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/auth/refresh"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RefreshTokenOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/auth/refresh"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeRefreshTokenRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRefreshTokenResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RemoveAreaBlockingEntries invokes removeAreaBlockingEntries operation.
+//
+// Remove blocking periods by IDs.
+//
+// DELETE /areas/{areaId}/blocking/entries
+func (c *Client) RemoveAreaBlockingEntries(ctx context.Context, request *RemoveAreaBlockingEntriesReq, params RemoveAreaBlockingEntriesParams) (RemoveAreaBlockingEntriesRes, error) {
+	res, err := c.sendRemoveAreaBlockingEntries(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendRemoveAreaBlockingEntries(ctx context.Context, request *RemoveAreaBlockingEntriesReq, params RemoveAreaBlockingEntriesParams) (res RemoveAreaBlockingEntriesRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("removeAreaBlockingEntries"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/areas/{areaId}/blocking/entries"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RemoveAreaBlockingEntriesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/areas/"
+	{
+		// Encode "areaId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "areaId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.AreaId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/blocking/entries"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeRemoveAreaBlockingEntriesRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, RemoveAreaBlockingEntriesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:ApiKeyAuth"
+			switch err := c.securityApiKeyAuth(ctx, RemoveAreaBlockingEntriesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKeyAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRemoveAreaBlockingEntriesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RemoveAreaRoomPlanMarkers invokes removeAreaRoomPlanMarkers operation.
+//
+// Remove place markers by IDs.
+//
+// DELETE /areas/{areaId}/roomPlan/markers
+func (c *Client) RemoveAreaRoomPlanMarkers(ctx context.Context, request *RemoveAreaRoomPlanMarkersReq, params RemoveAreaRoomPlanMarkersParams) (RemoveAreaRoomPlanMarkersRes, error) {
+	res, err := c.sendRemoveAreaRoomPlanMarkers(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendRemoveAreaRoomPlanMarkers(ctx context.Context, request *RemoveAreaRoomPlanMarkersReq, params RemoveAreaRoomPlanMarkersParams) (res RemoveAreaRoomPlanMarkersRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("removeAreaRoomPlanMarkers"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/areas/{areaId}/roomPlan/markers"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RemoveAreaRoomPlanMarkersOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/areas/"
+	{
+		// Encode "areaId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "areaId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.AreaId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	pathParts[2] = "/roomPlan/markers"
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeRemoveAreaRoomPlanMarkersRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListUsersOperation, r); {
+			switch err := c.securityBearerAuth(ctx, RemoveAreaRoomPlanMarkersOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11054,7 +13451,7 @@ func (c *Client) sendListUsers(ctx context.Context, params ListUsersParams) (res
 		}
 		{
 			stage = "Security:ApiKeyAuth"
-			switch err := c.securityApiKeyAuth(ctx, ListUsersOperation, r); {
+			switch err := c.securityApiKeyAuth(ctx, RemoveAreaRoomPlanMarkersOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11091,7 +13488,7 @@ func (c *Client) sendListUsers(ctx context.Context, params ListUsersParams) (res
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListUsersResponse(resp)
+	result, err := decodeRemoveAreaRoomPlanMarkersResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -11099,21 +13496,21 @@ func (c *Client) sendListUsers(ctx context.Context, params ListUsersParams) (res
 	return result, nil
 }
 
-// Logout invokes logout operation.
+// RemoveBuildingBlockingEntries invokes removeBuildingBlockingEntries operation.
 //
-// Invalidate current session.
+// Remove blocking periods by IDs.
 //
-// POST /auth/logout
-func (c *Client) Logout(ctx context.Context) error {
-	_, err := c.sendLogout(ctx)
-	return err
+// DELETE /buildings/{buildingId}/blocking/entries
+func (c *Client) RemoveBuildingBlockingEntries(ctx context.Context, request *RemoveBuildingBlockingEntriesReq, params RemoveBuildingBlockingEntriesParams) (RemoveBuildingBlockingEntriesRes, error) {
+	res, err := c.sendRemoveBuildingBlockingEntries(ctx, request, params)
+	return res, err
 }
 
-func (c *Client) sendLogout(ctx context.Context) (res *LogoutNoContent, err error) {
+func (c *Client) sendRemoveBuildingBlockingEntries(ctx context.Context, request *RemoveBuildingBlockingEntriesReq, params RemoveBuildingBlockingEntriesParams) (res RemoveBuildingBlockingEntriesRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("logout"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/auth/logout"),
+		otelogen.OperationID("removeBuildingBlockingEntries"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/buildings/{buildingId}/blocking/entries"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -11129,7 +13526,7 @@ func (c *Client) sendLogout(ctx context.Context) (res *LogoutNoContent, err erro
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, LogoutOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, RemoveBuildingBlockingEntriesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -11146,22 +13543,44 @@ func (c *Client) sendLogout(ctx context.Context) (res *LogoutNoContent, err erro
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/auth/logout"
+	var pathParts [3]string
+	pathParts[0] = "/buildings/"
+	{
+		// Encode "buildingId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "buildingId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.BuildingId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/blocking/entries"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeRemoveBuildingBlockingEntriesRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, LogoutOperation, r); {
+			switch err := c.securityBearerAuth(ctx, RemoveBuildingBlockingEntriesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11172,7 +13591,7 @@ func (c *Client) sendLogout(ctx context.Context) (res *LogoutNoContent, err erro
 		}
 		{
 			stage = "Security:ApiKeyAuth"
-			switch err := c.securityApiKeyAuth(ctx, LogoutOperation, r); {
+			switch err := c.securityApiKeyAuth(ctx, RemoveBuildingBlockingEntriesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11209,7 +13628,7 @@ func (c *Client) sendLogout(ctx context.Context) (res *LogoutNoContent, err erro
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeLogoutResponse(resp)
+	result, err := decodeRemoveBuildingBlockingEntriesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -11217,21 +13636,21 @@ func (c *Client) sendLogout(ctx context.Context) (res *LogoutNoContent, err erro
 	return result, nil
 }
 
-// PreviewQrTemplate invokes previewQrTemplate operation.
+// RemoveCurrentUserFavorites invokes removeCurrentUserFavorites operation.
 //
-// Preview template with sample data.
+// Remove places from favorites.
 //
-// GET /qrTemplates/{templateId}/preview
-func (c *Client) PreviewQrTemplate(ctx context.Context, params PreviewQrTemplateParams) (PreviewQrTemplateRes, error) {
-	res, err := c.sendPreviewQrTemplate(ctx, params)
+// DELETE /users/me/favorites
+func (c *Client) RemoveCurrentUserFavorites(ctx context.Context, request *RemoveCurrentUserFavoritesReq) (RemoveCurrentUserFavoritesRes, error) {
+	res, err := c.sendRemoveCurrentUserFavorites(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendPreviewQrTemplate(ctx context.Context, params PreviewQrTemplateParams) (res PreviewQrTemplateRes, err error) {
+func (c *Client) sendRemoveCurrentUserFavorites(ctx context.Context, request *RemoveCurrentUserFavoritesReq) (res RemoveCurrentUserFavoritesRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("previewQrTemplate"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/qrTemplates/{templateId}/preview"),
+		otelogen.OperationID("removeCurrentUserFavorites"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/users/me/favorites"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -11247,7 +13666,7 @@ func (c *Client) sendPreviewQrTemplate(ctx context.Context, params PreviewQrTemp
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, PreviewQrTemplateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, RemoveCurrentUserFavoritesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -11264,41 +13683,25 @@ func (c *Client) sendPreviewQrTemplate(ctx context.Context, params PreviewQrTemp
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/qrTemplates/"
-	{
-		// Encode "templateId" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "templateId",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.TemplateId))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/preview"
+	var pathParts [1]string
+	pathParts[0] = "/users/me/favorites"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeRemoveCurrentUserFavoritesRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, PreviewQrTemplateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, RemoveCurrentUserFavoritesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11309,7 +13712,7 @@ func (c *Client) sendPreviewQrTemplate(ctx context.Context, params PreviewQrTemp
 		}
 		{
 			stage = "Security:ApiKeyAuth"
-			switch err := c.securityApiKeyAuth(ctx, PreviewQrTemplateOperation, r); {
+			switch err := c.securityApiKeyAuth(ctx, RemoveCurrentUserFavoritesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11346,7 +13749,7 @@ func (c *Client) sendPreviewQrTemplate(ctx context.Context, params PreviewQrTemp
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodePreviewQrTemplateResponse(resp)
+	result, err := decodeRemoveCurrentUserFavoritesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -11354,21 +13757,21 @@ func (c *Client) sendPreviewQrTemplate(ctx context.Context, params PreviewQrTemp
 	return result, nil
 }
 
-// RefreshToken invokes refreshToken operation.
+// RemoveGroupPermissions invokes removeGroupPermissions operation.
 //
-// Refresh access token.
+// Remove permissions from group.
 //
-// POST /auth/refresh
-func (c *Client) RefreshToken(ctx context.Context, request *RefreshTokenReq) (RefreshTokenRes, error) {
-	res, err := c.sendRefreshToken(ctx, request)
+// DELETE /groups/{groupId}/permissions
+func (c *Client) RemoveGroupPermissions(ctx context.Context, request *RemoveGroupPermissionsReq, params RemoveGroupPermissionsParams) (RemoveGroupPermissionsRes, error) {
+	res, err := c.sendRemoveGroupPermissions(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendRefreshToken(ctx context.Context, request *RefreshTokenReq) (res RefreshTokenRes, err error) {
+func (c *Client) sendRemoveGroupPermissions(ctx context.Context, request *RemoveGroupPermissionsReq, params RemoveGroupPermissionsParams) (res RemoveGroupPermissionsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("refreshToken"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/auth/refresh"),
+		otelogen.OperationID("removeGroupPermissions"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/groups/{groupId}/permissions"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -11384,7 +13787,7 @@ func (c *Client) sendRefreshToken(ctx context.Context, request *RefreshTokenReq)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, RefreshTokenOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, RemoveGroupPermissionsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -11401,19 +13804,83 @@ func (c *Client) sendRefreshToken(ctx context.Context, request *RefreshTokenReq)
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/auth/refresh"
+	var pathParts [3]string
+	pathParts[0] = "/groups/"
+	{
+		// Encode "groupId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "groupId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.GroupId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/permissions"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeRefreshTokenRequest(request, r); err != nil {
+	if err := encodeRemoveGroupPermissionsRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, RemoveGroupPermissionsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:ApiKeyAuth"
+			switch err := c.securityApiKeyAuth(ctx, RemoveGroupPermissionsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKeyAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -11422,7 +13889,7 @@ func (c *Client) sendRefreshToken(ctx context.Context, request *RefreshTokenReq)
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeRefreshTokenResponse(resp)
+	result, err := decodeRemoveGroupPermissionsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -11430,21 +13897,21 @@ func (c *Client) sendRefreshToken(ctx context.Context, request *RefreshTokenReq)
 	return result, nil
 }
 
-// RemoveAreaBlockingEntries invokes removeAreaBlockingEntries operation.
+// RemovePlaceBlockingEntries invokes removePlaceBlockingEntries operation.
 //
 // Remove blocking periods by IDs.
 //
-// DELETE /areas/{areaId}/blocking/entries
-func (c *Client) RemoveAreaBlockingEntries(ctx context.Context, request *RemoveAreaBlockingEntriesReq, params RemoveAreaBlockingEntriesParams) (RemoveAreaBlockingEntriesRes, error) {
-	res, err := c.sendRemoveAreaBlockingEntries(ctx, request, params)
+// DELETE /places/{placeId}/blocking/entries
+func (c *Client) RemovePlaceBlockingEntries(ctx context.Context, request *RemovePlaceBlockingEntriesReq, params RemovePlaceBlockingEntriesParams) (RemovePlaceBlockingEntriesRes, error) {
+	res, err := c.sendRemovePlaceBlockingEntries(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendRemoveAreaBlockingEntries(ctx context.Context, request *RemoveAreaBlockingEntriesReq, params RemoveAreaBlockingEntriesParams) (res RemoveAreaBlockingEntriesRes, err error) {
+func (c *Client) sendRemovePlaceBlockingEntries(ctx context.Context, request *RemovePlaceBlockingEntriesReq, params RemovePlaceBlockingEntriesParams) (res RemovePlaceBlockingEntriesRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removeAreaBlockingEntries"),
+		otelogen.OperationID("removePlaceBlockingEntries"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/areas/{areaId}/blocking/entries"),
+		semconv.URLTemplateKey.String("/places/{placeId}/blocking/entries"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -11460,7 +13927,7 @@ func (c *Client) sendRemoveAreaBlockingEntries(ctx context.Context, request *Rem
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, RemoveAreaBlockingEntriesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, RemovePlaceBlockingEntriesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -11478,16 +13945,16 @@ func (c *Client) sendRemoveAreaBlockingEntries(ctx context.Context, request *Rem
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/areas/"
+	pathParts[0] = "/places/"
 	{
-		// Encode "areaId" parameter.
+		// Encode "placeId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "areaId",
+			Param:   "placeId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.AreaId))
+			return e.EncodeValue(conv.UUIDToString(params.PlaceId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -11505,7 +13972,7 @@ func (c *Client) sendRemoveAreaBlockingEntries(ctx context.Context, request *Rem
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeRemoveAreaBlockingEntriesRequest(request, r); err != nil {
+	if err := encodeRemovePlaceBlockingEntriesRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -11514,7 +13981,7 @@ func (c *Client) sendRemoveAreaBlockingEntries(ctx context.Context, request *Rem
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, RemoveAreaBlockingEntriesOperation, r); {
+			switch err := c.securityBearerAuth(ctx, RemovePlaceBlockingEntriesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11525,7 +13992,7 @@ func (c *Client) sendRemoveAreaBlockingEntries(ctx context.Context, request *Rem
 		}
 		{
 			stage = "Security:ApiKeyAuth"
-			switch err := c.securityApiKeyAuth(ctx, RemoveAreaBlockingEntriesOperation, r); {
+			switch err := c.securityApiKeyAuth(ctx, RemovePlaceBlockingEntriesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11562,7 +14029,7 @@ func (c *Client) sendRemoveAreaBlockingEntries(ctx context.Context, request *Rem
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeRemoveAreaBlockingEntriesResponse(resp)
+	result, err := decodeRemovePlaceBlockingEntriesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -11570,21 +14037,21 @@ func (c *Client) sendRemoveAreaBlockingEntries(ctx context.Context, request *Rem
 	return result, nil
 }
 
-// RemoveAreaRoomPlanMarkers invokes removeAreaRoomPlanMarkers operation.
+// RemovePlaceEquipment invokes removePlaceEquipment operation.
 //
-// Remove place markers by IDs.
+// Remove equipment from place.
 //
-// DELETE /areas/{areaId}/roomPlan/markers
-func (c *Client) RemoveAreaRoomPlanMarkers(ctx context.Context, request *RemoveAreaRoomPlanMarkersReq, params RemoveAreaRoomPlanMarkersParams) (RemoveAreaRoomPlanMarkersRes, error) {
-	res, err := c.sendRemoveAreaRoomPlanMarkers(ctx, request, params)
+// DELETE /places/{placeId}/equipment
+func (c *Client) RemovePlaceEquipment(ctx context.Context, request *RemovePlaceEquipmentReq, params RemovePlaceEquipmentParams) (RemovePlaceEquipmentRes, error) {
+	res, err := c.sendRemovePlaceEquipment(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendRemoveAreaRoomPlanMarkers(ctx context.Context, request *RemoveAreaRoomPlanMarkersReq, params RemoveAreaRoomPlanMarkersParams) (res RemoveAreaRoomPlanMarkersRes, err error) {
+func (c *Client) sendRemovePlaceEquipment(ctx context.Context, request *RemovePlaceEquipmentReq, params RemovePlaceEquipmentParams) (res RemovePlaceEquipmentRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removeAreaRoomPlanMarkers"),
+		otelogen.OperationID("removePlaceEquipment"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/areas/{areaId}/roomPlan/markers"),
+		semconv.URLTemplateKey.String("/places/{placeId}/equipment"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -11600,7 +14067,7 @@ func (c *Client) sendRemoveAreaRoomPlanMarkers(ctx context.Context, request *Rem
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, RemoveAreaRoomPlanMarkersOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, RemovePlaceEquipmentOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -11618,16 +14085,16 @@ func (c *Client) sendRemoveAreaRoomPlanMarkers(ctx context.Context, request *Rem
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/areas/"
+	pathParts[0] = "/places/"
 	{
-		// Encode "areaId" parameter.
+		// Encode "placeId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "areaId",
+			Param:   "placeId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.AreaId))
+			return e.EncodeValue(conv.UUIDToString(params.PlaceId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -11637,7 +14104,7 @@ func (c *Client) sendRemoveAreaRoomPlanMarkers(ctx context.Context, request *Rem
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/roomPlan/markers"
+	pathParts[2] = "/equipment"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -11645,7 +14112,7 @@ func (c *Client) sendRemoveAreaRoomPlanMarkers(ctx context.Context, request *Rem
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeRemoveAreaRoomPlanMarkersRequest(request, r); err != nil {
+	if err := encodeRemovePlaceEquipmentRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -11654,7 +14121,7 @@ func (c *Client) sendRemoveAreaRoomPlanMarkers(ctx context.Context, request *Rem
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, RemoveAreaRoomPlanMarkersOperation, r); {
+			switch err := c.securityBearerAuth(ctx, RemovePlaceEquipmentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11665,7 +14132,7 @@ func (c *Client) sendRemoveAreaRoomPlanMarkers(ctx context.Context, request *Rem
 		}
 		{
 			stage = "Security:ApiKeyAuth"
-			switch err := c.securityApiKeyAuth(ctx, RemoveAreaRoomPlanMarkersOperation, r); {
+			switch err := c.securityApiKeyAuth(ctx, RemovePlaceEquipmentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11702,7 +14169,7 @@ func (c *Client) sendRemoveAreaRoomPlanMarkers(ctx context.Context, request *Rem
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeRemoveAreaRoomPlanMarkersResponse(resp)
+	result, err := decodeRemovePlaceEquipmentResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -11710,21 +14177,21 @@ func (c *Client) sendRemoveAreaRoomPlanMarkers(ctx context.Context, request *Rem
 	return result, nil
 }
 
-// RemoveBuildingBlockingEntries invokes removeBuildingBlockingEntries operation.
+// RemovePlaceWhitelistUsers invokes removePlaceWhitelistUsers operation.
 //
-// Remove blocking periods by IDs.
+// Remove users from whitelist.
 //
-// DELETE /buildings/{buildingId}/blocking/entries
-func (c *Client) RemoveBuildingBlockingEntries(ctx context.Context, request *RemoveBuildingBlockingEntriesReq, params RemoveBuildingBlockingEntriesParams) (RemoveBuildingBlockingEntriesRes, error) {
-	res, err := c.sendRemoveBuildingBlockingEntries(ctx, request, params)
+// DELETE /places/{placeId}/whitelist
+func (c *Client) RemovePlaceWhitelistUsers(ctx context.Context, request *RemovePlaceWhitelistUsersReq, params RemovePlaceWhitelistUsersParams) (RemovePlaceWhitelistUsersRes, error) {
+	res, err := c.sendRemovePlaceWhitelistUsers(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendRemoveBuildingBlockingEntries(ctx context.Context, request *RemoveBuildingBlockingEntriesReq, params RemoveBuildingBlockingEntriesParams) (res RemoveBuildingBlockingEntriesRes, err error) {
+func (c *Client) sendRemovePlaceWhitelistUsers(ctx context.Context, request *RemovePlaceWhitelistUsersReq, params RemovePlaceWhitelistUsersParams) (res RemovePlaceWhitelistUsersRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removeBuildingBlockingEntries"),
+		otelogen.OperationID("removePlaceWhitelistUsers"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/buildings/{buildingId}/blocking/entries"),
+		semconv.URLTemplateKey.String("/places/{placeId}/whitelist"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -11740,7 +14207,7 @@ func (c *Client) sendRemoveBuildingBlockingEntries(ctx context.Context, request
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, RemoveBuildingBlockingEntriesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, RemovePlaceWhitelistUsersOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -11758,16 +14225,16 @@ func (c *Client) sendRemoveBuildingBlockingEntries(ctx context.Context, request
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/buildings/"
+	pathParts[0] = "/places/"
 	{
-		// Encode "buildingId" parameter.
+		// Encode "placeId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "buildingId",
+			Param:   "placeId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.BuildingId))
+			return e.EncodeValue(conv.UUIDToString(params.PlaceId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -11777,7 +14244,7 @@ func (c *Client) sendRemoveBuildingBlockingEntries(ctx context.Context, request
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/blocking/entries"
+	pathParts[2] = "/whitelist"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -11785,7 +14252,7 @@ func (c *Client) sendRemoveBuildingBlockingEntries(ctx context.Context, request
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeRemoveBuildingBlockingEntriesRequest(request, r); err != nil {
+	if err := encodeRemovePlaceWhitelistUsersRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -11794,7 +14261,7 @@ func (c *Client) sendRemoveBuildingBlockingEntries(ctx context.Context, request
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, RemoveBuildingBlockingEntriesOperation, r); {
+			switch err := c.securityBearerAuth(ctx, RemovePlaceWhitelistUsersOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11805,7 +14272,7 @@ func (c *Client) sendRemoveBuildingBlockingEntries(ctx context.Context, request
 		}
 		{
 			stage = "Security:ApiKeyAuth"
-			switch err := c.securityApiKeyAuth(ctx, RemoveBuildingBlockingEntriesOperation, r); {
+			switch err := c.securityApiKeyAuth(ctx, RemovePlaceWhitelistUsersOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11842,7 +14309,7 @@ func (c *Client) sendRemoveBuildingBlockingEntries(ctx context.Context, request
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeRemoveBuildingBlockingEntriesResponse(resp)
+	result, err := decodeRemovePlaceWhitelistUsersResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -11850,21 +14317,21 @@ func (c *Client) sendRemoveBuildingBlockingEntries(ctx context.Context, request
 	return result, nil
 }
 
-// RemoveCurrentUserFavorites invokes removeCurrentUserFavorites operation.
+// RemoveUserGroups invokes removeUserGroups operation.
 //
-// Remove places from favorites.
+// Remove groups from user.
 //
-// DELETE /users/me/favorites
-func (c *Client) RemoveCurrentUserFavorites(ctx context.Context, request *RemoveCurrentUserFavoritesReq) (RemoveCurrentUserFavoritesRes, error) {
-	res, err := c.sendRemoveCurrentUserFavorites(ctx, request)
+// DELETE /users/{userId}/groups
+func (c *Client) RemoveUserGroups(ctx context.Context, request *RemoveUserGroupsReq, params RemoveUserGroupsParams) (RemoveUserGroupsRes, error) {
+	res, err := c.sendRemoveUserGroups(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendRemoveCurrentUserFavorites(ctx context.Context, request *RemoveCurrentUserFavoritesReq) (res RemoveCurrentUserFavoritesRes, err error) {
+func (c *Client) sendRemoveUserGroups(ctx context.Context, request *RemoveUserGroupsReq, params RemoveUserGroupsParams) (res RemoveUserGroupsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removeCurrentUserFavorites"),
+		otelogen.OperationID("removeUserGroups"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/users/me/favorites"),
+		semconv.URLTemplateKey.String("/users/{userId}/groups"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -11880,7 +14347,7 @@ func (c *Client) sendRemoveCurrentUserFavorites(ctx context.Context, request *Re
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, RemoveCurrentUserFavoritesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, RemoveUserGroupsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -11897,8 +14364,27 @@ func (c *Client) sendRemoveCurrentUserFavorites(ctx context.Context, request *Re
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/users/me/favorites"
+	var pathParts [3]string
+	pathParts[0] = "/users/"
+	{
+		// Encode "userId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "userId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.UserId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/groups"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -11906,7 +14392,7 @@ func (c *Client) sendRemoveCurrentUserFavorites(ctx context.Context, request *Re
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeRemoveCurrentUserFavoritesRequest(request, r); err != nil {
+	if err := encodeRemoveUserGroupsRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -11915,7 +14401,7 @@ func (c *Client) sendRemoveCurrentUserFavorites(ctx context.Context, request *Re
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, RemoveCurrentUserFavoritesOperation, r); {
+			switch err := c.securityBearerAuth(ctx, RemoveUserGroupsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11926,7 +14412,7 @@ func (c *Client) sendRemoveCurrentUserFavorites(ctx context.Context, request *Re
 		}
 		{
 			stage = "Security:ApiKeyAuth"
-			switch err := c.securityApiKeyAuth(ctx, RemoveCurrentUserFavoritesOperation, r); {
+			switch err := c.securityApiKeyAuth(ctx, RemoveUserGroupsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -11963,7 +14449,7 @@ func (c *Client) sendRemoveCurrentUserFavorites(ctx context.Context, request *Re
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeRemoveCurrentUserFavoritesResponse(resp)
+	result, err := decodeRemoveUserGroupsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -11971,21 +14457,21 @@ func (c *Client) sendRemoveCurrentUserFavorites(ctx context.Context, request *Re
 	return result, nil
 }
 
-// RemoveGroupPermissions invokes removeGroupPermissions operation.
+// ReplaceAreaBlocking invokes replaceAreaBlocking operation.
 //
-// Remove permissions from group.
+// Replace all area blocking periods.
 //
-// DELETE /groups/{groupId}/permissions
-func (c *Client) RemoveGroupPermissions(ctx context.Context, request *RemoveGroupPermissionsReq, params RemoveGroupPermissionsParams) (RemoveGroupPermissionsRes, error) {
-	res, err := c.sendRemoveGroupPermissions(ctx, request, params)
+// PUT /areas/{areaId}/blocking
+func (c *Client) ReplaceAreaBlocking(ctx context.Context, request *ReplaceAreaBlockingReq, params ReplaceAreaBlockingParams) (ReplaceAreaBlockingRes, error) {
+	res, err := c.sendReplaceAreaBlocking(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendRemoveGroupPermissions(ctx context.Context, request *RemoveGroupPermissionsReq, params RemoveGroupPermissionsParams) (res RemoveGroupPermissionsRes, err error) {
+func (c *Client) sendReplaceAreaBlocking(ctx context.Context, request *ReplaceAreaBlockingReq, params ReplaceAreaBlockingParams) (res ReplaceAreaBlockingRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removeGroupPermissions"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/groups/{groupId}/permissions"),
+		otelogen.OperationID("replaceAreaBlocking"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/areas/{areaId}/blocking"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -12001,7 +14487,7 @@ func (c *Client) sendRemoveGroupPermissions(ctx context.Context, request *Remove
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, RemoveGroupPermissionsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ReplaceAreaBlockingOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -12019,16 +14505,16 @@ func (c *Client) sendRemoveGroupPermissions(ctx context.Context, request *Remove
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/groups/"
+	pathParts[0] = "/areas/"
 	{
-		// Encode "groupId" parameter.
+		// Encode "areaId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "groupId",
+			Param:   "areaId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.GroupId))
+			return e.EncodeValue(conv.UUIDToString(params.AreaId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -12038,15 +14524,15 @@ func (c *Client) sendRemoveGroupPermissions(ctx context.Context, request *Remove
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/permissions"
+	pathParts[2] = "/blocking"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeRemoveGroupPermissionsRequest(request, r); err != nil {
+	if err := encodeReplaceAreaBlockingRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -12055,7 +14541,7 @@ func (c *Client) sendRemoveGroupPermissions(ctx context.Context, request *Remove
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, RemoveGroupPermissionsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, ReplaceAreaBlockingOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12066,7 +14552,7 @@ func (c *Client) sendRemoveGroupPermissions(ctx context.Context, request *Remove
 		}
 		{
 			stage = "Security:ApiKeyAuth"
-			switch err := c.securityApiKeyAuth(ctx, RemoveGroupPermissionsOperation, r); {
+			switch err := c.securityApiKeyAuth(ctx, ReplaceAreaBlockingOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12103,7 +14589,7 @@ func (c *Client) sendRemoveGroupPermissions(ctx context.Context, request *Remove
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeRemoveGroupPermissionsResponse(resp)
+	result, err := decodeReplaceAreaBlockingResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -12111,21 +14597,21 @@ func (c *Client) sendRemoveGroupPermissions(ctx context.Context, request *Remove
 	return result, nil
 }
 
-// RemovePlaceBlockingEntries invokes removePlaceBlockingEntries operation.
+// ReplaceBuildingBlocking invokes replaceBuildingBlocking operation.
 //
-// Remove blocking periods by IDs.
+// Replace all building blocking periods.
 //
-// DELETE /places/{placeId}/blocking/entries
-func (c *Client) RemovePlaceBlockingEntries(ctx context.Context, request *RemovePlaceBlockingEntriesReq, params RemovePlaceBlockingEntriesParams) (RemovePlaceBlockingEntriesRes, error) {
-	res, err := c.sendRemovePlaceBlockingEntries(ctx, request, params)
+// PUT /buildings/{buildingId}/blocking
+func (c *Client) ReplaceBuildingBlocking(ctx context.Context, request *ReplaceBuildingBlockingReq, params ReplaceBuildingBlockingParams) (ReplaceBuildingBlockingRes, error) {
+	res, err := c.sendReplaceBuildingBlocking(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendRemovePlaceBlockingEntries(ctx context.Context, request *RemovePlaceBlockingEntriesReq, params RemovePlaceBlockingEntriesParams) (res RemovePlaceBlockingEntriesRes, err error) {
+func (c *Client) sendReplaceBuildingBlocking(ctx context.Context, request *ReplaceBuildingBlockingReq, params ReplaceBuildingBlockingParams) (res ReplaceBuildingBlockingRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removePlaceBlockingEntries"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/places/{placeId}/blocking/entries"),
+		otelogen.OperationID("replaceBuildingBlocking"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/buildings/{buildingId}/blocking"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -12141,7 +14627,7 @@ func (c *Client) sendRemovePlaceBlockingEntries(ctx context.Context, request *Re
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, RemovePlaceBlockingEntriesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ReplaceBuildingBlockingOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -12159,16 +14645,16 @@ func (c *Client) sendRemovePlaceBlockingEntries(ctx context.Context, request *Re
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/places/"
+	pathParts[0] = "/buildings/"
 	{
-		// Encode "placeId" parameter.
+		// Encode "buildingId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "placeId",
+			Param:   "buildingId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.PlaceId))
+			return e.EncodeValue(conv.UUIDToString(params.BuildingId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -12178,15 +14664,15 @@ func (c *Client) sendRemovePlaceBlockingEntries(ctx context.Context, request *Re
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/blocking/entries"
+	pathParts[2] = "/blocking"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeRemovePlaceBlockingEntriesRequest(request, r); err != nil {
+	if err := encodeReplaceBuildingBlockingRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -12195,7 +14681,7 @@ func (c *Client) sendRemovePlaceBlockingEntries(ctx context.Context, request *Re
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, RemovePlaceBlockingEntriesOperation, r); {
+			switch err := c.securityBearerAuth(ctx, ReplaceBuildingBlockingOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12206,7 +14692,7 @@ func (c *Client) sendRemovePlaceBlockingEntries(ctx context.Context, request *Re
 		}
 		{
 			stage = "Security:ApiKeyAuth"
-			switch err := c.securityApiKeyAuth(ctx, RemovePlaceBlockingEntriesOperation, r); {
+			switch err := c.securityApiKeyAuth(ctx, ReplaceBuildingBlockingOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12243,7 +14729,7 @@ func (c *Client) sendRemovePlaceBlockingEntries(ctx context.Context, request *Re
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeRemovePlaceBlockingEntriesResponse(resp)
+	result, err := decodeReplaceBuildingBlockingResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -12251,21 +14737,21 @@ func (c *Client) sendRemovePlaceBlockingEntries(ctx context.Context, request *Re
 	return result, nil
 }
 
-// RemovePlaceEquipment invokes removePlaceEquipment operation.
+// ReplacePlaceBlocking invokes replacePlaceBlocking operation.
 //
-// Remove equipment from place.
+// Replace all place blocking periods.
 //
-// DELETE /places/{placeId}/equipment
-func (c *Client) RemovePlaceEquipment(ctx context.Context, request *RemovePlaceEquipmentReq, params RemovePlaceEquipmentParams) (RemovePlaceEquipmentRes, error) {
-	res, err := c.sendRemovePlaceEquipment(ctx, request, params)
+// PUT /places/{placeId}/blocking
+func (c *Client) ReplacePlaceBlocking(ctx context.Context, request *ReplacePlaceBlockingReq, params ReplacePlaceBlockingParams) (ReplacePlaceBlockingRes, error) {
+	res, err := c.sendReplacePlaceBlocking(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendRemovePlaceEquipment(ctx context.Context, request *RemovePlaceEquipmentReq, params RemovePlaceEquipmentParams) (res RemovePlaceEquipmentRes, err error) {
+func (c *Client) sendReplacePlaceBlocking(ctx context.Context, request *ReplacePlaceBlockingReq, params ReplacePlaceBlockingParams) (res ReplacePlaceBlockingRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removePlaceEquipment"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/places/{placeId}/equipment"),
+		otelogen.OperationID("replacePlaceBlocking"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/places/{placeId}/blocking"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -12281,7 +14767,7 @@ func (c *Client) sendRemovePlaceEquipment(ctx context.Context, request *RemovePl
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, RemovePlaceEquipmentOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ReplacePlaceBlockingOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -12318,15 +14804,15 @@ func (c *Client) sendRemovePlaceEquipment(ctx context.Context, request *RemovePl
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/equipment"
+	pathParts[2] = "/blocking"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeRemovePlaceEquipmentRequest(request, r); err != nil {
+	if err := encodeReplacePlaceBlockingRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -12335,7 +14821,7 @@ func (c *Client) sendRemovePlaceEquipment(ctx context.Context, request *RemovePl
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, RemovePlaceEquipmentOperation, r); {
+			switch err := c.securityBearerAuth(ctx, ReplacePlaceBlockingOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12346,7 +14832,7 @@ func (c *Client) sendRemovePlaceEquipment(ctx context.Context, request *RemovePl
 		}
 		{
 			stage = "Security:ApiKeyAuth"
-			switch err := c.securityApiKeyAuth(ctx, RemovePlaceEquipmentOperation, r); {
+			switch err := c.securityApiKeyAuth(ctx, ReplacePlaceBlockingOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12383,7 +14869,7 @@ func (c *Client) sendRemovePlaceEquipment(ctx context.Context, request *RemovePl
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeRemovePlaceEquipmentResponse(resp)
+	result, err := decodeReplacePlaceBlockingResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -12391,21 +14877,21 @@ func (c *Client) sendRemovePlaceEquipment(ctx context.Context, request *RemovePl
 	return result, nil
 }
 
-// RemovePlaceWhitelistUsers invokes removePlaceWhitelistUsers operation.
-//
-// Remove users from whitelist.
+// RevokeApiKey invokes revokeApiKey operation.
 //
-// DELETE /places/{placeId}/whitelist
-func (c *Client) RemovePlaceWhitelistUsers(ctx context.Context, request *RemovePlaceWhitelistUsersReq, params RemovePlaceWhitelistUsersParams) (RemovePlaceWhitelistUsersRes, error) {
-	res, err := c.sendRemovePlaceWhitelistUsers(ctx, request, params)
+// Revoke API key.
+//
+// DELETE /apiKeys/{keyId}
+func (c *Client) RevokeApiKey(ctx context.Context, params RevokeApiKeyParams) (RevokeApiKeyRes, error) {
+	res, err := c.sendRevokeApiKey(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendRemovePlaceWhitelistUsers(ctx context.Context, request *RemovePlaceWhitelistUsersReq, params RemovePlaceWhitelistUsersParams) (res RemovePlaceWhitelistUsersRes, err error) {
+func (c *Client) sendRevokeApiKey(ctx context.Context, params RevokeApiKeyParams) (res RevokeApiKeyRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removePlaceWhitelistUsers"),
+		otelogen.OperationID("revokeApiKey"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/places/{placeId}/whitelist"),
+		semconv.URLTemplateKey.String("/apiKeys/{keyId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -12421,7 +14907,7 @@ func (c *Client) sendRemovePlaceWhitelistUsers(ctx context.Context, request *Rem
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, RemovePlaceWhitelistUsersOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, RevokeApiKeyOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -12438,17 +14924,17 @@ func (c *Client) sendRemovePlaceWhitelistUsers(ctx context.Context, request *Rem
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/places/"
+	var pathParts [2]string
+	pathParts[0] = "/apiKeys/"
 	{
-		// Encode "placeId" parameter.
+		// Encode "keyId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "placeId",
+			Param:   "keyId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.PlaceId))
+			return e.EncodeValue(conv.UUIDToString(params.KeyId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -12458,7 +14944,6 @@ func (c *Client) sendRemovePlaceWhitelistUsers(ctx context.Context, request *Rem
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/whitelist"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -12466,16 +14951,13 @@ func (c *Client) sendRemovePlaceWhitelistUsers(ctx context.Context, request *Rem
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeRemovePlaceWhitelistUsersRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, RemovePlaceWhitelistUsersOperation, r); {
+			switch err := c.securityBearerAuth(ctx, RevokeApiKeyOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12486,7 +14968,7 @@ func (c *Client) sendRemovePlaceWhitelistUsers(ctx context.Context, request *Rem
 		}
 		{
 			stage = "Security:ApiKeyAuth"
-			switch err := c.securityApiKeyAuth(ctx, RemovePlaceWhitelistUsersOperation, r); {
+			switch err := c.securityApiKeyAuth(ctx, RevokeApiKeyOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12523,7 +15005,7 @@ func (c *Client) sendRemovePlaceWhitelistUsers(ctx context.Context, request *Rem
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeRemovePlaceWhitelistUsersResponse(resp)
+	result, err := decodeRevokeApiKeyResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -12531,21 +15013,21 @@ func (c *Client) sendRemovePlaceWhitelistUsers(ctx context.Context, request *Rem
 	return result, nil
 }
 
-// RemoveUserGroups invokes removeUserGroups operation.
+// RevokeCurrentUserCalendarToken invokes revokeCurrentUserCalendarToken operation.
 //
-// Remove groups from user.
+// Revoke personal calendar feed token.
 //
-// DELETE /users/{userId}/groups
-func (c *Client) RemoveUserGroups(ctx context.Context, request *RemoveUserGroupsReq, params RemoveUserGroupsParams) (RemoveUserGroupsRes, error) {
-	res, err := c.sendRemoveUserGroups(ctx, request, params)
+// DELETE /users/me/calendar-token
+func (c *Client) RevokeCurrentUserCalendarToken(ctx context.Context) (RevokeCurrentUserCalendarTokenRes, error) {
+	res, err := c.sendRevokeCurrentUserCalendarToken(ctx)
 	return res, err
 }
 
-func (c *Client) sendRemoveUserGroups(ctx context.Context, request *RemoveUserGroupsReq, params RemoveUserGroupsParams) (res RemoveUserGroupsRes, err error) {
+func (c *Client) sendRevokeCurrentUserCalendarToken(ctx context.Context) (res RevokeCurrentUserCalendarTokenRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removeUserGroups"),
+		otelogen.OperationID("revokeCurrentUserCalendarToken"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/users/{userId}/groups"),
+		semconv.URLTemplateKey.String("/users/me/calendar-token"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -12561,7 +15043,7 @@ func (c *Client) sendRemoveUserGroups(ctx context.Context, request *RemoveUserGr
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, RemoveUserGroupsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, RevokeCurrentUserCalendarTokenOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -12578,27 +15060,8 @@ func (c *Client) sendRemoveUserGroups(ctx context.Context, request *RemoveUserGr
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/users/"
-	{
-		// Encode "userId" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "userId",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.UserId))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/groups"
+	var pathParts [1]string
+	pathParts[0] = "/users/me/calendar-token"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -12606,16 +15069,13 @@ func (c *Client) sendRemoveUserGroups(ctx context.Context, request *RemoveUserGr
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeRemoveUserGroupsRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, RemoveUserGroupsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, RevokeCurrentUserCalendarTokenOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12626,7 +15086,7 @@ func (c *Client) sendRemoveUserGroups(ctx context.Context, request *RemoveUserGr
 		}
 		{
 			stage = "Security:ApiKeyAuth"
-			switch err := c.securityApiKeyAuth(ctx, RemoveUserGroupsOperation, r); {
+			switch err := c.securityApiKeyAuth(ctx, RevokeCurrentUserCalendarTokenOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12663,7 +15123,7 @@ func (c *Client) sendRemoveUserGroups(ctx context.Context, request *RemoveUserGr
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeRemoveUserGroupsResponse(resp)
+	result, err := decodeRevokeCurrentUserCalendarTokenResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -12671,21 +15131,22 @@ func (c *Client) sendRemoveUserGroups(ctx context.Context, request *RemoveUserGr
 	return result, nil
 }
 
-// ReplaceAreaBlocking invokes replaceAreaBlocking operation.
+// RotateCurrentUserCalendarToken invokes rotateCurrentUserCalendarToken operation.
 //
-// Replace all area blocking periods.
+// Creates a new secret token for the current user's personal calendar feed.
+// Any previous token is revoked, so subscriptions using the old feed URL stop working.
 //
-// PUT /areas/{areaId}/blocking
-func (c *Client) ReplaceAreaBlocking(ctx context.Context, request *ReplaceAreaBlockingReq, params ReplaceAreaBlockingParams) (ReplaceAreaBlockingRes, error) {
-	res, err := c.sendReplaceAreaBlocking(ctx, request, params)
+// POST /users/me/calendar-token
+func (c *Client) RotateCurrentUserCalendarToken(ctx context.Context) (RotateCurrentUserCalendarTokenRes, error) {
+	res, err := c.sendRotateCurrentUserCalendarToken(ctx)
 	return res, err
 }
 
-func (c *Client) sendReplaceAreaBlocking(ctx context.Context, request *ReplaceAreaBlockingReq, params ReplaceAreaBlockingParams) (res ReplaceAreaBlockingRes, err error) {
+func (c *Client) sendRotateCurrentUserCalendarToken(ctx context.Context) (res RotateCurrentUserCalendarTokenRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("replaceAreaBlocking"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.URLTemplateKey.String("/areas/{areaId}/blocking"),
+		otelogen.OperationID("rotateCurrentUserCalendarToken"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/users/me/calendar-token"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -12701,7 +15162,7 @@ func (c *Client) sendReplaceAreaBlocking(ctx context.Context, request *ReplaceAr
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ReplaceAreaBlockingOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, RotateCurrentUserCalendarTokenOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -12718,44 +15179,22 @@ func (c *Client) sendReplaceAreaBlocking(ctx context.Context, request *ReplaceAr
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/areas/"
-	{
-		// Encode "areaId" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "areaId",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.AreaId))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/blocking"
+	var pathParts [1]string
+	pathParts[0] = "/users/me/calendar-token"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeReplaceAreaBlockingRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ReplaceAreaBlockingOperation, r); {
+			switch err := c.securityBearerAuth(ctx, RotateCurrentUserCalendarTokenOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12766,7 +15205,7 @@ func (c *Client) sendReplaceAreaBlocking(ctx context.Context, request *ReplaceAr
 		}
 		{
 			stage = "Security:ApiKeyAuth"
-			switch err := c.securityApiKeyAuth(ctx, ReplaceAreaBlockingOperation, r); {
+			switch err := c.securityApiKeyAuth(ctx, RotateCurrentUserCalendarTokenOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12803,7 +15242,7 @@ func (c *Client) sendReplaceAreaBlocking(ctx context.Context, request *ReplaceAr
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeReplaceAreaBlockingResponse(resp)
+	result, err := decodeRotateCurrentUserCalendarTokenResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -12811,21 +15250,22 @@ func (c *Client) sendReplaceAreaBlocking(ctx context.Context, request *ReplaceAr
 	return result, nil
 }
 
-// ReplaceBuildingBlocking invokes replaceBuildingBlocking operation.
+// RotatePlaceQrKey invokes rotatePlaceQrKey operation.
 //
-// Replace all building blocking periods.
+// Increments the key version of the place. All QR codes printed before are rejected at
+// check-in with QR_CODE_REVOKED and have to be replaced by newly generated ones.
 //
-// PUT /buildings/{buildingId}/blocking
-func (c *Client) ReplaceBuildingBlocking(ctx context.Context, request *ReplaceBuildingBlockingReq, params ReplaceBuildingBlockingParams) (ReplaceBuildingBlockingRes, error) {
-	res, err := c.sendReplaceBuildingBlocking(ctx, request, params)
+// POST /places/{placeId}/qrCode/key
+func (c *Client) RotatePlaceQrKey(ctx context.Context, params RotatePlaceQrKeyParams) (RotatePlaceQrKeyRes, error) {
+	res, err := c.sendRotatePlaceQrKey(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendReplaceBuildingBlocking(ctx context.Context, request *ReplaceBuildingBlockingReq, params ReplaceBuildingBlockingParams) (res ReplaceBuildingBlockingRes, err error) {
+func (c *Client) sendRotatePlaceQrKey(ctx context.Context, params RotatePlaceQrKeyParams) (res RotatePlaceQrKeyRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("replaceBuildingBlocking"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.URLTemplateKey.String("/buildings/{buildingId}/blocking"),
+		otelogen.OperationID("rotatePlaceQrKey"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/places/{placeId}/qrCode/key"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -12841,7 +15281,7 @@ func (c *Client) sendReplaceBuildingBlocking(ctx context.Context, request *Repla
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ReplaceBuildingBlockingOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, RotatePlaceQrKeyOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -12859,16 +15299,16 @@ func (c *Client) sendReplaceBuildingBlocking(ctx context.Context, request *Repla
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/buildings/"
+	pathParts[0] = "/places/"
 	{
-		// Encode "buildingId" parameter.
+		// Encode "placeId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "buildingId",
+			Param:   "placeId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.BuildingId))
+			return e.EncodeValue(conv.UUIDToString(params.PlaceId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -12878,24 +15318,21 @@ func (c *Client) sendReplaceBuildingBlocking(ctx context.Context, request *Repla
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/blocking"
+	pathParts[2] = "/qrCode/key"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeReplaceBuildingBlockingRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ReplaceBuildingBlockingOperation, r); {
+			switch err := c.securityBearerAuth(ctx, RotatePlaceQrKeyOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12906,7 +15343,7 @@ func (c *Client) sendReplaceBuildingBlocking(ctx context.Context, request *Repla
 		}
 		{
 			stage = "Security:ApiKeyAuth"
-			switch err := c.securityApiKeyAuth(ctx, ReplaceBuildingBlockingOperation, r); {
+			switch err := c.securityApiKeyAuth(ctx, RotatePlaceQrKeyOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -12943,7 +15380,7 @@ func (c *Client) sendReplaceBuildingBlocking(ctx context.Context, request *Repla
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeReplaceBuildingBlockingResponse(resp)
+	result, err := decodeRotatePlaceQrKeyResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -12951,21 +15388,21 @@ func (c *Client) sendReplaceBuildingBlocking(ctx context.Context, request *Repla
 	return result, nil
 }
 
-// ReplacePlaceBlocking invokes replacePlaceBlocking operation.
+// SetDefaultGroupAssignment invokes setDefaultGroupAssignment operation.
 //
-// Replace all place blocking periods.
+// Set default groups for new users.
 //
-// PUT /places/{placeId}/blocking
-func (c *Client) ReplacePlaceBlocking(ctx context.Context, request *ReplacePlaceBlockingReq, params ReplacePlaceBlockingParams) (ReplacePlaceBlockingRes, error) {
-	res, err := c.sendReplacePlaceBlocking(ctx, request, params)
+// PUT /groups/defaultAssignment
+func (c *Client) SetDefaultGroupAssignment(ctx context.Context, request *SetDefaultGroupAssignmentReq) (SetDefaultGroupAssignmentRes, error) {
+	res, err := c.sendSetDefaultGroupAssignment(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendReplacePlaceBlocking(ctx context.Context, request *ReplacePlaceBlockingReq, params ReplacePlaceBlockingParams) (res ReplacePlaceBlockingRes, err error) {
+func (c *Client) sendSetDefaultGroupAssignment(ctx context.Context, request *SetDefaultGroupAssignmentReq) (res SetDefaultGroupAssignmentRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("replacePlaceBlocking"),
+		otelogen.OperationID("setDefaultGroupAssignment"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.URLTemplateKey.String("/places/{placeId}/blocking"),
+		semconv.URLTemplateKey.String("/groups/defaultAssignment"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -12981,7 +15418,7 @@ func (c *Client) sendReplacePlaceBlocking(ctx context.Context, request *ReplaceP
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ReplacePlaceBlockingOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, SetDefaultGroupAssignmentOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -12998,27 +15435,8 @@ func (c *Client) sendReplacePlaceBlocking(ctx context.Context, request *ReplaceP
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/places/"
-	{
-		// Encode "placeId" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "placeId",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.PlaceId))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/blocking"
+	var pathParts [1]string
+	pathParts[0] = "/groups/defaultAssignment"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -13026,7 +15444,7 @@ func (c *Client) sendReplacePlaceBlocking(ctx context.Context, request *ReplaceP
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeReplacePlaceBlockingRequest(request, r); err != nil {
+	if err := encodeSetDefaultGroupAssignmentRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -13035,7 +15453,7 @@ func (c *Client) sendReplacePlaceBlocking(ctx context.Context, request *ReplaceP
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ReplacePlaceBlockingOperation, r); {
+			switch err := c.securityBearerAuth(ctx, SetDefaultGroupAssignmentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13046,7 +15464,7 @@ func (c *Client) sendReplacePlaceBlocking(ctx context.Context, request *ReplaceP
 		}
 		{
 			stage = "Security:ApiKeyAuth"
-			switch err := c.securityApiKeyAuth(ctx, ReplacePlaceBlockingOperation, r); {
+			switch err := c.securityApiKeyAuth(ctx, SetDefaultGroupAssignmentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13083,7 +15501,7 @@ func (c *Client) sendReplacePlaceBlocking(ctx context.Context, request *ReplaceP
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeReplacePlaceBlockingResponse(resp)
+	result, err := decodeSetDefaultGroupAssignmentResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -13091,21 +15509,21 @@ func (c *Client) sendReplacePlaceBlocking(ctx context.Context, request *ReplaceP
 	return result, nil
 }
 
-// RevokeApiKey invokes revokeApiKey operation.
+// UpdateArea invokes updateArea operation.
 //
-// Revoke API key.
+// Update area.
 //
-// DELETE /apiKeys/{keyId}
-func (c *Client) RevokeApiKey(ctx context.Context, params RevokeApiKeyParams) (RevokeApiKeyRes, error) {
-	res, err := c.sendRevokeApiKey(ctx, params)
+// PUT /areas/{areaId}
+func (c *Client) UpdateArea(ctx context.Context, request *UpdateAreaRequest, params UpdateAreaParams) (UpdateAreaRes, error) {
+	res, err := c.sendUpdateArea(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendRevokeApiKey(ctx context.Context, params RevokeApiKeyParams) (res RevokeApiKeyRes, err error) {
+func (c *Client) sendUpdateArea(ctx context.Context, request *UpdateAreaRequest, params UpdateAreaParams) (res UpdateAreaRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("revokeApiKey"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/apiKeys/{keyId}"),
+		otelogen.OperationID("updateArea"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/areas/{areaId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -13121,7 +15539,7 @@ func (c *Client) sendRevokeApiKey(ctx context.Context, params RevokeApiKeyParams
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, RevokeApiKeyOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateAreaOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -13139,16 +15557,16 @@ func (c *Client) sendRevokeApiKey(ctx context.Context, params RevokeApiKeyParams
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/apiKeys/"
+	pathParts[0] = "/areas/"
 	{
-		// Encode "keyId" parameter.
+		// Encode "areaId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "keyId",
+			Param:   "areaId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.KeyId))
+			return e.EncodeValue(conv.UUIDToString(params.AreaId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -13161,17 +15579,20 @@ func (c *Client) sendRevokeApiKey(ctx context.Context, params RevokeApiKeyParams
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUpdateAreaRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, RevokeApiKeyOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateAreaOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13182,7 +15603,7 @@ func (c *Client) sendRevokeApiKey(ctx context.Context, params RevokeApiKeyParams
 		}
 		{
 			stage = "Security:ApiKeyAuth"
-			switch err := c.securityApiKeyAuth(ctx, RevokeApiKeyOperation, r); {
+			switch err := c.securityApiKeyAuth(ctx, UpdateAreaOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13219,7 +15640,7 @@ func (c *Client) sendRevokeApiKey(ctx context.Context, params RevokeApiKeyParams
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeRevokeApiKeyResponse(resp)
+	result, err := decodeUpdateAreaResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -13227,21 +15648,21 @@ func (c *Client) sendRevokeApiKey(ctx context.Context, params RevokeApiKeyParams
 	return result, nil
 }
 
-// SetDefaultGroupAssignment invokes setDefaultGroupAssignment operation.
+// UpdateAreaConstraints invokes updateAreaConstraints operation.
 //
-// Set default groups for new users.
+// Update area constraints.
 //
-// PUT /groups/defaultAssignment
-func (c *Client) SetDefaultGroupAssignment(ctx context.Context, request *SetDefaultGroupAssignmentReq) (SetDefaultGroupAssignmentRes, error) {
-	res, err := c.sendSetDefaultGroupAssignment(ctx, request)
+// PUT /areas/{areaId}/constraints
+func (c *Client) UpdateAreaConstraints(ctx context.Context, request *PlaceConstraints, params UpdateAreaConstraintsParams) (UpdateAreaConstraintsRes, error) {
+	res, err := c.sendUpdateAreaConstraints(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendSetDefaultGroupAssignment(ctx context.Context, request *SetDefaultGroupAssignmentReq) (res SetDefaultGroupAssignmentRes, err error) {
+func (c *Client) sendUpdateAreaConstraints(ctx context.Context, request *PlaceConstraints, params UpdateAreaConstraintsParams) (res UpdateAreaConstraintsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("setDefaultGroupAssignment"),
+		otelogen.OperationID("updateAreaConstraints"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.URLTemplateKey.String("/groups/defaultAssignment"),
+		semconv.URLTemplateKey.String("/areas/{areaId}/constraints"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -13257,7 +15678,7 @@ func (c *Client) sendSetDefaultGroupAssignment(ctx context.Context, request *Set
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SetDefaultGroupAssignmentOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateAreaConstraintsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -13269,13 +15690,32 @@ func (c *Client) sendSetDefaultGroupAssignment(ctx context.Context, request *Set
 			span.SetStatus(codes.Error, stage)
 			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		span.End()
-	}()
-
-	stage = "BuildURL"
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/groups/defaultAssignment"
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/areas/"
+	{
+		// Encode "areaId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "areaId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.AreaId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/constraints"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -13283,7 +15723,7 @@ func (c *Client) sendSetDefaultGroupAssignment(ctx context.Context, request *Set
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeSetDefaultGroupAssignmentRequest(request, r); err != nil {
+	if err := encodeUpdateAreaConstraintsRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -13292,7 +15732,7 @@ func (c *Client) sendSetDefaultGroupAssignment(ctx context.Context, request *Set
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, SetDefaultGroupAssignmentOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateAreaConstraintsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13303,7 +15743,7 @@ func (c *Client) sendSetDefaultGroupAssignment(ctx context.Context, request *Set
 		}
 		{
 			stage = "Security:ApiKeyAuth"
-			switch err := c.securityApiKeyAuth(ctx, SetDefaultGroupAssignmentOperation, r); {
+			switch err := c.securityApiKeyAuth(ctx, UpdateAreaConstraintsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13340,7 +15780,7 @@ func (c *Client) sendSetDefaultGroupAssignment(ctx context.Context, request *Set
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeSetDefaultGroupAssignmentResponse(resp)
+	result, err := decodeUpdateAreaConstraintsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -13348,21 +15788,21 @@ func (c *Client) sendSetDefaultGroupAssignment(ctx context.Context, request *Set
 	return result, nil
 }
 
-// UpdateArea invokes updateArea operation.
+// UpdateAreaRoomPlan invokes updateAreaRoomPlan operation.
 //
-// Update area.
+// Update room plan.
 //
-// PUT /areas/{areaId}
-func (c *Client) UpdateArea(ctx context.Context, request *UpdateAreaRequest, params UpdateAreaParams) (UpdateAreaRes, error) {
-	res, err := c.sendUpdateArea(ctx, request, params)
+// PUT /areas/{areaId}/roomPlan
+func (c *Client) UpdateAreaRoomPlan(ctx context.Context, request *UpdateAreaRoomPlanReq, params UpdateAreaRoomPlanParams) (UpdateAreaRoomPlanRes, error) {
+	res, err := c.sendUpdateAreaRoomPlan(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendUpdateArea(ctx context.Context, request *UpdateAreaRequest, params UpdateAreaParams) (res UpdateAreaRes, err error) {
+func (c *Client) sendUpdateAreaRoomPlan(ctx context.Context, request *UpdateAreaRoomPlanReq, params UpdateAreaRoomPlanParams) (res UpdateAreaRoomPlanRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateArea"),
+		otelogen.OperationID("updateAreaRoomPlan"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.URLTemplateKey.String("/areas/{areaId}"),
+		semconv.URLTemplateKey.String("/areas/{areaId}/roomPlan"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -13378,7 +15818,7 @@ func (c *Client) sendUpdateArea(ctx context.Context, request *UpdateAreaRequest,
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UpdateAreaOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateAreaRoomPlanOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -13395,7 +15835,7 @@ func (c *Client) sendUpdateArea(ctx context.Context, request *UpdateAreaRequest,
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
+	var pathParts [3]string
 	pathParts[0] = "/areas/"
 	{
 		// Encode "areaId" parameter.
@@ -13415,6 +15855,7 @@ func (c *Client) sendUpdateArea(ctx context.Context, request *UpdateAreaRequest,
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/roomPlan"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -13422,7 +15863,7 @@ func (c *Client) sendUpdateArea(ctx context.Context, request *UpdateAreaRequest,
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUpdateAreaRequest(request, r); err != nil {
+	if err := encodeUpdateAreaRoomPlanRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -13431,7 +15872,7 @@ func (c *Client) sendUpdateArea(ctx context.Context, request *UpdateAreaRequest,
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UpdateAreaOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateAreaRoomPlanOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13442,7 +15883,7 @@ func (c *Client) sendUpdateArea(ctx context.Context, request *UpdateAreaRequest,
 		}
 		{
 			stage = "Security:ApiKeyAuth"
-			switch err := c.securityApiKeyAuth(ctx, UpdateAreaOperation, r); {
+			switch err := c.securityApiKeyAuth(ctx, UpdateAreaRoomPlanOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13479,7 +15920,7 @@ func (c *Client) sendUpdateArea(ctx context.Context, request *UpdateAreaRequest,
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUpdateAreaResponse(resp)
+	result, err := decodeUpdateAreaRoomPlanResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -13487,21 +15928,21 @@ func (c *Client) sendUpdateArea(ctx context.Context, request *UpdateAreaRequest,
 	return result, nil
 }
 
-// UpdateAreaRoomPlan invokes updateAreaRoomPlan operation.
+// UpdateBuilding invokes updateBuilding operation.
 //
-// Update room plan.
+// Update building.
 //
-// PUT /areas/{areaId}/roomPlan
-func (c *Client) UpdateAreaRoomPlan(ctx context.Context, request *UpdateAreaRoomPlanReq, params UpdateAreaRoomPlanParams) (UpdateAreaRoomPlanRes, error) {
-	res, err := c.sendUpdateAreaRoomPlan(ctx, request, params)
+// PUT /buildings/{buildingId}
+func (c *Client) UpdateBuilding(ctx context.Context, request *UpdateBuildingRequest, params UpdateBuildingParams) (UpdateBuildingRes, error) {
+	res, err := c.sendUpdateBuilding(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendUpdateAreaRoomPlan(ctx context.Context, request *UpdateAreaRoomPlanReq, params UpdateAreaRoomPlanParams) (res UpdateAreaRoomPlanRes, err error) {
+func (c *Client) sendUpdateBuilding(ctx context.Context, request *UpdateBuildingRequest, params UpdateBuildingParams) (res UpdateBuildingRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateAreaRoomPlan"),
+		otelogen.OperationID("updateBuilding"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.URLTemplateKey.String("/areas/{areaId}/roomPlan"),
+		semconv.URLTemplateKey.String("/buildings/{buildingId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -13517,7 +15958,7 @@ func (c *Client) sendUpdateAreaRoomPlan(ctx context.Context, request *UpdateArea
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UpdateAreaRoomPlanOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateBuildingOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -13534,17 +15975,17 @@ func (c *Client) sendUpdateAreaRoomPlan(ctx context.Context, request *UpdateArea
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/areas/"
+	var pathParts [2]string
+	pathParts[0] = "/buildings/"
 	{
-		// Encode "areaId" parameter.
+		// Encode "buildingId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "areaId",
+			Param:   "buildingId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.AreaId))
+			return e.EncodeValue(conv.UUIDToString(params.BuildingId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -13554,7 +15995,6 @@ func (c *Client) sendUpdateAreaRoomPlan(ctx context.Context, request *UpdateArea
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/roomPlan"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -13562,7 +16002,7 @@ func (c *Client) sendUpdateAreaRoomPlan(ctx context.Context, request *UpdateArea
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUpdateAreaRoomPlanRequest(request, r); err != nil {
+	if err := encodeUpdateBuildingRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -13571,7 +16011,7 @@ func (c *Client) sendUpdateAreaRoomPlan(ctx context.Context, request *UpdateArea
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UpdateAreaRoomPlanOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateBuildingOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13582,7 +16022,7 @@ func (c *Client) sendUpdateAreaRoomPlan(ctx context.Context, request *UpdateArea
 		}
 		{
 			stage = "Security:ApiKeyAuth"
-			switch err := c.securityApiKeyAuth(ctx, UpdateAreaRoomPlanOperation, r); {
+			switch err := c.securityApiKeyAuth(ctx, UpdateBuildingOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13619,7 +16059,7 @@ func (c *Client) sendUpdateAreaRoomPlan(ctx context.Context, request *UpdateArea
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUpdateAreaRoomPlanResponse(resp)
+	result, err := decodeUpdateBuildingResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -13627,21 +16067,21 @@ func (c *Client) sendUpdateAreaRoomPlan(ctx context.Context, request *UpdateArea
 	return result, nil
 }
 
-// UpdateBuilding invokes updateBuilding operation.
+// UpdateBuildingConstraints invokes updateBuildingConstraints operation.
 //
-// Update building.
+// Update building constraints.
 //
-// PUT /buildings/{buildingId}
-func (c *Client) UpdateBuilding(ctx context.Context, request *UpdateBuildingRequest, params UpdateBuildingParams) (UpdateBuildingRes, error) {
-	res, err := c.sendUpdateBuilding(ctx, request, params)
+// PUT /buildings/{buildingId}/constraints
+func (c *Client) UpdateBuildingConstraints(ctx context.Context, request *PlaceConstraints, params UpdateBuildingConstraintsParams) (UpdateBuildingConstraintsRes, error) {
+	res, err := c.sendUpdateBuildingConstraints(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendUpdateBuilding(ctx context.Context, request *UpdateBuildingRequest, params UpdateBuildingParams) (res UpdateBuildingRes, err error) {
+func (c *Client) sendUpdateBuildingConstraints(ctx context.Context, request *PlaceConstraints, params UpdateBuildingConstraintsParams) (res UpdateBuildingConstraintsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateBuilding"),
+		otelogen.OperationID("updateBuildingConstraints"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.URLTemplateKey.String("/buildings/{buildingId}"),
+		semconv.URLTemplateKey.String("/buildings/{buildingId}/constraints"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -13657,7 +16097,7 @@ func (c *Client) sendUpdateBuilding(ctx context.Context, request *UpdateBuilding
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UpdateBuildingOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateBuildingConstraintsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -13674,7 +16114,7 @@ func (c *Client) sendUpdateBuilding(ctx context.Context, request *UpdateBuilding
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
+	var pathParts [3]string
 	pathParts[0] = "/buildings/"
 	{
 		// Encode "buildingId" parameter.
@@ -13694,6 +16134,7 @@ func (c *Client) sendUpdateBuilding(ctx context.Context, request *UpdateBuilding
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/constraints"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -13701,7 +16142,7 @@ func (c *Client) sendUpdateBuilding(ctx context.Context, request *UpdateBuilding
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUpdateBuildingRequest(request, r); err != nil {
+	if err := encodeUpdateBuildingConstraintsRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -13710,7 +16151,7 @@ func (c *Client) sendUpdateBuilding(ctx context.Context, request *UpdateBuilding
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UpdateBuildingOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateBuildingConstraintsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13721,7 +16162,7 @@ func (c *Client) sendUpdateBuilding(ctx context.Context, request *UpdateBuilding
 		}
 		{
 			stage = "Security:ApiKeyAuth"
-			switch err := c.securityApiKeyAuth(ctx, UpdateBuildingOperation, r); {
+			switch err := c.securityApiKeyAuth(ctx, UpdateBuildingConstraintsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -13758,7 +16199,7 @@ func (c *Client) sendUpdateBuilding(ctx context.Context, request *UpdateBuilding
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUpdateBuildingResponse(resp)
+	result, err := decodeUpdateBuildingConstraintsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -14165,6 +16606,164 @@ func (c *Client) sendUpdateGroup(ctx context.Context, request *UpdateGroupReques
 	return result, nil
 }
 
+// UpdateGroupConstraintProfile invokes updateGroupConstraintProfile operation.
+//
+// Update constraint profile.
+//
+// PUT /groups/{groupId}/constraintProfiles/{profileId}
+func (c *Client) UpdateGroupConstraintProfile(ctx context.Context, request *ConstraintProfileRequest, params UpdateGroupConstraintProfileParams) (UpdateGroupConstraintProfileRes, error) {
+	res, err := c.sendUpdateGroupConstraintProfile(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendUpdateGroupConstraintProfile(ctx context.Context, request *ConstraintProfileRequest, params UpdateGroupConstraintProfileParams) (res UpdateGroupConstraintProfileRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("updateGroupConstraintProfile"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/groups/{groupId}/constraintProfiles/{profileId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateGroupConstraintProfileOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/groups/"
+	{
+		// Encode "groupId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "groupId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.GroupId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/constraintProfiles/"
+	{
+		// Encode "profileId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "profileId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ProfileId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeUpdateGroupConstraintProfileRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, UpdateGroupConstraintProfileOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:ApiKeyAuth"
+			switch err := c.securityApiKeyAuth(ctx, UpdateGroupConstraintProfileOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKeyAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeUpdateGroupConstraintProfileResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // UpdatePlace invokes updatePlace operation.
 //
 // Update place.
@@ -14729,6 +17328,10 @@ func (c *Client) sendUpdateQrTemplate(ctx context.Context, request *UpdateQRTemp
 // Extending is only allowed if the adjacent time slot is not blocked or reserved.
 // Shortening is always allowed within minimum duration constraints.
 // Times must align with the place's configured time slot intervals.
+// Holders of manage:reservations for the place may move reservations of other
+// users, also at places that can only be booked manually, and may bypass quotas
+// and blockings. Such changes are recorded in the audit log and the user is
+// notified who made them.
 //
 // PUT /reservations/{reservationId}
 func (c *Client) UpdateReservation(ctx context.Context, request *UpdateReservationRequest, params UpdateReservationParams) (UpdateReservationRes, error) {
@@ -14795,6 +17398,27 @@ func (c *Client) sendUpdateReservation(ctx context.Context, request *UpdateReser
 	}
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "scope" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "scope",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Scope.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
@@ -30,6 +30,26 @@ func (s *CreatePlaceRequest) setDefaults() {
 	}
 }
 
+// setDefaults set default value of fields.
+func (s *CreateReservationRequest) setDefaults() {
+	{
+		val := bool(false)
+		s.BypassQuotas.SetTo(val)
+	}
+	{
+		val := bool(false)
+		s.BypassBlockings.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *CreateReservationRequestRecurrence) setDefaults() {
+	{
+		val := CreateReservationRequestRecurrenceMode("allOrNothing")
+		s.Mode.SetTo(val)
+	}
+}
+
 // setDefaults set default value of fields.
 func (s *NotificationPreferences) setDefaults() {
 	{
@@ -55,9 +75,13 @@ func (s *NotificationPreferences) setDefaults() {
 }
 
 // setDefaults set default value of fields.
-func (s *PlaceConstraints) setDefaults() {
+func (s *UpdateReservationRequest) setDefaults() {
+	{
+		val := bool(false)
+		s.BypassQuotas.SetTo(val)
+	}
 	{
 		val := bool(false)
-		s.WhitelistEnabled.SetTo(val)
+		s.BypassBlockings.SetTo(val)
 	}
 }
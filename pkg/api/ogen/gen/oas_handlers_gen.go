@@ -1982,7 +1982,11 @@ func (s *Server) handleAddUserGroupsRequest(args [1]string, argsEscaped bool, w
 
 // handleCancelReservationRequest handles cancelReservation operation.
 //
-// Cancel reservation.
+// Cancels the reservation. Holders of manage:reservations for the place may
+// cancel reservations of other users; this is recorded in the audit log and
+// the user is notified who cancelled it. Reservations that have been
+// cancelled, including no-shows that have been released, or have already
+// ended are rejected (`RESERVATION_NOT_MODIFIABLE`).
 //
 // DELETE /reservations/{reservationId}
 func (s *Server) handleCancelReservationRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
@@ -2139,6 +2143,10 @@ func (s *Server) handleCancelReservationRequest(args [1]string, argsEscaped bool
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
+				{
+					Name: "scope",
+					In:   "query",
+				}: params.Scope,
 				{
 					Name: "reservationId",
 					In:   "path",
@@ -2185,7 +2193,14 @@ func (s *Server) handleCancelReservationRequest(args [1]string, argsEscaped bool
 
 // handleCheckInReservationRequest handles checkInReservation operation.
 //
-// Check in to reservation.
+// Checks the holder of the reservation in. Checking in is possible from the configured
+// time before the start until the configured time after the start, or the end of the
+// reservation. Every check-in requires the scanned QR code of the place, which must belong
+// to the reserved place and must not have been replaced by rotating the place's QR key,
+// also for places that do not require a check-in. Failures carry one of the error codes
+// QR_CODE_REQUIRED, QR_CODE_INVALID,
+// QR_CODE_REVOKED, QR_CODE_WRONG_PLACE, CHECK_IN_TOO_EARLY, CHECK_IN_TOO_LATE (400),
+// NOT_RESERVATION_OWNER (403), ALREADY_CHECKED_IN and RESERVATION_NOT_CHECKINABLE (409).
 //
 // POST /reservations/{reservationId}/checkIn
 func (s *Server) handleCheckInReservationRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
@@ -3416,6 +3431,224 @@ func (s *Server) handleCreateGroupRequest(args [0]string, argsEscaped bool, w ht
 	}
 }
 
+// handleCreateGroupConstraintProfileRequest handles createGroupConstraintProfile operation.
+//
+// Create constraint profile for group.
+//
+// POST /groups/{groupId}/constraintProfiles
+func (s *Server) handleCreateGroupConstraintProfileRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("createGroupConstraintProfile"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/groups/{groupId}/constraintProfiles"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateGroupConstraintProfileOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: CreateGroupConstraintProfileOperation,
+			ID:   "createGroupConstraintProfile",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateGroupConstraintProfileOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKeyAuth(ctx, CreateGroupConstraintProfileOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeCreateGroupConstraintProfileParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeCreateGroupConstraintProfileRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response CreateGroupConstraintProfileRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    CreateGroupConstraintProfileOperation,
+			OperationSummary: "Create constraint profile for group",
+			OperationID:      "createGroupConstraintProfile",
+			Body:             request,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "groupId",
+					In:   "path",
+				}: params.GroupId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *ConstraintProfileRequest
+			Params   = CreateGroupConstraintProfileParams
+			Response = CreateGroupConstraintProfileRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackCreateGroupConstraintProfileParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.CreateGroupConstraintProfile(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.CreateGroupConstraintProfile(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeCreateGroupConstraintProfileResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
 // handleCreatePlaceRequest handles createPlace operation.
 //
 // Create a new place.
@@ -3827,7 +4060,17 @@ func (s *Server) handleCreateQrTemplateRequest(args [0]string, argsEscaped bool,
 // Creates a single reservation or multiple recurring reservations.
 // For recurring reservations, provide the recurrence field with pattern details.
 // Start/end times must align with the place's configured time slot intervals.
-// Reservation will fail if any time slot is blocked or already reserved.
+// A single reservation fails if its time slot is blocked or already reserved,
+// or if it would exceed a quota of the place's constraints. Constraint profiles
+// of the user's groups override the place's constraints; rejections name the
+// profile a violated limit was taken from.
+// Recurring reservations are created as a series; with mode skipConflicts,
+// conflicting occurrences are skipped and reported instead.
+// Holders of manage:reservations for the place may book on behalf of another
+// user given by userId or userEmail, including places that can only be booked
+// manually, and may bypass the user's quotas and blockings of the place. Such
+// bookings are recorded in the audit log and the user is notified who booked
+// for them.
 //
 // POST /reservations
 func (s *Server) handleCreateReservationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
@@ -5043,22 +5286,22 @@ func (s *Server) handleDeleteGroupRequest(args [1]string, argsEscaped bool, w ht
 	}
 }
 
-// handleDeletePlaceRequest handles deletePlace operation.
+// handleDeleteGroupConstraintProfileRequest handles deleteGroupConstraintProfile operation.
 //
-// Delete place.
+// Delete constraint profile.
 //
-// DELETE /places/{placeId}
-func (s *Server) handleDeletePlaceRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /groups/{groupId}/constraintProfiles/{profileId}
+func (s *Server) handleDeleteGroupConstraintProfileRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deletePlace"),
+		otelogen.OperationID("deleteGroupConstraintProfile"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/places/{placeId}"),
+		semconv.HTTPRouteKey.String("/groups/{groupId}/constraintProfiles/{profileId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeletePlaceOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteGroupConstraintProfileOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5113,15 +5356,15 @@ func (s *Server) handleDeletePlaceRequest(args [1]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeletePlaceOperation,
-			ID:   "deletePlace",
+			Name: DeleteGroupConstraintProfileOperation,
+			ID:   "deleteGroupConstraintProfile",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DeletePlaceOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteGroupConstraintProfileOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5138,7 +5381,7 @@ func (s *Server) handleDeletePlaceRequest(args [1]string, argsEscaped bool, w ht
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, DeletePlaceOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, DeleteGroupConstraintProfileOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5179,7 +5422,7 @@ func (s *Server) handleDeletePlaceRequest(args [1]string, argsEscaped bool, w ht
 			return
 		}
 	}
-	params, err := decodeDeletePlaceParams(args, argsEscaped, r)
+	params, err := decodeDeleteGroupConstraintProfileParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -5192,28 +5435,32 @@ func (s *Server) handleDeletePlaceRequest(args [1]string, argsEscaped bool, w ht
 
 	var rawBody []byte
 
-	var response DeletePlaceRes
+	var response DeleteGroupConstraintProfileRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeletePlaceOperation,
-			OperationSummary: "Delete place",
-			OperationID:      "deletePlace",
+			OperationName:    DeleteGroupConstraintProfileOperation,
+			OperationSummary: "Delete constraint profile",
+			OperationID:      "deleteGroupConstraintProfile",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "placeId",
+					Name: "groupId",
 					In:   "path",
-				}: params.PlaceId,
+				}: params.GroupId,
+				{
+					Name: "profileId",
+					In:   "path",
+				}: params.ProfileId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = DeletePlaceParams
-			Response = DeletePlaceRes
+			Params   = DeleteGroupConstraintProfileParams
+			Response = DeleteGroupConstraintProfileRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5222,14 +5469,14 @@ func (s *Server) handleDeletePlaceRequest(args [1]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			unpackDeletePlaceParams,
+			unpackDeleteGroupConstraintProfileParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeletePlace(ctx, params)
+				response, err = s.h.DeleteGroupConstraintProfile(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeletePlace(ctx, params)
+		response, err = s.h.DeleteGroupConstraintProfile(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -5237,7 +5484,7 @@ func (s *Server) handleDeletePlaceRequest(args [1]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeDeletePlaceResponse(response, w, span); err != nil {
+	if err := encodeDeleteGroupConstraintProfileResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5246,22 +5493,22 @@ func (s *Server) handleDeletePlaceRequest(args [1]string, argsEscaped bool, w ht
 	}
 }
 
-// handleDeleteQrTemplateRequest handles deleteQrTemplate operation.
+// handleDeletePlaceRequest handles deletePlace operation.
 //
-// Delete template.
+// Delete place.
 //
-// DELETE /qrTemplates/{templateId}
-func (s *Server) handleDeleteQrTemplateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /places/{placeId}
+func (s *Server) handleDeletePlaceRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteQrTemplate"),
+		otelogen.OperationID("deletePlace"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/qrTemplates/{templateId}"),
+		semconv.HTTPRouteKey.String("/places/{placeId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteQrTemplateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeletePlaceOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5316,15 +5563,15 @@ func (s *Server) handleDeleteQrTemplateRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteQrTemplateOperation,
-			ID:   "deleteQrTemplate",
+			Name: DeletePlaceOperation,
+			ID:   "deletePlace",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DeleteQrTemplateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DeletePlaceOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5341,7 +5588,7 @@ func (s *Server) handleDeleteQrTemplateRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, DeleteQrTemplateOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, DeletePlaceOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5382,7 +5629,7 @@ func (s *Server) handleDeleteQrTemplateRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeDeleteQrTemplateParams(args, argsEscaped, r)
+	params, err := decodeDeletePlaceParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -5395,13 +5642,216 @@ func (s *Server) handleDeleteQrTemplateRequest(args [1]string, argsEscaped bool,
 
 	var rawBody []byte
 
-	var response DeleteQrTemplateRes
+	var response DeletePlaceRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteQrTemplateOperation,
-			OperationSummary: "Delete template",
-			OperationID:      "deleteQrTemplate",
+			OperationName:    DeletePlaceOperation,
+			OperationSummary: "Delete place",
+			OperationID:      "deletePlace",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "placeId",
+					In:   "path",
+				}: params.PlaceId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = DeletePlaceParams
+			Response = DeletePlaceRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackDeletePlaceParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.DeletePlace(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.DeletePlace(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeDeletePlaceResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleDeleteQrTemplateRequest handles deleteQrTemplate operation.
+//
+// Delete template.
+//
+// DELETE /qrTemplates/{templateId}
+func (s *Server) handleDeleteQrTemplateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deleteQrTemplate"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/qrTemplates/{templateId}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteQrTemplateOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: DeleteQrTemplateOperation,
+			ID:   "deleteQrTemplate",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteQrTemplateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKeyAuth(ctx, DeleteQrTemplateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeDeleteQrTemplateParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response DeleteQrTemplateRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    DeleteQrTemplateOperation,
+			OperationSummary: "Delete template",
+			OperationID:      "deleteQrTemplate",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -5855,22 +6305,24 @@ func (s *Server) handleEnableUserRequest(args [1]string, argsEscaped bool, w htt
 	}
 }
 
-// handleExportReservationsRequest handles exportReservations operation.
+// handleExportAreaQrCodesRequest handles exportAreaQrCodes operation.
 //
-// Export reservations as CSV.
+// Renders the labels of all bookable, enabled places in the area, sorted by place name,
+// either as PDF print sheets with as many labels per sheet as fit on a grid, or as ZIP
+// archive of PNG images. At most 2000 places are exported at once.
 //
-// GET /reservations/export
-func (s *Server) handleExportReservationsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /areas/{areaId}/qrCodes
+func (s *Server) handleExportAreaQrCodesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("exportReservations"),
+		otelogen.OperationID("exportAreaQrCodes"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/reservations/export"),
+		semconv.HTTPRouteKey.String("/areas/{areaId}/qrCodes"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ExportReservationsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ExportAreaQrCodesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5925,15 +6377,15 @@ func (s *Server) handleExportReservationsRequest(args [0]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ExportReservationsOperation,
-			ID:   "exportReservations",
+			Name: ExportAreaQrCodesOperation,
+			ID:   "exportAreaQrCodes",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ExportReservationsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, ExportAreaQrCodesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5950,7 +6402,7 @@ func (s *Server) handleExportReservationsRequest(args [0]string, argsEscaped boo
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, ExportReservationsOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, ExportAreaQrCodesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5991,7 +6443,7 @@ func (s *Server) handleExportReservationsRequest(args [0]string, argsEscaped boo
 			return
 		}
 	}
-	params, err := decodeExportReservationsParams(args, argsEscaped, r)
+	params, err := decodeExportAreaQrCodesParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6004,31 +6456,31 @@ func (s *Server) handleExportReservationsRequest(args [0]string, argsEscaped boo
 
 	var rawBody []byte
 
-	var response ExportReservationsRes
+	var response ExportAreaQrCodesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ExportReservationsOperation,
-			OperationSummary: "Export reservations as CSV",
-			OperationID:      "exportReservations",
+			OperationName:    ExportAreaQrCodesOperation,
+			OperationSummary: "Export QR code labels of all places in the area",
+			OperationID:      "exportAreaQrCodes",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "startDate",
+					Name: "templateId",
 					In:   "query",
-				}: params.StartDate,
+				}: params.TemplateId,
 				{
-					Name: "endDate",
+					Name: "format",
 					In:   "query",
-				}: params.EndDate,
+				}: params.Format,
 				{
-					Name: "buildingId",
+					Name: "paper",
 					In:   "query",
-				}: params.BuildingId,
+				}: params.Paper,
 				{
 					Name: "areaId",
-					In:   "query",
+					In:   "path",
 				}: params.AreaId,
 			},
 			Raw: r,
@@ -6036,8 +6488,8 @@ func (s *Server) handleExportReservationsRequest(args [0]string, argsEscaped boo
 
 		type (
 			Request  = struct{}
-			Params   = ExportReservationsParams
-			Response = ExportReservationsRes
+			Params   = ExportAreaQrCodesParams
+			Response = ExportAreaQrCodesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6046,14 +6498,14 @@ func (s *Server) handleExportReservationsRequest(args [0]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackExportReservationsParams,
+			unpackExportAreaQrCodesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ExportReservations(ctx, params)
+				response, err = s.h.ExportAreaQrCodes(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ExportReservations(ctx, params)
+		response, err = s.h.ExportAreaQrCodes(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -6061,7 +6513,7 @@ func (s *Server) handleExportReservationsRequest(args [0]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeExportReservationsResponse(response, w, span); err != nil {
+	if err := encodeExportAreaQrCodesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6070,22 +6522,24 @@ func (s *Server) handleExportReservationsRequest(args [0]string, argsEscaped boo
 	}
 }
 
-// handleGetAreaRequest handles getArea operation.
+// handleExportBuildingQrCodesRequest handles exportBuildingQrCodes operation.
 //
-// Get area details.
+// Renders the labels of all bookable, enabled places in the building, sorted by place name,
+// either as PDF print sheets with as many labels per sheet as fit on a grid, or as ZIP
+// archive of PNG images. At most 2000 places are exported at once.
 //
-// GET /areas/{areaId}
-func (s *Server) handleGetAreaRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /buildings/{buildingId}/qrCodes
+func (s *Server) handleExportBuildingQrCodesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getArea"),
+		otelogen.OperationID("exportBuildingQrCodes"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/areas/{areaId}"),
+		semconv.HTTPRouteKey.String("/buildings/{buildingId}/qrCodes"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetAreaOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ExportBuildingQrCodesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6140,45 +6594,119 @@ func (s *Server) handleGetAreaRequest(args [1]string, argsEscaped bool, w http.R
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetAreaOperation,
-			ID:   "getArea",
+			Name: ExportBuildingQrCodesOperation,
+			ID:   "exportBuildingQrCodes",
 		}
 	)
-	params, err := decodeGetAreaParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ExportBuildingQrCodesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKeyAuth(ctx, ExportBuildingQrCodesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeExportBuildingQrCodesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response GetAreaRes
+	var response ExportBuildingQrCodesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetAreaOperation,
-			OperationSummary: "Get area details",
-			OperationID:      "getArea",
+			OperationName:    ExportBuildingQrCodesOperation,
+			OperationSummary: "Export QR code labels of all places in the building",
+			OperationID:      "exportBuildingQrCodes",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "areaId",
+					Name: "templateId",
+					In:   "query",
+				}: params.TemplateId,
+				{
+					Name: "format",
+					In:   "query",
+				}: params.Format,
+				{
+					Name: "paper",
+					In:   "query",
+				}: params.Paper,
+				{
+					Name: "buildingId",
 					In:   "path",
-				}: params.AreaId,
+				}: params.BuildingId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetAreaParams
-			Response = GetAreaRes
+			Params   = ExportBuildingQrCodesParams
+			Response = ExportBuildingQrCodesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6187,14 +6715,14 @@ func (s *Server) handleGetAreaRequest(args [1]string, argsEscaped bool, w http.R
 		](
 			m,
 			mreq,
-			unpackGetAreaParams,
+			unpackExportBuildingQrCodesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetArea(ctx, params)
+				response, err = s.h.ExportBuildingQrCodes(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetArea(ctx, params)
+		response, err = s.h.ExportBuildingQrCodes(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -6202,7 +6730,7 @@ func (s *Server) handleGetAreaRequest(args [1]string, argsEscaped bool, w http.R
 		return
 	}
 
-	if err := encodeGetAreaResponse(response, w, span); err != nil {
+	if err := encodeExportBuildingQrCodesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6211,24 +6739,22 @@ func (s *Server) handleGetAreaRequest(args [1]string, argsEscaped bool, w http.R
 	}
 }
 
-// handleGetAreaAvailabilityRequest handles getAreaAvailability operation.
+// handleExportReservationsRequest handles exportReservations operation.
 //
-// Returns availability status for each place in the area within the given time range.
-// Availability is calculated as: time slots NOT blocked (from place, area, or building)
-// AND NOT reserved. Optimized for room plan visualization.
+// Export reservations as CSV.
 //
-// GET /areas/{areaId}/availability
-func (s *Server) handleGetAreaAvailabilityRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /reservations/export
+func (s *Server) handleExportReservationsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAreaAvailability"),
+		otelogen.OperationID("exportReservations"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/areas/{areaId}/availability"),
+		semconv.HTTPRouteKey.String("/reservations/export"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetAreaAvailabilityOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ExportReservationsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6283,11 +6809,73 @@ func (s *Server) handleGetAreaAvailabilityRequest(args [1]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetAreaAvailabilityOperation,
-			ID:   "getAreaAvailability",
+			Name: ExportReservationsOperation,
+			ID:   "exportReservations",
 		}
 	)
-	params, err := decodeGetAreaAvailabilityParams(args, argsEscaped, r)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ExportReservationsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKeyAuth(ctx, ExportReservationsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeExportReservationsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6300,31 +6888,31 @@ func (s *Server) handleGetAreaAvailabilityRequest(args [1]string, argsEscaped bo
 
 	var rawBody []byte
 
-	var response GetAreaAvailabilityRes
+	var response ExportReservationsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetAreaAvailabilityOperation,
-			OperationSummary: "Get availability summary for all places in area",
-			OperationID:      "getAreaAvailability",
+			OperationName:    ExportReservationsOperation,
+			OperationSummary: "Export reservations as CSV",
+			OperationID:      "exportReservations",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "date",
+					Name: "startDate",
 					In:   "query",
-				}: params.Date,
+				}: params.StartDate,
 				{
-					Name: "startTime",
+					Name: "endDate",
 					In:   "query",
-				}: params.StartTime,
+				}: params.EndDate,
 				{
-					Name: "endTime",
+					Name: "buildingId",
 					In:   "query",
-				}: params.EndTime,
+				}: params.BuildingId,
 				{
 					Name: "areaId",
-					In:   "path",
+					In:   "query",
 				}: params.AreaId,
 			},
 			Raw: r,
@@ -6332,8 +6920,8 @@ func (s *Server) handleGetAreaAvailabilityRequest(args [1]string, argsEscaped bo
 
 		type (
 			Request  = struct{}
-			Params   = GetAreaAvailabilityParams
-			Response = GetAreaAvailabilityRes
+			Params   = ExportReservationsParams
+			Response = ExportReservationsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6342,14 +6930,14 @@ func (s *Server) handleGetAreaAvailabilityRequest(args [1]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackGetAreaAvailabilityParams,
+			unpackExportReservationsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAreaAvailability(ctx, params)
+				response, err = s.h.ExportReservations(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAreaAvailability(ctx, params)
+		response, err = s.h.ExportReservations(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -6357,7 +6945,7 @@ func (s *Server) handleGetAreaAvailabilityRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeGetAreaAvailabilityResponse(response, w, span); err != nil {
+	if err := encodeExportReservationsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6366,23 +6954,22 @@ func (s *Server) handleGetAreaAvailabilityRequest(args [1]string, argsEscaped bo
 	}
 }
 
-// handleGetAreaBlockingRequest handles getAreaBlocking operation.
+// handleGetAreaRequest handles getArea operation.
 //
-// Returns blocking periods defined at the area level.
-// Use includeInherited=true to also see blocking inherited from the building.
+// Get area details.
 //
-// GET /areas/{areaId}/blocking
-func (s *Server) handleGetAreaBlockingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /areas/{areaId}
+func (s *Server) handleGetAreaRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAreaBlocking"),
+		otelogen.OperationID("getArea"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/areas/{areaId}/blocking"),
+		semconv.HTTPRouteKey.String("/areas/{areaId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetAreaBlockingOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetAreaOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6437,11 +7024,11 @@ func (s *Server) handleGetAreaBlockingRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetAreaBlockingOperation,
-			ID:   "getAreaBlocking",
+			Name: GetAreaOperation,
+			ID:   "getArea",
 		}
 	)
-	params, err := decodeGetAreaBlockingParams(args, argsEscaped, r)
+	params, err := decodeGetAreaParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6454,32 +7041,16 @@ func (s *Server) handleGetAreaBlockingRequest(args [1]string, argsEscaped bool,
 
 	var rawBody []byte
 
-	var response GetAreaBlockingRes
+	var response GetAreaRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetAreaBlockingOperation,
-			OperationSummary: "Get area blocking periods",
-			OperationID:      "getAreaBlocking",
+			OperationName:    GetAreaOperation,
+			OperationSummary: "Get area details",
+			OperationID:      "getArea",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
-				{
-					Name: "includeInherited",
-					In:   "query",
-				}: params.IncludeInherited,
-				{
-					Name: "includeRecurring",
-					In:   "query",
-				}: params.IncludeRecurring,
-				{
-					Name: "startDate",
-					In:   "query",
-				}: params.StartDate,
-				{
-					Name: "endDate",
-					In:   "query",
-				}: params.EndDate,
 				{
 					Name: "areaId",
 					In:   "path",
@@ -6490,8 +7061,8 @@ func (s *Server) handleGetAreaBlockingRequest(args [1]string, argsEscaped bool,
 
 		type (
 			Request  = struct{}
-			Params   = GetAreaBlockingParams
-			Response = GetAreaBlockingRes
+			Params   = GetAreaParams
+			Response = GetAreaRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6500,14 +7071,14 @@ func (s *Server) handleGetAreaBlockingRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetAreaBlockingParams,
+			unpackGetAreaParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAreaBlocking(ctx, params)
+				response, err = s.h.GetArea(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAreaBlocking(ctx, params)
+		response, err = s.h.GetArea(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -6515,7 +7086,7 @@ func (s *Server) handleGetAreaBlockingRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetAreaBlockingResponse(response, w, span); err != nil {
+	if err := encodeGetAreaResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6524,22 +7095,26 @@ func (s *Server) handleGetAreaBlockingRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleGetAreaCalendarRequest handles getAreaCalendar operation.
+// handleGetAreaAvailabilityRequest handles getAreaAvailability operation.
 //
-// Returns .ics file with blocking periods and reservations for all places in area (next 3 months).
+// Returns availability status for each place in the area within the given time range.
+// Availability is calculated as: time slots NOT blocked (from place, area, or building)
+// AND NOT reserved. Optimized for room plan visualization.
+// The window defaults to the whole day; nextAvailableSlot is the first available
+// slot on that day starting at or after the window start.
 //
-// GET /areas/{areaId}/calendar.ics
-func (s *Server) handleGetAreaCalendarRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /areas/{areaId}/availability
+func (s *Server) handleGetAreaAvailabilityRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAreaCalendar"),
+		otelogen.OperationID("getAreaAvailability"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/areas/{areaId}/calendar.ics"),
+		semconv.HTTPRouteKey.String("/areas/{areaId}/availability"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetAreaCalendarOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetAreaAvailabilityOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6594,11 +7169,11 @@ func (s *Server) handleGetAreaCalendarRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetAreaCalendarOperation,
-			ID:   "getAreaCalendar",
+			Name: GetAreaAvailabilityOperation,
+			ID:   "getAreaAvailability",
 		}
 	)
-	params, err := decodeGetAreaCalendarParams(args, argsEscaped, r)
+	params, err := decodeGetAreaAvailabilityParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6611,16 +7186,28 @@ func (s *Server) handleGetAreaCalendarRequest(args [1]string, argsEscaped bool,
 
 	var rawBody []byte
 
-	var response GetAreaCalendarRes
+	var response GetAreaAvailabilityRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetAreaCalendarOperation,
-			OperationSummary: "Public iCalendar feed for area",
-			OperationID:      "getAreaCalendar",
+			OperationName:    GetAreaAvailabilityOperation,
+			OperationSummary: "Get availability summary for all places in area",
+			OperationID:      "getAreaAvailability",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
+				{
+					Name: "date",
+					In:   "query",
+				}: params.Date,
+				{
+					Name: "startTime",
+					In:   "query",
+				}: params.StartTime,
+				{
+					Name: "endTime",
+					In:   "query",
+				}: params.EndTime,
 				{
 					Name: "areaId",
 					In:   "path",
@@ -6631,8 +7218,8 @@ func (s *Server) handleGetAreaCalendarRequest(args [1]string, argsEscaped bool,
 
 		type (
 			Request  = struct{}
-			Params   = GetAreaCalendarParams
-			Response = GetAreaCalendarRes
+			Params   = GetAreaAvailabilityParams
+			Response = GetAreaAvailabilityRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6641,14 +7228,14 @@ func (s *Server) handleGetAreaCalendarRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetAreaCalendarParams,
+			unpackGetAreaAvailabilityParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAreaCalendar(ctx, params)
+				response, err = s.h.GetAreaAvailability(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAreaCalendar(ctx, params)
+		response, err = s.h.GetAreaAvailability(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -6656,7 +7243,7 @@ func (s *Server) handleGetAreaCalendarRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetAreaCalendarResponse(response, w, span); err != nil {
+	if err := encodeGetAreaAvailabilityResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6665,22 +7252,23 @@ func (s *Server) handleGetAreaCalendarRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleGetAreaRoomPlanRequest handles getAreaRoomPlan operation.
+// handleGetAreaBlockingRequest handles getAreaBlocking operation.
 //
-// Get room plan data.
+// Returns blocking periods defined at the area level.
+// Use includeInherited=true to also see blocking inherited from the building.
 //
-// GET /areas/{areaId}/roomPlan
-func (s *Server) handleGetAreaRoomPlanRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /areas/{areaId}/blocking
+func (s *Server) handleGetAreaBlockingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAreaRoomPlan"),
+		otelogen.OperationID("getAreaBlocking"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/areas/{areaId}/roomPlan"),
+		semconv.HTTPRouteKey.String("/areas/{areaId}/blocking"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetAreaRoomPlanOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetAreaBlockingOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6735,11 +7323,11 @@ func (s *Server) handleGetAreaRoomPlanRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetAreaRoomPlanOperation,
-			ID:   "getAreaRoomPlan",
+			Name: GetAreaBlockingOperation,
+			ID:   "getAreaBlocking",
 		}
 	)
-	params, err := decodeGetAreaRoomPlanParams(args, argsEscaped, r)
+	params, err := decodeGetAreaBlockingParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6752,16 +7340,32 @@ func (s *Server) handleGetAreaRoomPlanRequest(args [1]string, argsEscaped bool,
 
 	var rawBody []byte
 
-	var response GetAreaRoomPlanRes
+	var response GetAreaBlockingRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetAreaRoomPlanOperation,
-			OperationSummary: "Get room plan data",
-			OperationID:      "getAreaRoomPlan",
+			OperationName:    GetAreaBlockingOperation,
+			OperationSummary: "Get area blocking periods",
+			OperationID:      "getAreaBlocking",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
+				{
+					Name: "includeInherited",
+					In:   "query",
+				}: params.IncludeInherited,
+				{
+					Name: "includeRecurring",
+					In:   "query",
+				}: params.IncludeRecurring,
+				{
+					Name: "startDate",
+					In:   "query",
+				}: params.StartDate,
+				{
+					Name: "endDate",
+					In:   "query",
+				}: params.EndDate,
 				{
 					Name: "areaId",
 					In:   "path",
@@ -6772,8 +7376,8 @@ func (s *Server) handleGetAreaRoomPlanRequest(args [1]string, argsEscaped bool,
 
 		type (
 			Request  = struct{}
-			Params   = GetAreaRoomPlanParams
-			Response = GetAreaRoomPlanRes
+			Params   = GetAreaBlockingParams
+			Response = GetAreaBlockingRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6782,14 +7386,14 @@ func (s *Server) handleGetAreaRoomPlanRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetAreaRoomPlanParams,
+			unpackGetAreaBlockingParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAreaRoomPlan(ctx, params)
+				response, err = s.h.GetAreaBlocking(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAreaRoomPlan(ctx, params)
+		response, err = s.h.GetAreaBlocking(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -6797,7 +7401,7 @@ func (s *Server) handleGetAreaRoomPlanRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetAreaRoomPlanResponse(response, w, span); err != nil {
+	if err := encodeGetAreaBlockingResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6806,22 +7410,24 @@ func (s *Server) handleGetAreaRoomPlanRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleGetAuditLogRequest handles getAuditLog operation.
+// handleGetAreaCalendarRequest handles getAreaCalendar operation.
 //
-// Get audit log entries.
+// Returns .ics file with blocking periods and reservations for all places in area (next 3 months).
+// Blockings of the area, its places and its building are included. Reservations never include
+// details about the user holding them; recurring blockings are emitted as RRULE/EXDATE.
 //
-// GET /auditLog
-func (s *Server) handleGetAuditLogRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /areas/{areaId}/calendar.ics
+func (s *Server) handleGetAreaCalendarRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAuditLog"),
+		otelogen.OperationID("getAreaCalendar"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/auditLog"),
+		semconv.HTTPRouteKey.String("/areas/{areaId}/calendar.ics"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetAuditLogOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetAreaCalendarOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6876,73 +7482,11 @@ func (s *Server) handleGetAuditLogRequest(args [0]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetAuditLogOperation,
-			ID:   "getAuditLog",
+			Name: GetAreaCalendarOperation,
+			ID:   "getAreaCalendar",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetAuditLogOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "BearerAuth",
-					Err:              err,
-				}
-				defer recordError("Security:BearerAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, GetAuditLogOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "ApiKeyAuth",
-					Err:              err,
-				}
-				defer recordError("Security:ApiKeyAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 1
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-				{0b00000010},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
-		}
-	}
-	params, err := decodeGetAuditLogParams(args, argsEscaped, r)
+	params, err := decodeGetAreaCalendarParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6955,56 +7499,32 @@ func (s *Server) handleGetAuditLogRequest(args [0]string, argsEscaped bool, w ht
 
 	var rawBody []byte
 
-	var response GetAuditLogRes
+	var response GetAreaCalendarRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetAuditLogOperation,
-			OperationSummary: "Get audit log entries",
-			OperationID:      "getAuditLog",
+			OperationName:    GetAreaCalendarOperation,
+			OperationSummary: "Public iCalendar feed for area",
+			OperationID:      "getAreaCalendar",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "entityType",
-					In:   "query",
-				}: params.EntityType,
-				{
-					Name: "entityId",
-					In:   "query",
-				}: params.EntityId,
-				{
-					Name: "userId",
-					In:   "query",
-				}: params.UserId,
-				{
-					Name: "action",
-					In:   "query",
-				}: params.Action,
-				{
-					Name: "startDate",
-					In:   "query",
-				}: params.StartDate,
-				{
-					Name: "endDate",
-					In:   "query",
-				}: params.EndDate,
-				{
-					Name: "page",
-					In:   "query",
-				}: params.Page,
+					Name: "If-None-Match",
+					In:   "header",
+				}: params.IfNoneMatch,
 				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
+					Name: "areaId",
+					In:   "path",
+				}: params.AreaId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetAuditLogParams
-			Response = GetAuditLogRes
+			Params   = GetAreaCalendarParams
+			Response = GetAreaCalendarRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7013,14 +7533,14 @@ func (s *Server) handleGetAuditLogRequest(args [0]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			unpackGetAuditLogParams,
+			unpackGetAreaCalendarParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAuditLog(ctx, params)
+				response, err = s.h.GetAreaCalendar(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAuditLog(ctx, params)
+		response, err = s.h.GetAreaCalendar(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -7028,7 +7548,7 @@ func (s *Server) handleGetAuditLogRequest(args [0]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeGetAuditLogResponse(response, w, span); err != nil {
+	if err := encodeGetAreaCalendarResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7037,22 +7557,23 @@ func (s *Server) handleGetAuditLogRequest(args [0]string, argsEscaped bool, w ht
 	}
 }
 
-// handleGetBuildingRequest handles getBuilding operation.
+// handleGetAreaConstraintsRequest handles getAreaConstraints operation.
 //
-// Get building details.
+// Returns the default constraints of all places in the area. Places
+// inherit every field they do not set themselves, falling back to the building.
 //
-// GET /buildings/{buildingId}
-func (s *Server) handleGetBuildingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /areas/{areaId}/constraints
+func (s *Server) handleGetAreaConstraintsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getBuilding"),
+		otelogen.OperationID("getAreaConstraints"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/buildings/{buildingId}"),
+		semconv.HTTPRouteKey.String("/areas/{areaId}/constraints"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetBuildingOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetAreaConstraintsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7107,11 +7628,11 @@ func (s *Server) handleGetBuildingRequest(args [1]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetBuildingOperation,
-			ID:   "getBuilding",
+			Name: GetAreaConstraintsOperation,
+			ID:   "getAreaConstraints",
 		}
 	)
-	params, err := decodeGetBuildingParams(args, argsEscaped, r)
+	params, err := decodeGetAreaConstraintsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7124,28 +7645,28 @@ func (s *Server) handleGetBuildingRequest(args [1]string, argsEscaped bool, w ht
 
 	var rawBody []byte
 
-	var response GetBuildingRes
+	var response GetAreaConstraintsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetBuildingOperation,
-			OperationSummary: "Get building details",
-			OperationID:      "getBuilding",
+			OperationName:    GetAreaConstraintsOperation,
+			OperationSummary: "Get area constraints",
+			OperationID:      "getAreaConstraints",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "buildingId",
+					Name: "areaId",
 					In:   "path",
-				}: params.BuildingId,
+				}: params.AreaId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetBuildingParams
-			Response = GetBuildingRes
+			Params   = GetAreaConstraintsParams
+			Response = GetAreaConstraintsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7154,14 +7675,14 @@ func (s *Server) handleGetBuildingRequest(args [1]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			unpackGetBuildingParams,
+			unpackGetAreaConstraintsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetBuilding(ctx, params)
+				response, err = s.h.GetAreaConstraints(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetBuilding(ctx, params)
+		response, err = s.h.GetAreaConstraints(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -7169,7 +7690,7 @@ func (s *Server) handleGetBuildingRequest(args [1]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeGetBuildingResponse(response, w, span); err != nil {
+	if err := encodeGetAreaConstraintsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7178,23 +7699,22 @@ func (s *Server) handleGetBuildingRequest(args [1]string, argsEscaped bool, w ht
 	}
 }
 
-// handleGetBuildingAvailabilityRequest handles getBuildingAvailability operation.
+// handleGetAreaRoomPlanRequest handles getAreaRoomPlan operation.
 //
-// Returns time slots that are NOT blocked for the building.
-// Useful for seeing when the building is open/operational.
+// Get room plan data.
 //
-// GET /buildings/{buildingId}/availability
-func (s *Server) handleGetBuildingAvailabilityRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /areas/{areaId}/roomPlan
+func (s *Server) handleGetAreaRoomPlanRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getBuildingAvailability"),
+		otelogen.OperationID("getAreaRoomPlan"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/buildings/{buildingId}/availability"),
+		semconv.HTTPRouteKey.String("/areas/{areaId}/roomPlan"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetBuildingAvailabilityOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetAreaRoomPlanOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7249,11 +7769,11 @@ func (s *Server) handleGetBuildingAvailabilityRequest(args [1]string, argsEscape
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetBuildingAvailabilityOperation,
-			ID:   "getBuildingAvailability",
+			Name: GetAreaRoomPlanOperation,
+			ID:   "getAreaRoomPlan",
 		}
 	)
-	params, err := decodeGetBuildingAvailabilityParams(args, argsEscaped, r)
+	params, err := decodeGetAreaRoomPlanParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7266,36 +7786,28 @@ func (s *Server) handleGetBuildingAvailabilityRequest(args [1]string, argsEscape
 
 	var rawBody []byte
 
-	var response GetBuildingAvailabilityRes
+	var response GetAreaRoomPlanRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetBuildingAvailabilityOperation,
-			OperationSummary: "Get available time slots for building",
-			OperationID:      "getBuildingAvailability",
+			OperationName:    GetAreaRoomPlanOperation,
+			OperationSummary: "Get room plan data",
+			OperationID:      "getAreaRoomPlan",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "startDate",
-					In:   "query",
-				}: params.StartDate,
-				{
-					Name: "endDate",
-					In:   "query",
-				}: params.EndDate,
-				{
-					Name: "buildingId",
+					Name: "areaId",
 					In:   "path",
-				}: params.BuildingId,
+				}: params.AreaId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetBuildingAvailabilityParams
-			Response = GetBuildingAvailabilityRes
+			Params   = GetAreaRoomPlanParams
+			Response = GetAreaRoomPlanRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7304,14 +7816,14 @@ func (s *Server) handleGetBuildingAvailabilityRequest(args [1]string, argsEscape
 		](
 			m,
 			mreq,
-			unpackGetBuildingAvailabilityParams,
+			unpackGetAreaRoomPlanParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetBuildingAvailability(ctx, params)
+				response, err = s.h.GetAreaRoomPlan(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetBuildingAvailability(ctx, params)
+		response, err = s.h.GetAreaRoomPlan(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -7319,7 +7831,7 @@ func (s *Server) handleGetBuildingAvailabilityRequest(args [1]string, argsEscape
 		return
 	}
 
-	if err := encodeGetBuildingAvailabilityResponse(response, w, span); err != nil {
+	if err := encodeGetAreaRoomPlanResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7328,23 +7840,22 @@ func (s *Server) handleGetBuildingAvailabilityRequest(args [1]string, argsEscape
 	}
 }
 
-// handleGetBuildingBlockingRequest handles getBuildingBlocking operation.
+// handleGetAuditLogRequest handles getAuditLog operation.
 //
-// Returns all blocking periods for this building.
-// These blocking periods cascade to all areas and places within the building.
+// Get audit log entries.
 //
-// GET /buildings/{buildingId}/blocking
-func (s *Server) handleGetBuildingBlockingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /auditLog
+func (s *Server) handleGetAuditLogRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getBuildingBlocking"),
+		otelogen.OperationID("getAuditLog"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/buildings/{buildingId}/blocking"),
+		semconv.HTTPRouteKey.String("/auditLog"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetBuildingBlockingOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetAuditLogOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7399,11 +7910,73 @@ func (s *Server) handleGetBuildingBlockingRequest(args [1]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetBuildingBlockingOperation,
-			ID:   "getBuildingBlocking",
+			Name: GetAuditLogOperation,
+			ID:   "getAuditLog",
 		}
 	)
-	params, err := decodeGetBuildingBlockingParams(args, argsEscaped, r)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetAuditLogOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKeyAuth(ctx, GetAuditLogOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetAuditLogParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7416,20 +7989,32 @@ func (s *Server) handleGetBuildingBlockingRequest(args [1]string, argsEscaped bo
 
 	var rawBody []byte
 
-	var response GetBuildingBlockingRes
+	var response GetAuditLogRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetBuildingBlockingOperation,
-			OperationSummary: "Get building blocking periods",
-			OperationID:      "getBuildingBlocking",
+			OperationName:    GetAuditLogOperation,
+			OperationSummary: "Get audit log entries",
+			OperationID:      "getAuditLog",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "includeRecurring",
+					Name: "entityType",
 					In:   "query",
-				}: params.IncludeRecurring,
+				}: params.EntityType,
+				{
+					Name: "entityId",
+					In:   "query",
+				}: params.EntityId,
+				{
+					Name: "userId",
+					In:   "query",
+				}: params.UserId,
+				{
+					Name: "action",
+					In:   "query",
+				}: params.Action,
 				{
 					Name: "startDate",
 					In:   "query",
@@ -7439,17 +8024,21 @@ func (s *Server) handleGetBuildingBlockingRequest(args [1]string, argsEscaped bo
 					In:   "query",
 				}: params.EndDate,
 				{
-					Name: "buildingId",
-					In:   "path",
-				}: params.BuildingId,
+					Name: "page",
+					In:   "query",
+				}: params.Page,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetBuildingBlockingParams
-			Response = GetBuildingBlockingRes
+			Params   = GetAuditLogParams
+			Response = GetAuditLogRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7458,14 +8047,14 @@ func (s *Server) handleGetBuildingBlockingRequest(args [1]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackGetBuildingBlockingParams,
+			unpackGetAuditLogParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetBuildingBlocking(ctx, params)
+				response, err = s.h.GetAuditLog(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetBuildingBlocking(ctx, params)
+		response, err = s.h.GetAuditLog(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -7473,7 +8062,7 @@ func (s *Server) handleGetBuildingBlockingRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeGetBuildingBlockingResponse(response, w, span); err != nil {
+	if err := encodeGetAuditLogResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7482,22 +8071,22 @@ func (s *Server) handleGetBuildingBlockingRequest(args [1]string, argsEscaped bo
 	}
 }
 
-// handleGetBuildingCalendarRequest handles getBuildingCalendar operation.
+// handleGetBuildingRequest handles getBuilding operation.
 //
-// Returns .ics file with blocking periods and reservations for all places (next 3 months).
+// Get building details.
 //
-// GET /buildings/{buildingId}/calendar.ics
-func (s *Server) handleGetBuildingCalendarRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /buildings/{buildingId}
+func (s *Server) handleGetBuildingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getBuildingCalendar"),
+		otelogen.OperationID("getBuilding"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/buildings/{buildingId}/calendar.ics"),
+		semconv.HTTPRouteKey.String("/buildings/{buildingId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetBuildingCalendarOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetBuildingOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7552,11 +8141,11 @@ func (s *Server) handleGetBuildingCalendarRequest(args [1]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetBuildingCalendarOperation,
-			ID:   "getBuildingCalendar",
+			Name: GetBuildingOperation,
+			ID:   "getBuilding",
 		}
 	)
-	params, err := decodeGetBuildingCalendarParams(args, argsEscaped, r)
+	params, err := decodeGetBuildingParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7569,13 +8158,13 @@ func (s *Server) handleGetBuildingCalendarRequest(args [1]string, argsEscaped bo
 
 	var rawBody []byte
 
-	var response GetBuildingCalendarRes
+	var response GetBuildingRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetBuildingCalendarOperation,
-			OperationSummary: "Public iCalendar feed for building",
-			OperationID:      "getBuildingCalendar",
+			OperationName:    GetBuildingOperation,
+			OperationSummary: "Get building details",
+			OperationID:      "getBuilding",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -7589,8 +8178,8 @@ func (s *Server) handleGetBuildingCalendarRequest(args [1]string, argsEscaped bo
 
 		type (
 			Request  = struct{}
-			Params   = GetBuildingCalendarParams
-			Response = GetBuildingCalendarRes
+			Params   = GetBuildingParams
+			Response = GetBuildingRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7599,14 +8188,14 @@ func (s *Server) handleGetBuildingCalendarRequest(args [1]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackGetBuildingCalendarParams,
+			unpackGetBuildingParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetBuildingCalendar(ctx, params)
+				response, err = s.h.GetBuilding(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetBuildingCalendar(ctx, params)
+		response, err = s.h.GetBuilding(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -7614,7 +8203,7 @@ func (s *Server) handleGetBuildingCalendarRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeGetBuildingCalendarResponse(response, w, span); err != nil {
+	if err := encodeGetBuildingResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7623,22 +8212,27 @@ func (s *Server) handleGetBuildingCalendarRequest(args [1]string, argsEscaped bo
 	}
 }
 
-// handleGetCurrentOccupancyRequest handles getCurrentOccupancy operation.
+// handleGetBuildingAvailabilityRequest handles getBuildingAvailability operation.
 //
-// Get current occupancy.
+// Returns time slots that are NOT blocked for the building.
+// Useful for seeing when the building is open/operational.
+// Only building-level blockings are considered, with recurrences expanded.
+// Besides the merged open ranges, the response summarizes the regular opening
+// hours per weekday and lists the days that deviate from them.
+// Ranges longer than 31 days are truncated.
 //
-// GET /statistics/current
-func (s *Server) handleGetCurrentOccupancyRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
-	statusWriter := &codeRecorder{ResponseWriter: w}
+// GET /buildings/{buildingId}/availability
+func (s *Server) handleGetBuildingAvailabilityRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getCurrentOccupancy"),
+		otelogen.OperationID("getBuildingAvailability"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/statistics/current"),
+		semconv.HTTPRouteKey.String("/buildings/{buildingId}/availability"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetCurrentOccupancyOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetBuildingAvailabilityOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7693,73 +8287,11 @@ func (s *Server) handleGetCurrentOccupancyRequest(args [0]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetCurrentOccupancyOperation,
-			ID:   "getCurrentOccupancy",
+			Name: GetBuildingAvailabilityOperation,
+			ID:   "getBuildingAvailability",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetCurrentOccupancyOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "BearerAuth",
-					Err:              err,
-				}
-				defer recordError("Security:BearerAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, GetCurrentOccupancyOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "ApiKeyAuth",
-					Err:              err,
-				}
-				defer recordError("Security:ApiKeyAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 1
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-				{0b00000010},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
-		}
-	}
-	params, err := decodeGetCurrentOccupancyParams(args, argsEscaped, r)
+	params, err := decodeGetBuildingAvailabilityParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7772,32 +8304,36 @@ func (s *Server) handleGetCurrentOccupancyRequest(args [0]string, argsEscaped bo
 
 	var rawBody []byte
 
-	var response GetCurrentOccupancyRes
+	var response GetBuildingAvailabilityRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetCurrentOccupancyOperation,
-			OperationSummary: "Get current occupancy",
-			OperationID:      "getCurrentOccupancy",
+			OperationName:    GetBuildingAvailabilityOperation,
+			OperationSummary: "Get available time slots for building",
+			OperationID:      "getBuildingAvailability",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "buildingId",
+					Name: "startDate",
 					In:   "query",
-				}: params.BuildingId,
+				}: params.StartDate,
 				{
-					Name: "areaId",
+					Name: "endDate",
 					In:   "query",
-				}: params.AreaId,
+				}: params.EndDate,
+				{
+					Name: "buildingId",
+					In:   "path",
+				}: params.BuildingId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetCurrentOccupancyParams
-			Response = GetCurrentOccupancyRes
+			Params   = GetBuildingAvailabilityParams
+			Response = GetBuildingAvailabilityRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7806,14 +8342,14 @@ func (s *Server) handleGetCurrentOccupancyRequest(args [0]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackGetCurrentOccupancyParams,
+			unpackGetBuildingAvailabilityParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetCurrentOccupancy(ctx, params)
+				response, err = s.h.GetBuildingAvailability(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetCurrentOccupancy(ctx, params)
+		response, err = s.h.GetBuildingAvailability(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -7821,7 +8357,7 @@ func (s *Server) handleGetCurrentOccupancyRequest(args [0]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeGetCurrentOccupancyResponse(response, w, span); err != nil {
+	if err := encodeGetBuildingAvailabilityResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7830,22 +8366,23 @@ func (s *Server) handleGetCurrentOccupancyRequest(args [0]string, argsEscaped bo
 	}
 }
 
-// handleGetCurrentUserRequest handles getCurrentUser operation.
+// handleGetBuildingBlockingRequest handles getBuildingBlocking operation.
 //
-// Get current user profile.
+// Returns all blocking periods for this building.
+// These blocking periods cascade to all areas and places within the building.
 //
-// GET /auth/me
-func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /buildings/{buildingId}/blocking
+func (s *Server) handleGetBuildingBlockingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getCurrentUser"),
+		otelogen.OperationID("getBuildingBlocking"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/auth/me"),
+		semconv.HTTPRouteKey.String("/buildings/{buildingId}/blocking"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetCurrentUserOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetBuildingBlockingOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7900,92 +8437,57 @@ func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetCurrentUserOperation,
-			ID:   "getCurrentUser",
+			Name: GetBuildingBlockingOperation,
+			ID:   "getBuildingBlocking",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetCurrentUserOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "BearerAuth",
-					Err:              err,
-				}
-				defer recordError("Security:BearerAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, GetCurrentUserOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "ApiKeyAuth",
-					Err:              err,
-				}
-				defer recordError("Security:ApiKeyAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 1
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-				{0b00000010},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
+	params, err := decodeGetBuildingBlockingParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
 		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
 	}
 
 	var rawBody []byte
 
-	var response GetCurrentUserRes
+	var response GetBuildingBlockingRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetCurrentUserOperation,
-			OperationSummary: "Get current user profile",
-			OperationID:      "getCurrentUser",
+			OperationName:    GetBuildingBlockingOperation,
+			OperationSummary: "Get building blocking periods",
+			OperationID:      "getBuildingBlocking",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "includeRecurring",
+					In:   "query",
+				}: params.IncludeRecurring,
+				{
+					Name: "startDate",
+					In:   "query",
+				}: params.StartDate,
+				{
+					Name: "endDate",
+					In:   "query",
+				}: params.EndDate,
+				{
+					Name: "buildingId",
+					In:   "path",
+				}: params.BuildingId,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = GetCurrentUserRes
+			Params   = GetBuildingBlockingParams
+			Response = GetBuildingBlockingRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7994,14 +8496,14 @@ func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetBuildingBlockingParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetCurrentUser(ctx)
+				response, err = s.h.GetBuildingBlocking(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetCurrentUser(ctx)
+		response, err = s.h.GetBuildingBlocking(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -8009,7 +8511,7 @@ func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetCurrentUserResponse(response, w, span); err != nil {
+	if err := encodeGetBuildingBlockingResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8018,22 +8520,24 @@ func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleGetCurrentUserFavoritesRequest handles getCurrentUserFavorites operation.
+// handleGetBuildingCalendarRequest handles getBuildingCalendar operation.
 //
-// Get current user's favorite places.
+// Returns .ics file with blocking periods and reservations for all places (next 3 months).
+// Blockings of the building and of its areas and places are included. Reservations never
+// include details about the user holding them; recurring blockings are emitted as RRULE/EXDATE.
 //
-// GET /users/me/favorites
-func (s *Server) handleGetCurrentUserFavoritesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /buildings/{buildingId}/calendar.ics
+func (s *Server) handleGetBuildingCalendarRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getCurrentUserFavorites"),
+		otelogen.OperationID("getBuildingCalendar"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/users/me/favorites"),
+		semconv.HTTPRouteKey.String("/buildings/{buildingId}/calendar.ics"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetCurrentUserFavoritesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetBuildingCalendarOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8088,92 +8592,49 @@ func (s *Server) handleGetCurrentUserFavoritesRequest(args [0]string, argsEscape
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetCurrentUserFavoritesOperation,
-			ID:   "getCurrentUserFavorites",
+			Name: GetBuildingCalendarOperation,
+			ID:   "getBuildingCalendar",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetCurrentUserFavoritesOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "BearerAuth",
-					Err:              err,
-				}
-				defer recordError("Security:BearerAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, GetCurrentUserFavoritesOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "ApiKeyAuth",
-					Err:              err,
-				}
-				defer recordError("Security:ApiKeyAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 1
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-				{0b00000010},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
+	params, err := decodeGetBuildingCalendarParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
 		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
 	}
 
 	var rawBody []byte
 
-	var response GetCurrentUserFavoritesRes
+	var response GetBuildingCalendarRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetCurrentUserFavoritesOperation,
-			OperationSummary: "Get current user's favorite places",
-			OperationID:      "getCurrentUserFavorites",
+			OperationName:    GetBuildingCalendarOperation,
+			OperationSummary: "Public iCalendar feed for building",
+			OperationID:      "getBuildingCalendar",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "If-None-Match",
+					In:   "header",
+				}: params.IfNoneMatch,
+				{
+					Name: "buildingId",
+					In:   "path",
+				}: params.BuildingId,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = GetCurrentUserFavoritesRes
+			Params   = GetBuildingCalendarParams
+			Response = GetBuildingCalendarRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8182,14 +8643,14 @@ func (s *Server) handleGetCurrentUserFavoritesRequest(args [0]string, argsEscape
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetBuildingCalendarParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetCurrentUserFavorites(ctx)
+				response, err = s.h.GetBuildingCalendar(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetCurrentUserFavorites(ctx)
+		response, err = s.h.GetBuildingCalendar(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -8197,7 +8658,7 @@ func (s *Server) handleGetCurrentUserFavoritesRequest(args [0]string, argsEscape
 		return
 	}
 
-	if err := encodeGetCurrentUserFavoritesResponse(response, w, span); err != nil {
+	if err := encodeGetBuildingCalendarResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8206,22 +8667,23 @@ func (s *Server) handleGetCurrentUserFavoritesRequest(args [0]string, argsEscape
 	}
 }
 
-// handleGetCurrentUserNotificationsRequest handles getCurrentUserNotifications operation.
+// handleGetBuildingConstraintsRequest handles getBuildingConstraints operation.
 //
-// Get notification preferences.
+// Returns the default constraints of all places in the building. Places
+// inherit every field they do not set themselves.
 //
-// GET /users/me/notifications
-func (s *Server) handleGetCurrentUserNotificationsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /buildings/{buildingId}/constraints
+func (s *Server) handleGetBuildingConstraintsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getCurrentUserNotifications"),
+		otelogen.OperationID("getBuildingConstraints"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/users/me/notifications"),
+		semconv.HTTPRouteKey.String("/buildings/{buildingId}/constraints"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetCurrentUserNotificationsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetBuildingConstraintsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8276,92 +8738,45 @@ func (s *Server) handleGetCurrentUserNotificationsRequest(args [0]string, argsEs
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetCurrentUserNotificationsOperation,
-			ID:   "getCurrentUserNotifications",
+			Name: GetBuildingConstraintsOperation,
+			ID:   "getBuildingConstraints",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetCurrentUserNotificationsOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "BearerAuth",
-					Err:              err,
-				}
-				defer recordError("Security:BearerAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, GetCurrentUserNotificationsOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "ApiKeyAuth",
-					Err:              err,
-				}
-				defer recordError("Security:ApiKeyAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 1
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-				{0b00000010},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
+	params, err := decodeGetBuildingConstraintsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
 		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
 	}
 
 	var rawBody []byte
 
-	var response GetCurrentUserNotificationsRes
+	var response GetBuildingConstraintsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetCurrentUserNotificationsOperation,
-			OperationSummary: "Get notification preferences",
-			OperationID:      "getCurrentUserNotifications",
+			OperationName:    GetBuildingConstraintsOperation,
+			OperationSummary: "Get building constraints",
+			OperationID:      "getBuildingConstraints",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "buildingId",
+					In:   "path",
+				}: params.BuildingId,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = GetCurrentUserNotificationsRes
+			Params   = GetBuildingConstraintsParams
+			Response = GetBuildingConstraintsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8370,14 +8785,14 @@ func (s *Server) handleGetCurrentUserNotificationsRequest(args [0]string, argsEs
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetBuildingConstraintsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetCurrentUserNotifications(ctx)
+				response, err = s.h.GetBuildingConstraints(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetCurrentUserNotifications(ctx)
+		response, err = s.h.GetBuildingConstraints(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -8385,7 +8800,7 @@ func (s *Server) handleGetCurrentUserNotificationsRequest(args [0]string, argsEs
 		return
 	}
 
-	if err := encodeGetCurrentUserNotificationsResponse(response, w, span); err != nil {
+	if err := encodeGetBuildingConstraintsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8394,22 +8809,22 @@ func (s *Server) handleGetCurrentUserNotificationsRequest(args [0]string, argsEs
 	}
 }
 
-// handleGetDefaultGroupAssignmentRequest handles getDefaultGroupAssignment operation.
+// handleGetCurrentOccupancyRequest handles getCurrentOccupancy operation.
 //
-// Get default groups for new users.
+// Get current occupancy.
 //
-// GET /groups/defaultAssignment
-func (s *Server) handleGetDefaultGroupAssignmentRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /statistics/current
+func (s *Server) handleGetCurrentOccupancyRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getDefaultGroupAssignment"),
+		otelogen.OperationID("getCurrentOccupancy"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/groups/defaultAssignment"),
+		semconv.HTTPRouteKey.String("/statistics/current"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetDefaultGroupAssignmentOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetCurrentOccupancyOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8464,15 +8879,15 @@ func (s *Server) handleGetDefaultGroupAssignmentRequest(args [0]string, argsEsca
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetDefaultGroupAssignmentOperation,
-			ID:   "getDefaultGroupAssignment",
+			Name: GetCurrentOccupancyOperation,
+			ID:   "getCurrentOccupancy",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetDefaultGroupAssignmentOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetCurrentOccupancyOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8489,7 +8904,7 @@ func (s *Server) handleGetDefaultGroupAssignmentRequest(args [0]string, argsEsca
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, GetDefaultGroupAssignmentOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, GetCurrentOccupancyOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8530,26 +8945,45 @@ func (s *Server) handleGetDefaultGroupAssignmentRequest(args [0]string, argsEsca
 			return
 		}
 	}
+	params, err := decodeGetCurrentOccupancyParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response GetDefaultGroupAssignmentRes
+	var response GetCurrentOccupancyRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetDefaultGroupAssignmentOperation,
-			OperationSummary: "Get default groups for new users",
-			OperationID:      "getDefaultGroupAssignment",
+			OperationName:    GetCurrentOccupancyOperation,
+			OperationSummary: "Get current occupancy",
+			OperationID:      "getCurrentOccupancy",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "buildingId",
+					In:   "query",
+				}: params.BuildingId,
+				{
+					Name: "areaId",
+					In:   "query",
+				}: params.AreaId,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = GetDefaultGroupAssignmentRes
+			Params   = GetCurrentOccupancyParams
+			Response = GetCurrentOccupancyRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8558,14 +8992,14 @@ func (s *Server) handleGetDefaultGroupAssignmentRequest(args [0]string, argsEsca
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetCurrentOccupancyParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetDefaultGroupAssignment(ctx)
+				response, err = s.h.GetCurrentOccupancy(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetDefaultGroupAssignment(ctx)
+		response, err = s.h.GetCurrentOccupancy(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -8573,7 +9007,7 @@ func (s *Server) handleGetDefaultGroupAssignmentRequest(args [0]string, argsEsca
 		return
 	}
 
-	if err := encodeGetDefaultGroupAssignmentResponse(response, w, span); err != nil {
+	if err := encodeGetCurrentOccupancyResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8582,22 +9016,22 @@ func (s *Server) handleGetDefaultGroupAssignmentRequest(args [0]string, argsEsca
 	}
 }
 
-// handleGetEquipmentRequest handles getEquipment operation.
+// handleGetCurrentUserRequest handles getCurrentUser operation.
 //
-// Get equipment type details.
+// Get current user profile.
 //
-// GET /equipment/{equipmentId}
-func (s *Server) handleGetEquipmentRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /auth/me
+func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getEquipment"),
+		otelogen.OperationID("getCurrentUser"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/equipment/{equipmentId}"),
+		semconv.HTTPRouteKey.String("/auth/me"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetEquipmentOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetCurrentUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8652,45 +9086,92 @@ func (s *Server) handleGetEquipmentRequest(args [1]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetEquipmentOperation,
-			ID:   "getEquipment",
+			Name: GetCurrentUserOperation,
+			ID:   "getCurrentUser",
 		}
 	)
-	params, err := decodeGetEquipmentParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetCurrentUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKeyAuth(ctx, GetCurrentUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
 		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
 	}
 
 	var rawBody []byte
 
-	var response GetEquipmentRes
+	var response GetCurrentUserRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetEquipmentOperation,
-			OperationSummary: "Get equipment type details",
-			OperationID:      "getEquipment",
+			OperationName:    GetCurrentUserOperation,
+			OperationSummary: "Get current user profile",
+			OperationID:      "getCurrentUser",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "equipmentId",
-					In:   "path",
-				}: params.EquipmentId,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetEquipmentParams
-			Response = GetEquipmentRes
+			Params   = struct{}
+			Response = GetCurrentUserRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8699,14 +9180,14 @@ func (s *Server) handleGetEquipmentRequest(args [1]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			unpackGetEquipmentParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetEquipment(ctx, params)
+				response, err = s.h.GetCurrentUser(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetEquipment(ctx, params)
+		response, err = s.h.GetCurrentUser(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -8714,7 +9195,7 @@ func (s *Server) handleGetEquipmentRequest(args [1]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeGetEquipmentResponse(response, w, span); err != nil {
+	if err := encodeGetCurrentUserResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8723,22 +9204,23 @@ func (s *Server) handleGetEquipmentRequest(args [1]string, argsEscaped bool, w h
 	}
 }
 
-// handleGetGroupRequest handles getGroup operation.
+// handleGetCurrentUserCalendarTokenRequest handles getCurrentUserCalendarToken operation.
 //
-// Get group details.
+// Returns when the current user's calendar feed token was created and last used. The token itself is
+// only shown once when it is created.
 //
-// GET /groups/{groupId}
-func (s *Server) handleGetGroupRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /users/me/calendar-token
+func (s *Server) handleGetCurrentUserCalendarTokenRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getGroup"),
+		otelogen.OperationID("getCurrentUserCalendarToken"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/groups/{groupId}"),
+		semconv.HTTPRouteKey.String("/users/me/calendar-token"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetGroupOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetCurrentUserCalendarTokenOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8793,15 +9275,15 @@ func (s *Server) handleGetGroupRequest(args [1]string, argsEscaped bool, w http.
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetGroupOperation,
-			ID:   "getGroup",
+			Name: GetCurrentUserCalendarTokenOperation,
+			ID:   "getCurrentUserCalendarToken",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetGroupOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetCurrentUserCalendarTokenOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8818,7 +9300,7 @@ func (s *Server) handleGetGroupRequest(args [1]string, argsEscaped bool, w http.
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, GetGroupOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, GetCurrentUserCalendarTokenOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8859,41 +9341,26 @@ func (s *Server) handleGetGroupRequest(args [1]string, argsEscaped bool, w http.
 			return
 		}
 	}
-	params, err := decodeGetGroupParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
 
-	var response GetGroupRes
+	var response GetCurrentUserCalendarTokenRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetGroupOperation,
-			OperationSummary: "Get group details",
-			OperationID:      "getGroup",
+			OperationName:    GetCurrentUserCalendarTokenOperation,
+			OperationSummary: "Get personal calendar feed token status",
+			OperationID:      "getCurrentUserCalendarToken",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "groupId",
-					In:   "path",
-				}: params.GroupId,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetGroupParams
-			Response = GetGroupRes
+			Params   = struct{}
+			Response = GetCurrentUserCalendarTokenRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8902,14 +9369,14 @@ func (s *Server) handleGetGroupRequest(args [1]string, argsEscaped bool, w http.
 		](
 			m,
 			mreq,
-			unpackGetGroupParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetGroup(ctx, params)
+				response, err = s.h.GetCurrentUserCalendarToken(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetGroup(ctx, params)
+		response, err = s.h.GetCurrentUserCalendarToken(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -8917,7 +9384,7 @@ func (s *Server) handleGetGroupRequest(args [1]string, argsEscaped bool, w http.
 		return
 	}
 
-	if err := encodeGetGroupResponse(response, w, span); err != nil {
+	if err := encodeGetCurrentUserCalendarTokenResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8926,22 +9393,22 @@ func (s *Server) handleGetGroupRequest(args [1]string, argsEscaped bool, w http.
 	}
 }
 
-// handleGetGroupMembersRequest handles getGroupMembers operation.
+// handleGetCurrentUserFavoritesRequest handles getCurrentUserFavorites operation.
 //
-// Get group members.
+// Get current user's favorite places.
 //
-// GET /groups/{groupId}/members
-func (s *Server) handleGetGroupMembersRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /users/me/favorites
+func (s *Server) handleGetCurrentUserFavoritesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getGroupMembers"),
+		otelogen.OperationID("getCurrentUserFavorites"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/groups/{groupId}/members"),
+		semconv.HTTPRouteKey.String("/users/me/favorites"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetGroupMembersOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetCurrentUserFavoritesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8996,15 +9463,15 @@ func (s *Server) handleGetGroupMembersRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetGroupMembersOperation,
-			ID:   "getGroupMembers",
+			Name: GetCurrentUserFavoritesOperation,
+			ID:   "getCurrentUserFavorites",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetGroupMembersOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetCurrentUserFavoritesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9021,7 +9488,7 @@ func (s *Server) handleGetGroupMembersRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, GetGroupMembersOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, GetCurrentUserFavoritesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9062,41 +9529,26 @@ func (s *Server) handleGetGroupMembersRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeGetGroupMembersParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
 
-	var response GetGroupMembersRes
+	var response GetCurrentUserFavoritesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetGroupMembersOperation,
-			OperationSummary: "Get group members",
-			OperationID:      "getGroupMembers",
+			OperationName:    GetCurrentUserFavoritesOperation,
+			OperationSummary: "Get current user's favorite places",
+			OperationID:      "getCurrentUserFavorites",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "groupId",
-					In:   "path",
-				}: params.GroupId,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetGroupMembersParams
-			Response = GetGroupMembersRes
+			Params   = struct{}
+			Response = GetCurrentUserFavoritesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9105,14 +9557,14 @@ func (s *Server) handleGetGroupMembersRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetGroupMembersParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetGroupMembers(ctx, params)
+				response, err = s.h.GetCurrentUserFavorites(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetGroupMembers(ctx, params)
+		response, err = s.h.GetCurrentUserFavorites(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -9120,7 +9572,7 @@ func (s *Server) handleGetGroupMembersRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetGroupMembersResponse(response, w, span); err != nil {
+	if err := encodeGetCurrentUserFavoritesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9129,22 +9581,22 @@ func (s *Server) handleGetGroupMembersRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleGetGroupPermissionsRequest handles getGroupPermissions operation.
+// handleGetCurrentUserNotificationsRequest handles getCurrentUserNotifications operation.
 //
-// Get group permissions.
+// Get notification preferences.
 //
-// GET /groups/{groupId}/permissions
-func (s *Server) handleGetGroupPermissionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /users/me/notifications
+func (s *Server) handleGetCurrentUserNotificationsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getGroupPermissions"),
+		otelogen.OperationID("getCurrentUserNotifications"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/groups/{groupId}/permissions"),
+		semconv.HTTPRouteKey.String("/users/me/notifications"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetGroupPermissionsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetCurrentUserNotificationsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9199,15 +9651,15 @@ func (s *Server) handleGetGroupPermissionsRequest(args [1]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetGroupPermissionsOperation,
-			ID:   "getGroupPermissions",
+			Name: GetCurrentUserNotificationsOperation,
+			ID:   "getCurrentUserNotifications",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetGroupPermissionsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetCurrentUserNotificationsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9224,7 +9676,7 @@ func (s *Server) handleGetGroupPermissionsRequest(args [1]string, argsEscaped bo
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, GetGroupPermissionsOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, GetCurrentUserNotificationsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9265,41 +9717,26 @@ func (s *Server) handleGetGroupPermissionsRequest(args [1]string, argsEscaped bo
 			return
 		}
 	}
-	params, err := decodeGetGroupPermissionsParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
 
-	var response GetGroupPermissionsRes
+	var response GetCurrentUserNotificationsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetGroupPermissionsOperation,
-			OperationSummary: "Get group permissions",
-			OperationID:      "getGroupPermissions",
+			OperationName:    GetCurrentUserNotificationsOperation,
+			OperationSummary: "Get notification preferences",
+			OperationID:      "getCurrentUserNotifications",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "groupId",
-					In:   "path",
-				}: params.GroupId,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetGroupPermissionsParams
-			Response = GetGroupPermissionsRes
+			Params   = struct{}
+			Response = GetCurrentUserNotificationsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9308,14 +9745,14 @@ func (s *Server) handleGetGroupPermissionsRequest(args [1]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackGetGroupPermissionsParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetGroupPermissions(ctx, params)
+				response, err = s.h.GetCurrentUserNotifications(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetGroupPermissions(ctx, params)
+		response, err = s.h.GetCurrentUserNotifications(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -9323,7 +9760,7 @@ func (s *Server) handleGetGroupPermissionsRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeGetGroupPermissionsResponse(response, w, span); err != nil {
+	if err := encodeGetCurrentUserNotificationsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9332,22 +9769,22 @@ func (s *Server) handleGetGroupPermissionsRequest(args [1]string, argsEscaped bo
 	}
 }
 
-// handleGetPlaceRequest handles getPlace operation.
+// handleGetDefaultGroupAssignmentRequest handles getDefaultGroupAssignment operation.
 //
-// Get place details.
+// Get default groups for new users.
 //
-// GET /places/{placeId}
-func (s *Server) handleGetPlaceRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /groups/defaultAssignment
+func (s *Server) handleGetDefaultGroupAssignmentRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPlace"),
+		otelogen.OperationID("getDefaultGroupAssignment"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/places/{placeId}"),
+		semconv.HTTPRouteKey.String("/groups/defaultAssignment"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPlaceOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetDefaultGroupAssignmentOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9402,45 +9839,92 @@ func (s *Server) handleGetPlaceRequest(args [1]string, argsEscaped bool, w http.
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetPlaceOperation,
-			ID:   "getPlace",
+			Name: GetDefaultGroupAssignmentOperation,
+			ID:   "getDefaultGroupAssignment",
 		}
 	)
-	params, err := decodeGetPlaceParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetDefaultGroupAssignmentOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKeyAuth(ctx, GetDefaultGroupAssignmentOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
 		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
 	}
 
 	var rawBody []byte
 
-	var response GetPlaceRes
+	var response GetDefaultGroupAssignmentRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetPlaceOperation,
-			OperationSummary: "Get place details",
-			OperationID:      "getPlace",
+			OperationName:    GetDefaultGroupAssignmentOperation,
+			OperationSummary: "Get default groups for new users",
+			OperationID:      "getDefaultGroupAssignment",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "placeId",
-					In:   "path",
-				}: params.PlaceId,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetPlaceParams
-			Response = GetPlaceRes
+			Params   = struct{}
+			Response = GetDefaultGroupAssignmentRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9449,14 +9933,14 @@ func (s *Server) handleGetPlaceRequest(args [1]string, argsEscaped bool, w http.
 		](
 			m,
 			mreq,
-			unpackGetPlaceParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetPlace(ctx, params)
+				response, err = s.h.GetDefaultGroupAssignment(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetPlace(ctx, params)
+		response, err = s.h.GetDefaultGroupAssignment(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -9464,7 +9948,7 @@ func (s *Server) handleGetPlaceRequest(args [1]string, argsEscaped bool, w http.
 		return
 	}
 
-	if err := encodeGetPlaceResponse(response, w, span); err != nil {
+	if err := encodeGetDefaultGroupAssignmentResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9473,25 +9957,24 @@ func (s *Server) handleGetPlaceRequest(args [1]string, argsEscaped bool, w http.
 	}
 }
 
-// handleGetPlaceAvailabilityRequest handles getPlaceAvailability operation.
+// handleGetEffectivePlaceConstraintsRequest handles getEffectivePlaceConstraints operation.
 //
-// Returns available time slots for this place.
-// Availability is computed as: All valid time slot intervals
-// MINUS blocking periods (own + inherited from area + building)
-// MINUS existing reservations.
+// Returns the constraints that apply to bookings of the place. Every
+// field is taken from the place if set there, otherwise from its area,
+// otherwise from its building.
 //
-// GET /places/{placeId}/availability
-func (s *Server) handleGetPlaceAvailabilityRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /places/{placeId}/constraints/effective
+func (s *Server) handleGetEffectivePlaceConstraintsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPlaceAvailability"),
+		otelogen.OperationID("getEffectivePlaceConstraints"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/places/{placeId}/availability"),
+		semconv.HTTPRouteKey.String("/places/{placeId}/constraints/effective"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPlaceAvailabilityOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetEffectivePlaceConstraintsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9546,11 +10029,11 @@ func (s *Server) handleGetPlaceAvailabilityRequest(args [1]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetPlaceAvailabilityOperation,
-			ID:   "getPlaceAvailability",
+			Name: GetEffectivePlaceConstraintsOperation,
+			ID:   "getEffectivePlaceConstraints",
 		}
 	)
-	params, err := decodeGetPlaceAvailabilityParams(args, argsEscaped, r)
+	params, err := decodeGetEffectivePlaceConstraintsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -9563,24 +10046,16 @@ func (s *Server) handleGetPlaceAvailabilityRequest(args [1]string, argsEscaped b
 
 	var rawBody []byte
 
-	var response GetPlaceAvailabilityRes
+	var response GetEffectivePlaceConstraintsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetPlaceAvailabilityOperation,
-			OperationSummary: "Get availability calendar with time slots",
-			OperationID:      "getPlaceAvailability",
+			OperationName:    GetEffectivePlaceConstraintsOperation,
+			OperationSummary: "Get effective place constraints",
+			OperationID:      "getEffectivePlaceConstraints",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
-				{
-					Name: "startDate",
-					In:   "query",
-				}: params.StartDate,
-				{
-					Name: "endDate",
-					In:   "query",
-				}: params.EndDate,
 				{
 					Name: "placeId",
 					In:   "path",
@@ -9591,8 +10066,8 @@ func (s *Server) handleGetPlaceAvailabilityRequest(args [1]string, argsEscaped b
 
 		type (
 			Request  = struct{}
-			Params   = GetPlaceAvailabilityParams
-			Response = GetPlaceAvailabilityRes
+			Params   = GetEffectivePlaceConstraintsParams
+			Response = GetEffectivePlaceConstraintsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9601,14 +10076,14 @@ func (s *Server) handleGetPlaceAvailabilityRequest(args [1]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackGetPlaceAvailabilityParams,
+			unpackGetEffectivePlaceConstraintsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetPlaceAvailability(ctx, params)
+				response, err = s.h.GetEffectivePlaceConstraints(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetPlaceAvailability(ctx, params)
+		response, err = s.h.GetEffectivePlaceConstraints(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -9616,7 +10091,7 @@ func (s *Server) handleGetPlaceAvailabilityRequest(args [1]string, argsEscaped b
 		return
 	}
 
-	if err := encodeGetPlaceAvailabilityResponse(response, w, span); err != nil {
+	if err := encodeGetEffectivePlaceConstraintsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9625,24 +10100,22 @@ func (s *Server) handleGetPlaceAvailabilityRequest(args [1]string, argsEscaped b
 	}
 }
 
-// handleGetPlaceBlockingRequest handles getPlaceBlocking operation.
+// handleGetEquipmentRequest handles getEquipment operation.
 //
-// Returns blocking periods for this place.
-// Use includeInherited=true to see blocking inherited from area and building.
-// Blocking = times when the place is NOT available.
+// Get equipment type details.
 //
-// GET /places/{placeId}/blocking
-func (s *Server) handleGetPlaceBlockingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /equipment/{equipmentId}
+func (s *Server) handleGetEquipmentRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPlaceBlocking"),
+		otelogen.OperationID("getEquipment"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/places/{placeId}/blocking"),
+		semconv.HTTPRouteKey.String("/equipment/{equipmentId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPlaceBlockingOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetEquipmentOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9697,11 +10170,11 @@ func (s *Server) handleGetPlaceBlockingRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetPlaceBlockingOperation,
-			ID:   "getPlaceBlocking",
+			Name: GetEquipmentOperation,
+			ID:   "getEquipment",
 		}
 	)
-	params, err := decodeGetPlaceBlockingParams(args, argsEscaped, r)
+	params, err := decodeGetEquipmentParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -9714,44 +10187,28 @@ func (s *Server) handleGetPlaceBlockingRequest(args [1]string, argsEscaped bool,
 
 	var rawBody []byte
 
-	var response GetPlaceBlockingRes
+	var response GetEquipmentRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetPlaceBlockingOperation,
-			OperationSummary: "Get place blocking periods",
-			OperationID:      "getPlaceBlocking",
+			OperationName:    GetEquipmentOperation,
+			OperationSummary: "Get equipment type details",
+			OperationID:      "getEquipment",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "includeInherited",
-					In:   "query",
-				}: params.IncludeInherited,
-				{
-					Name: "includeRecurring",
-					In:   "query",
-				}: params.IncludeRecurring,
-				{
-					Name: "startDate",
-					In:   "query",
-				}: params.StartDate,
-				{
-					Name: "endDate",
-					In:   "query",
-				}: params.EndDate,
-				{
-					Name: "placeId",
+					Name: "equipmentId",
 					In:   "path",
-				}: params.PlaceId,
+				}: params.EquipmentId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetPlaceBlockingParams
-			Response = GetPlaceBlockingRes
+			Params   = GetEquipmentParams
+			Response = GetEquipmentRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9760,14 +10217,14 @@ func (s *Server) handleGetPlaceBlockingRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetPlaceBlockingParams,
+			unpackGetEquipmentParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetPlaceBlocking(ctx, params)
+				response, err = s.h.GetEquipment(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetPlaceBlocking(ctx, params)
+		response, err = s.h.GetEquipment(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -9775,7 +10232,7 @@ func (s *Server) handleGetPlaceBlockingRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetPlaceBlockingResponse(response, w, span); err != nil {
+	if err := encodeGetEquipmentResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9784,22 +10241,22 @@ func (s *Server) handleGetPlaceBlockingRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleGetPlaceCalendarRequest handles getPlaceCalendar operation.
+// handleGetGroupRequest handles getGroup operation.
 //
-// Returns .ics file with blocking periods and reservations (next 3 months).
+// Get group details.
 //
-// GET /places/{placeId}/calendar.ics
-func (s *Server) handleGetPlaceCalendarRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /groups/{groupId}
+func (s *Server) handleGetGroupRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPlaceCalendar"),
+		otelogen.OperationID("getGroup"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/places/{placeId}/calendar.ics"),
+		semconv.HTTPRouteKey.String("/groups/{groupId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPlaceCalendarOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetGroupOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9854,16 +10311,78 @@ func (s *Server) handleGetPlaceCalendarRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetPlaceCalendarOperation,
-			ID:   "getPlaceCalendar",
+			Name: GetGroupOperation,
+			ID:   "getGroup",
 		}
 	)
-	params, err := decodeGetPlaceCalendarParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetGroupOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKeyAuth(ctx, GetGroupOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetGroupParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
 		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
@@ -9871,28 +10390,28 @@ func (s *Server) handleGetPlaceCalendarRequest(args [1]string, argsEscaped bool,
 
 	var rawBody []byte
 
-	var response GetPlaceCalendarRes
+	var response GetGroupRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetPlaceCalendarOperation,
-			OperationSummary: "Public iCalendar feed for place",
-			OperationID:      "getPlaceCalendar",
+			OperationName:    GetGroupOperation,
+			OperationSummary: "Get group details",
+			OperationID:      "getGroup",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "placeId",
+					Name: "groupId",
 					In:   "path",
-				}: params.PlaceId,
+				}: params.GroupId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetPlaceCalendarParams
-			Response = GetPlaceCalendarRes
+			Params   = GetGroupParams
+			Response = GetGroupRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9901,14 +10420,14 @@ func (s *Server) handleGetPlaceCalendarRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetPlaceCalendarParams,
+			unpackGetGroupParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetPlaceCalendar(ctx, params)
+				response, err = s.h.GetGroup(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetPlaceCalendar(ctx, params)
+		response, err = s.h.GetGroup(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -9916,7 +10435,7 @@ func (s *Server) handleGetPlaceCalendarRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetPlaceCalendarResponse(response, w, span); err != nil {
+	if err := encodeGetGroupResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9925,22 +10444,22 @@ func (s *Server) handleGetPlaceCalendarRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleGetPlaceConstraintsRequest handles getPlaceConstraints operation.
+// handleGetGroupMembersRequest handles getGroupMembers operation.
 //
-// Get place constraints.
+// Get group members.
 //
-// GET /places/{placeId}/constraints
-func (s *Server) handleGetPlaceConstraintsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /groups/{groupId}/members
+func (s *Server) handleGetGroupMembersRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPlaceConstraints"),
+		otelogen.OperationID("getGroupMembers"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/places/{placeId}/constraints"),
+		semconv.HTTPRouteKey.String("/groups/{groupId}/members"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPlaceConstraintsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetGroupMembersOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9995,11 +10514,73 @@ func (s *Server) handleGetPlaceConstraintsRequest(args [1]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetPlaceConstraintsOperation,
-			ID:   "getPlaceConstraints",
+			Name: GetGroupMembersOperation,
+			ID:   "getGroupMembers",
 		}
 	)
-	params, err := decodeGetPlaceConstraintsParams(args, argsEscaped, r)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetGroupMembersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKeyAuth(ctx, GetGroupMembersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetGroupMembersParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -10012,28 +10593,28 @@ func (s *Server) handleGetPlaceConstraintsRequest(args [1]string, argsEscaped bo
 
 	var rawBody []byte
 
-	var response GetPlaceConstraintsRes
+	var response GetGroupMembersRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetPlaceConstraintsOperation,
-			OperationSummary: "Get place constraints",
-			OperationID:      "getPlaceConstraints",
+			OperationName:    GetGroupMembersOperation,
+			OperationSummary: "Get group members",
+			OperationID:      "getGroupMembers",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "placeId",
+					Name: "groupId",
 					In:   "path",
-				}: params.PlaceId,
+				}: params.GroupId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetPlaceConstraintsParams
-			Response = GetPlaceConstraintsRes
+			Params   = GetGroupMembersParams
+			Response = GetGroupMembersRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10042,14 +10623,14 @@ func (s *Server) handleGetPlaceConstraintsRequest(args [1]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackGetPlaceConstraintsParams,
+			unpackGetGroupMembersParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetPlaceConstraints(ctx, params)
+				response, err = s.h.GetGroupMembers(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetPlaceConstraints(ctx, params)
+		response, err = s.h.GetGroupMembers(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -10057,7 +10638,7 @@ func (s *Server) handleGetPlaceConstraintsRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeGetPlaceConstraintsResponse(response, w, span); err != nil {
+	if err := encodeGetGroupMembersResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10066,22 +10647,22 @@ func (s *Server) handleGetPlaceConstraintsRequest(args [1]string, argsEscaped bo
 	}
 }
 
-// handleGetPlaceEquipmentRequest handles getPlaceEquipment operation.
+// handleGetGroupPermissionsRequest handles getGroupPermissions operation.
 //
-// Get place equipment/amenities.
+// Get group permissions.
 //
-// GET /places/{placeId}/equipment
-func (s *Server) handleGetPlaceEquipmentRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /groups/{groupId}/permissions
+func (s *Server) handleGetGroupPermissionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPlaceEquipment"),
+		otelogen.OperationID("getGroupPermissions"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/places/{placeId}/equipment"),
+		semconv.HTTPRouteKey.String("/groups/{groupId}/permissions"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPlaceEquipmentOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetGroupPermissionsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10136,173 +10717,32 @@ func (s *Server) handleGetPlaceEquipmentRequest(args [1]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetPlaceEquipmentOperation,
-			ID:   "getPlaceEquipment",
+			Name: GetGroupPermissionsOperation,
+			ID:   "getGroupPermissions",
 		}
 	)
-	params, err := decodeGetPlaceEquipmentParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-
-	var rawBody []byte
-
-	var response GetPlaceEquipmentRes
-	if m := s.cfg.Middleware; m != nil {
-		mreq := middleware.Request{
-			Context:          ctx,
-			OperationName:    GetPlaceEquipmentOperation,
-			OperationSummary: "Get place equipment/amenities",
-			OperationID:      "getPlaceEquipment",
-			Body:             nil,
-			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "placeId",
-					In:   "path",
-				}: params.PlaceId,
-			},
-			Raw: r,
-		}
-
-		type (
-			Request  = struct{}
-			Params   = GetPlaceEquipmentParams
-			Response = GetPlaceEquipmentRes
-		)
-		response, err = middleware.HookMiddleware[
-			Request,
-			Params,
-			Response,
-		](
-			m,
-			mreq,
-			unpackGetPlaceEquipmentParams,
-			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetPlaceEquipment(ctx, params)
-				return response, err
-			},
-		)
-	} else {
-		response, err = s.h.GetPlaceEquipment(ctx, params)
-	}
-	if err != nil {
-		defer recordError("Internal", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-
-	if err := encodeGetPlaceEquipmentResponse(response, w, span); err != nil {
-		defer recordError("EncodeResponse", err)
-		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
-			s.cfg.ErrorHandler(ctx, w, r, err)
-		}
-		return
-	}
-}
-
-// handleGetPlaceQrCodeRequest handles getPlaceQrCode operation.
-//
-// Generate QR code.
-//
-// GET /places/{placeId}/qrCode
-func (s *Server) handleGetPlaceQrCodeRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
-	statusWriter := &codeRecorder{ResponseWriter: w}
-	w = statusWriter
-	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPlaceQrCode"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/places/{placeId}/qrCode"),
-	}
-
-	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPlaceQrCodeOperation,
-		trace.WithAttributes(otelAttrs...),
-		serverSpanKind,
-	)
-	defer span.End()
-
-	// Add Labeler to context.
-	labeler := &Labeler{attrs: otelAttrs}
-	ctx = contextWithLabeler(ctx, labeler)
-
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		elapsedDuration := time.Since(startTime)
-
-		attrSet := labeler.AttributeSet()
-		attrs := attrSet.ToSlice()
-		code := statusWriter.status
-		if code != 0 {
-			codeAttr := semconv.HTTPResponseStatusCode(code)
-			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
-		}
-		attrOpt := metric.WithAttributes(attrs...)
-
-		// Increment request counter.
-		s.requests.Add(ctx, 1, attrOpt)
-
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
-	}()
-
-	var (
-		recordError = func(stage string, err error) {
-			span.RecordError(err)
-
-			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
-			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
-			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
-			// max redirects exceeded), in which case status MUST be set to Error.
-			code := statusWriter.status
-			if code < 100 || code >= 500 {
-				span.SetStatus(codes.Error, stage)
-			}
-
-			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
-			if code != 0 {
-				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
-			}
-
-			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
-		}
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: GetPlaceQrCodeOperation,
-			ID:   "getPlaceQrCode",
-		}
-	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetPlaceQrCodeOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "BearerAuth",
-					Err:              err,
-				}
-				defer recordError("Security:BearerAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetGroupPermissionsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, GetPlaceQrCodeOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, GetGroupPermissionsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10343,7 +10783,7 @@ func (s *Server) handleGetPlaceQrCodeRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeGetPlaceQrCodeParams(args, argsEscaped, r)
+	params, err := decodeGetGroupPermissionsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -10356,40 +10796,28 @@ func (s *Server) handleGetPlaceQrCodeRequest(args [1]string, argsEscaped bool, w
 
 	var rawBody []byte
 
-	var response GetPlaceQrCodeRes
+	var response GetGroupPermissionsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetPlaceQrCodeOperation,
-			OperationSummary: "Generate QR code",
-			OperationID:      "getPlaceQrCode",
+			OperationName:    GetGroupPermissionsOperation,
+			OperationSummary: "Get group permissions",
+			OperationID:      "getGroupPermissions",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "templateId",
-					In:   "query",
-				}: params.TemplateId,
-				{
-					Name: "format",
-					In:   "query",
-				}: params.Format,
-				{
-					Name: "includeDetails",
-					In:   "query",
-				}: params.IncludeDetails,
-				{
-					Name: "placeId",
+					Name: "groupId",
 					In:   "path",
-				}: params.PlaceId,
+				}: params.GroupId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetPlaceQrCodeParams
-			Response = GetPlaceQrCodeRes
+			Params   = GetGroupPermissionsParams
+			Response = GetGroupPermissionsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10398,14 +10826,14 @@ func (s *Server) handleGetPlaceQrCodeRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetPlaceQrCodeParams,
+			unpackGetGroupPermissionsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetPlaceQrCode(ctx, params)
+				response, err = s.h.GetGroupPermissions(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetPlaceQrCode(ctx, params)
+		response, err = s.h.GetGroupPermissions(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -10413,7 +10841,7 @@ func (s *Server) handleGetPlaceQrCodeRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetPlaceQrCodeResponse(response, w, span); err != nil {
+	if err := encodeGetGroupPermissionsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10422,24 +10850,22 @@ func (s *Server) handleGetPlaceQrCodeRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleGetPlaceTimeSlotsRequest handles getPlaceTimeSlots operation.
+// handleGetPlaceRequest handles getPlace operation.
 //
-// Returns the booking grid configuration for this place.
-// Reservations must start and end on these interval boundaries.
-// This does NOT define availability - blocking periods define unavailability.
+// Get place details.
 //
-// GET /places/{placeId}/timeSlots
-func (s *Server) handleGetPlaceTimeSlotsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /places/{placeId}
+func (s *Server) handleGetPlaceRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPlaceTimeSlots"),
+		otelogen.OperationID("getPlace"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/places/{placeId}/timeSlots"),
+		semconv.HTTPRouteKey.String("/places/{placeId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPlaceTimeSlotsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPlaceOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10494,11 +10920,11 @@ func (s *Server) handleGetPlaceTimeSlotsRequest(args [1]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetPlaceTimeSlotsOperation,
-			ID:   "getPlaceTimeSlots",
+			Name: GetPlaceOperation,
+			ID:   "getPlace",
 		}
 	)
-	params, err := decodeGetPlaceTimeSlotsParams(args, argsEscaped, r)
+	params, err := decodeGetPlaceParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -10511,13 +10937,13 @@ func (s *Server) handleGetPlaceTimeSlotsRequest(args [1]string, argsEscaped bool
 
 	var rawBody []byte
 
-	var response GetPlaceTimeSlotsRes
+	var response GetPlaceRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetPlaceTimeSlotsOperation,
-			OperationSummary: "Get configured time slot intervals",
-			OperationID:      "getPlaceTimeSlots",
+			OperationName:    GetPlaceOperation,
+			OperationSummary: "Get place details",
+			OperationID:      "getPlace",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -10531,8 +10957,8 @@ func (s *Server) handleGetPlaceTimeSlotsRequest(args [1]string, argsEscaped bool
 
 		type (
 			Request  = struct{}
-			Params   = GetPlaceTimeSlotsParams
-			Response = GetPlaceTimeSlotsRes
+			Params   = GetPlaceParams
+			Response = GetPlaceRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10541,14 +10967,14 @@ func (s *Server) handleGetPlaceTimeSlotsRequest(args [1]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			unpackGetPlaceTimeSlotsParams,
+			unpackGetPlaceParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetPlaceTimeSlots(ctx, params)
+				response, err = s.h.GetPlace(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetPlaceTimeSlots(ctx, params)
+		response, err = s.h.GetPlace(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -10556,7 +10982,7 @@ func (s *Server) handleGetPlaceTimeSlotsRequest(args [1]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeGetPlaceTimeSlotsResponse(response, w, span); err != nil {
+	if err := encodeGetPlaceResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10565,22 +10991,27 @@ func (s *Server) handleGetPlaceTimeSlotsRequest(args [1]string, argsEscaped bool
 	}
 }
 
-// handleGetPlaceWhitelistRequest handles getPlaceWhitelist operation.
+// handleGetPlaceAvailabilityRequest handles getPlaceAvailability operation.
 //
-// Get user whitelist.
+// Returns available time slots for this place.
+// Availability is computed as: All valid time slot intervals
+// MINUS blocking periods (own + inherited from area + building)
+// MINUS existing reservations.
+// Ranges longer than 31 days are truncated. Reserved slots never
+// include details about the user holding the reservation.
 //
-// GET /places/{placeId}/whitelist
-func (s *Server) handleGetPlaceWhitelistRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /places/{placeId}/availability
+func (s *Server) handleGetPlaceAvailabilityRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPlaceWhitelist"),
+		otelogen.OperationID("getPlaceAvailability"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/places/{placeId}/whitelist"),
+		semconv.HTTPRouteKey.String("/places/{placeId}/availability"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPlaceWhitelistOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPlaceAvailabilityOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10635,73 +11066,11 @@ func (s *Server) handleGetPlaceWhitelistRequest(args [1]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetPlaceWhitelistOperation,
-			ID:   "getPlaceWhitelist",
+			Name: GetPlaceAvailabilityOperation,
+			ID:   "getPlaceAvailability",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetPlaceWhitelistOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "BearerAuth",
-					Err:              err,
-				}
-				defer recordError("Security:BearerAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, GetPlaceWhitelistOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "ApiKeyAuth",
-					Err:              err,
-				}
-				defer recordError("Security:ApiKeyAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 1
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-				{0b00000010},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
-		}
-	}
-	params, err := decodeGetPlaceWhitelistParams(args, argsEscaped, r)
+	params, err := decodeGetPlaceAvailabilityParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -10714,16 +11083,24 @@ func (s *Server) handleGetPlaceWhitelistRequest(args [1]string, argsEscaped bool
 
 	var rawBody []byte
 
-	var response GetPlaceWhitelistRes
+	var response GetPlaceAvailabilityRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetPlaceWhitelistOperation,
-			OperationSummary: "Get user whitelist",
-			OperationID:      "getPlaceWhitelist",
+			OperationName:    GetPlaceAvailabilityOperation,
+			OperationSummary: "Get availability calendar with time slots",
+			OperationID:      "getPlaceAvailability",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
+				{
+					Name: "startDate",
+					In:   "query",
+				}: params.StartDate,
+				{
+					Name: "endDate",
+					In:   "query",
+				}: params.EndDate,
 				{
 					Name: "placeId",
 					In:   "path",
@@ -10734,8 +11111,8 @@ func (s *Server) handleGetPlaceWhitelistRequest(args [1]string, argsEscaped bool
 
 		type (
 			Request  = struct{}
-			Params   = GetPlaceWhitelistParams
-			Response = GetPlaceWhitelistRes
+			Params   = GetPlaceAvailabilityParams
+			Response = GetPlaceAvailabilityRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10744,14 +11121,14 @@ func (s *Server) handleGetPlaceWhitelistRequest(args [1]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			unpackGetPlaceWhitelistParams,
+			unpackGetPlaceAvailabilityParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetPlaceWhitelist(ctx, params)
+				response, err = s.h.GetPlaceAvailability(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetPlaceWhitelist(ctx, params)
+		response, err = s.h.GetPlaceAvailability(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -10759,7 +11136,7 @@ func (s *Server) handleGetPlaceWhitelistRequest(args [1]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeGetPlaceWhitelistResponse(response, w, span); err != nil {
+	if err := encodeGetPlaceAvailabilityResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10768,22 +11145,24 @@ func (s *Server) handleGetPlaceWhitelistRequest(args [1]string, argsEscaped bool
 	}
 }
 
-// handleGetQrTemplateRequest handles getQrTemplate operation.
+// handleGetPlaceBlockingRequest handles getPlaceBlocking operation.
 //
-// Get template details.
+// Returns blocking periods for this place.
+// Use includeInherited=true to see blocking inherited from area and building.
+// Blocking = times when the place is NOT available.
 //
-// GET /qrTemplates/{templateId}
-func (s *Server) handleGetQrTemplateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /places/{placeId}/blocking
+func (s *Server) handleGetPlaceBlockingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getQrTemplate"),
+		otelogen.OperationID("getPlaceBlocking"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/qrTemplates/{templateId}"),
+		semconv.HTTPRouteKey.String("/places/{placeId}/blocking"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetQrTemplateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPlaceBlockingOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10838,73 +11217,11 @@ func (s *Server) handleGetQrTemplateRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetQrTemplateOperation,
-			ID:   "getQrTemplate",
+			Name: GetPlaceBlockingOperation,
+			ID:   "getPlaceBlocking",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetQrTemplateOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "BearerAuth",
-					Err:              err,
-				}
-				defer recordError("Security:BearerAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, GetQrTemplateOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "ApiKeyAuth",
-					Err:              err,
-				}
-				defer recordError("Security:ApiKeyAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 1
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-				{0b00000010},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
-		}
-	}
-	params, err := decodeGetQrTemplateParams(args, argsEscaped, r)
+	params, err := decodeGetPlaceBlockingParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -10917,28 +11234,44 @@ func (s *Server) handleGetQrTemplateRequest(args [1]string, argsEscaped bool, w
 
 	var rawBody []byte
 
-	var response GetQrTemplateRes
+	var response GetPlaceBlockingRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetQrTemplateOperation,
-			OperationSummary: "Get template details",
-			OperationID:      "getQrTemplate",
+			OperationName:    GetPlaceBlockingOperation,
+			OperationSummary: "Get place blocking periods",
+			OperationID:      "getPlaceBlocking",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "templateId",
+					Name: "includeInherited",
+					In:   "query",
+				}: params.IncludeInherited,
+				{
+					Name: "includeRecurring",
+					In:   "query",
+				}: params.IncludeRecurring,
+				{
+					Name: "startDate",
+					In:   "query",
+				}: params.StartDate,
+				{
+					Name: "endDate",
+					In:   "query",
+				}: params.EndDate,
+				{
+					Name: "placeId",
 					In:   "path",
-				}: params.TemplateId,
+				}: params.PlaceId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetQrTemplateParams
-			Response = GetQrTemplateRes
+			Params   = GetPlaceBlockingParams
+			Response = GetPlaceBlockingRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10947,14 +11280,14 @@ func (s *Server) handleGetQrTemplateRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetQrTemplateParams,
+			unpackGetPlaceBlockingParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetQrTemplate(ctx, params)
+				response, err = s.h.GetPlaceBlocking(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetQrTemplate(ctx, params)
+		response, err = s.h.GetPlaceBlocking(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -10962,7 +11295,7 @@ func (s *Server) handleGetQrTemplateRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetQrTemplateResponse(response, w, span); err != nil {
+	if err := encodeGetPlaceBlockingResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10971,22 +11304,24 @@ func (s *Server) handleGetQrTemplateRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleGetReservationRequest handles getReservation operation.
+// handleGetPlaceCalendarRequest handles getPlaceCalendar operation.
 //
-// Get reservation details.
+// Returns .ics file with blocking periods and reservations (next 3 months).
+// Blockings inherited from the area and building are included. Reservations never include
+// details about the user holding them; recurring blockings are emitted as RRULE/EXDATE.
 //
-// GET /reservations/{reservationId}
-func (s *Server) handleGetReservationRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /places/{placeId}/calendar.ics
+func (s *Server) handleGetPlaceCalendarRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getReservation"),
+		otelogen.OperationID("getPlaceCalendar"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/reservations/{reservationId}"),
+		semconv.HTTPRouteKey.String("/places/{placeId}/calendar.ics"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetReservationOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPlaceCalendarOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11041,77 +11376,15 @@ func (s *Server) handleGetReservationRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetReservationOperation,
-			ID:   "getReservation",
+			Name: GetPlaceCalendarOperation,
+			ID:   "getPlaceCalendar",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetReservationOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "BearerAuth",
-					Err:              err,
-				}
-				defer recordError("Security:BearerAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, GetReservationOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "ApiKeyAuth",
-					Err:              err,
-				}
-				defer recordError("Security:ApiKeyAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 1
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-				{0b00000010},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
-		}
-	}
-	params, err := decodeGetReservationParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
+	params, err := decodeGetPlaceCalendarParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
 		}
 		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11120,28 +11393,32 @@ func (s *Server) handleGetReservationRequest(args [1]string, argsEscaped bool, w
 
 	var rawBody []byte
 
-	var response GetReservationRes
+	var response GetPlaceCalendarRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetReservationOperation,
-			OperationSummary: "Get reservation details",
-			OperationID:      "getReservation",
+			OperationName:    GetPlaceCalendarOperation,
+			OperationSummary: "Public iCalendar feed for place",
+			OperationID:      "getPlaceCalendar",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "reservationId",
+					Name: "If-None-Match",
+					In:   "header",
+				}: params.IfNoneMatch,
+				{
+					Name: "placeId",
 					In:   "path",
-				}: params.ReservationId,
+				}: params.PlaceId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetReservationParams
-			Response = GetReservationRes
+			Params   = GetPlaceCalendarParams
+			Response = GetPlaceCalendarRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11150,14 +11427,14 @@ func (s *Server) handleGetReservationRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetReservationParams,
+			unpackGetPlaceCalendarParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetReservation(ctx, params)
+				response, err = s.h.GetPlaceCalendar(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetReservation(ctx, params)
+		response, err = s.h.GetPlaceCalendar(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -11165,7 +11442,7 @@ func (s *Server) handleGetReservationRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetReservationResponse(response, w, span); err != nil {
+	if err := encodeGetPlaceCalendarResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11174,23 +11451,24 @@ func (s *Server) handleGetReservationRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleGetReservationShareLinkRequest handles getReservationShareLink operation.
+// handleGetPlaceConstraintsRequest handles getPlaceConstraints operation.
 //
-// Returns a shareable URL and Open Graph / Twitter Card metadata
-// for rich link previews in chat applications.
+// Returns the constraints set on the place itself. Unset fields are
+// inherited from the area and the building of the place; see
+// getEffectivePlaceConstraints for the constraints that apply.
 //
-// GET /reservations/{reservationId}/share
-func (s *Server) handleGetReservationShareLinkRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /places/{placeId}/constraints
+func (s *Server) handleGetPlaceConstraintsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getReservationShareLink"),
+		otelogen.OperationID("getPlaceConstraints"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/reservations/{reservationId}/share"),
+		semconv.HTTPRouteKey.String("/places/{placeId}/constraints"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetReservationShareLinkOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPlaceConstraintsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11245,73 +11523,11 @@ func (s *Server) handleGetReservationShareLinkRequest(args [1]string, argsEscape
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetReservationShareLinkOperation,
-			ID:   "getReservationShareLink",
+			Name: GetPlaceConstraintsOperation,
+			ID:   "getPlaceConstraints",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetReservationShareLinkOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "BearerAuth",
-					Err:              err,
-				}
-				defer recordError("Security:BearerAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, GetReservationShareLinkOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "ApiKeyAuth",
-					Err:              err,
-				}
-				defer recordError("Security:ApiKeyAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 1
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-				{0b00000010},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
-		}
-	}
-	params, err := decodeGetReservationShareLinkParams(args, argsEscaped, r)
+	params, err := decodeGetPlaceConstraintsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -11324,28 +11540,28 @@ func (s *Server) handleGetReservationShareLinkRequest(args [1]string, argsEscape
 
 	var rawBody []byte
 
-	var response GetReservationShareLinkRes
+	var response GetPlaceConstraintsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetReservationShareLinkOperation,
-			OperationSummary: "Get shareable reservation link with preview metadata",
-			OperationID:      "getReservationShareLink",
+			OperationName:    GetPlaceConstraintsOperation,
+			OperationSummary: "Get place constraints",
+			OperationID:      "getPlaceConstraints",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "reservationId",
+					Name: "placeId",
 					In:   "path",
-				}: params.ReservationId,
+				}: params.PlaceId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetReservationShareLinkParams
-			Response = GetReservationShareLinkRes
+			Params   = GetPlaceConstraintsParams
+			Response = GetPlaceConstraintsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11354,14 +11570,14 @@ func (s *Server) handleGetReservationShareLinkRequest(args [1]string, argsEscape
 		](
 			m,
 			mreq,
-			unpackGetReservationShareLinkParams,
+			unpackGetPlaceConstraintsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetReservationShareLink(ctx, params)
+				response, err = s.h.GetPlaceConstraints(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetReservationShareLink(ctx, params)
+		response, err = s.h.GetPlaceConstraints(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -11369,7 +11585,7 @@ func (s *Server) handleGetReservationShareLinkRequest(args [1]string, argsEscape
 		return
 	}
 
-	if err := encodeGetReservationShareLinkResponse(response, w, span); err != nil {
+	if err := encodeGetPlaceConstraintsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11378,22 +11594,22 @@ func (s *Server) handleGetReservationShareLinkRequest(args [1]string, argsEscape
 	}
 }
 
-// handleGetStatisticsRequest handles getStatistics operation.
+// handleGetPlaceEquipmentRequest handles getPlaceEquipment operation.
 //
-// Get overall statistics.
+// Get place equipment/amenities.
 //
-// GET /statistics
-func (s *Server) handleGetStatisticsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /places/{placeId}/equipment
+func (s *Server) handleGetPlaceEquipmentRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getStatistics"),
+		otelogen.OperationID("getPlaceEquipment"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/statistics"),
+		semconv.HTTPRouteKey.String("/places/{placeId}/equipment"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetStatisticsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPlaceEquipmentOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11448,92 +11664,45 @@ func (s *Server) handleGetStatisticsRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetStatisticsOperation,
-			ID:   "getStatistics",
+			Name: GetPlaceEquipmentOperation,
+			ID:   "getPlaceEquipment",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetStatisticsOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "BearerAuth",
-					Err:              err,
-				}
-				defer recordError("Security:BearerAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, GetStatisticsOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "ApiKeyAuth",
-					Err:              err,
-				}
-				defer recordError("Security:ApiKeyAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 1
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-				{0b00000010},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
+	params, err := decodeGetPlaceEquipmentParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
 		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
 	}
 
 	var rawBody []byte
 
-	var response GetStatisticsRes
+	var response GetPlaceEquipmentRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetStatisticsOperation,
-			OperationSummary: "Get overall statistics",
-			OperationID:      "getStatistics",
+			OperationName:    GetPlaceEquipmentOperation,
+			OperationSummary: "Get place equipment/amenities",
+			OperationID:      "getPlaceEquipment",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "placeId",
+					In:   "path",
+				}: params.PlaceId,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = GetStatisticsRes
+			Params   = GetPlaceEquipmentParams
+			Response = GetPlaceEquipmentRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11542,14 +11711,14 @@ func (s *Server) handleGetStatisticsRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetPlaceEquipmentParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetStatistics(ctx)
+				response, err = s.h.GetPlaceEquipment(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetStatistics(ctx)
+		response, err = s.h.GetPlaceEquipment(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -11557,7 +11726,7 @@ func (s *Server) handleGetStatisticsRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetStatisticsResponse(response, w, span); err != nil {
+	if err := encodeGetPlaceEquipmentResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11566,22 +11735,25 @@ func (s *Server) handleGetStatisticsRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleGetUsageStatisticsRequest handles getUsageStatistics operation.
+// handleGetPlaceQrCodeRequest handles getPlaceQrCode operation.
 //
-// Get usage statistics over time.
+// Renders the printable label of the place at 300 DPI. The QR symbol encodes the check-in
+// URL of the place; the requested details are printed below it in the order name,
+// areaName, buildingName. PNG and JPEG images carry their resolution, PDFs consist of a
+// single page of the size of the label.
 //
-// GET /statistics/usage
-func (s *Server) handleGetUsageStatisticsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /places/{placeId}/qrCode
+func (s *Server) handleGetPlaceQrCodeRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getUsageStatistics"),
+		otelogen.OperationID("getPlaceQrCode"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/statistics/usage"),
+		semconv.HTTPRouteKey.String("/places/{placeId}/qrCode"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUsageStatisticsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPlaceQrCodeOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11636,15 +11808,15 @@ func (s *Server) handleGetUsageStatisticsRequest(args [0]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetUsageStatisticsOperation,
-			ID:   "getUsageStatistics",
+			Name: GetPlaceQrCodeOperation,
+			ID:   "getPlaceQrCode",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetUsageStatisticsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetPlaceQrCodeOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11661,7 +11833,7 @@ func (s *Server) handleGetUsageStatisticsRequest(args [0]string, argsEscaped boo
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, GetUsageStatisticsOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, GetPlaceQrCodeOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11702,7 +11874,7 @@ func (s *Server) handleGetUsageStatisticsRequest(args [0]string, argsEscaped boo
 			return
 		}
 	}
-	params, err := decodeGetUsageStatisticsParams(args, argsEscaped, r)
+	params, err := decodeGetPlaceQrCodeParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -11715,39 +11887,31 @@ func (s *Server) handleGetUsageStatisticsRequest(args [0]string, argsEscaped boo
 
 	var rawBody []byte
 
-	var response GetUsageStatisticsRes
+	var response GetPlaceQrCodeRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetUsageStatisticsOperation,
-			OperationSummary: "Get usage statistics over time",
-			OperationID:      "getUsageStatistics",
+			OperationName:    GetPlaceQrCodeOperation,
+			OperationSummary: "Generate QR code",
+			OperationID:      "getPlaceQrCode",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "period",
-					In:   "query",
-				}: params.Period,
-				{
-					Name: "startDate",
-					In:   "query",
-				}: params.StartDate,
-				{
-					Name: "endDate",
+					Name: "templateId",
 					In:   "query",
-				}: params.EndDate,
+				}: params.TemplateId,
 				{
-					Name: "buildingId",
+					Name: "format",
 					In:   "query",
-				}: params.BuildingId,
+				}: params.Format,
 				{
-					Name: "areaId",
+					Name: "includeDetails",
 					In:   "query",
-				}: params.AreaId,
+				}: params.IncludeDetails,
 				{
 					Name: "placeId",
-					In:   "query",
+					In:   "path",
 				}: params.PlaceId,
 			},
 			Raw: r,
@@ -11755,8 +11919,8 @@ func (s *Server) handleGetUsageStatisticsRequest(args [0]string, argsEscaped boo
 
 		type (
 			Request  = struct{}
-			Params   = GetUsageStatisticsParams
-			Response = GetUsageStatisticsRes
+			Params   = GetPlaceQrCodeParams
+			Response = GetPlaceQrCodeRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11765,14 +11929,14 @@ func (s *Server) handleGetUsageStatisticsRequest(args [0]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackGetUsageStatisticsParams,
+			unpackGetPlaceQrCodeParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetUsageStatistics(ctx, params)
+				response, err = s.h.GetPlaceQrCode(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetUsageStatistics(ctx, params)
+		response, err = s.h.GetPlaceQrCode(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -11780,7 +11944,7 @@ func (s *Server) handleGetUsageStatisticsRequest(args [0]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeGetUsageStatisticsResponse(response, w, span); err != nil {
+	if err := encodeGetPlaceQrCodeResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11789,22 +11953,22 @@ func (s *Server) handleGetUsageStatisticsRequest(args [0]string, argsEscaped boo
 	}
 }
 
-// handleGetUserRequest handles getUser operation.
+// handleGetPlaceQrKeyRequest handles getPlaceQrKey operation.
 //
-// Get user details.
+// Returns the key version and the check-in URL encoded in the place's QR code.
 //
-// GET /users/{userId}
-func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /places/{placeId}/qrCode/key
+func (s *Server) handleGetPlaceQrKeyRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getUser"),
+		otelogen.OperationID("getPlaceQrKey"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/users/{userId}"),
+		semconv.HTTPRouteKey.String("/places/{placeId}/qrCode/key"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPlaceQrKeyOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11859,15 +12023,15 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetUserOperation,
-			ID:   "getUser",
+			Name: GetPlaceQrKeyOperation,
+			ID:   "getPlaceQrKey",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetUserOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetPlaceQrKeyOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11884,7 +12048,7 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, GetUserOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, GetPlaceQrKeyOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11901,31 +12065,2844 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 			}
 		}
 
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-				{0b00000010},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetPlaceQrKeyParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetPlaceQrKeyRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetPlaceQrKeyOperation,
+			OperationSummary: "Get the current QR key of a place",
+			OperationID:      "getPlaceQrKey",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "placeId",
+					In:   "path",
+				}: params.PlaceId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetPlaceQrKeyParams
+			Response = GetPlaceQrKeyRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetPlaceQrKeyParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetPlaceQrKey(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetPlaceQrKey(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetPlaceQrKeyResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetPlaceTimeSlotsRequest handles getPlaceTimeSlots operation.
+//
+// Returns the booking grid configuration for this place.
+// Reservations must start and end on these interval boundaries.
+// This does NOT define availability - blocking periods define unavailability.
+//
+// GET /places/{placeId}/timeSlots
+func (s *Server) handleGetPlaceTimeSlotsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getPlaceTimeSlots"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/places/{placeId}/timeSlots"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPlaceTimeSlotsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetPlaceTimeSlotsOperation,
+			ID:   "getPlaceTimeSlots",
+		}
+	)
+	params, err := decodeGetPlaceTimeSlotsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetPlaceTimeSlotsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetPlaceTimeSlotsOperation,
+			OperationSummary: "Get configured time slot intervals",
+			OperationID:      "getPlaceTimeSlots",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "placeId",
+					In:   "path",
+				}: params.PlaceId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetPlaceTimeSlotsParams
+			Response = GetPlaceTimeSlotsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetPlaceTimeSlotsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetPlaceTimeSlots(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetPlaceTimeSlots(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetPlaceTimeSlotsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetPlaceWhitelistRequest handles getPlaceWhitelist operation.
+//
+// Get user whitelist.
+//
+// GET /places/{placeId}/whitelist
+func (s *Server) handleGetPlaceWhitelistRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getPlaceWhitelist"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/places/{placeId}/whitelist"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPlaceWhitelistOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetPlaceWhitelistOperation,
+			ID:   "getPlaceWhitelist",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetPlaceWhitelistOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKeyAuth(ctx, GetPlaceWhitelistOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetPlaceWhitelistParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetPlaceWhitelistRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetPlaceWhitelistOperation,
+			OperationSummary: "Get user whitelist",
+			OperationID:      "getPlaceWhitelist",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "placeId",
+					In:   "path",
+				}: params.PlaceId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetPlaceWhitelistParams
+			Response = GetPlaceWhitelistRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetPlaceWhitelistParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetPlaceWhitelist(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetPlaceWhitelist(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetPlaceWhitelistResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetQrTemplateRequest handles getQrTemplate operation.
+//
+// Get template details.
+//
+// GET /qrTemplates/{templateId}
+func (s *Server) handleGetQrTemplateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getQrTemplate"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/qrTemplates/{templateId}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetQrTemplateOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetQrTemplateOperation,
+			ID:   "getQrTemplate",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetQrTemplateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKeyAuth(ctx, GetQrTemplateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetQrTemplateParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetQrTemplateRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetQrTemplateOperation,
+			OperationSummary: "Get template details",
+			OperationID:      "getQrTemplate",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "templateId",
+					In:   "path",
+				}: params.TemplateId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetQrTemplateParams
+			Response = GetQrTemplateRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetQrTemplateParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetQrTemplate(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetQrTemplate(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetQrTemplateResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetReservationRequest handles getReservation operation.
+//
+// Get reservation details.
+//
+// GET /reservations/{reservationId}
+func (s *Server) handleGetReservationRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getReservation"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/reservations/{reservationId}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetReservationOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetReservationOperation,
+			ID:   "getReservation",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetReservationOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKeyAuth(ctx, GetReservationOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetReservationParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetReservationRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetReservationOperation,
+			OperationSummary: "Get reservation details",
+			OperationID:      "getReservation",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "reservationId",
+					In:   "path",
+				}: params.ReservationId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetReservationParams
+			Response = GetReservationRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetReservationParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetReservation(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetReservation(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetReservationResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetReservationShareLinkRequest handles getReservationShareLink operation.
+//
+// Returns a shareable URL and Open Graph / Twitter Card metadata
+// for rich link previews in chat applications.
+//
+// GET /reservations/{reservationId}/share
+func (s *Server) handleGetReservationShareLinkRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getReservationShareLink"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/reservations/{reservationId}/share"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetReservationShareLinkOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetReservationShareLinkOperation,
+			ID:   "getReservationShareLink",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetReservationShareLinkOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKeyAuth(ctx, GetReservationShareLinkOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetReservationShareLinkParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetReservationShareLinkRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetReservationShareLinkOperation,
+			OperationSummary: "Get shareable reservation link with preview metadata",
+			OperationID:      "getReservationShareLink",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "reservationId",
+					In:   "path",
+				}: params.ReservationId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetReservationShareLinkParams
+			Response = GetReservationShareLinkRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetReservationShareLinkParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetReservationShareLink(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetReservationShareLink(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetReservationShareLinkResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetStatisticsRequest handles getStatistics operation.
+//
+// Get overall statistics.
+//
+// GET /statistics
+func (s *Server) handleGetStatisticsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getStatistics"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/statistics"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetStatisticsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetStatisticsOperation,
+			ID:   "getStatistics",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetStatisticsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKeyAuth(ctx, GetStatisticsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response GetStatisticsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetStatisticsOperation,
+			OperationSummary: "Get overall statistics",
+			OperationID:      "getStatistics",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetStatisticsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetStatistics(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetStatistics(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetStatisticsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetUsageStatisticsRequest handles getUsageStatistics operation.
+//
+// Get usage statistics over time.
+//
+// GET /statistics/usage
+func (s *Server) handleGetUsageStatisticsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getUsageStatistics"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/statistics/usage"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUsageStatisticsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetUsageStatisticsOperation,
+			ID:   "getUsageStatistics",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetUsageStatisticsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKeyAuth(ctx, GetUsageStatisticsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetUsageStatisticsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetUsageStatisticsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetUsageStatisticsOperation,
+			OperationSummary: "Get usage statistics over time",
+			OperationID:      "getUsageStatistics",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "period",
+					In:   "query",
+				}: params.Period,
+				{
+					Name: "startDate",
+					In:   "query",
+				}: params.StartDate,
+				{
+					Name: "endDate",
+					In:   "query",
+				}: params.EndDate,
+				{
+					Name: "buildingId",
+					In:   "query",
+				}: params.BuildingId,
+				{
+					Name: "areaId",
+					In:   "query",
+				}: params.AreaId,
+				{
+					Name: "placeId",
+					In:   "query",
+				}: params.PlaceId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetUsageStatisticsParams
+			Response = GetUsageStatisticsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetUsageStatisticsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetUsageStatistics(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetUsageStatistics(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetUsageStatisticsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetUserRequest handles getUser operation.
+//
+// Get user details.
+//
+// GET /users/{userId}
+func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getUser"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/users/{userId}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetUserOperation,
+			ID:   "getUser",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKeyAuth(ctx, GetUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetUserParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetUserRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetUserOperation,
+			OperationSummary: "Get user details",
+			OperationID:      "getUser",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "userId",
+					In:   "path",
+				}: params.UserId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetUserParams
+			Response = GetUserRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetUserParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetUser(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetUser(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetUserResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetUserCalendarRequest handles getUserCalendar operation.
+//
+// Returns .ics file with the reservations of the user owning the token, from three months
+// ago until three months ahead, including place, area and building names and whether a
+// check-in is required. Cancelled reservations are included with STATUS:CANCELLED so that
+// subscribed calendars remove them.
+//
+// GET /calendar/users/{token}.ics
+func (s *Server) handleGetUserCalendarRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getUserCalendar"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/calendar/users/{token}.ics"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserCalendarOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetUserCalendarOperation,
+			ID:   "getUserCalendar",
+		}
+	)
+	params, err := decodeGetUserCalendarParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetUserCalendarRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetUserCalendarOperation,
+			OperationSummary: "Personal iCalendar feed",
+			OperationID:      "getUserCalendar",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "If-None-Match",
+					In:   "header",
+				}: params.IfNoneMatch,
+				{
+					Name: "token",
+					In:   "path",
+				}: params.Token,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetUserCalendarParams
+			Response = GetUserCalendarRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetUserCalendarParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetUserCalendar(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetUserCalendar(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetUserCalendarResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetUserGroupsRequest handles getUserGroups operation.
+//
+// Get user's groups.
+//
+// GET /users/{userId}/groups
+func (s *Server) handleGetUserGroupsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getUserGroups"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/users/{userId}/groups"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserGroupsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetUserGroupsOperation,
+			ID:   "getUserGroups",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetUserGroupsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKeyAuth(ctx, GetUserGroupsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetUserGroupsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetUserGroupsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetUserGroupsOperation,
+			OperationSummary: "Get user's groups",
+			OperationID:      "getUserGroups",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "userId",
+					In:   "path",
+				}: params.UserId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetUserGroupsParams
+			Response = GetUserGroupsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetUserGroupsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetUserGroups(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetUserGroups(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetUserGroupsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleHandleOAuthCallbackRequest handles handleOAuthCallback operation.
+//
+// Handles the OAuth callback from GitLab. The responses below expire the
+// state cookie, since a state can only be used once.
+//
+// GET /auth/callback
+func (s *Server) handleHandleOAuthCallbackRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("handleOAuthCallback"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/auth/callback"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), HandleOAuthCallbackOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: HandleOAuthCallbackOperation,
+			ID:   "handleOAuthCallback",
+		}
+	)
+	params, err := decodeHandleOAuthCallbackParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response HandleOAuthCallbackRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    HandleOAuthCallbackOperation,
+			OperationSummary: "OAuth callback handler",
+			OperationID:      "handleOAuthCallback",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "code",
+					In:   "query",
+				}: params.Code,
+				{
+					Name: "state",
+					In:   "query",
+				}: params.State,
+				{
+					Name: "roomy_oauth_state",
+					In:   "cookie",
+				}: params.RoomyOAuthState,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = HandleOAuthCallbackParams
+			Response = HandleOAuthCallbackRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackHandleOAuthCallbackParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.HandleOAuthCallback(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.HandleOAuthCallback(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeHandleOAuthCallbackResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleInitiateOAuthLoginRequest handles initiateOAuthLogin operation.
+//
+// Redirects to GitLab authorization URL. The state is bound to the browser
+// by a cookie, which the callback requires.
+//
+// GET /auth/login
+func (s *Server) handleInitiateOAuthLoginRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("initiateOAuthLogin"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/auth/login"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), InitiateOAuthLoginOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err error
+	)
+
+	var rawBody []byte
+
+	var response *InitiateOAuthLoginFound
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    InitiateOAuthLoginOperation,
+			OperationSummary: "Initiate GitLab OAuth flow",
+			OperationID:      "initiateOAuthLogin",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = *InitiateOAuthLoginFound
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.InitiateOAuthLogin(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.InitiateOAuthLogin(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeInitiateOAuthLoginResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListApiKeysRequest handles listApiKeys operation.
+//
+// List user's API keys.
+//
+// GET /apiKeys
+func (s *Server) handleListApiKeysRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listApiKeys"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/apiKeys"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListApiKeysOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListApiKeysOperation,
+			ID:   "listApiKeys",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListApiKeysOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKeyAuth(ctx, ListApiKeysOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response ListApiKeysRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListApiKeysOperation,
+			OperationSummary: "List user's API keys",
+			OperationID:      "listApiKeys",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = ListApiKeysRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListApiKeys(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListApiKeys(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListApiKeysResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListAreaPlacesRequest handles listAreaPlaces operation.
+//
+// List places in area.
+//
+// GET /areas/{areaId}/places
+func (s *Server) handleListAreaPlacesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listAreaPlaces"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/areas/{areaId}/places"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListAreaPlacesOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListAreaPlacesOperation,
+			ID:   "listAreaPlaces",
+		}
+	)
+	params, err := decodeListAreaPlacesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response ListAreaPlacesRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListAreaPlacesOperation,
+			OperationSummary: "List places in area",
+			OperationID:      "listAreaPlaces",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "areaId",
+					In:   "path",
+				}: params.AreaId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListAreaPlacesParams
+			Response = ListAreaPlacesRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListAreaPlacesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListAreaPlaces(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListAreaPlaces(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListAreaPlacesResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListAreasRequest handles listAreas operation.
+//
+// List all areas.
+//
+// GET /areas
+func (s *Server) handleListAreasRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listAreas"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/areas"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListAreasOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListAreasOperation,
+			ID:   "listAreas",
+		}
+	)
+	params, err := decodeListAreasParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response *PaginatedAreaList
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListAreasOperation,
+			OperationSummary: "List all areas",
+			OperationID:      "listAreas",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "buildingId",
+					In:   "query",
+				}: params.BuildingId,
+				{
+					Name: "page",
+					In:   "query",
+				}: params.Page,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListAreasParams
+			Response = *PaginatedAreaList
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListAreasParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListAreas(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListAreas(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListAreasResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListBuildingAreasRequest handles listBuildingAreas operation.
+//
+// List areas in building.
+//
+// GET /buildings/{buildingId}/areas
+func (s *Server) handleListBuildingAreasRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listBuildingAreas"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/buildings/{buildingId}/areas"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListBuildingAreasOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
 			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
 		}
-	}
-	params, err := decodeGetUserParams(args, argsEscaped, r)
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListBuildingAreasOperation,
+			ID:   "listBuildingAreas",
+		}
+	)
+	params, err := decodeListBuildingAreasParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -11938,28 +14915,28 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 
 	var rawBody []byte
 
-	var response GetUserRes
+	var response ListBuildingAreasRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetUserOperation,
-			OperationSummary: "Get user details",
-			OperationID:      "getUser",
+			OperationName:    ListBuildingAreasOperation,
+			OperationSummary: "List areas in building",
+			OperationID:      "listBuildingAreas",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "userId",
+					Name: "buildingId",
 					In:   "path",
-				}: params.UserId,
+				}: params.BuildingId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetUserParams
-			Response = GetUserRes
+			Params   = ListBuildingAreasParams
+			Response = ListBuildingAreasRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11968,14 +14945,14 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 		](
 			m,
 			mreq,
-			unpackGetUserParams,
+			unpackListBuildingAreasParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetUser(ctx, params)
+				response, err = s.h.ListBuildingAreas(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetUser(ctx, params)
+		response, err = s.h.ListBuildingAreas(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -11983,7 +14960,7 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 		return
 	}
 
-	if err := encodeGetUserResponse(response, w, span); err != nil {
+	if err := encodeListBuildingAreasResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11992,22 +14969,22 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 	}
 }
 
-// handleGetUserGroupsRequest handles getUserGroups operation.
+// handleListBuildingsRequest handles listBuildings operation.
 //
-// Get user's groups.
+// List all buildings.
 //
-// GET /users/{userId}/groups
-func (s *Server) handleGetUserGroupsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /buildings
+func (s *Server) handleListBuildingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getUserGroups"),
+		otelogen.OperationID("listBuildings"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/users/{userId}/groups"),
+		semconv.HTTPRouteKey.String("/buildings"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserGroupsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListBuildingsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -12062,73 +15039,11 @@ func (s *Server) handleGetUserGroupsRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetUserGroupsOperation,
-			ID:   "getUserGroups",
+			Name: ListBuildingsOperation,
+			ID:   "listBuildings",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetUserGroupsOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "BearerAuth",
-					Err:              err,
-				}
-				defer recordError("Security:BearerAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, GetUserGroupsOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "ApiKeyAuth",
-					Err:              err,
-				}
-				defer recordError("Security:ApiKeyAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 1
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-				{0b00000010},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
-		}
-	}
-	params, err := decodeGetUserGroupsParams(args, argsEscaped, r)
+	params, err := decodeListBuildingsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -12141,28 +15056,36 @@ func (s *Server) handleGetUserGroupsRequest(args [1]string, argsEscaped bool, w
 
 	var rawBody []byte
 
-	var response GetUserGroupsRes
+	var response *PaginatedBuildingList
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetUserGroupsOperation,
-			OperationSummary: "Get user's groups",
-			OperationID:      "getUserGroups",
+			OperationName:    ListBuildingsOperation,
+			OperationSummary: "List all buildings",
+			OperationID:      "listBuildings",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "userId",
-					In:   "path",
-				}: params.UserId,
+					Name: "page",
+					In:   "query",
+				}: params.Page,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "search",
+					In:   "query",
+				}: params.Search,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetUserGroupsParams
-			Response = GetUserGroupsRes
+			Params   = ListBuildingsParams
+			Response = *PaginatedBuildingList
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -12171,14 +15094,14 @@ func (s *Server) handleGetUserGroupsRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetUserGroupsParams,
+			unpackListBuildingsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetUserGroups(ctx, params)
+				response, err = s.h.ListBuildings(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetUserGroups(ctx, params)
+		response, err = s.h.ListBuildings(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -12186,7 +15109,7 @@ func (s *Server) handleGetUserGroupsRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetUserGroupsResponse(response, w, span); err != nil {
+	if err := encodeListBuildingsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -12195,22 +15118,22 @@ func (s *Server) handleGetUserGroupsRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleHandleOAuthCallbackRequest handles handleOAuthCallback operation.
+// handleListEquipmentRequest handles listEquipment operation.
 //
-// Handles the OAuth callback from GitLab.
+// List all equipment types.
 //
-// GET /auth/callback
-func (s *Server) handleHandleOAuthCallbackRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /equipment
+func (s *Server) handleListEquipmentRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("handleOAuthCallback"),
+		otelogen.OperationID("listEquipment"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/auth/callback"),
+		semconv.HTTPRouteKey.String("/equipment"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), HandleOAuthCallbackOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListEquipmentOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -12263,51 +15186,28 @@ func (s *Server) handleHandleOAuthCallbackRequest(args [0]string, argsEscaped bo
 
 			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
 		}
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: HandleOAuthCallbackOperation,
-			ID:   "handleOAuthCallback",
-		}
+		err error
 	)
-	params, err := decodeHandleOAuthCallbackParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
 
-	var response HandleOAuthCallbackRes
+	var response []Equipment
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    HandleOAuthCallbackOperation,
-			OperationSummary: "OAuth callback handler",
-			OperationID:      "handleOAuthCallback",
-			Body:             nil,
-			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "code",
-					In:   "query",
-				}: params.Code,
-				{
-					Name: "state",
-					In:   "query",
-				}: params.State,
-			},
-			Raw: r,
+			OperationName:    ListEquipmentOperation,
+			OperationSummary: "List all equipment types",
+			OperationID:      "listEquipment",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = HandleOAuthCallbackParams
-			Response = HandleOAuthCallbackRes
+			Params   = struct{}
+			Response = []Equipment
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -12316,14 +15216,14 @@ func (s *Server) handleHandleOAuthCallbackRequest(args [0]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackHandleOAuthCallbackParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.HandleOAuthCallback(ctx, params)
+				response, err = s.h.ListEquipment(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.HandleOAuthCallback(ctx, params)
+		response, err = s.h.ListEquipment(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -12331,7 +15231,7 @@ func (s *Server) handleHandleOAuthCallbackRequest(args [0]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeHandleOAuthCallbackResponse(response, w, span); err != nil {
+	if err := encodeListEquipmentResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -12340,22 +15240,22 @@ func (s *Server) handleHandleOAuthCallbackRequest(args [0]string, argsEscaped bo
 	}
 }
 
-// handleInitiateOAuthLoginRequest handles initiateOAuthLogin operation.
+// handleListGroupConstraintProfilesRequest handles listGroupConstraintProfiles operation.
 //
-// Redirects to GitLab authorization URL.
+// List constraint profiles of group.
 //
-// GET /auth/login
-func (s *Server) handleInitiateOAuthLoginRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /groups/{groupId}/constraintProfiles
+func (s *Server) handleListGroupConstraintProfilesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("initiateOAuthLogin"),
+		otelogen.OperationID("listGroupConstraintProfiles"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/auth/login"),
+		semconv.HTTPRouteKey.String("/groups/{groupId}/constraintProfiles"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), InitiateOAuthLoginOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListGroupConstraintProfilesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -12408,28 +15308,109 @@ func (s *Server) handleInitiateOAuthLoginRequest(args [0]string, argsEscaped boo
 
 			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
 		}
-		err error
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListGroupConstraintProfilesOperation,
+			ID:   "listGroupConstraintProfiles",
+		}
 	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListGroupConstraintProfilesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKeyAuth(ctx, ListGroupConstraintProfilesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeListGroupConstraintProfilesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response *InitiateOAuthLoginFound
+	var response ListGroupConstraintProfilesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    InitiateOAuthLoginOperation,
-			OperationSummary: "Initiate GitLab OAuth flow",
-			OperationID:      "initiateOAuthLogin",
+			OperationName:    ListGroupConstraintProfilesOperation,
+			OperationSummary: "List constraint profiles of group",
+			OperationID:      "listGroupConstraintProfiles",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "groupId",
+					In:   "path",
+				}: params.GroupId,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = *InitiateOAuthLoginFound
+			Params   = ListGroupConstraintProfilesParams
+			Response = ListGroupConstraintProfilesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -12438,14 +15419,14 @@ func (s *Server) handleInitiateOAuthLoginRequest(args [0]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			nil,
+			unpackListGroupConstraintProfilesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.InitiateOAuthLogin(ctx)
+				response, err = s.h.ListGroupConstraintProfiles(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.InitiateOAuthLogin(ctx)
+		response, err = s.h.ListGroupConstraintProfiles(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -12453,7 +15434,7 @@ func (s *Server) handleInitiateOAuthLoginRequest(args [0]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeInitiateOAuthLoginResponse(response, w, span); err != nil {
+	if err := encodeListGroupConstraintProfilesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -12462,22 +15443,22 @@ func (s *Server) handleInitiateOAuthLoginRequest(args [0]string, argsEscaped boo
 	}
 }
 
-// handleListApiKeysRequest handles listApiKeys operation.
+// handleListGroupsRequest handles listGroups operation.
 //
-// List user's API keys.
+// List all permission groups.
 //
-// GET /apiKeys
-func (s *Server) handleListApiKeysRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /groups
+func (s *Server) handleListGroupsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listApiKeys"),
+		otelogen.OperationID("listGroups"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/apiKeys"),
+		semconv.HTTPRouteKey.String("/groups"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListApiKeysOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListGroupsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -12532,15 +15513,15 @@ func (s *Server) handleListApiKeysRequest(args [0]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListApiKeysOperation,
-			ID:   "listApiKeys",
+			Name: ListGroupsOperation,
+			ID:   "listGroups",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListApiKeysOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, ListGroupsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12557,7 +15538,7 @@ func (s *Server) handleListApiKeysRequest(args [0]string, argsEscaped bool, w ht
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, ListApiKeysOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, ListGroupsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12601,13 +15582,13 @@ func (s *Server) handleListApiKeysRequest(args [0]string, argsEscaped bool, w ht
 
 	var rawBody []byte
 
-	var response ListApiKeysRes
+	var response ListGroupsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListApiKeysOperation,
-			OperationSummary: "List user's API keys",
-			OperationID:      "listApiKeys",
+			OperationName:    ListGroupsOperation,
+			OperationSummary: "List all permission groups",
+			OperationID:      "listGroups",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -12617,7 +15598,7 @@ func (s *Server) handleListApiKeysRequest(args [0]string, argsEscaped bool, w ht
 		type (
 			Request  = struct{}
 			Params   = struct{}
-			Response = ListApiKeysRes
+			Response = ListGroupsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -12628,12 +15609,12 @@ func (s *Server) handleListApiKeysRequest(args [0]string, argsEscaped bool, w ht
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListApiKeys(ctx)
+				response, err = s.h.ListGroups(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListApiKeys(ctx)
+		response, err = s.h.ListGroups(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -12641,7 +15622,7 @@ func (s *Server) handleListApiKeysRequest(args [0]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeListApiKeysResponse(response, w, span); err != nil {
+	if err := encodeListGroupsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -12650,22 +15631,22 @@ func (s *Server) handleListApiKeysRequest(args [0]string, argsEscaped bool, w ht
 	}
 }
 
-// handleListAreaPlacesRequest handles listAreaPlaces operation.
+// handleListPermissionsRequest handles listPermissions operation.
 //
-// List places in area.
+// List all available permissions.
 //
-// GET /areas/{areaId}/places
-func (s *Server) handleListAreaPlacesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /permissions
+func (s *Server) handleListPermissionsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listAreaPlaces"),
+		otelogen.OperationID("listPermissions"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/areas/{areaId}/places"),
+		semconv.HTTPRouteKey.String("/permissions"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListAreaPlacesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListPermissionsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -12720,45 +15701,92 @@ func (s *Server) handleListAreaPlacesRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListAreaPlacesOperation,
-			ID:   "listAreaPlaces",
+			Name: ListPermissionsOperation,
+			ID:   "listPermissions",
 		}
 	)
-	params, err := decodeListAreaPlacesParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListPermissionsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKeyAuth(ctx, ListPermissionsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
 		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
 	}
 
 	var rawBody []byte
 
-	var response ListAreaPlacesRes
+	var response ListPermissionsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListAreaPlacesOperation,
-			OperationSummary: "List places in area",
-			OperationID:      "listAreaPlaces",
+			OperationName:    ListPermissionsOperation,
+			OperationSummary: "List all available permissions",
+			OperationID:      "listPermissions",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "areaId",
-					In:   "path",
-				}: params.AreaId,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ListAreaPlacesParams
-			Response = ListAreaPlacesRes
+			Params   = struct{}
+			Response = ListPermissionsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -12767,14 +15795,14 @@ func (s *Server) handleListAreaPlacesRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackListAreaPlacesParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListAreaPlaces(ctx, params)
+				response, err = s.h.ListPermissions(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListAreaPlaces(ctx, params)
+		response, err = s.h.ListPermissions(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -12782,7 +15810,7 @@ func (s *Server) handleListAreaPlacesRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeListAreaPlacesResponse(response, w, span); err != nil {
+	if err := encodeListPermissionsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -12791,22 +15819,22 @@ func (s *Server) handleListAreaPlacesRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleListAreasRequest handles listAreas operation.
+// handleListPlacesRequest handles listPlaces operation.
 //
-// List all areas.
+// List/search places.
 //
-// GET /areas
-func (s *Server) handleListAreasRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /places
+func (s *Server) handleListPlacesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listAreas"),
+		otelogen.OperationID("listPlaces"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/areas"),
+		semconv.HTTPRouteKey.String("/places"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListAreasOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListPlacesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -12861,11 +15889,11 @@ func (s *Server) handleListAreasRequest(args [0]string, argsEscaped bool, w http
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListAreasOperation,
-			ID:   "listAreas",
+			Name: ListPlacesOperation,
+			ID:   "listPlaces",
 		}
 	)
-	params, err := decodeListAreasParams(args, argsEscaped, r)
+	params, err := decodeListPlacesParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -12878,20 +15906,52 @@ func (s *Server) handleListAreasRequest(args [0]string, argsEscaped bool, w http
 
 	var rawBody []byte
 
-	var response *PaginatedAreaList
+	var response *PaginatedPlaceList
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListAreasOperation,
-			OperationSummary: "List all areas",
-			OperationID:      "listAreas",
+			OperationName:    ListPlacesOperation,
+			OperationSummary: "List/search places",
+			OperationID:      "listPlaces",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
+				{
+					Name: "areaId",
+					In:   "query",
+				}: params.AreaId,
 				{
 					Name: "buildingId",
 					In:   "query",
 				}: params.BuildingId,
+				{
+					Name: "search",
+					In:   "query",
+				}: params.Search,
+				{
+					Name: "equipment",
+					In:   "query",
+				}: params.Equipment,
+				{
+					Name: "minCapacity",
+					In:   "query",
+				}: params.MinCapacity,
+				{
+					Name: "available",
+					In:   "query",
+				}: params.Available,
+				{
+					Name: "date",
+					In:   "query",
+				}: params.Date,
+				{
+					Name: "startTime",
+					In:   "query",
+				}: params.StartTime,
+				{
+					Name: "endTime",
+					In:   "query",
+				}: params.EndTime,
 				{
 					Name: "page",
 					In:   "query",
@@ -12906,8 +15966,8 @@ func (s *Server) handleListAreasRequest(args [0]string, argsEscaped bool, w http
 
 		type (
 			Request  = struct{}
-			Params   = ListAreasParams
-			Response = *PaginatedAreaList
+			Params   = ListPlacesParams
+			Response = *PaginatedPlaceList
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -12916,14 +15976,14 @@ func (s *Server) handleListAreasRequest(args [0]string, argsEscaped bool, w http
 		](
 			m,
 			mreq,
-			unpackListAreasParams,
+			unpackListPlacesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListAreas(ctx, params)
+				response, err = s.h.ListPlaces(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListAreas(ctx, params)
+		response, err = s.h.ListPlaces(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -12931,7 +15991,7 @@ func (s *Server) handleListAreasRequest(args [0]string, argsEscaped bool, w http
 		return
 	}
 
-	if err := encodeListAreasResponse(response, w, span); err != nil {
+	if err := encodeListPlacesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -12940,22 +16000,22 @@ func (s *Server) handleListAreasRequest(args [0]string, argsEscaped bool, w http
 	}
 }
 
-// handleListBuildingAreasRequest handles listBuildingAreas operation.
+// handleListQrTemplatesRequest handles listQrTemplates operation.
 //
-// List areas in building.
+// List QR code templates.
 //
-// GET /buildings/{buildingId}/areas
-func (s *Server) handleListBuildingAreasRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /qrTemplates
+func (s *Server) handleListQrTemplatesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listBuildingAreas"),
+		otelogen.OperationID("listQrTemplates"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/buildings/{buildingId}/areas"),
+		semconv.HTTPRouteKey.String("/qrTemplates"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListBuildingAreasOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListQrTemplatesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -13010,45 +16070,92 @@ func (s *Server) handleListBuildingAreasRequest(args [1]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListBuildingAreasOperation,
-			ID:   "listBuildingAreas",
+			Name: ListQrTemplatesOperation,
+			ID:   "listQrTemplates",
 		}
 	)
-	params, err := decodeListBuildingAreasParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListQrTemplatesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKeyAuth(ctx, ListQrTemplatesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
 		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
 	}
 
 	var rawBody []byte
 
-	var response ListBuildingAreasRes
+	var response ListQrTemplatesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListBuildingAreasOperation,
-			OperationSummary: "List areas in building",
-			OperationID:      "listBuildingAreas",
+			OperationName:    ListQrTemplatesOperation,
+			OperationSummary: "List QR code templates",
+			OperationID:      "listQrTemplates",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "buildingId",
-					In:   "path",
-				}: params.BuildingId,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ListBuildingAreasParams
-			Response = ListBuildingAreasRes
+			Params   = struct{}
+			Response = ListQrTemplatesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -13057,14 +16164,14 @@ func (s *Server) handleListBuildingAreasRequest(args [1]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			unpackListBuildingAreasParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListBuildingAreas(ctx, params)
+				response, err = s.h.ListQrTemplates(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListBuildingAreas(ctx, params)
+		response, err = s.h.ListQrTemplates(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -13072,7 +16179,7 @@ func (s *Server) handleListBuildingAreasRequest(args [1]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeListBuildingAreasResponse(response, w, span); err != nil {
+	if err := encodeListQrTemplatesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -13081,22 +16188,22 @@ func (s *Server) handleListBuildingAreasRequest(args [1]string, argsEscaped bool
 	}
 }
 
-// handleListBuildingsRequest handles listBuildings operation.
+// handleListReservationsRequest handles listReservations operation.
 //
-// List all buildings.
+// List reservations.
 //
-// GET /buildings
-func (s *Server) handleListBuildingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /reservations
+func (s *Server) handleListReservationsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listBuildings"),
+		otelogen.OperationID("listReservations"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/buildings"),
+		semconv.HTTPRouteKey.String("/reservations"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListBuildingsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListReservationsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -13140,22 +16247,84 @@ func (s *Server) handleListBuildingsRequest(args [0]string, argsEscaped bool, w
 			if code < 100 || code >= 500 {
 				span.SetStatus(codes.Error, stage)
 			}
-
-			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
-			if code != 0 {
-				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListReservationsOperation,
+			ID:   "listReservations",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListReservationsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKeyAuth(ctx, ListReservationsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
 			}
-
-			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
 		}
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: ListBuildingsOperation,
-			ID:   "listBuildings",
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
 		}
-	)
-	params, err := decodeListBuildingsParams(args, argsEscaped, r)
+	}
+	params, err := decodeListReservationsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -13168,16 +16337,44 @@ func (s *Server) handleListBuildingsRequest(args [0]string, argsEscaped bool, w
 
 	var rawBody []byte
 
-	var response *PaginatedBuildingList
+	var response ListReservationsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListBuildingsOperation,
-			OperationSummary: "List all buildings",
-			OperationID:      "listBuildings",
+			OperationName:    ListReservationsOperation,
+			OperationSummary: "List reservations",
+			OperationID:      "listReservations",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
+				{
+					Name: "userId",
+					In:   "query",
+				}: params.UserId,
+				{
+					Name: "placeId",
+					In:   "query",
+				}: params.PlaceId,
+				{
+					Name: "areaId",
+					In:   "query",
+				}: params.AreaId,
+				{
+					Name: "buildingId",
+					In:   "query",
+				}: params.BuildingId,
+				{
+					Name: "status",
+					In:   "query",
+				}: params.Status,
+				{
+					Name: "startDate",
+					In:   "query",
+				}: params.StartDate,
+				{
+					Name: "endDate",
+					In:   "query",
+				}: params.EndDate,
 				{
 					Name: "page",
 					In:   "query",
@@ -13186,18 +16383,14 @@ func (s *Server) handleListBuildingsRequest(args [0]string, argsEscaped bool, w
 					Name: "limit",
 					In:   "query",
 				}: params.Limit,
-				{
-					Name: "search",
-					In:   "query",
-				}: params.Search,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ListBuildingsParams
-			Response = *PaginatedBuildingList
+			Params   = ListReservationsParams
+			Response = ListReservationsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -13206,14 +16399,14 @@ func (s *Server) handleListBuildingsRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackListBuildingsParams,
+			unpackListReservationsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListBuildings(ctx, params)
+				response, err = s.h.ListReservations(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListBuildings(ctx, params)
+		response, err = s.h.ListReservations(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -13221,7 +16414,7 @@ func (s *Server) handleListBuildingsRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeListBuildingsResponse(response, w, span); err != nil {
+	if err := encodeListReservationsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -13230,22 +16423,22 @@ func (s *Server) handleListBuildingsRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleListEquipmentRequest handles listEquipment operation.
+// handleListUsersRequest handles listUsers operation.
 //
-// List all equipment types.
+// List all users.
 //
-// GET /equipment
-func (s *Server) handleListEquipmentRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /users
+func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listEquipment"),
+		otelogen.OperationID("listUsers"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/equipment"),
+		semconv.HTTPRouteKey.String("/users"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListEquipmentOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListUsersOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -13298,28 +16491,125 @@ func (s *Server) handleListEquipmentRequest(args [0]string, argsEscaped bool, w
 
 			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
 		}
-		err error
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListUsersOperation,
+			ID:   "listUsers",
+		}
 	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListUsersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKeyAuth(ctx, ListUsersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeListUsersParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response []Equipment
+	var response ListUsersRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListEquipmentOperation,
-			OperationSummary: "List all equipment types",
-			OperationID:      "listEquipment",
+			OperationName:    ListUsersOperation,
+			OperationSummary: "List all users",
+			OperationID:      "listUsers",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "search",
+					In:   "query",
+				}: params.Search,
+				{
+					Name: "groupIds",
+					In:   "query",
+				}: params.GroupIds,
+				{
+					Name: "status",
+					In:   "query",
+				}: params.Status,
+				{
+					Name: "page",
+					In:   "query",
+				}: params.Page,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = []Equipment
+			Params   = ListUsersParams
+			Response = ListUsersRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -13328,14 +16618,14 @@ func (s *Server) handleListEquipmentRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackListUsersParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListEquipment(ctx)
+				response, err = s.h.ListUsers(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListEquipment(ctx)
+		response, err = s.h.ListUsers(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -13343,7 +16633,7 @@ func (s *Server) handleListEquipmentRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeListEquipmentResponse(response, w, span); err != nil {
+	if err := encodeListUsersResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -13352,22 +16642,22 @@ func (s *Server) handleListEquipmentRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleListGroupsRequest handles listGroups operation.
+// handleLogoutRequest handles logout operation.
 //
-// List all permission groups.
+// Invalidate current session.
 //
-// GET /groups
-func (s *Server) handleListGroupsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /auth/logout
+func (s *Server) handleLogoutRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listGroups"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/groups"),
+		otelogen.OperationID("logout"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/auth/logout"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListGroupsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), LogoutOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -13422,15 +16712,15 @@ func (s *Server) handleListGroupsRequest(args [0]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListGroupsOperation,
-			ID:   "listGroups",
+			Name: LogoutOperation,
+			ID:   "logout",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListGroupsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, LogoutOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -13447,7 +16737,7 @@ func (s *Server) handleListGroupsRequest(args [0]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, ListGroupsOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, LogoutOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -13491,13 +16781,13 @@ func (s *Server) handleListGroupsRequest(args [0]string, argsEscaped bool, w htt
 
 	var rawBody []byte
 
-	var response ListGroupsRes
+	var response *LogoutNoContent
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListGroupsOperation,
-			OperationSummary: "List all permission groups",
-			OperationID:      "listGroups",
+			OperationName:    LogoutOperation,
+			OperationSummary: "Invalidate current session",
+			OperationID:      "logout",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -13507,7 +16797,7 @@ func (s *Server) handleListGroupsRequest(args [0]string, argsEscaped bool, w htt
 		type (
 			Request  = struct{}
 			Params   = struct{}
-			Response = ListGroupsRes
+			Response = *LogoutNoContent
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -13518,12 +16808,12 @@ func (s *Server) handleListGroupsRequest(args [0]string, argsEscaped bool, w htt
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListGroups(ctx)
+				err = s.h.Logout(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListGroups(ctx)
+		err = s.h.Logout(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -13531,7 +16821,7 @@ func (s *Server) handleListGroupsRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeListGroupsResponse(response, w, span); err != nil {
+	if err := encodeLogoutResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -13540,22 +16830,23 @@ func (s *Server) handleListGroupsRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleListPermissionsRequest handles listPermissions operation.
+// handlePreviewQrTemplateRequest handles previewQrTemplate operation.
 //
-// List all available permissions.
+// Renders the template with the data of a sample place, either as the HTML shown in
+// browsers or as the label image printed by getPlaceQrCode.
 //
-// GET /permissions
-func (s *Server) handleListPermissionsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /qrTemplates/{templateId}/preview
+func (s *Server) handlePreviewQrTemplateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listPermissions"),
+		otelogen.OperationID("previewQrTemplate"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/permissions"),
+		semconv.HTTPRouteKey.String("/qrTemplates/{templateId}/preview"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListPermissionsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PreviewQrTemplateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -13610,15 +16901,15 @@ func (s *Server) handleListPermissionsRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListPermissionsOperation,
-			ID:   "listPermissions",
+			Name: PreviewQrTemplateOperation,
+			ID:   "previewQrTemplate",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListPermissionsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, PreviewQrTemplateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -13635,7 +16926,7 @@ func (s *Server) handleListPermissionsRequest(args [0]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, ListPermissionsOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, PreviewQrTemplateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -13676,26 +16967,45 @@ func (s *Server) handleListPermissionsRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
+	params, err := decodePreviewQrTemplateParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response ListPermissionsRes
+	var response PreviewQrTemplateRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListPermissionsOperation,
-			OperationSummary: "List all available permissions",
-			OperationID:      "listPermissions",
+			OperationName:    PreviewQrTemplateOperation,
+			OperationSummary: "Preview template with sample data",
+			OperationID:      "previewQrTemplate",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "format",
+					In:   "query",
+				}: params.Format,
+				{
+					Name: "templateId",
+					In:   "path",
+				}: params.TemplateId,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = ListPermissionsRes
+			Params   = PreviewQrTemplateParams
+			Response = PreviewQrTemplateRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -13704,14 +17014,14 @@ func (s *Server) handleListPermissionsRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			nil,
+			unpackPreviewQrTemplateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListPermissions(ctx)
+				response, err = s.h.PreviewQrTemplate(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListPermissions(ctx)
+		response, err = s.h.PreviewQrTemplate(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -13719,7 +17029,7 @@ func (s *Server) handleListPermissionsRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeListPermissionsResponse(response, w, span); err != nil {
+	if err := encodePreviewQrTemplateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -13728,22 +17038,22 @@ func (s *Server) handleListPermissionsRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleListPlacesRequest handles listPlaces operation.
+// handleRefreshTokenRequest handles refreshToken operation.
 //
-// List/search places.
+// Refresh access token.
 //
-// GET /places
-func (s *Server) handleListPlacesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /auth/refresh
+func (s *Server) handleRefreshTokenRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listPlaces"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/places"),
+		otelogen.OperationID("refreshToken"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/auth/refresh"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListPlacesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RefreshTokenOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -13798,85 +17108,45 @@ func (s *Server) handleListPlacesRequest(args [0]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListPlacesOperation,
-			ID:   "listPlaces",
+			Name: RefreshTokenOperation,
+			ID:   "refreshToken",
 		}
 	)
-	params, err := decodeListPlacesParams(args, argsEscaped, r)
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeRefreshTokenRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var rawBody []byte
-
-	var response *PaginatedPlaceList
+	var response RefreshTokenRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListPlacesOperation,
-			OperationSummary: "List/search places",
-			OperationID:      "listPlaces",
-			Body:             nil,
+			OperationName:    RefreshTokenOperation,
+			OperationSummary: "Refresh access token",
+			OperationID:      "refreshToken",
+			Body:             request,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "areaId",
-					In:   "query",
-				}: params.AreaId,
-				{
-					Name: "buildingId",
-					In:   "query",
-				}: params.BuildingId,
-				{
-					Name: "search",
-					In:   "query",
-				}: params.Search,
-				{
-					Name: "equipment",
-					In:   "query",
-				}: params.Equipment,
-				{
-					Name: "minCapacity",
-					In:   "query",
-				}: params.MinCapacity,
-				{
-					Name: "available",
-					In:   "query",
-				}: params.Available,
-				{
-					Name: "date",
-					In:   "query",
-				}: params.Date,
-				{
-					Name: "startTime",
-					In:   "query",
-				}: params.StartTime,
-				{
-					Name: "endTime",
-					In:   "query",
-				}: params.EndTime,
-				{
-					Name: "page",
-					In:   "query",
-				}: params.Page,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = ListPlacesParams
-			Response = *PaginatedPlaceList
+			Request  = *RefreshTokenReq
+			Params   = struct{}
+			Response = RefreshTokenRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -13885,14 +17155,14 @@ func (s *Server) handleListPlacesRequest(args [0]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackListPlacesParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListPlaces(ctx, params)
+				response, err = s.h.RefreshToken(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListPlaces(ctx, params)
+		response, err = s.h.RefreshToken(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -13900,7 +17170,7 @@ func (s *Server) handleListPlacesRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeListPlacesResponse(response, w, span); err != nil {
+	if err := encodeRefreshTokenResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -13909,22 +17179,22 @@ func (s *Server) handleListPlacesRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleListQrTemplatesRequest handles listQrTemplates operation.
+// handleRemoveAreaBlockingEntriesRequest handles removeAreaBlockingEntries operation.
 //
-// List QR code templates.
+// Remove blocking periods by IDs.
 //
-// GET /qrTemplates
-func (s *Server) handleListQrTemplatesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /areas/{areaId}/blocking/entries
+func (s *Server) handleRemoveAreaBlockingEntriesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listQrTemplates"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/qrTemplates"),
+		otelogen.OperationID("removeAreaBlockingEntries"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/areas/{areaId}/blocking/entries"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListQrTemplatesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveAreaBlockingEntriesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -13979,15 +17249,15 @@ func (s *Server) handleListQrTemplatesRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListQrTemplatesOperation,
-			ID:   "listQrTemplates",
+			Name: RemoveAreaBlockingEntriesOperation,
+			ID:   "removeAreaBlockingEntries",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListQrTemplatesOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, RemoveAreaBlockingEntriesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -14004,7 +17274,7 @@ func (s *Server) handleListQrTemplatesRequest(args [0]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, ListQrTemplatesOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, RemoveAreaBlockingEntriesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -14045,26 +17315,56 @@ func (s *Server) handleListQrTemplatesRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
+	params, err := decodeRemoveAreaBlockingEntriesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeRemoveAreaBlockingEntriesRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response ListQrTemplatesRes
+	var response RemoveAreaBlockingEntriesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListQrTemplatesOperation,
-			OperationSummary: "List QR code templates",
-			OperationID:      "listQrTemplates",
-			Body:             nil,
+			OperationName:    RemoveAreaBlockingEntriesOperation,
+			OperationSummary: "Remove blocking periods by IDs",
+			OperationID:      "removeAreaBlockingEntries",
+			Body:             request,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "areaId",
+					In:   "path",
+				}: params.AreaId,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = struct{}
-			Response = ListQrTemplatesRes
+			Request  = *RemoveAreaBlockingEntriesReq
+			Params   = RemoveAreaBlockingEntriesParams
+			Response = RemoveAreaBlockingEntriesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -14073,14 +17373,14 @@ func (s *Server) handleListQrTemplatesRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			nil,
+			unpackRemoveAreaBlockingEntriesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListQrTemplates(ctx)
+				response, err = s.h.RemoveAreaBlockingEntries(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListQrTemplates(ctx)
+		response, err = s.h.RemoveAreaBlockingEntries(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -14088,7 +17388,7 @@ func (s *Server) handleListQrTemplatesRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeListQrTemplatesResponse(response, w, span); err != nil {
+	if err := encodeRemoveAreaBlockingEntriesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -14097,22 +17397,22 @@ func (s *Server) handleListQrTemplatesRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleListReservationsRequest handles listReservations operation.
+// handleRemoveAreaRoomPlanMarkersRequest handles removeAreaRoomPlanMarkers operation.
 //
-// List reservations.
+// Remove place markers by IDs.
 //
-// GET /reservations
-func (s *Server) handleListReservationsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /areas/{areaId}/roomPlan/markers
+func (s *Server) handleRemoveAreaRoomPlanMarkersRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listReservations"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/reservations"),
+		otelogen.OperationID("removeAreaRoomPlanMarkers"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/areas/{areaId}/roomPlan/markers"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListReservationsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveAreaRoomPlanMarkersOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -14167,15 +17467,15 @@ func (s *Server) handleListReservationsRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListReservationsOperation,
-			ID:   "listReservations",
+			Name: RemoveAreaRoomPlanMarkersOperation,
+			ID:   "removeAreaRoomPlanMarkers",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListReservationsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, RemoveAreaRoomPlanMarkersOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -14192,7 +17492,7 @@ func (s *Server) handleListReservationsRequest(args [0]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, ListReservationsOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, RemoveAreaRoomPlanMarkersOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -14233,7 +17533,7 @@ func (s *Server) handleListReservationsRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeListReservationsParams(args, argsEscaped, r)
+	params, err := decodeRemoveAreaRoomPlanMarkersParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -14245,61 +17545,44 @@ func (s *Server) handleListReservationsRequest(args [0]string, argsEscaped bool,
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeRemoveAreaRoomPlanMarkersRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response ListReservationsRes
+	var response RemoveAreaRoomPlanMarkersRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListReservationsOperation,
-			OperationSummary: "List reservations",
-			OperationID:      "listReservations",
-			Body:             nil,
+			OperationName:    RemoveAreaRoomPlanMarkersOperation,
+			OperationSummary: "Remove place markers by IDs",
+			OperationID:      "removeAreaRoomPlanMarkers",
+			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
-				{
-					Name: "userId",
-					In:   "query",
-				}: params.UserId,
-				{
-					Name: "placeId",
-					In:   "query",
-				}: params.PlaceId,
 				{
 					Name: "areaId",
-					In:   "query",
+					In:   "path",
 				}: params.AreaId,
-				{
-					Name: "buildingId",
-					In:   "query",
-				}: params.BuildingId,
-				{
-					Name: "status",
-					In:   "query",
-				}: params.Status,
-				{
-					Name: "startDate",
-					In:   "query",
-				}: params.StartDate,
-				{
-					Name: "endDate",
-					In:   "query",
-				}: params.EndDate,
-				{
-					Name: "page",
-					In:   "query",
-				}: params.Page,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = ListReservationsParams
-			Response = ListReservationsRes
+			Request  = *RemoveAreaRoomPlanMarkersReq
+			Params   = RemoveAreaRoomPlanMarkersParams
+			Response = RemoveAreaRoomPlanMarkersRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -14308,14 +17591,14 @@ func (s *Server) handleListReservationsRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackListReservationsParams,
+			unpackRemoveAreaRoomPlanMarkersParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListReservations(ctx, params)
+				response, err = s.h.RemoveAreaRoomPlanMarkers(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListReservations(ctx, params)
+		response, err = s.h.RemoveAreaRoomPlanMarkers(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -14323,7 +17606,7 @@ func (s *Server) handleListReservationsRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeListReservationsResponse(response, w, span); err != nil {
+	if err := encodeRemoveAreaRoomPlanMarkersResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -14332,22 +17615,22 @@ func (s *Server) handleListReservationsRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleListUsersRequest handles listUsers operation.
+// handleRemoveBuildingBlockingEntriesRequest handles removeBuildingBlockingEntries operation.
 //
-// List all users.
+// Remove blocking periods by IDs.
 //
-// GET /users
-func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /buildings/{buildingId}/blocking/entries
+func (s *Server) handleRemoveBuildingBlockingEntriesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listUsers"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/users"),
+		otelogen.OperationID("removeBuildingBlockingEntries"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/buildings/{buildingId}/blocking/entries"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListUsersOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveBuildingBlockingEntriesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -14402,15 +17685,15 @@ func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListUsersOperation,
-			ID:   "listUsers",
+			Name: RemoveBuildingBlockingEntriesOperation,
+			ID:   "removeBuildingBlockingEntries",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListUsersOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, RemoveBuildingBlockingEntriesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -14427,7 +17710,7 @@ func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, ListUsersOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, RemoveBuildingBlockingEntriesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -14468,7 +17751,7 @@ func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http
 			return
 		}
 	}
-	params, err := decodeListUsersParams(args, argsEscaped, r)
+	params, err := decodeRemoveBuildingBlockingEntriesParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -14480,45 +17763,44 @@ func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeRemoveBuildingBlockingEntriesRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response ListUsersRes
+	var response RemoveBuildingBlockingEntriesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListUsersOperation,
-			OperationSummary: "List all users",
-			OperationID:      "listUsers",
-			Body:             nil,
+			OperationName:    RemoveBuildingBlockingEntriesOperation,
+			OperationSummary: "Remove blocking periods by IDs",
+			OperationID:      "removeBuildingBlockingEntries",
+			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "search",
-					In:   "query",
-				}: params.Search,
-				{
-					Name: "groupIds",
-					In:   "query",
-				}: params.GroupIds,
-				{
-					Name: "status",
-					In:   "query",
-				}: params.Status,
-				{
-					Name: "page",
-					In:   "query",
-				}: params.Page,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
+					Name: "buildingId",
+					In:   "path",
+				}: params.BuildingId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = ListUsersParams
-			Response = ListUsersRes
+			Request  = *RemoveBuildingBlockingEntriesReq
+			Params   = RemoveBuildingBlockingEntriesParams
+			Response = RemoveBuildingBlockingEntriesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -14527,14 +17809,14 @@ func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http
 		](
 			m,
 			mreq,
-			unpackListUsersParams,
+			unpackRemoveBuildingBlockingEntriesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListUsers(ctx, params)
+				response, err = s.h.RemoveBuildingBlockingEntries(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListUsers(ctx, params)
+		response, err = s.h.RemoveBuildingBlockingEntries(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -14542,7 +17824,7 @@ func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http
 		return
 	}
 
-	if err := encodeListUsersResponse(response, w, span); err != nil {
+	if err := encodeRemoveBuildingBlockingEntriesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -14551,22 +17833,22 @@ func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http
 	}
 }
 
-// handleLogoutRequest handles logout operation.
+// handleRemoveCurrentUserFavoritesRequest handles removeCurrentUserFavorites operation.
 //
-// Invalidate current session.
+// Remove places from favorites.
 //
-// POST /auth/logout
-func (s *Server) handleLogoutRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /users/me/favorites
+func (s *Server) handleRemoveCurrentUserFavoritesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("logout"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/auth/logout"),
+		otelogen.OperationID("removeCurrentUserFavorites"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/users/me/favorites"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), LogoutOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveCurrentUserFavoritesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -14621,15 +17903,15 @@ func (s *Server) handleLogoutRequest(args [0]string, argsEscaped bool, w http.Re
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: LogoutOperation,
-			ID:   "logout",
+			Name: RemoveCurrentUserFavoritesOperation,
+			ID:   "removeCurrentUserFavorites",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, LogoutOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, RemoveCurrentUserFavoritesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -14646,7 +17928,7 @@ func (s *Server) handleLogoutRequest(args [0]string, argsEscaped bool, w http.Re
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, LogoutOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, RemoveCurrentUserFavoritesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -14689,24 +17971,39 @@ func (s *Server) handleLogoutRequest(args [0]string, argsEscaped bool, w http.Re
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeRemoveCurrentUserFavoritesRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *LogoutNoContent
+	var response RemoveCurrentUserFavoritesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    LogoutOperation,
-			OperationSummary: "Invalidate current session",
-			OperationID:      "logout",
-			Body:             nil,
+			OperationName:    RemoveCurrentUserFavoritesOperation,
+			OperationSummary: "Remove places from favorites",
+			OperationID:      "removeCurrentUserFavorites",
+			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
+			Request  = *RemoveCurrentUserFavoritesReq
 			Params   = struct{}
-			Response = *LogoutNoContent
+			Response = RemoveCurrentUserFavoritesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -14717,12 +18014,12 @@ func (s *Server) handleLogoutRequest(args [0]string, argsEscaped bool, w http.Re
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.Logout(ctx)
+				response, err = s.h.RemoveCurrentUserFavorites(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.Logout(ctx)
+		response, err = s.h.RemoveCurrentUserFavorites(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -14730,7 +18027,7 @@ func (s *Server) handleLogoutRequest(args [0]string, argsEscaped bool, w http.Re
 		return
 	}
 
-	if err := encodeLogoutResponse(response, w, span); err != nil {
+	if err := encodeRemoveCurrentUserFavoritesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -14739,22 +18036,22 @@ func (s *Server) handleLogoutRequest(args [0]string, argsEscaped bool, w http.Re
 	}
 }
 
-// handlePreviewQrTemplateRequest handles previewQrTemplate operation.
+// handleRemoveGroupPermissionsRequest handles removeGroupPermissions operation.
 //
-// Preview template with sample data.
+// Remove permissions from group.
 //
-// GET /qrTemplates/{templateId}/preview
-func (s *Server) handlePreviewQrTemplateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /groups/{groupId}/permissions
+func (s *Server) handleRemoveGroupPermissionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("previewQrTemplate"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/qrTemplates/{templateId}/preview"),
+		otelogen.OperationID("removeGroupPermissions"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/groups/{groupId}/permissions"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), PreviewQrTemplateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveGroupPermissionsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -14809,15 +18106,15 @@ func (s *Server) handlePreviewQrTemplateRequest(args [1]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: PreviewQrTemplateOperation,
-			ID:   "previewQrTemplate",
+			Name: RemoveGroupPermissionsOperation,
+			ID:   "removeGroupPermissions",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, PreviewQrTemplateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, RemoveGroupPermissionsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -14834,7 +18131,7 @@ func (s *Server) handlePreviewQrTemplateRequest(args [1]string, argsEscaped bool
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, PreviewQrTemplateOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, RemoveGroupPermissionsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -14875,7 +18172,7 @@ func (s *Server) handlePreviewQrTemplateRequest(args [1]string, argsEscaped bool
 			return
 		}
 	}
-	params, err := decodePreviewQrTemplateParams(args, argsEscaped, r)
+	params, err := decodeRemoveGroupPermissionsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -14887,29 +18184,44 @@ func (s *Server) handlePreviewQrTemplateRequest(args [1]string, argsEscaped bool
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeRemoveGroupPermissionsRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response PreviewQrTemplateRes
+	var response RemoveGroupPermissionsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    PreviewQrTemplateOperation,
-			OperationSummary: "Preview template with sample data",
-			OperationID:      "previewQrTemplate",
-			Body:             nil,
+			OperationName:    RemoveGroupPermissionsOperation,
+			OperationSummary: "Remove permissions from group",
+			OperationID:      "removeGroupPermissions",
+			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "templateId",
+					Name: "groupId",
 					In:   "path",
-				}: params.TemplateId,
+				}: params.GroupId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = PreviewQrTemplateParams
-			Response = PreviewQrTemplateRes
+			Request  = *RemoveGroupPermissionsReq
+			Params   = RemoveGroupPermissionsParams
+			Response = RemoveGroupPermissionsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -14918,14 +18230,14 @@ func (s *Server) handlePreviewQrTemplateRequest(args [1]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			unpackPreviewQrTemplateParams,
+			unpackRemoveGroupPermissionsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.PreviewQrTemplate(ctx, params)
+				response, err = s.h.RemoveGroupPermissions(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.PreviewQrTemplate(ctx, params)
+		response, err = s.h.RemoveGroupPermissions(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -14933,7 +18245,7 @@ func (s *Server) handlePreviewQrTemplateRequest(args [1]string, argsEscaped bool
 		return
 	}
 
-	if err := encodePreviewQrTemplateResponse(response, w, span); err != nil {
+	if err := encodeRemoveGroupPermissionsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -14942,22 +18254,22 @@ func (s *Server) handlePreviewQrTemplateRequest(args [1]string, argsEscaped bool
 	}
 }
 
-// handleRefreshTokenRequest handles refreshToken operation.
+// handleRemovePlaceBlockingEntriesRequest handles removePlaceBlockingEntries operation.
 //
-// Refresh access token.
+// Remove blocking periods by IDs.
 //
-// POST /auth/refresh
-func (s *Server) handleRefreshTokenRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /places/{placeId}/blocking/entries
+func (s *Server) handleRemovePlaceBlockingEntriesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("refreshToken"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/auth/refresh"),
+		otelogen.OperationID("removePlaceBlockingEntries"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/places/{placeId}/blocking/entries"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), RefreshTokenOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RemovePlaceBlockingEntriesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -15012,13 +18324,85 @@ func (s *Server) handleRefreshTokenRequest(args [0]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: RefreshTokenOperation,
-			ID:   "refreshToken",
+			Name: RemovePlaceBlockingEntriesOperation,
+			ID:   "removePlaceBlockingEntries",
 		}
 	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, RemovePlaceBlockingEntriesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityApiKeyAuth(ctx, RemovePlaceBlockingEntriesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:ApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeRemovePlaceBlockingEntriesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeRefreshTokenRequest(r)
+	request, rawBody, close, err := s.decodeRemovePlaceBlockingEntriesRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -15034,23 +18418,28 @@ func (s *Server) handleRefreshTokenRequest(args [0]string, argsEscaped bool, w h
 		}
 	}()
 
-	var response RefreshTokenRes
+	var response RemovePlaceBlockingEntriesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    RefreshTokenOperation,
-			OperationSummary: "Refresh access token",
-			OperationID:      "refreshToken",
+			OperationName:    RemovePlaceBlockingEntriesOperation,
+			OperationSummary: "Remove blocking periods by IDs",
+			OperationID:      "removePlaceBlockingEntries",
 			Body:             request,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "placeId",
+					In:   "path",
+				}: params.PlaceId,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *RefreshTokenReq
-			Params   = struct{}
-			Response = RefreshTokenRes
+			Request  = *RemovePlaceBlockingEntriesReq
+			Params   = RemovePlaceBlockingEntriesParams
+			Response = RemovePlaceBlockingEntriesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -15059,14 +18448,14 @@ func (s *Server) handleRefreshTokenRequest(args [0]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			nil,
+			unpackRemovePlaceBlockingEntriesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.RefreshToken(ctx, request)
+				response, err = s.h.RemovePlaceBlockingEntries(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.RefreshToken(ctx, request)
+		response, err = s.h.RemovePlaceBlockingEntries(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -15074,7 +18463,7 @@ func (s *Server) handleRefreshTokenRequest(args [0]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeRefreshTokenResponse(response, w, span); err != nil {
+	if err := encodeRemovePlaceBlockingEntriesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -15083,22 +18472,22 @@ func (s *Server) handleRefreshTokenRequest(args [0]string, argsEscaped bool, w h
 	}
 }
 
-// handleRemoveAreaBlockingEntriesRequest handles removeAreaBlockingEntries operation.
+// handleRemovePlaceEquipmentRequest handles removePlaceEquipment operation.
 //
-// Remove blocking periods by IDs.
+// Remove equipment from place.
 //
-// DELETE /areas/{areaId}/blocking/entries
-func (s *Server) handleRemoveAreaBlockingEntriesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /places/{placeId}/equipment
+func (s *Server) handleRemovePlaceEquipmentRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removeAreaBlockingEntries"),
+		otelogen.OperationID("removePlaceEquipment"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/areas/{areaId}/blocking/entries"),
+		semconv.HTTPRouteKey.String("/places/{placeId}/equipment"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveAreaBlockingEntriesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RemovePlaceEquipmentOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -15153,15 +18542,15 @@ func (s *Server) handleRemoveAreaBlockingEntriesRequest(args [1]string, argsEsca
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: RemoveAreaBlockingEntriesOperation,
-			ID:   "removeAreaBlockingEntries",
+			Name: RemovePlaceEquipmentOperation,
+			ID:   "removePlaceEquipment",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, RemoveAreaBlockingEntriesOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, RemovePlaceEquipmentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -15178,7 +18567,7 @@ func (s *Server) handleRemoveAreaBlockingEntriesRequest(args [1]string, argsEsca
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, RemoveAreaBlockingEntriesOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, RemovePlaceEquipmentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -15219,7 +18608,7 @@ func (s *Server) handleRemoveAreaBlockingEntriesRequest(args [1]string, argsEsca
 			return
 		}
 	}
-	params, err := decodeRemoveAreaBlockingEntriesParams(args, argsEscaped, r)
+	params, err := decodeRemovePlaceEquipmentParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -15231,7 +18620,7 @@ func (s *Server) handleRemoveAreaBlockingEntriesRequest(args [1]string, argsEsca
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeRemoveAreaBlockingEntriesRequest(r)
+	request, rawBody, close, err := s.decodeRemovePlaceEquipmentRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -15247,28 +18636,28 @@ func (s *Server) handleRemoveAreaBlockingEntriesRequest(args [1]string, argsEsca
 		}
 	}()
 
-	var response RemoveAreaBlockingEntriesRes
+	var response RemovePlaceEquipmentRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    RemoveAreaBlockingEntriesOperation,
-			OperationSummary: "Remove blocking periods by IDs",
-			OperationID:      "removeAreaBlockingEntries",
+			OperationName:    RemovePlaceEquipmentOperation,
+			OperationSummary: "Remove equipment from place",
+			OperationID:      "removePlaceEquipment",
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "areaId",
+					Name: "placeId",
 					In:   "path",
-				}: params.AreaId,
+				}: params.PlaceId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *RemoveAreaBlockingEntriesReq
-			Params   = RemoveAreaBlockingEntriesParams
-			Response = RemoveAreaBlockingEntriesRes
+			Request  = *RemovePlaceEquipmentReq
+			Params   = RemovePlaceEquipmentParams
+			Response = RemovePlaceEquipmentRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -15277,14 +18666,14 @@ func (s *Server) handleRemoveAreaBlockingEntriesRequest(args [1]string, argsEsca
 		](
 			m,
 			mreq,
-			unpackRemoveAreaBlockingEntriesParams,
+			unpackRemovePlaceEquipmentParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.RemoveAreaBlockingEntries(ctx, request, params)
+				response, err = s.h.RemovePlaceEquipment(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.RemoveAreaBlockingEntries(ctx, request, params)
+		response, err = s.h.RemovePlaceEquipment(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -15292,7 +18681,7 @@ func (s *Server) handleRemoveAreaBlockingEntriesRequest(args [1]string, argsEsca
 		return
 	}
 
-	if err := encodeRemoveAreaBlockingEntriesResponse(response, w, span); err != nil {
+	if err := encodeRemovePlaceEquipmentResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -15301,22 +18690,22 @@ func (s *Server) handleRemoveAreaBlockingEntriesRequest(args [1]string, argsEsca
 	}
 }
 
-// handleRemoveAreaRoomPlanMarkersRequest handles removeAreaRoomPlanMarkers operation.
+// handleRemovePlaceWhitelistUsersRequest handles removePlaceWhitelistUsers operation.
 //
-// Remove place markers by IDs.
+// Remove users from whitelist.
 //
-// DELETE /areas/{areaId}/roomPlan/markers
-func (s *Server) handleRemoveAreaRoomPlanMarkersRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /places/{placeId}/whitelist
+func (s *Server) handleRemovePlaceWhitelistUsersRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removeAreaRoomPlanMarkers"),
+		otelogen.OperationID("removePlaceWhitelistUsers"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/areas/{areaId}/roomPlan/markers"),
+		semconv.HTTPRouteKey.String("/places/{placeId}/whitelist"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveAreaRoomPlanMarkersOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RemovePlaceWhitelistUsersOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -15371,15 +18760,15 @@ func (s *Server) handleRemoveAreaRoomPlanMarkersRequest(args [1]string, argsEsca
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: RemoveAreaRoomPlanMarkersOperation,
-			ID:   "removeAreaRoomPlanMarkers",
+			Name: RemovePlaceWhitelistUsersOperation,
+			ID:   "removePlaceWhitelistUsers",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, RemoveAreaRoomPlanMarkersOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, RemovePlaceWhitelistUsersOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -15396,7 +18785,7 @@ func (s *Server) handleRemoveAreaRoomPlanMarkersRequest(args [1]string, argsEsca
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, RemoveAreaRoomPlanMarkersOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, RemovePlaceWhitelistUsersOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -15437,7 +18826,7 @@ func (s *Server) handleRemoveAreaRoomPlanMarkersRequest(args [1]string, argsEsca
 			return
 		}
 	}
-	params, err := decodeRemoveAreaRoomPlanMarkersParams(args, argsEscaped, r)
+	params, err := decodeRemovePlaceWhitelistUsersParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -15449,7 +18838,7 @@ func (s *Server) handleRemoveAreaRoomPlanMarkersRequest(args [1]string, argsEsca
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeRemoveAreaRoomPlanMarkersRequest(r)
+	request, rawBody, close, err := s.decodeRemovePlaceWhitelistUsersRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -15465,28 +18854,28 @@ func (s *Server) handleRemoveAreaRoomPlanMarkersRequest(args [1]string, argsEsca
 		}
 	}()
 
-	var response RemoveAreaRoomPlanMarkersRes
+	var response RemovePlaceWhitelistUsersRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    RemoveAreaRoomPlanMarkersOperation,
-			OperationSummary: "Remove place markers by IDs",
-			OperationID:      "removeAreaRoomPlanMarkers",
+			OperationName:    RemovePlaceWhitelistUsersOperation,
+			OperationSummary: "Remove users from whitelist",
+			OperationID:      "removePlaceWhitelistUsers",
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "areaId",
+					Name: "placeId",
 					In:   "path",
-				}: params.AreaId,
+				}: params.PlaceId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *RemoveAreaRoomPlanMarkersReq
-			Params   = RemoveAreaRoomPlanMarkersParams
-			Response = RemoveAreaRoomPlanMarkersRes
+			Request  = *RemovePlaceWhitelistUsersReq
+			Params   = RemovePlaceWhitelistUsersParams
+			Response = RemovePlaceWhitelistUsersRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -15495,14 +18884,14 @@ func (s *Server) handleRemoveAreaRoomPlanMarkersRequest(args [1]string, argsEsca
 		](
 			m,
 			mreq,
-			unpackRemoveAreaRoomPlanMarkersParams,
+			unpackRemovePlaceWhitelistUsersParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.RemoveAreaRoomPlanMarkers(ctx, request, params)
+				response, err = s.h.RemovePlaceWhitelistUsers(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.RemoveAreaRoomPlanMarkers(ctx, request, params)
+		response, err = s.h.RemovePlaceWhitelistUsers(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -15510,7 +18899,7 @@ func (s *Server) handleRemoveAreaRoomPlanMarkersRequest(args [1]string, argsEsca
 		return
 	}
 
-	if err := encodeRemoveAreaRoomPlanMarkersResponse(response, w, span); err != nil {
+	if err := encodeRemovePlaceWhitelistUsersResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -15519,22 +18908,22 @@ func (s *Server) handleRemoveAreaRoomPlanMarkersRequest(args [1]string, argsEsca
 	}
 }
 
-// handleRemoveBuildingBlockingEntriesRequest handles removeBuildingBlockingEntries operation.
+// handleRemoveUserGroupsRequest handles removeUserGroups operation.
 //
-// Remove blocking periods by IDs.
+// Remove groups from user.
 //
-// DELETE /buildings/{buildingId}/blocking/entries
-func (s *Server) handleRemoveBuildingBlockingEntriesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /users/{userId}/groups
+func (s *Server) handleRemoveUserGroupsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removeBuildingBlockingEntries"),
+		otelogen.OperationID("removeUserGroups"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/buildings/{buildingId}/blocking/entries"),
+		semconv.HTTPRouteKey.String("/users/{userId}/groups"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveBuildingBlockingEntriesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveUserGroupsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -15589,15 +18978,15 @@ func (s *Server) handleRemoveBuildingBlockingEntriesRequest(args [1]string, args
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: RemoveBuildingBlockingEntriesOperation,
-			ID:   "removeBuildingBlockingEntries",
+			Name: RemoveUserGroupsOperation,
+			ID:   "removeUserGroups",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, RemoveBuildingBlockingEntriesOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, RemoveUserGroupsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -15614,7 +19003,7 @@ func (s *Server) handleRemoveBuildingBlockingEntriesRequest(args [1]string, args
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, RemoveBuildingBlockingEntriesOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, RemoveUserGroupsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -15655,7 +19044,7 @@ func (s *Server) handleRemoveBuildingBlockingEntriesRequest(args [1]string, args
 			return
 		}
 	}
-	params, err := decodeRemoveBuildingBlockingEntriesParams(args, argsEscaped, r)
+	params, err := decodeRemoveUserGroupsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -15667,7 +19056,7 @@ func (s *Server) handleRemoveBuildingBlockingEntriesRequest(args [1]string, args
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeRemoveBuildingBlockingEntriesRequest(r)
+	request, rawBody, close, err := s.decodeRemoveUserGroupsRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -15683,28 +19072,28 @@ func (s *Server) handleRemoveBuildingBlockingEntriesRequest(args [1]string, args
 		}
 	}()
 
-	var response RemoveBuildingBlockingEntriesRes
+	var response RemoveUserGroupsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    RemoveBuildingBlockingEntriesOperation,
-			OperationSummary: "Remove blocking periods by IDs",
-			OperationID:      "removeBuildingBlockingEntries",
+			OperationName:    RemoveUserGroupsOperation,
+			OperationSummary: "Remove groups from user",
+			OperationID:      "removeUserGroups",
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "buildingId",
+					Name: "userId",
 					In:   "path",
-				}: params.BuildingId,
+				}: params.UserId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *RemoveBuildingBlockingEntriesReq
-			Params   = RemoveBuildingBlockingEntriesParams
-			Response = RemoveBuildingBlockingEntriesRes
+			Request  = *RemoveUserGroupsReq
+			Params   = RemoveUserGroupsParams
+			Response = RemoveUserGroupsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -15713,14 +19102,14 @@ func (s *Server) handleRemoveBuildingBlockingEntriesRequest(args [1]string, args
 		](
 			m,
 			mreq,
-			unpackRemoveBuildingBlockingEntriesParams,
+			unpackRemoveUserGroupsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.RemoveBuildingBlockingEntries(ctx, request, params)
+				response, err = s.h.RemoveUserGroups(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.RemoveBuildingBlockingEntries(ctx, request, params)
+		response, err = s.h.RemoveUserGroups(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -15728,7 +19117,7 @@ func (s *Server) handleRemoveBuildingBlockingEntriesRequest(args [1]string, args
 		return
 	}
 
-	if err := encodeRemoveBuildingBlockingEntriesResponse(response, w, span); err != nil {
+	if err := encodeRemoveUserGroupsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -15737,22 +19126,22 @@ func (s *Server) handleRemoveBuildingBlockingEntriesRequest(args [1]string, args
 	}
 }
 
-// handleRemoveCurrentUserFavoritesRequest handles removeCurrentUserFavorites operation.
+// handleReplaceAreaBlockingRequest handles replaceAreaBlocking operation.
 //
-// Remove places from favorites.
+// Replace all area blocking periods.
 //
-// DELETE /users/me/favorites
-func (s *Server) handleRemoveCurrentUserFavoritesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /areas/{areaId}/blocking
+func (s *Server) handleReplaceAreaBlockingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removeCurrentUserFavorites"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/users/me/favorites"),
+		otelogen.OperationID("replaceAreaBlocking"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/areas/{areaId}/blocking"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveCurrentUserFavoritesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ReplaceAreaBlockingOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -15807,15 +19196,15 @@ func (s *Server) handleRemoveCurrentUserFavoritesRequest(args [0]string, argsEsc
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: RemoveCurrentUserFavoritesOperation,
-			ID:   "removeCurrentUserFavorites",
+			Name: ReplaceAreaBlockingOperation,
+			ID:   "replaceAreaBlocking",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, RemoveCurrentUserFavoritesOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, ReplaceAreaBlockingOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -15832,7 +19221,7 @@ func (s *Server) handleRemoveCurrentUserFavoritesRequest(args [0]string, argsEsc
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, RemoveCurrentUserFavoritesOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, ReplaceAreaBlockingOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -15873,9 +19262,19 @@ func (s *Server) handleRemoveCurrentUserFavoritesRequest(args [0]string, argsEsc
 			return
 		}
 	}
+	params, err := decodeReplaceAreaBlockingParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeRemoveCurrentUserFavoritesRequest(r)
+	request, rawBody, close, err := s.decodeReplaceAreaBlockingRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -15891,23 +19290,28 @@ func (s *Server) handleRemoveCurrentUserFavoritesRequest(args [0]string, argsEsc
 		}
 	}()
 
-	var response RemoveCurrentUserFavoritesRes
+	var response ReplaceAreaBlockingRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    RemoveCurrentUserFavoritesOperation,
-			OperationSummary: "Remove places from favorites",
-			OperationID:      "removeCurrentUserFavorites",
+			OperationName:    ReplaceAreaBlockingOperation,
+			OperationSummary: "Replace all area blocking periods",
+			OperationID:      "replaceAreaBlocking",
 			Body:             request,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "areaId",
+					In:   "path",
+				}: params.AreaId,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *RemoveCurrentUserFavoritesReq
-			Params   = struct{}
-			Response = RemoveCurrentUserFavoritesRes
+			Request  = *ReplaceAreaBlockingReq
+			Params   = ReplaceAreaBlockingParams
+			Response = ReplaceAreaBlockingRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -15916,14 +19320,14 @@ func (s *Server) handleRemoveCurrentUserFavoritesRequest(args [0]string, argsEsc
 		](
 			m,
 			mreq,
-			nil,
+			unpackReplaceAreaBlockingParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.RemoveCurrentUserFavorites(ctx, request)
+				response, err = s.h.ReplaceAreaBlocking(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.RemoveCurrentUserFavorites(ctx, request)
+		response, err = s.h.ReplaceAreaBlocking(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -15931,7 +19335,7 @@ func (s *Server) handleRemoveCurrentUserFavoritesRequest(args [0]string, argsEsc
 		return
 	}
 
-	if err := encodeRemoveCurrentUserFavoritesResponse(response, w, span); err != nil {
+	if err := encodeReplaceAreaBlockingResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -15940,22 +19344,22 @@ func (s *Server) handleRemoveCurrentUserFavoritesRequest(args [0]string, argsEsc
 	}
 }
 
-// handleRemoveGroupPermissionsRequest handles removeGroupPermissions operation.
+// handleReplaceBuildingBlockingRequest handles replaceBuildingBlocking operation.
 //
-// Remove permissions from group.
+// Replace all building blocking periods.
 //
-// DELETE /groups/{groupId}/permissions
-func (s *Server) handleRemoveGroupPermissionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /buildings/{buildingId}/blocking
+func (s *Server) handleReplaceBuildingBlockingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removeGroupPermissions"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/groups/{groupId}/permissions"),
+		otelogen.OperationID("replaceBuildingBlocking"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/buildings/{buildingId}/blocking"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveGroupPermissionsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ReplaceBuildingBlockingOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -16010,15 +19414,15 @@ func (s *Server) handleRemoveGroupPermissionsRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: RemoveGroupPermissionsOperation,
-			ID:   "removeGroupPermissions",
+			Name: ReplaceBuildingBlockingOperation,
+			ID:   "replaceBuildingBlocking",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, RemoveGroupPermissionsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, ReplaceBuildingBlockingOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -16035,7 +19439,7 @@ func (s *Server) handleRemoveGroupPermissionsRequest(args [1]string, argsEscaped
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, RemoveGroupPermissionsOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, ReplaceBuildingBlockingOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -16076,7 +19480,7 @@ func (s *Server) handleRemoveGroupPermissionsRequest(args [1]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeRemoveGroupPermissionsParams(args, argsEscaped, r)
+	params, err := decodeReplaceBuildingBlockingParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -16088,7 +19492,7 @@ func (s *Server) handleRemoveGroupPermissionsRequest(args [1]string, argsEscaped
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeRemoveGroupPermissionsRequest(r)
+	request, rawBody, close, err := s.decodeReplaceBuildingBlockingRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -16104,28 +19508,28 @@ func (s *Server) handleRemoveGroupPermissionsRequest(args [1]string, argsEscaped
 		}
 	}()
 
-	var response RemoveGroupPermissionsRes
+	var response ReplaceBuildingBlockingRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    RemoveGroupPermissionsOperation,
-			OperationSummary: "Remove permissions from group",
-			OperationID:      "removeGroupPermissions",
+			OperationName:    ReplaceBuildingBlockingOperation,
+			OperationSummary: "Replace all building blocking periods",
+			OperationID:      "replaceBuildingBlocking",
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "groupId",
+					Name: "buildingId",
 					In:   "path",
-				}: params.GroupId,
+				}: params.BuildingId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *RemoveGroupPermissionsReq
-			Params   = RemoveGroupPermissionsParams
-			Response = RemoveGroupPermissionsRes
+			Request  = *ReplaceBuildingBlockingReq
+			Params   = ReplaceBuildingBlockingParams
+			Response = ReplaceBuildingBlockingRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -16134,14 +19538,14 @@ func (s *Server) handleRemoveGroupPermissionsRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackRemoveGroupPermissionsParams,
+			unpackReplaceBuildingBlockingParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.RemoveGroupPermissions(ctx, request, params)
+				response, err = s.h.ReplaceBuildingBlocking(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.RemoveGroupPermissions(ctx, request, params)
+		response, err = s.h.ReplaceBuildingBlocking(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -16149,7 +19553,7 @@ func (s *Server) handleRemoveGroupPermissionsRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeRemoveGroupPermissionsResponse(response, w, span); err != nil {
+	if err := encodeReplaceBuildingBlockingResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -16158,22 +19562,22 @@ func (s *Server) handleRemoveGroupPermissionsRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleRemovePlaceBlockingEntriesRequest handles removePlaceBlockingEntries operation.
+// handleReplacePlaceBlockingRequest handles replacePlaceBlocking operation.
 //
-// Remove blocking periods by IDs.
+// Replace all place blocking periods.
 //
-// DELETE /places/{placeId}/blocking/entries
-func (s *Server) handleRemovePlaceBlockingEntriesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /places/{placeId}/blocking
+func (s *Server) handleReplacePlaceBlockingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removePlaceBlockingEntries"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/places/{placeId}/blocking/entries"),
+		otelogen.OperationID("replacePlaceBlocking"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/places/{placeId}/blocking"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), RemovePlaceBlockingEntriesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ReplacePlaceBlockingOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -16228,15 +19632,15 @@ func (s *Server) handleRemovePlaceBlockingEntriesRequest(args [1]string, argsEsc
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: RemovePlaceBlockingEntriesOperation,
-			ID:   "removePlaceBlockingEntries",
+			Name: ReplacePlaceBlockingOperation,
+			ID:   "replacePlaceBlocking",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, RemovePlaceBlockingEntriesOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, ReplacePlaceBlockingOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -16253,7 +19657,7 @@ func (s *Server) handleRemovePlaceBlockingEntriesRequest(args [1]string, argsEsc
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, RemovePlaceBlockingEntriesOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, ReplacePlaceBlockingOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -16294,7 +19698,7 @@ func (s *Server) handleRemovePlaceBlockingEntriesRequest(args [1]string, argsEsc
 			return
 		}
 	}
-	params, err := decodeRemovePlaceBlockingEntriesParams(args, argsEscaped, r)
+	params, err := decodeReplacePlaceBlockingParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -16306,7 +19710,7 @@ func (s *Server) handleRemovePlaceBlockingEntriesRequest(args [1]string, argsEsc
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeRemovePlaceBlockingEntriesRequest(r)
+	request, rawBody, close, err := s.decodeReplacePlaceBlockingRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -16322,13 +19726,13 @@ func (s *Server) handleRemovePlaceBlockingEntriesRequest(args [1]string, argsEsc
 		}
 	}()
 
-	var response RemovePlaceBlockingEntriesRes
+	var response ReplacePlaceBlockingRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    RemovePlaceBlockingEntriesOperation,
-			OperationSummary: "Remove blocking periods by IDs",
-			OperationID:      "removePlaceBlockingEntries",
+			OperationName:    ReplacePlaceBlockingOperation,
+			OperationSummary: "Replace all place blocking periods",
+			OperationID:      "replacePlaceBlocking",
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -16341,9 +19745,9 @@ func (s *Server) handleRemovePlaceBlockingEntriesRequest(args [1]string, argsEsc
 		}
 
 		type (
-			Request  = *RemovePlaceBlockingEntriesReq
-			Params   = RemovePlaceBlockingEntriesParams
-			Response = RemovePlaceBlockingEntriesRes
+			Request  = *ReplacePlaceBlockingReq
+			Params   = ReplacePlaceBlockingParams
+			Response = ReplacePlaceBlockingRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -16352,14 +19756,14 @@ func (s *Server) handleRemovePlaceBlockingEntriesRequest(args [1]string, argsEsc
 		](
 			m,
 			mreq,
-			unpackRemovePlaceBlockingEntriesParams,
+			unpackReplacePlaceBlockingParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.RemovePlaceBlockingEntries(ctx, request, params)
+				response, err = s.h.ReplacePlaceBlocking(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.RemovePlaceBlockingEntries(ctx, request, params)
+		response, err = s.h.ReplacePlaceBlocking(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -16367,7 +19771,7 @@ func (s *Server) handleRemovePlaceBlockingEntriesRequest(args [1]string, argsEsc
 		return
 	}
 
-	if err := encodeRemovePlaceBlockingEntriesResponse(response, w, span); err != nil {
+	if err := encodeReplacePlaceBlockingResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -16376,22 +19780,22 @@ func (s *Server) handleRemovePlaceBlockingEntriesRequest(args [1]string, argsEsc
 	}
 }
 
-// handleRemovePlaceEquipmentRequest handles removePlaceEquipment operation.
+// handleRevokeApiKeyRequest handles revokeApiKey operation.
 //
-// Remove equipment from place.
+// Revoke API key.
 //
-// DELETE /places/{placeId}/equipment
-func (s *Server) handleRemovePlaceEquipmentRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /apiKeys/{keyId}
+func (s *Server) handleRevokeApiKeyRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removePlaceEquipment"),
+		otelogen.OperationID("revokeApiKey"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/places/{placeId}/equipment"),
+		semconv.HTTPRouteKey.String("/apiKeys/{keyId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), RemovePlaceEquipmentOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RevokeApiKeyOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -16446,15 +19850,15 @@ func (s *Server) handleRemovePlaceEquipmentRequest(args [1]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: RemovePlaceEquipmentOperation,
-			ID:   "removePlaceEquipment",
+			Name: RevokeApiKeyOperation,
+			ID:   "revokeApiKey",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, RemovePlaceEquipmentOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, RevokeApiKeyOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -16471,7 +19875,7 @@ func (s *Server) handleRemovePlaceEquipmentRequest(args [1]string, argsEscaped b
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, RemovePlaceEquipmentOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, RevokeApiKeyOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -16512,7 +19916,7 @@ func (s *Server) handleRemovePlaceEquipmentRequest(args [1]string, argsEscaped b
 			return
 		}
 	}
-	params, err := decodeRemovePlaceEquipmentParams(args, argsEscaped, r)
+	params, err := decodeRevokeApiKeyParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -16524,44 +19928,29 @@ func (s *Server) handleRemovePlaceEquipmentRequest(args [1]string, argsEscaped b
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeRemovePlaceEquipmentRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response RemovePlaceEquipmentRes
+	var response RevokeApiKeyRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    RemovePlaceEquipmentOperation,
-			OperationSummary: "Remove equipment from place",
-			OperationID:      "removePlaceEquipment",
-			Body:             request,
+			OperationName:    RevokeApiKeyOperation,
+			OperationSummary: "Revoke API key",
+			OperationID:      "revokeApiKey",
+			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "placeId",
+					Name: "keyId",
 					In:   "path",
-				}: params.PlaceId,
+				}: params.KeyId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *RemovePlaceEquipmentReq
-			Params   = RemovePlaceEquipmentParams
-			Response = RemovePlaceEquipmentRes
+			Request  = struct{}
+			Params   = RevokeApiKeyParams
+			Response = RevokeApiKeyRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -16570,14 +19959,14 @@ func (s *Server) handleRemovePlaceEquipmentRequest(args [1]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackRemovePlaceEquipmentParams,
+			unpackRevokeApiKeyParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.RemovePlaceEquipment(ctx, request, params)
+				response, err = s.h.RevokeApiKey(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.RemovePlaceEquipment(ctx, request, params)
+		response, err = s.h.RevokeApiKey(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -16585,7 +19974,7 @@ func (s *Server) handleRemovePlaceEquipmentRequest(args [1]string, argsEscaped b
 		return
 	}
 
-	if err := encodeRemovePlaceEquipmentResponse(response, w, span); err != nil {
+	if err := encodeRevokeApiKeyResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -16594,22 +19983,22 @@ func (s *Server) handleRemovePlaceEquipmentRequest(args [1]string, argsEscaped b
 	}
 }
 
-// handleRemovePlaceWhitelistUsersRequest handles removePlaceWhitelistUsers operation.
+// handleRevokeCurrentUserCalendarTokenRequest handles revokeCurrentUserCalendarToken operation.
 //
-// Remove users from whitelist.
+// Revoke personal calendar feed token.
 //
-// DELETE /places/{placeId}/whitelist
-func (s *Server) handleRemovePlaceWhitelistUsersRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /users/me/calendar-token
+func (s *Server) handleRevokeCurrentUserCalendarTokenRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removePlaceWhitelistUsers"),
+		otelogen.OperationID("revokeCurrentUserCalendarToken"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/places/{placeId}/whitelist"),
+		semconv.HTTPRouteKey.String("/users/me/calendar-token"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), RemovePlaceWhitelistUsersOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RevokeCurrentUserCalendarTokenOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -16664,15 +20053,15 @@ func (s *Server) handleRemovePlaceWhitelistUsersRequest(args [1]string, argsEsca
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: RemovePlaceWhitelistUsersOperation,
-			ID:   "removePlaceWhitelistUsers",
+			Name: RevokeCurrentUserCalendarTokenOperation,
+			ID:   "revokeCurrentUserCalendarToken",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, RemovePlaceWhitelistUsersOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, RevokeCurrentUserCalendarTokenOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -16689,7 +20078,7 @@ func (s *Server) handleRemovePlaceWhitelistUsersRequest(args [1]string, argsEsca
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, RemovePlaceWhitelistUsersOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, RevokeCurrentUserCalendarTokenOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -16730,56 +20119,26 @@ func (s *Server) handleRemovePlaceWhitelistUsersRequest(args [1]string, argsEsca
 			return
 		}
 	}
-	params, err := decodeRemovePlaceWhitelistUsersParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeRemovePlaceWhitelistUsersRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response RemovePlaceWhitelistUsersRes
+	var response RevokeCurrentUserCalendarTokenRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    RemovePlaceWhitelistUsersOperation,
-			OperationSummary: "Remove users from whitelist",
-			OperationID:      "removePlaceWhitelistUsers",
-			Body:             request,
+			OperationName:    RevokeCurrentUserCalendarTokenOperation,
+			OperationSummary: "Revoke personal calendar feed token",
+			OperationID:      "revokeCurrentUserCalendarToken",
+			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "placeId",
-					In:   "path",
-				}: params.PlaceId,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = *RemovePlaceWhitelistUsersReq
-			Params   = RemovePlaceWhitelistUsersParams
-			Response = RemovePlaceWhitelistUsersRes
+			Request  = struct{}
+			Params   = struct{}
+			Response = RevokeCurrentUserCalendarTokenRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -16788,14 +20147,14 @@ func (s *Server) handleRemovePlaceWhitelistUsersRequest(args [1]string, argsEsca
 		](
 			m,
 			mreq,
-			unpackRemovePlaceWhitelistUsersParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.RemovePlaceWhitelistUsers(ctx, request, params)
+				response, err = s.h.RevokeCurrentUserCalendarToken(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.RemovePlaceWhitelistUsers(ctx, request, params)
+		response, err = s.h.RevokeCurrentUserCalendarToken(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -16803,7 +20162,7 @@ func (s *Server) handleRemovePlaceWhitelistUsersRequest(args [1]string, argsEsca
 		return
 	}
 
-	if err := encodeRemovePlaceWhitelistUsersResponse(response, w, span); err != nil {
+	if err := encodeRevokeCurrentUserCalendarTokenResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -16812,22 +20171,23 @@ func (s *Server) handleRemovePlaceWhitelistUsersRequest(args [1]string, argsEsca
 	}
 }
 
-// handleRemoveUserGroupsRequest handles removeUserGroups operation.
+// handleRotateCurrentUserCalendarTokenRequest handles rotateCurrentUserCalendarToken operation.
 //
-// Remove groups from user.
+// Creates a new secret token for the current user's personal calendar feed.
+// Any previous token is revoked, so subscriptions using the old feed URL stop working.
 //
-// DELETE /users/{userId}/groups
-func (s *Server) handleRemoveUserGroupsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /users/me/calendar-token
+func (s *Server) handleRotateCurrentUserCalendarTokenRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removeUserGroups"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/users/{userId}/groups"),
+		otelogen.OperationID("rotateCurrentUserCalendarToken"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/users/me/calendar-token"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveUserGroupsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RotateCurrentUserCalendarTokenOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -16882,15 +20242,15 @@ func (s *Server) handleRemoveUserGroupsRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: RemoveUserGroupsOperation,
-			ID:   "removeUserGroups",
+			Name: RotateCurrentUserCalendarTokenOperation,
+			ID:   "rotateCurrentUserCalendarToken",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, RemoveUserGroupsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, RotateCurrentUserCalendarTokenOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -16907,7 +20267,7 @@ func (s *Server) handleRemoveUserGroupsRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, RemoveUserGroupsOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, RotateCurrentUserCalendarTokenOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -16948,56 +20308,26 @@ func (s *Server) handleRemoveUserGroupsRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeRemoveUserGroupsParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeRemoveUserGroupsRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response RemoveUserGroupsRes
+	var response RotateCurrentUserCalendarTokenRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    RemoveUserGroupsOperation,
-			OperationSummary: "Remove groups from user",
-			OperationID:      "removeUserGroups",
-			Body:             request,
+			OperationName:    RotateCurrentUserCalendarTokenOperation,
+			OperationSummary: "Create or rotate personal calendar feed token",
+			OperationID:      "rotateCurrentUserCalendarToken",
+			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "userId",
-					In:   "path",
-				}: params.UserId,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = *RemoveUserGroupsReq
-			Params   = RemoveUserGroupsParams
-			Response = RemoveUserGroupsRes
+			Request  = struct{}
+			Params   = struct{}
+			Response = RotateCurrentUserCalendarTokenRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -17006,14 +20336,14 @@ func (s *Server) handleRemoveUserGroupsRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackRemoveUserGroupsParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.RemoveUserGroups(ctx, request, params)
+				response, err = s.h.RotateCurrentUserCalendarToken(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.RemoveUserGroups(ctx, request, params)
+		response, err = s.h.RotateCurrentUserCalendarToken(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -17021,7 +20351,7 @@ func (s *Server) handleRemoveUserGroupsRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeRemoveUserGroupsResponse(response, w, span); err != nil {
+	if err := encodeRotateCurrentUserCalendarTokenResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -17030,22 +20360,23 @@ func (s *Server) handleRemoveUserGroupsRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleReplaceAreaBlockingRequest handles replaceAreaBlocking operation.
+// handleRotatePlaceQrKeyRequest handles rotatePlaceQrKey operation.
 //
-// Replace all area blocking periods.
+// Increments the key version of the place. All QR codes printed before are rejected at
+// check-in with QR_CODE_REVOKED and have to be replaced by newly generated ones.
 //
-// PUT /areas/{areaId}/blocking
-func (s *Server) handleReplaceAreaBlockingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /places/{placeId}/qrCode/key
+func (s *Server) handleRotatePlaceQrKeyRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("replaceAreaBlocking"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/areas/{areaId}/blocking"),
+		otelogen.OperationID("rotatePlaceQrKey"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/places/{placeId}/qrCode/key"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ReplaceAreaBlockingOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RotatePlaceQrKeyOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -17100,15 +20431,15 @@ func (s *Server) handleReplaceAreaBlockingRequest(args [1]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ReplaceAreaBlockingOperation,
-			ID:   "replaceAreaBlocking",
+			Name: RotatePlaceQrKeyOperation,
+			ID:   "rotatePlaceQrKey",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ReplaceAreaBlockingOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, RotatePlaceQrKeyOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -17125,7 +20456,7 @@ func (s *Server) handleReplaceAreaBlockingRequest(args [1]string, argsEscaped bo
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, ReplaceAreaBlockingOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, RotatePlaceQrKeyOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -17166,7 +20497,7 @@ func (s *Server) handleReplaceAreaBlockingRequest(args [1]string, argsEscaped bo
 			return
 		}
 	}
-	params, err := decodeReplaceAreaBlockingParams(args, argsEscaped, r)
+	params, err := decodeRotatePlaceQrKeyParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -17178,44 +20509,29 @@ func (s *Server) handleReplaceAreaBlockingRequest(args [1]string, argsEscaped bo
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeReplaceAreaBlockingRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response ReplaceAreaBlockingRes
+	var response RotatePlaceQrKeyRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ReplaceAreaBlockingOperation,
-			OperationSummary: "Replace all area blocking periods",
-			OperationID:      "replaceAreaBlocking",
-			Body:             request,
+			OperationName:    RotatePlaceQrKeyOperation,
+			OperationSummary: "Rotate the QR key of a place",
+			OperationID:      "rotatePlaceQrKey",
+			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "areaId",
+					Name: "placeId",
 					In:   "path",
-				}: params.AreaId,
+				}: params.PlaceId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *ReplaceAreaBlockingReq
-			Params   = ReplaceAreaBlockingParams
-			Response = ReplaceAreaBlockingRes
+			Request  = struct{}
+			Params   = RotatePlaceQrKeyParams
+			Response = RotatePlaceQrKeyRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -17224,14 +20540,14 @@ func (s *Server) handleReplaceAreaBlockingRequest(args [1]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackReplaceAreaBlockingParams,
+			unpackRotatePlaceQrKeyParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ReplaceAreaBlocking(ctx, request, params)
+				response, err = s.h.RotatePlaceQrKey(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ReplaceAreaBlocking(ctx, request, params)
+		response, err = s.h.RotatePlaceQrKey(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -17239,7 +20555,7 @@ func (s *Server) handleReplaceAreaBlockingRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeReplaceAreaBlockingResponse(response, w, span); err != nil {
+	if err := encodeRotatePlaceQrKeyResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -17248,22 +20564,22 @@ func (s *Server) handleReplaceAreaBlockingRequest(args [1]string, argsEscaped bo
 	}
 }
 
-// handleReplaceBuildingBlockingRequest handles replaceBuildingBlocking operation.
+// handleSetDefaultGroupAssignmentRequest handles setDefaultGroupAssignment operation.
 //
-// Replace all building blocking periods.
+// Set default groups for new users.
 //
-// PUT /buildings/{buildingId}/blocking
-func (s *Server) handleReplaceBuildingBlockingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /groups/defaultAssignment
+func (s *Server) handleSetDefaultGroupAssignmentRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("replaceBuildingBlocking"),
+		otelogen.OperationID("setDefaultGroupAssignment"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/buildings/{buildingId}/blocking"),
+		semconv.HTTPRouteKey.String("/groups/defaultAssignment"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ReplaceBuildingBlockingOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SetDefaultGroupAssignmentOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -17318,15 +20634,15 @@ func (s *Server) handleReplaceBuildingBlockingRequest(args [1]string, argsEscape
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ReplaceBuildingBlockingOperation,
-			ID:   "replaceBuildingBlocking",
+			Name: SetDefaultGroupAssignmentOperation,
+			ID:   "setDefaultGroupAssignment",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ReplaceBuildingBlockingOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, SetDefaultGroupAssignmentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -17343,7 +20659,7 @@ func (s *Server) handleReplaceBuildingBlockingRequest(args [1]string, argsEscape
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, ReplaceBuildingBlockingOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, SetDefaultGroupAssignmentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -17384,19 +20700,9 @@ func (s *Server) handleReplaceBuildingBlockingRequest(args [1]string, argsEscape
 			return
 		}
 	}
-	params, err := decodeReplaceBuildingBlockingParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeReplaceBuildingBlockingRequest(r)
+	request, rawBody, close, err := s.decodeSetDefaultGroupAssignmentRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -17412,28 +20718,23 @@ func (s *Server) handleReplaceBuildingBlockingRequest(args [1]string, argsEscape
 		}
 	}()
 
-	var response ReplaceBuildingBlockingRes
+	var response SetDefaultGroupAssignmentRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ReplaceBuildingBlockingOperation,
-			OperationSummary: "Replace all building blocking periods",
-			OperationID:      "replaceBuildingBlocking",
+			OperationName:    SetDefaultGroupAssignmentOperation,
+			OperationSummary: "Set default groups for new users",
+			OperationID:      "setDefaultGroupAssignment",
 			Body:             request,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "buildingId",
-					In:   "path",
-				}: params.BuildingId,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = *ReplaceBuildingBlockingReq
-			Params   = ReplaceBuildingBlockingParams
-			Response = ReplaceBuildingBlockingRes
+			Request  = *SetDefaultGroupAssignmentReq
+			Params   = struct{}
+			Response = SetDefaultGroupAssignmentRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -17442,14 +20743,14 @@ func (s *Server) handleReplaceBuildingBlockingRequest(args [1]string, argsEscape
 		](
 			m,
 			mreq,
-			unpackReplaceBuildingBlockingParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ReplaceBuildingBlocking(ctx, request, params)
+				response, err = s.h.SetDefaultGroupAssignment(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ReplaceBuildingBlocking(ctx, request, params)
+		response, err = s.h.SetDefaultGroupAssignment(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -17457,7 +20758,7 @@ func (s *Server) handleReplaceBuildingBlockingRequest(args [1]string, argsEscape
 		return
 	}
 
-	if err := encodeReplaceBuildingBlockingResponse(response, w, span); err != nil {
+	if err := encodeSetDefaultGroupAssignmentResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -17466,22 +20767,22 @@ func (s *Server) handleReplaceBuildingBlockingRequest(args [1]string, argsEscape
 	}
 }
 
-// handleReplacePlaceBlockingRequest handles replacePlaceBlocking operation.
+// handleUpdateAreaRequest handles updateArea operation.
 //
-// Replace all place blocking periods.
+// Update area.
 //
-// PUT /places/{placeId}/blocking
-func (s *Server) handleReplacePlaceBlockingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /areas/{areaId}
+func (s *Server) handleUpdateAreaRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("replacePlaceBlocking"),
+		otelogen.OperationID("updateArea"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/places/{placeId}/blocking"),
+		semconv.HTTPRouteKey.String("/areas/{areaId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ReplacePlaceBlockingOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateAreaOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -17536,15 +20837,15 @@ func (s *Server) handleReplacePlaceBlockingRequest(args [1]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ReplacePlaceBlockingOperation,
-			ID:   "replacePlaceBlocking",
+			Name: UpdateAreaOperation,
+			ID:   "updateArea",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ReplacePlaceBlockingOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateAreaOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -17561,7 +20862,7 @@ func (s *Server) handleReplacePlaceBlockingRequest(args [1]string, argsEscaped b
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, ReplacePlaceBlockingOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, UpdateAreaOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -17602,7 +20903,7 @@ func (s *Server) handleReplacePlaceBlockingRequest(args [1]string, argsEscaped b
 			return
 		}
 	}
-	params, err := decodeReplacePlaceBlockingParams(args, argsEscaped, r)
+	params, err := decodeUpdateAreaParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -17614,7 +20915,7 @@ func (s *Server) handleReplacePlaceBlockingRequest(args [1]string, argsEscaped b
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeReplacePlaceBlockingRequest(r)
+	request, rawBody, close, err := s.decodeUpdateAreaRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -17630,28 +20931,28 @@ func (s *Server) handleReplacePlaceBlockingRequest(args [1]string, argsEscaped b
 		}
 	}()
 
-	var response ReplacePlaceBlockingRes
+	var response UpdateAreaRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ReplacePlaceBlockingOperation,
-			OperationSummary: "Replace all place blocking periods",
-			OperationID:      "replacePlaceBlocking",
+			OperationName:    UpdateAreaOperation,
+			OperationSummary: "Update area",
+			OperationID:      "updateArea",
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "placeId",
+					Name: "areaId",
 					In:   "path",
-				}: params.PlaceId,
+				}: params.AreaId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *ReplacePlaceBlockingReq
-			Params   = ReplacePlaceBlockingParams
-			Response = ReplacePlaceBlockingRes
+			Request  = *UpdateAreaRequest
+			Params   = UpdateAreaParams
+			Response = UpdateAreaRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -17660,14 +20961,14 @@ func (s *Server) handleReplacePlaceBlockingRequest(args [1]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackReplacePlaceBlockingParams,
+			unpackUpdateAreaParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ReplacePlaceBlocking(ctx, request, params)
+				response, err = s.h.UpdateArea(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ReplacePlaceBlocking(ctx, request, params)
+		response, err = s.h.UpdateArea(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -17675,7 +20976,7 @@ func (s *Server) handleReplacePlaceBlockingRequest(args [1]string, argsEscaped b
 		return
 	}
 
-	if err := encodeReplacePlaceBlockingResponse(response, w, span); err != nil {
+	if err := encodeUpdateAreaResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -17684,22 +20985,22 @@ func (s *Server) handleReplacePlaceBlockingRequest(args [1]string, argsEscaped b
 	}
 }
 
-// handleRevokeApiKeyRequest handles revokeApiKey operation.
+// handleUpdateAreaConstraintsRequest handles updateAreaConstraints operation.
 //
-// Revoke API key.
+// Update area constraints.
 //
-// DELETE /apiKeys/{keyId}
-func (s *Server) handleRevokeApiKeyRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /areas/{areaId}/constraints
+func (s *Server) handleUpdateAreaConstraintsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("revokeApiKey"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/apiKeys/{keyId}"),
+		otelogen.OperationID("updateAreaConstraints"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/areas/{areaId}/constraints"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), RevokeApiKeyOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateAreaConstraintsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -17754,15 +21055,15 @@ func (s *Server) handleRevokeApiKeyRequest(args [1]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: RevokeApiKeyOperation,
-			ID:   "revokeApiKey",
+			Name: UpdateAreaConstraintsOperation,
+			ID:   "updateAreaConstraints",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, RevokeApiKeyOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateAreaConstraintsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -17779,7 +21080,7 @@ func (s *Server) handleRevokeApiKeyRequest(args [1]string, argsEscaped bool, w h
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, RevokeApiKeyOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, UpdateAreaConstraintsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -17820,7 +21121,7 @@ func (s *Server) handleRevokeApiKeyRequest(args [1]string, argsEscaped bool, w h
 			return
 		}
 	}
-	params, err := decodeRevokeApiKeyParams(args, argsEscaped, r)
+	params, err := decodeUpdateAreaConstraintsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -17832,29 +21133,44 @@ func (s *Server) handleRevokeApiKeyRequest(args [1]string, argsEscaped bool, w h
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeUpdateAreaConstraintsRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response RevokeApiKeyRes
+	var response UpdateAreaConstraintsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    RevokeApiKeyOperation,
-			OperationSummary: "Revoke API key",
-			OperationID:      "revokeApiKey",
-			Body:             nil,
+			OperationName:    UpdateAreaConstraintsOperation,
+			OperationSummary: "Update area constraints",
+			OperationID:      "updateAreaConstraints",
+			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "keyId",
+					Name: "areaId",
 					In:   "path",
-				}: params.KeyId,
+				}: params.AreaId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = RevokeApiKeyParams
-			Response = RevokeApiKeyRes
+			Request  = *PlaceConstraints
+			Params   = UpdateAreaConstraintsParams
+			Response = UpdateAreaConstraintsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -17863,14 +21179,14 @@ func (s *Server) handleRevokeApiKeyRequest(args [1]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			unpackRevokeApiKeyParams,
+			unpackUpdateAreaConstraintsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.RevokeApiKey(ctx, params)
+				response, err = s.h.UpdateAreaConstraints(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.RevokeApiKey(ctx, params)
+		response, err = s.h.UpdateAreaConstraints(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -17878,7 +21194,7 @@ func (s *Server) handleRevokeApiKeyRequest(args [1]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeRevokeApiKeyResponse(response, w, span); err != nil {
+	if err := encodeUpdateAreaConstraintsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -17887,22 +21203,22 @@ func (s *Server) handleRevokeApiKeyRequest(args [1]string, argsEscaped bool, w h
 	}
 }
 
-// handleSetDefaultGroupAssignmentRequest handles setDefaultGroupAssignment operation.
+// handleUpdateAreaRoomPlanRequest handles updateAreaRoomPlan operation.
 //
-// Set default groups for new users.
+// Update room plan.
 //
-// PUT /groups/defaultAssignment
-func (s *Server) handleSetDefaultGroupAssignmentRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /areas/{areaId}/roomPlan
+func (s *Server) handleUpdateAreaRoomPlanRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("setDefaultGroupAssignment"),
+		otelogen.OperationID("updateAreaRoomPlan"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/groups/defaultAssignment"),
+		semconv.HTTPRouteKey.String("/areas/{areaId}/roomPlan"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SetDefaultGroupAssignmentOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateAreaRoomPlanOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -17957,15 +21273,15 @@ func (s *Server) handleSetDefaultGroupAssignmentRequest(args [0]string, argsEsca
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SetDefaultGroupAssignmentOperation,
-			ID:   "setDefaultGroupAssignment",
+			Name: UpdateAreaRoomPlanOperation,
+			ID:   "updateAreaRoomPlan",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, SetDefaultGroupAssignmentOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateAreaRoomPlanOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -17982,7 +21298,7 @@ func (s *Server) handleSetDefaultGroupAssignmentRequest(args [0]string, argsEsca
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, SetDefaultGroupAssignmentOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, UpdateAreaRoomPlanOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -18023,9 +21339,19 @@ func (s *Server) handleSetDefaultGroupAssignmentRequest(args [0]string, argsEsca
 			return
 		}
 	}
+	params, err := decodeUpdateAreaRoomPlanParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeSetDefaultGroupAssignmentRequest(r)
+	request, rawBody, close, err := s.decodeUpdateAreaRoomPlanRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -18041,23 +21367,28 @@ func (s *Server) handleSetDefaultGroupAssignmentRequest(args [0]string, argsEsca
 		}
 	}()
 
-	var response SetDefaultGroupAssignmentRes
+	var response UpdateAreaRoomPlanRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SetDefaultGroupAssignmentOperation,
-			OperationSummary: "Set default groups for new users",
-			OperationID:      "setDefaultGroupAssignment",
+			OperationName:    UpdateAreaRoomPlanOperation,
+			OperationSummary: "Update room plan",
+			OperationID:      "updateAreaRoomPlan",
 			Body:             request,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "areaId",
+					In:   "path",
+				}: params.AreaId,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *SetDefaultGroupAssignmentReq
-			Params   = struct{}
-			Response = SetDefaultGroupAssignmentRes
+			Request  = *UpdateAreaRoomPlanReq
+			Params   = UpdateAreaRoomPlanParams
+			Response = UpdateAreaRoomPlanRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -18066,14 +21397,14 @@ func (s *Server) handleSetDefaultGroupAssignmentRequest(args [0]string, argsEsca
 		](
 			m,
 			mreq,
-			nil,
+			unpackUpdateAreaRoomPlanParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SetDefaultGroupAssignment(ctx, request)
+				response, err = s.h.UpdateAreaRoomPlan(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SetDefaultGroupAssignment(ctx, request)
+		response, err = s.h.UpdateAreaRoomPlan(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -18081,7 +21412,7 @@ func (s *Server) handleSetDefaultGroupAssignmentRequest(args [0]string, argsEsca
 		return
 	}
 
-	if err := encodeSetDefaultGroupAssignmentResponse(response, w, span); err != nil {
+	if err := encodeUpdateAreaRoomPlanResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -18090,22 +21421,22 @@ func (s *Server) handleSetDefaultGroupAssignmentRequest(args [0]string, argsEsca
 	}
 }
 
-// handleUpdateAreaRequest handles updateArea operation.
+// handleUpdateBuildingRequest handles updateBuilding operation.
 //
-// Update area.
+// Update building.
 //
-// PUT /areas/{areaId}
-func (s *Server) handleUpdateAreaRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /buildings/{buildingId}
+func (s *Server) handleUpdateBuildingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateArea"),
+		otelogen.OperationID("updateBuilding"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/areas/{areaId}"),
+		semconv.HTTPRouteKey.String("/buildings/{buildingId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateAreaOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateBuildingOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -18160,15 +21491,15 @@ func (s *Server) handleUpdateAreaRequest(args [1]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateAreaOperation,
-			ID:   "updateArea",
+			Name: UpdateBuildingOperation,
+			ID:   "updateBuilding",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UpdateAreaOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateBuildingOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -18185,7 +21516,7 @@ func (s *Server) handleUpdateAreaRequest(args [1]string, argsEscaped bool, w htt
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, UpdateAreaOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, UpdateBuildingOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -18226,7 +21557,7 @@ func (s *Server) handleUpdateAreaRequest(args [1]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	params, err := decodeUpdateAreaParams(args, argsEscaped, r)
+	params, err := decodeUpdateBuildingParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -18238,7 +21569,7 @@ func (s *Server) handleUpdateAreaRequest(args [1]string, argsEscaped bool, w htt
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeUpdateAreaRequest(r)
+	request, rawBody, close, err := s.decodeUpdateBuildingRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -18254,28 +21585,28 @@ func (s *Server) handleUpdateAreaRequest(args [1]string, argsEscaped bool, w htt
 		}
 	}()
 
-	var response UpdateAreaRes
+	var response UpdateBuildingRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateAreaOperation,
-			OperationSummary: "Update area",
-			OperationID:      "updateArea",
+			OperationName:    UpdateBuildingOperation,
+			OperationSummary: "Update building",
+			OperationID:      "updateBuilding",
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "areaId",
+					Name: "buildingId",
 					In:   "path",
-				}: params.AreaId,
+				}: params.BuildingId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *UpdateAreaRequest
-			Params   = UpdateAreaParams
-			Response = UpdateAreaRes
+			Request  = *UpdateBuildingRequest
+			Params   = UpdateBuildingParams
+			Response = UpdateBuildingRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -18284,14 +21615,14 @@ func (s *Server) handleUpdateAreaRequest(args [1]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackUpdateAreaParams,
+			unpackUpdateBuildingParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateArea(ctx, request, params)
+				response, err = s.h.UpdateBuilding(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateArea(ctx, request, params)
+		response, err = s.h.UpdateBuilding(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -18299,7 +21630,7 @@ func (s *Server) handleUpdateAreaRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeUpdateAreaResponse(response, w, span); err != nil {
+	if err := encodeUpdateBuildingResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -18308,22 +21639,22 @@ func (s *Server) handleUpdateAreaRequest(args [1]string, argsEscaped bool, w htt
 	}
 }
 
-// handleUpdateAreaRoomPlanRequest handles updateAreaRoomPlan operation.
+// handleUpdateBuildingConstraintsRequest handles updateBuildingConstraints operation.
 //
-// Update room plan.
+// Update building constraints.
 //
-// PUT /areas/{areaId}/roomPlan
-func (s *Server) handleUpdateAreaRoomPlanRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /buildings/{buildingId}/constraints
+func (s *Server) handleUpdateBuildingConstraintsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateAreaRoomPlan"),
+		otelogen.OperationID("updateBuildingConstraints"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/areas/{areaId}/roomPlan"),
+		semconv.HTTPRouteKey.String("/buildings/{buildingId}/constraints"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateAreaRoomPlanOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateBuildingConstraintsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -18378,15 +21709,15 @@ func (s *Server) handleUpdateAreaRoomPlanRequest(args [1]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateAreaRoomPlanOperation,
-			ID:   "updateAreaRoomPlan",
+			Name: UpdateBuildingConstraintsOperation,
+			ID:   "updateBuildingConstraints",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UpdateAreaRoomPlanOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateBuildingConstraintsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -18403,7 +21734,7 @@ func (s *Server) handleUpdateAreaRoomPlanRequest(args [1]string, argsEscaped boo
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, UpdateAreaRoomPlanOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, UpdateBuildingConstraintsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -18444,7 +21775,7 @@ func (s *Server) handleUpdateAreaRoomPlanRequest(args [1]string, argsEscaped boo
 			return
 		}
 	}
-	params, err := decodeUpdateAreaRoomPlanParams(args, argsEscaped, r)
+	params, err := decodeUpdateBuildingConstraintsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -18456,7 +21787,7 @@ func (s *Server) handleUpdateAreaRoomPlanRequest(args [1]string, argsEscaped boo
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeUpdateAreaRoomPlanRequest(r)
+	request, rawBody, close, err := s.decodeUpdateBuildingConstraintsRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -18472,28 +21803,28 @@ func (s *Server) handleUpdateAreaRoomPlanRequest(args [1]string, argsEscaped boo
 		}
 	}()
 
-	var response UpdateAreaRoomPlanRes
+	var response UpdateBuildingConstraintsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateAreaRoomPlanOperation,
-			OperationSummary: "Update room plan",
-			OperationID:      "updateAreaRoomPlan",
+			OperationName:    UpdateBuildingConstraintsOperation,
+			OperationSummary: "Update building constraints",
+			OperationID:      "updateBuildingConstraints",
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "areaId",
+					Name: "buildingId",
 					In:   "path",
-				}: params.AreaId,
+				}: params.BuildingId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *UpdateAreaRoomPlanReq
-			Params   = UpdateAreaRoomPlanParams
-			Response = UpdateAreaRoomPlanRes
+			Request  = *PlaceConstraints
+			Params   = UpdateBuildingConstraintsParams
+			Response = UpdateBuildingConstraintsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -18502,14 +21833,14 @@ func (s *Server) handleUpdateAreaRoomPlanRequest(args [1]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackUpdateAreaRoomPlanParams,
+			unpackUpdateBuildingConstraintsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateAreaRoomPlan(ctx, request, params)
+				response, err = s.h.UpdateBuildingConstraints(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateAreaRoomPlan(ctx, request, params)
+		response, err = s.h.UpdateBuildingConstraints(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -18517,7 +21848,7 @@ func (s *Server) handleUpdateAreaRoomPlanRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeUpdateAreaRoomPlanResponse(response, w, span); err != nil {
+	if err := encodeUpdateBuildingConstraintsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -18526,22 +21857,22 @@ func (s *Server) handleUpdateAreaRoomPlanRequest(args [1]string, argsEscaped boo
 	}
 }
 
-// handleUpdateBuildingRequest handles updateBuilding operation.
+// handleUpdateCurrentUserNotificationsRequest handles updateCurrentUserNotifications operation.
 //
-// Update building.
+// Update notification preferences.
 //
-// PUT /buildings/{buildingId}
-func (s *Server) handleUpdateBuildingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /users/me/notifications
+func (s *Server) handleUpdateCurrentUserNotificationsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateBuilding"),
+		otelogen.OperationID("updateCurrentUserNotifications"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/buildings/{buildingId}"),
+		semconv.HTTPRouteKey.String("/users/me/notifications"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateBuildingOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateCurrentUserNotificationsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -18596,15 +21927,15 @@ func (s *Server) handleUpdateBuildingRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateBuildingOperation,
-			ID:   "updateBuilding",
+			Name: UpdateCurrentUserNotificationsOperation,
+			ID:   "updateCurrentUserNotifications",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UpdateBuildingOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateCurrentUserNotificationsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -18621,7 +21952,7 @@ func (s *Server) handleUpdateBuildingRequest(args [1]string, argsEscaped bool, w
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, UpdateBuildingOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, UpdateCurrentUserNotificationsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -18662,19 +21993,9 @@ func (s *Server) handleUpdateBuildingRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeUpdateBuildingParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeUpdateBuildingRequest(r)
+	request, rawBody, close, err := s.decodeUpdateCurrentUserNotificationsRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -18690,28 +22011,23 @@ func (s *Server) handleUpdateBuildingRequest(args [1]string, argsEscaped bool, w
 		}
 	}()
 
-	var response UpdateBuildingRes
+	var response UpdateCurrentUserNotificationsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateBuildingOperation,
-			OperationSummary: "Update building",
-			OperationID:      "updateBuilding",
+			OperationName:    UpdateCurrentUserNotificationsOperation,
+			OperationSummary: "Update notification preferences",
+			OperationID:      "updateCurrentUserNotifications",
 			Body:             request,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "buildingId",
-					In:   "path",
-				}: params.BuildingId,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = *UpdateBuildingRequest
-			Params   = UpdateBuildingParams
-			Response = UpdateBuildingRes
+			Request  = *NotificationPreferences
+			Params   = struct{}
+			Response = UpdateCurrentUserNotificationsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -18720,14 +22036,14 @@ func (s *Server) handleUpdateBuildingRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackUpdateBuildingParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateBuilding(ctx, request, params)
+				response, err = s.h.UpdateCurrentUserNotifications(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateBuilding(ctx, request, params)
+		response, err = s.h.UpdateCurrentUserNotifications(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -18735,7 +22051,7 @@ func (s *Server) handleUpdateBuildingRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeUpdateBuildingResponse(response, w, span); err != nil {
+	if err := encodeUpdateCurrentUserNotificationsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -18744,22 +22060,22 @@ func (s *Server) handleUpdateBuildingRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleUpdateCurrentUserNotificationsRequest handles updateCurrentUserNotifications operation.
+// handleUpdateEquipmentRequest handles updateEquipment operation.
 //
-// Update notification preferences.
+// Update equipment type.
 //
-// PUT /users/me/notifications
-func (s *Server) handleUpdateCurrentUserNotificationsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /equipment/{equipmentId}
+func (s *Server) handleUpdateEquipmentRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateCurrentUserNotifications"),
+		otelogen.OperationID("updateEquipment"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/users/me/notifications"),
+		semconv.HTTPRouteKey.String("/equipment/{equipmentId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateCurrentUserNotificationsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateEquipmentOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -18814,15 +22130,15 @@ func (s *Server) handleUpdateCurrentUserNotificationsRequest(args [0]string, arg
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateCurrentUserNotificationsOperation,
-			ID:   "updateCurrentUserNotifications",
+			Name: UpdateEquipmentOperation,
+			ID:   "updateEquipment",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UpdateCurrentUserNotificationsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateEquipmentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -18839,7 +22155,7 @@ func (s *Server) handleUpdateCurrentUserNotificationsRequest(args [0]string, arg
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, UpdateCurrentUserNotificationsOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, UpdateEquipmentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -18880,9 +22196,19 @@ func (s *Server) handleUpdateCurrentUserNotificationsRequest(args [0]string, arg
 			return
 		}
 	}
+	params, err := decodeUpdateEquipmentParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeUpdateCurrentUserNotificationsRequest(r)
+	request, rawBody, close, err := s.decodeUpdateEquipmentRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -18898,23 +22224,28 @@ func (s *Server) handleUpdateCurrentUserNotificationsRequest(args [0]string, arg
 		}
 	}()
 
-	var response UpdateCurrentUserNotificationsRes
+	var response UpdateEquipmentRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateCurrentUserNotificationsOperation,
-			OperationSummary: "Update notification preferences",
-			OperationID:      "updateCurrentUserNotifications",
+			OperationName:    UpdateEquipmentOperation,
+			OperationSummary: "Update equipment type",
+			OperationID:      "updateEquipment",
 			Body:             request,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "equipmentId",
+					In:   "path",
+				}: params.EquipmentId,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *NotificationPreferences
-			Params   = struct{}
-			Response = UpdateCurrentUserNotificationsRes
+			Request  = *UpdateEquipmentRequest
+			Params   = UpdateEquipmentParams
+			Response = UpdateEquipmentRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -18923,14 +22254,14 @@ func (s *Server) handleUpdateCurrentUserNotificationsRequest(args [0]string, arg
 		](
 			m,
 			mreq,
-			nil,
+			unpackUpdateEquipmentParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateCurrentUserNotifications(ctx, request)
+				response, err = s.h.UpdateEquipment(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateCurrentUserNotifications(ctx, request)
+		response, err = s.h.UpdateEquipment(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -18938,7 +22269,7 @@ func (s *Server) handleUpdateCurrentUserNotificationsRequest(args [0]string, arg
 		return
 	}
 
-	if err := encodeUpdateCurrentUserNotificationsResponse(response, w, span); err != nil {
+	if err := encodeUpdateEquipmentResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -18947,22 +22278,22 @@ func (s *Server) handleUpdateCurrentUserNotificationsRequest(args [0]string, arg
 	}
 }
 
-// handleUpdateEquipmentRequest handles updateEquipment operation.
+// handleUpdateGroupRequest handles updateGroup operation.
 //
-// Update equipment type.
+// Update group.
 //
-// PUT /equipment/{equipmentId}
-func (s *Server) handleUpdateEquipmentRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /groups/{groupId}
+func (s *Server) handleUpdateGroupRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateEquipment"),
+		otelogen.OperationID("updateGroup"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/equipment/{equipmentId}"),
+		semconv.HTTPRouteKey.String("/groups/{groupId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateEquipmentOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateGroupOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -19017,15 +22348,15 @@ func (s *Server) handleUpdateEquipmentRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateEquipmentOperation,
-			ID:   "updateEquipment",
+			Name: UpdateGroupOperation,
+			ID:   "updateGroup",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UpdateEquipmentOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateGroupOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -19042,7 +22373,7 @@ func (s *Server) handleUpdateEquipmentRequest(args [1]string, argsEscaped bool,
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, UpdateEquipmentOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, UpdateGroupOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -19083,7 +22414,7 @@ func (s *Server) handleUpdateEquipmentRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeUpdateEquipmentParams(args, argsEscaped, r)
+	params, err := decodeUpdateGroupParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -19095,7 +22426,7 @@ func (s *Server) handleUpdateEquipmentRequest(args [1]string, argsEscaped bool,
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeUpdateEquipmentRequest(r)
+	request, rawBody, close, err := s.decodeUpdateGroupRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -19111,28 +22442,28 @@ func (s *Server) handleUpdateEquipmentRequest(args [1]string, argsEscaped bool,
 		}
 	}()
 
-	var response UpdateEquipmentRes
+	var response UpdateGroupRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateEquipmentOperation,
-			OperationSummary: "Update equipment type",
-			OperationID:      "updateEquipment",
+			OperationName:    UpdateGroupOperation,
+			OperationSummary: "Update group",
+			OperationID:      "updateGroup",
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "equipmentId",
+					Name: "groupId",
 					In:   "path",
-				}: params.EquipmentId,
+				}: params.GroupId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *UpdateEquipmentRequest
-			Params   = UpdateEquipmentParams
-			Response = UpdateEquipmentRes
+			Request  = *UpdateGroupRequest
+			Params   = UpdateGroupParams
+			Response = UpdateGroupRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -19141,14 +22472,14 @@ func (s *Server) handleUpdateEquipmentRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackUpdateEquipmentParams,
+			unpackUpdateGroupParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateEquipment(ctx, request, params)
+				response, err = s.h.UpdateGroup(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateEquipment(ctx, request, params)
+		response, err = s.h.UpdateGroup(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -19156,7 +22487,7 @@ func (s *Server) handleUpdateEquipmentRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeUpdateEquipmentResponse(response, w, span); err != nil {
+	if err := encodeUpdateGroupResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -19165,22 +22496,22 @@ func (s *Server) handleUpdateEquipmentRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleUpdateGroupRequest handles updateGroup operation.
+// handleUpdateGroupConstraintProfileRequest handles updateGroupConstraintProfile operation.
 //
-// Update group.
+// Update constraint profile.
 //
-// PUT /groups/{groupId}
-func (s *Server) handleUpdateGroupRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /groups/{groupId}/constraintProfiles/{profileId}
+func (s *Server) handleUpdateGroupConstraintProfileRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateGroup"),
+		otelogen.OperationID("updateGroupConstraintProfile"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/groups/{groupId}"),
+		semconv.HTTPRouteKey.String("/groups/{groupId}/constraintProfiles/{profileId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateGroupOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateGroupConstraintProfileOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -19235,15 +22566,15 @@ func (s *Server) handleUpdateGroupRequest(args [1]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateGroupOperation,
-			ID:   "updateGroup",
+			Name: UpdateGroupConstraintProfileOperation,
+			ID:   "updateGroupConstraintProfile",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UpdateGroupOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateGroupConstraintProfileOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -19260,7 +22591,7 @@ func (s *Server) handleUpdateGroupRequest(args [1]string, argsEscaped bool, w ht
 			}
 		}
 		{
-			sctx, ok, err := s.securityApiKeyAuth(ctx, UpdateGroupOperation, r)
+			sctx, ok, err := s.securityApiKeyAuth(ctx, UpdateGroupConstraintProfileOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -19301,7 +22632,7 @@ func (s *Server) handleUpdateGroupRequest(args [1]string, argsEscaped bool, w ht
 			return
 		}
 	}
-	params, err := decodeUpdateGroupParams(args, argsEscaped, r)
+	params, err := decodeUpdateGroupConstraintProfileParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -19313,7 +22644,7 @@ func (s *Server) handleUpdateGroupRequest(args [1]string, argsEscaped bool, w ht
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeUpdateGroupRequest(r)
+	request, rawBody, close, err := s.decodeUpdateGroupConstraintProfileRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -19329,13 +22660,13 @@ func (s *Server) handleUpdateGroupRequest(args [1]string, argsEscaped bool, w ht
 		}
 	}()
 
-	var response UpdateGroupRes
+	var response UpdateGroupConstraintProfileRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateGroupOperation,
-			OperationSummary: "Update group",
-			OperationID:      "updateGroup",
+			OperationName:    UpdateGroupConstraintProfileOperation,
+			OperationSummary: "Update constraint profile",
+			OperationID:      "updateGroupConstraintProfile",
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -19343,14 +22674,18 @@ func (s *Server) handleUpdateGroupRequest(args [1]string, argsEscaped bool, w ht
 					Name: "groupId",
 					In:   "path",
 				}: params.GroupId,
+				{
+					Name: "profileId",
+					In:   "path",
+				}: params.ProfileId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *UpdateGroupRequest
-			Params   = UpdateGroupParams
-			Response = UpdateGroupRes
+			Request  = *ConstraintProfileRequest
+			Params   = UpdateGroupConstraintProfileParams
+			Response = UpdateGroupConstraintProfileRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -19359,14 +22694,14 @@ func (s *Server) handleUpdateGroupRequest(args [1]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			unpackUpdateGroupParams,
+			unpackUpdateGroupConstraintProfileParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateGroup(ctx, request, params)
+				response, err = s.h.UpdateGroupConstraintProfile(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateGroup(ctx, request, params)
+		response, err = s.h.UpdateGroupConstraintProfile(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -19374,7 +22709,7 @@ func (s *Server) handleUpdateGroupRequest(args [1]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeUpdateGroupResponse(response, w, span); err != nil {
+	if err := encodeUpdateGroupConstraintProfileResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -20261,6 +23596,10 @@ func (s *Server) handleUpdateQrTemplateRequest(args [1]string, argsEscaped bool,
 // Extending is only allowed if the adjacent time slot is not blocked or reserved.
 // Shortening is always allowed within minimum duration constraints.
 // Times must align with the place's configured time slot intervals.
+// Holders of manage:reservations for the place may move reservations of other
+// users, also at places that can only be booked manually, and may bypass quotas
+// and blockings. Such changes are recorded in the audit log and the user is
+// notified who made them.
 //
 // PUT /reservations/{reservationId}
 func (s *Server) handleUpdateReservationRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
@@ -20432,6 +23771,10 @@ func (s *Server) handleUpdateReservationRequest(args [1]string, argsEscaped bool
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
+				{
+					Name: "scope",
+					In:   "query",
+				}: params.Scope,
 				{
 					Name: "reservationId",
 					In:   "path",
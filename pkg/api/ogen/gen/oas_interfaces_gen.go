@@ -61,6 +61,10 @@ type CreateEquipmentRes interface {
 	createEquipmentRes()
 }
 
+type CreateGroupConstraintProfileRes interface {
+	createGroupConstraintProfileRes()
+}
+
 type CreateGroupRes interface {
 	createGroupRes()
 }
@@ -93,6 +97,10 @@ type DeleteEquipmentRes interface {
 	deleteEquipmentRes()
 }
 
+type DeleteGroupConstraintProfileRes interface {
+	deleteGroupConstraintProfileRes()
+}
+
 type DeleteGroupRes interface {
 	deleteGroupRes()
 }
@@ -113,6 +121,14 @@ type EnableUserRes interface {
 	enableUserRes()
 }
 
+type ExportAreaQrCodesRes interface {
+	exportAreaQrCodesRes()
+}
+
+type ExportBuildingQrCodesRes interface {
+	exportBuildingQrCodesRes()
+}
+
 type ExportReservationsRes interface {
 	exportReservationsRes()
 }
@@ -129,6 +145,10 @@ type GetAreaCalendarRes interface {
 	getAreaCalendarRes()
 }
 
+type GetAreaConstraintsRes interface {
+	getAreaConstraintsRes()
+}
+
 type GetAreaRes interface {
 	getAreaRes()
 }
@@ -153,6 +173,10 @@ type GetBuildingCalendarRes interface {
 	getBuildingCalendarRes()
 }
 
+type GetBuildingConstraintsRes interface {
+	getBuildingConstraintsRes()
+}
+
 type GetBuildingRes interface {
 	getBuildingRes()
 }
@@ -161,6 +185,10 @@ type GetCurrentOccupancyRes interface {
 	getCurrentOccupancyRes()
 }
 
+type GetCurrentUserCalendarTokenRes interface {
+	getCurrentUserCalendarTokenRes()
+}
+
 type GetCurrentUserFavoritesRes interface {
 	getCurrentUserFavoritesRes()
 }
@@ -177,6 +205,10 @@ type GetDefaultGroupAssignmentRes interface {
 	getDefaultGroupAssignmentRes()
 }
 
+type GetEffectivePlaceConstraintsRes interface {
+	getEffectivePlaceConstraintsRes()
+}
+
 type GetEquipmentRes interface {
 	getEquipmentRes()
 }
@@ -217,6 +249,10 @@ type GetPlaceQrCodeRes interface {
 	getPlaceQrCodeRes()
 }
 
+type GetPlaceQrKeyRes interface {
+	getPlaceQrKeyRes()
+}
+
 type GetPlaceRes interface {
 	getPlaceRes()
 }
@@ -249,6 +285,10 @@ type GetUsageStatisticsRes interface {
 	getUsageStatisticsRes()
 }
 
+type GetUserCalendarRes interface {
+	getUserCalendarRes()
+}
+
 type GetUserGroupsRes interface {
 	getUserGroupsRes()
 }
@@ -273,6 +313,10 @@ type ListBuildingAreasRes interface {
 	listBuildingAreasRes()
 }
 
+type ListGroupConstraintProfilesRes interface {
+	listGroupConstraintProfilesRes()
+}
+
 type ListGroupsRes interface {
 	listGroupsRes()
 }
@@ -353,10 +397,26 @@ type RevokeApiKeyRes interface {
 	revokeApiKeyRes()
 }
 
+type RevokeCurrentUserCalendarTokenRes interface {
+	revokeCurrentUserCalendarTokenRes()
+}
+
+type RotateCurrentUserCalendarTokenRes interface {
+	rotateCurrentUserCalendarTokenRes()
+}
+
+type RotatePlaceQrKeyRes interface {
+	rotatePlaceQrKeyRes()
+}
+
 type SetDefaultGroupAssignmentRes interface {
 	setDefaultGroupAssignmentRes()
 }
 
+type UpdateAreaConstraintsRes interface {
+	updateAreaConstraintsRes()
+}
+
 type UpdateAreaRes interface {
 	updateAreaRes()
 }
@@ -365,6 +425,10 @@ type UpdateAreaRoomPlanRes interface {
 	updateAreaRoomPlanRes()
 }
 
+type UpdateBuildingConstraintsRes interface {
+	updateBuildingConstraintsRes()
+}
+
 type UpdateBuildingRes interface {
 	updateBuildingRes()
 }
@@ -377,6 +441,10 @@ type UpdateEquipmentRes interface {
 	updateEquipmentRes()
 }
 
+type UpdateGroupConstraintProfileRes interface {
+	updateGroupConstraintProfileRes()
+}
+
 type UpdateGroupRes interface {
 	updateGroupRes()
 }
@@ -1626,10 +1626,17 @@ func (s *AddGroupPermissionsReq) encodeFields(e *jx.Encoder) {
 		}
 		e.ArrEnd()
 	}
+	{
+		if s.Scope.Set {
+			e.FieldStart("scope")
+			s.Scope.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfAddGroupPermissionsReq = [1]string{
+var jsonFieldsNameOfAddGroupPermissionsReq = [2]string{
 	0: "permissions",
+	1: "scope",
 }
 
 // Decode decodes AddGroupPermissionsReq from json.
@@ -1661,6 +1668,16 @@ func (s *AddGroupPermissionsReq) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"permissions\"")
 			}
+		case "scope":
+			if err := func() error {
+				s.Scope.Reset()
+				if err := s.Scope.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"scope\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -4412,327 +4429,381 @@ func (s *Building) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
-// Encode encodes CancelReservationForbidden as json.
-func (s *CancelReservationForbidden) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode implements json.Marshaler.
+func (s *BuildingAvailability) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes CancelReservationForbidden from json.
-func (s *CancelReservationForbidden) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CancelReservationForbidden to nil")
+// encodeFields encodes fields.
+func (s *BuildingAvailability) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("ranges")
+		e.ArrStart()
+		for _, elem := range s.Ranges {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	{
+		e.FieldStart("openingHours")
+		e.ArrStart()
+		for _, elem := range s.OpeningHours {
+			elem.Encode(e)
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("exceptions")
+		e.ArrStart()
+		for _, elem := range s.Exceptions {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
 	}
-	*s = CancelReservationForbidden(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *CancelReservationForbidden) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CancelReservationForbidden) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
 }
 
-// Encode encodes CancelReservationNotFound as json.
-func (s *CancelReservationNotFound) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+var jsonFieldsNameOfBuildingAvailability = [3]string{
+	0: "ranges",
+	1: "openingHours",
+	2: "exceptions",
 }
 
-// Decode decodes CancelReservationNotFound from json.
-func (s *CancelReservationNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes BuildingAvailability from json.
+func (s *BuildingAvailability) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CancelReservationNotFound to nil")
+		return errors.New("invalid: unable to decode BuildingAvailability to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "ranges":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Ranges = make([]TimeRange, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TimeRange
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Ranges = append(s.Ranges, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ranges\"")
+			}
+		case "openingHours":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				s.OpeningHours = make([]WeekdayOpeningHours, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem WeekdayOpeningHours
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.OpeningHours = append(s.OpeningHours, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"openingHours\"")
+			}
+		case "exceptions":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				s.Exceptions = make([]OpeningHoursException, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem OpeningHoursException
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Exceptions = append(s.Exceptions, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"exceptions\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode BuildingAvailability")
 	}
-	*s = CancelReservationNotFound(unwrapped)
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfBuildingAvailability) {
+					name = jsonFieldsNameOfBuildingAvailability[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CancelReservationNotFound) MarshalJSON() ([]byte, error) {
+func (s *BuildingAvailability) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CancelReservationNotFound) UnmarshalJSON(data []byte) error {
+func (s *BuildingAvailability) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CheckInReservationBadRequest as json.
-func (s *CheckInReservationBadRequest) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
+// Encode implements json.Marshaler.
+func (s *CalendarToken) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	unwrapped.Encode(e)
+// encodeFields encodes fields.
+func (s *CalendarToken) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("createdAt")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		if s.LastUsedAt.Set {
+			e.FieldStart("lastUsedAt")
+			s.LastUsedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
 }
 
-// Decode decodes CheckInReservationBadRequest from json.
-func (s *CheckInReservationBadRequest) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfCalendarToken = [2]string{
+	0: "createdAt",
+	1: "lastUsedAt",
+}
+
+// Decode decodes CalendarToken from json.
+func (s *CalendarToken) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CheckInReservationBadRequest to nil")
+		return errors.New("invalid: unable to decode CalendarToken to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "createdAt":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"createdAt\"")
+			}
+		case "lastUsedAt":
+			if err := func() error {
+				s.LastUsedAt.Reset()
+				if err := s.LastUsedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"lastUsedAt\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode CalendarToken")
 	}
-	*s = CheckInReservationBadRequest(unwrapped)
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCalendarToken) {
+					name = jsonFieldsNameOfCalendarToken[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CheckInReservationBadRequest) MarshalJSON() ([]byte, error) {
+func (s *CalendarToken) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CheckInReservationBadRequest) UnmarshalJSON(data []byte) error {
+func (s *CalendarToken) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CheckInReservationForbidden as json.
-func (s *CheckInReservationForbidden) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
+// Encode implements json.Marshaler.
+func (s *CalendarTokenWithSecret) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	unwrapped.Encode(e)
-}
-
-// Decode decodes CheckInReservationForbidden from json.
-func (s *CheckInReservationForbidden) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CheckInReservationForbidden to nil")
+// encodeFields encodes fields.
+func (s *CalendarTokenWithSecret) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("createdAt")
+		json.EncodeDateTime(e, s.CreatedAt)
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	{
+		if s.LastUsedAt.Set {
+			e.FieldStart("lastUsedAt")
+			s.LastUsedAt.Encode(e, json.EncodeDateTime)
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
-	}
-	*s = CheckInReservationForbidden(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *CheckInReservationForbidden) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CheckInReservationForbidden) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes CheckInReservationNotFound as json.
-func (s *CheckInReservationNotFound) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
-}
-
-// Decode decodes CheckInReservationNotFound from json.
-func (s *CheckInReservationNotFound) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CheckInReservationNotFound to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	{
+		e.FieldStart("token")
+		e.Str(s.Token)
 	}
-	*s = CheckInReservationNotFound(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *CheckInReservationNotFound) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CheckInReservationNotFound) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *CheckInReservationReq) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *CheckInReservationReq) encodeFields(e *jx.Encoder) {
 	{
-		if s.QrCode.Set {
-			e.FieldStart("qrCode")
-			s.QrCode.Encode(e)
-		}
+		e.FieldStart("path")
+		e.Str(s.Path)
 	}
 }
 
-var jsonFieldsNameOfCheckInReservationReq = [1]string{
-	0: "qrCode",
+var jsonFieldsNameOfCalendarTokenWithSecret = [4]string{
+	0: "createdAt",
+	1: "lastUsedAt",
+	2: "token",
+	3: "path",
 }
 
-// Decode decodes CheckInReservationReq from json.
-func (s *CheckInReservationReq) Decode(d *jx.Decoder) error {
+// Decode decodes CalendarTokenWithSecret from json.
+func (s *CalendarTokenWithSecret) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CheckInReservationReq to nil")
+		return errors.New("invalid: unable to decode CalendarTokenWithSecret to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "qrCode":
+		case "createdAt":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.QrCode.Reset()
-				if err := s.QrCode.Decode(d); err != nil {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"qrCode\"")
+				return errors.Wrap(err, "decode field \"createdAt\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode CheckInReservationReq")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *CheckInReservationReq) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CheckInReservationReq) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *CreateAPIKeyRequest) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *CreateAPIKeyRequest) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
-	}
-	{
-		if s.ExpiresAt.Set {
-			e.FieldStart("expiresAt")
-			s.ExpiresAt.Encode(e, json.EncodeDateTime)
-		}
-	}
-}
-
-var jsonFieldsNameOfCreateAPIKeyRequest = [2]string{
-	0: "name",
-	1: "expiresAt",
-}
-
-// Decode decodes CreateAPIKeyRequest from json.
-func (s *CreateAPIKeyRequest) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CreateAPIKeyRequest to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "name":
-			requiredBitSet[0] |= 1 << 0
+		case "lastUsedAt":
+			if err := func() error {
+				s.LastUsedAt.Reset()
+				if err := s.LastUsedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"lastUsedAt\"")
+			}
+		case "token":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
 				v, err := d.Str()
-				s.Name = string(v)
+				s.Token = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"token\"")
 			}
-		case "expiresAt":
+		case "path":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.ExpiresAt.Reset()
-				if err := s.ExpiresAt.Decode(d, json.DecodeDateTime); err != nil {
+				v, err := d.Str()
+				s.Path = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"expiresAt\"")
+				return errors.Wrap(err, "decode field \"path\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CreateAPIKeyRequest")
+		return errors.Wrap(err, "decode CalendarTokenWithSecret")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00001101,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -4744,8 +4815,8 @@ func (s *CreateAPIKeyRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCreateAPIKeyRequest) {
-					name = jsonFieldsNameOfCreateAPIKeyRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCalendarTokenWithSecret) {
+					name = jsonFieldsNameOfCalendarTokenWithSecret[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4766,29 +4837,29 @@ func (s *CreateAPIKeyRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreateAPIKeyRequest) MarshalJSON() ([]byte, error) {
+func (s *CalendarTokenWithSecret) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateAPIKeyRequest) UnmarshalJSON(data []byte) error {
+func (s *CalendarTokenWithSecret) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CreateApiKeyBadRequest as json.
-func (s *CreateApiKeyBadRequest) Encode(e *jx.Encoder) {
+// Encode encodes CancelReservationConflict as json.
+func (s *CancelReservationConflict) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes CreateApiKeyBadRequest from json.
-func (s *CreateApiKeyBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes CancelReservationConflict from json.
+func (s *CancelReservationConflict) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateApiKeyBadRequest to nil")
+		return errors.New("invalid: unable to decode CancelReservationConflict to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -4799,34 +4870,34 @@ func (s *CreateApiKeyBadRequest) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = CreateApiKeyBadRequest(unwrapped)
+	*s = CancelReservationConflict(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreateApiKeyBadRequest) MarshalJSON() ([]byte, error) {
+func (s *CancelReservationConflict) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateApiKeyBadRequest) UnmarshalJSON(data []byte) error {
+func (s *CancelReservationConflict) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CreateApiKeyUnauthorized as json.
-func (s *CreateApiKeyUnauthorized) Encode(e *jx.Encoder) {
+// Encode encodes CancelReservationForbidden as json.
+func (s *CancelReservationForbidden) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes CreateApiKeyUnauthorized from json.
-func (s *CreateApiKeyUnauthorized) Decode(d *jx.Decoder) error {
+// Decode decodes CancelReservationForbidden from json.
+func (s *CancelReservationForbidden) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateApiKeyUnauthorized to nil")
+		return errors.New("invalid: unable to decode CancelReservationForbidden to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -4837,34 +4908,34 @@ func (s *CreateApiKeyUnauthorized) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = CreateApiKeyUnauthorized(unwrapped)
+	*s = CancelReservationForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreateApiKeyUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *CancelReservationForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateApiKeyUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *CancelReservationForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CreateAreaBadRequest as json.
-func (s *CreateAreaBadRequest) Encode(e *jx.Encoder) {
+// Encode encodes CancelReservationNotFound as json.
+func (s *CancelReservationNotFound) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes CreateAreaBadRequest from json.
-func (s *CreateAreaBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes CancelReservationNotFound from json.
+func (s *CancelReservationNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateAreaBadRequest to nil")
+		return errors.New("invalid: unable to decode CancelReservationNotFound to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -4875,34 +4946,34 @@ func (s *CreateAreaBadRequest) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = CreateAreaBadRequest(unwrapped)
+	*s = CancelReservationNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreateAreaBadRequest) MarshalJSON() ([]byte, error) {
+func (s *CancelReservationNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateAreaBadRequest) UnmarshalJSON(data []byte) error {
+func (s *CancelReservationNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CreateAreaForbidden as json.
-func (s *CreateAreaForbidden) Encode(e *jx.Encoder) {
+// Encode encodes CheckInReservationBadRequest as json.
+func (s *CheckInReservationBadRequest) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes CreateAreaForbidden from json.
-func (s *CreateAreaForbidden) Decode(d *jx.Decoder) error {
+// Decode decodes CheckInReservationBadRequest from json.
+func (s *CheckInReservationBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateAreaForbidden to nil")
+		return errors.New("invalid: unable to decode CheckInReservationBadRequest to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -4913,357 +4984,389 @@ func (s *CreateAreaForbidden) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = CreateAreaForbidden(unwrapped)
+	*s = CheckInReservationBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreateAreaForbidden) MarshalJSON() ([]byte, error) {
+func (s *CheckInReservationBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateAreaForbidden) UnmarshalJSON(data []byte) error {
+func (s *CheckInReservationBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *CreateAreaRequest) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
+// Encode encodes CheckInReservationConflict as json.
+func (s *CheckInReservationConflict) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
 
-// encodeFields encodes fields.
-func (s *CreateAreaRequest) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("buildingId")
-		json.EncodeUUID(e, s.BuildingId)
+	unwrapped.Encode(e)
+}
+
+// Decode decodes CheckInReservationConflict from json.
+func (s *CheckInReservationConflict) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CheckInReservationConflict to nil")
 	}
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-	{
-		if s.Description.Set {
-			e.FieldStart("description")
-			s.Description.Encode(e)
+	*s = CheckInReservationConflict(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CheckInReservationConflict) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CheckInReservationConflict) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CheckInReservationForbidden as json.
+func (s *CheckInReservationForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes CheckInReservationForbidden from json.
+func (s *CheckInReservationForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CheckInReservationForbidden to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = CheckInReservationForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CheckInReservationForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CheckInReservationForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CheckInReservationNotFound as json.
+func (s *CheckInReservationNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes CheckInReservationNotFound from json.
+func (s *CheckInReservationNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CheckInReservationNotFound to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = CheckInReservationNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CheckInReservationNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CheckInReservationNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *CheckInReservationReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *CheckInReservationReq) encodeFields(e *jx.Encoder) {
 	{
-		if s.Location.Set {
-			e.FieldStart("location")
-			s.Location.Encode(e)
+		if s.QrCode.Set {
+			e.FieldStart("qrCode")
+			s.QrCode.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfCreateAreaRequest = [4]string{
-	0: "buildingId",
-	1: "name",
-	2: "description",
-	3: "location",
+var jsonFieldsNameOfCheckInReservationReq = [1]string{
+	0: "qrCode",
 }
 
-// Decode decodes CreateAreaRequest from json.
-func (s *CreateAreaRequest) Decode(d *jx.Decoder) error {
+// Decode decodes CheckInReservationReq from json.
+func (s *CheckInReservationReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateAreaRequest to nil")
+		return errors.New("invalid: unable to decode CheckInReservationReq to nil")
 	}
-	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "buildingId":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.BuildingId = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"buildingId\"")
-			}
-		case "name":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "description":
-			if err := func() error {
-				s.Description.Reset()
-				if err := s.Description.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"description\"")
-			}
-		case "location":
+		case "qrCode":
 			if err := func() error {
-				s.Location.Reset()
-				if err := s.Location.Decode(d); err != nil {
+				s.QrCode.Reset()
+				if err := s.QrCode.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"location\"")
+				return errors.Wrap(err, "decode field \"qrCode\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CreateAreaRequest")
+		return errors.Wrap(err, "decode CheckInReservationReq")
 	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfCreateAreaRequest) {
-					name = jsonFieldsNameOfCreateAreaRequest[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CheckInReservationReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CheckInReservationReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CheckInReservationUnauthorized as json.
+func (s *CheckInReservationUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes CheckInReservationUnauthorized from json.
+func (s *CheckInReservationUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CheckInReservationUnauthorized to nil")
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = CheckInReservationUnauthorized(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreateAreaRequest) MarshalJSON() ([]byte, error) {
+func (s *CheckInReservationUnauthorized) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateAreaRequest) UnmarshalJSON(data []byte) error {
+func (s *CheckInReservationUnauthorized) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CreateBlockingRequest) Encode(e *jx.Encoder) {
+func (s *ConstraintProfile) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CreateBlockingRequest) encodeFields(e *jx.Encoder) {
+func (s *ConstraintProfile) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("blockingType")
-		s.BlockingType.Encode(e)
+		e.FieldStart("id")
+		json.EncodeUUID(e, s.ID)
 	}
 	{
-		if s.Name.Set {
-			e.FieldStart("name")
-			s.Name.Encode(e)
-		}
-	}
-	{
-		if s.Reason.Set {
-			e.FieldStart("reason")
-			s.Reason.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("startTime")
-		json.EncodeDateTime(e, s.StartTime)
+		e.FieldStart("groupId")
+		json.EncodeUUID(e, s.GroupId)
 	}
 	{
-		e.FieldStart("endTime")
-		json.EncodeDateTime(e, s.EndTime)
+		e.FieldStart("name")
+		e.Str(s.Name)
 	}
 	{
-		if s.IsRecurring.Set {
-			e.FieldStart("isRecurring")
-			s.IsRecurring.Encode(e)
+		if s.Scope.Set {
+			e.FieldStart("scope")
+			s.Scope.Encode(e)
 		}
 	}
 	{
-		if s.RecurrenceRule.Set {
-			e.FieldStart("recurrenceRule")
-			s.RecurrenceRule.Encode(e)
-		}
+		e.FieldStart("constraints")
+		s.Constraints.Encode(e)
 	}
 	{
-		if s.RecurrenceDuration.Set {
-			e.FieldStart("recurrenceDuration")
-			s.RecurrenceDuration.Encode(e)
-		}
+		e.FieldStart("createdAt")
+		json.EncodeDateTime(e, s.CreatedAt)
 	}
 	{
-		if s.RecurrenceEnd.Set {
-			e.FieldStart("recurrenceEnd")
-			s.RecurrenceEnd.Encode(e, json.EncodeDate)
-		}
+		e.FieldStart("updatedAt")
+		json.EncodeDateTime(e, s.UpdatedAt)
 	}
 }
 
-var jsonFieldsNameOfCreateBlockingRequest = [9]string{
-	0: "blockingType",
-	1: "name",
-	2: "reason",
-	3: "startTime",
-	4: "endTime",
-	5: "isRecurring",
-	6: "recurrenceRule",
-	7: "recurrenceDuration",
-	8: "recurrenceEnd",
+var jsonFieldsNameOfConstraintProfile = [7]string{
+	0: "id",
+	1: "groupId",
+	2: "name",
+	3: "scope",
+	4: "constraints",
+	5: "createdAt",
+	6: "updatedAt",
 }
 
-// Decode decodes CreateBlockingRequest from json.
-func (s *CreateBlockingRequest) Decode(d *jx.Decoder) error {
+// Decode decodes ConstraintProfile from json.
+func (s *ConstraintProfile) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateBlockingRequest to nil")
+		return errors.New("invalid: unable to decode ConstraintProfile to nil")
 	}
-	var requiredBitSet [2]uint8
-	s.setDefaults()
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "blockingType":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				if err := s.BlockingType.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"blockingType\"")
-			}
-		case "name":
-			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "reason":
-			if err := func() error {
-				s.Reason.Reset()
-				if err := s.Reason.Decode(d); err != nil {
+				v, err := json.DecodeUUID(d)
+				s.ID = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"reason\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "startTime":
-			requiredBitSet[0] |= 1 << 3
+		case "groupId":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.StartTime = v
+				v, err := json.DecodeUUID(d)
+				s.GroupId = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"startTime\"")
+				return errors.Wrap(err, "decode field \"groupId\"")
 			}
-		case "endTime":
-			requiredBitSet[0] |= 1 << 4
+		case "name":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.EndTime = v
+				v, err := d.Str()
+				s.Name = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"endTime\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "isRecurring":
+		case "scope":
 			if err := func() error {
-				s.IsRecurring.Reset()
-				if err := s.IsRecurring.Decode(d); err != nil {
+				s.Scope.Reset()
+				if err := s.Scope.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"isRecurring\"")
+				return errors.Wrap(err, "decode field \"scope\"")
 			}
-		case "recurrenceRule":
+		case "constraints":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				s.RecurrenceRule.Reset()
-				if err := s.RecurrenceRule.Decode(d); err != nil {
+				if err := s.Constraints.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"recurrenceRule\"")
+				return errors.Wrap(err, "decode field \"constraints\"")
 			}
-		case "recurrenceDuration":
+		case "createdAt":
+			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
-				s.RecurrenceDuration.Reset()
-				if err := s.RecurrenceDuration.Decode(d); err != nil {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"recurrenceDuration\"")
+				return errors.Wrap(err, "decode field \"createdAt\"")
 			}
-		case "recurrenceEnd":
+		case "updatedAt":
+			requiredBitSet[0] |= 1 << 6
 			if err := func() error {
-				s.RecurrenceEnd.Reset()
-				if err := s.RecurrenceEnd.Decode(d, json.DecodeDate); err != nil {
+				v, err := json.DecodeDateTime(d)
+				s.UpdatedAt = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"recurrenceEnd\"")
+				return errors.Wrap(err, "decode field \"updatedAt\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CreateBlockingRequest")
+		return errors.Wrap(err, "decode ConstraintProfile")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b00011001,
-		0b00000000,
+	for i, mask := range [1]uint8{
+		0b01110111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5275,8 +5378,8 @@ func (s *CreateBlockingRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCreateBlockingRequest) {
-					name = jsonFieldsNameOfCreateBlockingRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfConstraintProfile) {
+					name = jsonFieldsNameOfConstraintProfile[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5297,229 +5400,212 @@ func (s *CreateBlockingRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreateBlockingRequest) MarshalJSON() ([]byte, error) {
+func (s *ConstraintProfile) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateBlockingRequest) UnmarshalJSON(data []byte) error {
+func (s *ConstraintProfile) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CreateBlockingRequestBlockingType as json.
-func (s CreateBlockingRequestBlockingType) Encode(e *jx.Encoder) {
-	e.Str(string(s))
+// Encode implements json.Marshaler.
+func (s *ConstraintProfileRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes CreateBlockingRequestBlockingType from json.
-func (s *CreateBlockingRequestBlockingType) Decode(d *jx.Decoder) error {
+// encodeFields encodes fields.
+func (s *ConstraintProfileRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		if s.Scope.Set {
+			e.FieldStart("scope")
+			s.Scope.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("constraints")
+		s.Constraints.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfConstraintProfileRequest = [3]string{
+	0: "name",
+	1: "scope",
+	2: "constraints",
+}
+
+// Decode decodes ConstraintProfileRequest from json.
+func (s *ConstraintProfileRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateBlockingRequestBlockingType to nil")
+		return errors.New("invalid: unable to decode ConstraintProfileRequest to nil")
 	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "scope":
+			if err := func() error {
+				s.Scope.Reset()
+				if err := s.Scope.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"scope\"")
+			}
+		case "constraints":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Constraints.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"constraints\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ConstraintProfileRequest")
 	}
-	// Try to use constant string.
-	switch CreateBlockingRequestBlockingType(v) {
-	case CreateBlockingRequestBlockingTypeClosedHours:
-		*s = CreateBlockingRequestBlockingTypeClosedHours
-	case CreateBlockingRequestBlockingTypeWeekend:
-		*s = CreateBlockingRequestBlockingTypeWeekend
-	case CreateBlockingRequestBlockingTypeHoliday:
-		*s = CreateBlockingRequestBlockingTypeHoliday
-	case CreateBlockingRequestBlockingTypeMaintenance:
-		*s = CreateBlockingRequestBlockingTypeMaintenance
-	case CreateBlockingRequestBlockingTypeEvent:
-		*s = CreateBlockingRequestBlockingTypeEvent
-	case CreateBlockingRequestBlockingTypeDisabled:
-		*s = CreateBlockingRequestBlockingTypeDisabled
-	case CreateBlockingRequestBlockingTypeCustom:
-		*s = CreateBlockingRequestBlockingTypeCustom
-	default:
-		*s = CreateBlockingRequestBlockingType(v)
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000101,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfConstraintProfileRequest) {
+					name = jsonFieldsNameOfConstraintProfileRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s CreateBlockingRequestBlockingType) MarshalJSON() ([]byte, error) {
+func (s *ConstraintProfileRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateBlockingRequestBlockingType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes CreateBuildingBadRequest as json.
-func (s *CreateBuildingBadRequest) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
-}
-
-// Decode decodes CreateBuildingBadRequest from json.
-func (s *CreateBuildingBadRequest) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CreateBuildingBadRequest to nil")
-	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
-	}
-	*s = CreateBuildingBadRequest(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *CreateBuildingBadRequest) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateBuildingBadRequest) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes CreateBuildingForbidden as json.
-func (s *CreateBuildingForbidden) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
-}
-
-// Decode decodes CreateBuildingForbidden from json.
-func (s *CreateBuildingForbidden) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CreateBuildingForbidden to nil")
-	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
-	}
-	*s = CreateBuildingForbidden(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *CreateBuildingForbidden) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateBuildingForbidden) UnmarshalJSON(data []byte) error {
+func (s *ConstraintProfileRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CreateBuildingRequest) Encode(e *jx.Encoder) {
+func (s *ConstraintProfileScope) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CreateBuildingRequest) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
-	}
+func (s *ConstraintProfileScope) encodeFields(e *jx.Encoder) {
 	{
-		if s.Description.Set {
-			e.FieldStart("description")
-			s.Description.Encode(e)
-		}
+		e.FieldStart("type")
+		s.Type.Encode(e)
 	}
 	{
-		if s.Location.Set {
-			e.FieldStart("location")
-			s.Location.Encode(e)
-		}
+		e.FieldStart("id")
+		json.EncodeUUID(e, s.ID)
 	}
 }
 
-var jsonFieldsNameOfCreateBuildingRequest = [3]string{
-	0: "name",
-	1: "description",
-	2: "location",
+var jsonFieldsNameOfConstraintProfileScope = [2]string{
+	0: "type",
+	1: "id",
 }
 
-// Decode decodes CreateBuildingRequest from json.
-func (s *CreateBuildingRequest) Decode(d *jx.Decoder) error {
+// Decode decodes ConstraintProfileScope from json.
+func (s *ConstraintProfileScope) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateBuildingRequest to nil")
+		return errors.New("invalid: unable to decode ConstraintProfileScope to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "name":
+		case "type":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "description":
-			if err := func() error {
-				s.Description.Reset()
-				if err := s.Description.Decode(d); err != nil {
+				if err := s.Type.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"description\"")
+				return errors.Wrap(err, "decode field \"type\"")
 			}
-		case "location":
+		case "id":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Location.Reset()
-				if err := s.Location.Decode(d); err != nil {
+				v, err := json.DecodeUUID(d)
+				s.ID = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"location\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CreateBuildingRequest")
+		return errors.Wrap(err, "decode ConstraintProfileScope")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5531,8 +5617,8 @@ func (s *CreateBuildingRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCreateBuildingRequest) {
-					name = jsonFieldsNameOfCreateBuildingRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfConstraintProfileScope) {
+					name = jsonFieldsNameOfConstraintProfileScope[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5553,131 +5639,88 @@ func (s *CreateBuildingRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreateBuildingRequest) MarshalJSON() ([]byte, error) {
+func (s *ConstraintProfileScope) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateBuildingRequest) UnmarshalJSON(data []byte) error {
+func (s *ConstraintProfileScope) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CreateEquipmentBadRequest as json.
-func (s *CreateEquipmentBadRequest) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode encodes ConstraintProfileScopeType as json.
+func (s ConstraintProfileScopeType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes CreateEquipmentBadRequest from json.
-func (s *CreateEquipmentBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes ConstraintProfileScopeType from json.
+func (s *ConstraintProfileScopeType) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateEquipmentBadRequest to nil")
-	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+		return errors.New("invalid: unable to decode ConstraintProfileScopeType to nil")
 	}
-	*s = CreateEquipmentBadRequest(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *CreateEquipmentBadRequest) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateEquipmentBadRequest) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes CreateEquipmentForbidden as json.
-func (s *CreateEquipmentForbidden) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
-}
-
-// Decode decodes CreateEquipmentForbidden from json.
-func (s *CreateEquipmentForbidden) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CreateEquipmentForbidden to nil")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	// Try to use constant string.
+	switch ConstraintProfileScopeType(v) {
+	case ConstraintProfileScopeTypeBuilding:
+		*s = ConstraintProfileScopeTypeBuilding
+	case ConstraintProfileScopeTypeArea:
+		*s = ConstraintProfileScopeTypeArea
+	default:
+		*s = ConstraintProfileScopeType(v)
 	}
-	*s = CreateEquipmentForbidden(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreateEquipmentForbidden) MarshalJSON() ([]byte, error) {
+func (s ConstraintProfileScopeType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateEquipmentForbidden) UnmarshalJSON(data []byte) error {
+func (s *ConstraintProfileScopeType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CreateEquipmentRequest) Encode(e *jx.Encoder) {
+func (s *CreateAPIKeyRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CreateEquipmentRequest) encodeFields(e *jx.Encoder) {
+func (s *CreateAPIKeyRequest) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("name")
 		e.Str(s.Name)
 	}
 	{
-		if s.Icon.Set {
-			e.FieldStart("icon")
-			s.Icon.Encode(e)
-		}
-	}
-	{
-		if s.Description.Set {
-			e.FieldStart("description")
-			s.Description.Encode(e)
+		if s.ExpiresAt.Set {
+			e.FieldStart("expiresAt")
+			s.ExpiresAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 }
 
-var jsonFieldsNameOfCreateEquipmentRequest = [3]string{
+var jsonFieldsNameOfCreateAPIKeyRequest = [2]string{
 	0: "name",
-	1: "icon",
-	2: "description",
+	1: "expiresAt",
 }
 
-// Decode decodes CreateEquipmentRequest from json.
-func (s *CreateEquipmentRequest) Decode(d *jx.Decoder) error {
+// Decode decodes CreateAPIKeyRequest from json.
+func (s *CreateAPIKeyRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateEquipmentRequest to nil")
+		return errors.New("invalid: unable to decode CreateAPIKeyRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -5695,32 +5738,22 @@ func (s *CreateEquipmentRequest) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "icon":
-			if err := func() error {
-				s.Icon.Reset()
-				if err := s.Icon.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"icon\"")
-			}
-		case "description":
+		case "expiresAt":
 			if err := func() error {
-				s.Description.Reset()
-				if err := s.Description.Decode(d); err != nil {
+				s.ExpiresAt.Reset()
+				if err := s.ExpiresAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"description\"")
+				return errors.Wrap(err, "decode field \"expiresAt\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CreateEquipmentRequest")
+		return errors.Wrap(err, "decode CreateAPIKeyRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -5737,8 +5770,8 @@ func (s *CreateEquipmentRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCreateEquipmentRequest) {
-					name = jsonFieldsNameOfCreateEquipmentRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCreateAPIKeyRequest) {
+					name = jsonFieldsNameOfCreateAPIKeyRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5759,29 +5792,29 @@ func (s *CreateEquipmentRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreateEquipmentRequest) MarshalJSON() ([]byte, error) {
+func (s *CreateAPIKeyRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateEquipmentRequest) UnmarshalJSON(data []byte) error {
+func (s *CreateAPIKeyRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CreateGroupBadRequest as json.
-func (s *CreateGroupBadRequest) Encode(e *jx.Encoder) {
+// Encode encodes CreateApiKeyBadRequest as json.
+func (s *CreateApiKeyBadRequest) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes CreateGroupBadRequest from json.
-func (s *CreateGroupBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes CreateApiKeyBadRequest from json.
+func (s *CreateApiKeyBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateGroupBadRequest to nil")
+		return errors.New("invalid: unable to decode CreateApiKeyBadRequest to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -5792,34 +5825,34 @@ func (s *CreateGroupBadRequest) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = CreateGroupBadRequest(unwrapped)
+	*s = CreateApiKeyBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreateGroupBadRequest) MarshalJSON() ([]byte, error) {
+func (s *CreateApiKeyBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateGroupBadRequest) UnmarshalJSON(data []byte) error {
+func (s *CreateApiKeyBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CreateGroupForbidden as json.
-func (s *CreateGroupForbidden) Encode(e *jx.Encoder) {
+// Encode encodes CreateApiKeyUnauthorized as json.
+func (s *CreateApiKeyUnauthorized) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes CreateGroupForbidden from json.
-func (s *CreateGroupForbidden) Decode(d *jx.Decoder) error {
+// Decode decodes CreateApiKeyUnauthorized from json.
+func (s *CreateApiKeyUnauthorized) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateGroupForbidden to nil")
+		return errors.New("invalid: unable to decode CreateApiKeyUnauthorized to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -5830,32 +5863,112 @@ func (s *CreateGroupForbidden) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = CreateGroupForbidden(unwrapped)
+	*s = CreateApiKeyUnauthorized(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreateGroupForbidden) MarshalJSON() ([]byte, error) {
+func (s *CreateApiKeyUnauthorized) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateGroupForbidden) UnmarshalJSON(data []byte) error {
+func (s *CreateApiKeyUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CreateAreaBadRequest as json.
+func (s *CreateAreaBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes CreateAreaBadRequest from json.
+func (s *CreateAreaBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateAreaBadRequest to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = CreateAreaBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CreateAreaBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreateAreaBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CreateAreaForbidden as json.
+func (s *CreateAreaForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes CreateAreaForbidden from json.
+func (s *CreateAreaForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateAreaForbidden to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = CreateAreaForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CreateAreaForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreateAreaForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CreateGroupRequest) Encode(e *jx.Encoder) {
+func (s *CreateAreaRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CreateGroupRequest) encodeFields(e *jx.Encoder) {
+func (s *CreateAreaRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("buildingId")
+		json.EncodeUUID(e, s.BuildingId)
+	}
 	{
 		e.FieldStart("name")
 		e.Str(s.Name)
@@ -5867,34 +5980,43 @@ func (s *CreateGroupRequest) encodeFields(e *jx.Encoder) {
 		}
 	}
 	{
-		if s.Permissions != nil {
-			e.FieldStart("permissions")
-			e.ArrStart()
-			for _, elem := range s.Permissions {
-				e.Str(elem)
-			}
-			e.ArrEnd()
+		if s.Location.Set {
+			e.FieldStart("location")
+			s.Location.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfCreateGroupRequest = [3]string{
-	0: "name",
-	1: "description",
-	2: "permissions",
+var jsonFieldsNameOfCreateAreaRequest = [4]string{
+	0: "buildingId",
+	1: "name",
+	2: "description",
+	3: "location",
 }
 
-// Decode decodes CreateGroupRequest from json.
-func (s *CreateGroupRequest) Decode(d *jx.Decoder) error {
+// Decode decodes CreateAreaRequest from json.
+func (s *CreateAreaRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateGroupRequest to nil")
+		return errors.New("invalid: unable to decode CreateAreaRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "name":
+		case "buildingId":
 			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.BuildingId = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"buildingId\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Str()
 				s.Name = string(v)
@@ -5915,36 +6037,27 @@ func (s *CreateGroupRequest) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"description\"")
 			}
-		case "permissions":
+		case "location":
 			if err := func() error {
-				s.Permissions = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.Permissions = append(s.Permissions, elem)
-					return nil
-				}); err != nil {
+				s.Location.Reset()
+				if err := s.Location.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"permissions\"")
+				return errors.Wrap(err, "decode field \"location\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CreateGroupRequest")
+		return errors.Wrap(err, "decode CreateAreaRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5956,8 +6069,8 @@ func (s *CreateGroupRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCreateGroupRequest) {
-					name = jsonFieldsNameOfCreateGroupRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCreateAreaRequest) {
+					name = jsonFieldsNameOfCreateAreaRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5978,228 +6091,205 @@ func (s *CreateGroupRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreateGroupRequest) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateGroupRequest) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes CreatePlaceBadRequest as json.
-func (s *CreatePlaceBadRequest) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
-}
-
-// Decode decodes CreatePlaceBadRequest from json.
-func (s *CreatePlaceBadRequest) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CreatePlaceBadRequest to nil")
-	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
-	}
-	*s = CreatePlaceBadRequest(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *CreatePlaceBadRequest) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreatePlaceBadRequest) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes CreatePlaceForbidden as json.
-func (s *CreatePlaceForbidden) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
-}
-
-// Decode decodes CreatePlaceForbidden from json.
-func (s *CreatePlaceForbidden) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CreatePlaceForbidden to nil")
-	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
-	}
-	*s = CreatePlaceForbidden(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *CreatePlaceForbidden) MarshalJSON() ([]byte, error) {
+func (s *CreateAreaRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreatePlaceForbidden) UnmarshalJSON(data []byte) error {
+func (s *CreateAreaRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CreatePlaceMarkerRequest) Encode(e *jx.Encoder) {
+func (s *CreateBlockingRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CreatePlaceMarkerRequest) encodeFields(e *jx.Encoder) {
+func (s *CreateBlockingRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("placeId")
-		json.EncodeUUID(e, s.PlaceId)
+		e.FieldStart("blockingType")
+		s.BlockingType.Encode(e)
 	}
 	{
-		e.FieldStart("x")
-		e.Float32(s.X)
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("y")
-		e.Float32(s.Y)
+		if s.Reason.Set {
+			e.FieldStart("reason")
+			s.Reason.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("width")
-		e.Float32(s.Width)
+		e.FieldStart("startTime")
+		json.EncodeDateTime(e, s.StartTime)
 	}
 	{
-		e.FieldStart("height")
-		e.Float32(s.Height)
+		e.FieldStart("endTime")
+		json.EncodeDateTime(e, s.EndTime)
 	}
 	{
-		e.FieldStart("shape")
-		s.Shape.Encode(e)
+		if s.IsRecurring.Set {
+			e.FieldStart("isRecurring")
+			s.IsRecurring.Encode(e)
+		}
+	}
+	{
+		if s.RecurrenceRule.Set {
+			e.FieldStart("recurrenceRule")
+			s.RecurrenceRule.Encode(e)
+		}
+	}
+	{
+		if s.RecurrenceDuration.Set {
+			e.FieldStart("recurrenceDuration")
+			s.RecurrenceDuration.Encode(e)
+		}
+	}
+	{
+		if s.RecurrenceEnd.Set {
+			e.FieldStart("recurrenceEnd")
+			s.RecurrenceEnd.Encode(e, json.EncodeDate)
+		}
 	}
 }
 
-var jsonFieldsNameOfCreatePlaceMarkerRequest = [6]string{
-	0: "placeId",
-	1: "x",
-	2: "y",
-	3: "width",
-	4: "height",
-	5: "shape",
+var jsonFieldsNameOfCreateBlockingRequest = [9]string{
+	0: "blockingType",
+	1: "name",
+	2: "reason",
+	3: "startTime",
+	4: "endTime",
+	5: "isRecurring",
+	6: "recurrenceRule",
+	7: "recurrenceDuration",
+	8: "recurrenceEnd",
 }
 
-// Decode decodes CreatePlaceMarkerRequest from json.
-func (s *CreatePlaceMarkerRequest) Decode(d *jx.Decoder) error {
+// Decode decodes CreateBlockingRequest from json.
+func (s *CreateBlockingRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreatePlaceMarkerRequest to nil")
+		return errors.New("invalid: unable to decode CreateBlockingRequest to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
+	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "placeId":
+		case "blockingType":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.PlaceId = v
-				if err != nil {
+				if err := s.BlockingType.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"placeId\"")
+				return errors.Wrap(err, "decode field \"blockingType\"")
 			}
-		case "x":
-			requiredBitSet[0] |= 1 << 1
+		case "name":
 			if err := func() error {
-				v, err := d.Float32()
-				s.X = float32(v)
-				if err != nil {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"x\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "y":
-			requiredBitSet[0] |= 1 << 2
+		case "reason":
 			if err := func() error {
-				v, err := d.Float32()
-				s.Y = float32(v)
-				if err != nil {
+				s.Reason.Reset()
+				if err := s.Reason.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"y\"")
+				return errors.Wrap(err, "decode field \"reason\"")
 			}
-		case "width":
+		case "startTime":
 			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				v, err := d.Float32()
-				s.Width = float32(v)
+				v, err := json.DecodeDateTime(d)
+				s.StartTime = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"width\"")
+				return errors.Wrap(err, "decode field \"startTime\"")
 			}
-		case "height":
+		case "endTime":
 			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				v, err := d.Float32()
-				s.Height = float32(v)
+				v, err := json.DecodeDateTime(d)
+				s.EndTime = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"height\"")
+				return errors.Wrap(err, "decode field \"endTime\"")
 			}
-		case "shape":
-			requiredBitSet[0] |= 1 << 5
+		case "isRecurring":
 			if err := func() error {
-				if err := s.Shape.Decode(d); err != nil {
+				s.IsRecurring.Reset()
+				if err := s.IsRecurring.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"shape\"")
+				return errors.Wrap(err, "decode field \"isRecurring\"")
+			}
+		case "recurrenceRule":
+			if err := func() error {
+				s.RecurrenceRule.Reset()
+				if err := s.RecurrenceRule.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"recurrenceRule\"")
+			}
+		case "recurrenceDuration":
+			if err := func() error {
+				s.RecurrenceDuration.Reset()
+				if err := s.RecurrenceDuration.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"recurrenceDuration\"")
+			}
+		case "recurrenceEnd":
+			if err := func() error {
+				s.RecurrenceEnd.Reset()
+				if err := s.RecurrenceEnd.Decode(d, json.DecodeDate); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"recurrenceEnd\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CreatePlaceMarkerRequest")
+		return errors.Wrap(err, "decode CreateBlockingRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00111111,
+	for i, mask := range [2]uint8{
+		0b00011001,
+		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -6211,8 +6301,8 @@ func (s *CreatePlaceMarkerRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCreatePlaceMarkerRequest) {
-					name = jsonFieldsNameOfCreatePlaceMarkerRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCreateBlockingRequest) {
+					name = jsonFieldsNameOfCreateBlockingRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6233,148 +6323,188 @@ func (s *CreatePlaceMarkerRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreatePlaceMarkerRequest) MarshalJSON() ([]byte, error) {
+func (s *CreateBlockingRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreatePlaceMarkerRequest) UnmarshalJSON(data []byte) error {
+func (s *CreateBlockingRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CreatePlaceMarkerRequestShape as json.
-func (s CreatePlaceMarkerRequestShape) Encode(e *jx.Encoder) {
+// Encode encodes CreateBlockingRequestBlockingType as json.
+func (s CreateBlockingRequestBlockingType) Encode(e *jx.Encoder) {
 	e.Str(string(s))
 }
 
-// Decode decodes CreatePlaceMarkerRequestShape from json.
-func (s *CreatePlaceMarkerRequestShape) Decode(d *jx.Decoder) error {
+// Decode decodes CreateBlockingRequestBlockingType from json.
+func (s *CreateBlockingRequestBlockingType) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreatePlaceMarkerRequestShape to nil")
+		return errors.New("invalid: unable to decode CreateBlockingRequestBlockingType to nil")
 	}
 	v, err := d.StrBytes()
 	if err != nil {
 		return err
 	}
 	// Try to use constant string.
-	switch CreatePlaceMarkerRequestShape(v) {
-	case CreatePlaceMarkerRequestShapeRectangle:
-		*s = CreatePlaceMarkerRequestShapeRectangle
-	case CreatePlaceMarkerRequestShapeCircle:
-		*s = CreatePlaceMarkerRequestShapeCircle
+	switch CreateBlockingRequestBlockingType(v) {
+	case CreateBlockingRequestBlockingTypeClosedHours:
+		*s = CreateBlockingRequestBlockingTypeClosedHours
+	case CreateBlockingRequestBlockingTypeWeekend:
+		*s = CreateBlockingRequestBlockingTypeWeekend
+	case CreateBlockingRequestBlockingTypeHoliday:
+		*s = CreateBlockingRequestBlockingTypeHoliday
+	case CreateBlockingRequestBlockingTypeMaintenance:
+		*s = CreateBlockingRequestBlockingTypeMaintenance
+	case CreateBlockingRequestBlockingTypeEvent:
+		*s = CreateBlockingRequestBlockingTypeEvent
+	case CreateBlockingRequestBlockingTypeDisabled:
+		*s = CreateBlockingRequestBlockingTypeDisabled
+	case CreateBlockingRequestBlockingTypeCustom:
+		*s = CreateBlockingRequestBlockingTypeCustom
 	default:
-		*s = CreatePlaceMarkerRequestShape(v)
+		*s = CreateBlockingRequestBlockingType(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s CreatePlaceMarkerRequestShape) MarshalJSON() ([]byte, error) {
+func (s CreateBlockingRequestBlockingType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreatePlaceMarkerRequestShape) UnmarshalJSON(data []byte) error {
+func (s *CreateBlockingRequestBlockingType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *CreatePlaceRequest) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes CreateBuildingBadRequest as json.
+func (s *CreateBuildingBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *CreatePlaceRequest) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("areaId")
-		json.EncodeUUID(e, s.AreaId)
-	}
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
-	}
-	{
-		if s.Description.Set {
-			e.FieldStart("description")
-			s.Description.Encode(e)
-		}
-	}
-	{
-		if s.Location.Set {
-			e.FieldStart("location")
-			s.Location.Encode(e)
-		}
+// Decode decodes CreateBuildingBadRequest from json.
+func (s *CreateBuildingBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateBuildingBadRequest to nil")
 	}
-	{
-		if s.Capacity.Set {
-			e.FieldStart("capacity")
-			s.Capacity.Encode(e)
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-	{
-		if s.IsBookable.Set {
-			e.FieldStart("isBookable")
-			s.IsBookable.Encode(e)
+	*s = CreateBuildingBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CreateBuildingBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreateBuildingBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CreateBuildingForbidden as json.
+func (s *CreateBuildingForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes CreateBuildingForbidden from json.
+func (s *CreateBuildingForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateBuildingForbidden to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = CreateBuildingForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CreateBuildingForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreateBuildingForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *CreateBuildingRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *CreateBuildingRequest) encodeFields(e *jx.Encoder) {
 	{
-		if s.BookingMethod.Set {
-			e.FieldStart("bookingMethod")
-			s.BookingMethod.Encode(e)
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		if s.Description.Set {
+			e.FieldStart("description")
+			s.Description.Encode(e)
 		}
 	}
 	{
-		if s.RequiresCheckIn.Set {
-			e.FieldStart("requiresCheckIn")
-			s.RequiresCheckIn.Encode(e)
+		if s.Location.Set {
+			e.FieldStart("location")
+			s.Location.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfCreatePlaceRequest = [8]string{
-	0: "areaId",
-	1: "name",
-	2: "description",
-	3: "location",
-	4: "capacity",
-	5: "isBookable",
-	6: "bookingMethod",
-	7: "requiresCheckIn",
+var jsonFieldsNameOfCreateBuildingRequest = [3]string{
+	0: "name",
+	1: "description",
+	2: "location",
 }
 
-// Decode decodes CreatePlaceRequest from json.
-func (s *CreatePlaceRequest) Decode(d *jx.Decoder) error {
+// Decode decodes CreateBuildingRequest from json.
+func (s *CreateBuildingRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreatePlaceRequest to nil")
+		return errors.New("invalid: unable to decode CreateBuildingRequest to nil")
 	}
 	var requiredBitSet [1]uint8
-	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "areaId":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.AreaId = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"areaId\"")
-			}
 		case "name":
-			requiredBitSet[0] |= 1 << 1
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
 				s.Name = string(v)
@@ -6405,57 +6535,17 @@ func (s *CreatePlaceRequest) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"location\"")
 			}
-		case "capacity":
-			if err := func() error {
-				s.Capacity.Reset()
-				if err := s.Capacity.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"capacity\"")
-			}
-		case "isBookable":
-			if err := func() error {
-				s.IsBookable.Reset()
-				if err := s.IsBookable.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"isBookable\"")
-			}
-		case "bookingMethod":
-			if err := func() error {
-				s.BookingMethod.Reset()
-				if err := s.BookingMethod.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"bookingMethod\"")
-			}
-		case "requiresCheckIn":
-			if err := func() error {
-				s.RequiresCheckIn.Reset()
-				if err := s.RequiresCheckIn.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"requiresCheckIn\"")
-			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CreatePlaceRequest")
+		return errors.Wrap(err, "decode CreateBuildingRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -6467,8 +6557,8 @@ func (s *CreatePlaceRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCreatePlaceRequest) {
-					name = jsonFieldsNameOfCreatePlaceRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCreateBuildingRequest) {
+					name = jsonFieldsNameOfCreateBuildingRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6489,86 +6579,131 @@ func (s *CreatePlaceRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreatePlaceRequest) MarshalJSON() ([]byte, error) {
+func (s *CreateBuildingRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreatePlaceRequest) UnmarshalJSON(data []byte) error {
+func (s *CreateBuildingRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CreatePlaceRequestBookingMethod as json.
-func (s CreatePlaceRequestBookingMethod) Encode(e *jx.Encoder) {
-	e.Str(string(s))
+// Encode encodes CreateEquipmentBadRequest as json.
+func (s *CreateEquipmentBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
 }
 
-// Decode decodes CreatePlaceRequestBookingMethod from json.
-func (s *CreatePlaceRequestBookingMethod) Decode(d *jx.Decoder) error {
+// Decode decodes CreateEquipmentBadRequest from json.
+func (s *CreateEquipmentBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreatePlaceRequestBookingMethod to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
+		return errors.New("invalid: unable to decode CreateEquipmentBadRequest to nil")
 	}
-	// Try to use constant string.
-	switch CreatePlaceRequestBookingMethod(v) {
-	case CreatePlaceRequestBookingMethodSelfService:
-		*s = CreatePlaceRequestBookingMethodSelfService
-	case CreatePlaceRequestBookingMethodManual:
-		*s = CreatePlaceRequestBookingMethodManual
-	default:
-		*s = CreatePlaceRequestBookingMethod(v)
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = CreateEquipmentBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CreateEquipmentBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
 
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreateEquipmentBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CreateEquipmentForbidden as json.
+func (s *CreateEquipmentForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes CreateEquipmentForbidden from json.
+func (s *CreateEquipmentForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateEquipmentForbidden to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = CreateEquipmentForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s CreatePlaceRequestBookingMethod) MarshalJSON() ([]byte, error) {
+func (s *CreateEquipmentForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreatePlaceRequestBookingMethod) UnmarshalJSON(data []byte) error {
+func (s *CreateEquipmentForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CreateQRTemplateRequest) Encode(e *jx.Encoder) {
+func (s *CreateEquipmentRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CreateQRTemplateRequest) encodeFields(e *jx.Encoder) {
+func (s *CreateEquipmentRequest) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("name")
 		e.Str(s.Name)
 	}
 	{
-		e.FieldStart("htmlTemplate")
-		e.Str(s.HtmlTemplate)
+		if s.Icon.Set {
+			e.FieldStart("icon")
+			s.Icon.Encode(e)
+		}
+	}
+	{
+		if s.Description.Set {
+			e.FieldStart("description")
+			s.Description.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfCreateQRTemplateRequest = [2]string{
+var jsonFieldsNameOfCreateEquipmentRequest = [3]string{
 	0: "name",
-	1: "htmlTemplate",
+	1: "icon",
+	2: "description",
 }
 
-// Decode decodes CreateQRTemplateRequest from json.
-func (s *CreateQRTemplateRequest) Decode(d *jx.Decoder) error {
+// Decode decodes CreateEquipmentRequest from json.
+func (s *CreateEquipmentRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateQRTemplateRequest to nil")
+		return errors.New("invalid: unable to decode CreateEquipmentRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -6586,29 +6721,37 @@ func (s *CreateQRTemplateRequest) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "htmlTemplate":
-			requiredBitSet[0] |= 1 << 1
+		case "icon":
 			if err := func() error {
-				v, err := d.Str()
-				s.HtmlTemplate = string(v)
-				if err != nil {
+				s.Icon.Reset()
+				if err := s.Icon.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"htmlTemplate\"")
+				return errors.Wrap(err, "decode field \"icon\"")
+			}
+		case "description":
+			if err := func() error {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"description\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CreateQRTemplateRequest")
+		return errors.Wrap(err, "decode CreateEquipmentRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -6620,8 +6763,8 @@ func (s *CreateQRTemplateRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCreateQRTemplateRequest) {
-					name = jsonFieldsNameOfCreateQRTemplateRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCreateEquipmentRequest) {
+					name = jsonFieldsNameOfCreateEquipmentRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6642,29 +6785,29 @@ func (s *CreateQRTemplateRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreateQRTemplateRequest) MarshalJSON() ([]byte, error) {
+func (s *CreateEquipmentRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateQRTemplateRequest) UnmarshalJSON(data []byte) error {
+func (s *CreateEquipmentRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CreateQrTemplateBadRequest as json.
-func (s *CreateQrTemplateBadRequest) Encode(e *jx.Encoder) {
+// Encode encodes CreateGroupBadRequest as json.
+func (s *CreateGroupBadRequest) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes CreateQrTemplateBadRequest from json.
-func (s *CreateQrTemplateBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes CreateGroupBadRequest from json.
+func (s *CreateGroupBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateQrTemplateBadRequest to nil")
+		return errors.New("invalid: unable to decode CreateGroupBadRequest to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -6675,34 +6818,34 @@ func (s *CreateQrTemplateBadRequest) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = CreateQrTemplateBadRequest(unwrapped)
+	*s = CreateGroupBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreateQrTemplateBadRequest) MarshalJSON() ([]byte, error) {
+func (s *CreateGroupBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateQrTemplateBadRequest) UnmarshalJSON(data []byte) error {
+func (s *CreateGroupBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CreateQrTemplateForbidden as json.
-func (s *CreateQrTemplateForbidden) Encode(e *jx.Encoder) {
+// Encode encodes CreateGroupConstraintProfileBadRequest as json.
+func (s *CreateGroupConstraintProfileBadRequest) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes CreateQrTemplateForbidden from json.
-func (s *CreateQrTemplateForbidden) Decode(d *jx.Decoder) error {
+// Decode decodes CreateGroupConstraintProfileBadRequest from json.
+func (s *CreateGroupConstraintProfileBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateQrTemplateForbidden to nil")
+		return errors.New("invalid: unable to decode CreateGroupConstraintProfileBadRequest to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -6713,34 +6856,34 @@ func (s *CreateQrTemplateForbidden) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = CreateQrTemplateForbidden(unwrapped)
+	*s = CreateGroupConstraintProfileBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreateQrTemplateForbidden) MarshalJSON() ([]byte, error) {
+func (s *CreateGroupConstraintProfileBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateQrTemplateForbidden) UnmarshalJSON(data []byte) error {
+func (s *CreateGroupConstraintProfileBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CreateReservationBadRequest as json.
-func (s *CreateReservationBadRequest) Encode(e *jx.Encoder) {
+// Encode encodes CreateGroupConstraintProfileForbidden as json.
+func (s *CreateGroupConstraintProfileForbidden) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes CreateReservationBadRequest from json.
-func (s *CreateReservationBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes CreateGroupConstraintProfileForbidden from json.
+func (s *CreateGroupConstraintProfileForbidden) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateReservationBadRequest to nil")
+		return errors.New("invalid: unable to decode CreateGroupConstraintProfileForbidden to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -6751,34 +6894,34 @@ func (s *CreateReservationBadRequest) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = CreateReservationBadRequest(unwrapped)
+	*s = CreateGroupConstraintProfileForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreateReservationBadRequest) MarshalJSON() ([]byte, error) {
+func (s *CreateGroupConstraintProfileForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateReservationBadRequest) UnmarshalJSON(data []byte) error {
+func (s *CreateGroupConstraintProfileForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CreateReservationConflict as json.
-func (s *CreateReservationConflict) Encode(e *jx.Encoder) {
+// Encode encodes CreateGroupConstraintProfileNotFound as json.
+func (s *CreateGroupConstraintProfileNotFound) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes CreateReservationConflict from json.
-func (s *CreateReservationConflict) Decode(d *jx.Decoder) error {
+// Decode decodes CreateGroupConstraintProfileNotFound from json.
+func (s *CreateGroupConstraintProfileNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateReservationConflict to nil")
+		return errors.New("invalid: unable to decode CreateGroupConstraintProfileNotFound to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -6789,92 +6932,34 @@ func (s *CreateReservationConflict) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = CreateReservationConflict(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *CreateReservationConflict) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateReservationConflict) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes CreateReservationCreated as json.
-func (s CreateReservationCreated) Encode(e *jx.Encoder) {
-	switch s.Type {
-	case ReservationCreateReservationCreated:
-		s.Reservation.Encode(e)
-	case ReservationArrayCreateReservationCreated:
-		e.ArrStart()
-		for _, elem := range s.ReservationArray {
-			elem.Encode(e)
-		}
-		e.ArrEnd()
-	}
-}
-
-// Decode decodes CreateReservationCreated from json.
-func (s *CreateReservationCreated) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CreateReservationCreated to nil")
-	}
-	// Sum type type_discriminator.
-	switch t := d.Next(); t {
-	case jx.Array:
-		s.ReservationArray = make([]Reservation, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem Reservation
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			s.ReservationArray = append(s.ReservationArray, elem)
-			return nil
-		}); err != nil {
-			return err
-		}
-		s.Type = ReservationArrayCreateReservationCreated
-	case jx.Object:
-		if err := s.Reservation.Decode(d); err != nil {
-			return err
-		}
-		s.Type = ReservationCreateReservationCreated
-	default:
-		return errors.Errorf("unexpected json type %q", t)
-	}
+	*s = CreateGroupConstraintProfileNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s CreateReservationCreated) MarshalJSON() ([]byte, error) {
+func (s *CreateGroupConstraintProfileNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateReservationCreated) UnmarshalJSON(data []byte) error {
+func (s *CreateGroupConstraintProfileNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CreateReservationForbidden as json.
-func (s *CreateReservationForbidden) Encode(e *jx.Encoder) {
+// Encode encodes CreateGroupForbidden as json.
+func (s *CreateGroupForbidden) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes CreateReservationForbidden from json.
-func (s *CreateReservationForbidden) Decode(d *jx.Decoder) error {
+// Decode decodes CreateGroupForbidden from json.
+func (s *CreateGroupForbidden) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateReservationForbidden to nil")
+		return errors.New("invalid: unable to decode CreateGroupForbidden to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -6885,125 +6970,121 @@ func (s *CreateReservationForbidden) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = CreateReservationForbidden(unwrapped)
+	*s = CreateGroupForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreateReservationForbidden) MarshalJSON() ([]byte, error) {
+func (s *CreateGroupForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateReservationForbidden) UnmarshalJSON(data []byte) error {
+func (s *CreateGroupForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CreateReservationRequest) Encode(e *jx.Encoder) {
+func (s *CreateGroupRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CreateReservationRequest) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("placeId")
-		json.EncodeUUID(e, s.PlaceId)
-	}
+func (s *CreateGroupRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("startTime")
-		json.EncodeDateTime(e, s.StartTime)
+		e.FieldStart("name")
+		e.Str(s.Name)
 	}
 	{
-		e.FieldStart("endTime")
-		json.EncodeDateTime(e, s.EndTime)
+		if s.Description.Set {
+			e.FieldStart("description")
+			s.Description.Encode(e)
+		}
 	}
 	{
-		if s.Recurrence.Set {
-			e.FieldStart("recurrence")
-			s.Recurrence.Encode(e)
+		if s.Permissions != nil {
+			e.FieldStart("permissions")
+			e.ArrStart()
+			for _, elem := range s.Permissions {
+				e.Str(elem)
+			}
+			e.ArrEnd()
 		}
 	}
 }
 
-var jsonFieldsNameOfCreateReservationRequest = [4]string{
-	0: "placeId",
-	1: "startTime",
-	2: "endTime",
-	3: "recurrence",
+var jsonFieldsNameOfCreateGroupRequest = [3]string{
+	0: "name",
+	1: "description",
+	2: "permissions",
 }
 
-// Decode decodes CreateReservationRequest from json.
-func (s *CreateReservationRequest) Decode(d *jx.Decoder) error {
+// Decode decodes CreateGroupRequest from json.
+func (s *CreateGroupRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateReservationRequest to nil")
+		return errors.New("invalid: unable to decode CreateGroupRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "placeId":
+		case "name":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.PlaceId = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"placeId\"")
-			}
-		case "startTime":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.StartTime = v
+				v, err := d.Str()
+				s.Name = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"startTime\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "endTime":
-			requiredBitSet[0] |= 1 << 2
+		case "description":
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.EndTime = v
-				if err != nil {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"endTime\"")
+				return errors.Wrap(err, "decode field \"description\"")
 			}
-		case "recurrence":
+		case "permissions":
 			if err := func() error {
-				s.Recurrence.Reset()
-				if err := s.Recurrence.Decode(d); err != nil {
+				s.Permissions = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Permissions = append(s.Permissions, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"recurrence\"")
+				return errors.Wrap(err, "decode field \"permissions\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CreateReservationRequest")
+		return errors.Wrap(err, "decode CreateGroupRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -7015,8 +7096,8 @@ func (s *CreateReservationRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCreateReservationRequest) {
-					name = jsonFieldsNameOfCreateReservationRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCreateGroupRequest) {
+					name = jsonFieldsNameOfCreateGroupRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -7037,183 +7118,67 @@ func (s *CreateReservationRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreateReservationRequest) MarshalJSON() ([]byte, error) {
+func (s *CreateGroupRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateReservationRequest) UnmarshalJSON(data []byte) error {
+func (s *CreateGroupRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *CreateReservationRequestRecurrence) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes CreatePlaceBadRequest as json.
+func (s *CreatePlaceBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *CreateReservationRequestRecurrence) encodeFields(e *jx.Encoder) {
-	{
-		if s.Pattern.Set {
-			e.FieldStart("pattern")
-			s.Pattern.Encode(e)
-		}
-	}
-	{
-		if s.EndDate.Set {
-			e.FieldStart("endDate")
-			s.EndDate.Encode(e, json.EncodeDate)
-		}
-	}
-	{
-		if s.DaysOfWeek != nil {
-			e.FieldStart("daysOfWeek")
-			e.ArrStart()
-			for _, elem := range s.DaysOfWeek {
-				e.Int(elem)
-			}
-			e.ArrEnd()
-		}
-	}
-}
-
-var jsonFieldsNameOfCreateReservationRequestRecurrence = [3]string{
-	0: "pattern",
-	1: "endDate",
-	2: "daysOfWeek",
-}
-
-// Decode decodes CreateReservationRequestRecurrence from json.
-func (s *CreateReservationRequestRecurrence) Decode(d *jx.Decoder) error {
+// Decode decodes CreatePlaceBadRequest from json.
+func (s *CreatePlaceBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateReservationRequestRecurrence to nil")
+		return errors.New("invalid: unable to decode CreatePlaceBadRequest to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "pattern":
-			if err := func() error {
-				s.Pattern.Reset()
-				if err := s.Pattern.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"pattern\"")
-			}
-		case "endDate":
-			if err := func() error {
-				s.EndDate.Reset()
-				if err := s.EndDate.Decode(d, json.DecodeDate); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"endDate\"")
-			}
-		case "daysOfWeek":
-			if err := func() error {
-				s.DaysOfWeek = make([]int, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem int
-					v, err := d.Int()
-					elem = int(v)
-					if err != nil {
-						return err
-					}
-					s.DaysOfWeek = append(s.DaysOfWeek, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"daysOfWeek\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode CreateReservationRequestRecurrence")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *CreateReservationRequestRecurrence) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateReservationRequestRecurrence) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes CreateReservationRequestRecurrencePattern as json.
-func (s CreateReservationRequestRecurrencePattern) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes CreateReservationRequestRecurrencePattern from json.
-func (s *CreateReservationRequestRecurrencePattern) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CreateReservationRequestRecurrencePattern to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
-	}
-	// Try to use constant string.
-	switch CreateReservationRequestRecurrencePattern(v) {
-	case CreateReservationRequestRecurrencePatternDaily:
-		*s = CreateReservationRequestRecurrencePatternDaily
-	case CreateReservationRequestRecurrencePatternWeekly:
-		*s = CreateReservationRequestRecurrencePatternWeekly
-	case CreateReservationRequestRecurrencePatternBiweekly:
-		*s = CreateReservationRequestRecurrencePatternBiweekly
-	case CreateReservationRequestRecurrencePatternMonthly:
-		*s = CreateReservationRequestRecurrencePatternMonthly
-	default:
-		*s = CreateReservationRequestRecurrencePattern(v)
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = CreatePlaceBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s CreateReservationRequestRecurrencePattern) MarshalJSON() ([]byte, error) {
+func (s *CreatePlaceBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateReservationRequestRecurrencePattern) UnmarshalJSON(data []byte) error {
+func (s *CreatePlaceBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CreateReservationUnauthorized as json.
-func (s *CreateReservationUnauthorized) Encode(e *jx.Encoder) {
+// Encode encodes CreatePlaceForbidden as json.
+func (s *CreatePlaceForbidden) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes CreateReservationUnauthorized from json.
-func (s *CreateReservationUnauthorized) Decode(d *jx.Decoder) error {
+// Decode decodes CreatePlaceForbidden from json.
+func (s *CreatePlaceForbidden) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateReservationUnauthorized to nil")
+		return errors.New("invalid: unable to decode CreatePlaceForbidden to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -7224,355 +7189,2197 @@ func (s *CreateReservationUnauthorized) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = CreateReservationUnauthorized(unwrapped)
+	*s = CreatePlaceForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreateReservationUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *CreatePlaceForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateReservationUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *CreatePlaceForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CurrentOccupancy) Encode(e *jx.Encoder) {
+func (s *CreatePlaceMarkerRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CurrentOccupancy) encodeFields(e *jx.Encoder) {
+func (s *CreatePlaceMarkerRequest) encodeFields(e *jx.Encoder) {
 	{
-		if s.TotalPlaces.Set {
-			e.FieldStart("totalPlaces")
-			s.TotalPlaces.Encode(e)
-		}
+		e.FieldStart("placeId")
+		json.EncodeUUID(e, s.PlaceId)
 	}
 	{
-		if s.OccupiedPlaces.Set {
-			e.FieldStart("occupiedPlaces")
-			s.OccupiedPlaces.Encode(e)
-		}
+		e.FieldStart("x")
+		e.Float32(s.X)
 	}
 	{
-		if s.AvailablePlaces.Set {
-			e.FieldStart("availablePlaces")
-			s.AvailablePlaces.Encode(e)
-		}
+		e.FieldStart("y")
+		e.Float32(s.Y)
 	}
 	{
-		if s.OccupancyRate.Set {
-			e.FieldStart("occupancyRate")
-			s.OccupancyRate.Encode(e)
-		}
+		e.FieldStart("width")
+		e.Float32(s.Width)
 	}
 	{
-		if s.CheckedInCount.Set {
-			e.FieldStart("checkedInCount")
-			s.CheckedInCount.Encode(e)
-		}
+		e.FieldStart("height")
+		e.Float32(s.Height)
+	}
+	{
+		e.FieldStart("shape")
+		s.Shape.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfCurrentOccupancy = [5]string{
-	0: "totalPlaces",
-	1: "occupiedPlaces",
-	2: "availablePlaces",
-	3: "occupancyRate",
-	4: "checkedInCount",
+var jsonFieldsNameOfCreatePlaceMarkerRequest = [6]string{
+	0: "placeId",
+	1: "x",
+	2: "y",
+	3: "width",
+	4: "height",
+	5: "shape",
 }
 
-// Decode decodes CurrentOccupancy from json.
-func (s *CurrentOccupancy) Decode(d *jx.Decoder) error {
+// Decode decodes CreatePlaceMarkerRequest from json.
+func (s *CreatePlaceMarkerRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CurrentOccupancy to nil")
+		return errors.New("invalid: unable to decode CreatePlaceMarkerRequest to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "totalPlaces":
+		case "placeId":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.TotalPlaces.Reset()
-				if err := s.TotalPlaces.Decode(d); err != nil {
+				v, err := json.DecodeUUID(d)
+				s.PlaceId = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"totalPlaces\"")
+				return errors.Wrap(err, "decode field \"placeId\"")
 			}
-		case "occupiedPlaces":
+		case "x":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.OccupiedPlaces.Reset()
-				if err := s.OccupiedPlaces.Decode(d); err != nil {
+				v, err := d.Float32()
+				s.X = float32(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"occupiedPlaces\"")
+				return errors.Wrap(err, "decode field \"x\"")
 			}
-		case "availablePlaces":
+		case "y":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.AvailablePlaces.Reset()
-				if err := s.AvailablePlaces.Decode(d); err != nil {
+				v, err := d.Float32()
+				s.Y = float32(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"availablePlaces\"")
+				return errors.Wrap(err, "decode field \"y\"")
 			}
-		case "occupancyRate":
+		case "width":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.OccupancyRate.Reset()
-				if err := s.OccupancyRate.Decode(d); err != nil {
+				v, err := d.Float32()
+				s.Width = float32(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"occupancyRate\"")
+				return errors.Wrap(err, "decode field \"width\"")
 			}
-		case "checkedInCount":
+		case "height":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				s.CheckedInCount.Reset()
-				if err := s.CheckedInCount.Decode(d); err != nil {
+				v, err := d.Float32()
+				s.Height = float32(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"checkedInCount\"")
+				return errors.Wrap(err, "decode field \"height\"")
 			}
-		default:
-			return d.Skip()
+		case "shape":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				if err := s.Shape.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"shape\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CurrentOccupancy")
+		return errors.Wrap(err, "decode CreatePlaceMarkerRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00111111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCreatePlaceMarkerRequest) {
+					name = jsonFieldsNameOfCreatePlaceMarkerRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CurrentOccupancy) MarshalJSON() ([]byte, error) {
+func (s *CreatePlaceMarkerRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CurrentOccupancy) UnmarshalJSON(data []byte) error {
+func (s *CreatePlaceMarkerRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes DeleteAreaForbidden as json.
-func (s *DeleteAreaForbidden) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode encodes CreatePlaceMarkerRequestShape as json.
+func (s CreatePlaceMarkerRequestShape) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes DeleteAreaForbidden from json.
-func (s *DeleteAreaForbidden) Decode(d *jx.Decoder) error {
+// Decode decodes CreatePlaceMarkerRequestShape from json.
+func (s *CreatePlaceMarkerRequestShape) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DeleteAreaForbidden to nil")
+		return errors.New("invalid: unable to decode CreatePlaceMarkerRequestShape to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	*s = DeleteAreaForbidden(unwrapped)
+	// Try to use constant string.
+	switch CreatePlaceMarkerRequestShape(v) {
+	case CreatePlaceMarkerRequestShapeRectangle:
+		*s = CreatePlaceMarkerRequestShapeRectangle
+	case CreatePlaceMarkerRequestShapeCircle:
+		*s = CreatePlaceMarkerRequestShapeCircle
+	default:
+		*s = CreatePlaceMarkerRequestShape(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DeleteAreaForbidden) MarshalJSON() ([]byte, error) {
+func (s CreatePlaceMarkerRequestShape) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DeleteAreaForbidden) UnmarshalJSON(data []byte) error {
+func (s *CreatePlaceMarkerRequestShape) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes DeleteAreaNotFound as json.
-func (s *DeleteAreaNotFound) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode implements json.Marshaler.
+func (s *CreatePlaceRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes DeleteAreaNotFound from json.
-func (s *DeleteAreaNotFound) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode DeleteAreaNotFound to nil")
+// encodeFields encodes fields.
+func (s *CreatePlaceRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("areaId")
+		json.EncodeUUID(e, s.AreaId)
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		if s.Description.Set {
+			e.FieldStart("description")
+			s.Description.Encode(e)
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
 	}
-	*s = DeleteAreaNotFound(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *DeleteAreaNotFound) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DeleteAreaNotFound) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes DeleteAreaRoomPlanForbidden as json.
-func (s *DeleteAreaRoomPlanForbidden) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
-}
-
-// Decode decodes DeleteAreaRoomPlanForbidden from json.
-func (s *DeleteAreaRoomPlanForbidden) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode DeleteAreaRoomPlanForbidden to nil")
+	{
+		if s.Location.Set {
+			e.FieldStart("location")
+			s.Location.Encode(e)
+		}
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	{
+		if s.Capacity.Set {
+			e.FieldStart("capacity")
+			s.Capacity.Encode(e)
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
 	}
-	*s = DeleteAreaRoomPlanForbidden(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *DeleteAreaRoomPlanForbidden) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DeleteAreaRoomPlanForbidden) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes DeleteAreaRoomPlanNotFound as json.
-func (s *DeleteAreaRoomPlanNotFound) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
-}
-
-// Decode decodes DeleteAreaRoomPlanNotFound from json.
-func (s *DeleteAreaRoomPlanNotFound) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode DeleteAreaRoomPlanNotFound to nil")
+	{
+		if s.IsBookable.Set {
+			e.FieldStart("isBookable")
+			s.IsBookable.Encode(e)
+		}
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	{
+		if s.BookingMethod.Set {
+			e.FieldStart("bookingMethod")
+			s.BookingMethod.Encode(e)
+		}
+	}
+	{
+		if s.RequiresCheckIn.Set {
+			e.FieldStart("requiresCheckIn")
+			s.RequiresCheckIn.Encode(e)
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
 	}
-	*s = DeleteAreaRoomPlanNotFound(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *DeleteAreaRoomPlanNotFound) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DeleteAreaRoomPlanNotFound) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
 }
 
-// Encode encodes DeleteBuildingForbidden as json.
-func (s *DeleteBuildingForbidden) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+var jsonFieldsNameOfCreatePlaceRequest = [8]string{
+	0: "areaId",
+	1: "name",
+	2: "description",
+	3: "location",
+	4: "capacity",
+	5: "isBookable",
+	6: "bookingMethod",
+	7: "requiresCheckIn",
 }
 
-// Decode decodes DeleteBuildingForbidden from json.
-func (s *DeleteBuildingForbidden) Decode(d *jx.Decoder) error {
+// Decode decodes CreatePlaceRequest from json.
+func (s *CreatePlaceRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DeleteBuildingForbidden to nil")
+		return errors.New("invalid: unable to decode CreatePlaceRequest to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
+	var requiredBitSet [1]uint8
+	s.setDefaults()
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "areaId":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.AreaId = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"areaId\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "description":
+			if err := func() error {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"description\"")
+			}
+		case "location":
+			if err := func() error {
+				s.Location.Reset()
+				if err := s.Location.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"location\"")
+			}
+		case "capacity":
+			if err := func() error {
+				s.Capacity.Reset()
+				if err := s.Capacity.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"capacity\"")
+			}
+		case "isBookable":
+			if err := func() error {
+				s.IsBookable.Reset()
+				if err := s.IsBookable.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"isBookable\"")
+			}
+		case "bookingMethod":
+			if err := func() error {
+				s.BookingMethod.Reset()
+				if err := s.BookingMethod.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bookingMethod\"")
+			}
+		case "requiresCheckIn":
+			if err := func() error {
+				s.RequiresCheckIn.Reset()
+				if err := s.RequiresCheckIn.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"requiresCheckIn\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CreatePlaceRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCreatePlaceRequest) {
+					name = jsonFieldsNameOfCreatePlaceRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CreatePlaceRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreatePlaceRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CreatePlaceRequestBookingMethod as json.
+func (s CreatePlaceRequestBookingMethod) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes CreatePlaceRequestBookingMethod from json.
+func (s *CreatePlaceRequestBookingMethod) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreatePlaceRequestBookingMethod to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch CreatePlaceRequestBookingMethod(v) {
+	case CreatePlaceRequestBookingMethodSelfService:
+		*s = CreatePlaceRequestBookingMethodSelfService
+	case CreatePlaceRequestBookingMethodManual:
+		*s = CreatePlaceRequestBookingMethodManual
+	default:
+		*s = CreatePlaceRequestBookingMethod(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s CreatePlaceRequestBookingMethod) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreatePlaceRequestBookingMethod) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *CreateQRTemplateRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *CreateQRTemplateRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("htmlTemplate")
+		e.Str(s.HtmlTemplate)
+	}
+}
+
+var jsonFieldsNameOfCreateQRTemplateRequest = [2]string{
+	0: "name",
+	1: "htmlTemplate",
+}
+
+// Decode decodes CreateQRTemplateRequest from json.
+func (s *CreateQRTemplateRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateQRTemplateRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "htmlTemplate":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.HtmlTemplate = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"htmlTemplate\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CreateQRTemplateRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCreateQRTemplateRequest) {
+					name = jsonFieldsNameOfCreateQRTemplateRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CreateQRTemplateRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreateQRTemplateRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CreateQrTemplateBadRequest as json.
+func (s *CreateQrTemplateBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes CreateQrTemplateBadRequest from json.
+func (s *CreateQrTemplateBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateQrTemplateBadRequest to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = CreateQrTemplateBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CreateQrTemplateBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreateQrTemplateBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CreateQrTemplateForbidden as json.
+func (s *CreateQrTemplateForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes CreateQrTemplateForbidden from json.
+func (s *CreateQrTemplateForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateQrTemplateForbidden to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = CreateQrTemplateForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CreateQrTemplateForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreateQrTemplateForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CreateReservationBadRequest as json.
+func (s *CreateReservationBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes CreateReservationBadRequest from json.
+func (s *CreateReservationBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateReservationBadRequest to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = CreateReservationBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CreateReservationBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreateReservationBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CreateReservationConflict as json.
+func (s *CreateReservationConflict) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes CreateReservationConflict from json.
+func (s *CreateReservationConflict) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateReservationConflict to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = CreateReservationConflict(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CreateReservationConflict) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreateReservationConflict) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CreateReservationCreated as json.
+func (s CreateReservationCreated) Encode(e *jx.Encoder) {
+	switch s.Type {
+	case ReservationCreateReservationCreated:
+		s.Reservation.Encode(e)
+	case ReservationSeriesCreateReservationCreated:
+		s.ReservationSeries.Encode(e)
+	}
+}
+
+func (s CreateReservationCreated) encodeFields(e *jx.Encoder) {
+	switch s.Type {
+	case ReservationCreateReservationCreated:
+		s.Reservation.encodeFields(e)
+	case ReservationSeriesCreateReservationCreated:
+		s.ReservationSeries.encodeFields(e)
+	}
+}
+
+// Decode decodes CreateReservationCreated from json.
+func (s *CreateReservationCreated) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateReservationCreated to nil")
+	}
+	// Sum type fields.
+	if typ := d.Next(); typ != jx.Object {
+		return errors.Errorf("unexpected json type %q", typ)
+	}
+
+	var found bool
+	if err := d.Capture(func(d *jx.Decoder) error {
+		return d.ObjBytes(func(d *jx.Decoder, key []byte) error {
+			switch string(key) {
+			case "cancelReason":
+				// Type-based discrimination: check if field has expected JSON type (nullable)
+				typ := d.Next()
+				if typ != jx.String && typ != jx.Null {
+					// Field exists but has wrong type, not a match for this variant
+					return d.Skip()
+				}
+				match := ReservationCreateReservationCreated
+				if found && s.Type != match {
+					s.Type = ""
+					return errors.Errorf("multiple oneOf matches: (%v, %v)", s.Type, match)
+				}
+				found = true
+				s.Type = match
+			case "checkInTime":
+				match := ReservationCreateReservationCreated
+				if found && s.Type != match {
+					s.Type = ""
+					return errors.Errorf("multiple oneOf matches: (%v, %v)", s.Type, match)
+				}
+				found = true
+				s.Type = match
+			case "createdAt":
+				match := ReservationCreateReservationCreated
+				if found && s.Type != match {
+					s.Type = ""
+					return errors.Errorf("multiple oneOf matches: (%v, %v)", s.Type, match)
+				}
+				found = true
+				s.Type = match
+			case "endTime":
+				match := ReservationCreateReservationCreated
+				if found && s.Type != match {
+					s.Type = ""
+					return errors.Errorf("multiple oneOf matches: (%v, %v)", s.Type, match)
+				}
+				found = true
+				s.Type = match
+			case "id":
+				match := ReservationCreateReservationCreated
+				if found && s.Type != match {
+					s.Type = ""
+					return errors.Errorf("multiple oneOf matches: (%v, %v)", s.Type, match)
+				}
+				found = true
+				s.Type = match
+			case "isRecurring":
+				// Type-based discrimination: check if field has expected JSON type
+				if typ := d.Next(); typ != jx.Bool {
+					// Field exists but has wrong type, not a match for this variant
+					return d.Skip()
+				}
+				match := ReservationCreateReservationCreated
+				if found && s.Type != match {
+					s.Type = ""
+					return errors.Errorf("multiple oneOf matches: (%v, %v)", s.Type, match)
+				}
+				found = true
+				s.Type = match
+			case "occurrences":
+				// Type-based discrimination: check if field has expected JSON type
+				if typ := d.Next(); typ != jx.Array {
+					// Field exists but has wrong type, not a match for this variant
+					return d.Skip()
+				}
+				match := ReservationSeriesCreateReservationCreated
+				if found && s.Type != match {
+					s.Type = ""
+					return errors.Errorf("multiple oneOf matches: (%v, %v)", s.Type, match)
+				}
+				found = true
+				s.Type = match
+			case "placeId":
+				match := ReservationCreateReservationCreated
+				if found && s.Type != match {
+					s.Type = ""
+					return errors.Errorf("multiple oneOf matches: (%v, %v)", s.Type, match)
+				}
+				found = true
+				s.Type = match
+			case "startTime":
+				match := ReservationCreateReservationCreated
+				if found && s.Type != match {
+					s.Type = ""
+					return errors.Errorf("multiple oneOf matches: (%v, %v)", s.Type, match)
+				}
+				found = true
+				s.Type = match
+			case "status":
+				// Type-based discrimination: check if field has expected JSON type
+				if typ := d.Next(); typ != jx.String {
+					// Field exists but has wrong type, not a match for this variant
+					return d.Skip()
+				}
+				match := ReservationCreateReservationCreated
+				if found && s.Type != match {
+					s.Type = ""
+					return errors.Errorf("multiple oneOf matches: (%v, %v)", s.Type, match)
+				}
+				found = true
+				s.Type = match
+			case "updatedAt":
+				match := ReservationCreateReservationCreated
+				if found && s.Type != match {
+					s.Type = ""
+					return errors.Errorf("multiple oneOf matches: (%v, %v)", s.Type, match)
+				}
+				found = true
+				s.Type = match
+			case "userId":
+				match := ReservationCreateReservationCreated
+				if found && s.Type != match {
+					s.Type = ""
+					return errors.Errorf("multiple oneOf matches: (%v, %v)", s.Type, match)
+				}
+				found = true
+				s.Type = match
+			}
+			return d.Skip()
+		})
+	}); err != nil {
+		return errors.Wrap(err, "capture")
+	}
+	if !found {
+		return errors.New("unable to detect sum type variant")
+	}
+	switch s.Type {
+	case ReservationCreateReservationCreated:
+		if err := s.Reservation.Decode(d); err != nil {
+			return err
+		}
+	case ReservationSeriesCreateReservationCreated:
+		if err := s.ReservationSeries.Decode(d); err != nil {
+			return err
+		}
+	default:
+		return errors.Errorf("inferred invalid type: %s", s.Type)
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s CreateReservationCreated) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreateReservationCreated) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CreateReservationForbidden as json.
+func (s *CreateReservationForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes CreateReservationForbidden from json.
+func (s *CreateReservationForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateReservationForbidden to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = CreateReservationForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CreateReservationForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreateReservationForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *CreateReservationRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *CreateReservationRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("placeId")
+		json.EncodeUUID(e, s.PlaceId)
+	}
+	{
+		e.FieldStart("startTime")
+		json.EncodeDateTime(e, s.StartTime)
+	}
+	{
+		e.FieldStart("endTime")
+		json.EncodeDateTime(e, s.EndTime)
+	}
+	{
+		if s.Recurrence.Set {
+			e.FieldStart("recurrence")
+			s.Recurrence.Encode(e)
+		}
+	}
+	{
+		if s.UserId.Set {
+			e.FieldStart("userId")
+			s.UserId.Encode(e)
+		}
+	}
+	{
+		if s.UserEmail.Set {
+			e.FieldStart("userEmail")
+			s.UserEmail.Encode(e)
+		}
+	}
+	{
+		if s.BypassQuotas.Set {
+			e.FieldStart("bypassQuotas")
+			s.BypassQuotas.Encode(e)
+		}
+	}
+	{
+		if s.BypassBlockings.Set {
+			e.FieldStart("bypassBlockings")
+			s.BypassBlockings.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfCreateReservationRequest = [8]string{
+	0: "placeId",
+	1: "startTime",
+	2: "endTime",
+	3: "recurrence",
+	4: "userId",
+	5: "userEmail",
+	6: "bypassQuotas",
+	7: "bypassBlockings",
+}
+
+// Decode decodes CreateReservationRequest from json.
+func (s *CreateReservationRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateReservationRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+	s.setDefaults()
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "placeId":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.PlaceId = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"placeId\"")
+			}
+		case "startTime":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.StartTime = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"startTime\"")
+			}
+		case "endTime":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.EndTime = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"endTime\"")
+			}
+		case "recurrence":
+			if err := func() error {
+				s.Recurrence.Reset()
+				if err := s.Recurrence.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"recurrence\"")
+			}
+		case "userId":
+			if err := func() error {
+				s.UserId.Reset()
+				if err := s.UserId.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"userId\"")
+			}
+		case "userEmail":
+			if err := func() error {
+				s.UserEmail.Reset()
+				if err := s.UserEmail.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"userEmail\"")
+			}
+		case "bypassQuotas":
+			if err := func() error {
+				s.BypassQuotas.Reset()
+				if err := s.BypassQuotas.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bypassQuotas\"")
+			}
+		case "bypassBlockings":
+			if err := func() error {
+				s.BypassBlockings.Reset()
+				if err := s.BypassBlockings.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bypassBlockings\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CreateReservationRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCreateReservationRequest) {
+					name = jsonFieldsNameOfCreateReservationRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CreateReservationRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreateReservationRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *CreateReservationRequestRecurrence) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *CreateReservationRequestRecurrence) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("pattern")
+		s.Pattern.Encode(e)
+	}
+	{
+		e.FieldStart("endDate")
+		json.EncodeDate(e, s.EndDate)
+	}
+	{
+		if s.DaysOfWeek != nil {
+			e.FieldStart("daysOfWeek")
+			e.ArrStart()
+			for _, elem := range s.DaysOfWeek {
+				e.Int(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Mode.Set {
+			e.FieldStart("mode")
+			s.Mode.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfCreateReservationRequestRecurrence = [4]string{
+	0: "pattern",
+	1: "endDate",
+	2: "daysOfWeek",
+	3: "mode",
+}
+
+// Decode decodes CreateReservationRequestRecurrence from json.
+func (s *CreateReservationRequestRecurrence) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateReservationRequestRecurrence to nil")
+	}
+	var requiredBitSet [1]uint8
+	s.setDefaults()
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "pattern":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Pattern.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pattern\"")
+			}
+		case "endDate":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeDate(d)
+				s.EndDate = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"endDate\"")
+			}
+		case "daysOfWeek":
+			if err := func() error {
+				s.DaysOfWeek = make([]int, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem int
+					v, err := d.Int()
+					elem = int(v)
+					if err != nil {
+						return err
+					}
+					s.DaysOfWeek = append(s.DaysOfWeek, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"daysOfWeek\"")
+			}
+		case "mode":
+			if err := func() error {
+				s.Mode.Reset()
+				if err := s.Mode.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"mode\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CreateReservationRequestRecurrence")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCreateReservationRequestRecurrence) {
+					name = jsonFieldsNameOfCreateReservationRequestRecurrence[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CreateReservationRequestRecurrence) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreateReservationRequestRecurrence) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CreateReservationRequestRecurrenceMode as json.
+func (s CreateReservationRequestRecurrenceMode) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes CreateReservationRequestRecurrenceMode from json.
+func (s *CreateReservationRequestRecurrenceMode) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateReservationRequestRecurrenceMode to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch CreateReservationRequestRecurrenceMode(v) {
+	case CreateReservationRequestRecurrenceModeAllOrNothing:
+		*s = CreateReservationRequestRecurrenceModeAllOrNothing
+	case CreateReservationRequestRecurrenceModeSkipConflicts:
+		*s = CreateReservationRequestRecurrenceModeSkipConflicts
+	default:
+		*s = CreateReservationRequestRecurrenceMode(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s CreateReservationRequestRecurrenceMode) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreateReservationRequestRecurrenceMode) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CreateReservationRequestRecurrencePattern as json.
+func (s CreateReservationRequestRecurrencePattern) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes CreateReservationRequestRecurrencePattern from json.
+func (s *CreateReservationRequestRecurrencePattern) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateReservationRequestRecurrencePattern to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch CreateReservationRequestRecurrencePattern(v) {
+	case CreateReservationRequestRecurrencePatternDaily:
+		*s = CreateReservationRequestRecurrencePatternDaily
+	case CreateReservationRequestRecurrencePatternWeekly:
+		*s = CreateReservationRequestRecurrencePatternWeekly
+	case CreateReservationRequestRecurrencePatternBiweekly:
+		*s = CreateReservationRequestRecurrencePatternBiweekly
+	case CreateReservationRequestRecurrencePatternMonthly:
+		*s = CreateReservationRequestRecurrencePatternMonthly
+	default:
+		*s = CreateReservationRequestRecurrencePattern(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s CreateReservationRequestRecurrencePattern) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreateReservationRequestRecurrencePattern) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CreateReservationUnauthorized as json.
+func (s *CreateReservationUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes CreateReservationUnauthorized from json.
+func (s *CreateReservationUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateReservationUnauthorized to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = CreateReservationUnauthorized(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CreateReservationUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreateReservationUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *CurrentOccupancy) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *CurrentOccupancy) encodeFields(e *jx.Encoder) {
+	{
+		if s.TotalPlaces.Set {
+			e.FieldStart("totalPlaces")
+			s.TotalPlaces.Encode(e)
+		}
+	}
+	{
+		if s.OccupiedPlaces.Set {
+			e.FieldStart("occupiedPlaces")
+			s.OccupiedPlaces.Encode(e)
+		}
+	}
+	{
+		if s.AvailablePlaces.Set {
+			e.FieldStart("availablePlaces")
+			s.AvailablePlaces.Encode(e)
+		}
+	}
+	{
+		if s.OccupancyRate.Set {
+			e.FieldStart("occupancyRate")
+			s.OccupancyRate.Encode(e)
+		}
+	}
+	{
+		if s.CheckedInCount.Set {
+			e.FieldStart("checkedInCount")
+			s.CheckedInCount.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfCurrentOccupancy = [5]string{
+	0: "totalPlaces",
+	1: "occupiedPlaces",
+	2: "availablePlaces",
+	3: "occupancyRate",
+	4: "checkedInCount",
+}
+
+// Decode decodes CurrentOccupancy from json.
+func (s *CurrentOccupancy) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CurrentOccupancy to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "totalPlaces":
+			if err := func() error {
+				s.TotalPlaces.Reset()
+				if err := s.TotalPlaces.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"totalPlaces\"")
+			}
+		case "occupiedPlaces":
+			if err := func() error {
+				s.OccupiedPlaces.Reset()
+				if err := s.OccupiedPlaces.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"occupiedPlaces\"")
+			}
+		case "availablePlaces":
+			if err := func() error {
+				s.AvailablePlaces.Reset()
+				if err := s.AvailablePlaces.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"availablePlaces\"")
+			}
+		case "occupancyRate":
+			if err := func() error {
+				s.OccupancyRate.Reset()
+				if err := s.OccupancyRate.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"occupancyRate\"")
+			}
+		case "checkedInCount":
+			if err := func() error {
+				s.CheckedInCount.Reset()
+				if err := s.CheckedInCount.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"checkedInCount\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CurrentOccupancy")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CurrentOccupancy) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CurrentOccupancy) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes DeleteAreaForbidden as json.
+func (s *DeleteAreaForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes DeleteAreaForbidden from json.
+func (s *DeleteAreaForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DeleteAreaForbidden to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = DeleteAreaForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DeleteAreaForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DeleteAreaForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes DeleteAreaNotFound as json.
+func (s *DeleteAreaNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes DeleteAreaNotFound from json.
+func (s *DeleteAreaNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DeleteAreaNotFound to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = DeleteAreaNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DeleteAreaNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DeleteAreaNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes DeleteAreaRoomPlanForbidden as json.
+func (s *DeleteAreaRoomPlanForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes DeleteAreaRoomPlanForbidden from json.
+func (s *DeleteAreaRoomPlanForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DeleteAreaRoomPlanForbidden to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = DeleteAreaRoomPlanForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DeleteAreaRoomPlanForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DeleteAreaRoomPlanForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes DeleteAreaRoomPlanNotFound as json.
+func (s *DeleteAreaRoomPlanNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes DeleteAreaRoomPlanNotFound from json.
+func (s *DeleteAreaRoomPlanNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DeleteAreaRoomPlanNotFound to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = DeleteAreaRoomPlanNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DeleteAreaRoomPlanNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DeleteAreaRoomPlanNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes DeleteBuildingForbidden as json.
+func (s *DeleteBuildingForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes DeleteBuildingForbidden from json.
+func (s *DeleteBuildingForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DeleteBuildingForbidden to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = DeleteBuildingForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DeleteBuildingForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DeleteBuildingForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes DeleteBuildingNotFound as json.
+func (s *DeleteBuildingNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes DeleteBuildingNotFound from json.
+func (s *DeleteBuildingNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DeleteBuildingNotFound to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = DeleteBuildingNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DeleteBuildingNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DeleteBuildingNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes DeleteEquipmentForbidden as json.
+func (s *DeleteEquipmentForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes DeleteEquipmentForbidden from json.
+func (s *DeleteEquipmentForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DeleteEquipmentForbidden to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = DeleteEquipmentForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DeleteEquipmentForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DeleteEquipmentForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes DeleteEquipmentNotFound as json.
+func (s *DeleteEquipmentNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes DeleteEquipmentNotFound from json.
+func (s *DeleteEquipmentNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DeleteEquipmentNotFound to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = DeleteEquipmentNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DeleteEquipmentNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DeleteEquipmentNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes DeleteGroupConstraintProfileForbidden as json.
+func (s *DeleteGroupConstraintProfileForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes DeleteGroupConstraintProfileForbidden from json.
+func (s *DeleteGroupConstraintProfileForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DeleteGroupConstraintProfileForbidden to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = DeleteGroupConstraintProfileForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DeleteGroupConstraintProfileForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DeleteGroupConstraintProfileForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes DeleteGroupConstraintProfileNotFound as json.
+func (s *DeleteGroupConstraintProfileNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes DeleteGroupConstraintProfileNotFound from json.
+func (s *DeleteGroupConstraintProfileNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DeleteGroupConstraintProfileNotFound to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = DeleteGroupConstraintProfileNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DeleteGroupConstraintProfileNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DeleteGroupConstraintProfileNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes DeleteGroupForbidden as json.
+func (s *DeleteGroupForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes DeleteGroupForbidden from json.
+func (s *DeleteGroupForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DeleteGroupForbidden to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = DeleteGroupForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DeleteGroupForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DeleteGroupForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes DeleteGroupNotFound as json.
+func (s *DeleteGroupNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes DeleteGroupNotFound from json.
+func (s *DeleteGroupNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DeleteGroupNotFound to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = DeleteGroupNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DeleteGroupNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DeleteGroupNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes DeletePlaceForbidden as json.
+func (s *DeletePlaceForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes DeletePlaceForbidden from json.
+func (s *DeletePlaceForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DeletePlaceForbidden to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = DeletePlaceForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DeletePlaceForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DeletePlaceForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes DeletePlaceNotFound as json.
+func (s *DeletePlaceNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes DeletePlaceNotFound from json.
+func (s *DeletePlaceNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DeletePlaceNotFound to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = DeletePlaceNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DeletePlaceNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DeletePlaceNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes DeleteQrTemplateForbidden as json.
+func (s *DeleteQrTemplateForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes DeleteQrTemplateForbidden from json.
+func (s *DeleteQrTemplateForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DeleteQrTemplateForbidden to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
 			return err
 		}
 		return nil
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = DeleteBuildingForbidden(unwrapped)
+	*s = DeleteQrTemplateForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DeleteBuildingForbidden) MarshalJSON() ([]byte, error) {
+func (s *DeleteQrTemplateForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DeleteBuildingForbidden) UnmarshalJSON(data []byte) error {
+func (s *DeleteQrTemplateForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes DeleteBuildingNotFound as json.
-func (s *DeleteBuildingNotFound) Encode(e *jx.Encoder) {
+// Encode encodes DeleteQrTemplateNotFound as json.
+func (s *DeleteQrTemplateNotFound) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes DeleteBuildingNotFound from json.
-func (s *DeleteBuildingNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes DeleteQrTemplateNotFound from json.
+func (s *DeleteQrTemplateNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DeleteBuildingNotFound to nil")
+		return errors.New("invalid: unable to decode DeleteQrTemplateNotFound to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -7583,34 +9390,72 @@ func (s *DeleteBuildingNotFound) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = DeleteBuildingNotFound(unwrapped)
+	*s = DeleteQrTemplateNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DeleteBuildingNotFound) MarshalJSON() ([]byte, error) {
+func (s *DeleteQrTemplateNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DeleteBuildingNotFound) UnmarshalJSON(data []byte) error {
+func (s *DeleteQrTemplateNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes DeleteEquipmentForbidden as json.
-func (s *DeleteEquipmentForbidden) Encode(e *jx.Encoder) {
+// Encode encodes DisableUserForbidden as json.
+func (s *DisableUserForbidden) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes DeleteEquipmentForbidden from json.
-func (s *DeleteEquipmentForbidden) Decode(d *jx.Decoder) error {
+// Decode decodes DisableUserForbidden from json.
+func (s *DisableUserForbidden) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DeleteEquipmentForbidden to nil")
+		return errors.New("invalid: unable to decode DisableUserForbidden to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = DisableUserForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DisableUserForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DisableUserForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes DisableUserNotFound as json.
+func (s *DisableUserNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes DisableUserNotFound from json.
+func (s *DisableUserNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DisableUserNotFound to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -7621,34 +9466,239 @@ func (s *DeleteEquipmentForbidden) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = DeleteEquipmentForbidden(unwrapped)
+	*s = DisableUserNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DisableUserNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DisableUserNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *EffectivePlaceConstraints) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *EffectivePlaceConstraints) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("constraints")
+		s.Constraints.Encode(e)
+	}
+	{
+		e.FieldStart("sources")
+		s.Sources.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfEffectivePlaceConstraints = [2]string{
+	0: "constraints",
+	1: "sources",
+}
+
+// Decode decodes EffectivePlaceConstraints from json.
+func (s *EffectivePlaceConstraints) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EffectivePlaceConstraints to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "constraints":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Constraints.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"constraints\"")
+			}
+		case "sources":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Sources.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"sources\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode EffectivePlaceConstraints")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfEffectivePlaceConstraints) {
+					name = jsonFieldsNameOfEffectivePlaceConstraints[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *EffectivePlaceConstraints) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EffectivePlaceConstraints) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s EffectivePlaceConstraintsSources) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s EffectivePlaceConstraintsSources) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		elem.Encode(e)
+	}
+}
+
+// Decode decodes EffectivePlaceConstraintsSources from json.
+func (s *EffectivePlaceConstraintsSources) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EffectivePlaceConstraintsSources to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem EffectivePlaceConstraintsSourcesItem
+		if err := func() error {
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode EffectivePlaceConstraintsSources")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s EffectivePlaceConstraintsSources) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EffectivePlaceConstraintsSources) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EffectivePlaceConstraintsSourcesItem as json.
+func (s EffectivePlaceConstraintsSourcesItem) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes EffectivePlaceConstraintsSourcesItem from json.
+func (s *EffectivePlaceConstraintsSourcesItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EffectivePlaceConstraintsSourcesItem to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch EffectivePlaceConstraintsSourcesItem(v) {
+	case EffectivePlaceConstraintsSourcesItemPlace:
+		*s = EffectivePlaceConstraintsSourcesItemPlace
+	case EffectivePlaceConstraintsSourcesItemArea:
+		*s = EffectivePlaceConstraintsSourcesItemArea
+	case EffectivePlaceConstraintsSourcesItemBuilding:
+		*s = EffectivePlaceConstraintsSourcesItemBuilding
+	default:
+		*s = EffectivePlaceConstraintsSourcesItem(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DeleteEquipmentForbidden) MarshalJSON() ([]byte, error) {
+func (s EffectivePlaceConstraintsSourcesItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DeleteEquipmentForbidden) UnmarshalJSON(data []byte) error {
+func (s *EffectivePlaceConstraintsSourcesItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes DeleteEquipmentNotFound as json.
-func (s *DeleteEquipmentNotFound) Encode(e *jx.Encoder) {
+// Encode encodes EnableUserForbidden as json.
+func (s *EnableUserForbidden) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes DeleteEquipmentNotFound from json.
-func (s *DeleteEquipmentNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes EnableUserForbidden from json.
+func (s *EnableUserForbidden) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DeleteEquipmentNotFound to nil")
+		return errors.New("invalid: unable to decode EnableUserForbidden to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -7659,34 +9709,34 @@ func (s *DeleteEquipmentNotFound) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = DeleteEquipmentNotFound(unwrapped)
+	*s = EnableUserForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DeleteEquipmentNotFound) MarshalJSON() ([]byte, error) {
+func (s *EnableUserForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DeleteEquipmentNotFound) UnmarshalJSON(data []byte) error {
+func (s *EnableUserForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes DeleteGroupForbidden as json.
-func (s *DeleteGroupForbidden) Encode(e *jx.Encoder) {
+// Encode encodes EnableUserNotFound as json.
+func (s *EnableUserNotFound) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes DeleteGroupForbidden from json.
-func (s *DeleteGroupForbidden) Decode(d *jx.Decoder) error {
+// Decode decodes EnableUserNotFound from json.
+func (s *EnableUserNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DeleteGroupForbidden to nil")
+		return errors.New("invalid: unable to decode EnableUserNotFound to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -7697,262 +9747,463 @@ func (s *DeleteGroupForbidden) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = DeleteGroupForbidden(unwrapped)
+	*s = EnableUserNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DeleteGroupForbidden) MarshalJSON() ([]byte, error) {
+func (s *EnableUserNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DeleteGroupForbidden) UnmarshalJSON(data []byte) error {
+func (s *EnableUserNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes DeleteGroupNotFound as json.
-func (s *DeleteGroupNotFound) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
+// Encode implements json.Marshaler.
+func (s *Equipment) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	unwrapped.Encode(e)
+// encodeFields encodes fields.
+func (s *Equipment) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		json.EncodeUUID(e, s.ID)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		if s.Icon.Set {
+			e.FieldStart("icon")
+			s.Icon.Encode(e)
+		}
+	}
+	{
+		if s.Description.Set {
+			e.FieldStart("description")
+			s.Description.Encode(e)
+		}
+	}
 }
 
-// Decode decodes DeleteGroupNotFound from json.
-func (s *DeleteGroupNotFound) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfEquipment = [4]string{
+	0: "id",
+	1: "name",
+	2: "icon",
+	3: "description",
+}
+
+// Decode decodes Equipment from json.
+func (s *Equipment) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DeleteGroupNotFound to nil")
+		return errors.New("invalid: unable to decode Equipment to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.ID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "icon":
+			if err := func() error {
+				s.Icon.Reset()
+				if err := s.Icon.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"icon\"")
+			}
+		case "description":
+			if err := func() error {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"description\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode Equipment")
 	}
-	*s = DeleteGroupNotFound(unwrapped)
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfEquipment) {
+					name = jsonFieldsNameOfEquipment[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DeleteGroupNotFound) MarshalJSON() ([]byte, error) {
+func (s *Equipment) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DeleteGroupNotFound) UnmarshalJSON(data []byte) error {
+func (s *Equipment) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes DeletePlaceForbidden as json.
-func (s *DeletePlaceForbidden) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
+// Encode implements json.Marshaler.
+func (s *ErrorResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	unwrapped.Encode(e)
+// encodeFields encodes fields.
+func (s *ErrorResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("error")
+		s.Error.Encode(e)
+	}
 }
 
-// Decode decodes DeletePlaceForbidden from json.
-func (s *DeletePlaceForbidden) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfErrorResponse = [1]string{
+	0: "error",
+}
+
+// Decode decodes ErrorResponse from json.
+func (s *ErrorResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DeletePlaceForbidden to nil")
+		return errors.New("invalid: unable to decode ErrorResponse to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "error":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Error.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"error\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode ErrorResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfErrorResponse) {
+					name = jsonFieldsNameOfErrorResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
-	*s = DeletePlaceForbidden(unwrapped)
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DeletePlaceForbidden) MarshalJSON() ([]byte, error) {
+func (s *ErrorResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DeletePlaceForbidden) UnmarshalJSON(data []byte) error {
+func (s *ErrorResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes DeletePlaceNotFound as json.
-func (s *DeletePlaceNotFound) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode implements json.Marshaler.
+func (s *ErrorResponseError) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes DeletePlaceNotFound from json.
-func (s *DeletePlaceNotFound) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode DeletePlaceNotFound to nil")
+// encodeFields encodes fields.
+func (s *ErrorResponseError) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("code")
+		e.Str(s.Code)
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	{
+		e.FieldStart("message")
+		e.Str(s.Message)
+	}
+	{
+		if s.Details.Set {
+			e.FieldStart("details")
+			s.Details.Encode(e)
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
 	}
-	*s = DeletePlaceNotFound(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *DeletePlaceNotFound) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DeletePlaceNotFound) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
 }
 
-// Encode encodes DeleteQrTemplateForbidden as json.
-func (s *DeleteQrTemplateForbidden) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+var jsonFieldsNameOfErrorResponseError = [3]string{
+	0: "code",
+	1: "message",
+	2: "details",
 }
 
-// Decode decodes DeleteQrTemplateForbidden from json.
-func (s *DeleteQrTemplateForbidden) Decode(d *jx.Decoder) error {
+// Decode decodes ErrorResponseError from json.
+func (s *ErrorResponseError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DeleteQrTemplateForbidden to nil")
+		return errors.New("invalid: unable to decode ErrorResponseError to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "code":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Code = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"code\"")
+			}
+		case "message":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Message = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		case "details":
+			if err := func() error {
+				s.Details.Reset()
+				if err := s.Details.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"details\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode ErrorResponseError")
 	}
-	*s = DeleteQrTemplateForbidden(unwrapped)
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfErrorResponseError) {
+					name = jsonFieldsNameOfErrorResponseError[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DeleteQrTemplateForbidden) MarshalJSON() ([]byte, error) {
+func (s *ErrorResponseError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DeleteQrTemplateForbidden) UnmarshalJSON(data []byte) error {
+func (s *ErrorResponseError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes DeleteQrTemplateNotFound as json.
-func (s *DeleteQrTemplateNotFound) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode implements json.Marshaler.
+func (s ErrorResponseErrorDetails) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes DeleteQrTemplateNotFound from json.
-func (s *DeleteQrTemplateNotFound) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode DeleteQrTemplateNotFound to nil")
-	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+// encodeFields implements json.Marshaler.
+func (s ErrorResponseErrorDetails) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
 	}
-	*s = DeleteQrTemplateNotFound(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *DeleteQrTemplateNotFound) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DeleteQrTemplateNotFound) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes DisableUserForbidden as json.
-func (s *DisableUserForbidden) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
 }
 
-// Decode decodes DisableUserForbidden from json.
-func (s *DisableUserForbidden) Decode(d *jx.Decoder) error {
+// Decode decodes ErrorResponseErrorDetails from json.
+func (s *ErrorResponseErrorDetails) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DisableUserForbidden to nil")
+		return errors.New("invalid: unable to decode ErrorResponseErrorDetails to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
 		}
+		m[string(k)] = elem
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode ErrorResponseErrorDetails")
 	}
-	*s = DisableUserForbidden(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DisableUserForbidden) MarshalJSON() ([]byte, error) {
+func (s ErrorResponseErrorDetails) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DisableUserForbidden) UnmarshalJSON(data []byte) error {
+func (s *ErrorResponseErrorDetails) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes DisableUserNotFound as json.
-func (s *DisableUserNotFound) Encode(e *jx.Encoder) {
+// Encode encodes ExportAreaQrCodesBadRequest as json.
+func (s *ExportAreaQrCodesBadRequest) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes DisableUserNotFound from json.
-func (s *DisableUserNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes ExportAreaQrCodesBadRequest from json.
+func (s *ExportAreaQrCodesBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DisableUserNotFound to nil")
+		return errors.New("invalid: unable to decode ExportAreaQrCodesBadRequest to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -7963,34 +10214,34 @@ func (s *DisableUserNotFound) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = DisableUserNotFound(unwrapped)
+	*s = ExportAreaQrCodesBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DisableUserNotFound) MarshalJSON() ([]byte, error) {
+func (s *ExportAreaQrCodesBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DisableUserNotFound) UnmarshalJSON(data []byte) error {
+func (s *ExportAreaQrCodesBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EnableUserForbidden as json.
-func (s *EnableUserForbidden) Encode(e *jx.Encoder) {
+// Encode encodes ExportAreaQrCodesForbidden as json.
+func (s *ExportAreaQrCodesForbidden) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes EnableUserForbidden from json.
-func (s *EnableUserForbidden) Decode(d *jx.Decoder) error {
+// Decode decodes ExportAreaQrCodesForbidden from json.
+func (s *ExportAreaQrCodesForbidden) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EnableUserForbidden to nil")
+		return errors.New("invalid: unable to decode ExportAreaQrCodesForbidden to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -8001,34 +10252,34 @@ func (s *EnableUserForbidden) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = EnableUserForbidden(unwrapped)
+	*s = ExportAreaQrCodesForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EnableUserForbidden) MarshalJSON() ([]byte, error) {
+func (s *ExportAreaQrCodesForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EnableUserForbidden) UnmarshalJSON(data []byte) error {
+func (s *ExportAreaQrCodesForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EnableUserNotFound as json.
-func (s *EnableUserNotFound) Encode(e *jx.Encoder) {
+// Encode encodes ExportAreaQrCodesNotFound as json.
+func (s *ExportAreaQrCodesNotFound) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes EnableUserNotFound from json.
-func (s *EnableUserNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes ExportAreaQrCodesNotFound from json.
+func (s *ExportAreaQrCodesNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EnableUserNotFound to nil")
+		return errors.New("invalid: unable to decode ExportAreaQrCodesNotFound to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -8039,448 +10290,209 @@ func (s *EnableUserNotFound) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = EnableUserNotFound(unwrapped)
+	*s = ExportAreaQrCodesNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EnableUserNotFound) MarshalJSON() ([]byte, error) {
+func (s *ExportAreaQrCodesNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EnableUserNotFound) UnmarshalJSON(data []byte) error {
+func (s *ExportAreaQrCodesNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *Equipment) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *Equipment) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		json.EncodeUUID(e, s.ID)
-	}
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
-	}
-	{
-		if s.Icon.Set {
-			e.FieldStart("icon")
-			s.Icon.Encode(e)
-		}
-	}
-	{
-		if s.Description.Set {
-			e.FieldStart("description")
-			s.Description.Encode(e)
-		}
-	}
-}
+// Encode encodes ExportBuildingQrCodesBadRequest as json.
+func (s *ExportBuildingQrCodesBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
 
-var jsonFieldsNameOfEquipment = [4]string{
-	0: "id",
-	1: "name",
-	2: "icon",
-	3: "description",
+	unwrapped.Encode(e)
 }
 
-// Decode decodes Equipment from json.
-func (s *Equipment) Decode(d *jx.Decoder) error {
+// Decode decodes ExportBuildingQrCodesBadRequest from json.
+func (s *ExportBuildingQrCodesBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Equipment to nil")
+		return errors.New("invalid: unable to decode ExportBuildingQrCodesBadRequest to nil")
 	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "id":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.ID = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "name":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "icon":
-			if err := func() error {
-				s.Icon.Reset()
-				if err := s.Icon.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"icon\"")
-			}
-		case "description":
-			if err := func() error {
-				s.Description.Reset()
-				if err := s.Description.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"description\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode Equipment")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfEquipment) {
-					name = jsonFieldsNameOfEquipment[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = ExportBuildingQrCodesBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Equipment) MarshalJSON() ([]byte, error) {
+func (s *ExportBuildingQrCodesBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Equipment) UnmarshalJSON(data []byte) error {
+func (s *ExportBuildingQrCodesBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *ErrorResponse) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *ErrorResponse) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("error")
-		s.Error.Encode(e)
-	}
-}
+// Encode encodes ExportBuildingQrCodesForbidden as json.
+func (s *ExportBuildingQrCodesForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
 
-var jsonFieldsNameOfErrorResponse = [1]string{
-	0: "error",
+	unwrapped.Encode(e)
 }
 
-// Decode decodes ErrorResponse from json.
-func (s *ErrorResponse) Decode(d *jx.Decoder) error {
+// Decode decodes ExportBuildingQrCodesForbidden from json.
+func (s *ExportBuildingQrCodesForbidden) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ErrorResponse to nil")
+		return errors.New("invalid: unable to decode ExportBuildingQrCodesForbidden to nil")
 	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "error":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				if err := s.Error.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"error\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode ErrorResponse")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfErrorResponse) {
-					name = jsonFieldsNameOfErrorResponse[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = ExportBuildingQrCodesForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ErrorResponse) MarshalJSON() ([]byte, error) {
+func (s *ExportBuildingQrCodesForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ErrorResponse) UnmarshalJSON(data []byte) error {
+func (s *ExportBuildingQrCodesForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *ErrorResponseError) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes ExportBuildingQrCodesNotFound as json.
+func (s *ExportBuildingQrCodesNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *ErrorResponseError) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("code")
-		e.Str(s.Code)
-	}
-	{
-		e.FieldStart("message")
-		e.Str(s.Message)
+// Decode decodes ExportBuildingQrCodesNotFound from json.
+func (s *ExportBuildingQrCodesNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ExportBuildingQrCodesNotFound to nil")
 	}
-	{
-		if s.Details.Set {
-			e.FieldStart("details")
-			s.Details.Encode(e)
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = ExportBuildingQrCodesNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ExportBuildingQrCodesNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-var jsonFieldsNameOfErrorResponseError = [3]string{
-	0: "code",
-	1: "message",
-	2: "details",
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ExportBuildingQrCodesNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
 }
 
-// Decode decodes ErrorResponseError from json.
-func (s *ErrorResponseError) Decode(d *jx.Decoder) error {
+// Encode encodes GetAreaAvailabilityBadRequest as json.
+func (s *GetAreaAvailabilityBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetAreaAvailabilityBadRequest from json.
+func (s *GetAreaAvailabilityBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ErrorResponseError to nil")
+		return errors.New("invalid: unable to decode GetAreaAvailabilityBadRequest to nil")
 	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "code":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Str()
-				s.Code = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"code\"")
-			}
-		case "message":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.Message = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message\"")
-			}
-		case "details":
-			if err := func() error {
-				s.Details.Reset()
-				if err := s.Details.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"details\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode ErrorResponseError")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfErrorResponseError) {
-					name = jsonFieldsNameOfErrorResponseError[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = GetAreaAvailabilityBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ErrorResponseError) MarshalJSON() ([]byte, error) {
+func (s *GetAreaAvailabilityBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ErrorResponseError) UnmarshalJSON(data []byte) error {
+func (s *GetAreaAvailabilityBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s ErrorResponseErrorDetails) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields implements json.Marshaler.
-func (s ErrorResponseErrorDetails) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
+// Encode encodes GetAreaAvailabilityNotFound as json.
+func (s *GetAreaAvailabilityNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
 
-		if len(elem) != 0 {
-			e.Raw(elem)
-		}
-	}
+	unwrapped.Encode(e)
 }
 
-// Decode decodes ErrorResponseErrorDetails from json.
-func (s *ErrorResponseErrorDetails) Decode(d *jx.Decoder) error {
+// Decode decodes GetAreaAvailabilityNotFound from json.
+func (s *GetAreaAvailabilityNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ErrorResponseErrorDetails to nil")
+		return errors.New("invalid: unable to decode GetAreaAvailabilityNotFound to nil")
 	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
-		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
-		m[string(k)] = elem
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode ErrorResponseErrorDetails")
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = GetAreaAvailabilityNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s ErrorResponseErrorDetails) MarshalJSON() ([]byte, error) {
+func (s *GetAreaAvailabilityNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ErrorResponseErrorDetails) UnmarshalJSON(data []byte) error {
+func (s *GetAreaAvailabilityNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
@@ -8573,114 +10585,216 @@ func (s *GetAreaBlockingOKApplicationJSON) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s GetAreaBlockingOKApplicationJSON) MarshalJSON() ([]byte, error) {
+func (s GetAreaBlockingOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetAreaBlockingOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetBuildingAvailabilityBadRequest as json.
+func (s *GetBuildingAvailabilityBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetBuildingAvailabilityBadRequest from json.
+func (s *GetBuildingAvailabilityBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetBuildingAvailabilityBadRequest to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetBuildingAvailabilityBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetBuildingAvailabilityBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetBuildingAvailabilityBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetBuildingAvailabilityNotFound as json.
+func (s *GetBuildingAvailabilityNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetBuildingAvailabilityNotFound from json.
+func (s *GetBuildingAvailabilityNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetBuildingAvailabilityNotFound to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetBuildingAvailabilityNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetBuildingAvailabilityNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetBuildingAvailabilityNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetBuildingBlockingOKApplicationJSON as json.
+func (s GetBuildingBlockingOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []Blocking(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes GetBuildingBlockingOKApplicationJSON from json.
+func (s *GetBuildingBlockingOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetBuildingBlockingOKApplicationJSON to nil")
+	}
+	var unwrapped []Blocking
+	if err := func() error {
+		unwrapped = make([]Blocking, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem Blocking
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetBuildingBlockingOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s GetBuildingBlockingOKApplicationJSON) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetAreaBlockingOKApplicationJSON) UnmarshalJSON(data []byte) error {
+func (s *GetBuildingBlockingOKApplicationJSON) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetBuildingAvailabilityOKApplicationJSON as json.
-func (s GetBuildingAvailabilityOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []TimeRange(s)
+// Encode encodes GetCurrentUserCalendarTokenNotFound as json.
+func (s *GetCurrentUserCalendarTokenNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
 
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
-	}
-	e.ArrEnd()
+	unwrapped.Encode(e)
 }
 
-// Decode decodes GetBuildingAvailabilityOKApplicationJSON from json.
-func (s *GetBuildingAvailabilityOKApplicationJSON) Decode(d *jx.Decoder) error {
+// Decode decodes GetCurrentUserCalendarTokenNotFound from json.
+func (s *GetCurrentUserCalendarTokenNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetBuildingAvailabilityOKApplicationJSON to nil")
+		return errors.New("invalid: unable to decode GetCurrentUserCalendarTokenNotFound to nil")
 	}
-	var unwrapped []TimeRange
+	var unwrapped ErrorResponse
 	if err := func() error {
-		unwrapped = make([]TimeRange, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem TimeRange
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
+		if err := unwrapped.Decode(d); err != nil {
 			return err
 		}
 		return nil
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetBuildingAvailabilityOKApplicationJSON(unwrapped)
+	*s = GetCurrentUserCalendarTokenNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s GetBuildingAvailabilityOKApplicationJSON) MarshalJSON() ([]byte, error) {
+func (s *GetCurrentUserCalendarTokenNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetBuildingAvailabilityOKApplicationJSON) UnmarshalJSON(data []byte) error {
+func (s *GetCurrentUserCalendarTokenNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetBuildingBlockingOKApplicationJSON as json.
-func (s GetBuildingBlockingOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []Blocking(s)
+// Encode encodes GetCurrentUserCalendarTokenUnauthorized as json.
+func (s *GetCurrentUserCalendarTokenUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
 
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
-	}
-	e.ArrEnd()
+	unwrapped.Encode(e)
 }
 
-// Decode decodes GetBuildingBlockingOKApplicationJSON from json.
-func (s *GetBuildingBlockingOKApplicationJSON) Decode(d *jx.Decoder) error {
+// Decode decodes GetCurrentUserCalendarTokenUnauthorized from json.
+func (s *GetCurrentUserCalendarTokenUnauthorized) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetBuildingBlockingOKApplicationJSON to nil")
+		return errors.New("invalid: unable to decode GetCurrentUserCalendarTokenUnauthorized to nil")
 	}
-	var unwrapped []Blocking
+	var unwrapped ErrorResponse
 	if err := func() error {
-		unwrapped = make([]Blocking, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem Blocking
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
+		if err := unwrapped.Decode(d); err != nil {
 			return err
 		}
 		return nil
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetBuildingBlockingOKApplicationJSON(unwrapped)
+	*s = GetCurrentUserCalendarTokenUnauthorized(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s GetBuildingBlockingOKApplicationJSON) MarshalJSON() ([]byte, error) {
+func (s *GetCurrentUserCalendarTokenUnauthorized) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetBuildingBlockingOKApplicationJSON) UnmarshalJSON(data []byte) error {
+func (s *GetCurrentUserCalendarTokenUnauthorized) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
@@ -9113,6 +11227,82 @@ func (s *GetGroupPermissionsOKApplicationJSON) UnmarshalJSON(data []byte) error
 	return s.Decode(d)
 }
 
+// Encode encodes GetPlaceAvailabilityBadRequest as json.
+func (s *GetPlaceAvailabilityBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetPlaceAvailabilityBadRequest from json.
+func (s *GetPlaceAvailabilityBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetPlaceAvailabilityBadRequest to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetPlaceAvailabilityBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetPlaceAvailabilityBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetPlaceAvailabilityBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetPlaceAvailabilityNotFound as json.
+func (s *GetPlaceAvailabilityNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetPlaceAvailabilityNotFound from json.
+func (s *GetPlaceAvailabilityNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetPlaceAvailabilityNotFound to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetPlaceAvailabilityNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetPlaceAvailabilityNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetPlaceAvailabilityNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode encodes GetPlaceAvailabilityOKApplicationJSON as json.
 func (s GetPlaceAvailabilityOKApplicationJSON) Encode(e *jx.Encoder) {
 	unwrapped := []AvailabilitySlot(s)
@@ -9339,6 +11529,82 @@ func (s *GetPlaceQrCodeNotFound) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode encodes GetPlaceQrKeyForbidden as json.
+func (s *GetPlaceQrKeyForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetPlaceQrKeyForbidden from json.
+func (s *GetPlaceQrKeyForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetPlaceQrKeyForbidden to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetPlaceQrKeyForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetPlaceQrKeyForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetPlaceQrKeyForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetPlaceQrKeyNotFound as json.
+func (s *GetPlaceQrKeyNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetPlaceQrKeyNotFound from json.
+func (s *GetPlaceQrKeyNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetPlaceQrKeyNotFound to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetPlaceQrKeyNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetPlaceQrKeyNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetPlaceQrKeyNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode encodes GetPlaceWhitelistForbidden as json.
 func (s *GetPlaceWhitelistForbidden) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
@@ -10406,37 +12672,187 @@ func (s *GroupWithMembers) Decode(d *jx.Decoder) error {
 			}
 		}
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GroupWithMembers) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GroupWithMembers) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ListApiKeysOKApplicationJSON as json.
+func (s ListApiKeysOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []APIKey(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes ListApiKeysOKApplicationJSON from json.
+func (s *ListApiKeysOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListApiKeysOKApplicationJSON to nil")
+	}
+	var unwrapped []APIKey
+	if err := func() error {
+		unwrapped = make([]APIKey, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem APIKey
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ListApiKeysOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ListApiKeysOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListApiKeysOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ListAreaPlacesOKApplicationJSON as json.
+func (s ListAreaPlacesOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []Place(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes ListAreaPlacesOKApplicationJSON from json.
+func (s *ListAreaPlacesOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListAreaPlacesOKApplicationJSON to nil")
+	}
+	var unwrapped []Place
+	if err := func() error {
+		unwrapped = make([]Place, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem Place
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ListAreaPlacesOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ListAreaPlacesOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListAreaPlacesOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ListBuildingAreasOKApplicationJSON as json.
+func (s ListBuildingAreasOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []Area(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
 	}
+	e.ArrEnd()
+}
 
+// Decode decodes ListBuildingAreasOKApplicationJSON from json.
+func (s *ListBuildingAreasOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListBuildingAreasOKApplicationJSON to nil")
+	}
+	var unwrapped []Area
+	if err := func() error {
+		unwrapped = make([]Area, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem Area
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ListBuildingAreasOKApplicationJSON(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GroupWithMembers) MarshalJSON() ([]byte, error) {
+func (s ListBuildingAreasOKApplicationJSON) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GroupWithMembers) UnmarshalJSON(data []byte) error {
+func (s *ListBuildingAreasOKApplicationJSON) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes HandleOAuthCallbackBadRequest as json.
-func (s *HandleOAuthCallbackBadRequest) Encode(e *jx.Encoder) {
+// Encode encodes ListGroupConstraintProfilesForbidden as json.
+func (s *ListGroupConstraintProfilesForbidden) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes HandleOAuthCallbackBadRequest from json.
-func (s *HandleOAuthCallbackBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes ListGroupConstraintProfilesForbidden from json.
+func (s *ListGroupConstraintProfilesForbidden) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode HandleOAuthCallbackBadRequest to nil")
+		return errors.New("invalid: unable to decode ListGroupConstraintProfilesForbidden to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -10447,34 +12863,34 @@ func (s *HandleOAuthCallbackBadRequest) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = HandleOAuthCallbackBadRequest(unwrapped)
+	*s = ListGroupConstraintProfilesForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *HandleOAuthCallbackBadRequest) MarshalJSON() ([]byte, error) {
+func (s *ListGroupConstraintProfilesForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *HandleOAuthCallbackBadRequest) UnmarshalJSON(data []byte) error {
+func (s *ListGroupConstraintProfilesForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes HandleOAuthCallbackUnauthorized as json.
-func (s *HandleOAuthCallbackUnauthorized) Encode(e *jx.Encoder) {
+// Encode encodes ListGroupConstraintProfilesNotFound as json.
+func (s *ListGroupConstraintProfilesNotFound) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes HandleOAuthCallbackUnauthorized from json.
-func (s *HandleOAuthCallbackUnauthorized) Decode(d *jx.Decoder) error {
+// Decode decodes ListGroupConstraintProfilesNotFound from json.
+func (s *ListGroupConstraintProfilesNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode HandleOAuthCallbackUnauthorized to nil")
+		return errors.New("invalid: unable to decode ListGroupConstraintProfilesNotFound to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -10485,26 +12901,26 @@ func (s *HandleOAuthCallbackUnauthorized) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = HandleOAuthCallbackUnauthorized(unwrapped)
+	*s = ListGroupConstraintProfilesNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *HandleOAuthCallbackUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *ListGroupConstraintProfilesNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *HandleOAuthCallbackUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *ListGroupConstraintProfilesNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ListApiKeysOKApplicationJSON as json.
-func (s ListApiKeysOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []APIKey(s)
+// Encode encodes ListGroupConstraintProfilesOKApplicationJSON as json.
+func (s ListGroupConstraintProfilesOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []ConstraintProfile(s)
 
 	e.ArrStart()
 	for _, elem := range unwrapped {
@@ -10513,16 +12929,16 @@ func (s ListApiKeysOKApplicationJSON) Encode(e *jx.Encoder) {
 	e.ArrEnd()
 }
 
-// Decode decodes ListApiKeysOKApplicationJSON from json.
-func (s *ListApiKeysOKApplicationJSON) Decode(d *jx.Decoder) error {
+// Decode decodes ListGroupConstraintProfilesOKApplicationJSON from json.
+func (s *ListGroupConstraintProfilesOKApplicationJSON) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ListApiKeysOKApplicationJSON to nil")
+		return errors.New("invalid: unable to decode ListGroupConstraintProfilesOKApplicationJSON to nil")
 	}
-	var unwrapped []APIKey
+	var unwrapped []ConstraintProfile
 	if err := func() error {
-		unwrapped = make([]APIKey, 0)
+		unwrapped = make([]ConstraintProfile, 0)
 		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem APIKey
+			var elem ConstraintProfile
 			if err := elem.Decode(d); err != nil {
 				return err
 			}
@@ -10535,26 +12951,26 @@ func (s *ListApiKeysOKApplicationJSON) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = ListApiKeysOKApplicationJSON(unwrapped)
+	*s = ListGroupConstraintProfilesOKApplicationJSON(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s ListApiKeysOKApplicationJSON) MarshalJSON() ([]byte, error) {
+func (s ListGroupConstraintProfilesOKApplicationJSON) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ListApiKeysOKApplicationJSON) UnmarshalJSON(data []byte) error {
+func (s *ListGroupConstraintProfilesOKApplicationJSON) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ListAreaPlacesOKApplicationJSON as json.
-func (s ListAreaPlacesOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []Place(s)
+// Encode encodes ListGroupsOKApplicationJSON as json.
+func (s ListGroupsOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []Group(s)
 
 	e.ArrStart()
 	for _, elem := range unwrapped {
@@ -10563,16 +12979,16 @@ func (s ListAreaPlacesOKApplicationJSON) Encode(e *jx.Encoder) {
 	e.ArrEnd()
 }
 
-// Decode decodes ListAreaPlacesOKApplicationJSON from json.
-func (s *ListAreaPlacesOKApplicationJSON) Decode(d *jx.Decoder) error {
+// Decode decodes ListGroupsOKApplicationJSON from json.
+func (s *ListGroupsOKApplicationJSON) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ListAreaPlacesOKApplicationJSON to nil")
+		return errors.New("invalid: unable to decode ListGroupsOKApplicationJSON to nil")
 	}
-	var unwrapped []Place
+	var unwrapped []Group
 	if err := func() error {
-		unwrapped = make([]Place, 0)
+		unwrapped = make([]Group, 0)
 		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem Place
+			var elem Group
 			if err := elem.Decode(d); err != nil {
 				return err
 			}
@@ -10585,26 +13001,26 @@ func (s *ListAreaPlacesOKApplicationJSON) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = ListAreaPlacesOKApplicationJSON(unwrapped)
+	*s = ListGroupsOKApplicationJSON(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s ListAreaPlacesOKApplicationJSON) MarshalJSON() ([]byte, error) {
+func (s ListGroupsOKApplicationJSON) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ListAreaPlacesOKApplicationJSON) UnmarshalJSON(data []byte) error {
+func (s *ListGroupsOKApplicationJSON) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ListBuildingAreasOKApplicationJSON as json.
-func (s ListBuildingAreasOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []Area(s)
+// Encode encodes ListPermissionsOKApplicationJSON as json.
+func (s ListPermissionsOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []Permission(s)
 
 	e.ArrStart()
 	for _, elem := range unwrapped {
@@ -10613,16 +13029,16 @@ func (s ListBuildingAreasOKApplicationJSON) Encode(e *jx.Encoder) {
 	e.ArrEnd()
 }
 
-// Decode decodes ListBuildingAreasOKApplicationJSON from json.
-func (s *ListBuildingAreasOKApplicationJSON) Decode(d *jx.Decoder) error {
+// Decode decodes ListPermissionsOKApplicationJSON from json.
+func (s *ListPermissionsOKApplicationJSON) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ListBuildingAreasOKApplicationJSON to nil")
+		return errors.New("invalid: unable to decode ListPermissionsOKApplicationJSON to nil")
 	}
-	var unwrapped []Area
+	var unwrapped []Permission
 	if err := func() error {
-		unwrapped = make([]Area, 0)
+		unwrapped = make([]Permission, 0)
 		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem Area
+			var elem Permission
 			if err := elem.Decode(d); err != nil {
 				return err
 			}
@@ -10635,26 +13051,26 @@ func (s *ListBuildingAreasOKApplicationJSON) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = ListBuildingAreasOKApplicationJSON(unwrapped)
+	*s = ListPermissionsOKApplicationJSON(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s ListBuildingAreasOKApplicationJSON) MarshalJSON() ([]byte, error) {
+func (s ListPermissionsOKApplicationJSON) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ListBuildingAreasOKApplicationJSON) UnmarshalJSON(data []byte) error {
+func (s *ListPermissionsOKApplicationJSON) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ListGroupsOKApplicationJSON as json.
-func (s ListGroupsOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []Group(s)
+// Encode encodes ListQrTemplatesOKApplicationJSON as json.
+func (s ListQrTemplatesOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []QRTemplate(s)
 
 	e.ArrStart()
 	for _, elem := range unwrapped {
@@ -10663,273 +13079,539 @@ func (s ListGroupsOKApplicationJSON) Encode(e *jx.Encoder) {
 	e.ArrEnd()
 }
 
-// Decode decodes ListGroupsOKApplicationJSON from json.
-func (s *ListGroupsOKApplicationJSON) Decode(d *jx.Decoder) error {
+// Decode decodes ListQrTemplatesOKApplicationJSON from json.
+func (s *ListQrTemplatesOKApplicationJSON) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ListGroupsOKApplicationJSON to nil")
+		return errors.New("invalid: unable to decode ListQrTemplatesOKApplicationJSON to nil")
 	}
-	var unwrapped []Group
+	var unwrapped []QRTemplate
 	if err := func() error {
-		unwrapped = make([]Group, 0)
+		unwrapped = make([]QRTemplate, 0)
 		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem Group
+			var elem QRTemplate
 			if err := elem.Decode(d); err != nil {
 				return err
 			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ListQrTemplatesOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ListQrTemplatesOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListQrTemplatesOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NotificationPreferences) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NotificationPreferences) encodeFields(e *jx.Encoder) {
+	{
+		if s.ReservationConfirmed.Set {
+			e.FieldStart("reservationConfirmed")
+			s.ReservationConfirmed.Encode(e)
+		}
+	}
+	{
+		if s.ReservationCancelled.Set {
+			e.FieldStart("reservationCancelled")
+			s.ReservationCancelled.Encode(e)
+		}
+	}
+	{
+		if s.ReservationReminder.Set {
+			e.FieldStart("reservationReminder")
+			s.ReservationReminder.Encode(e)
+		}
+	}
+	{
+		if s.ReminderMinutesBefore.Set {
+			e.FieldStart("reminderMinutesBefore")
+			s.ReminderMinutesBefore.Encode(e)
+		}
+	}
+	{
+		if s.CheckInWarning.Set {
+			e.FieldStart("checkInWarning")
+			s.CheckInWarning.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfNotificationPreferences = [5]string{
+	0: "reservationConfirmed",
+	1: "reservationCancelled",
+	2: "reservationReminder",
+	3: "reminderMinutesBefore",
+	4: "checkInWarning",
+}
+
+// Decode decodes NotificationPreferences from json.
+func (s *NotificationPreferences) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NotificationPreferences to nil")
+	}
+	s.setDefaults()
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "reservationConfirmed":
+			if err := func() error {
+				s.ReservationConfirmed.Reset()
+				if err := s.ReservationConfirmed.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"reservationConfirmed\"")
+			}
+		case "reservationCancelled":
+			if err := func() error {
+				s.ReservationCancelled.Reset()
+				if err := s.ReservationCancelled.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"reservationCancelled\"")
+			}
+		case "reservationReminder":
+			if err := func() error {
+				s.ReservationReminder.Reset()
+				if err := s.ReservationReminder.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"reservationReminder\"")
+			}
+		case "reminderMinutesBefore":
+			if err := func() error {
+				s.ReminderMinutesBefore.Reset()
+				if err := s.ReminderMinutesBefore.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"reminderMinutesBefore\"")
+			}
+		case "checkInWarning":
+			if err := func() error {
+				s.CheckInWarning.Reset()
+				if err := s.CheckInWarning.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"checkInWarning\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode NotificationPreferences")
 	}
-	*s = ListGroupsOKApplicationJSON(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s ListGroupsOKApplicationJSON) MarshalJSON() ([]byte, error) {
+func (s *NotificationPreferences) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ListGroupsOKApplicationJSON) UnmarshalJSON(data []byte) error {
+func (s *NotificationPreferences) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ListPermissionsOKApplicationJSON as json.
-func (s ListPermissionsOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []Permission(s)
+// Encode implements json.Marshaler.
+func (s *OccurrenceRejection) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
+// encodeFields encodes fields.
+func (s *OccurrenceRejection) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("code")
+		e.Str(s.Code)
+	}
+	{
+		e.FieldStart("message")
+		e.Str(s.Message)
 	}
-	e.ArrEnd()
 }
 
-// Decode decodes ListPermissionsOKApplicationJSON from json.
-func (s *ListPermissionsOKApplicationJSON) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfOccurrenceRejection = [2]string{
+	0: "code",
+	1: "message",
+}
+
+// Decode decodes OccurrenceRejection from json.
+func (s *OccurrenceRejection) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ListPermissionsOKApplicationJSON to nil")
+		return errors.New("invalid: unable to decode OccurrenceRejection to nil")
 	}
-	var unwrapped []Permission
-	if err := func() error {
-		unwrapped = make([]Permission, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem Permission
-			if err := elem.Decode(d); err != nil {
-				return err
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "code":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Code = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"code\"")
 			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
+		case "message":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Message = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode OccurrenceRejection")
 	}
-	*s = ListPermissionsOKApplicationJSON(unwrapped)
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfOccurrenceRejection) {
+					name = jsonFieldsNameOfOccurrenceRejection[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s ListPermissionsOKApplicationJSON) MarshalJSON() ([]byte, error) {
+func (s *OccurrenceRejection) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ListPermissionsOKApplicationJSON) UnmarshalJSON(data []byte) error {
+func (s *OccurrenceRejection) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ListQrTemplatesOKApplicationJSON as json.
-func (s ListQrTemplatesOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []QRTemplate(s)
+// Encode implements json.Marshaler.
+func (s *OpeningHoursException) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
+// encodeFields encodes fields.
+func (s *OpeningHoursException) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("date")
+		json.EncodeDate(e, s.Date)
+	}
+	{
+		e.FieldStart("hours")
+		e.ArrStart()
+		for _, elem := range s.Hours {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		if s.Reason.Set {
+			e.FieldStart("reason")
+			s.Reason.Encode(e)
+		}
 	}
-	e.ArrEnd()
 }
 
-// Decode decodes ListQrTemplatesOKApplicationJSON from json.
-func (s *ListQrTemplatesOKApplicationJSON) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfOpeningHoursException = [3]string{
+	0: "date",
+	1: "hours",
+	2: "reason",
+}
+
+// Decode decodes OpeningHoursException from json.
+func (s *OpeningHoursException) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ListQrTemplatesOKApplicationJSON to nil")
+		return errors.New("invalid: unable to decode OpeningHoursException to nil")
 	}
-	var unwrapped []QRTemplate
-	if err := func() error {
-		unwrapped = make([]QRTemplate, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem QRTemplate
-			if err := elem.Decode(d); err != nil {
-				return err
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "date":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeDate(d)
+				s.Date = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"date\"")
 			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
+		case "hours":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				s.Hours = make([]OpeningHoursRange, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem OpeningHoursRange
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Hours = append(s.Hours, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"hours\"")
+			}
+		case "reason":
+			if err := func() error {
+				s.Reason.Reset()
+				if err := s.Reason.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"reason\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode OpeningHoursException")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfOpeningHoursException) {
+					name = jsonFieldsNameOfOpeningHoursException[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
-	*s = ListQrTemplatesOKApplicationJSON(unwrapped)
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s ListQrTemplatesOKApplicationJSON) MarshalJSON() ([]byte, error) {
+func (s *OpeningHoursException) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ListQrTemplatesOKApplicationJSON) UnmarshalJSON(data []byte) error {
+func (s *OpeningHoursException) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *NotificationPreferences) Encode(e *jx.Encoder) {
+func (s *OpeningHoursRange) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *NotificationPreferences) encodeFields(e *jx.Encoder) {
-	{
-		if s.ReservationConfirmed.Set {
-			e.FieldStart("reservationConfirmed")
-			s.ReservationConfirmed.Encode(e)
-		}
-	}
+func (s *OpeningHoursRange) encodeFields(e *jx.Encoder) {
 	{
-		if s.ReservationCancelled.Set {
-			e.FieldStart("reservationCancelled")
-			s.ReservationCancelled.Encode(e)
-		}
-	}
-	{
-		if s.ReservationReminder.Set {
-			e.FieldStart("reservationReminder")
-			s.ReservationReminder.Encode(e)
-		}
-	}
-	{
-		if s.ReminderMinutesBefore.Set {
-			e.FieldStart("reminderMinutesBefore")
-			s.ReminderMinutesBefore.Encode(e)
-		}
+		e.FieldStart("startTime")
+		e.Str(s.StartTime)
 	}
 	{
-		if s.CheckInWarning.Set {
-			e.FieldStart("checkInWarning")
-			s.CheckInWarning.Encode(e)
-		}
+		e.FieldStart("endTime")
+		e.Str(s.EndTime)
 	}
 }
 
-var jsonFieldsNameOfNotificationPreferences = [5]string{
-	0: "reservationConfirmed",
-	1: "reservationCancelled",
-	2: "reservationReminder",
-	3: "reminderMinutesBefore",
-	4: "checkInWarning",
+var jsonFieldsNameOfOpeningHoursRange = [2]string{
+	0: "startTime",
+	1: "endTime",
 }
 
-// Decode decodes NotificationPreferences from json.
-func (s *NotificationPreferences) Decode(d *jx.Decoder) error {
+// Decode decodes OpeningHoursRange from json.
+func (s *OpeningHoursRange) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NotificationPreferences to nil")
+		return errors.New("invalid: unable to decode OpeningHoursRange to nil")
 	}
-	s.setDefaults()
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "reservationConfirmed":
-			if err := func() error {
-				s.ReservationConfirmed.Reset()
-				if err := s.ReservationConfirmed.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"reservationConfirmed\"")
-			}
-		case "reservationCancelled":
-			if err := func() error {
-				s.ReservationCancelled.Reset()
-				if err := s.ReservationCancelled.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"reservationCancelled\"")
-			}
-		case "reservationReminder":
-			if err := func() error {
-				s.ReservationReminder.Reset()
-				if err := s.ReservationReminder.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"reservationReminder\"")
-			}
-		case "reminderMinutesBefore":
+		case "startTime":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.ReminderMinutesBefore.Reset()
-				if err := s.ReminderMinutesBefore.Decode(d); err != nil {
+				v, err := d.Str()
+				s.StartTime = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"reminderMinutesBefore\"")
+				return errors.Wrap(err, "decode field \"startTime\"")
 			}
-		case "checkInWarning":
+		case "endTime":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.CheckInWarning.Reset()
-				if err := s.CheckInWarning.Decode(d); err != nil {
+				v, err := d.Str()
+				s.EndTime = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"checkInWarning\"")
+				return errors.Wrap(err, "decode field \"endTime\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode NotificationPreferences")
+		return errors.Wrap(err, "decode OpeningHoursRange")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfOpeningHoursRange) {
+					name = jsonFieldsNameOfOpeningHoursRange[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NotificationPreferences) MarshalJSON() ([]byte, error) {
+func (s *OpeningHoursRange) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NotificationPreferences) UnmarshalJSON(data []byte) error {
+func (s *OpeningHoursRange) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
@@ -11036,6 +13718,39 @@ func (s *OptCheckInReservationReq) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode encodes ConstraintProfileScope as json.
+func (o OptConstraintProfileScope) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes ConstraintProfileScope from json.
+func (o *OptConstraintProfileScope) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptConstraintProfileScope to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptConstraintProfileScope) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptConstraintProfileScope) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode encodes CreatePlaceRequestBookingMethod as json.
 func (o OptCreatePlaceRequestBookingMethod) Encode(e *jx.Encoder) {
 	if !o.Set {
@@ -11102,18 +13817,18 @@ func (s *OptCreateReservationRequestRecurrence) UnmarshalJSON(data []byte) error
 	return s.Decode(d)
 }
 
-// Encode encodes CreateReservationRequestRecurrencePattern as json.
-func (o OptCreateReservationRequestRecurrencePattern) Encode(e *jx.Encoder) {
+// Encode encodes CreateReservationRequestRecurrenceMode as json.
+func (o OptCreateReservationRequestRecurrenceMode) Encode(e *jx.Encoder) {
 	if !o.Set {
 		return
 	}
 	e.Str(string(o.Value))
 }
 
-// Decode decodes CreateReservationRequestRecurrencePattern from json.
-func (o *OptCreateReservationRequestRecurrencePattern) Decode(d *jx.Decoder) error {
+// Decode decodes CreateReservationRequestRecurrenceMode from json.
+func (o *OptCreateReservationRequestRecurrenceMode) Decode(d *jx.Decoder) error {
 	if o == nil {
-		return errors.New("invalid: unable to decode OptCreateReservationRequestRecurrencePattern to nil")
+		return errors.New("invalid: unable to decode OptCreateReservationRequestRecurrenceMode to nil")
 	}
 	o.Set = true
 	if err := o.Value.Decode(d); err != nil {
@@ -11123,14 +13838,14 @@ func (o *OptCreateReservationRequestRecurrencePattern) Decode(d *jx.Decoder) err
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptCreateReservationRequestRecurrencePattern) MarshalJSON() ([]byte, error) {
+func (s OptCreateReservationRequestRecurrenceMode) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptCreateReservationRequestRecurrencePattern) UnmarshalJSON(data []byte) error {
+func (s *OptCreateReservationRequestRecurrenceMode) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
@@ -11358,6 +14073,57 @@ func (s *OptNilAvailabilitySlotBlockingSource) UnmarshalJSON(data []byte) error
 	return s.Decode(d)
 }
 
+// Encode encodes bool as json.
+func (o OptNilBool) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.Bool(bool(o.Value))
+}
+
+// Decode decodes bool from json.
+func (o *OptNilBool) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilBool to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v bool
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	v, err := d.Bool()
+	if err != nil {
+		return err
+	}
+	o.Value = bool(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilBool) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilBool) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode encodes time.Time as json.
 func (o OptNilDate) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
 	if !o.Set {
@@ -11475,14 +14241,65 @@ func (o OptNilInt) Encode(e *jx.Encoder) {
 // Decode decodes int from json.
 func (o *OptNilInt) Decode(d *jx.Decoder) error {
 	if o == nil {
-		return errors.New("invalid: unable to decode OptNilInt to nil")
+		return errors.New("invalid: unable to decode OptNilInt to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v int
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	v, err := d.Int()
+	if err != nil {
+		return err
+	}
+	o.Value = int(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilInt) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilInt) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes PlaceAvailabilityBlockedSource as json.
+func (o OptNilPlaceAvailabilityBlockedSource) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes PlaceAvailabilityBlockedSource from json.
+func (o *OptNilPlaceAvailabilityBlockedSource) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilPlaceAvailabilityBlockedSource to nil")
 	}
 	if d.Next() == jx.Null {
 		if err := d.Null(); err != nil {
 			return err
 		}
 
-		var v int
+		var v PlaceAvailabilityBlockedSource
 		o.Value = v
 		o.Set = true
 		o.Null = true
@@ -11490,29 +14307,27 @@ func (o *OptNilInt) Decode(d *jx.Decoder) error {
 	}
 	o.Set = true
 	o.Null = false
-	v, err := d.Int()
-	if err != nil {
+	if err := o.Value.Decode(d); err != nil {
 		return err
 	}
-	o.Value = int(v)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptNilInt) MarshalJSON() ([]byte, error) {
+func (s OptNilPlaceAvailabilityBlockedSource) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilInt) UnmarshalJSON(data []byte) error {
+func (s *OptNilPlaceAvailabilityBlockedSource) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes PlaceAvailabilityBlockedSource as json.
-func (o OptNilPlaceAvailabilityBlockedSource) Encode(e *jx.Encoder) {
+// Encode encodes PlaceConstraintsQuotaWindow as json.
+func (o OptNilPlaceConstraintsQuotaWindow) Encode(e *jx.Encoder) {
 	if !o.Set {
 		return
 	}
@@ -11523,17 +14338,17 @@ func (o OptNilPlaceAvailabilityBlockedSource) Encode(e *jx.Encoder) {
 	e.Str(string(o.Value))
 }
 
-// Decode decodes PlaceAvailabilityBlockedSource from json.
-func (o *OptNilPlaceAvailabilityBlockedSource) Decode(d *jx.Decoder) error {
+// Decode decodes PlaceConstraintsQuotaWindow from json.
+func (o *OptNilPlaceConstraintsQuotaWindow) Decode(d *jx.Decoder) error {
 	if o == nil {
-		return errors.New("invalid: unable to decode OptNilPlaceAvailabilityBlockedSource to nil")
+		return errors.New("invalid: unable to decode OptNilPlaceConstraintsQuotaWindow to nil")
 	}
 	if d.Next() == jx.Null {
 		if err := d.Null(); err != nil {
 			return err
 		}
 
-		var v PlaceAvailabilityBlockedSource
+		var v PlaceConstraintsQuotaWindow
 		o.Value = v
 		o.Set = true
 		o.Null = true
@@ -11548,14 +14363,14 @@ func (o *OptNilPlaceAvailabilityBlockedSource) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptNilPlaceAvailabilityBlockedSource) MarshalJSON() ([]byte, error) {
+func (s OptNilPlaceConstraintsQuotaWindow) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilPlaceAvailabilityBlockedSource) UnmarshalJSON(data []byte) error {
+func (s *OptNilPlaceConstraintsQuotaWindow) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
@@ -11713,6 +14528,72 @@ func (s *OptNilUUID) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode encodes OccurrenceRejection as json.
+func (o OptOccurrenceRejection) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes OccurrenceRejection from json.
+func (o *OptOccurrenceRejection) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptOccurrenceRejection to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptOccurrenceRejection) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptOccurrenceRejection) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes PermissionScope as json.
+func (o OptPermissionScope) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes PermissionScope from json.
+func (o *OptPermissionScope) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptPermissionScope to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptPermissionScope) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptPermissionScope) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode encodes PlaceConstraints as json.
 func (o OptPlaceConstraints) Encode(e *jx.Encoder) {
 	if !o.Set {
@@ -11746,6 +14627,39 @@ func (s *OptPlaceConstraints) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode encodes Reservation as json.
+func (o OptReservation) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes Reservation from json.
+func (o *OptReservation) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptReservation to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptReservation) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptReservation) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode encodes RoomPlan as json.
 func (o OptRoomPlan) Encode(e *jx.Encoder) {
 	if !o.Set {
@@ -11882,6 +14796,41 @@ func (s *OptURI) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode encodes uuid.UUID as json.
+func (o OptUUID) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	json.EncodeUUID(e, o.Value)
+}
+
+// Decode decodes uuid.UUID from json.
+func (o *OptUUID) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptUUID to nil")
+	}
+	o.Set = true
+	v, err := json.DecodeUUID(d)
+	if err != nil {
+		return err
+	}
+	o.Value = v
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptUUID) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptUUID) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode encodes UpdatePlaceRequestBookingMethod as json.
 func (o OptUpdatePlaceRequestBookingMethod) Encode(e *jx.Encoder) {
 	if !o.Set {
@@ -12706,43 +15655,173 @@ func (s *PaginationMeta) Decode(d *jx.Decoder) error {
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"limit\"")
+				return errors.Wrap(err, "decode field \"limit\"")
+			}
+		case "total":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.Total = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"total\"")
+			}
+		case "totalPages":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int()
+				s.TotalPages = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"totalPages\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PaginationMeta")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPaginationMeta) {
+					name = jsonFieldsNameOfPaginationMeta[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *PaginationMeta) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PaginationMeta) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *Permission) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Permission) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("description")
+		e.Str(s.Description)
+	}
+	{
+		if s.Scope.Set {
+			e.FieldStart("scope")
+			s.Scope.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfPermission = [3]string{
+	0: "name",
+	1: "description",
+	2: "scope",
+}
+
+// Decode decodes Permission from json.
+func (s *Permission) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Permission to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "total":
-			requiredBitSet[0] |= 1 << 2
+		case "description":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Int()
-				s.Total = int(v)
+				v, err := d.Str()
+				s.Description = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"total\"")
+				return errors.Wrap(err, "decode field \"description\"")
 			}
-		case "totalPages":
-			requiredBitSet[0] |= 1 << 3
+		case "scope":
 			if err := func() error {
-				v, err := d.Int()
-				s.TotalPages = int(v)
-				if err != nil {
+				s.Scope.Reset()
+				if err := s.Scope.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"totalPages\"")
+				return errors.Wrap(err, "decode field \"scope\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PaginationMeta")
+		return errors.Wrap(err, "decode Permission")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00001111,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -12754,8 +15833,8 @@ func (s *PaginationMeta) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfPaginationMeta) {
-					name = jsonFieldsNameOfPaginationMeta[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfPermission) {
+					name = jsonFieldsNameOfPermission[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -12776,81 +15855,79 @@ func (s *PaginationMeta) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PaginationMeta) MarshalJSON() ([]byte, error) {
+func (s *Permission) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PaginationMeta) UnmarshalJSON(data []byte) error {
+func (s *Permission) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *Permission) Encode(e *jx.Encoder) {
+func (s *PermissionScope) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Permission) encodeFields(e *jx.Encoder) {
+func (s *PermissionScope) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("name")
-		e.Str(s.Name)
+		e.FieldStart("type")
+		s.Type.Encode(e)
 	}
 	{
-		e.FieldStart("description")
-		e.Str(s.Description)
+		e.FieldStart("id")
+		json.EncodeUUID(e, s.ID)
 	}
 }
 
-var jsonFieldsNameOfPermission = [2]string{
-	0: "name",
-	1: "description",
+var jsonFieldsNameOfPermissionScope = [2]string{
+	0: "type",
+	1: "id",
 }
 
-// Decode decodes Permission from json.
-func (s *Permission) Decode(d *jx.Decoder) error {
+// Decode decodes PermissionScope from json.
+func (s *PermissionScope) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Permission to nil")
+		return errors.New("invalid: unable to decode PermissionScope to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "name":
+		case "type":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
+				if err := s.Type.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"type\"")
 			}
-		case "description":
+		case "id":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Str()
-				s.Description = string(v)
+				v, err := json.DecodeUUID(d)
+				s.ID = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"description\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Permission")
+		return errors.Wrap(err, "decode PermissionScope")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -12867,8 +15944,8 @@ func (s *Permission) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfPermission) {
-					name = jsonFieldsNameOfPermission[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfPermissionScope) {
+					name = jsonFieldsNameOfPermissionScope[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -12889,14 +15966,56 @@ func (s *Permission) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Permission) MarshalJSON() ([]byte, error) {
+func (s *PermissionScope) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Permission) UnmarshalJSON(data []byte) error {
+func (s *PermissionScope) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes PermissionScopeType as json.
+func (s PermissionScopeType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes PermissionScopeType from json.
+func (s *PermissionScopeType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PermissionScopeType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch PermissionScopeType(v) {
+	case PermissionScopeTypeBuilding:
+		*s = PermissionScopeTypeBuilding
+	case PermissionScopeTypeArea:
+		*s = PermissionScopeTypeArea
+	case PermissionScopeTypePlace:
+		*s = PermissionScopeTypePlace
+	default:
+		*s = PermissionScopeType(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s PermissionScopeType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PermissionScopeType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
@@ -13651,9 +16770,15 @@ func (s *PlaceConstraints) encodeFields(e *jx.Encoder) {
 			s.WhitelistEnabled.Encode(e)
 		}
 	}
+	{
+		if s.QuotaWindow.Set {
+			e.FieldStart("quotaWindow")
+			s.QuotaWindow.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfPlaceConstraints = [15]string{
+var jsonFieldsNameOfPlaceConstraints = [16]string{
 	0:  "maxReservationDuration",
 	1:  "minReservationDuration",
 	2:  "maxReservationsPerDay",
@@ -13669,6 +16794,7 @@ var jsonFieldsNameOfPlaceConstraints = [15]string{
 	12: "checkInTimeoutMinutes",
 	13: "decayTimeoutMinutes",
 	14: "whitelistEnabled",
+	15: "quotaWindow",
 }
 
 // Decode decodes PlaceConstraints from json.
@@ -13676,7 +16802,6 @@ func (s *PlaceConstraints) Decode(d *jx.Decoder) error {
 	if s == nil {
 		return errors.New("invalid: unable to decode PlaceConstraints to nil")
 	}
-	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
@@ -13830,6 +16955,16 @@ func (s *PlaceConstraints) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"whitelistEnabled\"")
 			}
+		case "quotaWindow":
+			if err := func() error {
+				s.QuotaWindow.Reset()
+				if err := s.QuotaWindow.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"quotaWindow\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -13854,6 +16989,46 @@ func (s *PlaceConstraints) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode encodes PlaceConstraintsQuotaWindow as json.
+func (s PlaceConstraintsQuotaWindow) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes PlaceConstraintsQuotaWindow from json.
+func (s *PlaceConstraintsQuotaWindow) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PlaceConstraintsQuotaWindow to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch PlaceConstraintsQuotaWindow(v) {
+	case PlaceConstraintsQuotaWindowCalendar:
+		*s = PlaceConstraintsQuotaWindowCalendar
+	case PlaceConstraintsQuotaWindowRolling:
+		*s = PlaceConstraintsQuotaWindowRolling
+	default:
+		*s = PlaceConstraintsQuotaWindow(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s PlaceConstraintsQuotaWindow) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PlaceConstraintsQuotaWindow) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode implements json.Marshaler.
 func (s *PlaceMarker) Encode(e *jx.Encoder) {
 	e.ObjStart()
@@ -14038,54 +17213,167 @@ func (s *PlaceMarker) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PlaceMarker) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PlaceMarker) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes PlaceMarkerShape as json.
-func (s PlaceMarkerShape) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes PlaceMarkerShape from json.
-func (s *PlaceMarkerShape) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode PlaceMarkerShape to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
-	}
-	// Try to use constant string.
-	switch PlaceMarkerShape(v) {
-	case PlaceMarkerShapeRectangle:
-		*s = PlaceMarkerShapeRectangle
-	case PlaceMarkerShapeCircle:
-		*s = PlaceMarkerShapeCircle
-	default:
-		*s = PlaceMarkerShape(v)
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s PlaceMarkerShape) MarshalJSON() ([]byte, error) {
+func (s *PlaceMarker) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PlaceMarker) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes PlaceMarkerShape as json.
+func (s PlaceMarkerShape) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes PlaceMarkerShape from json.
+func (s *PlaceMarkerShape) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PlaceMarkerShape to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch PlaceMarkerShape(v) {
+	case PlaceMarkerShapeRectangle:
+		*s = PlaceMarkerShapeRectangle
+	case PlaceMarkerShapeCircle:
+		*s = PlaceMarkerShapeCircle
+	default:
+		*s = PlaceMarkerShape(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s PlaceMarkerShape) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PlaceMarkerShape) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *PlaceQrKey) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PlaceQrKey) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("keyVersion")
+		e.Int(s.KeyVersion)
+	}
+	{
+		e.FieldStart("checkInUrl")
+		json.EncodeURI(e, s.CheckInUrl)
+	}
+}
+
+var jsonFieldsNameOfPlaceQrKey = [2]string{
+	0: "keyVersion",
+	1: "checkInUrl",
+}
+
+// Decode decodes PlaceQrKey from json.
+func (s *PlaceQrKey) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PlaceQrKey to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "keyVersion":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.KeyVersion = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"keyVersion\"")
+			}
+		case "checkInUrl":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeURI(d)
+				s.CheckInUrl = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"checkInUrl\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PlaceQrKey")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPlaceQrKey) {
+					name = jsonFieldsNameOfPlaceQrKey[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *PlaceQrKey) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PlaceMarkerShape) UnmarshalJSON(data []byte) error {
+func (s *PlaceQrKey) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
@@ -15179,10 +18467,17 @@ func (s *RemoveGroupPermissionsReq) encodeFields(e *jx.Encoder) {
 		}
 		e.ArrEnd()
 	}
+	{
+		if s.Scope.Set {
+			e.FieldStart("scope")
+			s.Scope.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfRemoveGroupPermissionsReq = [1]string{
+var jsonFieldsNameOfRemoveGroupPermissionsReq = [2]string{
 	0: "permissions",
+	1: "scope",
 }
 
 // Decode decodes RemoveGroupPermissionsReq from json.
@@ -15214,6 +18509,16 @@ func (s *RemoveGroupPermissionsReq) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"permissions\"")
 			}
+		case "scope":
+			if err := func() error {
+				s.Scope.Reset()
+				if err := s.Scope.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"scope\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -17003,56 +20308,381 @@ func (s *Reservation) Decode(d *jx.Decoder) error {
 				if err != nil {
 					return err
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"isRecurring\"")
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"isRecurring\"")
+			}
+		case "recurringGroupId":
+			if err := func() error {
+				s.RecurringGroupId.Reset()
+				if err := s.RecurringGroupId.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"recurringGroupId\"")
+			}
+		case "createdAt":
+			requiredBitSet[1] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"createdAt\"")
+			}
+		case "updatedAt":
+			requiredBitSet[1] |= 1 << 3
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.UpdatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updatedAt\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Reservation")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b00111111,
+		0b00001101,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfReservation) {
+					name = jsonFieldsNameOfReservation[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *Reservation) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Reservation) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ReservationOccurrence) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ReservationOccurrence) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("startTime")
+		json.EncodeDateTime(e, s.StartTime)
+	}
+	{
+		e.FieldStart("endTime")
+		json.EncodeDateTime(e, s.EndTime)
+	}
+	{
+		e.FieldStart("status")
+		s.Status.Encode(e)
+	}
+	{
+		if s.Reservation.Set {
+			e.FieldStart("reservation")
+			s.Reservation.Encode(e)
+		}
+	}
+	{
+		if s.Reason.Set {
+			e.FieldStart("reason")
+			s.Reason.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfReservationOccurrence = [5]string{
+	0: "startTime",
+	1: "endTime",
+	2: "status",
+	3: "reservation",
+	4: "reason",
+}
+
+// Decode decodes ReservationOccurrence from json.
+func (s *ReservationOccurrence) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ReservationOccurrence to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "startTime":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.StartTime = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"startTime\"")
+			}
+		case "endTime":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.EndTime = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"endTime\"")
+			}
+		case "status":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "reservation":
+			if err := func() error {
+				s.Reservation.Reset()
+				if err := s.Reservation.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"reservation\"")
+			}
+		case "reason":
+			if err := func() error {
+				s.Reason.Reset()
+				if err := s.Reason.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"reason\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ReservationOccurrence")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfReservationOccurrence) {
+					name = jsonFieldsNameOfReservationOccurrence[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ReservationOccurrence) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ReservationOccurrence) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ReservationOccurrenceStatus as json.
+func (s ReservationOccurrenceStatus) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes ReservationOccurrenceStatus from json.
+func (s *ReservationOccurrenceStatus) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ReservationOccurrenceStatus to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch ReservationOccurrenceStatus(v) {
+	case ReservationOccurrenceStatusCreated:
+		*s = ReservationOccurrenceStatusCreated
+	case ReservationOccurrenceStatusSkipped:
+		*s = ReservationOccurrenceStatusSkipped
+	default:
+		*s = ReservationOccurrenceStatus(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ReservationOccurrenceStatus) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ReservationOccurrenceStatus) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ReservationSeries) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ReservationSeries) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("recurringGroupId")
+		json.EncodeUUID(e, s.RecurringGroupId)
+	}
+	{
+		e.FieldStart("occurrences")
+		e.ArrStart()
+		for _, elem := range s.Occurrences {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfReservationSeries = [2]string{
+	0: "recurringGroupId",
+	1: "occurrences",
+}
+
+// Decode decodes ReservationSeries from json.
+func (s *ReservationSeries) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ReservationSeries to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
 		case "recurringGroupId":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.RecurringGroupId.Reset()
-				if err := s.RecurringGroupId.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"recurringGroupId\"")
-			}
-		case "createdAt":
-			requiredBitSet[1] |= 1 << 2
-			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.CreatedAt = v
+				v, err := json.DecodeUUID(d)
+				s.RecurringGroupId = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"createdAt\"")
+				return errors.Wrap(err, "decode field \"recurringGroupId\"")
 			}
-		case "updatedAt":
-			requiredBitSet[1] |= 1 << 3
+		case "occurrences":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.UpdatedAt = v
-				if err != nil {
+				s.Occurrences = make([]ReservationOccurrence, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ReservationOccurrence
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Occurrences = append(s.Occurrences, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"updatedAt\"")
+				return errors.Wrap(err, "decode field \"occurrences\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Reservation")
+		return errors.Wrap(err, "decode ReservationSeries")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b00111111,
-		0b00001101,
+	for i, mask := range [1]uint8{
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -17064,8 +20694,8 @@ func (s *Reservation) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfReservation) {
-					name = jsonFieldsNameOfReservation[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfReservationSeries) {
+					name = jsonFieldsNameOfReservationSeries[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -17086,14 +20716,14 @@ func (s *Reservation) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Reservation) MarshalJSON() ([]byte, error) {
+func (s *ReservationSeries) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Reservation) UnmarshalJSON(data []byte) error {
+func (s *ReservationSeries) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
@@ -17345,6 +20975,82 @@ func (s *RoomPlan) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode encodes RotatePlaceQrKeyForbidden as json.
+func (s *RotatePlaceQrKeyForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes RotatePlaceQrKeyForbidden from json.
+func (s *RotatePlaceQrKeyForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RotatePlaceQrKeyForbidden to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = RotatePlaceQrKeyForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RotatePlaceQrKeyForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RotatePlaceQrKeyForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes RotatePlaceQrKeyNotFound as json.
+func (s *RotatePlaceQrKeyNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes RotatePlaceQrKeyNotFound from json.
+func (s *RotatePlaceQrKeyNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RotatePlaceQrKeyNotFound to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = RotatePlaceQrKeyNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RotatePlaceQrKeyNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RotatePlaceQrKeyNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode encodes SetDefaultGroupAssignmentBadRequest as json.
 func (s *SetDefaultGroupAssignmentBadRequest) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
@@ -18225,37 +21931,151 @@ func (s *TimeSlotConfig) Decode(d *jx.Decoder) error {
 			}
 		}
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *TimeSlotConfig) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TimeSlotConfig) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UpdateAreaBadRequest as json.
+func (s *UpdateAreaBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes UpdateAreaBadRequest from json.
+func (s *UpdateAreaBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UpdateAreaBadRequest to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = UpdateAreaBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UpdateAreaBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UpdateAreaBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UpdateAreaConstraintsBadRequest as json.
+func (s *UpdateAreaConstraintsBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes UpdateAreaConstraintsBadRequest from json.
+func (s *UpdateAreaConstraintsBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UpdateAreaConstraintsBadRequest to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = UpdateAreaConstraintsBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UpdateAreaConstraintsBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
 
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UpdateAreaConstraintsBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UpdateAreaConstraintsForbidden as json.
+func (s *UpdateAreaConstraintsForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes UpdateAreaConstraintsForbidden from json.
+func (s *UpdateAreaConstraintsForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UpdateAreaConstraintsForbidden to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = UpdateAreaConstraintsForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TimeSlotConfig) MarshalJSON() ([]byte, error) {
+func (s *UpdateAreaConstraintsForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TimeSlotConfig) UnmarshalJSON(data []byte) error {
+func (s *UpdateAreaConstraintsForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes UpdateAreaBadRequest as json.
-func (s *UpdateAreaBadRequest) Encode(e *jx.Encoder) {
+// Encode encodes UpdateAreaConstraintsNotFound as json.
+func (s *UpdateAreaConstraintsNotFound) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes UpdateAreaBadRequest from json.
-func (s *UpdateAreaBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes UpdateAreaConstraintsNotFound from json.
+func (s *UpdateAreaConstraintsNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UpdateAreaBadRequest to nil")
+		return errors.New("invalid: unable to decode UpdateAreaConstraintsNotFound to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -18266,19 +22086,19 @@ func (s *UpdateAreaBadRequest) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = UpdateAreaBadRequest(unwrapped)
+	*s = UpdateAreaConstraintsNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UpdateAreaBadRequest) MarshalJSON() ([]byte, error) {
+func (s *UpdateAreaConstraintsNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UpdateAreaBadRequest) UnmarshalJSON(data []byte) error {
+func (s *UpdateAreaConstraintsNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
@@ -18608,6 +22428,120 @@ func (s *UpdateBuildingBadRequest) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode encodes UpdateBuildingConstraintsBadRequest as json.
+func (s *UpdateBuildingConstraintsBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes UpdateBuildingConstraintsBadRequest from json.
+func (s *UpdateBuildingConstraintsBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UpdateBuildingConstraintsBadRequest to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = UpdateBuildingConstraintsBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UpdateBuildingConstraintsBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UpdateBuildingConstraintsBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UpdateBuildingConstraintsForbidden as json.
+func (s *UpdateBuildingConstraintsForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes UpdateBuildingConstraintsForbidden from json.
+func (s *UpdateBuildingConstraintsForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UpdateBuildingConstraintsForbidden to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = UpdateBuildingConstraintsForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UpdateBuildingConstraintsForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UpdateBuildingConstraintsForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UpdateBuildingConstraintsNotFound as json.
+func (s *UpdateBuildingConstraintsNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes UpdateBuildingConstraintsNotFound from json.
+func (s *UpdateBuildingConstraintsNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UpdateBuildingConstraintsNotFound to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = UpdateBuildingConstraintsNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UpdateBuildingConstraintsNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UpdateBuildingConstraintsNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode encodes UpdateBuildingForbidden as json.
 func (s *UpdateBuildingForbidden) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
@@ -19000,85 +22934,199 @@ func (s *UpdateEquipmentRequest) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfUpdateEquipmentRequest = [3]string{
-	0: "name",
-	1: "icon",
-	2: "description",
+var jsonFieldsNameOfUpdateEquipmentRequest = [3]string{
+	0: "name",
+	1: "icon",
+	2: "description",
+}
+
+// Decode decodes UpdateEquipmentRequest from json.
+func (s *UpdateEquipmentRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UpdateEquipmentRequest to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "icon":
+			if err := func() error {
+				s.Icon.Reset()
+				if err := s.Icon.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"icon\"")
+			}
+		case "description":
+			if err := func() error {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"description\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode UpdateEquipmentRequest")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UpdateEquipmentRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UpdateEquipmentRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UpdateGroupBadRequest as json.
+func (s *UpdateGroupBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes UpdateGroupBadRequest from json.
+func (s *UpdateGroupBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UpdateGroupBadRequest to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = UpdateGroupBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UpdateGroupBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UpdateGroupBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UpdateGroupConstraintProfileBadRequest as json.
+func (s *UpdateGroupConstraintProfileBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes UpdateGroupConstraintProfileBadRequest from json.
+func (s *UpdateGroupConstraintProfileBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UpdateGroupConstraintProfileBadRequest to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = UpdateGroupConstraintProfileBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UpdateGroupConstraintProfileBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes UpdateEquipmentRequest from json.
-func (s *UpdateEquipmentRequest) Decode(d *jx.Decoder) error {
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UpdateGroupConstraintProfileBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UpdateGroupConstraintProfileForbidden as json.
+func (s *UpdateGroupConstraintProfileForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes UpdateGroupConstraintProfileForbidden from json.
+func (s *UpdateGroupConstraintProfileForbidden) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UpdateEquipmentRequest to nil")
+		return errors.New("invalid: unable to decode UpdateGroupConstraintProfileForbidden to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "name":
-			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "icon":
-			if err := func() error {
-				s.Icon.Reset()
-				if err := s.Icon.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"icon\"")
-			}
-		case "description":
-			if err := func() error {
-				s.Description.Reset()
-				if err := s.Description.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"description\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode UpdateEquipmentRequest")
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = UpdateGroupConstraintProfileForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UpdateEquipmentRequest) MarshalJSON() ([]byte, error) {
+func (s *UpdateGroupConstraintProfileForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UpdateEquipmentRequest) UnmarshalJSON(data []byte) error {
+func (s *UpdateGroupConstraintProfileForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes UpdateGroupBadRequest as json.
-func (s *UpdateGroupBadRequest) Encode(e *jx.Encoder) {
+// Encode encodes UpdateGroupConstraintProfileNotFound as json.
+func (s *UpdateGroupConstraintProfileNotFound) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes UpdateGroupBadRequest from json.
-func (s *UpdateGroupBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes UpdateGroupConstraintProfileNotFound from json.
+func (s *UpdateGroupConstraintProfileNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UpdateGroupBadRequest to nil")
+		return errors.New("invalid: unable to decode UpdateGroupConstraintProfileNotFound to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -19089,19 +23137,19 @@ func (s *UpdateGroupBadRequest) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = UpdateGroupBadRequest(unwrapped)
+	*s = UpdateGroupConstraintProfileNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UpdateGroupBadRequest) MarshalJSON() ([]byte, error) {
+func (s *UpdateGroupConstraintProfileNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UpdateGroupBadRequest) UnmarshalJSON(data []byte) error {
+func (s *UpdateGroupConstraintProfileNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
@@ -20193,11 +24241,25 @@ func (s *UpdateReservationRequest) encodeFields(e *jx.Encoder) {
 			s.EndTime.Encode(e, json.EncodeDateTime)
 		}
 	}
+	{
+		if s.BypassQuotas.Set {
+			e.FieldStart("bypassQuotas")
+			s.BypassQuotas.Encode(e)
+		}
+	}
+	{
+		if s.BypassBlockings.Set {
+			e.FieldStart("bypassBlockings")
+			s.BypassBlockings.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfUpdateReservationRequest = [2]string{
+var jsonFieldsNameOfUpdateReservationRequest = [4]string{
 	0: "startTime",
 	1: "endTime",
+	2: "bypassQuotas",
+	3: "bypassBlockings",
 }
 
 // Decode decodes UpdateReservationRequest from json.
@@ -20205,6 +24267,7 @@ func (s *UpdateReservationRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
 		return errors.New("invalid: unable to decode UpdateReservationRequest to nil")
 	}
+	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
@@ -20228,6 +24291,26 @@ func (s *UpdateReservationRequest) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"endTime\"")
 			}
+		case "bypassQuotas":
+			if err := func() error {
+				s.BypassQuotas.Reset()
+				if err := s.BypassQuotas.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bypassQuotas\"")
+			}
+		case "bypassBlockings":
+			if err := func() error {
+				s.BypassBlockings.Reset()
+				if err := s.BypassBlockings.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bypassBlockings\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -20712,6 +24795,16 @@ func (s *User) encodeFields(e *jx.Encoder) {
 			e.ArrEnd()
 		}
 	}
+	{
+		if s.Permissions != nil {
+			e.FieldStart("permissions")
+			e.ArrStart()
+			for _, elem := range s.Permissions {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
 	{
 		e.FieldStart("createdAt")
 		json.EncodeDateTime(e, s.CreatedAt)
@@ -20722,7 +24815,7 @@ func (s *User) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfUser = [11]string{
+var jsonFieldsNameOfUser = [12]string{
 	0:  "id",
 	1:  "email",
 	2:  "username",
@@ -20732,8 +24825,9 @@ var jsonFieldsNameOfUser = [11]string{
 	6:  "oauthId",
 	7:  "isActive",
 	8:  "groups",
-	9:  "createdAt",
-	10: "updatedAt",
+	9:  "permissions",
+	10: "createdAt",
+	11: "updatedAt",
 }
 
 // Decode decodes User from json.
@@ -20854,8 +24948,25 @@ func (s *User) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"groups\"")
 			}
+		case "permissions":
+			if err := func() error {
+				s.Permissions = make([]Permission, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Permission
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Permissions = append(s.Permissions, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"permissions\"")
+			}
 		case "createdAt":
-			requiredBitSet[1] |= 1 << 1
+			requiredBitSet[1] |= 1 << 2
 			if err := func() error {
 				v, err := json.DecodeDateTime(d)
 				s.CreatedAt = v
@@ -20867,7 +24978,7 @@ func (s *User) Decode(d *jx.Decoder) error {
 				return errors.Wrap(err, "decode field \"createdAt\"")
 			}
 		case "updatedAt":
-			requiredBitSet[1] |= 1 << 2
+			requiredBitSet[1] |= 1 << 3
 			if err := func() error {
 				v, err := json.DecodeDateTime(d)
 				s.UpdatedAt = v
@@ -20889,7 +25000,7 @@ func (s *User) Decode(d *jx.Decoder) error {
 	var failures []validate.FieldError
 	for i, mask := range [2]uint8{
 		0b10101111,
-		0b00000110,
+		0b00001100,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -21064,3 +25175,174 @@ func (s *UserReference) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
+
+// Encode implements json.Marshaler.
+func (s *WeekdayOpeningHours) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *WeekdayOpeningHours) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("weekday")
+		s.Weekday.Encode(e)
+	}
+	{
+		e.FieldStart("hours")
+		e.ArrStart()
+		for _, elem := range s.Hours {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfWeekdayOpeningHours = [2]string{
+	0: "weekday",
+	1: "hours",
+}
+
+// Decode decodes WeekdayOpeningHours from json.
+func (s *WeekdayOpeningHours) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode WeekdayOpeningHours to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "weekday":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Weekday.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"weekday\"")
+			}
+		case "hours":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				s.Hours = make([]OpeningHoursRange, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem OpeningHoursRange
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Hours = append(s.Hours, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"hours\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode WeekdayOpeningHours")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfWeekdayOpeningHours) {
+					name = jsonFieldsNameOfWeekdayOpeningHours[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *WeekdayOpeningHours) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *WeekdayOpeningHours) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes WeekdayOpeningHoursWeekday as json.
+func (s WeekdayOpeningHoursWeekday) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes WeekdayOpeningHoursWeekday from json.
+func (s *WeekdayOpeningHoursWeekday) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode WeekdayOpeningHoursWeekday to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch WeekdayOpeningHoursWeekday(v) {
+	case WeekdayOpeningHoursWeekdayMonday:
+		*s = WeekdayOpeningHoursWeekdayMonday
+	case WeekdayOpeningHoursWeekdayTuesday:
+		*s = WeekdayOpeningHoursWeekdayTuesday
+	case WeekdayOpeningHoursWeekdayWednesday:
+		*s = WeekdayOpeningHoursWeekdayWednesday
+	case WeekdayOpeningHoursWeekdayThursday:
+		*s = WeekdayOpeningHoursWeekdayThursday
+	case WeekdayOpeningHoursWeekdayFriday:
+		*s = WeekdayOpeningHoursWeekdayFriday
+	case WeekdayOpeningHoursWeekdaySaturday:
+		*s = WeekdayOpeningHoursWeekdaySaturday
+	case WeekdayOpeningHoursWeekdaySunday:
+		*s = WeekdayOpeningHoursWeekdaySunday
+	default:
+		*s = WeekdayOpeningHoursWeekday(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s WeekdayOpeningHoursWeekday) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *WeekdayOpeningHoursWeekday) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
@@ -22,6 +22,7 @@ const (
 	CreateBuildingOperation                 OperationName = "CreateBuilding"
 	CreateEquipmentOperation                OperationName = "CreateEquipment"
 	CreateGroupOperation                    OperationName = "CreateGroup"
+	CreateGroupConstraintProfileOperation   OperationName = "CreateGroupConstraintProfile"
 	CreatePlaceOperation                    OperationName = "CreatePlace"
 	CreateQrTemplateOperation               OperationName = "CreateQrTemplate"
 	CreateReservationOperation              OperationName = "CreateReservation"
@@ -30,26 +31,33 @@ const (
 	DeleteBuildingOperation                 OperationName = "DeleteBuilding"
 	DeleteEquipmentOperation                OperationName = "DeleteEquipment"
 	DeleteGroupOperation                    OperationName = "DeleteGroup"
+	DeleteGroupConstraintProfileOperation   OperationName = "DeleteGroupConstraintProfile"
 	DeletePlaceOperation                    OperationName = "DeletePlace"
 	DeleteQrTemplateOperation               OperationName = "DeleteQrTemplate"
 	DisableUserOperation                    OperationName = "DisableUser"
 	EnableUserOperation                     OperationName = "EnableUser"
+	ExportAreaQrCodesOperation              OperationName = "ExportAreaQrCodes"
+	ExportBuildingQrCodesOperation          OperationName = "ExportBuildingQrCodes"
 	ExportReservationsOperation             OperationName = "ExportReservations"
 	GetAreaOperation                        OperationName = "GetArea"
 	GetAreaAvailabilityOperation            OperationName = "GetAreaAvailability"
 	GetAreaBlockingOperation                OperationName = "GetAreaBlocking"
 	GetAreaCalendarOperation                OperationName = "GetAreaCalendar"
+	GetAreaConstraintsOperation             OperationName = "GetAreaConstraints"
 	GetAreaRoomPlanOperation                OperationName = "GetAreaRoomPlan"
 	GetAuditLogOperation                    OperationName = "GetAuditLog"
 	GetBuildingOperation                    OperationName = "GetBuilding"
 	GetBuildingAvailabilityOperation        OperationName = "GetBuildingAvailability"
 	GetBuildingBlockingOperation            OperationName = "GetBuildingBlocking"
 	GetBuildingCalendarOperation            OperationName = "GetBuildingCalendar"
+	GetBuildingConstraintsOperation         OperationName = "GetBuildingConstraints"
 	GetCurrentOccupancyOperation            OperationName = "GetCurrentOccupancy"
 	GetCurrentUserOperation                 OperationName = "GetCurrentUser"
+	GetCurrentUserCalendarTokenOperation    OperationName = "GetCurrentUserCalendarToken"
 	GetCurrentUserFavoritesOperation        OperationName = "GetCurrentUserFavorites"
 	GetCurrentUserNotificationsOperation    OperationName = "GetCurrentUserNotifications"
 	GetDefaultGroupAssignmentOperation      OperationName = "GetDefaultGroupAssignment"
+	GetEffectivePlaceConstraintsOperation   OperationName = "GetEffectivePlaceConstraints"
 	GetEquipmentOperation                   OperationName = "GetEquipment"
 	GetGroupOperation                       OperationName = "GetGroup"
 	GetGroupMembersOperation                OperationName = "GetGroupMembers"
@@ -61,6 +69,7 @@ const (
 	GetPlaceConstraintsOperation            OperationName = "GetPlaceConstraints"
 	GetPlaceEquipmentOperation              OperationName = "GetPlaceEquipment"
 	GetPlaceQrCodeOperation                 OperationName = "GetPlaceQrCode"
+	GetPlaceQrKeyOperation                  OperationName = "GetPlaceQrKey"
 	GetPlaceTimeSlotsOperation              OperationName = "GetPlaceTimeSlots"
 	GetPlaceWhitelistOperation              OperationName = "GetPlaceWhitelist"
 	GetQrTemplateOperation                  OperationName = "GetQrTemplate"
@@ -69,6 +78,7 @@ const (
 	GetStatisticsOperation                  OperationName = "GetStatistics"
 	GetUsageStatisticsOperation             OperationName = "GetUsageStatistics"
 	GetUserOperation                        OperationName = "GetUser"
+	GetUserCalendarOperation                OperationName = "GetUserCalendar"
 	GetUserGroupsOperation                  OperationName = "GetUserGroups"
 	HandleOAuthCallbackOperation            OperationName = "HandleOAuthCallback"
 	InitiateOAuthLoginOperation             OperationName = "InitiateOAuthLogin"
@@ -78,6 +88,7 @@ const (
 	ListBuildingAreasOperation              OperationName = "ListBuildingAreas"
 	ListBuildingsOperation                  OperationName = "ListBuildings"
 	ListEquipmentOperation                  OperationName = "ListEquipment"
+	ListGroupConstraintProfilesOperation    OperationName = "ListGroupConstraintProfiles"
 	ListGroupsOperation                     OperationName = "ListGroups"
 	ListPermissionsOperation                OperationName = "ListPermissions"
 	ListPlacesOperation                     OperationName = "ListPlaces"
@@ -100,13 +111,19 @@ const (
 	ReplaceBuildingBlockingOperation        OperationName = "ReplaceBuildingBlocking"
 	ReplacePlaceBlockingOperation           OperationName = "ReplacePlaceBlocking"
 	RevokeApiKeyOperation                   OperationName = "RevokeApiKey"
+	RevokeCurrentUserCalendarTokenOperation OperationName = "RevokeCurrentUserCalendarToken"
+	RotateCurrentUserCalendarTokenOperation OperationName = "RotateCurrentUserCalendarToken"
+	RotatePlaceQrKeyOperation               OperationName = "RotatePlaceQrKey"
 	SetDefaultGroupAssignmentOperation      OperationName = "SetDefaultGroupAssignment"
 	UpdateAreaOperation                     OperationName = "UpdateArea"
+	UpdateAreaConstraintsOperation          OperationName = "UpdateAreaConstraints"
 	UpdateAreaRoomPlanOperation             OperationName = "UpdateAreaRoomPlan"
 	UpdateBuildingOperation                 OperationName = "UpdateBuilding"
+	UpdateBuildingConstraintsOperation      OperationName = "UpdateBuildingConstraints"
 	UpdateCurrentUserNotificationsOperation OperationName = "UpdateCurrentUserNotifications"
 	UpdateEquipmentOperation                OperationName = "UpdateEquipment"
 	UpdateGroupOperation                    OperationName = "UpdateGroup"
+	UpdateGroupConstraintProfileOperation   OperationName = "UpdateGroupConstraintProfile"
 	UpdatePlaceOperation                    OperationName = "UpdatePlace"
 	UpdatePlaceConstraintsOperation         OperationName = "UpdatePlaceConstraints"
 	UpdatePlaceTimeSlotsOperation           OperationName = "UpdatePlaceTimeSlots"
@@ -539,10 +539,23 @@ func decodeAddUserGroupsParams(args [1]string, argsEscaped bool, r *http.Request
 
 // CancelReservationParams is parameters of cancelReservation operation.
 type CancelReservationParams struct {
+	// Which occurrences of a recurring series the change applies to. Only
+	// upcoming, not yet cancelled or checked-in occurrences are affected
+	// besides the addressed one. Ignored for single reservations.
+	Scope         OptSeriesScopeParam `json:",omitempty,omitzero"`
 	ReservationId uuid.UUID
 }
 
 func unpackCancelReservationParams(packed middleware.Parameters) (params CancelReservationParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "scope",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Scope = v.(OptSeriesScopeParam)
+		}
+	}
 	{
 		key := middleware.ParameterKey{
 			Name: "reservationId",
@@ -554,6 +567,68 @@ func unpackCancelReservationParams(packed middleware.Parameters) (params CancelR
 }
 
 func decodeCancelReservationParams(args [1]string, argsEscaped bool, r *http.Request) (params CancelReservationParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: scope.
+	{
+		val := SeriesScopeParam("occurrence")
+		params.Scope.SetTo(val)
+	}
+	// Decode query: scope.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "scope",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotScopeVal SeriesScopeParam
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotScopeVal = SeriesScopeParam(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Scope.SetTo(paramsDotScopeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Scope.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "scope",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	// Decode path: reservationId.
 	if err := func() error {
 		param := args[0]
@@ -667,6 +742,71 @@ func decodeCheckInReservationParams(args [1]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
+// CreateGroupConstraintProfileParams is parameters of createGroupConstraintProfile operation.
+type CreateGroupConstraintProfileParams struct {
+	GroupId uuid.UUID
+}
+
+func unpackCreateGroupConstraintProfileParams(packed middleware.Parameters) (params CreateGroupConstraintProfileParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "groupId",
+			In:   "path",
+		}
+		params.GroupId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeCreateGroupConstraintProfileParams(args [1]string, argsEscaped bool, r *http.Request) (params CreateGroupConstraintProfileParams, _ error) {
+	// Decode path: groupId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "groupId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.GroupId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "groupId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // DeleteAreaParams is parameters of deleteArea operation.
 type DeleteAreaParams struct {
 	AreaId uuid.UUID
@@ -992,6 +1132,124 @@ func decodeDeleteGroupParams(args [1]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
+// DeleteGroupConstraintProfileParams is parameters of deleteGroupConstraintProfile operation.
+type DeleteGroupConstraintProfileParams struct {
+	GroupId   uuid.UUID
+	ProfileId uuid.UUID
+}
+
+func unpackDeleteGroupConstraintProfileParams(packed middleware.Parameters) (params DeleteGroupConstraintProfileParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "groupId",
+			In:   "path",
+		}
+		params.GroupId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "profileId",
+			In:   "path",
+		}
+		params.ProfileId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeDeleteGroupConstraintProfileParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteGroupConstraintProfileParams, _ error) {
+	// Decode path: groupId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "groupId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.GroupId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "groupId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: profileId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "profileId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProfileId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "profileId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // DeletePlaceParams is parameters of deletePlace operation.
 type DeletePlaceParams struct {
 	PlaceId uuid.UUID
@@ -1252,226 +1510,219 @@ func decodeEnableUserParams(args [1]string, argsEscaped bool, r *http.Request) (
 	return params, nil
 }
 
-// ExportReservationsParams is parameters of exportReservations operation.
-type ExportReservationsParams struct {
-	StartDate  time.Time
-	EndDate    time.Time
-	BuildingId OptUUID `json:",omitempty,omitzero"`
-	AreaId     OptUUID `json:",omitempty,omitzero"`
+// ExportAreaQrCodesParams is parameters of exportAreaQrCodes operation.
+type ExportAreaQrCodesParams struct {
+	// QR template to render the labels with; by default labels show the place, area and building names.
+	TemplateId OptUUID                `json:",omitempty,omitzero"`
+	Format     OptQrExportFormatParam `json:",omitempty,omitzero"`
+	// Paper size of PDF print sheets.
+	Paper  OptQrExportPaperParam `json:",omitempty,omitzero"`
+	AreaId uuid.UUID
 }
 
-func unpackExportReservationsParams(packed middleware.Parameters) (params ExportReservationsParams) {
+func unpackExportAreaQrCodesParams(packed middleware.Parameters) (params ExportAreaQrCodesParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "startDate",
+			Name: "templateId",
 			In:   "query",
 		}
-		params.StartDate = packed[key].(time.Time)
+		if v, ok := packed[key]; ok {
+			params.TemplateId = v.(OptUUID)
+		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "endDate",
+			Name: "format",
 			In:   "query",
 		}
-		params.EndDate = packed[key].(time.Time)
+		if v, ok := packed[key]; ok {
+			params.Format = v.(OptQrExportFormatParam)
+		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "buildingId",
+			Name: "paper",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.BuildingId = v.(OptUUID)
+			params.Paper = v.(OptQrExportPaperParam)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
 			Name: "areaId",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.AreaId = v.(OptUUID)
+			In:   "path",
 		}
+		params.AreaId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeExportReservationsParams(args [0]string, argsEscaped bool, r *http.Request) (params ExportReservationsParams, _ error) {
+func decodeExportAreaQrCodesParams(args [1]string, argsEscaped bool, r *http.Request) (params ExportAreaQrCodesParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: startDate.
+	// Decode query: templateId.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "startDate",
+			Name:    "templateId",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToDate(val)
-				if err != nil {
-					return err
-				}
-
-				params.StartDate = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "startDate",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: endDate.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "endDate",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+				var paramsDotTemplateIdVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToDate(val)
-				if err != nil {
+					paramsDotTemplateIdVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.EndDate = c
+				params.TemplateId.SetTo(paramsDotTemplateIdVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-		} else {
-			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "endDate",
+			Name: "templateId",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: buildingId.
+	// Set default value for query: format.
+	{
+		val := QrExportFormatParam("pdf")
+		params.Format.SetTo(val)
+	}
+	// Decode query: format.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "buildingId",
+			Name:    "format",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotBuildingIdVal uuid.UUID
+				var paramsDotFormatVal QrExportFormatParam
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToUUID(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotBuildingIdVal = c
+					paramsDotFormatVal = QrExportFormatParam(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.BuildingId.SetTo(paramsDotBuildingIdVal)
+				params.Format.SetTo(paramsDotFormatVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Format.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "buildingId",
+			Name: "format",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: areaId.
+	// Set default value for query: paper.
+	{
+		val := QrExportPaperParam("a4")
+		params.Paper.SetTo(val)
+	}
+	// Decode query: paper.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "areaId",
+			Name:    "paper",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotAreaIdVal uuid.UUID
+				var paramsDotPaperVal QrExportPaperParam
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToUUID(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotAreaIdVal = c
+					paramsDotPaperVal = QrExportPaperParam(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.AreaId.SetTo(paramsDotAreaIdVal)
+				params.Paper.SetTo(paramsDotPaperVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Paper.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "areaId",
+			Name: "paper",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetAreaParams is parameters of getArea operation.
-type GetAreaParams struct {
-	AreaId uuid.UUID
-}
-
-func unpackGetAreaParams(packed middleware.Parameters) (params GetAreaParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "areaId",
-			In:   "path",
-		}
-		params.AreaId = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodeGetAreaParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAreaParams, _ error) {
 	// Decode path: areaId.
 	if err := func() error {
 		param := args[0]
@@ -1520,171 +1771,220 @@ func decodeGetAreaParams(args [1]string, argsEscaped bool, r *http.Request) (par
 	return params, nil
 }
 
-// GetAreaAvailabilityParams is parameters of getAreaAvailability operation.
-type GetAreaAvailabilityParams struct {
-	Date      time.Time
-	StartTime OptTime `json:",omitempty,omitzero"`
-	EndTime   OptTime `json:",omitempty,omitzero"`
-	AreaId    uuid.UUID
+// ExportBuildingQrCodesParams is parameters of exportBuildingQrCodes operation.
+type ExportBuildingQrCodesParams struct {
+	// QR template to render the labels with; by default labels show the place, area and building names.
+	TemplateId OptUUID                `json:",omitempty,omitzero"`
+	Format     OptQrExportFormatParam `json:",omitempty,omitzero"`
+	// Paper size of PDF print sheets.
+	Paper      OptQrExportPaperParam `json:",omitempty,omitzero"`
+	BuildingId uuid.UUID
 }
 
-func unpackGetAreaAvailabilityParams(packed middleware.Parameters) (params GetAreaAvailabilityParams) {
+func unpackExportBuildingQrCodesParams(packed middleware.Parameters) (params ExportBuildingQrCodesParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "date",
+			Name: "templateId",
 			In:   "query",
 		}
-		params.Date = packed[key].(time.Time)
+		if v, ok := packed[key]; ok {
+			params.TemplateId = v.(OptUUID)
+		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "startTime",
+			Name: "format",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.StartTime = v.(OptTime)
+			params.Format = v.(OptQrExportFormatParam)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "endTime",
+			Name: "paper",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.EndTime = v.(OptTime)
+			params.Paper = v.(OptQrExportPaperParam)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "areaId",
+			Name: "buildingId",
 			In:   "path",
 		}
-		params.AreaId = packed[key].(uuid.UUID)
+		params.BuildingId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetAreaAvailabilityParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAreaAvailabilityParams, _ error) {
+func decodeExportBuildingQrCodesParams(args [1]string, argsEscaped bool, r *http.Request) (params ExportBuildingQrCodesParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: date.
+	// Decode query: templateId.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "date",
+			Name:    "templateId",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+				var paramsDotTemplateIdVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToDate(val)
-				if err != nil {
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTemplateIdVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Date = c
+				params.TemplateId.SetTo(paramsDotTemplateIdVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-		} else {
-			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "date",
+			Name: "templateId",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: startTime.
+	// Set default value for query: format.
+	{
+		val := QrExportFormatParam("pdf")
+		params.Format.SetTo(val)
+	}
+	// Decode query: format.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "startTime",
+			Name:    "format",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStartTimeVal time.Time
+				var paramsDotFormatVal QrExportFormatParam
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToTime(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotStartTimeVal = c
+					paramsDotFormatVal = QrExportFormatParam(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.StartTime.SetTo(paramsDotStartTimeVal)
+				params.Format.SetTo(paramsDotFormatVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Format.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "startTime",
+			Name: "format",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: endTime.
+	// Set default value for query: paper.
+	{
+		val := QrExportPaperParam("a4")
+		params.Paper.SetTo(val)
+	}
+	// Decode query: paper.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "endTime",
+			Name:    "paper",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotEndTimeVal time.Time
+				var paramsDotPaperVal QrExportPaperParam
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToTime(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotEndTimeVal = c
+					paramsDotPaperVal = QrExportPaperParam(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.EndTime.SetTo(paramsDotEndTimeVal)
+				params.Paper.SetTo(paramsDotPaperVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Paper.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "endTime",
+			Name: "paper",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode path: areaId.
+	// Decode path: buildingId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -1696,7 +1996,7 @@ func decodeGetAreaAvailabilityParams(args [1]string, argsEscaped bool, r *http.R
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "areaId",
+				Param:   "buildingId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1713,7 +2013,7 @@ func decodeGetAreaAvailabilityParams(args [1]string, argsEscaped bool, r *http.R
 					return err
 				}
 
-				params.AreaId = c
+				params.BuildingId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1724,7 +2024,7 @@ func decodeGetAreaAvailabilityParams(args [1]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "areaId",
+			Name: "buildingId",
 			In:   "path",
 			Err:  err,
 		}
@@ -1732,186 +2032,152 @@ func decodeGetAreaAvailabilityParams(args [1]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// GetAreaBlockingParams is parameters of getAreaBlocking operation.
-type GetAreaBlockingParams struct {
-	// Include blocking inherited from building.
-	IncludeInherited OptBool `json:",omitempty,omitzero"`
-	// Expand recurring rules for date range.
-	IncludeRecurring OptBool `json:",omitempty,omitzero"`
-	StartDate        OptDate `json:",omitempty,omitzero"`
-	EndDate          OptDate `json:",omitempty,omitzero"`
-	AreaId           uuid.UUID
+// ExportReservationsParams is parameters of exportReservations operation.
+type ExportReservationsParams struct {
+	StartDate  time.Time
+	EndDate    time.Time
+	BuildingId OptUUID `json:",omitempty,omitzero"`
+	AreaId     OptUUID `json:",omitempty,omitzero"`
 }
 
-func unpackGetAreaBlockingParams(packed middleware.Parameters) (params GetAreaBlockingParams) {
+func unpackExportReservationsParams(packed middleware.Parameters) (params ExportReservationsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "includeInherited",
+			Name: "startDate",
 			In:   "query",
 		}
-		if v, ok := packed[key]; ok {
-			params.IncludeInherited = v.(OptBool)
-		}
+		params.StartDate = packed[key].(time.Time)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "includeRecurring",
+			Name: "endDate",
 			In:   "query",
 		}
-		if v, ok := packed[key]; ok {
-			params.IncludeRecurring = v.(OptBool)
-		}
+		params.EndDate = packed[key].(time.Time)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "startDate",
+			Name: "buildingId",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.StartDate = v.(OptDate)
+			params.BuildingId = v.(OptUUID)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "endDate",
+			Name: "areaId",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.EndDate = v.(OptDate)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "areaId",
-			In:   "path",
+			params.AreaId = v.(OptUUID)
 		}
-		params.AreaId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetAreaBlockingParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAreaBlockingParams, _ error) {
+func decodeExportReservationsParams(args [0]string, argsEscaped bool, r *http.Request) (params ExportReservationsParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Set default value for query: includeInherited.
-	{
-		val := bool(false)
-		params.IncludeInherited.SetTo(val)
-	}
-	// Decode query: includeInherited.
+	// Decode query: startDate.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "includeInherited",
+			Name:    "startDate",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotIncludeInheritedVal bool
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToBool(val)
-					if err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotIncludeInheritedVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToDate(val)
+				if err != nil {
 					return err
 				}
-				params.IncludeInherited.SetTo(paramsDotIncludeInheritedVal)
+
+				params.StartDate = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "includeInherited",
+			Name: "startDate",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: includeRecurring.
-	{
-		val := bool(false)
-		params.IncludeRecurring.SetTo(val)
-	}
-	// Decode query: includeRecurring.
+	// Decode query: endDate.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "includeRecurring",
+			Name:    "endDate",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotIncludeRecurringVal bool
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToBool(val)
-					if err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotIncludeRecurringVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToDate(val)
+				if err != nil {
 					return err
 				}
-				params.IncludeRecurring.SetTo(paramsDotIncludeRecurringVal)
+
+				params.EndDate = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "includeRecurring",
+			Name: "endDate",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: startDate.
+	// Decode query: buildingId.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "startDate",
+			Name:    "buildingId",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStartDateVal time.Time
+				var paramsDotBuildingIdVal uuid.UUID
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToDate(val)
+					c, err := conv.ToUUID(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotStartDateVal = c
+					paramsDotBuildingIdVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.StartDate.SetTo(paramsDotStartDateVal)
+				params.BuildingId.SetTo(paramsDotBuildingIdVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1920,39 +2186,39 @@ func decodeGetAreaBlockingParams(args [1]string, argsEscaped bool, r *http.Reque
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "startDate",
+			Name: "buildingId",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: endDate.
+	// Decode query: areaId.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "endDate",
+			Name:    "areaId",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotEndDateVal time.Time
+				var paramsDotAreaIdVal uuid.UUID
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToDate(val)
+					c, err := conv.ToUUID(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotEndDateVal = c
+					paramsDotAreaIdVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.EndDate.SetTo(paramsDotEndDateVal)
+				params.AreaId.SetTo(paramsDotAreaIdVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1961,11 +2227,31 @@ func decodeGetAreaBlockingParams(args [1]string, argsEscaped bool, r *http.Reque
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "endDate",
+			Name: "areaId",
 			In:   "query",
 			Err:  err,
 		}
 	}
+	return params, nil
+}
+
+// GetAreaParams is parameters of getArea operation.
+type GetAreaParams struct {
+	AreaId uuid.UUID
+}
+
+func unpackGetAreaParams(packed middleware.Parameters) (params GetAreaParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "areaId",
+			In:   "path",
+		}
+		params.AreaId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetAreaParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAreaParams, _ error) {
 	// Decode path: areaId.
 	if err := func() error {
 		param := args[0]
@@ -2014,12 +2300,40 @@ func decodeGetAreaBlockingParams(args [1]string, argsEscaped bool, r *http.Reque
 	return params, nil
 }
 
-// GetAreaCalendarParams is parameters of getAreaCalendar operation.
-type GetAreaCalendarParams struct {
-	AreaId uuid.UUID
+// GetAreaAvailabilityParams is parameters of getAreaAvailability operation.
+type GetAreaAvailabilityParams struct {
+	Date      time.Time
+	StartTime OptTime `json:",omitempty,omitzero"`
+	EndTime   OptTime `json:",omitempty,omitzero"`
+	AreaId    uuid.UUID
 }
 
-func unpackGetAreaCalendarParams(packed middleware.Parameters) (params GetAreaCalendarParams) {
+func unpackGetAreaAvailabilityParams(packed middleware.Parameters) (params GetAreaAvailabilityParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "date",
+			In:   "query",
+		}
+		params.Date = packed[key].(time.Time)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "startTime",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.StartTime = v.(OptTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "endTime",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.EndTime = v.(OptTime)
+		}
+	}
 	{
 		key := middleware.ParameterKey{
 			Name: "areaId",
@@ -2030,72 +2344,126 @@ func unpackGetAreaCalendarParams(packed middleware.Parameters) (params GetAreaCa
 	return params
 }
 
-func decodeGetAreaCalendarParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAreaCalendarParams, _ error) {
-	// Decode path: areaId.
+func decodeGetAreaAvailabilityParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAreaAvailabilityParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: date.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "date",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "areaId",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToDate(val)
 				if err != nil {
 					return err
 				}
 
-				params.AreaId = c
+				params.Date = c
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		} else {
-			return validate.ErrFieldRequired
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "areaId",
-			In:   "path",
+			Name: "date",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetAreaRoomPlanParams is parameters of getAreaRoomPlan operation.
-type GetAreaRoomPlanParams struct {
-	AreaId uuid.UUID
-}
+	// Decode query: startTime.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "startTime",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-func unpackGetAreaRoomPlanParams(packed middleware.Parameters) (params GetAreaRoomPlanParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "areaId",
-			In:   "path",
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStartTimeVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStartTimeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.StartTime.SetTo(paramsDotStartTimeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "startTime",
+			In:   "query",
+			Err:  err,
 		}
-		params.AreaId = packed[key].(uuid.UUID)
 	}
-	return params
-}
+	// Decode query: endTime.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "endTime",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-func decodeGetAreaRoomPlanParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAreaRoomPlanParams, _ error) {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEndTimeVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEndTimeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.EndTime.SetTo(paramsDotEndTimeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "endTime",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	// Decode path: areaId.
 	if err := func() error {
 		param := args[0]
@@ -2144,56 +2512,34 @@ func decodeGetAreaRoomPlanParams(args [1]string, argsEscaped bool, r *http.Reque
 	return params, nil
 }
 
-// GetAuditLogParams is parameters of getAuditLog operation.
-type GetAuditLogParams struct {
-	EntityType OptGetAuditLogEntityType `json:",omitempty,omitzero"`
-	EntityId   OptUUID                  `json:",omitempty,omitzero"`
-	// Filter by who made the change.
-	UserId    OptUUID              `json:",omitempty,omitzero"`
-	Action    OptGetAuditLogAction `json:",omitempty,omitzero"`
-	StartDate OptDateTime          `json:",omitempty,omitzero"`
-	EndDate   OptDateTime          `json:",omitempty,omitzero"`
-	// Page number (1-indexed).
-	Page OptInt `json:",omitempty,omitzero"`
-	// Items per page.
-	Limit OptInt `json:",omitempty,omitzero"`
+// GetAreaBlockingParams is parameters of getAreaBlocking operation.
+type GetAreaBlockingParams struct {
+	// Include blocking inherited from building.
+	IncludeInherited OptBool `json:",omitempty,omitzero"`
+	// Expand recurring rules for date range.
+	IncludeRecurring OptBool `json:",omitempty,omitzero"`
+	StartDate        OptDate `json:",omitempty,omitzero"`
+	EndDate          OptDate `json:",omitempty,omitzero"`
+	AreaId           uuid.UUID
 }
 
-func unpackGetAuditLogParams(packed middleware.Parameters) (params GetAuditLogParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "entityType",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.EntityType = v.(OptGetAuditLogEntityType)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "entityId",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.EntityId = v.(OptUUID)
-		}
-	}
+func unpackGetAreaBlockingParams(packed middleware.Parameters) (params GetAreaBlockingParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "userId",
+			Name: "includeInherited",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.UserId = v.(OptUUID)
+			params.IncludeInherited = v.(OptBool)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "action",
+			Name: "includeRecurring",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Action = v.(OptGetAuditLogAction)
+			params.IncludeRecurring = v.(OptBool)
 		}
 	}
 	{
@@ -2202,7 +2548,7 @@ func unpackGetAuditLogParams(packed middleware.Parameters) (params GetAuditLogPa
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.StartDate = v.(OptDateTime)
+			params.StartDate = v.(OptDate)
 		}
 	}
 	{
@@ -2211,116 +2557,100 @@ func unpackGetAuditLogParams(packed middleware.Parameters) (params GetAuditLogPa
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.EndDate = v.(OptDateTime)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "page",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Page = v.(OptInt)
+			params.EndDate = v.(OptDate)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
+			Name: "areaId",
+			In:   "path",
 		}
+		params.AreaId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetAuditLogParams(args [0]string, argsEscaped bool, r *http.Request) (params GetAuditLogParams, _ error) {
+func decodeGetAreaBlockingParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAreaBlockingParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: entityType.
+	// Set default value for query: includeInherited.
+	{
+		val := bool(false)
+		params.IncludeInherited.SetTo(val)
+	}
+	// Decode query: includeInherited.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "entityType",
+			Name:    "includeInherited",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotEntityTypeVal GetAuditLogEntityType
+				var paramsDotIncludeInheritedVal bool
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToBool(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotEntityTypeVal = GetAuditLogEntityType(c)
+					paramsDotIncludeInheritedVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.EntityType.SetTo(paramsDotEntityTypeVal)
+				params.IncludeInherited.SetTo(paramsDotIncludeInheritedVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-			if err := func() error {
-				if value, ok := params.EntityType.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "entityType",
+			Name: "includeInherited",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: entityId.
+	// Set default value for query: includeRecurring.
+	{
+		val := bool(false)
+		params.IncludeRecurring.SetTo(val)
+	}
+	// Decode query: includeRecurring.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "entityId",
+			Name:    "includeRecurring",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotEntityIdVal uuid.UUID
+				var paramsDotIncludeRecurringVal bool
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToUUID(val)
+					c, err := conv.ToBool(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotEntityIdVal = c
+					paramsDotIncludeRecurringVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.EntityId.SetTo(paramsDotEntityIdVal)
+				params.IncludeRecurring.SetTo(paramsDotIncludeRecurringVal)
 				return nil
 			}); err != nil {
 				return err
@@ -2329,39 +2659,39 @@ func decodeGetAuditLogParams(args [0]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "entityId",
+			Name: "includeRecurring",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: userId.
+	// Decode query: startDate.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "userId",
+			Name:    "startDate",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotUserIdVal uuid.UUID
+				var paramsDotStartDateVal time.Time
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToUUID(val)
+					c, err := conv.ToDate(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotUserIdVal = c
+					paramsDotStartDateVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.UserId.SetTo(paramsDotUserIdVal)
+				params.StartDate.SetTo(paramsDotStartDateVal)
 				return nil
 			}); err != nil {
 				return err
@@ -2370,312 +2700,234 @@ func decodeGetAuditLogParams(args [0]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "userId",
+			Name: "startDate",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: action.
+	// Decode query: endDate.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "action",
+			Name:    "endDate",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotActionVal GetAuditLogAction
+				var paramsDotEndDateVal time.Time
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToDate(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotActionVal = GetAuditLogAction(c)
+					paramsDotEndDateVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Action.SetTo(paramsDotActionVal)
+				params.EndDate.SetTo(paramsDotEndDateVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-			if err := func() error {
-				if value, ok := params.Action.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "action",
+			Name: "endDate",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: startDate.
+	// Decode path: areaId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "startDate",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStartDateVal time.Time
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "areaId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-					c, err := conv.ToDateTime(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotStartDateVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToUUID(val)
+				if err != nil {
 					return err
 				}
-				params.StartDate.SetTo(paramsDotStartDateVal)
+
+				params.AreaId = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "startDate",
-			In:   "query",
+			Name: "areaId",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: endDate.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "endDate",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotEndDateVal time.Time
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+	return params, nil
+}
 
-					c, err := conv.ToDateTime(val)
-					if err != nil {
-						return err
-					}
+// GetAreaCalendarParams is parameters of getAreaCalendar operation.
+type GetAreaCalendarParams struct {
+	// Entity tag of a previously fetched response.
+	IfNoneMatch OptString `json:",omitempty,omitzero"`
+	AreaId      uuid.UUID
+}
 
-					paramsDotEndDateVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.EndDate.SetTo(paramsDotEndDateVal)
-				return nil
-			}); err != nil {
-				return err
-			}
+func unpackGetAreaCalendarParams(packed middleware.Parameters) (params GetAreaCalendarParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "If-None-Match",
+			In:   "header",
 		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "endDate",
-			In:   "query",
-			Err:  err,
+		if v, ok := packed[key]; ok {
+			params.IfNoneMatch = v.(OptString)
 		}
 	}
-	// Set default value for query: page.
 	{
-		val := int(1)
-		params.Page.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "areaId",
+			In:   "path",
+		}
+		params.AreaId = packed[key].(uuid.UUID)
 	}
-	// Decode query: page.
+	return params
+}
+
+func decodeGetAreaCalendarParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAreaCalendarParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: If-None-Match.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "page",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-None-Match",
+			Explode: false,
 		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPageVal int
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfNoneMatchVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotPageVal = c
+					paramsDotIfNoneMatchVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Page.SetTo(paramsDotPageVal)
+				params.IfNoneMatch.SetTo(paramsDotIfNoneMatchVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-			if err := func() error {
-				if value, ok := params.Page.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "page",
-			In:   "query",
+			Name: "If-None-Match",
+			In:   "header",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
-	{
-		val := int(20)
-		params.Limit.SetTo(val)
-	}
-	// Decode query: limit.
+	// Decode path: areaId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "areaId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           100,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.AreaId = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "areaId",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetBuildingParams is parameters of getBuilding operation.
-type GetBuildingParams struct {
-	BuildingId uuid.UUID
+// GetAreaConstraintsParams is parameters of getAreaConstraints operation.
+type GetAreaConstraintsParams struct {
+	AreaId uuid.UUID
 }
 
-func unpackGetBuildingParams(packed middleware.Parameters) (params GetBuildingParams) {
+func unpackGetAreaConstraintsParams(packed middleware.Parameters) (params GetAreaConstraintsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "buildingId",
+			Name: "areaId",
 			In:   "path",
 		}
-		params.BuildingId = packed[key].(uuid.UUID)
+		params.AreaId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetBuildingParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBuildingParams, _ error) {
-	// Decode path: buildingId.
+func decodeGetAreaConstraintsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAreaConstraintsParams, _ error) {
+	// Decode path: areaId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -2687,7 +2939,7 @@ func decodeGetBuildingParams(args [1]string, argsEscaped bool, r *http.Request)
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "buildingId",
+				Param:   "areaId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2704,7 +2956,7 @@ func decodeGetBuildingParams(args [1]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.BuildingId = c
+				params.AreaId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2715,7 +2967,7 @@ func decodeGetBuildingParams(args [1]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "buildingId",
+			Name: "areaId",
 			In:   "path",
 			Err:  err,
 		}
@@ -2723,113 +2975,24 @@ func decodeGetBuildingParams(args [1]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// GetBuildingAvailabilityParams is parameters of getBuildingAvailability operation.
-type GetBuildingAvailabilityParams struct {
-	StartDate  time.Time
-	EndDate    time.Time
-	BuildingId uuid.UUID
+// GetAreaRoomPlanParams is parameters of getAreaRoomPlan operation.
+type GetAreaRoomPlanParams struct {
+	AreaId uuid.UUID
 }
 
-func unpackGetBuildingAvailabilityParams(packed middleware.Parameters) (params GetBuildingAvailabilityParams) {
+func unpackGetAreaRoomPlanParams(packed middleware.Parameters) (params GetAreaRoomPlanParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "startDate",
-			In:   "query",
-		}
-		params.StartDate = packed[key].(time.Time)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "endDate",
-			In:   "query",
-		}
-		params.EndDate = packed[key].(time.Time)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "buildingId",
+			Name: "areaId",
 			In:   "path",
 		}
-		params.BuildingId = packed[key].(uuid.UUID)
+		params.AreaId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetBuildingAvailabilityParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBuildingAvailabilityParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: startDate.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "startDate",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToDate(val)
-				if err != nil {
-					return err
-				}
-
-				params.StartDate = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "startDate",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: endDate.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "endDate",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToDate(val)
-				if err != nil {
-					return err
-				}
-
-				params.EndDate = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "endDate",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode path: buildingId.
+func decodeGetAreaRoomPlanParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAreaRoomPlanParams, _ error) {
+	// Decode path: areaId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -2841,7 +3004,7 @@ func decodeGetBuildingAvailabilityParams(args [1]string, argsEscaped bool, r *ht
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "buildingId",
+				Param:   "areaId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2858,7 +3021,7 @@ func decodeGetBuildingAvailabilityParams(args [1]string, argsEscaped bool, r *ht
 					return err
 				}
 
-				params.BuildingId = c
+				params.AreaId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2869,7 +3032,7 @@ func decodeGetBuildingAvailabilityParams(args [1]string, argsEscaped bool, r *ht
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "buildingId",
+			Name: "areaId",
 			In:   "path",
 			Err:  err,
 		}
@@ -2877,25 +3040,56 @@ func decodeGetBuildingAvailabilityParams(args [1]string, argsEscaped bool, r *ht
 	return params, nil
 }
 
-// GetBuildingBlockingParams is parameters of getBuildingBlocking operation.
-type GetBuildingBlockingParams struct {
-	// Expand recurring rules for date range.
-	IncludeRecurring OptBool `json:",omitempty,omitzero"`
-	// Start date for expanding recurring rules.
-	StartDate OptDate `json:",omitempty,omitzero"`
-	// End date for expanding recurring rules.
-	EndDate    OptDate `json:",omitempty,omitzero"`
-	BuildingId uuid.UUID
+// GetAuditLogParams is parameters of getAuditLog operation.
+type GetAuditLogParams struct {
+	EntityType OptGetAuditLogEntityType `json:",omitempty,omitzero"`
+	EntityId   OptUUID                  `json:",omitempty,omitzero"`
+	// Filter by who made the change.
+	UserId    OptUUID              `json:",omitempty,omitzero"`
+	Action    OptGetAuditLogAction `json:",omitempty,omitzero"`
+	StartDate OptDateTime          `json:",omitempty,omitzero"`
+	EndDate   OptDateTime          `json:",omitempty,omitzero"`
+	// Page number (1-indexed).
+	Page OptInt `json:",omitempty,omitzero"`
+	// Items per page.
+	Limit OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackGetBuildingBlockingParams(packed middleware.Parameters) (params GetBuildingBlockingParams) {
+func unpackGetAuditLogParams(packed middleware.Parameters) (params GetAuditLogParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "includeRecurring",
+			Name: "entityType",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.IncludeRecurring = v.(OptBool)
+			params.EntityType = v.(OptGetAuditLogEntityType)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "entityId",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.EntityId = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "userId",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UserId = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "action",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Action = v.(OptGetAuditLogAction)
 		}
 	}
 	{
@@ -2904,7 +3098,7 @@ func unpackGetBuildingBlockingParams(packed middleware.Parameters) (params GetBu
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.StartDate = v.(OptDate)
+			params.StartDate = v.(OptDateTime)
 		}
 	}
 	{
@@ -2913,95 +3107,116 @@ func unpackGetBuildingBlockingParams(packed middleware.Parameters) (params GetBu
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.EndDate = v.(OptDate)
+			params.EndDate = v.(OptDateTime)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "buildingId",
-			In:   "path",
+			Name: "page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Page = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
 		}
-		params.BuildingId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetBuildingBlockingParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBuildingBlockingParams, _ error) {
+func decodeGetAuditLogParams(args [0]string, argsEscaped bool, r *http.Request) (params GetAuditLogParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Set default value for query: includeRecurring.
-	{
-		val := bool(false)
-		params.IncludeRecurring.SetTo(val)
-	}
-	// Decode query: includeRecurring.
+	// Decode query: entityType.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "includeRecurring",
+			Name:    "entityType",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotIncludeRecurringVal bool
+				var paramsDotEntityTypeVal GetAuditLogEntityType
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToBool(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotIncludeRecurringVal = c
+					paramsDotEntityTypeVal = GetAuditLogEntityType(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.IncludeRecurring.SetTo(paramsDotIncludeRecurringVal)
+				params.EntityType.SetTo(paramsDotEntityTypeVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.EntityType.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "includeRecurring",
+			Name: "entityType",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: startDate.
+	// Decode query: entityId.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "startDate",
+			Name:    "entityId",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStartDateVal time.Time
+				var paramsDotEntityIdVal uuid.UUID
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToDate(val)
+					c, err := conv.ToUUID(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotStartDateVal = c
+					paramsDotEntityIdVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.StartDate.SetTo(paramsDotStartDateVal)
+				params.EntityId.SetTo(paramsDotEntityIdVal)
 				return nil
 			}); err != nil {
 				return err
@@ -3010,39 +3225,39 @@ func decodeGetBuildingBlockingParams(args [1]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "startDate",
+			Name: "entityId",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: endDate.
+	// Decode query: userId.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "endDate",
+			Name:    "userId",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotEndDateVal time.Time
+				var paramsDotUserIdVal uuid.UUID
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToDate(val)
+					c, err := conv.ToUUID(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotEndDateVal = c
+					paramsDotUserIdVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.EndDate.SetTo(paramsDotEndDateVal)
+				params.UserId.SetTo(paramsDotUserIdVal)
 				return nil
 			}); err != nil {
 				return err
@@ -3051,182 +3266,136 @@ func decodeGetBuildingBlockingParams(args [1]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "endDate",
+			Name: "userId",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode path: buildingId.
+	// Decode query: action.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "action",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "buildingId",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotActionVal GetAuditLogAction
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotActionVal = GetAuditLogAction(c)
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.BuildingId = c
+				params.Action.SetTo(paramsDotActionVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Action.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "buildingId",
-			In:   "path",
+			Name: "action",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetBuildingCalendarParams is parameters of getBuildingCalendar operation.
-type GetBuildingCalendarParams struct {
-	BuildingId uuid.UUID
-}
-
-func unpackGetBuildingCalendarParams(packed middleware.Parameters) (params GetBuildingCalendarParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "buildingId",
-			In:   "path",
-		}
-		params.BuildingId = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodeGetBuildingCalendarParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBuildingCalendarParams, _ error) {
-	// Decode path: buildingId.
+	// Decode query: startDate.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "startDate",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "buildingId",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStartDateVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStartDateVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.BuildingId = c
+				params.StartDate.SetTo(paramsDotStartDateVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "buildingId",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// GetCurrentOccupancyParams is parameters of getCurrentOccupancy operation.
-type GetCurrentOccupancyParams struct {
-	BuildingId OptUUID `json:",omitempty,omitzero"`
-	AreaId     OptUUID `json:",omitempty,omitzero"`
-}
-
-func unpackGetCurrentOccupancyParams(packed middleware.Parameters) (params GetCurrentOccupancyParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "buildingId",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.BuildingId = v.(OptUUID)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "areaId",
+			Name: "startDate",
 			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.AreaId = v.(OptUUID)
+			Err:  err,
 		}
 	}
-	return params
-}
-
-func decodeGetCurrentOccupancyParams(args [0]string, argsEscaped bool, r *http.Request) (params GetCurrentOccupancyParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: buildingId.
+	// Decode query: endDate.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "buildingId",
+			Name:    "endDate",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotBuildingIdVal uuid.UUID
+				var paramsDotEndDateVal time.Time
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToUUID(val)
+					c, err := conv.ToDateTime(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotBuildingIdVal = c
+					paramsDotEndDateVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.BuildingId.SetTo(paramsDotBuildingIdVal)
+				params.EndDate.SetTo(paramsDotEndDateVal)
 				return nil
 			}); err != nil {
 				return err
@@ -3235,138 +3404,174 @@ func decodeGetCurrentOccupancyParams(args [0]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "buildingId",
+			Name: "endDate",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: areaId.
+	// Set default value for query: page.
+	{
+		val := int(1)
+		params.Page.SetTo(val)
+	}
+	// Decode query: page.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "areaId",
+			Name:    "page",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotAreaIdVal uuid.UUID
+				var paramsDotPageVal int
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToUUID(val)
+					c, err := conv.ToInt(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotAreaIdVal = c
+					paramsDotPageVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.AreaId.SetTo(paramsDotAreaIdVal)
+				params.Page.SetTo(paramsDotPageVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Page.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "areaId",
+			Name: "page",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetEquipmentParams is parameters of getEquipment operation.
-type GetEquipmentParams struct {
-	EquipmentId uuid.UUID
-}
-
-func unpackGetEquipmentParams(packed middleware.Parameters) (params GetEquipmentParams) {
+	// Set default value for query: limit.
 	{
-		key := middleware.ParameterKey{
-			Name: "equipmentId",
-			In:   "path",
-		}
-		params.EquipmentId = packed[key].(uuid.UUID)
+		val := int(20)
+		params.Limit.SetTo(val)
 	}
-	return params
-}
-
-func decodeGetEquipmentParams(args [1]string, argsEscaped bool, r *http.Request) (params GetEquipmentParams, _ error) {
-	// Decode path: equipmentId.
+	// Decode query: limit.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "equipmentId",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.EquipmentId = c
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "equipmentId",
-			In:   "path",
+			Name: "limit",
+			In:   "query",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetGroupParams is parameters of getGroup operation.
-type GetGroupParams struct {
-	GroupId uuid.UUID
+// GetBuildingParams is parameters of getBuilding operation.
+type GetBuildingParams struct {
+	BuildingId uuid.UUID
 }
 
-func unpackGetGroupParams(packed middleware.Parameters) (params GetGroupParams) {
+func unpackGetBuildingParams(packed middleware.Parameters) (params GetBuildingParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "groupId",
+			Name: "buildingId",
 			In:   "path",
 		}
-		params.GroupId = packed[key].(uuid.UUID)
+		params.BuildingId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetGroupParams(args [1]string, argsEscaped bool, r *http.Request) (params GetGroupParams, _ error) {
-	// Decode path: groupId.
+func decodeGetBuildingParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBuildingParams, _ error) {
+	// Decode path: buildingId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -3378,7 +3583,7 @@ func decodeGetGroupParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "groupId",
+				Param:   "buildingId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -3395,7 +3600,7 @@ func decodeGetGroupParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 					return err
 				}
 
-				params.GroupId = c
+				params.BuildingId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -3406,7 +3611,7 @@ func decodeGetGroupParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "groupId",
+			Name: "buildingId",
 			In:   "path",
 			Err:  err,
 		}
@@ -3414,154 +3619,113 @@ func decodeGetGroupParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 	return params, nil
 }
 
-// GetGroupMembersParams is parameters of getGroupMembers operation.
-type GetGroupMembersParams struct {
-	GroupId uuid.UUID
+// GetBuildingAvailabilityParams is parameters of getBuildingAvailability operation.
+type GetBuildingAvailabilityParams struct {
+	StartDate  time.Time
+	EndDate    time.Time
+	BuildingId uuid.UUID
 }
 
-func unpackGetGroupMembersParams(packed middleware.Parameters) (params GetGroupMembersParams) {
+func unpackGetBuildingAvailabilityParams(packed middleware.Parameters) (params GetBuildingAvailabilityParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "groupId",
+			Name: "startDate",
+			In:   "query",
+		}
+		params.StartDate = packed[key].(time.Time)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "endDate",
+			In:   "query",
+		}
+		params.EndDate = packed[key].(time.Time)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "buildingId",
 			In:   "path",
 		}
-		params.GroupId = packed[key].(uuid.UUID)
+		params.BuildingId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetGroupMembersParams(args [1]string, argsEscaped bool, r *http.Request) (params GetGroupMembersParams, _ error) {
-	// Decode path: groupId.
+func decodeGetBuildingAvailabilityParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBuildingAvailabilityParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: startDate.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "startDate",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "groupId",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToDate(val)
 				if err != nil {
 					return err
 				}
 
-				params.GroupId = c
+				params.StartDate = c
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		} else {
-			return validate.ErrFieldRequired
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "groupId",
-			In:   "path",
+			Name: "startDate",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetGroupPermissionsParams is parameters of getGroupPermissions operation.
-type GetGroupPermissionsParams struct {
-	GroupId uuid.UUID
-}
-
-func unpackGetGroupPermissionsParams(packed middleware.Parameters) (params GetGroupPermissionsParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "groupId",
-			In:   "path",
-		}
-		params.GroupId = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodeGetGroupPermissionsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetGroupPermissionsParams, _ error) {
-	// Decode path: groupId.
+	// Decode query: endDate.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "endDate",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "groupId",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToDate(val)
 				if err != nil {
 					return err
 				}
 
-				params.GroupId = c
+				params.EndDate = c
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		} else {
-			return validate.ErrFieldRequired
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "groupId",
-			In:   "path",
+			Name: "endDate",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetPlaceParams is parameters of getPlace operation.
-type GetPlaceParams struct {
-	PlaceId uuid.UUID
-}
-
-func unpackGetPlaceParams(packed middleware.Parameters) (params GetPlaceParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "placeId",
-			In:   "path",
-		}
-		params.PlaceId = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodeGetPlaceParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPlaceParams, _ error) {
-	// Decode path: placeId.
+	// Decode path: buildingId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -3573,7 +3737,7 @@ func decodeGetPlaceParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "placeId",
+				Param:   "buildingId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -3590,7 +3754,7 @@ func decodeGetPlaceParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 					return err
 				}
 
-				params.PlaceId = c
+				params.BuildingId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -3601,7 +3765,7 @@ func decodeGetPlaceParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "placeId",
+			Name: "buildingId",
 			In:   "path",
 			Err:  err,
 		}
@@ -3609,198 +3773,35 @@ func decodeGetPlaceParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 	return params, nil
 }
 
-// GetPlaceAvailabilityParams is parameters of getPlaceAvailability operation.
-type GetPlaceAvailabilityParams struct {
-	StartDate time.Time
-	EndDate   time.Time
-	PlaceId   uuid.UUID
+// GetBuildingBlockingParams is parameters of getBuildingBlocking operation.
+type GetBuildingBlockingParams struct {
+	// Expand recurring rules for date range.
+	IncludeRecurring OptBool `json:",omitempty,omitzero"`
+	// Start date for expanding recurring rules.
+	StartDate OptDate `json:",omitempty,omitzero"`
+	// End date for expanding recurring rules.
+	EndDate    OptDate `json:",omitempty,omitzero"`
+	BuildingId uuid.UUID
 }
 
-func unpackGetPlaceAvailabilityParams(packed middleware.Parameters) (params GetPlaceAvailabilityParams) {
+func unpackGetBuildingBlockingParams(packed middleware.Parameters) (params GetBuildingBlockingParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "startDate",
+			Name: "includeRecurring",
 			In:   "query",
 		}
-		params.StartDate = packed[key].(time.Time)
+		if v, ok := packed[key]; ok {
+			params.IncludeRecurring = v.(OptBool)
+		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "endDate",
+			Name: "startDate",
 			In:   "query",
 		}
-		params.EndDate = packed[key].(time.Time)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "placeId",
-			In:   "path",
-		}
-		params.PlaceId = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodeGetPlaceAvailabilityParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPlaceAvailabilityParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: startDate.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "startDate",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToDate(val)
-				if err != nil {
-					return err
-				}
-
-				params.StartDate = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "startDate",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: endDate.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "endDate",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToDate(val)
-				if err != nil {
-					return err
-				}
-
-				params.EndDate = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "endDate",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode path: placeId.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "placeId",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToUUID(val)
-				if err != nil {
-					return err
-				}
-
-				params.PlaceId = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "placeId",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// GetPlaceBlockingParams is parameters of getPlaceBlocking operation.
-type GetPlaceBlockingParams struct {
-	// Include blocking inherited from area and building.
-	IncludeInherited OptBool `json:",omitempty,omitzero"`
-	// Expand recurring rules for date range.
-	IncludeRecurring OptBool `json:",omitempty,omitzero"`
-	StartDate        OptDate `json:",omitempty,omitzero"`
-	EndDate          OptDate `json:",omitempty,omitzero"`
-	PlaceId          uuid.UUID
-}
-
-func unpackGetPlaceBlockingParams(packed middleware.Parameters) (params GetPlaceBlockingParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "includeInherited",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.IncludeInherited = v.(OptBool)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "includeRecurring",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.IncludeRecurring = v.(OptBool)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "startDate",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.StartDate = v.(OptDate)
-		}
+		if v, ok := packed[key]; ok {
+			params.StartDate = v.(OptDate)
+		}
 	}
 	{
 		key := middleware.ParameterKey{
@@ -3813,62 +3814,16 @@ func unpackGetPlaceBlockingParams(packed middleware.Parameters) (params GetPlace
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "placeId",
+			Name: "buildingId",
 			In:   "path",
 		}
-		params.PlaceId = packed[key].(uuid.UUID)
+		params.BuildingId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetPlaceBlockingParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPlaceBlockingParams, _ error) {
+func decodeGetBuildingBlockingParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBuildingBlockingParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Set default value for query: includeInherited.
-	{
-		val := bool(false)
-		params.IncludeInherited.SetTo(val)
-	}
-	// Decode query: includeInherited.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "includeInherited",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotIncludeInheritedVal bool
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToBool(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotIncludeInheritedVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.IncludeInherited.SetTo(paramsDotIncludeInheritedVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "includeInherited",
-			In:   "query",
-			Err:  err,
-		}
-	}
 	// Set default value for query: includeRecurring.
 	{
 		val := bool(false)
@@ -3997,7 +3952,7 @@ func decodeGetPlaceBlockingParams(args [1]string, argsEscaped bool, r *http.Requ
 			Err:  err,
 		}
 	}
-	// Decode path: placeId.
+	// Decode path: buildingId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -4009,7 +3964,7 @@ func decodeGetPlaceBlockingParams(args [1]string, argsEscaped bool, r *http.Requ
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "placeId",
+				Param:   "buildingId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4026,7 +3981,7 @@ func decodeGetPlaceBlockingParams(args [1]string, argsEscaped bool, r *http.Requ
 					return err
 				}
 
-				params.PlaceId = c
+				params.BuildingId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4037,7 +3992,7 @@ func decodeGetPlaceBlockingParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "placeId",
+			Name: "buildingId",
 			In:   "path",
 			Err:  err,
 		}
@@ -4045,89 +4000,75 @@ func decodeGetPlaceBlockingParams(args [1]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// GetPlaceCalendarParams is parameters of getPlaceCalendar operation.
-type GetPlaceCalendarParams struct {
-	PlaceId uuid.UUID
+// GetBuildingCalendarParams is parameters of getBuildingCalendar operation.
+type GetBuildingCalendarParams struct {
+	// Entity tag of a previously fetched response.
+	IfNoneMatch OptString `json:",omitempty,omitzero"`
+	BuildingId  uuid.UUID
 }
 
-func unpackGetPlaceCalendarParams(packed middleware.Parameters) (params GetPlaceCalendarParams) {
+func unpackGetBuildingCalendarParams(packed middleware.Parameters) (params GetBuildingCalendarParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "placeId",
+			Name: "If-None-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfNoneMatch = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "buildingId",
 			In:   "path",
 		}
-		params.PlaceId = packed[key].(uuid.UUID)
+		params.BuildingId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetPlaceCalendarParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPlaceCalendarParams, _ error) {
-	// Decode path: placeId.
+func decodeGetBuildingCalendarParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBuildingCalendarParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: If-None-Match.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-None-Match",
+			Explode: false,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "placeId",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfNoneMatchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					paramsDotIfNoneMatchVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.PlaceId = c
+				params.IfNoneMatch.SetTo(paramsDotIfNoneMatchVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "placeId",
-			In:   "path",
+			Name: "If-None-Match",
+			In:   "header",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetPlaceConstraintsParams is parameters of getPlaceConstraints operation.
-type GetPlaceConstraintsParams struct {
-	PlaceId uuid.UUID
-}
-
-func unpackGetPlaceConstraintsParams(packed middleware.Parameters) (params GetPlaceConstraintsParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "placeId",
-			In:   "path",
-		}
-		params.PlaceId = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodeGetPlaceConstraintsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPlaceConstraintsParams, _ error) {
-	// Decode path: placeId.
+	// Decode path: buildingId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -4139,7 +4080,7 @@ func decodeGetPlaceConstraintsParams(args [1]string, argsEscaped bool, r *http.R
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "placeId",
+				Param:   "buildingId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4156,7 +4097,7 @@ func decodeGetPlaceConstraintsParams(args [1]string, argsEscaped bool, r *http.R
 					return err
 				}
 
-				params.PlaceId = c
+				params.BuildingId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4167,7 +4108,7 @@ func decodeGetPlaceConstraintsParams(args [1]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "placeId",
+			Name: "buildingId",
 			In:   "path",
 			Err:  err,
 		}
@@ -4175,24 +4116,24 @@ func decodeGetPlaceConstraintsParams(args [1]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// GetPlaceEquipmentParams is parameters of getPlaceEquipment operation.
-type GetPlaceEquipmentParams struct {
-	PlaceId uuid.UUID
+// GetBuildingConstraintsParams is parameters of getBuildingConstraints operation.
+type GetBuildingConstraintsParams struct {
+	BuildingId uuid.UUID
 }
 
-func unpackGetPlaceEquipmentParams(packed middleware.Parameters) (params GetPlaceEquipmentParams) {
+func unpackGetBuildingConstraintsParams(packed middleware.Parameters) (params GetBuildingConstraintsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "placeId",
+			Name: "buildingId",
 			In:   "path",
 		}
-		params.PlaceId = packed[key].(uuid.UUID)
+		params.BuildingId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetPlaceEquipmentParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPlaceEquipmentParams, _ error) {
-	// Decode path: placeId.
+func decodeGetBuildingConstraintsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBuildingConstraintsParams, _ error) {
+	// Decode path: buildingId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -4204,7 +4145,7 @@ func decodeGetPlaceEquipmentParams(args [1]string, argsEscaped bool, r *http.Req
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "placeId",
+				Param:   "buildingId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4221,7 +4162,7 @@ func decodeGetPlaceEquipmentParams(args [1]string, argsEscaped bool, r *http.Req
 					return err
 				}
 
-				params.PlaceId = c
+				params.BuildingId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4232,7 +4173,7 @@ func decodeGetPlaceEquipmentParams(args [1]string, argsEscaped bool, r *http.Req
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "placeId",
+			Name: "buildingId",
 			In:   "path",
 			Err:  err,
 		}
@@ -4240,66 +4181,47 @@ func decodeGetPlaceEquipmentParams(args [1]string, argsEscaped bool, r *http.Req
 	return params, nil
 }
 
-// GetPlaceQrCodeParams is parameters of getPlaceQrCode operation.
-type GetPlaceQrCodeParams struct {
-	TemplateId OptUUID                 `json:",omitempty,omitzero"`
-	Format     OptGetPlaceQrCodeFormat `json:",omitempty,omitzero"`
-	// Details to include (comma-separated).
-	IncludeDetails []GetPlaceQrCodeIncludeDetailsItem `json:",omitempty"`
-	PlaceId        uuid.UUID
+// GetCurrentOccupancyParams is parameters of getCurrentOccupancy operation.
+type GetCurrentOccupancyParams struct {
+	BuildingId OptUUID `json:",omitempty,omitzero"`
+	AreaId     OptUUID `json:",omitempty,omitzero"`
 }
 
-func unpackGetPlaceQrCodeParams(packed middleware.Parameters) (params GetPlaceQrCodeParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "templateId",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.TemplateId = v.(OptUUID)
-		}
-	}
+func unpackGetCurrentOccupancyParams(packed middleware.Parameters) (params GetCurrentOccupancyParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "format",
+			Name: "buildingId",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Format = v.(OptGetPlaceQrCodeFormat)
+			params.BuildingId = v.(OptUUID)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "includeDetails",
+			Name: "areaId",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.IncludeDetails = v.([]GetPlaceQrCodeIncludeDetailsItem)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "placeId",
-			In:   "path",
+			params.AreaId = v.(OptUUID)
 		}
-		params.PlaceId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetPlaceQrCodeParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPlaceQrCodeParams, _ error) {
+func decodeGetCurrentOccupancyParams(args [0]string, argsEscaped bool, r *http.Request) (params GetCurrentOccupancyParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: templateId.
+	// Decode query: buildingId.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "templateId",
+			Name:    "buildingId",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotTemplateIdVal uuid.UUID
+				var paramsDotBuildingIdVal uuid.UUID
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -4311,12 +4233,12 @@ func decodeGetPlaceQrCodeParams(args [1]string, argsEscaped bool, r *http.Reques
 						return err
 					}
 
-					paramsDotTemplateIdVal = c
+					paramsDotBuildingIdVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.TemplateId.SetTo(paramsDotTemplateIdVal)
+				params.BuildingId.SetTo(paramsDotBuildingIdVal)
 				return nil
 			}); err != nil {
 				return err
@@ -4325,137 +4247,72 @@ func decodeGetPlaceQrCodeParams(args [1]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "templateId",
+			Name: "buildingId",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: format.
-	{
-		val := GetPlaceQrCodeFormat("png")
-		params.Format.SetTo(val)
-	}
-	// Decode query: format.
+	// Decode query: areaId.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "format",
+			Name:    "areaId",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotFormatVal GetPlaceQrCodeFormat
+				var paramsDotAreaIdVal uuid.UUID
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToUUID(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotFormatVal = GetPlaceQrCodeFormat(c)
+					paramsDotAreaIdVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Format.SetTo(paramsDotFormatVal)
+				params.AreaId.SetTo(paramsDotAreaIdVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-			if err := func() error {
-				if value, ok := params.Format.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "format",
+			Name: "areaId",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: includeDetails.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "includeDetails",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				return d.DecodeArray(func(d uri.Decoder) error {
-					var paramsDotIncludeDetailsVal GetPlaceQrCodeIncludeDetailsItem
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
+	return params, nil
+}
 
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
+// GetEffectivePlaceConstraintsParams is parameters of getEffectivePlaceConstraints operation.
+type GetEffectivePlaceConstraintsParams struct {
+	PlaceId uuid.UUID
+}
 
-						paramsDotIncludeDetailsVal = GetPlaceQrCodeIncludeDetailsItem(c)
-						return nil
-					}(); err != nil {
-						return err
-					}
-					params.IncludeDetails = append(params.IncludeDetails, paramsDotIncludeDetailsVal)
-					return nil
-				})
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				var failures []validate.FieldError
-				for i, elem := range params.IncludeDetails {
-					if err := func() error {
-						if err := elem.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						failures = append(failures, validate.FieldError{
-							Name:  fmt.Sprintf("[%d]", i),
-							Error: err,
-						})
-					}
-				}
-				if len(failures) > 0 {
-					return &validate.Error{Fields: failures}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "includeDetails",
-			In:   "query",
-			Err:  err,
+func unpackGetEffectivePlaceConstraintsParams(packed middleware.Parameters) (params GetEffectivePlaceConstraintsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "placeId",
+			In:   "path",
 		}
+		params.PlaceId = packed[key].(uuid.UUID)
 	}
+	return params
+}
+
+func decodeGetEffectivePlaceConstraintsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetEffectivePlaceConstraintsParams, _ error) {
 	// Decode path: placeId.
 	if err := func() error {
 		param := args[0]
@@ -4504,24 +4361,24 @@ func decodeGetPlaceQrCodeParams(args [1]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// GetPlaceTimeSlotsParams is parameters of getPlaceTimeSlots operation.
-type GetPlaceTimeSlotsParams struct {
-	PlaceId uuid.UUID
+// GetEquipmentParams is parameters of getEquipment operation.
+type GetEquipmentParams struct {
+	EquipmentId uuid.UUID
 }
 
-func unpackGetPlaceTimeSlotsParams(packed middleware.Parameters) (params GetPlaceTimeSlotsParams) {
+func unpackGetEquipmentParams(packed middleware.Parameters) (params GetEquipmentParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "placeId",
+			Name: "equipmentId",
 			In:   "path",
 		}
-		params.PlaceId = packed[key].(uuid.UUID)
+		params.EquipmentId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetPlaceTimeSlotsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPlaceTimeSlotsParams, _ error) {
-	// Decode path: placeId.
+func decodeGetEquipmentParams(args [1]string, argsEscaped bool, r *http.Request) (params GetEquipmentParams, _ error) {
+	// Decode path: equipmentId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -4533,7 +4390,7 @@ func decodeGetPlaceTimeSlotsParams(args [1]string, argsEscaped bool, r *http.Req
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "placeId",
+				Param:   "equipmentId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4550,7 +4407,7 @@ func decodeGetPlaceTimeSlotsParams(args [1]string, argsEscaped bool, r *http.Req
 					return err
 				}
 
-				params.PlaceId = c
+				params.EquipmentId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4561,7 +4418,7 @@ func decodeGetPlaceTimeSlotsParams(args [1]string, argsEscaped bool, r *http.Req
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "placeId",
+			Name: "equipmentId",
 			In:   "path",
 			Err:  err,
 		}
@@ -4569,24 +4426,24 @@ func decodeGetPlaceTimeSlotsParams(args [1]string, argsEscaped bool, r *http.Req
 	return params, nil
 }
 
-// GetPlaceWhitelistParams is parameters of getPlaceWhitelist operation.
-type GetPlaceWhitelistParams struct {
-	PlaceId uuid.UUID
+// GetGroupParams is parameters of getGroup operation.
+type GetGroupParams struct {
+	GroupId uuid.UUID
 }
 
-func unpackGetPlaceWhitelistParams(packed middleware.Parameters) (params GetPlaceWhitelistParams) {
+func unpackGetGroupParams(packed middleware.Parameters) (params GetGroupParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "placeId",
+			Name: "groupId",
 			In:   "path",
 		}
-		params.PlaceId = packed[key].(uuid.UUID)
+		params.GroupId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetPlaceWhitelistParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPlaceWhitelistParams, _ error) {
-	// Decode path: placeId.
+func decodeGetGroupParams(args [1]string, argsEscaped bool, r *http.Request) (params GetGroupParams, _ error) {
+	// Decode path: groupId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -4598,7 +4455,7 @@ func decodeGetPlaceWhitelistParams(args [1]string, argsEscaped bool, r *http.Req
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "placeId",
+				Param:   "groupId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4615,7 +4472,7 @@ func decodeGetPlaceWhitelistParams(args [1]string, argsEscaped bool, r *http.Req
 					return err
 				}
 
-				params.PlaceId = c
+				params.GroupId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4626,7 +4483,7 @@ func decodeGetPlaceWhitelistParams(args [1]string, argsEscaped bool, r *http.Req
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "placeId",
+			Name: "groupId",
 			In:   "path",
 			Err:  err,
 		}
@@ -4634,24 +4491,24 @@ func decodeGetPlaceWhitelistParams(args [1]string, argsEscaped bool, r *http.Req
 	return params, nil
 }
 
-// GetQrTemplateParams is parameters of getQrTemplate operation.
-type GetQrTemplateParams struct {
-	TemplateId uuid.UUID
+// GetGroupMembersParams is parameters of getGroupMembers operation.
+type GetGroupMembersParams struct {
+	GroupId uuid.UUID
 }
 
-func unpackGetQrTemplateParams(packed middleware.Parameters) (params GetQrTemplateParams) {
+func unpackGetGroupMembersParams(packed middleware.Parameters) (params GetGroupMembersParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "templateId",
+			Name: "groupId",
 			In:   "path",
 		}
-		params.TemplateId = packed[key].(uuid.UUID)
+		params.GroupId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetQrTemplateParams(args [1]string, argsEscaped bool, r *http.Request) (params GetQrTemplateParams, _ error) {
-	// Decode path: templateId.
+func decodeGetGroupMembersParams(args [1]string, argsEscaped bool, r *http.Request) (params GetGroupMembersParams, _ error) {
+	// Decode path: groupId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -4663,7 +4520,7 @@ func decodeGetQrTemplateParams(args [1]string, argsEscaped bool, r *http.Request
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "templateId",
+				Param:   "groupId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4680,7 +4537,7 @@ func decodeGetQrTemplateParams(args [1]string, argsEscaped bool, r *http.Request
 					return err
 				}
 
-				params.TemplateId = c
+				params.GroupId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4691,7 +4548,7 @@ func decodeGetQrTemplateParams(args [1]string, argsEscaped bool, r *http.Request
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "templateId",
+			Name: "groupId",
 			In:   "path",
 			Err:  err,
 		}
@@ -4699,24 +4556,24 @@ func decodeGetQrTemplateParams(args [1]string, argsEscaped bool, r *http.Request
 	return params, nil
 }
 
-// GetReservationParams is parameters of getReservation operation.
-type GetReservationParams struct {
-	ReservationId uuid.UUID
+// GetGroupPermissionsParams is parameters of getGroupPermissions operation.
+type GetGroupPermissionsParams struct {
+	GroupId uuid.UUID
 }
 
-func unpackGetReservationParams(packed middleware.Parameters) (params GetReservationParams) {
+func unpackGetGroupPermissionsParams(packed middleware.Parameters) (params GetGroupPermissionsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "reservationId",
+			Name: "groupId",
 			In:   "path",
 		}
-		params.ReservationId = packed[key].(uuid.UUID)
+		params.GroupId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetReservationParams(args [1]string, argsEscaped bool, r *http.Request) (params GetReservationParams, _ error) {
-	// Decode path: reservationId.
+func decodeGetGroupPermissionsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetGroupPermissionsParams, _ error) {
+	// Decode path: groupId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -4728,7 +4585,7 @@ func decodeGetReservationParams(args [1]string, argsEscaped bool, r *http.Reques
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "reservationId",
+				Param:   "groupId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4745,7 +4602,7 @@ func decodeGetReservationParams(args [1]string, argsEscaped bool, r *http.Reques
 					return err
 				}
 
-				params.ReservationId = c
+				params.GroupId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4756,7 +4613,7 @@ func decodeGetReservationParams(args [1]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "reservationId",
+			Name: "groupId",
 			In:   "path",
 			Err:  err,
 		}
@@ -4764,24 +4621,24 @@ func decodeGetReservationParams(args [1]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// GetReservationShareLinkParams is parameters of getReservationShareLink operation.
-type GetReservationShareLinkParams struct {
-	ReservationId uuid.UUID
+// GetPlaceParams is parameters of getPlace operation.
+type GetPlaceParams struct {
+	PlaceId uuid.UUID
 }
 
-func unpackGetReservationShareLinkParams(packed middleware.Parameters) (params GetReservationShareLinkParams) {
+func unpackGetPlaceParams(packed middleware.Parameters) (params GetPlaceParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "reservationId",
+			Name: "placeId",
 			In:   "path",
 		}
-		params.ReservationId = packed[key].(uuid.UUID)
+		params.PlaceId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetReservationShareLinkParams(args [1]string, argsEscaped bool, r *http.Request) (params GetReservationShareLinkParams, _ error) {
-	// Decode path: reservationId.
+func decodeGetPlaceParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPlaceParams, _ error) {
+	// Decode path: placeId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -4793,7 +4650,7 @@ func decodeGetReservationShareLinkParams(args [1]string, argsEscaped bool, r *ht
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "reservationId",
+				Param:   "placeId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4810,7 +4667,7 @@ func decodeGetReservationShareLinkParams(args [1]string, argsEscaped bool, r *ht
 					return err
 				}
 
-				params.ReservationId = c
+				params.PlaceId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4821,7 +4678,7 @@ func decodeGetReservationShareLinkParams(args [1]string, argsEscaped bool, r *ht
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "reservationId",
+			Name: "placeId",
 			In:   "path",
 			Err:  err,
 		}
@@ -4829,24 +4686,14 @@ func decodeGetReservationShareLinkParams(args [1]string, argsEscaped bool, r *ht
 	return params, nil
 }
 
-// GetUsageStatisticsParams is parameters of getUsageStatistics operation.
-type GetUsageStatisticsParams struct {
-	Period     GetUsageStatisticsPeriod
-	StartDate  time.Time
-	EndDate    time.Time
-	BuildingId OptUUID `json:",omitempty,omitzero"`
-	AreaId     OptUUID `json:",omitempty,omitzero"`
-	PlaceId    OptUUID `json:",omitempty,omitzero"`
+// GetPlaceAvailabilityParams is parameters of getPlaceAvailability operation.
+type GetPlaceAvailabilityParams struct {
+	StartDate time.Time
+	EndDate   time.Time
+	PlaceId   uuid.UUID
 }
 
-func unpackGetUsageStatisticsParams(packed middleware.Parameters) (params GetUsageStatisticsParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "period",
-			In:   "query",
-		}
-		params.Period = packed[key].(GetUsageStatisticsPeriod)
-	}
+func unpackGetPlaceAvailabilityParams(packed middleware.Parameters) (params GetPlaceAvailabilityParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "startDate",
@@ -4861,42 +4708,22 @@ func unpackGetUsageStatisticsParams(packed middleware.Parameters) (params GetUsa
 		}
 		params.EndDate = packed[key].(time.Time)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "buildingId",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.BuildingId = v.(OptUUID)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "areaId",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.AreaId = v.(OptUUID)
-		}
-	}
 	{
 		key := middleware.ParameterKey{
 			Name: "placeId",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.PlaceId = v.(OptUUID)
+			In:   "path",
 		}
+		params.PlaceId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetUsageStatisticsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetUsageStatisticsParams, _ error) {
+func decodeGetPlaceAvailabilityParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPlaceAvailabilityParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: period.
+	// Decode query: startDate.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "period",
+			Name:    "startDate",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
@@ -4908,39 +4735,31 @@ func decodeGetUsageStatisticsParams(args [0]string, argsEscaped bool, r *http.Re
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToDate(val)
 				if err != nil {
 					return err
 				}
 
-				params.Period = GetUsageStatisticsPeriod(c)
+				params.StartDate = c
 				return nil
 			}); err != nil {
 				return err
 			}
-			if err := func() error {
-				if err := params.Period.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
 		} else {
 			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "period",
+			Name: "startDate",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: startDate.
+	// Decode query: endDate.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "startDate",
+			Name:    "endDate",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
@@ -4957,7 +4776,7 @@ func decodeGetUsageStatisticsParams(args [0]string, argsEscaped bool, r *http.Re
 					return err
 				}
 
-				params.StartDate = c
+				params.EndDate = c
 				return nil
 			}); err != nil {
 				return err
@@ -4968,116 +4787,152 @@ func decodeGetUsageStatisticsParams(args [0]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "startDate",
+			Name: "endDate",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: endDate.
+	// Decode path: placeId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "endDate",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "placeId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToDate(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.EndDate = c
+				params.PlaceId = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
-			return err
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "endDate",
-			In:   "query",
+			Name: "placeId",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: buildingId.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "buildingId",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotBuildingIdVal uuid.UUID
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+	return params, nil
+}
 
-					c, err := conv.ToUUID(val)
-					if err != nil {
-						return err
-					}
+// GetPlaceBlockingParams is parameters of getPlaceBlocking operation.
+type GetPlaceBlockingParams struct {
+	// Include blocking inherited from area and building.
+	IncludeInherited OptBool `json:",omitempty,omitzero"`
+	// Expand recurring rules for date range.
+	IncludeRecurring OptBool `json:",omitempty,omitzero"`
+	StartDate        OptDate `json:",omitempty,omitzero"`
+	EndDate          OptDate `json:",omitempty,omitzero"`
+	PlaceId          uuid.UUID
+}
 
-					paramsDotBuildingIdVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.BuildingId.SetTo(paramsDotBuildingIdVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "buildingId",
+func unpackGetPlaceBlockingParams(packed middleware.Parameters) (params GetPlaceBlockingParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "includeInherited",
 			In:   "query",
-			Err:  err,
 		}
-	}
-	// Decode query: areaId.
+		if v, ok := packed[key]; ok {
+			params.IncludeInherited = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "includeRecurring",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.IncludeRecurring = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "startDate",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.StartDate = v.(OptDate)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "endDate",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.EndDate = v.(OptDate)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "placeId",
+			In:   "path",
+		}
+		params.PlaceId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetPlaceBlockingParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPlaceBlockingParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: includeInherited.
+	{
+		val := bool(false)
+		params.IncludeInherited.SetTo(val)
+	}
+	// Decode query: includeInherited.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "areaId",
+			Name:    "includeInherited",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotAreaIdVal uuid.UUID
+				var paramsDotIncludeInheritedVal bool
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToUUID(val)
+					c, err := conv.ToBool(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotAreaIdVal = c
+					paramsDotIncludeInheritedVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.AreaId.SetTo(paramsDotAreaIdVal)
+				params.IncludeInherited.SetTo(paramsDotIncludeInheritedVal)
 				return nil
 			}); err != nil {
 				return err
@@ -5086,39 +4941,44 @@ func decodeGetUsageStatisticsParams(args [0]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "areaId",
+			Name: "includeInherited",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: placeId.
+	// Set default value for query: includeRecurring.
+	{
+		val := bool(false)
+		params.IncludeRecurring.SetTo(val)
+	}
+	// Decode query: includeRecurring.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "placeId",
+			Name:    "includeRecurring",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPlaceIdVal uuid.UUID
+				var paramsDotIncludeRecurringVal bool
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToUUID(val)
+					c, err := conv.ToBool(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotPlaceIdVal = c
+					paramsDotIncludeRecurringVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.PlaceId.SetTo(paramsDotPlaceIdVal)
+				params.IncludeRecurring.SetTo(paramsDotIncludeRecurringVal)
 				return nil
 			}); err != nil {
 				return err
@@ -5127,32 +4987,94 @@ func decodeGetUsageStatisticsParams(args [0]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "placeId",
+			Name: "includeRecurring",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
+	// Decode query: startDate.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "startDate",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-// GetUserParams is parameters of getUser operation.
-type GetUserParams struct {
-	UserId uuid.UUID
-}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStartDateVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-func unpackGetUserParams(packed middleware.Parameters) (params GetUserParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "userId",
-			In:   "path",
+					c, err := conv.ToDate(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStartDateVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.StartDate.SetTo(paramsDotStartDateVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "startDate",
+			In:   "query",
+			Err:  err,
 		}
-		params.UserId = packed[key].(uuid.UUID)
 	}
-	return params
-}
+	// Decode query: endDate.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "endDate",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-func decodeGetUserParams(args [1]string, argsEscaped bool, r *http.Request) (params GetUserParams, _ error) {
-	// Decode path: userId.
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEndDateVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDate(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEndDateVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.EndDate.SetTo(paramsDotEndDateVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "endDate",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode path: placeId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -5164,7 +5086,7 @@ func decodeGetUserParams(args [1]string, argsEscaped bool, r *http.Request) (par
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "userId",
+				Param:   "placeId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -5181,7 +5103,7 @@ func decodeGetUserParams(args [1]string, argsEscaped bool, r *http.Request) (par
 					return err
 				}
 
-				params.UserId = c
+				params.PlaceId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -5192,7 +5114,7 @@ func decodeGetUserParams(args [1]string, argsEscaped bool, r *http.Request) (par
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "userId",
+			Name: "placeId",
 			In:   "path",
 			Err:  err,
 		}
@@ -5200,24 +5122,75 @@ func decodeGetUserParams(args [1]string, argsEscaped bool, r *http.Request) (par
 	return params, nil
 }
 
-// GetUserGroupsParams is parameters of getUserGroups operation.
-type GetUserGroupsParams struct {
-	UserId uuid.UUID
+// GetPlaceCalendarParams is parameters of getPlaceCalendar operation.
+type GetPlaceCalendarParams struct {
+	// Entity tag of a previously fetched response.
+	IfNoneMatch OptString `json:",omitempty,omitzero"`
+	PlaceId     uuid.UUID
 }
 
-func unpackGetUserGroupsParams(packed middleware.Parameters) (params GetUserGroupsParams) {
+func unpackGetPlaceCalendarParams(packed middleware.Parameters) (params GetPlaceCalendarParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "userId",
+			Name: "If-None-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfNoneMatch = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "placeId",
 			In:   "path",
 		}
-		params.UserId = packed[key].(uuid.UUID)
+		params.PlaceId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetUserGroupsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetUserGroupsParams, _ error) {
-	// Decode path: userId.
+func decodeGetPlaceCalendarParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPlaceCalendarParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: If-None-Match.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-None-Match",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfNoneMatchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIfNoneMatchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IfNoneMatch.SetTo(paramsDotIfNoneMatchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "If-None-Match",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Decode path: placeId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -5229,7 +5202,7 @@ func decodeGetUserGroupsParams(args [1]string, argsEscaped bool, r *http.Request
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "userId",
+				Param:   "placeId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -5246,7 +5219,7 @@ func decodeGetUserGroupsParams(args [1]string, argsEscaped bool, r *http.Request
 					return err
 				}
 
-				params.UserId = c
+				params.PlaceId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -5257,7 +5230,7 @@ func decodeGetUserGroupsParams(args [1]string, argsEscaped bool, r *http.Request
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "userId",
+			Name: "placeId",
 			In:   "path",
 			Err:  err,
 		}
@@ -5265,127 +5238,89 @@ func decodeGetUserGroupsParams(args [1]string, argsEscaped bool, r *http.Request
 	return params, nil
 }
 
-// HandleOAuthCallbackParams is parameters of handleOAuthCallback operation.
-type HandleOAuthCallbackParams struct {
-	// Authorization code from GitLab.
-	Code string
-	// CSRF protection state.
-	State string
+// GetPlaceConstraintsParams is parameters of getPlaceConstraints operation.
+type GetPlaceConstraintsParams struct {
+	PlaceId uuid.UUID
 }
 
-func unpackHandleOAuthCallbackParams(packed middleware.Parameters) (params HandleOAuthCallbackParams) {
+func unpackGetPlaceConstraintsParams(packed middleware.Parameters) (params GetPlaceConstraintsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "code",
-			In:   "query",
+			Name: "placeId",
+			In:   "path",
 		}
-		params.Code = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "state",
-			In:   "query",
-		}
-		params.State = packed[key].(string)
+		params.PlaceId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeHandleOAuthCallbackParams(args [0]string, argsEscaped bool, r *http.Request) (params HandleOAuthCallbackParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: code.
+func decodeGetPlaceConstraintsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPlaceConstraintsParams, _ error) {
+	// Decode path: placeId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "code",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.Code = c
-				return nil
-			}); err != nil {
-				return err
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
 			}
-		} else {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "code",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: state.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "state",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "placeId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.State = c
+				params.PlaceId = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
-			return err
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "state",
-			In:   "query",
+			Name: "placeId",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// ListAreaPlacesParams is parameters of listAreaPlaces operation.
-type ListAreaPlacesParams struct {
-	AreaId uuid.UUID
+// GetPlaceEquipmentParams is parameters of getPlaceEquipment operation.
+type GetPlaceEquipmentParams struct {
+	PlaceId uuid.UUID
 }
 
-func unpackListAreaPlacesParams(packed middleware.Parameters) (params ListAreaPlacesParams) {
+func unpackGetPlaceEquipmentParams(packed middleware.Parameters) (params GetPlaceEquipmentParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "areaId",
+			Name: "placeId",
 			In:   "path",
 		}
-		params.AreaId = packed[key].(uuid.UUID)
+		params.PlaceId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeListAreaPlacesParams(args [1]string, argsEscaped bool, r *http.Request) (params ListAreaPlacesParams, _ error) {
-	// Decode path: areaId.
+func decodeGetPlaceEquipmentParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPlaceEquipmentParams, _ error) {
+	// Decode path: placeId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -5397,7 +5332,7 @@ func decodeListAreaPlacesParams(args [1]string, argsEscaped bool, r *http.Reques
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "areaId",
+				Param:   "placeId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -5414,7 +5349,7 @@ func decodeListAreaPlacesParams(args [1]string, argsEscaped bool, r *http.Reques
 					return err
 				}
 
-				params.AreaId = c
+				params.PlaceId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -5425,7 +5360,7 @@ func decodeListAreaPlacesParams(args [1]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "areaId",
+			Name: "placeId",
 			In:   "path",
 			Err:  err,
 		}
@@ -5433,60 +5368,68 @@ func decodeListAreaPlacesParams(args [1]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// ListAreasParams is parameters of listAreas operation.
-type ListAreasParams struct {
-	// Filter by building.
-	BuildingId OptUUID `json:",omitempty,omitzero"`
-	// Page number (1-indexed).
-	Page OptInt `json:",omitempty,omitzero"`
-	// Items per page.
-	Limit OptInt `json:",omitempty,omitzero"`
+// GetPlaceQrCodeParams is parameters of getPlaceQrCode operation.
+type GetPlaceQrCodeParams struct {
+	// QR template to render the label with instead of the default layout. includeDetails is ignored, the
+	// template decides which details are shown.
+	TemplateId OptUUID                 `json:",omitempty,omitzero"`
+	Format     OptGetPlaceQrCodeFormat `json:",omitempty,omitzero"`
+	// Details to include (comma-separated).
+	IncludeDetails []GetPlaceQrCodeIncludeDetailsItem `json:",omitempty"`
+	PlaceId        uuid.UUID
 }
 
-func unpackListAreasParams(packed middleware.Parameters) (params ListAreasParams) {
+func unpackGetPlaceQrCodeParams(packed middleware.Parameters) (params GetPlaceQrCodeParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "buildingId",
+			Name: "templateId",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.BuildingId = v.(OptUUID)
+			params.TemplateId = v.(OptUUID)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "page",
+			Name: "format",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Page = v.(OptInt)
+			params.Format = v.(OptGetPlaceQrCodeFormat)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
+			Name: "includeDetails",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
+			params.IncludeDetails = v.([]GetPlaceQrCodeIncludeDetailsItem)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "placeId",
+			In:   "path",
 		}
+		params.PlaceId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeListAreasParams(args [0]string, argsEscaped bool, r *http.Request) (params ListAreasParams, _ error) {
+func decodeGetPlaceQrCodeParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPlaceQrCodeParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: buildingId.
+	// Decode query: templateId.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "buildingId",
+			Name:    "templateId",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotBuildingIdVal uuid.UUID
+				var paramsDotTemplateIdVal uuid.UUID
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -5498,12 +5441,12 @@ func decodeListAreasParams(args [0]string, argsEscaped bool, r *http.Request) (p
 						return err
 					}
 
-					paramsDotBuildingIdVal = c
+					paramsDotTemplateIdVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.BuildingId.SetTo(paramsDotBuildingIdVal)
+				params.TemplateId.SetTo(paramsDotTemplateIdVal)
 				return nil
 			}); err != nil {
 				return err
@@ -5512,63 +5455,53 @@ func decodeListAreasParams(args [0]string, argsEscaped bool, r *http.Request) (p
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "buildingId",
+			Name: "templateId",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: page.
+	// Set default value for query: format.
 	{
-		val := int(1)
-		params.Page.SetTo(val)
+		val := GetPlaceQrCodeFormat("png")
+		params.Format.SetTo(val)
 	}
-	// Decode query: page.
+	// Decode query: format.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "page",
+			Name:    "format",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPageVal int
+				var paramsDotFormatVal GetPlaceQrCodeFormat
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotPageVal = c
+					paramsDotFormatVal = GetPlaceQrCodeFormat(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Page.SetTo(paramsDotPageVal)
+				params.Format.SetTo(paramsDotFormatVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Page.Get(); ok {
+				if value, ok := params.Format.Get(); ok {
 					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
+						if err := value.Validate(); err != nil {
+							return err
 						}
 						return nil
 					}(); err != nil {
@@ -5583,69 +5516,63 @@ func decodeListAreasParams(args [0]string, argsEscaped bool, r *http.Request) (p
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "page",
+			Name: "format",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
-	{
-		val := int(20)
-		params.Limit.SetTo(val)
-	}
-	// Decode query: limit.
+	// Decode query: includeDetails.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
+			Name:    "includeDetails",
 			Style:   uri.QueryStyleForm,
-			Explode: true,
+			Explode: false,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotIncludeDetailsVal GetPlaceQrCodeIncludeDetailsItem
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
 
-					c, err := conv.ToInt(val)
-					if err != nil {
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotIncludeDetailsVal = GetPlaceQrCodeIncludeDetailsItem(c)
+						return nil
+					}(); err != nil {
 						return err
 					}
-
-					paramsDotLimitVal = c
+					params.IncludeDetails = append(params.IncludeDetails, paramsDotIncludeDetailsVal)
 					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Limit.SetTo(paramsDotLimitVal)
-				return nil
+				})
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
+				var failures []validate.FieldError
+				for i, elem := range params.IncludeDetails {
 					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           100,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
+						if err := elem.Validate(); err != nil {
+							return err
 						}
 						return nil
 					}(); err != nil {
-						return err
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
 					}
 				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
 				return nil
 			}(); err != nil {
 				return err
@@ -5654,32 +5581,12 @@ func decodeListAreasParams(args [0]string, argsEscaped bool, r *http.Request) (p
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
+			Name: "includeDetails",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// ListBuildingAreasParams is parameters of listBuildingAreas operation.
-type ListBuildingAreasParams struct {
-	BuildingId uuid.UUID
-}
-
-func unpackListBuildingAreasParams(packed middleware.Parameters) (params ListBuildingAreasParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "buildingId",
-			In:   "path",
-		}
-		params.BuildingId = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodeListBuildingAreasParams(args [1]string, argsEscaped bool, r *http.Request) (params ListBuildingAreasParams, _ error) {
-	// Decode path: buildingId.
+	// Decode path: placeId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -5691,7 +5598,7 @@ func decodeListBuildingAreasParams(args [1]string, argsEscaped bool, r *http.Req
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "buildingId",
+				Param:   "placeId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -5708,7 +5615,7 @@ func decodeListBuildingAreasParams(args [1]string, argsEscaped bool, r *http.Req
 					return err
 				}
 
-				params.BuildingId = c
+				params.PlaceId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -5719,7 +5626,7 @@ func decodeListBuildingAreasParams(args [1]string, argsEscaped bool, r *http.Req
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "buildingId",
+			Name: "placeId",
 			In:   "path",
 			Err:  err,
 		}
@@ -5727,202 +5634,1658 @@ func decodeListBuildingAreasParams(args [1]string, argsEscaped bool, r *http.Req
 	return params, nil
 }
 
-// ListBuildingsParams is parameters of listBuildings operation.
-type ListBuildingsParams struct {
-	// Page number (1-indexed).
-	Page OptInt `json:",omitempty,omitzero"`
-	// Items per page.
-	Limit OptInt `json:",omitempty,omitzero"`
-	// Search by name.
-	Search OptString `json:",omitempty,omitzero"`
+// GetPlaceQrKeyParams is parameters of getPlaceQrKey operation.
+type GetPlaceQrKeyParams struct {
+	PlaceId uuid.UUID
 }
 
-func unpackListBuildingsParams(packed middleware.Parameters) (params ListBuildingsParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "page",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Page = v.(OptInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
-	}
+func unpackGetPlaceQrKeyParams(packed middleware.Parameters) (params GetPlaceQrKeyParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "search",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Search = v.(OptString)
+			Name: "placeId",
+			In:   "path",
 		}
+		params.PlaceId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeListBuildingsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListBuildingsParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Set default value for query: page.
-	{
-		val := int(1)
-		params.Page.SetTo(val)
-	}
-	// Decode query: page.
+func decodeGetPlaceQrKeyParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPlaceQrKeyParams, _ error) {
+	// Decode path: placeId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "page",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "placeId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPageVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotPageVal = c
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Page.SetTo(paramsDotPageVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Page.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.PlaceId = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "page",
-			In:   "query",
+			Name: "placeId",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
+	return params, nil
+}
+
+// GetPlaceTimeSlotsParams is parameters of getPlaceTimeSlots operation.
+type GetPlaceTimeSlotsParams struct {
+	PlaceId uuid.UUID
+}
+
+func unpackGetPlaceTimeSlotsParams(packed middleware.Parameters) (params GetPlaceTimeSlotsParams) {
 	{
-		val := int(20)
-		params.Limit.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "placeId",
+			In:   "path",
+		}
+		params.PlaceId = packed[key].(uuid.UUID)
 	}
-	// Decode query: limit.
+	return params
+}
+
+func decodeGetPlaceTimeSlotsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPlaceTimeSlotsParams, _ error) {
+	// Decode path: placeId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "placeId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           100,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.PlaceId = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "placeId",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: search.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "search",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	return params, nil
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSearchVal string
+// GetPlaceWhitelistParams is parameters of getPlaceWhitelist operation.
+type GetPlaceWhitelistParams struct {
+	PlaceId uuid.UUID
+}
+
+func unpackGetPlaceWhitelistParams(packed middleware.Parameters) (params GetPlaceWhitelistParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "placeId",
+			In:   "path",
+		}
+		params.PlaceId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetPlaceWhitelistParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPlaceWhitelistParams, _ error) {
+	// Decode path: placeId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "placeId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.PlaceId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "placeId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetQrTemplateParams is parameters of getQrTemplate operation.
+type GetQrTemplateParams struct {
+	TemplateId uuid.UUID
+}
+
+func unpackGetQrTemplateParams(packed middleware.Parameters) (params GetQrTemplateParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "templateId",
+			In:   "path",
+		}
+		params.TemplateId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetQrTemplateParams(args [1]string, argsEscaped bool, r *http.Request) (params GetQrTemplateParams, _ error) {
+	// Decode path: templateId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "templateId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.TemplateId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "templateId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetReservationParams is parameters of getReservation operation.
+type GetReservationParams struct {
+	ReservationId uuid.UUID
+}
+
+func unpackGetReservationParams(packed middleware.Parameters) (params GetReservationParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "reservationId",
+			In:   "path",
+		}
+		params.ReservationId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetReservationParams(args [1]string, argsEscaped bool, r *http.Request) (params GetReservationParams, _ error) {
+	// Decode path: reservationId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "reservationId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ReservationId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "reservationId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetReservationShareLinkParams is parameters of getReservationShareLink operation.
+type GetReservationShareLinkParams struct {
+	ReservationId uuid.UUID
+}
+
+func unpackGetReservationShareLinkParams(packed middleware.Parameters) (params GetReservationShareLinkParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "reservationId",
+			In:   "path",
+		}
+		params.ReservationId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetReservationShareLinkParams(args [1]string, argsEscaped bool, r *http.Request) (params GetReservationShareLinkParams, _ error) {
+	// Decode path: reservationId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "reservationId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ReservationId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "reservationId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetUsageStatisticsParams is parameters of getUsageStatistics operation.
+type GetUsageStatisticsParams struct {
+	Period     GetUsageStatisticsPeriod
+	StartDate  time.Time
+	EndDate    time.Time
+	BuildingId OptUUID `json:",omitempty,omitzero"`
+	AreaId     OptUUID `json:",omitempty,omitzero"`
+	PlaceId    OptUUID `json:",omitempty,omitzero"`
+}
+
+func unpackGetUsageStatisticsParams(packed middleware.Parameters) (params GetUsageStatisticsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "period",
+			In:   "query",
+		}
+		params.Period = packed[key].(GetUsageStatisticsPeriod)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "startDate",
+			In:   "query",
+		}
+		params.StartDate = packed[key].(time.Time)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "endDate",
+			In:   "query",
+		}
+		params.EndDate = packed[key].(time.Time)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "buildingId",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.BuildingId = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "areaId",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.AreaId = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "placeId",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PlaceId = v.(OptUUID)
+		}
+	}
+	return params
+}
+
+func decodeGetUsageStatisticsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetUsageStatisticsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: period.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "period",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Period = GetUsageStatisticsPeriod(c)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := params.Period.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "period",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: startDate.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "startDate",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToDate(val)
+				if err != nil {
+					return err
+				}
+
+				params.StartDate = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "startDate",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: endDate.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "endDate",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToDate(val)
+				if err != nil {
+					return err
+				}
+
+				params.EndDate = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "endDate",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: buildingId.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "buildingId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBuildingIdVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotBuildingIdVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.BuildingId.SetTo(paramsDotBuildingIdVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "buildingId",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: areaId.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "areaId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAreaIdVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAreaIdVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.AreaId.SetTo(paramsDotAreaIdVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "areaId",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: placeId.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "placeId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPlaceIdVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPlaceIdVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.PlaceId.SetTo(paramsDotPlaceIdVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "placeId",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetUserParams is parameters of getUser operation.
+type GetUserParams struct {
+	UserId uuid.UUID
+}
+
+func unpackGetUserParams(packed middleware.Parameters) (params GetUserParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "userId",
+			In:   "path",
+		}
+		params.UserId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetUserParams(args [1]string, argsEscaped bool, r *http.Request) (params GetUserParams, _ error) {
+	// Decode path: userId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.UserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "userId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetUserCalendarParams is parameters of getUserCalendar operation.
+type GetUserCalendarParams struct {
+	// Entity tag of a previously fetched response.
+	IfNoneMatch OptString `json:",omitempty,omitzero"`
+	// Secret calendar feed token of the user.
+	Token string
+}
+
+func unpackGetUserCalendarParams(packed middleware.Parameters) (params GetUserCalendarParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "If-None-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfNoneMatch = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "token",
+			In:   "path",
+		}
+		params.Token = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetUserCalendarParams(args [1]string, argsEscaped bool, r *http.Request) (params GetUserCalendarParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: If-None-Match.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-None-Match",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfNoneMatchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIfNoneMatchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IfNoneMatch.SetTo(paramsDotIfNoneMatchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "If-None-Match",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Decode path: token.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "token",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Token = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "token",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetUserGroupsParams is parameters of getUserGroups operation.
+type GetUserGroupsParams struct {
+	UserId uuid.UUID
+}
+
+func unpackGetUserGroupsParams(packed middleware.Parameters) (params GetUserGroupsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "userId",
+			In:   "path",
+		}
+		params.UserId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetUserGroupsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetUserGroupsParams, _ error) {
+	// Decode path: userId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.UserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "userId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// HandleOAuthCallbackParams is parameters of handleOAuthCallback operation.
+type HandleOAuthCallbackParams struct {
+	// Authorization code from GitLab.
+	Code string
+	// CSRF protection state.
+	State string
+	// State cookie set by /auth/login in the same browser.
+	RoomyOAuthState OptString `json:",omitempty,omitzero"`
+}
+
+func unpackHandleOAuthCallbackParams(packed middleware.Parameters) (params HandleOAuthCallbackParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "code",
+			In:   "query",
+		}
+		params.Code = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "state",
+			In:   "query",
+		}
+		params.State = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "roomy_oauth_state",
+			In:   "cookie",
+		}
+		if v, ok := packed[key]; ok {
+			params.RoomyOAuthState = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeHandleOAuthCallbackParams(args [0]string, argsEscaped bool, r *http.Request) (params HandleOAuthCallbackParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	c := uri.NewCookieDecoder(r)
+	// Decode query: code.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "code",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Code = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "code",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: state.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "state",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.State = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "state",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode cookie: roomy_oauth_state.
+	if err := func() error {
+		cfg := uri.CookieParameterDecodingConfig{
+			Name:    "roomy_oauth_state",
+			Explode: true,
+		}
+		if err := c.HasParam(cfg); err == nil {
+			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotRoomyOAuthStateVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotRoomyOAuthStateVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.RoomyOAuthState.SetTo(paramsDotRoomyOAuthStateVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "roomy_oauth_state",
+			In:   "cookie",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListAreaPlacesParams is parameters of listAreaPlaces operation.
+type ListAreaPlacesParams struct {
+	AreaId uuid.UUID
+}
+
+func unpackListAreaPlacesParams(packed middleware.Parameters) (params ListAreaPlacesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "areaId",
+			In:   "path",
+		}
+		params.AreaId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeListAreaPlacesParams(args [1]string, argsEscaped bool, r *http.Request) (params ListAreaPlacesParams, _ error) {
+	// Decode path: areaId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "areaId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.AreaId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "areaId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListAreasParams is parameters of listAreas operation.
+type ListAreasParams struct {
+	// Filter by building.
+	BuildingId OptUUID `json:",omitempty,omitzero"`
+	// Page number (1-indexed).
+	Page OptInt `json:",omitempty,omitzero"`
+	// Items per page.
+	Limit OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListAreasParams(packed middleware.Parameters) (params ListAreasParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "buildingId",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.BuildingId = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Page = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListAreasParams(args [0]string, argsEscaped bool, r *http.Request) (params ListAreasParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: buildingId.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "buildingId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBuildingIdVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotBuildingIdVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.BuildingId.SetTo(paramsDotBuildingIdVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "buildingId",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: page.
+	{
+		val := int(1)
+		params.Page.SetTo(val)
+	}
+	// Decode query: page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPageVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Page.SetTo(paramsDotPageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Page.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListBuildingAreasParams is parameters of listBuildingAreas operation.
+type ListBuildingAreasParams struct {
+	BuildingId uuid.UUID
+}
+
+func unpackListBuildingAreasParams(packed middleware.Parameters) (params ListBuildingAreasParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "buildingId",
+			In:   "path",
+		}
+		params.BuildingId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeListBuildingAreasParams(args [1]string, argsEscaped bool, r *http.Request) (params ListBuildingAreasParams, _ error) {
+	// Decode path: buildingId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "buildingId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.BuildingId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "buildingId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListBuildingsParams is parameters of listBuildings operation.
+type ListBuildingsParams struct {
+	// Page number (1-indexed).
+	Page OptInt `json:",omitempty,omitzero"`
+	// Items per page.
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Search by name.
+	Search OptString `json:",omitempty,omitzero"`
+}
+
+func unpackListBuildingsParams(packed middleware.Parameters) (params ListBuildingsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Page = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "search",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Search = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeListBuildingsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListBuildingsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: page.
+	{
+		val := int(1)
+		params.Page.SetTo(val)
+	}
+	// Decode query: page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPageVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Page.SetTo(paramsDotPageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Page.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: search.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "search",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSearchVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -5956,6 +7319,71 @@ func decodeListBuildingsParams(args [0]string, argsEscaped bool, r *http.Request
 	return params, nil
 }
 
+// ListGroupConstraintProfilesParams is parameters of listGroupConstraintProfiles operation.
+type ListGroupConstraintProfilesParams struct {
+	GroupId uuid.UUID
+}
+
+func unpackListGroupConstraintProfilesParams(packed middleware.Parameters) (params ListGroupConstraintProfilesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "groupId",
+			In:   "path",
+		}
+		params.GroupId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeListGroupConstraintProfilesParams(args [1]string, argsEscaped bool, r *http.Request) (params ListGroupConstraintProfilesParams, _ error) {
+	// Decode path: groupId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "groupId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.GroupId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "groupId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // ListPlacesParams is parameters of listPlaces operation.
 type ListPlacesParams struct {
 	AreaId     OptUUID   `json:",omitempty,omitzero"`
@@ -6715,84 +8143,263 @@ func unpackListReservationsParams(packed middleware.Parameters) (params ListRese
 			Name: "limit",
 			In:   "query",
 		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListReservationsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListReservationsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: userId.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "userId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUserIdVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotUserIdVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.UserId.SetTo(paramsDotUserIdVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "userId",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: placeId.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "placeId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPlaceIdVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPlaceIdVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.PlaceId.SetTo(paramsDotPlaceIdVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "placeId",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: areaId.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "areaId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAreaIdVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAreaIdVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.AreaId.SetTo(paramsDotAreaIdVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "areaId",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: buildingId.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "buildingId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBuildingIdVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotBuildingIdVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.BuildingId.SetTo(paramsDotBuildingIdVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "buildingId",
+			In:   "query",
+			Err:  err,
 		}
 	}
-	return params
-}
-
-func decodeListReservationsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListReservationsParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: userId.
+	// Decode query: status.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "userId",
+			Name:    "status",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotUserIdVal uuid.UUID
+				var paramsDotStatusVal ListReservationsStatus
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToUUID(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotUserIdVal = c
+					paramsDotStatusVal = ListReservationsStatus(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.UserId.SetTo(paramsDotUserIdVal)
+				params.Status.SetTo(paramsDotStatusVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Status.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "userId",
+			Name: "status",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: placeId.
+	// Decode query: startDate.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "placeId",
+			Name:    "startDate",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPlaceIdVal uuid.UUID
+				var paramsDotStartDateVal time.Time
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToUUID(val)
+					c, err := conv.ToDate(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotPlaceIdVal = c
+					paramsDotStartDateVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.PlaceId.SetTo(paramsDotPlaceIdVal)
+				params.StartDate.SetTo(paramsDotStartDateVal)
 				return nil
 			}); err != nil {
 				return err
@@ -6801,39 +8408,39 @@ func decodeListReservationsParams(args [0]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "placeId",
+			Name: "startDate",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: areaId.
+	// Decode query: endDate.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "areaId",
+			Name:    "endDate",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotAreaIdVal uuid.UUID
+				var paramsDotEndDateVal time.Time
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToUUID(val)
+					c, err := conv.ToDate(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotAreaIdVal = c
+					paramsDotEndDateVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.AreaId.SetTo(paramsDotAreaIdVal)
+				params.EndDate.SetTo(paramsDotEndDateVal)
 				return nil
 			}); err != nil {
 				return err
@@ -6842,89 +8449,134 @@ func decodeListReservationsParams(args [0]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "areaId",
+			Name: "endDate",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: buildingId.
+	// Set default value for query: page.
+	{
+		val := int(1)
+		params.Page.SetTo(val)
+	}
+	// Decode query: page.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "buildingId",
+			Name:    "page",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotBuildingIdVal uuid.UUID
+				var paramsDotPageVal int
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToUUID(val)
+					c, err := conv.ToInt(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotBuildingIdVal = c
+					paramsDotPageVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.BuildingId.SetTo(paramsDotBuildingIdVal)
+				params.Page.SetTo(paramsDotPageVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Page.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "buildingId",
+			Name: "page",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: status.
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "status",
+			Name:    "limit",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStatusVal ListReservationsStatus
+				var paramsDotLimitVal int
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToInt(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotStatusVal = ListReservationsStatus(c)
+					paramsDotLimitVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Status.SetTo(paramsDotStatusVal)
+				params.Limit.SetTo(paramsDotLimitVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Status.Get(); ok {
+				if value, ok := params.Limit.Get(); ok {
 					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
 						}
 						return nil
 					}(); err != nil {
@@ -6939,40 +8591,150 @@ func decodeListReservationsParams(args [0]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "status",
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListUsersParams is parameters of listUsers operation.
+type ListUsersParams struct {
+	// Search by email or name.
+	Search OptString `json:",omitempty,omitzero"`
+	// Filter by group IDs (comma-separated).
+	GroupIds []uuid.UUID        `json:",omitempty"`
+	Status   OptListUsersStatus `json:",omitempty,omitzero"`
+	// Page number (1-indexed).
+	Page OptInt `json:",omitempty,omitzero"`
+	// Items per page.
+	Limit OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListUsersParams(packed middleware.Parameters) (params ListUsersParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "search",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Search = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "groupIds",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.GroupIds = v.([]uuid.UUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.(OptListUsersStatus)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Page = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListUsersParams(args [0]string, argsEscaped bool, r *http.Request) (params ListUsersParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: search.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "search",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSearchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSearchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Search.SetTo(paramsDotSearchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "search",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: startDate.
+	// Decode query: groupIds.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "startDate",
+			Name:    "groupIds",
 			Style:   uri.QueryStyleForm,
-			Explode: true,
+			Explode: false,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStartDateVal time.Time
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotGroupIdsVal uuid.UUID
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
 
-					c, err := conv.ToDate(val)
-					if err != nil {
+						c, err := conv.ToUUID(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotGroupIdsVal = c
+						return nil
+					}(); err != nil {
 						return err
 					}
-
-					paramsDotStartDateVal = c
+					params.GroupIds = append(params.GroupIds, paramsDotGroupIdsVal)
 					return nil
-				}(); err != nil {
-					return err
-				}
-				params.StartDate.SetTo(paramsDotStartDateVal)
-				return nil
+				})
 			}); err != nil {
 				return err
 			}
@@ -6980,48 +8742,63 @@ func decodeListReservationsParams(args [0]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "startDate",
+			Name: "groupIds",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: endDate.
+	// Decode query: status.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "endDate",
+			Name:    "status",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotEndDateVal time.Time
+				var paramsDotStatusVal ListUsersStatus
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToDate(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotEndDateVal = c
+					paramsDotStatusVal = ListUsersStatus(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.EndDate.SetTo(paramsDotEndDateVal)
+				params.Status.SetTo(paramsDotStatusVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Status.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "endDate",
+			Name: "status",
 			In:   "query",
 			Err:  err,
 		}
@@ -7171,373 +8948,421 @@ func decodeListReservationsParams(args [0]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// ListUsersParams is parameters of listUsers operation.
-type ListUsersParams struct {
-	// Search by email or name.
-	Search OptString `json:",omitempty,omitzero"`
-	// Filter by group IDs (comma-separated).
-	GroupIds []uuid.UUID        `json:",omitempty"`
-	Status   OptListUsersStatus `json:",omitempty,omitzero"`
-	// Page number (1-indexed).
-	Page OptInt `json:",omitempty,omitzero"`
-	// Items per page.
-	Limit OptInt `json:",omitempty,omitzero"`
+// PreviewQrTemplateParams is parameters of previewQrTemplate operation.
+type PreviewQrTemplateParams struct {
+	Format     OptPreviewQrTemplateFormat `json:",omitempty,omitzero"`
+	TemplateId uuid.UUID
 }
 
-func unpackListUsersParams(packed middleware.Parameters) (params ListUsersParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "search",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Search = v.(OptString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "groupIds",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.GroupIds = v.([]uuid.UUID)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "status",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Status = v.(OptListUsersStatus)
-		}
-	}
+func unpackPreviewQrTemplateParams(packed middleware.Parameters) (params PreviewQrTemplateParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "page",
+			Name: "format",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Page = v.(OptInt)
+			params.Format = v.(OptPreviewQrTemplateFormat)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
+			Name: "templateId",
+			In:   "path",
 		}
+		params.TemplateId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeListUsersParams(args [0]string, argsEscaped bool, r *http.Request) (params ListUsersParams, _ error) {
+func decodePreviewQrTemplateParams(args [1]string, argsEscaped bool, r *http.Request) (params PreviewQrTemplateParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: search.
+	// Set default value for query: format.
+	{
+		val := PreviewQrTemplateFormat("html")
+		params.Format.SetTo(val)
+	}
+	// Decode query: format.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "search",
+			Name:    "format",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSearchVal string
+				var paramsDotFormatVal PreviewQrTemplateFormat
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFormatVal = PreviewQrTemplateFormat(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Format.SetTo(paramsDotFormatVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Format.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "format",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode path: templateId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "templateId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.TemplateId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "templateId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RemoveAreaBlockingEntriesParams is parameters of removeAreaBlockingEntries operation.
+type RemoveAreaBlockingEntriesParams struct {
+	AreaId uuid.UUID
+}
+
+func unpackRemoveAreaBlockingEntriesParams(packed middleware.Parameters) (params RemoveAreaBlockingEntriesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "areaId",
+			In:   "path",
+		}
+		params.AreaId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeRemoveAreaBlockingEntriesParams(args [1]string, argsEscaped bool, r *http.Request) (params RemoveAreaBlockingEntriesParams, _ error) {
+	// Decode path: areaId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "areaId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotSearchVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToUUID(val)
+				if err != nil {
 					return err
 				}
-				params.Search.SetTo(paramsDotSearchVal)
+
+				params.AreaId = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "search",
-			In:   "query",
+			Name: "areaId",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: groupIds.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "groupIds",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				return d.DecodeArray(func(d uri.Decoder) error {
-					var paramsDotGroupIdsVal uuid.UUID
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
+	return params, nil
+}
 
-						c, err := conv.ToUUID(val)
-						if err != nil {
-							return err
-						}
+// RemoveAreaRoomPlanMarkersParams is parameters of removeAreaRoomPlanMarkers operation.
+type RemoveAreaRoomPlanMarkersParams struct {
+	AreaId uuid.UUID
+}
 
-						paramsDotGroupIdsVal = c
-						return nil
-					}(); err != nil {
-						return err
-					}
-					params.GroupIds = append(params.GroupIds, paramsDotGroupIdsVal)
-					return nil
-				})
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "groupIds",
-			In:   "query",
-			Err:  err,
+func unpackRemoveAreaRoomPlanMarkersParams(packed middleware.Parameters) (params RemoveAreaRoomPlanMarkersParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "areaId",
+			In:   "path",
 		}
+		params.AreaId = packed[key].(uuid.UUID)
 	}
-	// Decode query: status.
+	return params
+}
+
+func decodeRemoveAreaRoomPlanMarkersParams(args [1]string, argsEscaped bool, r *http.Request) (params RemoveAreaRoomPlanMarkersParams, _ error) {
+	// Decode path: areaId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "status",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "areaId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStatusVal ListUsersStatus
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotStatusVal = ListUsersStatus(c)
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Status.SetTo(paramsDotStatusVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Status.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.AreaId = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "status",
-			In:   "query",
+			Name: "areaId",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: page.
+	return params, nil
+}
+
+// RemoveBuildingBlockingEntriesParams is parameters of removeBuildingBlockingEntries operation.
+type RemoveBuildingBlockingEntriesParams struct {
+	BuildingId uuid.UUID
+}
+
+func unpackRemoveBuildingBlockingEntriesParams(packed middleware.Parameters) (params RemoveBuildingBlockingEntriesParams) {
 	{
-		val := int(1)
-		params.Page.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "buildingId",
+			In:   "path",
+		}
+		params.BuildingId = packed[key].(uuid.UUID)
 	}
-	// Decode query: page.
+	return params
+}
+
+func decodeRemoveBuildingBlockingEntriesParams(args [1]string, argsEscaped bool, r *http.Request) (params RemoveBuildingBlockingEntriesParams, _ error) {
+	// Decode path: buildingId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "page",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "buildingId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPageVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotPageVal = c
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Page.SetTo(paramsDotPageVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Page.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.BuildingId = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "page",
-			In:   "query",
+			Name: "buildingId",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
+	return params, nil
+}
+
+// RemoveGroupPermissionsParams is parameters of removeGroupPermissions operation.
+type RemoveGroupPermissionsParams struct {
+	GroupId uuid.UUID
+}
+
+func unpackRemoveGroupPermissionsParams(packed middleware.Parameters) (params RemoveGroupPermissionsParams) {
 	{
-		val := int(20)
-		params.Limit.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "groupId",
+			In:   "path",
+		}
+		params.GroupId = packed[key].(uuid.UUID)
 	}
-	// Decode query: limit.
+	return params
+}
+
+func decodeRemoveGroupPermissionsParams(args [1]string, argsEscaped bool, r *http.Request) (params RemoveGroupPermissionsParams, _ error) {
+	// Decode path: groupId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "groupId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           100,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.GroupId = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "groupId",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// PreviewQrTemplateParams is parameters of previewQrTemplate operation.
-type PreviewQrTemplateParams struct {
-	TemplateId uuid.UUID
+// RemovePlaceBlockingEntriesParams is parameters of removePlaceBlockingEntries operation.
+type RemovePlaceBlockingEntriesParams struct {
+	PlaceId uuid.UUID
 }
 
-func unpackPreviewQrTemplateParams(packed middleware.Parameters) (params PreviewQrTemplateParams) {
+func unpackRemovePlaceBlockingEntriesParams(packed middleware.Parameters) (params RemovePlaceBlockingEntriesParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "templateId",
+			Name: "placeId",
 			In:   "path",
 		}
-		params.TemplateId = packed[key].(uuid.UUID)
+		params.PlaceId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodePreviewQrTemplateParams(args [1]string, argsEscaped bool, r *http.Request) (params PreviewQrTemplateParams, _ error) {
-	// Decode path: templateId.
+func decodeRemovePlaceBlockingEntriesParams(args [1]string, argsEscaped bool, r *http.Request) (params RemovePlaceBlockingEntriesParams, _ error) {
+	// Decode path: placeId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -7549,7 +9374,7 @@ func decodePreviewQrTemplateParams(args [1]string, argsEscaped bool, r *http.Req
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "templateId",
+				Param:   "placeId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -7566,7 +9391,7 @@ func decodePreviewQrTemplateParams(args [1]string, argsEscaped bool, r *http.Req
 					return err
 				}
 
-				params.TemplateId = c
+				params.PlaceId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -7577,7 +9402,7 @@ func decodePreviewQrTemplateParams(args [1]string, argsEscaped bool, r *http.Req
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "templateId",
+			Name: "placeId",
 			In:   "path",
 			Err:  err,
 		}
@@ -7585,24 +9410,24 @@ func decodePreviewQrTemplateParams(args [1]string, argsEscaped bool, r *http.Req
 	return params, nil
 }
 
-// RemoveAreaBlockingEntriesParams is parameters of removeAreaBlockingEntries operation.
-type RemoveAreaBlockingEntriesParams struct {
-	AreaId uuid.UUID
+// RemovePlaceEquipmentParams is parameters of removePlaceEquipment operation.
+type RemovePlaceEquipmentParams struct {
+	PlaceId uuid.UUID
 }
 
-func unpackRemoveAreaBlockingEntriesParams(packed middleware.Parameters) (params RemoveAreaBlockingEntriesParams) {
+func unpackRemovePlaceEquipmentParams(packed middleware.Parameters) (params RemovePlaceEquipmentParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "areaId",
+			Name: "placeId",
 			In:   "path",
 		}
-		params.AreaId = packed[key].(uuid.UUID)
+		params.PlaceId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeRemoveAreaBlockingEntriesParams(args [1]string, argsEscaped bool, r *http.Request) (params RemoveAreaBlockingEntriesParams, _ error) {
-	// Decode path: areaId.
+func decodeRemovePlaceEquipmentParams(args [1]string, argsEscaped bool, r *http.Request) (params RemovePlaceEquipmentParams, _ error) {
+	// Decode path: placeId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -7614,7 +9439,7 @@ func decodeRemoveAreaBlockingEntriesParams(args [1]string, argsEscaped bool, r *
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "areaId",
+				Param:   "placeId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -7631,7 +9456,7 @@ func decodeRemoveAreaBlockingEntriesParams(args [1]string, argsEscaped bool, r *
 					return err
 				}
 
-				params.AreaId = c
+				params.PlaceId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -7642,7 +9467,7 @@ func decodeRemoveAreaBlockingEntriesParams(args [1]string, argsEscaped bool, r *
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "areaId",
+			Name: "placeId",
 			In:   "path",
 			Err:  err,
 		}
@@ -7650,24 +9475,24 @@ func decodeRemoveAreaBlockingEntriesParams(args [1]string, argsEscaped bool, r *
 	return params, nil
 }
 
-// RemoveAreaRoomPlanMarkersParams is parameters of removeAreaRoomPlanMarkers operation.
-type RemoveAreaRoomPlanMarkersParams struct {
-	AreaId uuid.UUID
+// RemovePlaceWhitelistUsersParams is parameters of removePlaceWhitelistUsers operation.
+type RemovePlaceWhitelistUsersParams struct {
+	PlaceId uuid.UUID
 }
 
-func unpackRemoveAreaRoomPlanMarkersParams(packed middleware.Parameters) (params RemoveAreaRoomPlanMarkersParams) {
+func unpackRemovePlaceWhitelistUsersParams(packed middleware.Parameters) (params RemovePlaceWhitelistUsersParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "areaId",
+			Name: "placeId",
 			In:   "path",
 		}
-		params.AreaId = packed[key].(uuid.UUID)
+		params.PlaceId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeRemoveAreaRoomPlanMarkersParams(args [1]string, argsEscaped bool, r *http.Request) (params RemoveAreaRoomPlanMarkersParams, _ error) {
-	// Decode path: areaId.
+func decodeRemovePlaceWhitelistUsersParams(args [1]string, argsEscaped bool, r *http.Request) (params RemovePlaceWhitelistUsersParams, _ error) {
+	// Decode path: placeId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -7679,7 +9504,7 @@ func decodeRemoveAreaRoomPlanMarkersParams(args [1]string, argsEscaped bool, r *
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "areaId",
+				Param:   "placeId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -7696,7 +9521,7 @@ func decodeRemoveAreaRoomPlanMarkersParams(args [1]string, argsEscaped bool, r *
 					return err
 				}
 
-				params.AreaId = c
+				params.PlaceId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -7707,7 +9532,7 @@ func decodeRemoveAreaRoomPlanMarkersParams(args [1]string, argsEscaped bool, r *
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "areaId",
+			Name: "placeId",
 			In:   "path",
 			Err:  err,
 		}
@@ -7715,24 +9540,24 @@ func decodeRemoveAreaRoomPlanMarkersParams(args [1]string, argsEscaped bool, r *
 	return params, nil
 }
 
-// RemoveBuildingBlockingEntriesParams is parameters of removeBuildingBlockingEntries operation.
-type RemoveBuildingBlockingEntriesParams struct {
-	BuildingId uuid.UUID
+// RemoveUserGroupsParams is parameters of removeUserGroups operation.
+type RemoveUserGroupsParams struct {
+	UserId uuid.UUID
 }
 
-func unpackRemoveBuildingBlockingEntriesParams(packed middleware.Parameters) (params RemoveBuildingBlockingEntriesParams) {
+func unpackRemoveUserGroupsParams(packed middleware.Parameters) (params RemoveUserGroupsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "buildingId",
+			Name: "userId",
 			In:   "path",
 		}
-		params.BuildingId = packed[key].(uuid.UUID)
+		params.UserId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeRemoveBuildingBlockingEntriesParams(args [1]string, argsEscaped bool, r *http.Request) (params RemoveBuildingBlockingEntriesParams, _ error) {
-	// Decode path: buildingId.
+func decodeRemoveUserGroupsParams(args [1]string, argsEscaped bool, r *http.Request) (params RemoveUserGroupsParams, _ error) {
+	// Decode path: userId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -7744,7 +9569,7 @@ func decodeRemoveBuildingBlockingEntriesParams(args [1]string, argsEscaped bool,
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "buildingId",
+				Param:   "userId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -7761,7 +9586,7 @@ func decodeRemoveBuildingBlockingEntriesParams(args [1]string, argsEscaped bool,
 					return err
 				}
 
-				params.BuildingId = c
+				params.UserId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -7772,7 +9597,7 @@ func decodeRemoveBuildingBlockingEntriesParams(args [1]string, argsEscaped bool,
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "buildingId",
+			Name: "userId",
 			In:   "path",
 			Err:  err,
 		}
@@ -7780,24 +9605,24 @@ func decodeRemoveBuildingBlockingEntriesParams(args [1]string, argsEscaped bool,
 	return params, nil
 }
 
-// RemoveGroupPermissionsParams is parameters of removeGroupPermissions operation.
-type RemoveGroupPermissionsParams struct {
-	GroupId uuid.UUID
+// ReplaceAreaBlockingParams is parameters of replaceAreaBlocking operation.
+type ReplaceAreaBlockingParams struct {
+	AreaId uuid.UUID
 }
 
-func unpackRemoveGroupPermissionsParams(packed middleware.Parameters) (params RemoveGroupPermissionsParams) {
+func unpackReplaceAreaBlockingParams(packed middleware.Parameters) (params ReplaceAreaBlockingParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "groupId",
+			Name: "areaId",
 			In:   "path",
 		}
-		params.GroupId = packed[key].(uuid.UUID)
+		params.AreaId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeRemoveGroupPermissionsParams(args [1]string, argsEscaped bool, r *http.Request) (params RemoveGroupPermissionsParams, _ error) {
-	// Decode path: groupId.
+func decodeReplaceAreaBlockingParams(args [1]string, argsEscaped bool, r *http.Request) (params ReplaceAreaBlockingParams, _ error) {
+	// Decode path: areaId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -7809,7 +9634,7 @@ func decodeRemoveGroupPermissionsParams(args [1]string, argsEscaped bool, r *htt
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "groupId",
+				Param:   "areaId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -7826,7 +9651,7 @@ func decodeRemoveGroupPermissionsParams(args [1]string, argsEscaped bool, r *htt
 					return err
 				}
 
-				params.GroupId = c
+				params.AreaId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -7837,7 +9662,7 @@ func decodeRemoveGroupPermissionsParams(args [1]string, argsEscaped bool, r *htt
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "groupId",
+			Name: "areaId",
 			In:   "path",
 			Err:  err,
 		}
@@ -7845,24 +9670,24 @@ func decodeRemoveGroupPermissionsParams(args [1]string, argsEscaped bool, r *htt
 	return params, nil
 }
 
-// RemovePlaceBlockingEntriesParams is parameters of removePlaceBlockingEntries operation.
-type RemovePlaceBlockingEntriesParams struct {
-	PlaceId uuid.UUID
+// ReplaceBuildingBlockingParams is parameters of replaceBuildingBlocking operation.
+type ReplaceBuildingBlockingParams struct {
+	BuildingId uuid.UUID
 }
 
-func unpackRemovePlaceBlockingEntriesParams(packed middleware.Parameters) (params RemovePlaceBlockingEntriesParams) {
+func unpackReplaceBuildingBlockingParams(packed middleware.Parameters) (params ReplaceBuildingBlockingParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "placeId",
+			Name: "buildingId",
 			In:   "path",
 		}
-		params.PlaceId = packed[key].(uuid.UUID)
+		params.BuildingId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeRemovePlaceBlockingEntriesParams(args [1]string, argsEscaped bool, r *http.Request) (params RemovePlaceBlockingEntriesParams, _ error) {
-	// Decode path: placeId.
+func decodeReplaceBuildingBlockingParams(args [1]string, argsEscaped bool, r *http.Request) (params ReplaceBuildingBlockingParams, _ error) {
+	// Decode path: buildingId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -7874,7 +9699,7 @@ func decodeRemovePlaceBlockingEntriesParams(args [1]string, argsEscaped bool, r
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "placeId",
+				Param:   "buildingId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -7891,7 +9716,7 @@ func decodeRemovePlaceBlockingEntriesParams(args [1]string, argsEscaped bool, r
 					return err
 				}
 
-				params.PlaceId = c
+				params.BuildingId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -7902,7 +9727,7 @@ func decodeRemovePlaceBlockingEntriesParams(args [1]string, argsEscaped bool, r
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "placeId",
+			Name: "buildingId",
 			In:   "path",
 			Err:  err,
 		}
@@ -7910,12 +9735,12 @@ func decodeRemovePlaceBlockingEntriesParams(args [1]string, argsEscaped bool, r
 	return params, nil
 }
 
-// RemovePlaceEquipmentParams is parameters of removePlaceEquipment operation.
-type RemovePlaceEquipmentParams struct {
+// ReplacePlaceBlockingParams is parameters of replacePlaceBlocking operation.
+type ReplacePlaceBlockingParams struct {
 	PlaceId uuid.UUID
 }
 
-func unpackRemovePlaceEquipmentParams(packed middleware.Parameters) (params RemovePlaceEquipmentParams) {
+func unpackReplacePlaceBlockingParams(packed middleware.Parameters) (params ReplacePlaceBlockingParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "placeId",
@@ -7926,7 +9751,7 @@ func unpackRemovePlaceEquipmentParams(packed middleware.Parameters) (params Remo
 	return params
 }
 
-func decodeRemovePlaceEquipmentParams(args [1]string, argsEscaped bool, r *http.Request) (params RemovePlaceEquipmentParams, _ error) {
+func decodeReplacePlaceBlockingParams(args [1]string, argsEscaped bool, r *http.Request) (params ReplacePlaceBlockingParams, _ error) {
 	// Decode path: placeId.
 	if err := func() error {
 		param := args[0]
@@ -7975,24 +9800,24 @@ func decodeRemovePlaceEquipmentParams(args [1]string, argsEscaped bool, r *http.
 	return params, nil
 }
 
-// RemovePlaceWhitelistUsersParams is parameters of removePlaceWhitelistUsers operation.
-type RemovePlaceWhitelistUsersParams struct {
-	PlaceId uuid.UUID
+// RevokeApiKeyParams is parameters of revokeApiKey operation.
+type RevokeApiKeyParams struct {
+	KeyId uuid.UUID
 }
 
-func unpackRemovePlaceWhitelistUsersParams(packed middleware.Parameters) (params RemovePlaceWhitelistUsersParams) {
+func unpackRevokeApiKeyParams(packed middleware.Parameters) (params RevokeApiKeyParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "placeId",
+			Name: "keyId",
 			In:   "path",
 		}
-		params.PlaceId = packed[key].(uuid.UUID)
+		params.KeyId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeRemovePlaceWhitelistUsersParams(args [1]string, argsEscaped bool, r *http.Request) (params RemovePlaceWhitelistUsersParams, _ error) {
-	// Decode path: placeId.
+func decodeRevokeApiKeyParams(args [1]string, argsEscaped bool, r *http.Request) (params RevokeApiKeyParams, _ error) {
+	// Decode path: keyId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -8004,7 +9829,7 @@ func decodeRemovePlaceWhitelistUsersParams(args [1]string, argsEscaped bool, r *
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "placeId",
+				Param:   "keyId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -8021,7 +9846,7 @@ func decodeRemovePlaceWhitelistUsersParams(args [1]string, argsEscaped bool, r *
 					return err
 				}
 
-				params.PlaceId = c
+				params.KeyId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -8032,7 +9857,7 @@ func decodeRemovePlaceWhitelistUsersParams(args [1]string, argsEscaped bool, r *
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "placeId",
+			Name: "keyId",
 			In:   "path",
 			Err:  err,
 		}
@@ -8040,24 +9865,24 @@ func decodeRemovePlaceWhitelistUsersParams(args [1]string, argsEscaped bool, r *
 	return params, nil
 }
 
-// RemoveUserGroupsParams is parameters of removeUserGroups operation.
-type RemoveUserGroupsParams struct {
-	UserId uuid.UUID
-}
-
-func unpackRemoveUserGroupsParams(packed middleware.Parameters) (params RemoveUserGroupsParams) {
+// RotatePlaceQrKeyParams is parameters of rotatePlaceQrKey operation.
+type RotatePlaceQrKeyParams struct {
+	PlaceId uuid.UUID
+}
+
+func unpackRotatePlaceQrKeyParams(packed middleware.Parameters) (params RotatePlaceQrKeyParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "userId",
+			Name: "placeId",
 			In:   "path",
 		}
-		params.UserId = packed[key].(uuid.UUID)
+		params.PlaceId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeRemoveUserGroupsParams(args [1]string, argsEscaped bool, r *http.Request) (params RemoveUserGroupsParams, _ error) {
-	// Decode path: userId.
+func decodeRotatePlaceQrKeyParams(args [1]string, argsEscaped bool, r *http.Request) (params RotatePlaceQrKeyParams, _ error) {
+	// Decode path: placeId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -8069,7 +9894,7 @@ func decodeRemoveUserGroupsParams(args [1]string, argsEscaped bool, r *http.Requ
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "userId",
+				Param:   "placeId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -8086,7 +9911,7 @@ func decodeRemoveUserGroupsParams(args [1]string, argsEscaped bool, r *http.Requ
 					return err
 				}
 
-				params.UserId = c
+				params.PlaceId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -8097,7 +9922,7 @@ func decodeRemoveUserGroupsParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "userId",
+			Name: "placeId",
 			In:   "path",
 			Err:  err,
 		}
@@ -8105,12 +9930,12 @@ func decodeRemoveUserGroupsParams(args [1]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// ReplaceAreaBlockingParams is parameters of replaceAreaBlocking operation.
-type ReplaceAreaBlockingParams struct {
+// UpdateAreaParams is parameters of updateArea operation.
+type UpdateAreaParams struct {
 	AreaId uuid.UUID
 }
 
-func unpackReplaceAreaBlockingParams(packed middleware.Parameters) (params ReplaceAreaBlockingParams) {
+func unpackUpdateAreaParams(packed middleware.Parameters) (params UpdateAreaParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "areaId",
@@ -8121,7 +9946,7 @@ func unpackReplaceAreaBlockingParams(packed middleware.Parameters) (params Repla
 	return params
 }
 
-func decodeReplaceAreaBlockingParams(args [1]string, argsEscaped bool, r *http.Request) (params ReplaceAreaBlockingParams, _ error) {
+func decodeUpdateAreaParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateAreaParams, _ error) {
 	// Decode path: areaId.
 	if err := func() error {
 		param := args[0]
@@ -8170,24 +9995,24 @@ func decodeReplaceAreaBlockingParams(args [1]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// ReplaceBuildingBlockingParams is parameters of replaceBuildingBlocking operation.
-type ReplaceBuildingBlockingParams struct {
-	BuildingId uuid.UUID
+// UpdateAreaConstraintsParams is parameters of updateAreaConstraints operation.
+type UpdateAreaConstraintsParams struct {
+	AreaId uuid.UUID
 }
 
-func unpackReplaceBuildingBlockingParams(packed middleware.Parameters) (params ReplaceBuildingBlockingParams) {
+func unpackUpdateAreaConstraintsParams(packed middleware.Parameters) (params UpdateAreaConstraintsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "buildingId",
+			Name: "areaId",
 			In:   "path",
 		}
-		params.BuildingId = packed[key].(uuid.UUID)
+		params.AreaId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeReplaceBuildingBlockingParams(args [1]string, argsEscaped bool, r *http.Request) (params ReplaceBuildingBlockingParams, _ error) {
-	// Decode path: buildingId.
+func decodeUpdateAreaConstraintsParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateAreaConstraintsParams, _ error) {
+	// Decode path: areaId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -8199,7 +10024,7 @@ func decodeReplaceBuildingBlockingParams(args [1]string, argsEscaped bool, r *ht
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "buildingId",
+				Param:   "areaId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -8216,7 +10041,7 @@ func decodeReplaceBuildingBlockingParams(args [1]string, argsEscaped bool, r *ht
 					return err
 				}
 
-				params.BuildingId = c
+				params.AreaId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -8227,7 +10052,7 @@ func decodeReplaceBuildingBlockingParams(args [1]string, argsEscaped bool, r *ht
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "buildingId",
+			Name: "areaId",
 			In:   "path",
 			Err:  err,
 		}
@@ -8235,24 +10060,24 @@ func decodeReplaceBuildingBlockingParams(args [1]string, argsEscaped bool, r *ht
 	return params, nil
 }
 
-// ReplacePlaceBlockingParams is parameters of replacePlaceBlocking operation.
-type ReplacePlaceBlockingParams struct {
-	PlaceId uuid.UUID
+// UpdateAreaRoomPlanParams is parameters of updateAreaRoomPlan operation.
+type UpdateAreaRoomPlanParams struct {
+	AreaId uuid.UUID
 }
 
-func unpackReplacePlaceBlockingParams(packed middleware.Parameters) (params ReplacePlaceBlockingParams) {
+func unpackUpdateAreaRoomPlanParams(packed middleware.Parameters) (params UpdateAreaRoomPlanParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "placeId",
+			Name: "areaId",
 			In:   "path",
 		}
-		params.PlaceId = packed[key].(uuid.UUID)
+		params.AreaId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeReplacePlaceBlockingParams(args [1]string, argsEscaped bool, r *http.Request) (params ReplacePlaceBlockingParams, _ error) {
-	// Decode path: placeId.
+func decodeUpdateAreaRoomPlanParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateAreaRoomPlanParams, _ error) {
+	// Decode path: areaId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -8264,7 +10089,7 @@ func decodeReplacePlaceBlockingParams(args [1]string, argsEscaped bool, r *http.
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "placeId",
+				Param:   "areaId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -8281,7 +10106,7 @@ func decodeReplacePlaceBlockingParams(args [1]string, argsEscaped bool, r *http.
 					return err
 				}
 
-				params.PlaceId = c
+				params.AreaId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -8292,7 +10117,7 @@ func decodeReplacePlaceBlockingParams(args [1]string, argsEscaped bool, r *http.
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "placeId",
+			Name: "areaId",
 			In:   "path",
 			Err:  err,
 		}
@@ -8300,24 +10125,24 @@ func decodeReplacePlaceBlockingParams(args [1]string, argsEscaped bool, r *http.
 	return params, nil
 }
 
-// RevokeApiKeyParams is parameters of revokeApiKey operation.
-type RevokeApiKeyParams struct {
-	KeyId uuid.UUID
+// UpdateBuildingParams is parameters of updateBuilding operation.
+type UpdateBuildingParams struct {
+	BuildingId uuid.UUID
 }
 
-func unpackRevokeApiKeyParams(packed middleware.Parameters) (params RevokeApiKeyParams) {
+func unpackUpdateBuildingParams(packed middleware.Parameters) (params UpdateBuildingParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "keyId",
+			Name: "buildingId",
 			In:   "path",
 		}
-		params.KeyId = packed[key].(uuid.UUID)
+		params.BuildingId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeRevokeApiKeyParams(args [1]string, argsEscaped bool, r *http.Request) (params RevokeApiKeyParams, _ error) {
-	// Decode path: keyId.
+func decodeUpdateBuildingParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateBuildingParams, _ error) {
+	// Decode path: buildingId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -8329,7 +10154,7 @@ func decodeRevokeApiKeyParams(args [1]string, argsEscaped bool, r *http.Request)
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "keyId",
+				Param:   "buildingId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -8346,7 +10171,7 @@ func decodeRevokeApiKeyParams(args [1]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.KeyId = c
+				params.BuildingId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -8357,7 +10182,7 @@ func decodeRevokeApiKeyParams(args [1]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "keyId",
+			Name: "buildingId",
 			In:   "path",
 			Err:  err,
 		}
@@ -8365,24 +10190,24 @@ func decodeRevokeApiKeyParams(args [1]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// UpdateAreaParams is parameters of updateArea operation.
-type UpdateAreaParams struct {
-	AreaId uuid.UUID
+// UpdateBuildingConstraintsParams is parameters of updateBuildingConstraints operation.
+type UpdateBuildingConstraintsParams struct {
+	BuildingId uuid.UUID
 }
 
-func unpackUpdateAreaParams(packed middleware.Parameters) (params UpdateAreaParams) {
+func unpackUpdateBuildingConstraintsParams(packed middleware.Parameters) (params UpdateBuildingConstraintsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "areaId",
+			Name: "buildingId",
 			In:   "path",
 		}
-		params.AreaId = packed[key].(uuid.UUID)
+		params.BuildingId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeUpdateAreaParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateAreaParams, _ error) {
-	// Decode path: areaId.
+func decodeUpdateBuildingConstraintsParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateBuildingConstraintsParams, _ error) {
+	// Decode path: buildingId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -8394,7 +10219,7 @@ func decodeUpdateAreaParams(args [1]string, argsEscaped bool, r *http.Request) (
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "areaId",
+				Param:   "buildingId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -8411,7 +10236,7 @@ func decodeUpdateAreaParams(args [1]string, argsEscaped bool, r *http.Request) (
 					return err
 				}
 
-				params.AreaId = c
+				params.BuildingId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -8422,7 +10247,7 @@ func decodeUpdateAreaParams(args [1]string, argsEscaped bool, r *http.Request) (
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "areaId",
+			Name: "buildingId",
 			In:   "path",
 			Err:  err,
 		}
@@ -8430,24 +10255,24 @@ func decodeUpdateAreaParams(args [1]string, argsEscaped bool, r *http.Request) (
 	return params, nil
 }
 
-// UpdateAreaRoomPlanParams is parameters of updateAreaRoomPlan operation.
-type UpdateAreaRoomPlanParams struct {
-	AreaId uuid.UUID
+// UpdateEquipmentParams is parameters of updateEquipment operation.
+type UpdateEquipmentParams struct {
+	EquipmentId uuid.UUID
 }
 
-func unpackUpdateAreaRoomPlanParams(packed middleware.Parameters) (params UpdateAreaRoomPlanParams) {
+func unpackUpdateEquipmentParams(packed middleware.Parameters) (params UpdateEquipmentParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "areaId",
+			Name: "equipmentId",
 			In:   "path",
 		}
-		params.AreaId = packed[key].(uuid.UUID)
+		params.EquipmentId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeUpdateAreaRoomPlanParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateAreaRoomPlanParams, _ error) {
-	// Decode path: areaId.
+func decodeUpdateEquipmentParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateEquipmentParams, _ error) {
+	// Decode path: equipmentId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -8459,7 +10284,7 @@ func decodeUpdateAreaRoomPlanParams(args [1]string, argsEscaped bool, r *http.Re
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "areaId",
+				Param:   "equipmentId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -8476,7 +10301,7 @@ func decodeUpdateAreaRoomPlanParams(args [1]string, argsEscaped bool, r *http.Re
 					return err
 				}
 
-				params.AreaId = c
+				params.EquipmentId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -8487,7 +10312,7 @@ func decodeUpdateAreaRoomPlanParams(args [1]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "areaId",
+			Name: "equipmentId",
 			In:   "path",
 			Err:  err,
 		}
@@ -8495,24 +10320,24 @@ func decodeUpdateAreaRoomPlanParams(args [1]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
-// UpdateBuildingParams is parameters of updateBuilding operation.
-type UpdateBuildingParams struct {
-	BuildingId uuid.UUID
+// UpdateGroupParams is parameters of updateGroup operation.
+type UpdateGroupParams struct {
+	GroupId uuid.UUID
 }
 
-func unpackUpdateBuildingParams(packed middleware.Parameters) (params UpdateBuildingParams) {
+func unpackUpdateGroupParams(packed middleware.Parameters) (params UpdateGroupParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "buildingId",
+			Name: "groupId",
 			In:   "path",
 		}
-		params.BuildingId = packed[key].(uuid.UUID)
+		params.GroupId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeUpdateBuildingParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateBuildingParams, _ error) {
-	// Decode path: buildingId.
+func decodeUpdateGroupParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateGroupParams, _ error) {
+	// Decode path: groupId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -8524,7 +10349,7 @@ func decodeUpdateBuildingParams(args [1]string, argsEscaped bool, r *http.Reques
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "buildingId",
+				Param:   "groupId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -8541,7 +10366,7 @@ func decodeUpdateBuildingParams(args [1]string, argsEscaped bool, r *http.Reques
 					return err
 				}
 
-				params.BuildingId = c
+				params.GroupId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -8552,7 +10377,7 @@ func decodeUpdateBuildingParams(args [1]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "buildingId",
+			Name: "groupId",
 			In:   "path",
 			Err:  err,
 		}
@@ -8560,24 +10385,32 @@ func decodeUpdateBuildingParams(args [1]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// UpdateEquipmentParams is parameters of updateEquipment operation.
-type UpdateEquipmentParams struct {
-	EquipmentId uuid.UUID
+// UpdateGroupConstraintProfileParams is parameters of updateGroupConstraintProfile operation.
+type UpdateGroupConstraintProfileParams struct {
+	GroupId   uuid.UUID
+	ProfileId uuid.UUID
 }
 
-func unpackUpdateEquipmentParams(packed middleware.Parameters) (params UpdateEquipmentParams) {
+func unpackUpdateGroupConstraintProfileParams(packed middleware.Parameters) (params UpdateGroupConstraintProfileParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "equipmentId",
+			Name: "groupId",
 			In:   "path",
 		}
-		params.EquipmentId = packed[key].(uuid.UUID)
+		params.GroupId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "profileId",
+			In:   "path",
+		}
+		params.ProfileId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeUpdateEquipmentParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateEquipmentParams, _ error) {
-	// Decode path: equipmentId.
+func decodeUpdateGroupConstraintProfileParams(args [2]string, argsEscaped bool, r *http.Request) (params UpdateGroupConstraintProfileParams, _ error) {
+	// Decode path: groupId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -8589,7 +10422,7 @@ func decodeUpdateEquipmentParams(args [1]string, argsEscaped bool, r *http.Reque
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "equipmentId",
+				Param:   "groupId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -8606,7 +10439,7 @@ func decodeUpdateEquipmentParams(args [1]string, argsEscaped bool, r *http.Reque
 					return err
 				}
 
-				params.EquipmentId = c
+				params.GroupId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -8617,36 +10450,16 @@ func decodeUpdateEquipmentParams(args [1]string, argsEscaped bool, r *http.Reque
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "equipmentId",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// UpdateGroupParams is parameters of updateGroup operation.
-type UpdateGroupParams struct {
-	GroupId uuid.UUID
-}
-
-func unpackUpdateGroupParams(packed middleware.Parameters) (params UpdateGroupParams) {
-	{
-		key := middleware.ParameterKey{
 			Name: "groupId",
 			In:   "path",
+			Err:  err,
 		}
-		params.GroupId = packed[key].(uuid.UUID)
 	}
-	return params
-}
-
-func decodeUpdateGroupParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateGroupParams, _ error) {
-	// Decode path: groupId.
+	// Decode path: profileId.
 	if err := func() error {
-		param := args[0]
+		param := args[1]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
+			unescaped, err := url.PathUnescape(args[1])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -8654,7 +10467,7 @@ func decodeUpdateGroupParams(args [1]string, argsEscaped bool, r *http.Request)
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "groupId",
+				Param:   "profileId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -8671,7 +10484,7 @@ func decodeUpdateGroupParams(args [1]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.GroupId = c
+				params.ProfileId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -8682,7 +10495,7 @@ func decodeUpdateGroupParams(args [1]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "groupId",
+			Name: "profileId",
 			In:   "path",
 			Err:  err,
 		}
@@ -8952,10 +10765,23 @@ func decodeUpdateQrTemplateParams(args [1]string, argsEscaped bool, r *http.Requ
 
 // UpdateReservationParams is parameters of updateReservation operation.
 type UpdateReservationParams struct {
+	// Which occurrences of a recurring series the change applies to. Only
+	// upcoming, not yet cancelled or checked-in occurrences are affected
+	// besides the addressed one. Ignored for single reservations.
+	Scope         OptSeriesScopeParam `json:",omitempty,omitzero"`
 	ReservationId uuid.UUID
 }
 
 func unpackUpdateReservationParams(packed middleware.Parameters) (params UpdateReservationParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "scope",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Scope = v.(OptSeriesScopeParam)
+		}
+	}
 	{
 		key := middleware.ParameterKey{
 			Name: "reservationId",
@@ -8967,6 +10793,68 @@ func unpackUpdateReservationParams(packed middleware.Parameters) (params UpdateR
 }
 
 func decodeUpdateReservationParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateReservationParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: scope.
+	{
+		val := SeriesScopeParam("occurrence")
+		params.Scope.SetTo(val)
+	}
+	// Decode query: scope.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "scope",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotScopeVal SeriesScopeParam
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotScopeVal = SeriesScopeParam(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Scope.SetTo(paramsDotScopeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Scope.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "scope",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	// Decode path: reservationId.
 	if err := func() error {
 		param := args[0]
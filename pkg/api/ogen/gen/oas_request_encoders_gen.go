@@ -231,6 +231,20 @@ func encodeCreateGroupRequest(
 	return nil
 }
 
+func encodeCreateGroupConstraintProfileRequest(
+	req *ConstraintProfileRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeCreatePlaceRequest(
 	req *CreatePlaceRequest,
 	r *http.Request,
@@ -483,6 +497,20 @@ func encodeUpdateAreaRequest(
 	return nil
 }
 
+func encodeUpdateAreaConstraintsRequest(
+	req *PlaceConstraints,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUpdateAreaRoomPlanRequest(
 	req *UpdateAreaRoomPlanReq,
 	r *http.Request,
@@ -536,6 +564,20 @@ func encodeUpdateBuildingRequest(
 	return nil
 }
 
+func encodeUpdateBuildingConstraintsRequest(
+	req *PlaceConstraints,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUpdateCurrentUserNotificationsRequest(
 	req *NotificationPreferences,
 	r *http.Request,
@@ -578,6 +620,20 @@ func encodeUpdateGroupRequest(
 	return nil
 }
 
+func encodeUpdateGroupConstraintProfileRequest(
+	req *ConstraintProfileRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUpdatePlaceRequest(
 	req *UpdatePlaceRequest,
 	r *http.Request,
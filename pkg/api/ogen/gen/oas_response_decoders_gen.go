@@ -7,10 +7,13 @@ import (
 	"io"
 	"mime"
 	"net/http"
+	"net/url"
 
 	"github.com/go-faster/errors"
 	"github.com/go-faster/jx"
+	"github.com/ogen-go/ogen/conv"
 	"github.com/ogen-go/ogen/ogenerrors"
+	"github.com/ogen-go/ogen/uri"
 	"github.com/ogen-go/ogen/validate"
 )
 
@@ -1408,6 +1411,41 @@ func decodeCancelReservationResponse(resp *http.Response) (res CancelReservation
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
+	case 409:
+		// Code 409.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response CancelReservationConflict
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
 	}
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
@@ -1493,6 +1531,41 @@ func decodeCheckInReservationResponse(resp *http.Response) (res CheckInReservati
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
+	case 401:
+		// Code 401.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response CheckInReservationUnauthorized
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
 	case 403:
 		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -1563,6 +1636,41 @@ func decodeCheckInReservationResponse(resp *http.Response) (res CheckInReservati
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
+	case 409:
+		// Code 409.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response CheckInReservationConflict
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
 	}
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
@@ -2062,6 +2170,15 @@ func decodeCreateGroupResponse(resp *http.Response) (res CreateGroupRes, _ error
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -2140,7 +2257,7 @@ func decodeCreateGroupResponse(resp *http.Response) (res CreateGroupRes, _ error
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeCreatePlaceResponse(resp *http.Response) (res CreatePlaceRes, _ error) {
+func decodeCreateGroupConstraintProfileResponse(resp *http.Response) (res CreateGroupConstraintProfileRes, _ error) {
 	switch resp.StatusCode {
 	case 201:
 		// Code 201.
@@ -2156,7 +2273,7 @@ func decodeCreatePlaceResponse(resp *http.Response) (res CreatePlaceRes, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Place
+			var response ConstraintProfile
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2200,7 +2317,7 @@ func decodeCreatePlaceResponse(resp *http.Response) (res CreatePlaceRes, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response CreatePlaceBadRequest
+			var response CreateGroupConstraintProfileBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2235,7 +2352,7 @@ func decodeCreatePlaceResponse(resp *http.Response) (res CreatePlaceRes, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response CreatePlaceForbidden
+			var response CreateGroupConstraintProfileForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2256,14 +2373,8 @@ func decodeCreatePlaceResponse(resp *http.Response) (res CreatePlaceRes, _ error
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	return res, validate.UnexpectedStatusCodeWithResponse(resp)
-}
-
-func decodeCreateQrTemplateResponse(resp *http.Response) (res CreateQrTemplateRes, _ error) {
-	switch resp.StatusCode {
-	case 201:
-		// Code 201.
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -2276,7 +2387,7 @@ func decodeCreateQrTemplateResponse(resp *http.Response) (res CreateQrTemplateRe
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response QRTemplate
+			var response CreateGroupConstraintProfileNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2297,8 +2408,14 @@ func decodeCreateQrTemplateResponse(resp *http.Response) (res CreateQrTemplateRe
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 400:
-		// Code 400.
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeCreatePlaceResponse(resp *http.Response) (res CreatePlaceRes, _ error) {
+	switch resp.StatusCode {
+	case 201:
+		// Code 201.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -2311,7 +2428,7 @@ func decodeCreateQrTemplateResponse(resp *http.Response) (res CreateQrTemplateRe
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response CreateQrTemplateBadRequest
+			var response Place
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2328,12 +2445,21 @@ func decodeCreateQrTemplateResponse(resp *http.Response) (res CreateQrTemplateRe
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 403:
-		// Code 403.
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -2346,7 +2472,7 @@ func decodeCreateQrTemplateResponse(resp *http.Response) (res CreateQrTemplateRe
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response CreateQrTemplateForbidden
+			var response CreatePlaceBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2367,14 +2493,8 @@ func decodeCreateQrTemplateResponse(resp *http.Response) (res CreateQrTemplateRe
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	return res, validate.UnexpectedStatusCodeWithResponse(resp)
-}
-
-func decodeCreateReservationResponse(resp *http.Response) (res CreateReservationRes, _ error) {
-	switch resp.StatusCode {
-	case 201:
-		// Code 201.
+	case 403:
+		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -2387,7 +2507,7 @@ func decodeCreateReservationResponse(resp *http.Response) (res CreateReservation
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response CreateReservationCreated
+			var response CreatePlaceForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2404,21 +2524,18 @@ func decodeCreateReservationResponse(resp *http.Response) (res CreateReservation
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 400:
-		// Code 400.
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeCreateQrTemplateResponse(resp *http.Response) (res CreateQrTemplateRes, _ error) {
+	switch resp.StatusCode {
+	case 201:
+		// Code 201.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -2431,7 +2548,7 @@ func decodeCreateReservationResponse(resp *http.Response) (res CreateReservation
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response CreateReservationBadRequest
+			var response QRTemplate
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2452,8 +2569,8 @@ func decodeCreateReservationResponse(resp *http.Response) (res CreateReservation
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -2466,7 +2583,7 @@ func decodeCreateReservationResponse(resp *http.Response) (res CreateReservation
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response CreateReservationUnauthorized
+			var response CreateQrTemplateBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2501,7 +2618,7 @@ func decodeCreateReservationResponse(resp *http.Response) (res CreateReservation
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response CreateReservationForbidden
+			var response CreateQrTemplateForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2522,8 +2639,14 @@ func decodeCreateReservationResponse(resp *http.Response) (res CreateReservation
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 409:
-		// Code 409.
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeCreateReservationResponse(resp *http.Response) (res CreateReservationRes, _ error) {
+	switch resp.StatusCode {
+	case 201:
+		// Code 201.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -2536,7 +2659,7 @@ func decodeCreateReservationResponse(resp *http.Response) (res CreateReservation
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response CreateReservationConflict
+			var response CreateReservationCreated
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2553,21 +2676,21 @@ func decodeCreateReservationResponse(resp *http.Response) (res CreateReservation
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	return res, validate.UnexpectedStatusCodeWithResponse(resp)
-}
-
-func decodeDeleteAreaResponse(resp *http.Response) (res DeleteAreaRes, _ error) {
-	switch resp.StatusCode {
-	case 204:
-		// Code 204.
-		return &DeleteAreaNoContent{}, nil
-	case 403:
-		// Code 403.
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -2580,7 +2703,7 @@ func decodeDeleteAreaResponse(resp *http.Response) (res DeleteAreaRes, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DeleteAreaForbidden
+			var response CreateReservationBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2601,8 +2724,8 @@ func decodeDeleteAreaResponse(resp *http.Response) (res DeleteAreaRes, _ error)
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
+	case 401:
+		// Code 401.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -2615,7 +2738,7 @@ func decodeDeleteAreaResponse(resp *http.Response) (res DeleteAreaRes, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DeleteAreaNotFound
+			var response CreateReservationUnauthorized
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2636,15 +2759,6 @@ func decodeDeleteAreaResponse(resp *http.Response) (res DeleteAreaRes, _ error)
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	return res, validate.UnexpectedStatusCodeWithResponse(resp)
-}
-
-func decodeDeleteAreaRoomPlanResponse(resp *http.Response) (res DeleteAreaRoomPlanRes, _ error) {
-	switch resp.StatusCode {
-	case 204:
-		// Code 204.
-		return &DeleteAreaRoomPlanNoContent{}, nil
 	case 403:
 		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -2659,7 +2773,7 @@ func decodeDeleteAreaRoomPlanResponse(resp *http.Response) (res DeleteAreaRoomPl
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DeleteAreaRoomPlanForbidden
+			var response CreateReservationForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2680,8 +2794,8 @@ func decodeDeleteAreaRoomPlanResponse(resp *http.Response) (res DeleteAreaRoomPl
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
+	case 409:
+		// Code 409.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -2694,7 +2808,7 @@ func decodeDeleteAreaRoomPlanResponse(resp *http.Response) (res DeleteAreaRoomPl
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DeleteAreaRoomPlanNotFound
+			var response CreateReservationConflict
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2719,11 +2833,11 @@ func decodeDeleteAreaRoomPlanResponse(resp *http.Response) (res DeleteAreaRoomPl
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeDeleteBuildingResponse(resp *http.Response) (res DeleteBuildingRes, _ error) {
+func decodeDeleteAreaResponse(resp *http.Response) (res DeleteAreaRes, _ error) {
 	switch resp.StatusCode {
 	case 204:
 		// Code 204.
-		return &DeleteBuildingNoContent{}, nil
+		return &DeleteAreaNoContent{}, nil
 	case 403:
 		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -2738,7 +2852,7 @@ func decodeDeleteBuildingResponse(resp *http.Response) (res DeleteBuildingRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DeleteBuildingForbidden
+			var response DeleteAreaForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2773,7 +2887,7 @@ func decodeDeleteBuildingResponse(resp *http.Response) (res DeleteBuildingRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DeleteBuildingNotFound
+			var response DeleteAreaNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2798,11 +2912,11 @@ func decodeDeleteBuildingResponse(resp *http.Response) (res DeleteBuildingRes, _
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeDeleteEquipmentResponse(resp *http.Response) (res DeleteEquipmentRes, _ error) {
+func decodeDeleteAreaRoomPlanResponse(resp *http.Response) (res DeleteAreaRoomPlanRes, _ error) {
 	switch resp.StatusCode {
 	case 204:
 		// Code 204.
-		return &DeleteEquipmentNoContent{}, nil
+		return &DeleteAreaRoomPlanNoContent{}, nil
 	case 403:
 		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -2817,7 +2931,7 @@ func decodeDeleteEquipmentResponse(resp *http.Response) (res DeleteEquipmentRes,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DeleteEquipmentForbidden
+			var response DeleteAreaRoomPlanForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2852,7 +2966,7 @@ func decodeDeleteEquipmentResponse(resp *http.Response) (res DeleteEquipmentRes,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DeleteEquipmentNotFound
+			var response DeleteAreaRoomPlanNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2877,11 +2991,11 @@ func decodeDeleteEquipmentResponse(resp *http.Response) (res DeleteEquipmentRes,
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeDeleteGroupResponse(resp *http.Response) (res DeleteGroupRes, _ error) {
+func decodeDeleteBuildingResponse(resp *http.Response) (res DeleteBuildingRes, _ error) {
 	switch resp.StatusCode {
 	case 204:
 		// Code 204.
-		return &DeleteGroupNoContent{}, nil
+		return &DeleteBuildingNoContent{}, nil
 	case 403:
 		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -2896,7 +3010,7 @@ func decodeDeleteGroupResponse(resp *http.Response) (res DeleteGroupRes, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DeleteGroupForbidden
+			var response DeleteBuildingForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2931,7 +3045,7 @@ func decodeDeleteGroupResponse(resp *http.Response) (res DeleteGroupRes, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DeleteGroupNotFound
+			var response DeleteBuildingNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -2956,11 +3070,11 @@ func decodeDeleteGroupResponse(resp *http.Response) (res DeleteGroupRes, _ error
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeDeletePlaceResponse(resp *http.Response) (res DeletePlaceRes, _ error) {
+func decodeDeleteEquipmentResponse(resp *http.Response) (res DeleteEquipmentRes, _ error) {
 	switch resp.StatusCode {
 	case 204:
 		// Code 204.
-		return &DeletePlaceNoContent{}, nil
+		return &DeleteEquipmentNoContent{}, nil
 	case 403:
 		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -2975,7 +3089,7 @@ func decodeDeletePlaceResponse(resp *http.Response) (res DeletePlaceRes, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DeletePlaceForbidden
+			var response DeleteEquipmentForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3010,7 +3124,7 @@ func decodeDeletePlaceResponse(resp *http.Response) (res DeletePlaceRes, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DeletePlaceNotFound
+			var response DeleteEquipmentNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3035,11 +3149,11 @@ func decodeDeletePlaceResponse(resp *http.Response) (res DeletePlaceRes, _ error
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeDeleteQrTemplateResponse(resp *http.Response) (res DeleteQrTemplateRes, _ error) {
+func decodeDeleteGroupResponse(resp *http.Response) (res DeleteGroupRes, _ error) {
 	switch resp.StatusCode {
 	case 204:
 		// Code 204.
-		return &DeleteQrTemplateNoContent{}, nil
+		return &DeleteGroupNoContent{}, nil
 	case 403:
 		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -3054,7 +3168,7 @@ func decodeDeleteQrTemplateResponse(resp *http.Response) (res DeleteQrTemplateRe
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DeleteQrTemplateForbidden
+			var response DeleteGroupForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3089,7 +3203,7 @@ func decodeDeleteQrTemplateResponse(resp *http.Response) (res DeleteQrTemplateRe
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DeleteQrTemplateNotFound
+			var response DeleteGroupNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3114,10 +3228,13 @@ func decodeDeleteQrTemplateResponse(resp *http.Response) (res DeleteQrTemplateRe
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeDisableUserResponse(resp *http.Response) (res DisableUserRes, _ error) {
+func decodeDeleteGroupConstraintProfileResponse(resp *http.Response) (res DeleteGroupConstraintProfileRes, _ error) {
 	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 204:
+		// Code 204.
+		return &DeleteGroupConstraintProfileNoContent{}, nil
+	case 403:
+		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3130,7 +3247,7 @@ func decodeDisableUserResponse(resp *http.Response) (res DisableUserRes, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response User
+			var response DeleteGroupConstraintProfileForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3147,21 +3264,12 @@ func decodeDisableUserResponse(resp *http.Response) (res DisableUserRes, _ error
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 403:
-		// Code 403.
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3174,7 +3282,7 @@ func decodeDisableUserResponse(resp *http.Response) (res DisableUserRes, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DisableUserForbidden
+			var response DeleteGroupConstraintProfileNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3195,8 +3303,17 @@ func decodeDisableUserResponse(resp *http.Response) (res DisableUserRes, _ error
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeDeletePlaceResponse(resp *http.Response) (res DeletePlaceRes, _ error) {
+	switch resp.StatusCode {
+	case 204:
+		// Code 204.
+		return &DeletePlaceNoContent{}, nil
+	case 403:
+		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3209,7 +3326,7 @@ func decodeDisableUserResponse(resp *http.Response) (res DisableUserRes, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response DisableUserNotFound
+			var response DeletePlaceForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3230,14 +3347,8 @@ func decodeDisableUserResponse(resp *http.Response) (res DisableUserRes, _ error
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	return res, validate.UnexpectedStatusCodeWithResponse(resp)
-}
-
-func decodeEnableUserResponse(resp *http.Response) (res EnableUserRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3250,7 +3361,7 @@ func decodeEnableUserResponse(resp *http.Response) (res EnableUserRes, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response User
+			var response DeletePlaceNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3267,19 +3378,19 @@ func decodeEnableUserResponse(resp *http.Response) (res EnableUserRes, _ error)
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeDeleteQrTemplateResponse(resp *http.Response) (res DeleteQrTemplateRes, _ error) {
+	switch resp.StatusCode {
+	case 204:
+		// Code 204.
+		return &DeleteQrTemplateNoContent{}, nil
 	case 403:
 		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -3294,7 +3405,7 @@ func decodeEnableUserResponse(resp *http.Response) (res EnableUserRes, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response EnableUserForbidden
+			var response DeleteQrTemplateForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3329,7 +3440,7 @@ func decodeEnableUserResponse(resp *http.Response) (res EnableUserRes, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response EnableUserNotFound
+			var response DeleteQrTemplateNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3354,7 +3465,7 @@ func decodeEnableUserResponse(resp *http.Response) (res EnableUserRes, _ error)
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeExportReservationsResponse(resp *http.Response) (res ExportReservationsRes, _ error) {
+func decodeDisableUserResponse(resp *http.Response) (res DisableUserRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -3363,25 +3474,6 @@ func decodeExportReservationsResponse(resp *http.Response) (res ExportReservatio
 			return res, errors.Wrap(err, "parse media type")
 		}
 		switch {
-		case ct == "text/csv":
-			reader := resp.Body
-			b, err := io.ReadAll(reader)
-			if err != nil {
-				return res, err
-			}
-
-			response := ExportReservationsOK{Data: bytes.NewReader(b)}
-			return &response, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
-	case 403:
-		// Code 403.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
 		case ct == "application/json":
 			buf, err := io.ReadAll(resp.Body)
 			if err != nil {
@@ -3389,7 +3481,7 @@ func decodeExportReservationsResponse(resp *http.Response) (res ExportReservatio
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ErrorResponse
+			var response User
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3406,18 +3498,21 @@ func decodeExportReservationsResponse(resp *http.Response) (res ExportReservatio
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	return res, validate.UnexpectedStatusCodeWithResponse(resp)
-}
-
-func decodeGetAreaResponse(resp *http.Response) (res GetAreaRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 403:
+		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3430,7 +3525,7 @@ func decodeGetAreaResponse(resp *http.Response) (res GetAreaRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Area
+			var response DisableUserForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3447,15 +3542,6 @@ func decodeGetAreaResponse(resp *http.Response) (res GetAreaRes, _ error) {
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -3474,7 +3560,7 @@ func decodeGetAreaResponse(resp *http.Response) (res GetAreaRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ErrorResponse
+			var response DisableUserNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3499,7 +3585,7 @@ func decodeGetAreaResponse(resp *http.Response) (res GetAreaRes, _ error) {
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetAreaAvailabilityResponse(resp *http.Response) (res GetAreaAvailabilityRes, _ error) {
+func decodeEnableUserResponse(resp *http.Response) (res EnableUserRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -3515,7 +3601,7 @@ func decodeGetAreaAvailabilityResponse(resp *http.Response) (res GetAreaAvailabi
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetAreaAvailabilityOKApplicationJSON
+			var response User
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3545,49 +3631,8 @@ func decodeGetAreaAvailabilityResponse(resp *http.Response) (res GetAreaAvailabi
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return res, err
-			}
-			d := jx.DecodeBytes(buf)
-
-			var response ErrorResponse
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
-				}
-				return res, err
-			}
-			return &response, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
-	}
-	return res, validate.UnexpectedStatusCodeWithResponse(resp)
-}
-
-func decodeGetAreaBlockingResponse(resp *http.Response) (res GetAreaBlockingRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 403:
+		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3600,7 +3645,7 @@ func decodeGetAreaBlockingResponse(resp *http.Response) (res GetAreaBlockingRes,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetAreaBlockingOKApplicationJSON
+			var response EnableUserForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3617,15 +3662,6 @@ func decodeGetAreaBlockingResponse(resp *http.Response) (res GetAreaBlockingRes,
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -3644,7 +3680,7 @@ func decodeGetAreaBlockingResponse(resp *http.Response) (res GetAreaBlockingRes,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ErrorResponse
+			var response EnableUserNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3669,7 +3705,7 @@ func decodeGetAreaBlockingResponse(resp *http.Response) (res GetAreaBlockingRes,
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetAreaCalendarResponse(resp *http.Response) (res GetAreaCalendarRes, _ error) {
+func decodeExportAreaQrCodesResponse(resp *http.Response) (res ExportAreaQrCodesRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -3678,20 +3714,29 @@ func decodeGetAreaCalendarResponse(resp *http.Response) (res GetAreaCalendarRes,
 			return res, errors.Wrap(err, "parse media type")
 		}
 		switch {
-		case ct == "text/calendar":
+		case ct == "application/pdf":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
+
+			response := QrCodeExportApplicationPdf{Data: bytes.NewReader(b)}
+			return &response, nil
+		case ct == "application/zip":
 			reader := resp.Body
 			b, err := io.ReadAll(reader)
 			if err != nil {
 				return res, err
 			}
 
-			response := GetAreaCalendarOK{Data: bytes.NewReader(b)}
+			response := QrCodeExportApplicationZip{Data: bytes.NewReader(b)}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3704,7 +3749,7 @@ func decodeGetAreaCalendarResponse(resp *http.Response) (res GetAreaCalendarRes,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ErrorResponse
+			var response ExportAreaQrCodesBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3725,14 +3770,8 @@ func decodeGetAreaCalendarResponse(resp *http.Response) (res GetAreaCalendarRes,
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	return res, validate.UnexpectedStatusCodeWithResponse(resp)
-}
-
-func decodeGetAreaRoomPlanResponse(resp *http.Response) (res GetAreaRoomPlanRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 403:
+		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3745,7 +3784,7 @@ func decodeGetAreaRoomPlanResponse(resp *http.Response) (res GetAreaRoomPlanRes,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response RoomPlan
+			var response ExportAreaQrCodesForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3762,15 +3801,6 @@ func decodeGetAreaRoomPlanResponse(resp *http.Response) (res GetAreaRoomPlanRes,
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -3789,7 +3819,7 @@ func decodeGetAreaRoomPlanResponse(resp *http.Response) (res GetAreaRoomPlanRes,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ErrorResponse
+			var response ExportAreaQrCodesNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3814,7 +3844,7 @@ func decodeGetAreaRoomPlanResponse(resp *http.Response) (res GetAreaRoomPlanRes,
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetAuditLogResponse(resp *http.Response) (res GetAuditLogRes, _ error) {
+func decodeExportBuildingQrCodesResponse(resp *http.Response) (res ExportBuildingQrCodesRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -3823,6 +3853,34 @@ func decodeGetAuditLogResponse(resp *http.Response) (res GetAuditLogRes, _ error
 			return res, errors.Wrap(err, "parse media type")
 		}
 		switch {
+		case ct == "application/pdf":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
+
+			response := QrCodeExportApplicationPdf{Data: bytes.NewReader(b)}
+			return &response, nil
+		case ct == "application/zip":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
+
+			response := QrCodeExportApplicationZip{Data: bytes.NewReader(b)}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 400:
+		// Code 400.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
 		case ct == "application/json":
 			buf, err := io.ReadAll(resp.Body)
 			if err != nil {
@@ -3830,7 +3888,7 @@ func decodeGetAuditLogResponse(resp *http.Response) (res GetAuditLogRes, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response PaginatedAuditLogList
+			var response ExportBuildingQrCodesBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3847,15 +3905,6 @@ func decodeGetAuditLogResponse(resp *http.Response) (res GetAuditLogRes, _ error
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -3874,7 +3923,7 @@ func decodeGetAuditLogResponse(resp *http.Response) (res GetAuditLogRes, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ErrorResponse
+			var response ExportBuildingQrCodesForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3895,14 +3944,8 @@ func decodeGetAuditLogResponse(resp *http.Response) (res GetAuditLogRes, _ error
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	return res, validate.UnexpectedStatusCodeWithResponse(resp)
-}
-
-func decodeGetBuildingResponse(resp *http.Response) (res GetBuildingRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3915,7 +3958,7 @@ func decodeGetBuildingResponse(resp *http.Response) (res GetBuildingRes, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Building
+			var response ExportBuildingQrCodesNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -3936,8 +3979,33 @@ func decodeGetBuildingResponse(resp *http.Response) (res GetBuildingRes, _ error
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeExportReservationsResponse(resp *http.Response) (res ExportReservationsRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "text/csv":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
+
+			response := ExportReservationsOK{Data: bytes.NewReader(b)}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 403:
+		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -3975,7 +4043,7 @@ func decodeGetBuildingResponse(resp *http.Response) (res GetBuildingRes, _ error
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetBuildingAvailabilityResponse(resp *http.Response) (res GetBuildingAvailabilityRes, _ error) {
+func decodeGetAreaResponse(resp *http.Response) (res GetAreaRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -3991,7 +4059,7 @@ func decodeGetBuildingAvailabilityResponse(resp *http.Response) (res GetBuilding
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetBuildingAvailabilityOKApplicationJSON
+			var response Area
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4060,7 +4128,7 @@ func decodeGetBuildingAvailabilityResponse(resp *http.Response) (res GetBuilding
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetBuildingBlockingResponse(resp *http.Response) (res GetBuildingBlockingRes, _ error) {
+func decodeGetAreaAvailabilityResponse(resp *http.Response) (res GetAreaAvailabilityRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -4076,7 +4144,7 @@ func decodeGetBuildingBlockingResponse(resp *http.Response) (res GetBuildingBloc
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetBuildingBlockingOKApplicationJSON
+			var response GetAreaAvailabilityOKApplicationJSON
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4106,8 +4174,8 @@ func decodeGetBuildingBlockingResponse(resp *http.Response) (res GetBuildingBloc
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -4120,7 +4188,7 @@ func decodeGetBuildingBlockingResponse(resp *http.Response) (res GetBuildingBloc
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ErrorResponse
+			var response GetAreaAvailabilityBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4141,31 +4209,6 @@ func decodeGetBuildingBlockingResponse(resp *http.Response) (res GetBuildingBloc
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	return res, validate.UnexpectedStatusCodeWithResponse(resp)
-}
-
-func decodeGetBuildingCalendarResponse(resp *http.Response) (res GetBuildingCalendarRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "text/calendar":
-			reader := resp.Body
-			b, err := io.ReadAll(reader)
-			if err != nil {
-				return res, err
-			}
-
-			response := GetBuildingCalendarOK{Data: bytes.NewReader(b)}
-			return &response, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
 	case 404:
 		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -4180,7 +4223,7 @@ func decodeGetBuildingCalendarResponse(resp *http.Response) (res GetBuildingCale
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ErrorResponse
+			var response GetAreaAvailabilityNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4205,7 +4248,7 @@ func decodeGetBuildingCalendarResponse(resp *http.Response) (res GetBuildingCale
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetCurrentOccupancyResponse(resp *http.Response) (res GetCurrentOccupancyRes, _ error) {
+func decodeGetAreaBlockingResponse(resp *http.Response) (res GetAreaBlockingRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -4221,7 +4264,7 @@ func decodeGetCurrentOccupancyResponse(resp *http.Response) (res GetCurrentOccup
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response CurrentOccupancy
+			var response GetAreaBlockingOKApplicationJSON
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4251,8 +4294,8 @@ func decodeGetCurrentOccupancyResponse(resp *http.Response) (res GetCurrentOccup
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 403:
-		// Code 403.
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -4290,7 +4333,7 @@ func decodeGetCurrentOccupancyResponse(resp *http.Response) (res GetCurrentOccup
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetCurrentUserResponse(resp *http.Response) (res GetCurrentUserRes, _ error) {
+func decodeGetAreaCalendarResponse(resp *http.Response) (res GetAreaCalendarRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -4299,45 +4342,139 @@ func decodeGetCurrentUserResponse(resp *http.Response) (res GetCurrentUserRes, _
 			return res, errors.Wrap(err, "parse media type")
 		}
 		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
+		case ct == "text/calendar":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
 			if err != nil {
 				return res, err
 			}
-			d := jx.DecodeBytes(buf)
 
-			var response User
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
-				}
-				return res, err
-			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
+			response := CalendarFeed{Data: bytes.NewReader(b)}
+			var wrapper CalendarFeedHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "ETag" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotETagVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotETagVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.ETag.SetTo(wrapperDotETagVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse ETag header")
+				}
+			}
+			// Parse "Last-Modified" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Last-Modified",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotLastModifiedVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotLastModifiedVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.LastModified.SetTo(wrapperDotLastModifiedVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Last-Modified header")
+				}
+			}
+			return &wrapper, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 304:
+		// Code 304.
+		var wrapper NotModified
+		h := uri.NewHeaderDecoder(resp.Header)
+		// Parse "ETag" header.
+		{
+			cfg := uri.HeaderParameterDecodingConfig{
+				Name:    "ETag",
+				Explode: false,
+			}
+			if err := func() error {
+				if err := h.HasParam(cfg); err == nil {
+					if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+						var wrapperDotETagVal string
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToString(val)
+							if err != nil {
+								return err
+							}
+
+							wrapperDotETagVal = c
+							return nil
+						}(); err != nil {
+							return err
+						}
+						wrapper.ETag.SetTo(wrapperDotETagVal)
+						return nil
+					}); err != nil {
+						return err
+					}
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				return res, errors.Wrap(err, "parse ETag header")
 			}
-			return &response, nil
-		default:
-			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
+		return &wrapper, nil
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -4375,7 +4512,7 @@ func decodeGetCurrentUserResponse(resp *http.Response) (res GetCurrentUserRes, _
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetCurrentUserFavoritesResponse(resp *http.Response) (res GetCurrentUserFavoritesRes, _ error) {
+func decodeGetAreaConstraintsResponse(resp *http.Response) (res GetAreaConstraintsRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -4391,7 +4528,7 @@ func decodeGetCurrentUserFavoritesResponse(resp *http.Response) (res GetCurrentU
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetCurrentUserFavoritesOKApplicationJSON
+			var response PlaceConstraints
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4421,8 +4558,8 @@ func decodeGetCurrentUserFavoritesResponse(resp *http.Response) (res GetCurrentU
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -4460,7 +4597,7 @@ func decodeGetCurrentUserFavoritesResponse(resp *http.Response) (res GetCurrentU
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetCurrentUserNotificationsResponse(resp *http.Response) (res GetCurrentUserNotificationsRes, _ error) {
+func decodeGetAreaRoomPlanResponse(resp *http.Response) (res GetAreaRoomPlanRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -4476,7 +4613,7 @@ func decodeGetCurrentUserNotificationsResponse(resp *http.Response) (res GetCurr
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response NotificationPreferences
+			var response RoomPlan
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4506,8 +4643,8 @@ func decodeGetCurrentUserNotificationsResponse(resp *http.Response) (res GetCurr
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -4545,7 +4682,7 @@ func decodeGetCurrentUserNotificationsResponse(resp *http.Response) (res GetCurr
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetDefaultGroupAssignmentResponse(resp *http.Response) (res GetDefaultGroupAssignmentRes, _ error) {
+func decodeGetAuditLogResponse(resp *http.Response) (res GetAuditLogRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -4561,7 +4698,7 @@ func decodeGetDefaultGroupAssignmentResponse(resp *http.Response) (res GetDefaul
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetDefaultGroupAssignmentOKApplicationJSON
+			var response PaginatedAuditLogList
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4630,7 +4767,7 @@ func decodeGetDefaultGroupAssignmentResponse(resp *http.Response) (res GetDefaul
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetEquipmentResponse(resp *http.Response) (res GetEquipmentRes, _ error) {
+func decodeGetBuildingResponse(resp *http.Response) (res GetBuildingRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -4646,7 +4783,7 @@ func decodeGetEquipmentResponse(resp *http.Response) (res GetEquipmentRes, _ err
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Equipment
+			var response Building
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4706,7 +4843,7 @@ func decodeGetEquipmentResponse(resp *http.Response) (res GetEquipmentRes, _ err
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetGroupResponse(resp *http.Response) (res GetGroupRes, _ error) {
+func decodeGetBuildingAvailabilityResponse(resp *http.Response) (res GetBuildingAvailabilityRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -4722,7 +4859,7 @@ func decodeGetGroupResponse(resp *http.Response) (res GetGroupRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GroupWithMembers
+			var response BuildingAvailability
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4752,8 +4889,8 @@ func decodeGetGroupResponse(resp *http.Response) (res GetGroupRes, _ error) {
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 403:
-		// Code 403.
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -4766,7 +4903,7 @@ func decodeGetGroupResponse(resp *http.Response) (res GetGroupRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetGroupForbidden
+			var response GetBuildingAvailabilityBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4801,7 +4938,7 @@ func decodeGetGroupResponse(resp *http.Response) (res GetGroupRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetGroupNotFound
+			var response GetBuildingAvailabilityNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4826,7 +4963,7 @@ func decodeGetGroupResponse(resp *http.Response) (res GetGroupRes, _ error) {
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetGroupMembersResponse(resp *http.Response) (res GetGroupMembersRes, _ error) {
+func decodeGetBuildingBlockingResponse(resp *http.Response) (res GetBuildingBlockingRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -4842,7 +4979,7 @@ func decodeGetGroupMembersResponse(resp *http.Response) (res GetGroupMembersRes,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetGroupMembersOKApplicationJSON
+			var response GetBuildingBlockingOKApplicationJSON
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4872,8 +5009,8 @@ func decodeGetGroupMembersResponse(resp *http.Response) (res GetGroupMembersRes,
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 403:
-		// Code 403.
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -4886,7 +5023,7 @@ func decodeGetGroupMembersResponse(resp *http.Response) (res GetGroupMembersRes,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetGroupMembersForbidden
+			var response ErrorResponse
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4907,6 +5044,150 @@ func decodeGetGroupMembersResponse(resp *http.Response) (res GetGroupMembersRes,
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeGetBuildingCalendarResponse(resp *http.Response) (res GetBuildingCalendarRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "text/calendar":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
+
+			response := CalendarFeed{Data: bytes.NewReader(b)}
+			var wrapper CalendarFeedHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "ETag" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotETagVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotETagVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.ETag.SetTo(wrapperDotETagVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse ETag header")
+				}
+			}
+			// Parse "Last-Modified" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Last-Modified",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotLastModifiedVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotLastModifiedVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.LastModified.SetTo(wrapperDotLastModifiedVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Last-Modified header")
+				}
+			}
+			return &wrapper, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 304:
+		// Code 304.
+		var wrapper NotModified
+		h := uri.NewHeaderDecoder(resp.Header)
+		// Parse "ETag" header.
+		{
+			cfg := uri.HeaderParameterDecodingConfig{
+				Name:    "ETag",
+				Explode: false,
+			}
+			if err := func() error {
+				if err := h.HasParam(cfg); err == nil {
+					if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+						var wrapperDotETagVal string
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToString(val)
+							if err != nil {
+								return err
+							}
+
+							wrapperDotETagVal = c
+							return nil
+						}(); err != nil {
+							return err
+						}
+						wrapper.ETag.SetTo(wrapperDotETagVal)
+						return nil
+					}); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "parse ETag header")
+			}
+		}
+		return &wrapper, nil
 	case 404:
 		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -4921,7 +5202,7 @@ func decodeGetGroupMembersResponse(resp *http.Response) (res GetGroupMembersRes,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetGroupMembersNotFound
+			var response ErrorResponse
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4946,7 +5227,7 @@ func decodeGetGroupMembersResponse(resp *http.Response) (res GetGroupMembersRes,
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetGroupPermissionsResponse(resp *http.Response) (res GetGroupPermissionsRes, _ error) {
+func decodeGetBuildingConstraintsResponse(resp *http.Response) (res GetBuildingConstraintsRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -4962,7 +5243,7 @@ func decodeGetGroupPermissionsResponse(resp *http.Response) (res GetGroupPermiss
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetGroupPermissionsOKApplicationJSON
+			var response PlaceConstraints
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -4992,8 +5273,8 @@ func decodeGetGroupPermissionsResponse(resp *http.Response) (res GetGroupPermiss
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 403:
-		// Code 403.
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -5006,42 +5287,7 @@ func decodeGetGroupPermissionsResponse(resp *http.Response) (res GetGroupPermiss
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetGroupPermissionsForbidden
-			if err := func() error {
-				if err := response.Decode(d); err != nil {
-					return err
-				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
-				return nil
-			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
-				}
-				return res, err
-			}
-			return &response, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
-	case 404:
-		// Code 404.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return res, err
-			}
-			d := jx.DecodeBytes(buf)
-
-			var response GetGroupPermissionsNotFound
+			var response ErrorResponse
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5066,7 +5312,7 @@ func decodeGetGroupPermissionsResponse(resp *http.Response) (res GetGroupPermiss
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetPlaceResponse(resp *http.Response) (res GetPlaceRes, _ error) {
+func decodeGetCurrentOccupancyResponse(resp *http.Response) (res GetCurrentOccupancyRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -5082,7 +5328,7 @@ func decodeGetPlaceResponse(resp *http.Response) (res GetPlaceRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Place
+			var response CurrentOccupancy
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5112,8 +5358,8 @@ func decodeGetPlaceResponse(resp *http.Response) (res GetPlaceRes, _ error) {
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
+	case 403:
+		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -5151,7 +5397,7 @@ func decodeGetPlaceResponse(resp *http.Response) (res GetPlaceRes, _ error) {
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetPlaceAvailabilityResponse(resp *http.Response) (res GetPlaceAvailabilityRes, _ error) {
+func decodeGetCurrentUserResponse(resp *http.Response) (res GetCurrentUserRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -5167,7 +5413,7 @@ func decodeGetPlaceAvailabilityResponse(resp *http.Response) (res GetPlaceAvaila
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetPlaceAvailabilityOKApplicationJSON
+			var response User
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5197,8 +5443,8 @@ func decodeGetPlaceAvailabilityResponse(resp *http.Response) (res GetPlaceAvaila
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
+	case 401:
+		// Code 401.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -5236,7 +5482,7 @@ func decodeGetPlaceAvailabilityResponse(resp *http.Response) (res GetPlaceAvaila
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetPlaceBlockingResponse(resp *http.Response) (res GetPlaceBlockingRes, _ error) {
+func decodeGetCurrentUserCalendarTokenResponse(resp *http.Response) (res GetCurrentUserCalendarTokenRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -5252,7 +5498,7 @@ func decodeGetPlaceBlockingResponse(resp *http.Response) (res GetPlaceBlockingRe
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetPlaceBlockingOKApplicationJSON
+			var response CalendarToken
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5269,14 +5515,40 @@ func decodeGetPlaceBlockingResponse(resp *http.Response) (res GetPlaceBlockingRe
 				}
 				return res, err
 			}
-			// Validate response.
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response GetCurrentUserCalendarTokenUnauthorized
 			if err := func() error {
-				if err := response.Validate(); err != nil {
+				if err := response.Decode(d); err != nil {
 					return err
 				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
 			}
 			return &response, nil
 		default:
@@ -5296,7 +5568,7 @@ func decodeGetPlaceBlockingResponse(resp *http.Response) (res GetPlaceBlockingRe
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ErrorResponse
+			var response GetCurrentUserCalendarTokenNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5321,7 +5593,7 @@ func decodeGetPlaceBlockingResponse(resp *http.Response) (res GetPlaceBlockingRe
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetPlaceCalendarResponse(resp *http.Response) (res GetPlaceCalendarRes, _ error) {
+func decodeGetCurrentUserFavoritesResponse(resp *http.Response) (res GetCurrentUserFavoritesRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -5330,20 +5602,45 @@ func decodeGetPlaceCalendarResponse(resp *http.Response) (res GetPlaceCalendarRe
 			return res, errors.Wrap(err, "parse media type")
 		}
 		switch {
-		case ct == "text/calendar":
-			reader := resp.Body
-			b, err := io.ReadAll(reader)
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
 			if err != nil {
 				return res, err
 			}
+			d := jx.DecodeBytes(buf)
 
-			response := GetPlaceCalendarOK{Data: bytes.NewReader(b)}
+			var response GetCurrentUserFavoritesOKApplicationJSON
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
+	case 401:
+		// Code 401.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -5381,7 +5678,7 @@ func decodeGetPlaceCalendarResponse(resp *http.Response) (res GetPlaceCalendarRe
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetPlaceConstraintsResponse(resp *http.Response) (res GetPlaceConstraintsRes, _ error) {
+func decodeGetCurrentUserNotificationsResponse(resp *http.Response) (res GetCurrentUserNotificationsRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -5397,7 +5694,7 @@ func decodeGetPlaceConstraintsResponse(resp *http.Response) (res GetPlaceConstra
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response PlaceConstraints
+			var response NotificationPreferences
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5414,12 +5711,21 @@ func decodeGetPlaceConstraintsResponse(resp *http.Response) (res GetPlaceConstra
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
+	case 401:
+		// Code 401.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -5457,7 +5763,7 @@ func decodeGetPlaceConstraintsResponse(resp *http.Response) (res GetPlaceConstra
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetPlaceEquipmentResponse(resp *http.Response) (res GetPlaceEquipmentRes, _ error) {
+func decodeGetDefaultGroupAssignmentResponse(resp *http.Response) (res GetDefaultGroupAssignmentRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -5473,7 +5779,7 @@ func decodeGetPlaceEquipmentResponse(resp *http.Response) (res GetPlaceEquipment
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetPlaceEquipmentOKApplicationJSON
+			var response GetDefaultGroupAssignmentOKApplicationJSON
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5503,8 +5809,8 @@ func decodeGetPlaceEquipmentResponse(resp *http.Response) (res GetPlaceEquipment
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
+	case 403:
+		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -5542,7 +5848,7 @@ func decodeGetPlaceEquipmentResponse(resp *http.Response) (res GetPlaceEquipment
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetPlaceQrCodeResponse(resp *http.Response) (res GetPlaceQrCodeRes, _ error) {
+func decodeGetEffectivePlaceConstraintsResponse(resp *http.Response) (res GetEffectivePlaceConstraintsRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -5551,43 +5857,6 @@ func decodeGetPlaceQrCodeResponse(resp *http.Response) (res GetPlaceQrCodeRes, _
 			return res, errors.Wrap(err, "parse media type")
 		}
 		switch {
-		case ct == "application/pdf":
-			reader := resp.Body
-			b, err := io.ReadAll(reader)
-			if err != nil {
-				return res, err
-			}
-
-			response := GetPlaceQrCodeOKApplicationPdf{Data: bytes.NewReader(b)}
-			return &response, nil
-		case ct == "image/jpeg":
-			reader := resp.Body
-			b, err := io.ReadAll(reader)
-			if err != nil {
-				return res, err
-			}
-
-			response := GetPlaceQrCodeOKImageJpeg{Data: bytes.NewReader(b)}
-			return &response, nil
-		case ct == "image/png":
-			reader := resp.Body
-			b, err := io.ReadAll(reader)
-			if err != nil {
-				return res, err
-			}
-
-			response := GetPlaceQrCodeOKImagePNG{Data: bytes.NewReader(b)}
-			return &response, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
-	case 403:
-		// Code 403.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
 		case ct == "application/json":
 			buf, err := io.ReadAll(resp.Body)
 			if err != nil {
@@ -5595,7 +5864,7 @@ func decodeGetPlaceQrCodeResponse(resp *http.Response) (res GetPlaceQrCodeRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetPlaceQrCodeForbidden
+			var response EffectivePlaceConstraints
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5612,6 +5881,15 @@ func decodeGetPlaceQrCodeResponse(resp *http.Response) (res GetPlaceQrCodeRes, _
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -5630,7 +5908,7 @@ func decodeGetPlaceQrCodeResponse(resp *http.Response) (res GetPlaceQrCodeRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetPlaceQrCodeNotFound
+			var response ErrorResponse
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5655,7 +5933,7 @@ func decodeGetPlaceQrCodeResponse(resp *http.Response) (res GetPlaceQrCodeRes, _
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetPlaceTimeSlotsResponse(resp *http.Response) (res GetPlaceTimeSlotsRes, _ error) {
+func decodeGetEquipmentResponse(resp *http.Response) (res GetEquipmentRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -5671,7 +5949,7 @@ func decodeGetPlaceTimeSlotsResponse(resp *http.Response) (res GetPlaceTimeSlots
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response TimeSlotConfig
+			var response Equipment
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5688,15 +5966,6 @@ func decodeGetPlaceTimeSlotsResponse(resp *http.Response) (res GetPlaceTimeSlots
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -5740,7 +6009,7 @@ func decodeGetPlaceTimeSlotsResponse(resp *http.Response) (res GetPlaceTimeSlots
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetPlaceWhitelistResponse(resp *http.Response) (res GetPlaceWhitelistRes, _ error) {
+func decodeGetGroupResponse(resp *http.Response) (res GetGroupRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -5756,7 +6025,7 @@ func decodeGetPlaceWhitelistResponse(resp *http.Response) (res GetPlaceWhitelist
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetPlaceWhitelistOKApplicationJSON
+			var response GroupWithMembers
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5800,7 +6069,7 @@ func decodeGetPlaceWhitelistResponse(resp *http.Response) (res GetPlaceWhitelist
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetPlaceWhitelistForbidden
+			var response GetGroupForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5835,7 +6104,7 @@ func decodeGetPlaceWhitelistResponse(resp *http.Response) (res GetPlaceWhitelist
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetPlaceWhitelistNotFound
+			var response GetGroupNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5860,7 +6129,7 @@ func decodeGetPlaceWhitelistResponse(resp *http.Response) (res GetPlaceWhitelist
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetQrTemplateResponse(resp *http.Response) (res GetQrTemplateRes, _ error) {
+func decodeGetGroupMembersResponse(resp *http.Response) (res GetGroupMembersRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -5876,7 +6145,7 @@ func decodeGetQrTemplateResponse(resp *http.Response) (res GetQrTemplateRes, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response QRTemplate
+			var response GetGroupMembersOKApplicationJSON
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5893,6 +6162,15 @@ func decodeGetQrTemplateResponse(resp *http.Response) (res GetQrTemplateRes, _ e
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -5911,7 +6189,7 @@ func decodeGetQrTemplateResponse(resp *http.Response) (res GetQrTemplateRes, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetQrTemplateForbidden
+			var response GetGroupMembersForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5946,7 +6224,7 @@ func decodeGetQrTemplateResponse(resp *http.Response) (res GetQrTemplateRes, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetQrTemplateNotFound
+			var response GetGroupMembersNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -5971,7 +6249,7 @@ func decodeGetQrTemplateResponse(resp *http.Response) (res GetQrTemplateRes, _ e
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetReservationResponse(resp *http.Response) (res GetReservationRes, _ error) {
+func decodeGetGroupPermissionsResponse(resp *http.Response) (res GetGroupPermissionsRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -5987,7 +6265,7 @@ func decodeGetReservationResponse(resp *http.Response) (res GetReservationRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Reservation
+			var response GetGroupPermissionsOKApplicationJSON
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6031,7 +6309,7 @@ func decodeGetReservationResponse(resp *http.Response) (res GetReservationRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetReservationForbidden
+			var response GetGroupPermissionsForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6066,7 +6344,7 @@ func decodeGetReservationResponse(resp *http.Response) (res GetReservationRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetReservationNotFound
+			var response GetGroupPermissionsNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6091,7 +6369,7 @@ func decodeGetReservationResponse(resp *http.Response) (res GetReservationRes, _
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetReservationShareLinkResponse(resp *http.Response) (res GetReservationShareLinkRes, _ error) {
+func decodeGetPlaceResponse(resp *http.Response) (res GetPlaceRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -6107,7 +6385,7 @@ func decodeGetReservationShareLinkResponse(resp *http.Response) (res GetReservat
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ShareResponse
+			var response Place
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6124,12 +6402,21 @@ func decodeGetReservationShareLinkResponse(resp *http.Response) (res GetReservat
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 403:
-		// Code 403.
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -6142,7 +6429,7 @@ func decodeGetReservationShareLinkResponse(resp *http.Response) (res GetReservat
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetReservationShareLinkForbidden
+			var response ErrorResponse
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6163,8 +6450,14 @@ func decodeGetReservationShareLinkResponse(resp *http.Response) (res GetReservat
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeGetPlaceAvailabilityResponse(resp *http.Response) (res GetPlaceAvailabilityRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -6177,7 +6470,7 @@ func decodeGetReservationShareLinkResponse(resp *http.Response) (res GetReservat
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetReservationShareLinkNotFound
+			var response GetPlaceAvailabilityOKApplicationJSON
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6194,18 +6487,21 @@ func decodeGetReservationShareLinkResponse(resp *http.Response) (res GetReservat
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	return res, validate.UnexpectedStatusCodeWithResponse(resp)
-}
-
-func decodeGetStatisticsResponse(resp *http.Response) (res GetStatisticsRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -6218,7 +6514,7 @@ func decodeGetStatisticsResponse(resp *http.Response) (res GetStatisticsRes, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Statistics
+			var response GetPlaceAvailabilityBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6239,8 +6535,8 @@ func decodeGetStatisticsResponse(resp *http.Response) (res GetStatisticsRes, _ e
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 403:
-		// Code 403.
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -6253,7 +6549,7 @@ func decodeGetStatisticsResponse(resp *http.Response) (res GetStatisticsRes, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ErrorResponse
+			var response GetPlaceAvailabilityNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6278,7 +6574,7 @@ func decodeGetStatisticsResponse(resp *http.Response) (res GetStatisticsRes, _ e
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetUsageStatisticsResponse(resp *http.Response) (res GetUsageStatisticsRes, _ error) {
+func decodeGetPlaceBlockingResponse(resp *http.Response) (res GetPlaceBlockingRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -6294,7 +6590,7 @@ func decodeGetUsageStatisticsResponse(resp *http.Response) (res GetUsageStatisti
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetUsageStatisticsOKApplicationJSON
+			var response GetPlaceBlockingOKApplicationJSON
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6324,8 +6620,8 @@ func decodeGetUsageStatisticsResponse(resp *http.Response) (res GetUsageStatisti
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 403:
-		// Code 403.
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -6363,7 +6659,7 @@ func decodeGetUsageStatisticsResponse(resp *http.Response) (res GetUsageStatisti
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetUserResponse(resp *http.Response) (res GetUserRes, _ error) {
+func decodeGetPlaceCalendarResponse(resp *http.Response) (res GetPlaceCalendarRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -6372,6 +6668,144 @@ func decodeGetUserResponse(resp *http.Response) (res GetUserRes, _ error) {
 			return res, errors.Wrap(err, "parse media type")
 		}
 		switch {
+		case ct == "text/calendar":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
+
+			response := CalendarFeed{Data: bytes.NewReader(b)}
+			var wrapper CalendarFeedHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "ETag" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotETagVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotETagVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.ETag.SetTo(wrapperDotETagVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse ETag header")
+				}
+			}
+			// Parse "Last-Modified" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Last-Modified",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotLastModifiedVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotLastModifiedVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.LastModified.SetTo(wrapperDotLastModifiedVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Last-Modified header")
+				}
+			}
+			return &wrapper, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 304:
+		// Code 304.
+		var wrapper NotModified
+		h := uri.NewHeaderDecoder(resp.Header)
+		// Parse "ETag" header.
+		{
+			cfg := uri.HeaderParameterDecodingConfig{
+				Name:    "ETag",
+				Explode: false,
+			}
+			if err := func() error {
+				if err := h.HasParam(cfg); err == nil {
+					if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+						var wrapperDotETagVal string
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToString(val)
+							if err != nil {
+								return err
+							}
+
+							wrapperDotETagVal = c
+							return nil
+						}(); err != nil {
+							return err
+						}
+						wrapper.ETag.SetTo(wrapperDotETagVal)
+						return nil
+					}); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "parse ETag header")
+			}
+		}
+		return &wrapper, nil
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
 		case ct == "application/json":
 			buf, err := io.ReadAll(resp.Body)
 			if err != nil {
@@ -6379,7 +6813,7 @@ func decodeGetUserResponse(resp *http.Response) (res GetUserRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response User
+			var response ErrorResponse
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6396,21 +6830,18 @@ func decodeGetUserResponse(resp *http.Response) (res GetUserRes, _ error) {
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 403:
-		// Code 403.
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeGetPlaceConstraintsResponse(resp *http.Response) (res GetPlaceConstraintsRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -6423,7 +6854,7 @@ func decodeGetUserResponse(resp *http.Response) (res GetUserRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetUserForbidden
+			var response PlaceConstraints
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6440,6 +6871,15 @@ func decodeGetUserResponse(resp *http.Response) (res GetUserRes, _ error) {
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -6458,7 +6898,7 @@ func decodeGetUserResponse(resp *http.Response) (res GetUserRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetUserNotFound
+			var response ErrorResponse
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6483,7 +6923,7 @@ func decodeGetUserResponse(resp *http.Response) (res GetUserRes, _ error) {
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeGetUserGroupsResponse(resp *http.Response) (res GetUserGroupsRes, _ error) {
+func decodeGetPlaceEquipmentResponse(resp *http.Response) (res GetPlaceEquipmentRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -6499,7 +6939,7 @@ func decodeGetUserGroupsResponse(resp *http.Response) (res GetUserGroupsRes, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetUserGroupsOKApplicationJSON
+			var response GetPlaceEquipmentOKApplicationJSON
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6529,6 +6969,84 @@ func decodeGetUserGroupsResponse(resp *http.Response) (res GetUserGroupsRes, _ e
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ErrorResponse
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeGetPlaceQrCodeResponse(resp *http.Response) (res GetPlaceQrCodeRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/pdf":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
+
+			response := GetPlaceQrCodeOKApplicationPdf{Data: bytes.NewReader(b)}
+			return &response, nil
+		case ct == "image/jpeg":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
+
+			response := GetPlaceQrCodeOKImageJpeg{Data: bytes.NewReader(b)}
+			return &response, nil
+		case ct == "image/png":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
+
+			response := GetPlaceQrCodeOKImagePNG{Data: bytes.NewReader(b)}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
 	case 403:
 		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -6543,7 +7061,7 @@ func decodeGetUserGroupsResponse(resp *http.Response) (res GetUserGroupsRes, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetUserGroupsForbidden
+			var response GetPlaceQrCodeForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6578,7 +7096,7 @@ func decodeGetUserGroupsResponse(resp *http.Response) (res GetUserGroupsRes, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response GetUserGroupsNotFound
+			var response GetPlaceQrCodeNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6603,7 +7121,7 @@ func decodeGetUserGroupsResponse(resp *http.Response) (res GetUserGroupsRes, _ e
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeHandleOAuthCallbackResponse(resp *http.Response) (res HandleOAuthCallbackRes, _ error) {
+func decodeGetPlaceQrKeyResponse(resp *http.Response) (res GetPlaceQrKeyRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -6619,7 +7137,7 @@ func decodeHandleOAuthCallbackResponse(resp *http.Response) (res HandleOAuthCall
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response AuthResponse
+			var response PlaceQrKey
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6636,21 +7154,12 @@ func decodeHandleOAuthCallbackResponse(resp *http.Response) (res HandleOAuthCall
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 400:
-		// Code 400.
+	case 403:
+		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -6663,7 +7172,7 @@ func decodeHandleOAuthCallbackResponse(resp *http.Response) (res HandleOAuthCall
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response HandleOAuthCallbackBadRequest
+			var response GetPlaceQrKeyForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6684,8 +7193,8 @@ func decodeHandleOAuthCallbackResponse(resp *http.Response) (res HandleOAuthCall
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -6698,7 +7207,7 @@ func decodeHandleOAuthCallbackResponse(resp *http.Response) (res HandleOAuthCall
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response HandleOAuthCallbackUnauthorized
+			var response GetPlaceQrKeyNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6723,16 +7232,7 @@ func decodeHandleOAuthCallbackResponse(resp *http.Response) (res HandleOAuthCall
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeInitiateOAuthLoginResponse(resp *http.Response) (res *InitiateOAuthLoginFound, _ error) {
-	switch resp.StatusCode {
-	case 302:
-		// Code 302.
-		return &InitiateOAuthLoginFound{}, nil
-	}
-	return res, validate.UnexpectedStatusCodeWithResponse(resp)
-}
-
-func decodeListApiKeysResponse(resp *http.Response) (res ListApiKeysRes, _ error) {
+func decodeGetPlaceTimeSlotsResponse(resp *http.Response) (res GetPlaceTimeSlotsRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -6748,7 +7248,7 @@ func decodeListApiKeysResponse(resp *http.Response) (res ListApiKeysRes, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ListApiKeysOKApplicationJSON
+			var response TimeSlotConfig
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6778,8 +7278,8 @@ func decodeListApiKeysResponse(resp *http.Response) (res ListApiKeysRes, _ error
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -6817,7 +7317,7 @@ func decodeListApiKeysResponse(resp *http.Response) (res ListApiKeysRes, _ error
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeListAreaPlacesResponse(resp *http.Response) (res ListAreaPlacesRes, _ error) {
+func decodeGetPlaceWhitelistResponse(resp *http.Response) (res GetPlaceWhitelistRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -6833,7 +7333,7 @@ func decodeListAreaPlacesResponse(resp *http.Response) (res ListAreaPlacesRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ListAreaPlacesOKApplicationJSON
+			var response GetPlaceWhitelistOKApplicationJSON
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6863,8 +7363,8 @@ func decodeListAreaPlacesResponse(resp *http.Response) (res ListAreaPlacesRes, _
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
+	case 403:
+		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -6877,7 +7377,7 @@ func decodeListAreaPlacesResponse(resp *http.Response) (res ListAreaPlacesRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ErrorResponse
+			var response GetPlaceWhitelistForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6898,14 +7398,8 @@ func decodeListAreaPlacesResponse(resp *http.Response) (res ListAreaPlacesRes, _
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	return res, validate.UnexpectedStatusCodeWithResponse(resp)
-}
-
-func decodeListAreasResponse(resp *http.Response) (res *PaginatedAreaList, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -6918,7 +7412,7 @@ func decodeListAreasResponse(resp *http.Response) (res *PaginatedAreaList, _ err
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response PaginatedAreaList
+			var response GetPlaceWhitelistNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6935,15 +7429,6 @@ func decodeListAreasResponse(resp *http.Response) (res *PaginatedAreaList, _ err
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -6952,7 +7437,7 @@ func decodeListAreasResponse(resp *http.Response) (res *PaginatedAreaList, _ err
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeListBuildingAreasResponse(resp *http.Response) (res ListBuildingAreasRes, _ error) {
+func decodeGetQrTemplateResponse(resp *http.Response) (res GetQrTemplateRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -6968,7 +7453,7 @@ func decodeListBuildingAreasResponse(resp *http.Response) (res ListBuildingAreas
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ListBuildingAreasOKApplicationJSON
+			var response QRTemplate
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -6985,21 +7470,12 @@ func decodeListBuildingAreasResponse(resp *http.Response) (res ListBuildingAreas
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
+	case 403:
+		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -7012,7 +7488,7 @@ func decodeListBuildingAreasResponse(resp *http.Response) (res ListBuildingAreas
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ErrorResponse
+			var response GetQrTemplateForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7033,14 +7509,8 @@ func decodeListBuildingAreasResponse(resp *http.Response) (res ListBuildingAreas
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	return res, validate.UnexpectedStatusCodeWithResponse(resp)
-}
-
-func decodeListBuildingsResponse(resp *http.Response) (res *PaginatedBuildingList, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -7053,7 +7523,7 @@ func decodeListBuildingsResponse(resp *http.Response) (res *PaginatedBuildingLis
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response PaginatedBuildingList
+			var response GetQrTemplateNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7070,15 +7540,6 @@ func decodeListBuildingsResponse(resp *http.Response) (res *PaginatedBuildingLis
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -7087,7 +7548,7 @@ func decodeListBuildingsResponse(resp *http.Response) (res *PaginatedBuildingLis
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeListEquipmentResponse(resp *http.Response) (res []Equipment, _ error) {
+func decodeGetReservationResponse(resp *http.Response) (res GetReservationRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -7103,17 +7564,9 @@ func decodeListEquipmentResponse(resp *http.Response) (res []Equipment, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response []Equipment
+			var response Reservation
 			if err := func() error {
-				response = make([]Equipment, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem Equipment
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					response = append(response, elem)
-					return nil
-				}); err != nil {
+				if err := response.Decode(d); err != nil {
 					return err
 				}
 				if err := d.Skip(); err != io.EOF {
@@ -7130,25 +7583,19 @@ func decodeListEquipmentResponse(resp *http.Response) (res []Equipment, _ error)
 			}
 			// Validate response.
 			if err := func() error {
-				if response == nil {
-					return errors.New("nil is invalid value")
+				if err := response.Validate(); err != nil {
+					return err
 				}
 				return nil
 			}(); err != nil {
 				return res, errors.Wrap(err, "validate")
 			}
-			return response, nil
+			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	return res, validate.UnexpectedStatusCodeWithResponse(resp)
-}
-
-func decodeListGroupsResponse(resp *http.Response) (res ListGroupsRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 403:
+		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -7161,7 +7608,7 @@ func decodeListGroupsResponse(resp *http.Response) (res ListGroupsRes, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ListGroupsOKApplicationJSON
+			var response GetReservationForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7178,21 +7625,12 @@ func decodeListGroupsResponse(resp *http.Response) (res ListGroupsRes, _ error)
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 403:
-		// Code 403.
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -7205,7 +7643,7 @@ func decodeListGroupsResponse(resp *http.Response) (res ListGroupsRes, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ErrorResponse
+			var response GetReservationNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7230,7 +7668,7 @@ func decodeListGroupsResponse(resp *http.Response) (res ListGroupsRes, _ error)
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeListPermissionsResponse(resp *http.Response) (res ListPermissionsRes, _ error) {
+func decodeGetReservationShareLinkResponse(resp *http.Response) (res GetReservationShareLinkRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -7246,7 +7684,7 @@ func decodeListPermissionsResponse(resp *http.Response) (res ListPermissionsRes,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ListPermissionsOKApplicationJSON
+			var response ShareResponse
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7263,21 +7701,47 @@ func decodeListPermissionsResponse(resp *http.Response) (res ListPermissionsRes,
 				}
 				return res, err
 			}
-			// Validate response.
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 403:
+		// Code 403.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response GetReservationShareLinkForbidden
 			if err := func() error {
-				if err := response.Validate(); err != nil {
+				if err := response.Decode(d); err != nil {
 					return err
 				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
 			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 403:
-		// Code 403.
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -7290,7 +7754,7 @@ func decodeListPermissionsResponse(resp *http.Response) (res ListPermissionsRes,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ErrorResponse
+			var response GetReservationShareLinkNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7315,7 +7779,7 @@ func decodeListPermissionsResponse(resp *http.Response) (res ListPermissionsRes,
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeListPlacesResponse(resp *http.Response) (res *PaginatedPlaceList, _ error) {
+func decodeGetStatisticsResponse(resp *http.Response) (res GetStatisticsRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -7331,7 +7795,7 @@ func decodeListPlacesResponse(resp *http.Response) (res *PaginatedPlaceList, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response PaginatedPlaceList
+			var response Statistics
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7348,14 +7812,40 @@ func decodeListPlacesResponse(resp *http.Response) (res *PaginatedPlaceList, _ e
 				}
 				return res, err
 			}
-			// Validate response.
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 403:
+		// Code 403.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ErrorResponse
 			if err := func() error {
-				if err := response.Validate(); err != nil {
+				if err := response.Decode(d); err != nil {
 					return err
 				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
 			}
 			return &response, nil
 		default:
@@ -7365,7 +7855,7 @@ func decodeListPlacesResponse(resp *http.Response) (res *PaginatedPlaceList, _ e
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeListQrTemplatesResponse(resp *http.Response) (res ListQrTemplatesRes, _ error) {
+func decodeGetUsageStatisticsResponse(resp *http.Response) (res GetUsageStatisticsRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -7381,7 +7871,7 @@ func decodeListQrTemplatesResponse(resp *http.Response) (res ListQrTemplatesRes,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ListQrTemplatesOKApplicationJSON
+			var response GetUsageStatisticsOKApplicationJSON
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7450,7 +7940,7 @@ func decodeListQrTemplatesResponse(resp *http.Response) (res ListQrTemplatesRes,
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeListReservationsResponse(resp *http.Response) (res ListReservationsRes, _ error) {
+func decodeGetUserResponse(resp *http.Response) (res GetUserRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -7466,7 +7956,7 @@ func decodeListReservationsResponse(resp *http.Response) (res ListReservationsRe
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response PaginatedReservationList
+			var response User
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7496,8 +7986,8 @@ func decodeListReservationsResponse(resp *http.Response) (res ListReservationsRe
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
+	case 403:
+		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -7510,7 +8000,42 @@ func decodeListReservationsResponse(resp *http.Response) (res ListReservationsRe
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ErrorResponse
+			var response GetUserForbidden
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response GetUserNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7535,7 +8060,7 @@ func decodeListReservationsResponse(resp *http.Response) (res ListReservationsRe
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeListUsersResponse(resp *http.Response) (res ListUsersRes, _ error) {
+func decodeGetUserCalendarResponse(resp *http.Response) (res GetUserCalendarRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -7544,6 +8069,144 @@ func decodeListUsersResponse(resp *http.Response) (res ListUsersRes, _ error) {
 			return res, errors.Wrap(err, "parse media type")
 		}
 		switch {
+		case ct == "text/calendar":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
+
+			response := CalendarFeed{Data: bytes.NewReader(b)}
+			var wrapper CalendarFeedHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "ETag" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotETagVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotETagVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.ETag.SetTo(wrapperDotETagVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse ETag header")
+				}
+			}
+			// Parse "Last-Modified" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Last-Modified",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotLastModifiedVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotLastModifiedVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.LastModified.SetTo(wrapperDotLastModifiedVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Last-Modified header")
+				}
+			}
+			return &wrapper, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 304:
+		// Code 304.
+		var wrapper NotModified
+		h := uri.NewHeaderDecoder(resp.Header)
+		// Parse "ETag" header.
+		{
+			cfg := uri.HeaderParameterDecodingConfig{
+				Name:    "ETag",
+				Explode: false,
+			}
+			if err := func() error {
+				if err := h.HasParam(cfg); err == nil {
+					if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+						var wrapperDotETagVal string
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToString(val)
+							if err != nil {
+								return err
+							}
+
+							wrapperDotETagVal = c
+							return nil
+						}(); err != nil {
+							return err
+						}
+						wrapper.ETag.SetTo(wrapperDotETagVal)
+						return nil
+					}); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "parse ETag header")
+			}
+		}
+		return &wrapper, nil
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
 		case ct == "application/json":
 			buf, err := io.ReadAll(resp.Body)
 			if err != nil {
@@ -7551,7 +8214,7 @@ func decodeListUsersResponse(resp *http.Response) (res ListUsersRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response PaginatedUserList
+			var response ErrorResponse
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7568,13 +8231,54 @@ func decodeListUsersResponse(resp *http.Response) (res ListUsersRes, _ error) {
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeGetUserGroupsResponse(resp *http.Response) (res GetUserGroupsRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response GetUserGroupsOKApplicationJSON
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
 				return res, errors.Wrap(err, "validate")
 			}
 			return &response, nil
@@ -7595,7 +8299,2245 @@ func decodeListUsersResponse(resp *http.Response) (res ListUsersRes, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ErrorResponse
+			var response GetUserGroupsForbidden
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response GetUserGroupsNotFound
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeHandleOAuthCallbackResponse(resp *http.Response) (res HandleOAuthCallbackRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response AuthResponse
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			var wrapper AuthResponseHeaders
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "Set-Cookie" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Set-Cookie",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotSetCookieVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotSetCookieVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.SetCookie.SetTo(wrapperDotSetCookieVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Set-Cookie header")
+				}
+			}
+			return &wrapper, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 400:
+		// Code 400.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ErrorResponse
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			var wrapper HandleOAuthCallbackBadRequest
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "Set-Cookie" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Set-Cookie",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotSetCookieVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotSetCookieVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.SetCookie.SetTo(wrapperDotSetCookieVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Set-Cookie header")
+				}
+			}
+			return &wrapper, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ErrorResponse
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			var wrapper HandleOAuthCallbackUnauthorized
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "Set-Cookie" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Set-Cookie",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotSetCookieVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotSetCookieVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.SetCookie.SetTo(wrapperDotSetCookieVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Set-Cookie header")
+				}
+			}
+			return &wrapper, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 409:
+		// Code 409.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ErrorResponse
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			var wrapper HandleOAuthCallbackConflict
+			wrapper.Response = response
+			h := uri.NewHeaderDecoder(resp.Header)
+			// Parse "Set-Cookie" header.
+			{
+				cfg := uri.HeaderParameterDecodingConfig{
+					Name:    "Set-Cookie",
+					Explode: false,
+				}
+				if err := func() error {
+					if err := h.HasParam(cfg); err == nil {
+						if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+							var wrapperDotSetCookieVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								wrapperDotSetCookieVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							wrapper.SetCookie.SetTo(wrapperDotSetCookieVal)
+							return nil
+						}); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return res, errors.Wrap(err, "parse Set-Cookie header")
+				}
+			}
+			return &wrapper, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeInitiateOAuthLoginResponse(resp *http.Response) (res *InitiateOAuthLoginFound, _ error) {
+	switch resp.StatusCode {
+	case 302:
+		// Code 302.
+		var wrapper InitiateOAuthLoginFound
+		h := uri.NewHeaderDecoder(resp.Header)
+		// Parse "Location" header.
+		{
+			cfg := uri.HeaderParameterDecodingConfig{
+				Name:    "Location",
+				Explode: false,
+			}
+			if err := func() error {
+				if err := h.HasParam(cfg); err == nil {
+					if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+						var wrapperDotLocationVal url.URL
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToURL(val)
+							if err != nil {
+								return err
+							}
+
+							wrapperDotLocationVal = c
+							return nil
+						}(); err != nil {
+							return err
+						}
+						wrapper.Location.SetTo(wrapperDotLocationVal)
+						return nil
+					}); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "parse Location header")
+			}
+		}
+		// Parse "Set-Cookie" header.
+		{
+			cfg := uri.HeaderParameterDecodingConfig{
+				Name:    "Set-Cookie",
+				Explode: false,
+			}
+			if err := func() error {
+				if err := h.HasParam(cfg); err == nil {
+					if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+						var wrapperDotSetCookieVal string
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToString(val)
+							if err != nil {
+								return err
+							}
+
+							wrapperDotSetCookieVal = c
+							return nil
+						}(); err != nil {
+							return err
+						}
+						wrapper.SetCookie.SetTo(wrapperDotSetCookieVal)
+						return nil
+					}); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "parse Set-Cookie header")
+			}
+		}
+		return &wrapper, nil
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeListApiKeysResponse(resp *http.Response) (res ListApiKeysRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ListApiKeysOKApplicationJSON
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ErrorResponse
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeListAreaPlacesResponse(resp *http.Response) (res ListAreaPlacesRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ListAreaPlacesOKApplicationJSON
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ErrorResponse
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeListAreasResponse(resp *http.Response) (res *PaginatedAreaList, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response PaginatedAreaList
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeListBuildingAreasResponse(resp *http.Response) (res ListBuildingAreasRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ListBuildingAreasOKApplicationJSON
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ErrorResponse
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeListBuildingsResponse(resp *http.Response) (res *PaginatedBuildingList, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response PaginatedBuildingList
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeListEquipmentResponse(resp *http.Response) (res []Equipment, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response []Equipment
+			if err := func() error {
+				response = make([]Equipment, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Equipment
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeListGroupConstraintProfilesResponse(resp *http.Response) (res ListGroupConstraintProfilesRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ListGroupConstraintProfilesOKApplicationJSON
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 403:
+		// Code 403.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ListGroupConstraintProfilesForbidden
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ListGroupConstraintProfilesNotFound
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeListGroupsResponse(resp *http.Response) (res ListGroupsRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ListGroupsOKApplicationJSON
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 403:
+		// Code 403.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ErrorResponse
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeListPermissionsResponse(resp *http.Response) (res ListPermissionsRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ListPermissionsOKApplicationJSON
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 403:
+		// Code 403.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ErrorResponse
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeListPlacesResponse(resp *http.Response) (res *PaginatedPlaceList, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response PaginatedPlaceList
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeListQrTemplatesResponse(resp *http.Response) (res ListQrTemplatesRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ListQrTemplatesOKApplicationJSON
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 403:
+		// Code 403.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ErrorResponse
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeListReservationsResponse(resp *http.Response) (res ListReservationsRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response PaginatedReservationList
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ErrorResponse
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeListUsersResponse(resp *http.Response) (res ListUsersRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response PaginatedUserList
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 403:
+		// Code 403.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ErrorResponse
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeLogoutResponse(resp *http.Response) (res *LogoutNoContent, _ error) {
+	switch resp.StatusCode {
+	case 204:
+		// Code 204.
+		return &LogoutNoContent{}, nil
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodePreviewQrTemplateResponse(resp *http.Response) (res PreviewQrTemplateRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "image/png":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
+
+			response := PreviewQrTemplateOKImagePNG{Data: bytes.NewReader(b)}
+			return &response, nil
+		case ct == "text/html":
+			reader := resp.Body
+			b, err := io.ReadAll(reader)
+			if err != nil {
+				return res, err
+			}
+
+			response := PreviewQrTemplateOKTextHTML{Data: bytes.NewReader(b)}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 403:
+		// Code 403.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response PreviewQrTemplateForbidden
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response PreviewQrTemplateNotFound
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeRefreshTokenResponse(resp *http.Response) (res RefreshTokenRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response AuthResponse
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 401:
+		// Code 401.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ErrorResponse
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeRemoveAreaBlockingEntriesResponse(resp *http.Response) (res RemoveAreaBlockingEntriesRes, _ error) {
+	switch resp.StatusCode {
+	case 204:
+		// Code 204.
+		return &RemoveAreaBlockingEntriesNoContent{}, nil
+	case 403:
+		// Code 403.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response RemoveAreaBlockingEntriesForbidden
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response RemoveAreaBlockingEntriesNotFound
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeRemoveAreaRoomPlanMarkersResponse(resp *http.Response) (res RemoveAreaRoomPlanMarkersRes, _ error) {
+	switch resp.StatusCode {
+	case 204:
+		// Code 204.
+		return &RemoveAreaRoomPlanMarkersNoContent{}, nil
+	case 403:
+		// Code 403.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response RemoveAreaRoomPlanMarkersForbidden
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response RemoveAreaRoomPlanMarkersNotFound
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeRemoveBuildingBlockingEntriesResponse(resp *http.Response) (res RemoveBuildingBlockingEntriesRes, _ error) {
+	switch resp.StatusCode {
+	case 204:
+		// Code 204.
+		return &RemoveBuildingBlockingEntriesNoContent{}, nil
+	case 403:
+		// Code 403.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response RemoveBuildingBlockingEntriesForbidden
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response RemoveBuildingBlockingEntriesNotFound
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeRemoveCurrentUserFavoritesResponse(resp *http.Response) (res RemoveCurrentUserFavoritesRes, _ error) {
+	switch resp.StatusCode {
+	case 204:
+		// Code 204.
+		return &RemoveCurrentUserFavoritesNoContent{}, nil
+	case 401:
+		// Code 401.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response ErrorResponse
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeRemoveGroupPermissionsResponse(resp *http.Response) (res RemoveGroupPermissionsRes, _ error) {
+	switch resp.StatusCode {
+	case 204:
+		// Code 204.
+		return &RemoveGroupPermissionsNoContent{}, nil
+	case 403:
+		// Code 403.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response RemoveGroupPermissionsForbidden
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response RemoveGroupPermissionsNotFound
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeRemovePlaceBlockingEntriesResponse(resp *http.Response) (res RemovePlaceBlockingEntriesRes, _ error) {
+	switch resp.StatusCode {
+	case 204:
+		// Code 204.
+		return &RemovePlaceBlockingEntriesNoContent{}, nil
+	case 403:
+		// Code 403.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response RemovePlaceBlockingEntriesForbidden
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response RemovePlaceBlockingEntriesNotFound
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeRemovePlaceEquipmentResponse(resp *http.Response) (res RemovePlaceEquipmentRes, _ error) {
+	switch resp.StatusCode {
+	case 204:
+		// Code 204.
+		return &RemovePlaceEquipmentNoContent{}, nil
+	case 403:
+		// Code 403.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response RemovePlaceEquipmentForbidden
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response RemovePlaceEquipmentNotFound
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeRemovePlaceWhitelistUsersResponse(resp *http.Response) (res RemovePlaceWhitelistUsersRes, _ error) {
+	switch resp.StatusCode {
+	case 204:
+		// Code 204.
+		return &RemovePlaceWhitelistUsersNoContent{}, nil
+	case 403:
+		// Code 403.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response RemovePlaceWhitelistUsersForbidden
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 404:
+		// Code 404.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response RemovePlaceWhitelistUsersNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7620,45 +10562,11 @@ func decodeListUsersResponse(resp *http.Response) (res ListUsersRes, _ error) {
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeLogoutResponse(resp *http.Response) (res *LogoutNoContent, _ error) {
+func decodeRemoveUserGroupsResponse(resp *http.Response) (res RemoveUserGroupsRes, _ error) {
 	switch resp.StatusCode {
 	case 204:
 		// Code 204.
-		return &LogoutNoContent{}, nil
-	}
-	return res, validate.UnexpectedStatusCodeWithResponse(resp)
-}
-
-func decodePreviewQrTemplateResponse(resp *http.Response) (res PreviewQrTemplateRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "image/png":
-			reader := resp.Body
-			b, err := io.ReadAll(reader)
-			if err != nil {
-				return res, err
-			}
-
-			response := PreviewQrTemplateOKImagePNG{Data: bytes.NewReader(b)}
-			return &response, nil
-		case ct == "text/html":
-			reader := resp.Body
-			b, err := io.ReadAll(reader)
-			if err != nil {
-				return res, err
-			}
-
-			response := PreviewQrTemplateOKTextHTML{Data: bytes.NewReader(b)}
-			return &response, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
+		return &RemoveUserGroupsNoContent{}, nil
 	case 403:
 		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -7673,7 +10581,7 @@ func decodePreviewQrTemplateResponse(resp *http.Response) (res PreviewQrTemplate
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response PreviewQrTemplateForbidden
+			var response RemoveUserGroupsForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7708,7 +10616,7 @@ func decodePreviewQrTemplateResponse(resp *http.Response) (res PreviewQrTemplate
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response PreviewQrTemplateNotFound
+			var response RemoveUserGroupsNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7733,7 +10641,7 @@ func decodePreviewQrTemplateResponse(resp *http.Response) (res PreviewQrTemplate
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeRefreshTokenResponse(resp *http.Response) (res RefreshTokenRes, _ error) {
+func decodeReplaceAreaBlockingResponse(resp *http.Response) (res ReplaceAreaBlockingRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -7749,7 +10657,7 @@ func decodeRefreshTokenResponse(resp *http.Response) (res RefreshTokenRes, _ err
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response AuthResponse
+			var response ReplaceAreaBlockingOKApplicationJSON
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7779,8 +10687,8 @@ func decodeRefreshTokenResponse(resp *http.Response) (res RefreshTokenRes, _ err
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -7793,7 +10701,7 @@ func decodeRefreshTokenResponse(resp *http.Response) (res RefreshTokenRes, _ err
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ErrorResponse
+			var response ReplaceAreaBlockingBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7814,15 +10722,6 @@ func decodeRefreshTokenResponse(resp *http.Response) (res RefreshTokenRes, _ err
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	return res, validate.UnexpectedStatusCodeWithResponse(resp)
-}
-
-func decodeRemoveAreaBlockingEntriesResponse(resp *http.Response) (res RemoveAreaBlockingEntriesRes, _ error) {
-	switch resp.StatusCode {
-	case 204:
-		// Code 204.
-		return &RemoveAreaBlockingEntriesNoContent{}, nil
 	case 403:
 		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -7837,7 +10736,7 @@ func decodeRemoveAreaBlockingEntriesResponse(resp *http.Response) (res RemoveAre
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response RemoveAreaBlockingEntriesForbidden
+			var response ReplaceAreaBlockingForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7872,7 +10771,7 @@ func decodeRemoveAreaBlockingEntriesResponse(resp *http.Response) (res RemoveAre
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response RemoveAreaBlockingEntriesNotFound
+			var response ReplaceAreaBlockingNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7897,13 +10796,10 @@ func decodeRemoveAreaBlockingEntriesResponse(resp *http.Response) (res RemoveAre
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeRemoveAreaRoomPlanMarkersResponse(resp *http.Response) (res RemoveAreaRoomPlanMarkersRes, _ error) {
+func decodeReplaceBuildingBlockingResponse(resp *http.Response) (res ReplaceBuildingBlockingRes, _ error) {
 	switch resp.StatusCode {
-	case 204:
-		// Code 204.
-		return &RemoveAreaRoomPlanMarkersNoContent{}, nil
-	case 403:
-		// Code 403.
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -7916,7 +10812,7 @@ func decodeRemoveAreaRoomPlanMarkersResponse(resp *http.Response) (res RemoveAre
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response RemoveAreaRoomPlanMarkersForbidden
+			var response ReplaceBuildingBlockingOKApplicationJSON
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7933,12 +10829,21 @@ func decodeRemoveAreaRoomPlanMarkersResponse(resp *http.Response) (res RemoveAre
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -7951,7 +10856,7 @@ func decodeRemoveAreaRoomPlanMarkersResponse(resp *http.Response) (res RemoveAre
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response RemoveAreaRoomPlanMarkersNotFound
+			var response ReplaceBuildingBlockingBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -7972,15 +10877,6 @@ func decodeRemoveAreaRoomPlanMarkersResponse(resp *http.Response) (res RemoveAre
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	return res, validate.UnexpectedStatusCodeWithResponse(resp)
-}
-
-func decodeRemoveBuildingBlockingEntriesResponse(resp *http.Response) (res RemoveBuildingBlockingEntriesRes, _ error) {
-	switch resp.StatusCode {
-	case 204:
-		// Code 204.
-		return &RemoveBuildingBlockingEntriesNoContent{}, nil
 	case 403:
 		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
@@ -7995,7 +10891,7 @@ func decodeRemoveBuildingBlockingEntriesResponse(resp *http.Response) (res Remov
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response RemoveBuildingBlockingEntriesForbidden
+			var response ReplaceBuildingBlockingForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8030,7 +10926,7 @@ func decodeRemoveBuildingBlockingEntriesResponse(resp *http.Response) (res Remov
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response RemoveBuildingBlockingEntriesNotFound
+			var response ReplaceBuildingBlockingNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8055,13 +10951,10 @@ func decodeRemoveBuildingBlockingEntriesResponse(resp *http.Response) (res Remov
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeRemoveCurrentUserFavoritesResponse(resp *http.Response) (res RemoveCurrentUserFavoritesRes, _ error) {
+func decodeReplacePlaceBlockingResponse(resp *http.Response) (res ReplacePlaceBlockingRes, _ error) {
 	switch resp.StatusCode {
-	case 204:
-		// Code 204.
-		return &RemoveCurrentUserFavoritesNoContent{}, nil
-	case 401:
-		// Code 401.
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -8074,7 +10967,7 @@ func decodeRemoveCurrentUserFavoritesResponse(resp *http.Response) (res RemoveCu
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ErrorResponse
+			var response ReplacePlaceBlockingOKApplicationJSON
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8091,21 +10984,21 @@ func decodeRemoveCurrentUserFavoritesResponse(resp *http.Response) (res RemoveCu
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	return res, validate.UnexpectedStatusCodeWithResponse(resp)
-}
-
-func decodeRemoveGroupPermissionsResponse(resp *http.Response) (res RemoveGroupPermissionsRes, _ error) {
-	switch resp.StatusCode {
-	case 204:
-		// Code 204.
-		return &RemoveGroupPermissionsNoContent{}, nil
-	case 403:
-		// Code 403.
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -8118,7 +11011,7 @@ func decodeRemoveGroupPermissionsResponse(resp *http.Response) (res RemoveGroupP
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response RemoveGroupPermissionsForbidden
+			var response ReplacePlaceBlockingBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8139,8 +11032,8 @@ func decodeRemoveGroupPermissionsResponse(resp *http.Response) (res RemoveGroupP
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
+	case 403:
+		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -8153,7 +11046,7 @@ func decodeRemoveGroupPermissionsResponse(resp *http.Response) (res RemoveGroupP
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response RemoveGroupPermissionsNotFound
+			var response ReplacePlaceBlockingForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8174,17 +11067,8 @@ func decodeRemoveGroupPermissionsResponse(resp *http.Response) (res RemoveGroupP
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	return res, validate.UnexpectedStatusCodeWithResponse(resp)
-}
-
-func decodeRemovePlaceBlockingEntriesResponse(resp *http.Response) (res RemovePlaceBlockingEntriesRes, _ error) {
-	switch resp.StatusCode {
-	case 204:
-		// Code 204.
-		return &RemovePlaceBlockingEntriesNoContent{}, nil
-	case 403:
-		// Code 403.
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -8197,7 +11081,7 @@ func decodeRemovePlaceBlockingEntriesResponse(resp *http.Response) (res RemovePl
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response RemovePlaceBlockingEntriesForbidden
+			var response ReplacePlaceBlockingNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8218,8 +11102,17 @@ func decodeRemovePlaceBlockingEntriesResponse(resp *http.Response) (res RemovePl
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeRevokeApiKeyResponse(resp *http.Response) (res RevokeApiKeyRes, _ error) {
+	switch resp.StatusCode {
+	case 204:
+		// Code 204.
+		return &RevokeApiKeyNoContent{}, nil
+	case 403:
+		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -8232,7 +11125,7 @@ func decodeRemovePlaceBlockingEntriesResponse(resp *http.Response) (res RemovePl
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response RemovePlaceBlockingEntriesNotFound
+			var response RevokeApiKeyForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8253,17 +11146,8 @@ func decodeRemovePlaceBlockingEntriesResponse(resp *http.Response) (res RemovePl
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	return res, validate.UnexpectedStatusCodeWithResponse(resp)
-}
-
-func decodeRemovePlaceEquipmentResponse(resp *http.Response) (res RemovePlaceEquipmentRes, _ error) {
-	switch resp.StatusCode {
-	case 204:
-		// Code 204.
-		return &RemovePlaceEquipmentNoContent{}, nil
-	case 403:
-		// Code 403.
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -8276,7 +11160,7 @@ func decodeRemovePlaceEquipmentResponse(resp *http.Response) (res RemovePlaceEqu
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response RemovePlaceEquipmentForbidden
+			var response RevokeApiKeyNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8297,8 +11181,17 @@ func decodeRemovePlaceEquipmentResponse(resp *http.Response) (res RemovePlaceEqu
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeRevokeCurrentUserCalendarTokenResponse(resp *http.Response) (res RevokeCurrentUserCalendarTokenRes, _ error) {
+	switch resp.StatusCode {
+	case 204:
+		// Code 204.
+		return &RevokeCurrentUserCalendarTokenNoContent{}, nil
+	case 401:
+		// Code 401.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -8311,7 +11204,7 @@ func decodeRemovePlaceEquipmentResponse(resp *http.Response) (res RemovePlaceEqu
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response RemovePlaceEquipmentNotFound
+			var response ErrorResponse
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8336,13 +11229,10 @@ func decodeRemovePlaceEquipmentResponse(resp *http.Response) (res RemovePlaceEqu
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeRemovePlaceWhitelistUsersResponse(resp *http.Response) (res RemovePlaceWhitelistUsersRes, _ error) {
+func decodeRotateCurrentUserCalendarTokenResponse(resp *http.Response) (res RotateCurrentUserCalendarTokenRes, _ error) {
 	switch resp.StatusCode {
-	case 204:
-		// Code 204.
-		return &RemovePlaceWhitelistUsersNoContent{}, nil
-	case 403:
-		// Code 403.
+	case 201:
+		// Code 201.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -8355,7 +11245,7 @@ func decodeRemovePlaceWhitelistUsersResponse(resp *http.Response) (res RemovePla
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response RemovePlaceWhitelistUsersForbidden
+			var response CalendarTokenWithSecret
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8376,8 +11266,8 @@ func decodeRemovePlaceWhitelistUsersResponse(resp *http.Response) (res RemovePla
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
+	case 401:
+		// Code 401.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -8390,7 +11280,7 @@ func decodeRemovePlaceWhitelistUsersResponse(resp *http.Response) (res RemovePla
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response RemovePlaceWhitelistUsersNotFound
+			var response ErrorResponse
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8415,13 +11305,10 @@ func decodeRemovePlaceWhitelistUsersResponse(resp *http.Response) (res RemovePla
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeRemoveUserGroupsResponse(resp *http.Response) (res RemoveUserGroupsRes, _ error) {
+func decodeRotatePlaceQrKeyResponse(resp *http.Response) (res RotatePlaceQrKeyRes, _ error) {
 	switch resp.StatusCode {
-	case 204:
-		// Code 204.
-		return &RemoveUserGroupsNoContent{}, nil
-	case 403:
-		// Code 403.
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -8434,7 +11321,7 @@ func decodeRemoveUserGroupsResponse(resp *http.Response) (res RemoveUserGroupsRe
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response RemoveUserGroupsForbidden
+			var response PlaceQrKey
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8455,8 +11342,8 @@ func decodeRemoveUserGroupsResponse(resp *http.Response) (res RemoveUserGroupsRe
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
+	case 403:
+		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -8469,7 +11356,7 @@ func decodeRemoveUserGroupsResponse(resp *http.Response) (res RemoveUserGroupsRe
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response RemoveUserGroupsNotFound
+			var response RotatePlaceQrKeyForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8490,14 +11377,8 @@ func decodeRemoveUserGroupsResponse(resp *http.Response) (res RemoveUserGroupsRe
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	return res, validate.UnexpectedStatusCodeWithResponse(resp)
-}
-
-func decodeReplaceAreaBlockingResponse(resp *http.Response) (res ReplaceAreaBlockingRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -8510,7 +11391,7 @@ func decodeReplaceAreaBlockingResponse(resp *http.Response) (res ReplaceAreaBloc
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ReplaceAreaBlockingOKApplicationJSON
+			var response RotatePlaceQrKeyNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8527,21 +11408,18 @@ func decodeReplaceAreaBlockingResponse(resp *http.Response) (res ReplaceAreaBloc
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 400:
-		// Code 400.
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeSetDefaultGroupAssignmentResponse(resp *http.Response) (res SetDefaultGroupAssignmentRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -8554,7 +11432,7 @@ func decodeReplaceAreaBlockingResponse(resp *http.Response) (res ReplaceAreaBloc
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ReplaceAreaBlockingBadRequest
+			var response SetDefaultGroupAssignmentOKApplicationJSON
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8571,12 +11449,21 @@ func decodeReplaceAreaBlockingResponse(resp *http.Response) (res ReplaceAreaBloc
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 403:
-		// Code 403.
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -8589,7 +11476,7 @@ func decodeReplaceAreaBlockingResponse(resp *http.Response) (res ReplaceAreaBloc
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ReplaceAreaBlockingForbidden
+			var response SetDefaultGroupAssignmentBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8610,8 +11497,8 @@ func decodeReplaceAreaBlockingResponse(resp *http.Response) (res ReplaceAreaBloc
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
+	case 403:
+		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -8624,7 +11511,7 @@ func decodeReplaceAreaBlockingResponse(resp *http.Response) (res ReplaceAreaBloc
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ReplaceAreaBlockingNotFound
+			var response SetDefaultGroupAssignmentForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8649,7 +11536,7 @@ func decodeReplaceAreaBlockingResponse(resp *http.Response) (res ReplaceAreaBloc
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeReplaceBuildingBlockingResponse(resp *http.Response) (res ReplaceBuildingBlockingRes, _ error) {
+func decodeUpdateAreaResponse(resp *http.Response) (res UpdateAreaRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -8665,7 +11552,7 @@ func decodeReplaceBuildingBlockingResponse(resp *http.Response) (res ReplaceBuil
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ReplaceBuildingBlockingOKApplicationJSON
+			var response Area
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8709,7 +11596,7 @@ func decodeReplaceBuildingBlockingResponse(resp *http.Response) (res ReplaceBuil
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ReplaceBuildingBlockingBadRequest
+			var response UpdateAreaBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8744,7 +11631,7 @@ func decodeReplaceBuildingBlockingResponse(resp *http.Response) (res ReplaceBuil
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ReplaceBuildingBlockingForbidden
+			var response UpdateAreaForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8779,7 +11666,7 @@ func decodeReplaceBuildingBlockingResponse(resp *http.Response) (res ReplaceBuil
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ReplaceBuildingBlockingNotFound
+			var response UpdateAreaNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8804,7 +11691,7 @@ func decodeReplaceBuildingBlockingResponse(resp *http.Response) (res ReplaceBuil
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeReplacePlaceBlockingResponse(resp *http.Response) (res ReplacePlaceBlockingRes, _ error) {
+func decodeUpdateAreaConstraintsResponse(resp *http.Response) (res UpdateAreaConstraintsRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -8820,7 +11707,7 @@ func decodeReplacePlaceBlockingResponse(resp *http.Response) (res ReplacePlaceBl
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ReplacePlaceBlockingOKApplicationJSON
+			var response PlaceConstraints
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8864,7 +11751,7 @@ func decodeReplacePlaceBlockingResponse(resp *http.Response) (res ReplacePlaceBl
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ReplacePlaceBlockingBadRequest
+			var response UpdateAreaConstraintsBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8899,7 +11786,7 @@ func decodeReplacePlaceBlockingResponse(resp *http.Response) (res ReplacePlaceBl
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ReplacePlaceBlockingForbidden
+			var response UpdateAreaConstraintsForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8934,7 +11821,7 @@ func decodeReplacePlaceBlockingResponse(resp *http.Response) (res ReplacePlaceBl
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response ReplacePlaceBlockingNotFound
+			var response UpdateAreaConstraintsNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8959,13 +11846,10 @@ func decodeReplacePlaceBlockingResponse(resp *http.Response) (res ReplacePlaceBl
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeRevokeApiKeyResponse(resp *http.Response) (res RevokeApiKeyRes, _ error) {
+func decodeUpdateAreaRoomPlanResponse(resp *http.Response) (res UpdateAreaRoomPlanRes, _ error) {
 	switch resp.StatusCode {
-	case 204:
-		// Code 204.
-		return &RevokeApiKeyNoContent{}, nil
-	case 403:
-		// Code 403.
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -8978,7 +11862,7 @@ func decodeRevokeApiKeyResponse(resp *http.Response) (res RevokeApiKeyRes, _ err
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response RevokeApiKeyForbidden
+			var response RoomPlan
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -8995,53 +11879,21 @@ func decodeRevokeApiKeyResponse(resp *http.Response) (res RevokeApiKeyRes, _ err
 				}
 				return res, err
 			}
-			return &response, nil
-		default:
-			return res, validate.InvalidContentType(ct)
-		}
-	case 404:
-		// Code 404.
-		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
-		if err != nil {
-			return res, errors.Wrap(err, "parse media type")
-		}
-		switch {
-		case ct == "application/json":
-			buf, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return res, err
-			}
-			d := jx.DecodeBytes(buf)
-
-			var response RevokeApiKeyNotFound
+			// Validate response.
 			if err := func() error {
-				if err := response.Decode(d); err != nil {
+				if err := response.Validate(); err != nil {
 					return err
 				}
-				if err := d.Skip(); err != io.EOF {
-					return errors.New("unexpected trailing data")
-				}
 				return nil
 			}(); err != nil {
-				err = &ogenerrors.DecodeBodyError{
-					ContentType: ct,
-					Body:        buf,
-					Err:         err,
-				}
-				return res, err
+				return res, errors.Wrap(err, "validate")
 			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	return res, validate.UnexpectedStatusCodeWithResponse(resp)
-}
-
-func decodeSetDefaultGroupAssignmentResponse(resp *http.Response) (res SetDefaultGroupAssignmentRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -9054,7 +11906,7 @@ func decodeSetDefaultGroupAssignmentResponse(resp *http.Response) (res SetDefaul
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response SetDefaultGroupAssignmentOKApplicationJSON
+			var response UpdateAreaRoomPlanBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -9071,21 +11923,12 @@ func decodeSetDefaultGroupAssignmentResponse(resp *http.Response) (res SetDefaul
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 400:
-		// Code 400.
+	case 403:
+		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -9098,7 +11941,7 @@ func decodeSetDefaultGroupAssignmentResponse(resp *http.Response) (res SetDefaul
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response SetDefaultGroupAssignmentBadRequest
+			var response UpdateAreaRoomPlanForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -9119,8 +11962,8 @@ func decodeSetDefaultGroupAssignmentResponse(resp *http.Response) (res SetDefaul
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 403:
-		// Code 403.
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -9133,7 +11976,7 @@ func decodeSetDefaultGroupAssignmentResponse(resp *http.Response) (res SetDefaul
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response SetDefaultGroupAssignmentForbidden
+			var response UpdateAreaRoomPlanNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -9158,7 +12001,7 @@ func decodeSetDefaultGroupAssignmentResponse(resp *http.Response) (res SetDefaul
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeUpdateAreaResponse(resp *http.Response) (res UpdateAreaRes, _ error) {
+func decodeUpdateBuildingResponse(resp *http.Response) (res UpdateBuildingRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -9174,7 +12017,7 @@ func decodeUpdateAreaResponse(resp *http.Response) (res UpdateAreaRes, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Area
+			var response Building
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -9191,15 +12034,6 @@ func decodeUpdateAreaResponse(resp *http.Response) (res UpdateAreaRes, _ error)
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -9218,7 +12052,7 @@ func decodeUpdateAreaResponse(resp *http.Response) (res UpdateAreaRes, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response UpdateAreaBadRequest
+			var response UpdateBuildingBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -9253,7 +12087,7 @@ func decodeUpdateAreaResponse(resp *http.Response) (res UpdateAreaRes, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response UpdateAreaForbidden
+			var response UpdateBuildingForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -9288,7 +12122,7 @@ func decodeUpdateAreaResponse(resp *http.Response) (res UpdateAreaRes, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response UpdateAreaNotFound
+			var response UpdateBuildingNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -9313,7 +12147,7 @@ func decodeUpdateAreaResponse(resp *http.Response) (res UpdateAreaRes, _ error)
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeUpdateAreaRoomPlanResponse(resp *http.Response) (res UpdateAreaRoomPlanRes, _ error) {
+func decodeUpdateBuildingConstraintsResponse(resp *http.Response) (res UpdateBuildingConstraintsRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -9329,7 +12163,7 @@ func decodeUpdateAreaRoomPlanResponse(resp *http.Response) (res UpdateAreaRoomPl
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response RoomPlan
+			var response PlaceConstraints
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -9373,7 +12207,7 @@ func decodeUpdateAreaRoomPlanResponse(resp *http.Response) (res UpdateAreaRoomPl
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response UpdateAreaRoomPlanBadRequest
+			var response UpdateBuildingConstraintsBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -9408,7 +12242,7 @@ func decodeUpdateAreaRoomPlanResponse(resp *http.Response) (res UpdateAreaRoomPl
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response UpdateAreaRoomPlanForbidden
+			var response UpdateBuildingConstraintsForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -9443,7 +12277,7 @@ func decodeUpdateAreaRoomPlanResponse(resp *http.Response) (res UpdateAreaRoomPl
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response UpdateAreaRoomPlanNotFound
+			var response UpdateBuildingConstraintsNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -9468,7 +12302,7 @@ func decodeUpdateAreaRoomPlanResponse(resp *http.Response) (res UpdateAreaRoomPl
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeUpdateBuildingResponse(resp *http.Response) (res UpdateBuildingRes, _ error) {
+func decodeUpdateCurrentUserNotificationsResponse(resp *http.Response) (res UpdateCurrentUserNotificationsRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -9484,7 +12318,7 @@ func decodeUpdateBuildingResponse(resp *http.Response) (res UpdateBuildingRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Building
+			var response NotificationPreferences
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -9501,6 +12335,15 @@ func decodeUpdateBuildingResponse(resp *http.Response) (res UpdateBuildingRes, _
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -9519,7 +12362,7 @@ func decodeUpdateBuildingResponse(resp *http.Response) (res UpdateBuildingRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response UpdateBuildingBadRequest
+			var response UpdateCurrentUserNotificationsBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -9540,8 +12383,8 @@ func decodeUpdateBuildingResponse(resp *http.Response) (res UpdateBuildingRes, _
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 403:
-		// Code 403.
+	case 401:
+		// Code 401.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -9554,7 +12397,7 @@ func decodeUpdateBuildingResponse(resp *http.Response) (res UpdateBuildingRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response UpdateBuildingForbidden
+			var response UpdateCurrentUserNotificationsUnauthorized
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -9575,8 +12418,14 @@ func decodeUpdateBuildingResponse(resp *http.Response) (res UpdateBuildingRes, _
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 404:
-		// Code 404.
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeUpdateEquipmentResponse(resp *http.Response) (res UpdateEquipmentRes, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -9589,7 +12438,7 @@ func decodeUpdateBuildingResponse(resp *http.Response) (res UpdateBuildingRes, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response UpdateBuildingNotFound
+			var response Equipment
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -9610,14 +12459,8 @@ func decodeUpdateBuildingResponse(resp *http.Response) (res UpdateBuildingRes, _
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	}
-	return res, validate.UnexpectedStatusCodeWithResponse(resp)
-}
-
-func decodeUpdateCurrentUserNotificationsResponse(resp *http.Response) (res UpdateCurrentUserNotificationsRes, _ error) {
-	switch resp.StatusCode {
-	case 200:
-		// Code 200.
+	case 400:
+		// Code 400.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -9630,7 +12473,7 @@ func decodeUpdateCurrentUserNotificationsResponse(resp *http.Response) (res Upda
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response NotificationPreferences
+			var response UpdateEquipmentBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -9647,21 +12490,12 @@ func decodeUpdateCurrentUserNotificationsResponse(resp *http.Response) (res Upda
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if err := response.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 400:
-		// Code 400.
+	case 403:
+		// Code 403.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -9674,7 +12508,7 @@ func decodeUpdateCurrentUserNotificationsResponse(resp *http.Response) (res Upda
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response UpdateCurrentUserNotificationsBadRequest
+			var response UpdateEquipmentForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -9695,8 +12529,8 @@ func decodeUpdateCurrentUserNotificationsResponse(resp *http.Response) (res Upda
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
-	case 401:
-		// Code 401.
+	case 404:
+		// Code 404.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -9709,7 +12543,7 @@ func decodeUpdateCurrentUserNotificationsResponse(resp *http.Response) (res Upda
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response UpdateCurrentUserNotificationsUnauthorized
+			var response UpdateEquipmentNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -9734,7 +12568,7 @@ func decodeUpdateCurrentUserNotificationsResponse(resp *http.Response) (res Upda
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeUpdateEquipmentResponse(resp *http.Response) (res UpdateEquipmentRes, _ error) {
+func decodeUpdateGroupResponse(resp *http.Response) (res UpdateGroupRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -9750,7 +12584,7 @@ func decodeUpdateEquipmentResponse(resp *http.Response) (res UpdateEquipmentRes,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Equipment
+			var response Group
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -9767,6 +12601,15 @@ func decodeUpdateEquipmentResponse(resp *http.Response) (res UpdateEquipmentRes,
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -9785,7 +12628,7 @@ func decodeUpdateEquipmentResponse(resp *http.Response) (res UpdateEquipmentRes,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response UpdateEquipmentBadRequest
+			var response UpdateGroupBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -9820,7 +12663,7 @@ func decodeUpdateEquipmentResponse(resp *http.Response) (res UpdateEquipmentRes,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response UpdateEquipmentForbidden
+			var response UpdateGroupForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -9855,7 +12698,7 @@ func decodeUpdateEquipmentResponse(resp *http.Response) (res UpdateEquipmentRes,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response UpdateEquipmentNotFound
+			var response UpdateGroupNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -9880,7 +12723,7 @@ func decodeUpdateEquipmentResponse(resp *http.Response) (res UpdateEquipmentRes,
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeUpdateGroupResponse(resp *http.Response) (res UpdateGroupRes, _ error) {
+func decodeUpdateGroupConstraintProfileResponse(resp *http.Response) (res UpdateGroupConstraintProfileRes, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -9896,7 +12739,7 @@ func decodeUpdateGroupResponse(resp *http.Response) (res UpdateGroupRes, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Group
+			var response ConstraintProfile
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -9913,6 +12756,15 @@ func decodeUpdateGroupResponse(resp *http.Response) (res UpdateGroupRes, _ error
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -9931,7 +12783,7 @@ func decodeUpdateGroupResponse(resp *http.Response) (res UpdateGroupRes, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response UpdateGroupBadRequest
+			var response UpdateGroupConstraintProfileBadRequest
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -9966,7 +12818,7 @@ func decodeUpdateGroupResponse(resp *http.Response) (res UpdateGroupRes, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response UpdateGroupForbidden
+			var response UpdateGroupConstraintProfileForbidden
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -10001,7 +12853,7 @@ func decodeUpdateGroupResponse(resp *http.Response) (res UpdateGroupRes, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response UpdateGroupNotFound
+			var response UpdateGroupConstraintProfileNotFound
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -10214,6 +13066,15 @@ func decodeUpdatePlaceConstraintsResponse(resp *http.Response) (res UpdatePlaceC
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
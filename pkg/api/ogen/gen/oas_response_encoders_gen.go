@@ -8,6 +8,8 @@ import (
 
 	"github.com/go-faster/errors"
 	"github.com/go-faster/jx"
+	"github.com/ogen-go/ogen/conv"
+	"github.com/ogen-go/ogen/uri"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -557,6 +559,19 @@ func encodeCancelReservationResponse(response CancelReservationRes, w http.Respo
 
 		return nil
 
+	case *CancelReservationConflict:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(409)
+		span.SetStatus(codes.Error, http.StatusText(409))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
 	default:
 		return errors.Errorf("unexpected response type: %T", response)
 	}
@@ -590,6 +605,19 @@ func encodeCheckInReservationResponse(response CheckInReservationRes, w http.Res
 
 		return nil
 
+	case *CheckInReservationUnauthorized:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
 	case *CheckInReservationForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(403)
@@ -616,6 +644,19 @@ func encodeCheckInReservationResponse(response CheckInReservationRes, w http.Res
 
 		return nil
 
+	case *CheckInReservationConflict:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(409)
+		span.SetStatus(codes.Error, http.StatusText(409))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
 	default:
 		return errors.Errorf("unexpected response type: %T", response)
 	}
@@ -851,6 +892,65 @@ func encodeCreateGroupResponse(response CreateGroupRes, w http.ResponseWriter, s
 	}
 }
 
+func encodeCreateGroupConstraintProfileResponse(response CreateGroupConstraintProfileRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *ConstraintProfile:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(201)
+		span.SetStatus(codes.Ok, http.StatusText(201))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *CreateGroupConstraintProfileBadRequest:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *CreateGroupConstraintProfileForbidden:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *CreateGroupConstraintProfileNotFound:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
 func encodeCreatePlaceResponse(response CreatePlaceRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
 	case *Place:
@@ -1210,6 +1310,45 @@ func encodeDeleteGroupResponse(response DeleteGroupRes, w http.ResponseWriter, s
 	}
 }
 
+func encodeDeleteGroupConstraintProfileResponse(response DeleteGroupConstraintProfileRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *DeleteGroupConstraintProfileNoContent:
+		w.WriteHeader(204)
+		span.SetStatus(codes.Ok, http.StatusText(204))
+
+		return nil
+
+	case *DeleteGroupConstraintProfileForbidden:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *DeleteGroupConstraintProfileNotFound:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
 func encodeDeletePlaceResponse(response DeletePlaceRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
 	case *DeletePlaceNoContent:
@@ -1380,10 +1519,10 @@ func encodeEnableUserResponse(response EnableUserRes, w http.ResponseWriter, spa
 	}
 }
 
-func encodeExportReservationsResponse(response ExportReservationsRes, w http.ResponseWriter, span trace.Span) error {
+func encodeExportAreaQrCodesResponse(response ExportAreaQrCodesRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *ExportReservationsOK:
-		w.Header().Set("Content-Type", "text/csv")
+	case *QrCodeExportApplicationPdf:
+		w.Header().Set("Content-Type", "application/pdf")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
 
@@ -1397,10 +1536,25 @@ func encodeExportReservationsResponse(response ExportReservationsRes, w http.Res
 
 		return nil
 
-	case *ErrorResponse:
+	case *QrCodeExportApplicationZip:
+		w.Header().Set("Content-Type", "application/zip")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		writer := w
+		if closer, ok := response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *ExportAreaQrCodesBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1410,17 +1564,10 @@ func encodeExportReservationsResponse(response ExportReservationsRes, w http.Res
 
 		return nil
 
-	default:
-		return errors.Errorf("unexpected response type: %T", response)
-	}
-}
-
-func encodeGetAreaResponse(response GetAreaRes, w http.ResponseWriter, span trace.Span) error {
-	switch response := response.(type) {
-	case *Area:
+	case *ExportAreaQrCodesForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1430,7 +1577,7 @@ func encodeGetAreaResponse(response GetAreaRes, w http.ResponseWriter, span trac
 
 		return nil
 
-	case *ErrorResponse:
+	case *ExportAreaQrCodesNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -1448,25 +1595,42 @@ func encodeGetAreaResponse(response GetAreaRes, w http.ResponseWriter, span trac
 	}
 }
 
-func encodeGetAreaAvailabilityResponse(response GetAreaAvailabilityRes, w http.ResponseWriter, span trace.Span) error {
+func encodeExportBuildingQrCodesResponse(response ExportBuildingQrCodesRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *GetAreaAvailabilityOKApplicationJSON:
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	case *QrCodeExportApplicationPdf:
+		w.Header().Set("Content-Type", "application/pdf")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
 
-		e := new(jx.Encoder)
-		response.Encode(e)
-		if _, err := e.WriteTo(w); err != nil {
+		writer := w
+		if closer, ok := response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response); err != nil {
 			return errors.Wrap(err, "write")
 		}
 
 		return nil
 
-	case *ErrorResponse:
+	case *QrCodeExportApplicationZip:
+		w.Header().Set("Content-Type", "application/zip")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		writer := w
+		if closer, ok := response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *ExportBuildingQrCodesBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1476,17 +1640,10 @@ func encodeGetAreaAvailabilityResponse(response GetAreaAvailabilityRes, w http.R
 
 		return nil
 
-	default:
-		return errors.Errorf("unexpected response type: %T", response)
-	}
-}
-
-func encodeGetAreaBlockingResponse(response GetAreaBlockingRes, w http.ResponseWriter, span trace.Span) error {
-	switch response := response.(type) {
-	case *GetAreaBlockingOKApplicationJSON:
+	case *ExportBuildingQrCodesForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1496,7 +1653,7 @@ func encodeGetAreaBlockingResponse(response GetAreaBlockingRes, w http.ResponseW
 
 		return nil
 
-	case *ErrorResponse:
+	case *ExportBuildingQrCodesNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -1514,10 +1671,10 @@ func encodeGetAreaBlockingResponse(response GetAreaBlockingRes, w http.ResponseW
 	}
 }
 
-func encodeGetAreaCalendarResponse(response GetAreaCalendarRes, w http.ResponseWriter, span trace.Span) error {
+func encodeExportReservationsResponse(response ExportReservationsRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *GetAreaCalendarOK:
-		w.Header().Set("Content-Type", "text/calendar")
+	case *ExportReservationsOK:
+		w.Header().Set("Content-Type", "text/csv")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
 
@@ -1533,8 +1690,8 @@ func encodeGetAreaCalendarResponse(response GetAreaCalendarRes, w http.ResponseW
 
 	case *ErrorResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1549,9 +1706,9 @@ func encodeGetAreaCalendarResponse(response GetAreaCalendarRes, w http.ResponseW
 	}
 }
 
-func encodeGetAreaRoomPlanResponse(response GetAreaRoomPlanRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetAreaResponse(response GetAreaRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *RoomPlan:
+	case *Area:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1582,9 +1739,9 @@ func encodeGetAreaRoomPlanResponse(response GetAreaRoomPlanRes, w http.ResponseW
 	}
 }
 
-func encodeGetAuditLogResponse(response GetAuditLogRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetAreaAvailabilityResponse(response GetAreaAvailabilityRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *PaginatedAuditLogList:
+	case *GetAreaAvailabilityOKApplicationJSON:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1597,10 +1754,23 @@ func encodeGetAuditLogResponse(response GetAuditLogRes, w http.ResponseWriter, s
 
 		return nil
 
-	case *ErrorResponse:
+	case *GetAreaAvailabilityBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetAreaAvailabilityNotFound:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1615,9 +1785,9 @@ func encodeGetAuditLogResponse(response GetAuditLogRes, w http.ResponseWriter, s
 	}
 }
 
-func encodeGetBuildingResponse(response GetBuildingRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetAreaBlockingResponse(response GetAreaBlockingRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *Building:
+	case *GetAreaBlockingOKApplicationJSON:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1648,21 +1818,82 @@ func encodeGetBuildingResponse(response GetBuildingRes, w http.ResponseWriter, s
 	}
 }
 
-func encodeGetBuildingAvailabilityResponse(response GetBuildingAvailabilityRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetAreaCalendarResponse(response GetAreaCalendarRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *GetBuildingAvailabilityOKApplicationJSON:
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	case *CalendarFeedHeaders:
+		w.Header().Set("Content-Type", "text/calendar")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "ETag" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ETag.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode ETag header")
+				}
+			}
+			// Encode "Last-Modified" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Last-Modified",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.LastModified.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Last-Modified header")
+				}
+			}
+		}
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
 
-		e := new(jx.Encoder)
-		response.Encode(e)
-		if _, err := e.WriteTo(w); err != nil {
+		writer := w
+		if closer, ok := response.Response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response.Response); err != nil {
 			return errors.Wrap(err, "write")
 		}
 
 		return nil
 
+	case *NotModified:
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "ETag" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ETag.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode ETag header")
+				}
+			}
+		}
+		w.WriteHeader(304)
+		span.SetStatus(codes.Ok, http.StatusText(304))
+
+		return nil
+
 	case *ErrorResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
@@ -1681,9 +1912,9 @@ func encodeGetBuildingAvailabilityResponse(response GetBuildingAvailabilityRes,
 	}
 }
 
-func encodeGetBuildingBlockingResponse(response GetBuildingBlockingRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetAreaConstraintsResponse(response GetAreaConstraintsRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *GetBuildingBlockingOKApplicationJSON:
+	case *PlaceConstraints:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1714,18 +1945,16 @@ func encodeGetBuildingBlockingResponse(response GetBuildingBlockingRes, w http.R
 	}
 }
 
-func encodeGetBuildingCalendarResponse(response GetBuildingCalendarRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetAreaRoomPlanResponse(response GetAreaRoomPlanRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *GetBuildingCalendarOK:
-		w.Header().Set("Content-Type", "text/calendar")
+	case *RoomPlan:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
 
-		writer := w
-		if closer, ok := response.Data.(io.Closer); ok {
-			defer closer.Close()
-		}
-		if _, err := io.Copy(writer, response); err != nil {
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
 			return errors.Wrap(err, "write")
 		}
 
@@ -1749,9 +1978,9 @@ func encodeGetBuildingCalendarResponse(response GetBuildingCalendarRes, w http.R
 	}
 }
 
-func encodeGetCurrentOccupancyResponse(response GetCurrentOccupancyRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetAuditLogResponse(response GetAuditLogRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *CurrentOccupancy:
+	case *PaginatedAuditLogList:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1782,9 +2011,9 @@ func encodeGetCurrentOccupancyResponse(response GetCurrentOccupancyRes, w http.R
 	}
 }
 
-func encodeGetCurrentUserResponse(response GetCurrentUserRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetBuildingResponse(response GetBuildingRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *User:
+	case *Building:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1799,8 +2028,8 @@ func encodeGetCurrentUserResponse(response GetCurrentUserRes, w http.ResponseWri
 
 	case *ErrorResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1815,9 +2044,9 @@ func encodeGetCurrentUserResponse(response GetCurrentUserRes, w http.ResponseWri
 	}
 }
 
-func encodeGetCurrentUserFavoritesResponse(response GetCurrentUserFavoritesRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetBuildingAvailabilityResponse(response GetBuildingAvailabilityRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *GetCurrentUserFavoritesOKApplicationJSON:
+	case *BuildingAvailability:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1830,10 +2059,23 @@ func encodeGetCurrentUserFavoritesResponse(response GetCurrentUserFavoritesRes,
 
 		return nil
 
-	case *ErrorResponse:
+	case *GetBuildingAvailabilityBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetBuildingAvailabilityNotFound:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1848,9 +2090,9 @@ func encodeGetCurrentUserFavoritesResponse(response GetCurrentUserFavoritesRes,
 	}
 }
 
-func encodeGetCurrentUserNotificationsResponse(response GetCurrentUserNotificationsRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetBuildingBlockingResponse(response GetBuildingBlockingRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *NotificationPreferences:
+	case *GetBuildingBlockingOKApplicationJSON:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1865,8 +2107,8 @@ func encodeGetCurrentUserNotificationsResponse(response GetCurrentUserNotificati
 
 	case *ErrorResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1881,25 +2123,86 @@ func encodeGetCurrentUserNotificationsResponse(response GetCurrentUserNotificati
 	}
 }
 
-func encodeGetDefaultGroupAssignmentResponse(response GetDefaultGroupAssignmentRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetBuildingCalendarResponse(response GetBuildingCalendarRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *GetDefaultGroupAssignmentOKApplicationJSON:
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	case *CalendarFeedHeaders:
+		w.Header().Set("Content-Type", "text/calendar")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "ETag" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ETag.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode ETag header")
+				}
+			}
+			// Encode "Last-Modified" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Last-Modified",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.LastModified.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Last-Modified header")
+				}
+			}
+		}
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
 
-		e := new(jx.Encoder)
-		response.Encode(e)
-		if _, err := e.WriteTo(w); err != nil {
+		writer := w
+		if closer, ok := response.Response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response.Response); err != nil {
 			return errors.Wrap(err, "write")
 		}
 
 		return nil
 
+	case *NotModified:
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "ETag" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ETag.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode ETag header")
+				}
+			}
+		}
+		w.WriteHeader(304)
+		span.SetStatus(codes.Ok, http.StatusText(304))
+
+		return nil
+
 	case *ErrorResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -1914,9 +2217,9 @@ func encodeGetDefaultGroupAssignmentResponse(response GetDefaultGroupAssignmentR
 	}
 }
 
-func encodeGetEquipmentResponse(response GetEquipmentRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetBuildingConstraintsResponse(response GetBuildingConstraintsRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *Equipment:
+	case *PlaceConstraints:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1947,9 +2250,9 @@ func encodeGetEquipmentResponse(response GetEquipmentRes, w http.ResponseWriter,
 	}
 }
 
-func encodeGetGroupResponse(response GetGroupRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetCurrentOccupancyResponse(response GetCurrentOccupancyRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *GroupWithMembers:
+	case *CurrentOccupancy:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -1962,7 +2265,7 @@ func encodeGetGroupResponse(response GetGroupRes, w http.ResponseWriter, span tr
 
 		return nil
 
-	case *GetGroupForbidden:
+	case *ErrorResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(403)
 		span.SetStatus(codes.Error, http.StatusText(403))
@@ -1975,27 +2278,14 @@ func encodeGetGroupResponse(response GetGroupRes, w http.ResponseWriter, span tr
 
 		return nil
 
-	case *GetGroupNotFound:
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
-
-		e := new(jx.Encoder)
-		response.Encode(e)
-		if _, err := e.WriteTo(w); err != nil {
-			return errors.Wrap(err, "write")
-		}
-
-		return nil
-
 	default:
 		return errors.Errorf("unexpected response type: %T", response)
 	}
 }
 
-func encodeGetGroupMembersResponse(response GetGroupMembersRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetCurrentUserResponse(response GetCurrentUserRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *GetGroupMembersOKApplicationJSON:
+	case *User:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -2008,23 +2298,10 @@ func encodeGetGroupMembersResponse(response GetGroupMembersRes, w http.ResponseW
 
 		return nil
 
-	case *GetGroupMembersForbidden:
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
-
-		e := new(jx.Encoder)
-		response.Encode(e)
-		if _, err := e.WriteTo(w); err != nil {
-			return errors.Wrap(err, "write")
-		}
-
-		return nil
-
-	case *GetGroupMembersNotFound:
+	case *ErrorResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2039,9 +2316,9 @@ func encodeGetGroupMembersResponse(response GetGroupMembersRes, w http.ResponseW
 	}
 }
 
-func encodeGetGroupPermissionsResponse(response GetGroupPermissionsRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetCurrentUserCalendarTokenResponse(response GetCurrentUserCalendarTokenRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *GetGroupPermissionsOKApplicationJSON:
+	case *CalendarToken:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -2054,10 +2331,10 @@ func encodeGetGroupPermissionsResponse(response GetGroupPermissionsRes, w http.R
 
 		return nil
 
-	case *GetGroupPermissionsForbidden:
+	case *GetCurrentUserCalendarTokenUnauthorized:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2067,7 +2344,7 @@ func encodeGetGroupPermissionsResponse(response GetGroupPermissionsRes, w http.R
 
 		return nil
 
-	case *GetGroupPermissionsNotFound:
+	case *GetCurrentUserCalendarTokenNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -2085,9 +2362,9 @@ func encodeGetGroupPermissionsResponse(response GetGroupPermissionsRes, w http.R
 	}
 }
 
-func encodeGetPlaceResponse(response GetPlaceRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetCurrentUserFavoritesResponse(response GetCurrentUserFavoritesRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *Place:
+	case *GetCurrentUserFavoritesOKApplicationJSON:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -2102,8 +2379,8 @@ func encodeGetPlaceResponse(response GetPlaceRes, w http.ResponseWriter, span tr
 
 	case *ErrorResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2118,9 +2395,9 @@ func encodeGetPlaceResponse(response GetPlaceRes, w http.ResponseWriter, span tr
 	}
 }
 
-func encodeGetPlaceAvailabilityResponse(response GetPlaceAvailabilityRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetCurrentUserNotificationsResponse(response GetCurrentUserNotificationsRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *GetPlaceAvailabilityOKApplicationJSON:
+	case *NotificationPreferences:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -2135,8 +2412,8 @@ func encodeGetPlaceAvailabilityResponse(response GetPlaceAvailabilityRes, w http
 
 	case *ErrorResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2151,9 +2428,9 @@ func encodeGetPlaceAvailabilityResponse(response GetPlaceAvailabilityRes, w http
 	}
 }
 
-func encodeGetPlaceBlockingResponse(response GetPlaceBlockingRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetDefaultGroupAssignmentResponse(response GetDefaultGroupAssignmentRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *GetPlaceBlockingOKApplicationJSON:
+	case *GetDefaultGroupAssignmentOKApplicationJSON:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -2168,8 +2445,8 @@ func encodeGetPlaceBlockingResponse(response GetPlaceBlockingRes, w http.Respons
 
 	case *ErrorResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2184,18 +2461,16 @@ func encodeGetPlaceBlockingResponse(response GetPlaceBlockingRes, w http.Respons
 	}
 }
 
-func encodeGetPlaceCalendarResponse(response GetPlaceCalendarRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetEffectivePlaceConstraintsResponse(response GetEffectivePlaceConstraintsRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *GetPlaceCalendarOK:
-		w.Header().Set("Content-Type", "text/calendar")
+	case *EffectivePlaceConstraints:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
 
-		writer := w
-		if closer, ok := response.Data.(io.Closer); ok {
-			defer closer.Close()
-		}
-		if _, err := io.Copy(writer, response); err != nil {
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
 			return errors.Wrap(err, "write")
 		}
 
@@ -2219,9 +2494,9 @@ func encodeGetPlaceCalendarResponse(response GetPlaceCalendarRes, w http.Respons
 	}
 }
 
-func encodeGetPlaceConstraintsResponse(response GetPlaceConstraintsRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetEquipmentResponse(response GetEquipmentRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *PlaceConstraints:
+	case *Equipment:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -2252,9 +2527,9 @@ func encodeGetPlaceConstraintsResponse(response GetPlaceConstraintsRes, w http.R
 	}
 }
 
-func encodeGetPlaceEquipmentResponse(response GetPlaceEquipmentRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetGroupResponse(response GetGroupRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *GetPlaceEquipmentOKApplicationJSON:
+	case *GroupWithMembers:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -2267,7 +2542,20 @@ func encodeGetPlaceEquipmentResponse(response GetPlaceEquipmentRes, w http.Respo
 
 		return nil
 
-	case *ErrorResponse:
+	case *GetGroupForbidden:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetGroupNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -2285,54 +2573,68 @@ func encodeGetPlaceEquipmentResponse(response GetPlaceEquipmentRes, w http.Respo
 	}
 }
 
-func encodeGetPlaceQrCodeResponse(response GetPlaceQrCodeRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetGroupMembersResponse(response GetGroupMembersRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *GetPlaceQrCodeOKApplicationPdf:
-		w.Header().Set("Content-Type", "application/pdf")
+	case *GetGroupMembersOKApplicationJSON:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
 
-		writer := w
-		if closer, ok := response.Data.(io.Closer); ok {
-			defer closer.Close()
-		}
-		if _, err := io.Copy(writer, response); err != nil {
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
 			return errors.Wrap(err, "write")
 		}
 
 		return nil
 
-	case *GetPlaceQrCodeOKImageJpeg:
-		w.Header().Set("Content-Type", "image/jpeg")
-		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
+	case *GetGroupMembersForbidden:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
 
-		writer := w
-		if closer, ok := response.Data.(io.Closer); ok {
-			defer closer.Close()
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
 		}
-		if _, err := io.Copy(writer, response); err != nil {
+
+		return nil
+
+	case *GetGroupMembersNotFound:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
 			return errors.Wrap(err, "write")
 		}
 
 		return nil
 
-	case *GetPlaceQrCodeOKImagePNG:
-		w.Header().Set("Content-Type", "image/png")
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetGroupPermissionsResponse(response GetGroupPermissionsRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *GetGroupPermissionsOKApplicationJSON:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
 
-		writer := w
-		if closer, ok := response.Data.(io.Closer); ok {
-			defer closer.Close()
-		}
-		if _, err := io.Copy(writer, response); err != nil {
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
 			return errors.Wrap(err, "write")
 		}
 
 		return nil
 
-	case *GetPlaceQrCodeForbidden:
+	case *GetGroupPermissionsForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(403)
 		span.SetStatus(codes.Error, http.StatusText(403))
@@ -2345,7 +2647,7 @@ func encodeGetPlaceQrCodeResponse(response GetPlaceQrCodeRes, w http.ResponseWri
 
 		return nil
 
-	case *GetPlaceQrCodeNotFound:
+	case *GetGroupPermissionsNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -2363,9 +2665,9 @@ func encodeGetPlaceQrCodeResponse(response GetPlaceQrCodeRes, w http.ResponseWri
 	}
 }
 
-func encodeGetPlaceTimeSlotsResponse(response GetPlaceTimeSlotsRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetPlaceResponse(response GetPlaceRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *TimeSlotConfig:
+	case *Place:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -2396,9 +2698,9 @@ func encodeGetPlaceTimeSlotsResponse(response GetPlaceTimeSlotsRes, w http.Respo
 	}
 }
 
-func encodeGetPlaceWhitelistResponse(response GetPlaceWhitelistRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetPlaceAvailabilityResponse(response GetPlaceAvailabilityRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *GetPlaceWhitelistOKApplicationJSON:
+	case *GetPlaceAvailabilityOKApplicationJSON:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -2411,10 +2713,10 @@ func encodeGetPlaceWhitelistResponse(response GetPlaceWhitelistRes, w http.Respo
 
 		return nil
 
-	case *GetPlaceWhitelistForbidden:
+	case *GetPlaceAvailabilityBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2424,7 +2726,7 @@ func encodeGetPlaceWhitelistResponse(response GetPlaceWhitelistRes, w http.Respo
 
 		return nil
 
-	case *GetPlaceWhitelistNotFound:
+	case *GetPlaceAvailabilityNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -2442,9 +2744,9 @@ func encodeGetPlaceWhitelistResponse(response GetPlaceWhitelistRes, w http.Respo
 	}
 }
 
-func encodeGetQrTemplateResponse(response GetQrTemplateRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetPlaceBlockingResponse(response GetPlaceBlockingRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *QRTemplate:
+	case *GetPlaceBlockingOKApplicationJSON:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -2457,10 +2759,10 @@ func encodeGetQrTemplateResponse(response GetQrTemplateRes, w http.ResponseWrite
 
 		return nil
 
-	case *GetQrTemplateForbidden:
+	case *ErrorResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2470,7 +2772,88 @@ func encodeGetQrTemplateResponse(response GetQrTemplateRes, w http.ResponseWrite
 
 		return nil
 
-	case *GetQrTemplateNotFound:
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetPlaceCalendarResponse(response GetPlaceCalendarRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *CalendarFeedHeaders:
+		w.Header().Set("Content-Type", "text/calendar")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "ETag" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ETag.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode ETag header")
+				}
+			}
+			// Encode "Last-Modified" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Last-Modified",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.LastModified.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Last-Modified header")
+				}
+			}
+		}
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		writer := w
+		if closer, ok := response.Response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response.Response); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *NotModified:
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "ETag" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ETag.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode ETag header")
+				}
+			}
+		}
+		w.WriteHeader(304)
+		span.SetStatus(codes.Ok, http.StatusText(304))
+
+		return nil
+
+	case *ErrorResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -2488,9 +2871,9 @@ func encodeGetQrTemplateResponse(response GetQrTemplateRes, w http.ResponseWrite
 	}
 }
 
-func encodeGetReservationResponse(response GetReservationRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetPlaceConstraintsResponse(response GetPlaceConstraintsRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *Reservation:
+	case *PlaceConstraints:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -2503,10 +2886,10 @@ func encodeGetReservationResponse(response GetReservationRes, w http.ResponseWri
 
 		return nil
 
-	case *GetReservationForbidden:
+	case *ErrorResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2516,7 +2899,27 @@ func encodeGetReservationResponse(response GetReservationRes, w http.ResponseWri
 
 		return nil
 
-	case *GetReservationNotFound:
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetPlaceEquipmentResponse(response GetPlaceEquipmentRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *GetPlaceEquipmentOKApplicationJSON:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *ErrorResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -2534,22 +2937,54 @@ func encodeGetReservationResponse(response GetReservationRes, w http.ResponseWri
 	}
 }
 
-func encodeGetReservationShareLinkResponse(response GetReservationShareLinkRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetPlaceQrCodeResponse(response GetPlaceQrCodeRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *ShareResponse:
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	case *GetPlaceQrCodeOKApplicationPdf:
+		w.Header().Set("Content-Type", "application/pdf")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
 
-		e := new(jx.Encoder)
-		response.Encode(e)
-		if _, err := e.WriteTo(w); err != nil {
+		writer := w
+		if closer, ok := response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response); err != nil {
 			return errors.Wrap(err, "write")
 		}
 
 		return nil
 
-	case *GetReservationShareLinkForbidden:
+	case *GetPlaceQrCodeOKImageJpeg:
+		w.Header().Set("Content-Type", "image/jpeg")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		writer := w
+		if closer, ok := response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetPlaceQrCodeOKImagePNG:
+		w.Header().Set("Content-Type", "image/png")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		writer := w
+		if closer, ok := response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetPlaceQrCodeForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(403)
 		span.SetStatus(codes.Error, http.StatusText(403))
@@ -2562,7 +2997,7 @@ func encodeGetReservationShareLinkResponse(response GetReservationShareLinkRes,
 
 		return nil
 
-	case *GetReservationShareLinkNotFound:
+	case *GetPlaceQrCodeNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -2580,9 +3015,9 @@ func encodeGetReservationShareLinkResponse(response GetReservationShareLinkRes,
 	}
 }
 
-func encodeGetStatisticsResponse(response GetStatisticsRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetPlaceQrKeyResponse(response GetPlaceQrKeyRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *Statistics:
+	case *PlaceQrKey:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -2595,7 +3030,7 @@ func encodeGetStatisticsResponse(response GetStatisticsRes, w http.ResponseWrite
 
 		return nil
 
-	case *ErrorResponse:
+	case *GetPlaceQrKeyForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(403)
 		span.SetStatus(codes.Error, http.StatusText(403))
@@ -2608,14 +3043,27 @@ func encodeGetStatisticsResponse(response GetStatisticsRes, w http.ResponseWrite
 
 		return nil
 
+	case *GetPlaceQrKeyNotFound:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
 	default:
 		return errors.Errorf("unexpected response type: %T", response)
 	}
 }
 
-func encodeGetUsageStatisticsResponse(response GetUsageStatisticsRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetPlaceTimeSlotsResponse(response GetPlaceTimeSlotsRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *GetUsageStatisticsOKApplicationJSON:
+	case *TimeSlotConfig:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -2630,8 +3078,8 @@ func encodeGetUsageStatisticsResponse(response GetUsageStatisticsRes, w http.Res
 
 	case *ErrorResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2646,9 +3094,9 @@ func encodeGetUsageStatisticsResponse(response GetUsageStatisticsRes, w http.Res
 	}
 }
 
-func encodeGetUserResponse(response GetUserRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetPlaceWhitelistResponse(response GetPlaceWhitelistRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *User:
+	case *GetPlaceWhitelistOKApplicationJSON:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -2661,7 +3109,7 @@ func encodeGetUserResponse(response GetUserRes, w http.ResponseWriter, span trac
 
 		return nil
 
-	case *GetUserForbidden:
+	case *GetPlaceWhitelistForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(403)
 		span.SetStatus(codes.Error, http.StatusText(403))
@@ -2674,7 +3122,7 @@ func encodeGetUserResponse(response GetUserRes, w http.ResponseWriter, span trac
 
 		return nil
 
-	case *GetUserNotFound:
+	case *GetPlaceWhitelistNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -2692,9 +3140,9 @@ func encodeGetUserResponse(response GetUserRes, w http.ResponseWriter, span trac
 	}
 }
 
-func encodeGetUserGroupsResponse(response GetUserGroupsRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetQrTemplateResponse(response GetQrTemplateRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *GetUserGroupsOKApplicationJSON:
+	case *QRTemplate:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -2707,7 +3155,7 @@ func encodeGetUserGroupsResponse(response GetUserGroupsRes, w http.ResponseWrite
 
 		return nil
 
-	case *GetUserGroupsForbidden:
+	case *GetQrTemplateForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(403)
 		span.SetStatus(codes.Error, http.StatusText(403))
@@ -2720,7 +3168,7 @@ func encodeGetUserGroupsResponse(response GetUserGroupsRes, w http.ResponseWrite
 
 		return nil
 
-	case *GetUserGroupsNotFound:
+	case *GetQrTemplateNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -2738,9 +3186,9 @@ func encodeGetUserGroupsResponse(response GetUserGroupsRes, w http.ResponseWrite
 	}
 }
 
-func encodeHandleOAuthCallbackResponse(response HandleOAuthCallbackRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetReservationResponse(response GetReservationRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *AuthResponse:
+	case *Reservation:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -2753,10 +3201,10 @@ func encodeHandleOAuthCallbackResponse(response HandleOAuthCallbackRes, w http.R
 
 		return nil
 
-	case *HandleOAuthCallbackBadRequest:
+	case *GetReservationForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2766,10 +3214,10 @@ func encodeHandleOAuthCallbackResponse(response HandleOAuthCallbackRes, w http.R
 
 		return nil
 
-	case *HandleOAuthCallbackUnauthorized:
+	case *GetReservationNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2784,16 +3232,9 @@ func encodeHandleOAuthCallbackResponse(response HandleOAuthCallbackRes, w http.R
 	}
 }
 
-func encodeInitiateOAuthLoginResponse(response *InitiateOAuthLoginFound, w http.ResponseWriter, span trace.Span) error {
-	w.WriteHeader(302)
-	span.SetStatus(codes.Ok, http.StatusText(302))
-
-	return nil
-}
-
-func encodeListApiKeysResponse(response ListApiKeysRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetReservationShareLinkResponse(response GetReservationShareLinkRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *ListApiKeysOKApplicationJSON:
+	case *ShareResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -2806,10 +3247,23 @@ func encodeListApiKeysResponse(response ListApiKeysRes, w http.ResponseWriter, s
 
 		return nil
 
-	case *ErrorResponse:
+	case *GetReservationShareLinkForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetReservationShareLinkNotFound:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2824,9 +3278,9 @@ func encodeListApiKeysResponse(response ListApiKeysRes, w http.ResponseWriter, s
 	}
 }
 
-func encodeListAreaPlacesResponse(response ListAreaPlacesRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetStatisticsResponse(response GetStatisticsRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *ListAreaPlacesOKApplicationJSON:
+	case *Statistics:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -2841,8 +3295,8 @@ func encodeListAreaPlacesResponse(response ListAreaPlacesRes, w http.ResponseWri
 
 	case *ErrorResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2857,23 +3311,9 @@ func encodeListAreaPlacesResponse(response ListAreaPlacesRes, w http.ResponseWri
 	}
 }
 
-func encodeListAreasResponse(response *PaginatedAreaList, w http.ResponseWriter, span trace.Span) error {
-	w.Header().Set("Content-Type", "application/json; charset=utf-8")
-	w.WriteHeader(200)
-	span.SetStatus(codes.Ok, http.StatusText(200))
-
-	e := new(jx.Encoder)
-	response.Encode(e)
-	if _, err := e.WriteTo(w); err != nil {
-		return errors.Wrap(err, "write")
-	}
-
-	return nil
-}
-
-func encodeListBuildingAreasResponse(response ListBuildingAreasRes, w http.ResponseWriter, span trace.Span) error {
+func encodeGetUsageStatisticsResponse(response GetUsageStatisticsRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *ListBuildingAreasOKApplicationJSON:
+	case *GetUsageStatisticsOKApplicationJSON:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -2888,8 +3328,8 @@ func encodeListBuildingAreasResponse(response ListBuildingAreasRes, w http.Respo
 
 	case *ErrorResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2904,31 +3344,632 @@ func encodeListBuildingAreasResponse(response ListBuildingAreasRes, w http.Respo
 	}
 }
 
-func encodeListBuildingsResponse(response *PaginatedBuildingList, w http.ResponseWriter, span trace.Span) error {
-	w.Header().Set("Content-Type", "application/json; charset=utf-8")
-	w.WriteHeader(200)
-	span.SetStatus(codes.Ok, http.StatusText(200))
-
-	e := new(jx.Encoder)
-	response.Encode(e)
-	if _, err := e.WriteTo(w); err != nil {
-		return errors.Wrap(err, "write")
-	}
+func encodeGetUserResponse(response GetUserRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *User:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetUserForbidden:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetUserNotFound:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetUserCalendarResponse(response GetUserCalendarRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *CalendarFeedHeaders:
+		w.Header().Set("Content-Type", "text/calendar")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "ETag" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ETag.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode ETag header")
+				}
+			}
+			// Encode "Last-Modified" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Last-Modified",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.LastModified.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Last-Modified header")
+				}
+			}
+		}
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		writer := w
+		if closer, ok := response.Response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response.Response); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *NotModified:
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "ETag" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "ETag",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.ETag.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode ETag header")
+				}
+			}
+		}
+		w.WriteHeader(304)
+		span.SetStatus(codes.Ok, http.StatusText(304))
+
+		return nil
+
+	case *ErrorResponse:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetUserGroupsResponse(response GetUserGroupsRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *GetUserGroupsOKApplicationJSON:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetUserGroupsForbidden:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetUserGroupsNotFound:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeHandleOAuthCallbackResponse(response HandleOAuthCallbackRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *AuthResponseHeaders:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "Set-Cookie" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Set-Cookie",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.SetCookie.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Set-Cookie header")
+				}
+			}
+		}
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *HandleOAuthCallbackBadRequest:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "Set-Cookie" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Set-Cookie",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.SetCookie.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Set-Cookie header")
+				}
+			}
+		}
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *HandleOAuthCallbackUnauthorized:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "Set-Cookie" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Set-Cookie",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.SetCookie.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Set-Cookie header")
+				}
+			}
+		}
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *HandleOAuthCallbackConflict:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "Set-Cookie" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Set-Cookie",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.SetCookie.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Set-Cookie header")
+				}
+			}
+		}
+		w.WriteHeader(409)
+		span.SetStatus(codes.Error, http.StatusText(409))
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeInitiateOAuthLoginResponse(response *InitiateOAuthLoginFound, w http.ResponseWriter, span trace.Span) error {
+	// Encoding response headers.
+	{
+		h := uri.NewHeaderEncoder(w.Header())
+		// Encode "Location" header.
+		{
+			cfg := uri.HeaderParameterEncodingConfig{
+				Name:    "Location",
+				Explode: false,
+			}
+			if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+				if val, ok := response.Location.Get(); ok {
+					return e.EncodeValue(conv.URLToString(val))
+				}
+				return nil
+			}); err != nil {
+				return errors.Wrap(err, "encode Location header")
+			}
+		}
+		// Encode "Set-Cookie" header.
+		{
+			cfg := uri.HeaderParameterEncodingConfig{
+				Name:    "Set-Cookie",
+				Explode: false,
+			}
+			if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+				if val, ok := response.SetCookie.Get(); ok {
+					return e.EncodeValue(conv.StringToString(val))
+				}
+				return nil
+			}); err != nil {
+				return errors.Wrap(err, "encode Set-Cookie header")
+			}
+		}
+	}
+	w.WriteHeader(302)
+	span.SetStatus(codes.Ok, http.StatusText(302))
+
+	return nil
+}
+
+func encodeListApiKeysResponse(response ListApiKeysRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *ListApiKeysOKApplicationJSON:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *ErrorResponse:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeListAreaPlacesResponse(response ListAreaPlacesRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *ListAreaPlacesOKApplicationJSON:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *ErrorResponse:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeListAreasResponse(response *PaginatedAreaList, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeListBuildingAreasResponse(response ListBuildingAreasRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *ListBuildingAreasOKApplicationJSON:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *ErrorResponse:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeListBuildingsResponse(response *PaginatedBuildingList, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeListEquipmentResponse(response []Equipment, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+	span.SetStatus(codes.Ok, http.StatusText(200))
+
+	e := new(jx.Encoder)
+	e.ArrStart()
+	for _, elem := range response {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
 
 	return nil
 }
 
-func encodeListEquipmentResponse(response []Equipment, w http.ResponseWriter, span trace.Span) error {
+func encodeListGroupConstraintProfilesResponse(response ListGroupConstraintProfilesRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *ListGroupConstraintProfilesOKApplicationJSON:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *ListGroupConstraintProfilesForbidden:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *ListGroupConstraintProfilesNotFound:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeListGroupsResponse(response ListGroupsRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *ListGroupsOKApplicationJSON:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *ErrorResponse:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeListPermissionsResponse(response ListPermissionsRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *ListPermissionsOKApplicationJSON:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *ErrorResponse:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeListPlacesResponse(response *PaginatedPlaceList, w http.ResponseWriter, span trace.Span) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(200)
 	span.SetStatus(codes.Ok, http.StatusText(200))
 
 	e := new(jx.Encoder)
-	e.ArrStart()
-	for _, elem := range response {
-		elem.Encode(e)
-	}
-	e.ArrEnd()
+	response.Encode(e)
 	if _, err := e.WriteTo(w); err != nil {
 		return errors.Wrap(err, "write")
 	}
@@ -2936,13 +3977,149 @@ func encodeListEquipmentResponse(response []Equipment, w http.ResponseWriter, sp
 	return nil
 }
 
-func encodeListGroupsResponse(response ListGroupsRes, w http.ResponseWriter, span trace.Span) error {
+func encodeListQrTemplatesResponse(response ListQrTemplatesRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *ListGroupsOKApplicationJSON:
+	case *ListQrTemplatesOKApplicationJSON:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *ErrorResponse:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeListReservationsResponse(response ListReservationsRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *PaginatedReservationList:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *ErrorResponse:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeListUsersResponse(response ListUsersRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *PaginatedUserList:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *ErrorResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeLogoutResponse(response *LogoutNoContent, w http.ResponseWriter, span trace.Span) error {
+	w.WriteHeader(204)
+	span.SetStatus(codes.Ok, http.StatusText(204))
+
+	return nil
+}
+
+func encodePreviewQrTemplateResponse(response PreviewQrTemplateRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *PreviewQrTemplateOKImagePNG:
+		w.Header().Set("Content-Type", "image/png")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		writer := w
+		if closer, ok := response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *PreviewQrTemplateOKTextHTML:
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
 
+		writer := w
+		if closer, ok := response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *PreviewQrTemplateForbidden:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
+
 		e := new(jx.Encoder)
 		response.Encode(e)
 		if _, err := e.WriteTo(w); err != nil {
@@ -2951,10 +4128,10 @@ func encodeListGroupsResponse(response ListGroupsRes, w http.ResponseWriter, spa
 
 		return nil
 
-	case *ErrorResponse:
+	case *PreviewQrTemplateNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -2969,9 +4146,9 @@ func encodeListGroupsResponse(response ListGroupsRes, w http.ResponseWriter, spa
 	}
 }
 
-func encodeListPermissionsResponse(response ListPermissionsRes, w http.ResponseWriter, span trace.Span) error {
+func encodeRefreshTokenResponse(response RefreshTokenRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *ListPermissionsOKApplicationJSON:
+	case *AuthResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -2986,8 +4163,8 @@ func encodeListPermissionsResponse(response ListPermissionsRes, w http.ResponseW
 
 	case *ErrorResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3002,26 +4179,18 @@ func encodeListPermissionsResponse(response ListPermissionsRes, w http.ResponseW
 	}
 }
 
-func encodeListPlacesResponse(response *PaginatedPlaceList, w http.ResponseWriter, span trace.Span) error {
-	w.Header().Set("Content-Type", "application/json; charset=utf-8")
-	w.WriteHeader(200)
-	span.SetStatus(codes.Ok, http.StatusText(200))
-
-	e := new(jx.Encoder)
-	response.Encode(e)
-	if _, err := e.WriteTo(w); err != nil {
-		return errors.Wrap(err, "write")
-	}
+func encodeRemoveAreaBlockingEntriesResponse(response RemoveAreaBlockingEntriesRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *RemoveAreaBlockingEntriesNoContent:
+		w.WriteHeader(204)
+		span.SetStatus(codes.Ok, http.StatusText(204))
 
-	return nil
-}
+		return nil
 
-func encodeListQrTemplatesResponse(response ListQrTemplatesRes, w http.ResponseWriter, span trace.Span) error {
-	switch response := response.(type) {
-	case *ListQrTemplatesOKApplicationJSON:
+	case *RemoveAreaBlockingEntriesForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3031,10 +4200,10 @@ func encodeListQrTemplatesResponse(response ListQrTemplatesRes, w http.ResponseW
 
 		return nil
 
-	case *ErrorResponse:
+	case *RemoveAreaBlockingEntriesNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3049,12 +4218,18 @@ func encodeListQrTemplatesResponse(response ListQrTemplatesRes, w http.ResponseW
 	}
 }
 
-func encodeListReservationsResponse(response ListReservationsRes, w http.ResponseWriter, span trace.Span) error {
+func encodeRemoveAreaRoomPlanMarkersResponse(response RemoveAreaRoomPlanMarkersRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *PaginatedReservationList:
+	case *RemoveAreaRoomPlanMarkersNoContent:
+		w.WriteHeader(204)
+		span.SetStatus(codes.Ok, http.StatusText(204))
+
+		return nil
+
+	case *RemoveAreaRoomPlanMarkersForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3064,10 +4239,10 @@ func encodeListReservationsResponse(response ListReservationsRes, w http.Respons
 
 		return nil
 
-	case *ErrorResponse:
+	case *RemoveAreaRoomPlanMarkersNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3082,12 +4257,18 @@ func encodeListReservationsResponse(response ListReservationsRes, w http.Respons
 	}
 }
 
-func encodeListUsersResponse(response ListUsersRes, w http.ResponseWriter, span trace.Span) error {
+func encodeRemoveBuildingBlockingEntriesResponse(response RemoveBuildingBlockingEntriesRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *PaginatedUserList:
+	case *RemoveBuildingBlockingEntriesNoContent:
+		w.WriteHeader(204)
+		span.SetStatus(codes.Ok, http.StatusText(204))
+
+		return nil
+
+	case *RemoveBuildingBlockingEntriesForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3097,10 +4278,10 @@ func encodeListUsersResponse(response ListUsersRes, w http.ResponseWriter, span
 
 		return nil
 
-	case *ErrorResponse:
+	case *RemoveBuildingBlockingEntriesNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3115,46 +4296,41 @@ func encodeListUsersResponse(response ListUsersRes, w http.ResponseWriter, span
 	}
 }
 
-func encodeLogoutResponse(response *LogoutNoContent, w http.ResponseWriter, span trace.Span) error {
-	w.WriteHeader(204)
-	span.SetStatus(codes.Ok, http.StatusText(204))
+func encodeRemoveCurrentUserFavoritesResponse(response RemoveCurrentUserFavoritesRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *RemoveCurrentUserFavoritesNoContent:
+		w.WriteHeader(204)
+		span.SetStatus(codes.Ok, http.StatusText(204))
 
-	return nil
-}
+		return nil
 
-func encodePreviewQrTemplateResponse(response PreviewQrTemplateRes, w http.ResponseWriter, span trace.Span) error {
-	switch response := response.(type) {
-	case *PreviewQrTemplateOKImagePNG:
-		w.Header().Set("Content-Type", "image/png")
-		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
+	case *ErrorResponse:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
 
-		writer := w
-		if closer, ok := response.Data.(io.Closer); ok {
-			defer closer.Close()
-		}
-		if _, err := io.Copy(writer, response); err != nil {
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
 			return errors.Wrap(err, "write")
 		}
 
 		return nil
 
-	case *PreviewQrTemplateOKTextHTML:
-		w.Header().Set("Content-Type", "text/html; charset=utf-8")
-		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
 
-		writer := w
-		if closer, ok := response.Data.(io.Closer); ok {
-			defer closer.Close()
-		}
-		if _, err := io.Copy(writer, response); err != nil {
-			return errors.Wrap(err, "write")
-		}
+func encodeRemoveGroupPermissionsResponse(response RemoveGroupPermissionsRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *RemoveGroupPermissionsNoContent:
+		w.WriteHeader(204)
+		span.SetStatus(codes.Ok, http.StatusText(204))
 
 		return nil
 
-	case *PreviewQrTemplateForbidden:
+	case *RemoveGroupPermissionsForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(403)
 		span.SetStatus(codes.Error, http.StatusText(403))
@@ -3167,7 +4343,7 @@ func encodePreviewQrTemplateResponse(response PreviewQrTemplateRes, w http.Respo
 
 		return nil
 
-	case *PreviewQrTemplateNotFound:
+	case *RemoveGroupPermissionsNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -3185,12 +4361,18 @@ func encodePreviewQrTemplateResponse(response PreviewQrTemplateRes, w http.Respo
 	}
 }
 
-func encodeRefreshTokenResponse(response RefreshTokenRes, w http.ResponseWriter, span trace.Span) error {
+func encodeRemovePlaceBlockingEntriesResponse(response RemovePlaceBlockingEntriesRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *AuthResponse:
+	case *RemovePlaceBlockingEntriesNoContent:
+		w.WriteHeader(204)
+		span.SetStatus(codes.Ok, http.StatusText(204))
+
+		return nil
+
+	case *RemovePlaceBlockingEntriesForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3200,10 +4382,10 @@ func encodeRefreshTokenResponse(response RefreshTokenRes, w http.ResponseWriter,
 
 		return nil
 
-	case *ErrorResponse:
+	case *RemovePlaceBlockingEntriesNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3218,15 +4400,15 @@ func encodeRefreshTokenResponse(response RefreshTokenRes, w http.ResponseWriter,
 	}
 }
 
-func encodeRemoveAreaBlockingEntriesResponse(response RemoveAreaBlockingEntriesRes, w http.ResponseWriter, span trace.Span) error {
+func encodeRemovePlaceEquipmentResponse(response RemovePlaceEquipmentRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *RemoveAreaBlockingEntriesNoContent:
+	case *RemovePlaceEquipmentNoContent:
 		w.WriteHeader(204)
 		span.SetStatus(codes.Ok, http.StatusText(204))
 
 		return nil
 
-	case *RemoveAreaBlockingEntriesForbidden:
+	case *RemovePlaceEquipmentForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(403)
 		span.SetStatus(codes.Error, http.StatusText(403))
@@ -3239,7 +4421,7 @@ func encodeRemoveAreaBlockingEntriesResponse(response RemoveAreaBlockingEntriesR
 
 		return nil
 
-	case *RemoveAreaBlockingEntriesNotFound:
+	case *RemovePlaceEquipmentNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -3257,15 +4439,15 @@ func encodeRemoveAreaBlockingEntriesResponse(response RemoveAreaBlockingEntriesR
 	}
 }
 
-func encodeRemoveAreaRoomPlanMarkersResponse(response RemoveAreaRoomPlanMarkersRes, w http.ResponseWriter, span trace.Span) error {
+func encodeRemovePlaceWhitelistUsersResponse(response RemovePlaceWhitelistUsersRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *RemoveAreaRoomPlanMarkersNoContent:
+	case *RemovePlaceWhitelistUsersNoContent:
 		w.WriteHeader(204)
 		span.SetStatus(codes.Ok, http.StatusText(204))
 
 		return nil
 
-	case *RemoveAreaRoomPlanMarkersForbidden:
+	case *RemovePlaceWhitelistUsersForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(403)
 		span.SetStatus(codes.Error, http.StatusText(403))
@@ -3278,7 +4460,7 @@ func encodeRemoveAreaRoomPlanMarkersResponse(response RemoveAreaRoomPlanMarkersR
 
 		return nil
 
-	case *RemoveAreaRoomPlanMarkersNotFound:
+	case *RemovePlaceWhitelistUsersNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -3296,15 +4478,15 @@ func encodeRemoveAreaRoomPlanMarkersResponse(response RemoveAreaRoomPlanMarkersR
 	}
 }
 
-func encodeRemoveBuildingBlockingEntriesResponse(response RemoveBuildingBlockingEntriesRes, w http.ResponseWriter, span trace.Span) error {
+func encodeRemoveUserGroupsResponse(response RemoveUserGroupsRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *RemoveBuildingBlockingEntriesNoContent:
+	case *RemoveUserGroupsNoContent:
 		w.WriteHeader(204)
 		span.SetStatus(codes.Ok, http.StatusText(204))
 
 		return nil
 
-	case *RemoveBuildingBlockingEntriesForbidden:
+	case *RemoveUserGroupsForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(403)
 		span.SetStatus(codes.Error, http.StatusText(403))
@@ -3317,7 +4499,7 @@ func encodeRemoveBuildingBlockingEntriesResponse(response RemoveBuildingBlocking
 
 		return nil
 
-	case *RemoveBuildingBlockingEntriesNotFound:
+	case *RemoveUserGroupsNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -3335,18 +4517,51 @@ func encodeRemoveBuildingBlockingEntriesResponse(response RemoveBuildingBlocking
 	}
 }
 
-func encodeRemoveCurrentUserFavoritesResponse(response RemoveCurrentUserFavoritesRes, w http.ResponseWriter, span trace.Span) error {
+func encodeReplaceAreaBlockingResponse(response ReplaceAreaBlockingRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *RemoveCurrentUserFavoritesNoContent:
-		w.WriteHeader(204)
-		span.SetStatus(codes.Ok, http.StatusText(204))
+	case *ReplaceAreaBlockingOKApplicationJSON:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
 
 		return nil
 
-	case *ErrorResponse:
+	case *ReplaceAreaBlockingBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(401)
-		span.SetStatus(codes.Error, http.StatusText(401))
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *ReplaceAreaBlockingForbidden:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *ReplaceAreaBlockingNotFound:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3361,15 +4576,35 @@ func encodeRemoveCurrentUserFavoritesResponse(response RemoveCurrentUserFavorite
 	}
 }
 
-func encodeRemoveGroupPermissionsResponse(response RemoveGroupPermissionsRes, w http.ResponseWriter, span trace.Span) error {
+func encodeReplaceBuildingBlockingResponse(response ReplaceBuildingBlockingRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *RemoveGroupPermissionsNoContent:
-		w.WriteHeader(204)
-		span.SetStatus(codes.Ok, http.StatusText(204))
+	case *ReplaceBuildingBlockingOKApplicationJSON:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *ReplaceBuildingBlockingBadRequest:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
 
 		return nil
 
-	case *RemoveGroupPermissionsForbidden:
+	case *ReplaceBuildingBlockingForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(403)
 		span.SetStatus(codes.Error, http.StatusText(403))
@@ -3382,7 +4617,7 @@ func encodeRemoveGroupPermissionsResponse(response RemoveGroupPermissionsRes, w
 
 		return nil
 
-	case *RemoveGroupPermissionsNotFound:
+	case *ReplaceBuildingBlockingNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -3400,18 +4635,12 @@ func encodeRemoveGroupPermissionsResponse(response RemoveGroupPermissionsRes, w
 	}
 }
 
-func encodeRemovePlaceBlockingEntriesResponse(response RemovePlaceBlockingEntriesRes, w http.ResponseWriter, span trace.Span) error {
+func encodeReplacePlaceBlockingResponse(response ReplacePlaceBlockingRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *RemovePlaceBlockingEntriesNoContent:
-		w.WriteHeader(204)
-		span.SetStatus(codes.Ok, http.StatusText(204))
-
-		return nil
-
-	case *RemovePlaceBlockingEntriesForbidden:
+	case *ReplacePlaceBlockingOKApplicationJSON:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3421,10 +4650,10 @@ func encodeRemovePlaceBlockingEntriesResponse(response RemovePlaceBlockingEntrie
 
 		return nil
 
-	case *RemovePlaceBlockingEntriesNotFound:
+	case *ReplacePlaceBlockingBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3434,20 +4663,7 @@ func encodeRemovePlaceBlockingEntriesResponse(response RemovePlaceBlockingEntrie
 
 		return nil
 
-	default:
-		return errors.Errorf("unexpected response type: %T", response)
-	}
-}
-
-func encodeRemovePlaceEquipmentResponse(response RemovePlaceEquipmentRes, w http.ResponseWriter, span trace.Span) error {
-	switch response := response.(type) {
-	case *RemovePlaceEquipmentNoContent:
-		w.WriteHeader(204)
-		span.SetStatus(codes.Ok, http.StatusText(204))
-
-		return nil
-
-	case *RemovePlaceEquipmentForbidden:
+	case *ReplacePlaceBlockingForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(403)
 		span.SetStatus(codes.Error, http.StatusText(403))
@@ -3460,7 +4676,7 @@ func encodeRemovePlaceEquipmentResponse(response RemovePlaceEquipmentRes, w http
 
 		return nil
 
-	case *RemovePlaceEquipmentNotFound:
+	case *ReplacePlaceBlockingNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -3478,15 +4694,15 @@ func encodeRemovePlaceEquipmentResponse(response RemovePlaceEquipmentRes, w http
 	}
 }
 
-func encodeRemovePlaceWhitelistUsersResponse(response RemovePlaceWhitelistUsersRes, w http.ResponseWriter, span trace.Span) error {
+func encodeRevokeApiKeyResponse(response RevokeApiKeyRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *RemovePlaceWhitelistUsersNoContent:
+	case *RevokeApiKeyNoContent:
 		w.WriteHeader(204)
 		span.SetStatus(codes.Ok, http.StatusText(204))
 
 		return nil
 
-	case *RemovePlaceWhitelistUsersForbidden:
+	case *RevokeApiKeyForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(403)
 		span.SetStatus(codes.Error, http.StatusText(403))
@@ -3499,7 +4715,7 @@ func encodeRemovePlaceWhitelistUsersResponse(response RemovePlaceWhitelistUsersR
 
 		return nil
 
-	case *RemovePlaceWhitelistUsersNotFound:
+	case *RevokeApiKeyNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -3517,18 +4733,18 @@ func encodeRemovePlaceWhitelistUsersResponse(response RemovePlaceWhitelistUsersR
 	}
 }
 
-func encodeRemoveUserGroupsResponse(response RemoveUserGroupsRes, w http.ResponseWriter, span trace.Span) error {
+func encodeRevokeCurrentUserCalendarTokenResponse(response RevokeCurrentUserCalendarTokenRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *RemoveUserGroupsNoContent:
+	case *RevokeCurrentUserCalendarTokenNoContent:
 		w.WriteHeader(204)
 		span.SetStatus(codes.Ok, http.StatusText(204))
 
 		return nil
 
-	case *RemoveUserGroupsForbidden:
+	case *ErrorResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3538,10 +4754,17 @@ func encodeRemoveUserGroupsResponse(response RemoveUserGroupsRes, w http.Respons
 
 		return nil
 
-	case *RemoveUserGroupsNotFound:
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeRotateCurrentUserCalendarTokenResponse(response RotateCurrentUserCalendarTokenRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *CalendarTokenWithSecret:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
+		w.WriteHeader(201)
+		span.SetStatus(codes.Ok, http.StatusText(201))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3551,17 +4774,10 @@ func encodeRemoveUserGroupsResponse(response RemoveUserGroupsRes, w http.Respons
 
 		return nil
 
-	default:
-		return errors.Errorf("unexpected response type: %T", response)
-	}
-}
-
-func encodeReplaceAreaBlockingResponse(response ReplaceAreaBlockingRes, w http.ResponseWriter, span trace.Span) error {
-	switch response := response.(type) {
-	case *ReplaceAreaBlockingOKApplicationJSON:
+	case *ErrorResponse:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
+		w.WriteHeader(401)
+		span.SetStatus(codes.Error, http.StatusText(401))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3571,10 +4787,17 @@ func encodeReplaceAreaBlockingResponse(response ReplaceAreaBlockingRes, w http.R
 
 		return nil
 
-	case *ReplaceAreaBlockingBadRequest:
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeRotatePlaceQrKeyResponse(response RotatePlaceQrKeyRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *PlaceQrKey:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3584,7 +4807,7 @@ func encodeReplaceAreaBlockingResponse(response ReplaceAreaBlockingRes, w http.R
 
 		return nil
 
-	case *ReplaceAreaBlockingForbidden:
+	case *RotatePlaceQrKeyForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(403)
 		span.SetStatus(codes.Error, http.StatusText(403))
@@ -3597,7 +4820,7 @@ func encodeReplaceAreaBlockingResponse(response ReplaceAreaBlockingRes, w http.R
 
 		return nil
 
-	case *ReplaceAreaBlockingNotFound:
+	case *RotatePlaceQrKeyNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -3615,9 +4838,9 @@ func encodeReplaceAreaBlockingResponse(response ReplaceAreaBlockingRes, w http.R
 	}
 }
 
-func encodeReplaceBuildingBlockingResponse(response ReplaceBuildingBlockingRes, w http.ResponseWriter, span trace.Span) error {
+func encodeSetDefaultGroupAssignmentResponse(response SetDefaultGroupAssignmentRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *ReplaceBuildingBlockingOKApplicationJSON:
+	case *SetDefaultGroupAssignmentOKApplicationJSON:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -3630,7 +4853,7 @@ func encodeReplaceBuildingBlockingResponse(response ReplaceBuildingBlockingRes,
 
 		return nil
 
-	case *ReplaceBuildingBlockingBadRequest:
+	case *SetDefaultGroupAssignmentBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
 		span.SetStatus(codes.Error, http.StatusText(400))
@@ -3643,7 +4866,7 @@ func encodeReplaceBuildingBlockingResponse(response ReplaceBuildingBlockingRes,
 
 		return nil
 
-	case *ReplaceBuildingBlockingForbidden:
+	case *SetDefaultGroupAssignmentForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(403)
 		span.SetStatus(codes.Error, http.StatusText(403))
@@ -3656,27 +4879,14 @@ func encodeReplaceBuildingBlockingResponse(response ReplaceBuildingBlockingRes,
 
 		return nil
 
-	case *ReplaceBuildingBlockingNotFound:
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
-
-		e := new(jx.Encoder)
-		response.Encode(e)
-		if _, err := e.WriteTo(w); err != nil {
-			return errors.Wrap(err, "write")
-		}
-
-		return nil
-
 	default:
 		return errors.Errorf("unexpected response type: %T", response)
 	}
 }
 
-func encodeReplacePlaceBlockingResponse(response ReplacePlaceBlockingRes, w http.ResponseWriter, span trace.Span) error {
+func encodeUpdateAreaResponse(response UpdateAreaRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *ReplacePlaceBlockingOKApplicationJSON:
+	case *Area:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -3689,7 +4899,7 @@ func encodeReplacePlaceBlockingResponse(response ReplacePlaceBlockingRes, w http
 
 		return nil
 
-	case *ReplacePlaceBlockingBadRequest:
+	case *UpdateAreaBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
 		span.SetStatus(codes.Error, http.StatusText(400))
@@ -3702,7 +4912,7 @@ func encodeReplacePlaceBlockingResponse(response ReplacePlaceBlockingRes, w http
 
 		return nil
 
-	case *ReplacePlaceBlockingForbidden:
+	case *UpdateAreaForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(403)
 		span.SetStatus(codes.Error, http.StatusText(403))
@@ -3715,7 +4925,7 @@ func encodeReplacePlaceBlockingResponse(response ReplacePlaceBlockingRes, w http
 
 		return nil
 
-	case *ReplacePlaceBlockingNotFound:
+	case *UpdateAreaNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -3733,31 +4943,12 @@ func encodeReplacePlaceBlockingResponse(response ReplacePlaceBlockingRes, w http
 	}
 }
 
-func encodeRevokeApiKeyResponse(response RevokeApiKeyRes, w http.ResponseWriter, span trace.Span) error {
+func encodeUpdateAreaConstraintsResponse(response UpdateAreaConstraintsRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *RevokeApiKeyNoContent:
-		w.WriteHeader(204)
-		span.SetStatus(codes.Ok, http.StatusText(204))
-
-		return nil
-
-	case *RevokeApiKeyForbidden:
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
-
-		e := new(jx.Encoder)
-		response.Encode(e)
-		if _, err := e.WriteTo(w); err != nil {
-			return errors.Wrap(err, "write")
-		}
-
-		return nil
-
-	case *RevokeApiKeyNotFound:
+	case *PlaceConstraints:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(404)
-		span.SetStatus(codes.Error, http.StatusText(404))
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3767,17 +4958,10 @@ func encodeRevokeApiKeyResponse(response RevokeApiKeyRes, w http.ResponseWriter,
 
 		return nil
 
-	default:
-		return errors.Errorf("unexpected response type: %T", response)
-	}
-}
-
-func encodeSetDefaultGroupAssignmentResponse(response SetDefaultGroupAssignmentRes, w http.ResponseWriter, span trace.Span) error {
-	switch response := response.(type) {
-	case *SetDefaultGroupAssignmentOKApplicationJSON:
+	case *UpdateAreaConstraintsBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(200)
-		span.SetStatus(codes.Ok, http.StatusText(200))
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3787,10 +4971,10 @@ func encodeSetDefaultGroupAssignmentResponse(response SetDefaultGroupAssignmentR
 
 		return nil
 
-	case *SetDefaultGroupAssignmentBadRequest:
+	case *UpdateAreaConstraintsForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(400)
-		span.SetStatus(codes.Error, http.StatusText(400))
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3800,10 +4984,10 @@ func encodeSetDefaultGroupAssignmentResponse(response SetDefaultGroupAssignmentR
 
 		return nil
 
-	case *SetDefaultGroupAssignmentForbidden:
+	case *UpdateAreaConstraintsNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(403)
-		span.SetStatus(codes.Error, http.StatusText(403))
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -3818,9 +5002,9 @@ func encodeSetDefaultGroupAssignmentResponse(response SetDefaultGroupAssignmentR
 	}
 }
 
-func encodeUpdateAreaResponse(response UpdateAreaRes, w http.ResponseWriter, span trace.Span) error {
+func encodeUpdateAreaRoomPlanResponse(response UpdateAreaRoomPlanRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *Area:
+	case *RoomPlan:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -3833,7 +5017,7 @@ func encodeUpdateAreaResponse(response UpdateAreaRes, w http.ResponseWriter, spa
 
 		return nil
 
-	case *UpdateAreaBadRequest:
+	case *UpdateAreaRoomPlanBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
 		span.SetStatus(codes.Error, http.StatusText(400))
@@ -3846,7 +5030,7 @@ func encodeUpdateAreaResponse(response UpdateAreaRes, w http.ResponseWriter, spa
 
 		return nil
 
-	case *UpdateAreaForbidden:
+	case *UpdateAreaRoomPlanForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(403)
 		span.SetStatus(codes.Error, http.StatusText(403))
@@ -3859,7 +5043,7 @@ func encodeUpdateAreaResponse(response UpdateAreaRes, w http.ResponseWriter, spa
 
 		return nil
 
-	case *UpdateAreaNotFound:
+	case *UpdateAreaRoomPlanNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -3877,9 +5061,9 @@ func encodeUpdateAreaResponse(response UpdateAreaRes, w http.ResponseWriter, spa
 	}
 }
 
-func encodeUpdateAreaRoomPlanResponse(response UpdateAreaRoomPlanRes, w http.ResponseWriter, span trace.Span) error {
+func encodeUpdateBuildingResponse(response UpdateBuildingRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *RoomPlan:
+	case *Building:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -3892,7 +5076,7 @@ func encodeUpdateAreaRoomPlanResponse(response UpdateAreaRoomPlanRes, w http.Res
 
 		return nil
 
-	case *UpdateAreaRoomPlanBadRequest:
+	case *UpdateBuildingBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
 		span.SetStatus(codes.Error, http.StatusText(400))
@@ -3905,7 +5089,7 @@ func encodeUpdateAreaRoomPlanResponse(response UpdateAreaRoomPlanRes, w http.Res
 
 		return nil
 
-	case *UpdateAreaRoomPlanForbidden:
+	case *UpdateBuildingForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(403)
 		span.SetStatus(codes.Error, http.StatusText(403))
@@ -3918,7 +5102,7 @@ func encodeUpdateAreaRoomPlanResponse(response UpdateAreaRoomPlanRes, w http.Res
 
 		return nil
 
-	case *UpdateAreaRoomPlanNotFound:
+	case *UpdateBuildingNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -3936,9 +5120,9 @@ func encodeUpdateAreaRoomPlanResponse(response UpdateAreaRoomPlanRes, w http.Res
 	}
 }
 
-func encodeUpdateBuildingResponse(response UpdateBuildingRes, w http.ResponseWriter, span trace.Span) error {
+func encodeUpdateBuildingConstraintsResponse(response UpdateBuildingConstraintsRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
-	case *Building:
+	case *PlaceConstraints:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 		span.SetStatus(codes.Ok, http.StatusText(200))
@@ -3951,7 +5135,7 @@ func encodeUpdateBuildingResponse(response UpdateBuildingRes, w http.ResponseWri
 
 		return nil
 
-	case *UpdateBuildingBadRequest:
+	case *UpdateBuildingConstraintsBadRequest:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(400)
 		span.SetStatus(codes.Error, http.StatusText(400))
@@ -3964,7 +5148,7 @@ func encodeUpdateBuildingResponse(response UpdateBuildingRes, w http.ResponseWri
 
 		return nil
 
-	case *UpdateBuildingForbidden:
+	case *UpdateBuildingConstraintsForbidden:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(403)
 		span.SetStatus(codes.Error, http.StatusText(403))
@@ -3977,7 +5161,7 @@ func encodeUpdateBuildingResponse(response UpdateBuildingRes, w http.ResponseWri
 
 		return nil
 
-	case *UpdateBuildingNotFound:
+	case *UpdateBuildingConstraintsNotFound:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(404)
 		span.SetStatus(codes.Error, http.StatusText(404))
@@ -4159,6 +5343,65 @@ func encodeUpdateGroupResponse(response UpdateGroupRes, w http.ResponseWriter, s
 	}
 }
 
+func encodeUpdateGroupConstraintProfileResponse(response UpdateGroupConstraintProfileRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *ConstraintProfile:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+		span.SetStatus(codes.Ok, http.StatusText(200))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *UpdateGroupConstraintProfileBadRequest:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(400)
+		span.SetStatus(codes.Error, http.StatusText(400))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *UpdateGroupConstraintProfileForbidden:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(403)
+		span.SetStatus(codes.Error, http.StatusText(403))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *UpdateGroupConstraintProfileNotFound:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(404)
+		span.SetStatus(codes.Error, http.StatusText(404))
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
 func encodeUpdatePlaceResponse(response UpdatePlaceRes, w http.ResponseWriter, span trace.Span) error {
 	switch response := response.(type) {
 	case *Place:
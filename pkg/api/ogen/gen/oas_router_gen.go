@@ -40,7 +40,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		s.notFound(w, r)
 		return
 	}
-	args := [1]string{}
+	args := [2]string{}
 
 	// Static code generated router with unwrapped path search.
 	switch {
@@ -273,9 +273,71 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 								}
 
-							case 'c': // Prefix: "calendar.ics"
+							case 'c': // Prefix: "c"
+
+								if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "alendar.ics"
+
+									if l := len("alendar.ics"); len(elem) >= l && elem[0:l] == "alendar.ics" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetAreaCalendarRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
 
-								if l := len("calendar.ics"); len(elem) >= l && elem[0:l] == "calendar.ics" {
+								case 'o': // Prefix: "onstraints"
+
+									if l := len("onstraints"); len(elem) >= l && elem[0:l] == "onstraints" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetAreaConstraintsRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "PUT":
+											s.handleUpdateAreaConstraintsRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET,PUT")
+										}
+
+										return
+									}
+
+								}
+
+							case 'p': // Prefix: "places"
+
+								if l := len("places"); len(elem) >= l && elem[0:l] == "places" {
 									elem = elem[l:]
 								} else {
 									break
@@ -285,7 +347,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									// Leaf node.
 									switch r.Method {
 									case "GET":
-										s.handleGetAreaCalendarRequest([1]string{
+										s.handleListAreaPlacesRequest([1]string{
 											args[0],
 										}, elemIsEscaped, w, r)
 									default:
@@ -295,9 +357,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									return
 								}
 
-							case 'p': // Prefix: "places"
+							case 'q': // Prefix: "qrCodes"
 
-								if l := len("places"); len(elem) >= l && elem[0:l] == "places" {
+								if l := len("qrCodes"); len(elem) >= l && elem[0:l] == "qrCodes" {
 									elem = elem[l:]
 								} else {
 									break
@@ -307,7 +369,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									// Leaf node.
 									switch r.Method {
 									case "GET":
-										s.handleListAreaPlacesRequest([1]string{
+										s.handleExportAreaQrCodesRequest([1]string{
 											args[0],
 										}, elemIsEscaped, w, r)
 									default:
@@ -726,9 +788,71 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 							}
 
-						case 'c': // Prefix: "calendar.ics"
+						case 'c': // Prefix: "c"
+
+							if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "alendar.ics"
+
+								if l := len("alendar.ics"); len(elem) >= l && elem[0:l] == "alendar.ics" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetBuildingCalendarRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+							case 'o': // Prefix: "onstraints"
+
+								if l := len("onstraints"); len(elem) >= l && elem[0:l] == "onstraints" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetBuildingConstraintsRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "PUT":
+										s.handleUpdateBuildingConstraintsRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,PUT")
+									}
+
+									return
+								}
+
+							}
+
+						case 'q': // Prefix: "qrCodes"
 
-							if l := len("calendar.ics"); len(elem) >= l && elem[0:l] == "calendar.ics" {
+							if l := len("qrCodes"); len(elem) >= l && elem[0:l] == "qrCodes" {
 								elem = elem[l:]
 							} else {
 								break
@@ -738,7 +862,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								// Leaf node.
 								switch r.Method {
 								case "GET":
-									s.handleGetBuildingCalendarRequest([1]string{
+									s.handleExportBuildingQrCodesRequest([1]string{
 										args[0],
 									}, elemIsEscaped, w, r)
 								default:
@@ -754,6 +878,51 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 				}
 
+			case 'c': // Prefix: "calendar/users/"
+
+				if l := len("calendar/users/"); len(elem) >= l && elem[0:l] == "calendar/users/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				// Param: "token"
+				// Match until "."
+				idx := strings.IndexByte(elem, '.')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '.': // Prefix: ".ics"
+
+					if l := len(".ics"); len(elem) >= l && elem[0:l] == ".ics" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleGetUserCalendarRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+				}
+
 			case 'e': // Prefix: "equipment"
 
 				if l := len("equipment"); len(elem) >= l && elem[0:l] == "equipment" {
@@ -915,6 +1084,70 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							break
 						}
 						switch elem[0] {
+						case 'c': // Prefix: "constraintProfiles"
+
+							if l := len("constraintProfiles"); len(elem) >= l && elem[0:l] == "constraintProfiles" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleListGroupConstraintProfilesRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "POST":
+									s.handleCreateGroupConstraintProfileRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET,POST")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "profileId"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[1] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "DELETE":
+										s.handleDeleteGroupConstraintProfileRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									case "PUT":
+										s.handleUpdateGroupConstraintProfileRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE,PUT")
+									}
+
+									return
+								}
+
+							}
+
 						case 'm': // Prefix: "members"
 
 							if l := len("members"); len(elem) >= l && elem[0:l] == "members" {
@@ -1194,7 +1427,6 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									}
 
 									if len(elem) == 0 {
-										// Leaf node.
 										switch r.Method {
 										case "GET":
 											s.handleGetPlaceConstraintsRequest([1]string{
@@ -1210,6 +1442,30 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 										return
 									}
+									switch elem[0] {
+									case '/': // Prefix: "/effective"
+
+										if l := len("/effective"); len(elem) >= l && elem[0:l] == "/effective" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetEffectivePlaceConstraintsRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
+
+									}
 
 								}
 
@@ -1252,7 +1508,6 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								}
 
 								if len(elem) == 0 {
-									// Leaf node.
 									switch r.Method {
 									case "GET":
 										s.handleGetPlaceQrCodeRequest([1]string{
@@ -1264,6 +1519,34 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 									return
 								}
+								switch elem[0] {
+								case '/': // Prefix: "/key"
+
+									if l := len("/key"); len(elem) >= l && elem[0:l] == "/key" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetPlaceQrKeyRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "POST":
+											s.handleRotatePlaceQrKeyRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET,POST")
+										}
+
+										return
+									}
+
+								}
 
 							case 't': // Prefix: "timeSlots"
 
@@ -1679,6 +1962,30 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							break
 						}
 						switch elem[0] {
+						case 'c': // Prefix: "calendar-token"
+
+							if l := len("calendar-token"); len(elem) >= l && elem[0:l] == "calendar-token" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handleRevokeCurrentUserCalendarTokenRequest([0]string{}, elemIsEscaped, w, r)
+								case "GET":
+									s.handleGetCurrentUserCalendarTokenRequest([0]string{}, elemIsEscaped, w, r)
+								case "POST":
+									s.handleRotateCurrentUserCalendarTokenRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,GET,POST")
+								}
+
+								return
+							}
+
 						case 'f': // Prefix: "favorites"
 
 							if l := len("favorites"); len(elem) >= l && elem[0:l] == "favorites" {
@@ -1862,7 +2169,7 @@ type Route struct {
 	operationGroup string
 	pathPattern    string
 	count          int
-	args           [1]string
+	args           [2]string
 }
 
 // Name returns ogen operation name.
@@ -2218,29 +2525,77 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 								}
 
-							case 'c': // Prefix: "calendar.ics"
+							case 'c': // Prefix: "c"
 
-								if l := len("calendar.ics"); len(elem) >= l && elem[0:l] == "calendar.ics" {
+								if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "GET":
-										r.name = GetAreaCalendarOperation
-										r.summary = "Public iCalendar feed for area"
-										r.operationID = "getAreaCalendar"
-										r.operationGroup = ""
-										r.pathPattern = "/areas/{areaId}/calendar.ics"
-										r.args = args
-										r.count = 1
-										return r, true
-									default:
-										return
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "alendar.ics"
+
+									if l := len("alendar.ics"); len(elem) >= l && elem[0:l] == "alendar.ics" {
+										elem = elem[l:]
+									} else {
+										break
 									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetAreaCalendarOperation
+											r.summary = "Public iCalendar feed for area"
+											r.operationID = "getAreaCalendar"
+											r.operationGroup = ""
+											r.pathPattern = "/areas/{areaId}/calendar.ics"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'o': // Prefix: "onstraints"
+
+									if l := len("onstraints"); len(elem) >= l && elem[0:l] == "onstraints" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetAreaConstraintsOperation
+											r.summary = "Get area constraints"
+											r.operationID = "getAreaConstraints"
+											r.operationGroup = ""
+											r.pathPattern = "/areas/{areaId}/constraints"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "PUT":
+											r.name = UpdateAreaConstraintsOperation
+											r.summary = "Update area constraints"
+											r.operationID = "updateAreaConstraints"
+											r.operationGroup = ""
+											r.pathPattern = "/areas/{areaId}/constraints"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
 								}
 
 							case 'p': // Prefix: "places"
@@ -2268,6 +2623,31 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									}
 								}
 
+							case 'q': // Prefix: "qrCodes"
+
+								if l := len("qrCodes"); len(elem) >= l && elem[0:l] == "qrCodes" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = ExportAreaQrCodesOperation
+										r.summary = "Export QR code labels of all places in the area"
+										r.operationID = "exportAreaQrCodes"
+										r.operationGroup = ""
+										r.pathPattern = "/areas/{areaId}/qrCodes"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
 							case 'r': // Prefix: "roomPlan"
 
 								if l := len("roomPlan"); len(elem) >= l && elem[0:l] == "roomPlan" {
@@ -2775,9 +3155,82 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 							}
 
-						case 'c': // Prefix: "calendar.ics"
+						case 'c': // Prefix: "c"
+
+							if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "alendar.ics"
+
+								if l := len("alendar.ics"); len(elem) >= l && elem[0:l] == "alendar.ics" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetBuildingCalendarOperation
+										r.summary = "Public iCalendar feed for building"
+										r.operationID = "getBuildingCalendar"
+										r.operationGroup = ""
+										r.pathPattern = "/buildings/{buildingId}/calendar.ics"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'o': // Prefix: "onstraints"
+
+								if l := len("onstraints"); len(elem) >= l && elem[0:l] == "onstraints" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetBuildingConstraintsOperation
+										r.summary = "Get building constraints"
+										r.operationID = "getBuildingConstraints"
+										r.operationGroup = ""
+										r.pathPattern = "/buildings/{buildingId}/constraints"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "PUT":
+										r.name = UpdateBuildingConstraintsOperation
+										r.summary = "Update building constraints"
+										r.operationID = "updateBuildingConstraints"
+										r.operationGroup = ""
+										r.pathPattern = "/buildings/{buildingId}/constraints"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						case 'q': // Prefix: "qrCodes"
 
-							if l := len("calendar.ics"); len(elem) >= l && elem[0:l] == "calendar.ics" {
+							if l := len("qrCodes"); len(elem) >= l && elem[0:l] == "qrCodes" {
 								elem = elem[l:]
 							} else {
 								break
@@ -2787,11 +3240,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								// Leaf node.
 								switch method {
 								case "GET":
-									r.name = GetBuildingCalendarOperation
-									r.summary = "Public iCalendar feed for building"
-									r.operationID = "getBuildingCalendar"
+									r.name = ExportBuildingQrCodesOperation
+									r.summary = "Export QR code labels of all places in the building"
+									r.operationID = "exportBuildingQrCodes"
 									r.operationGroup = ""
-									r.pathPattern = "/buildings/{buildingId}/calendar.ics"
+									r.pathPattern = "/buildings/{buildingId}/qrCodes"
 									r.args = args
 									r.count = 1
 									return r, true
@@ -2806,6 +3259,54 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 				}
 
+			case 'c': // Prefix: "calendar/users/"
+
+				if l := len("calendar/users/"); len(elem) >= l && elem[0:l] == "calendar/users/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				// Param: "token"
+				// Match until "."
+				idx := strings.IndexByte(elem, '.')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '.': // Prefix: ".ics"
+
+					if l := len(".ics"); len(elem) >= l && elem[0:l] == ".ics" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = GetUserCalendarOperation
+							r.summary = "Personal iCalendar feed"
+							r.operationID = "getUserCalendar"
+							r.operationGroup = ""
+							r.pathPattern = "/calendar/users/{token}.ics"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
+
 			case 'e': // Prefix: "equipment"
 
 				if l := len("equipment"); len(elem) >= l && elem[0:l] == "equipment" {
@@ -3029,6 +3530,84 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							break
 						}
 						switch elem[0] {
+						case 'c': // Prefix: "constraintProfiles"
+
+							if l := len("constraintProfiles"); len(elem) >= l && elem[0:l] == "constraintProfiles" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = ListGroupConstraintProfilesOperation
+									r.summary = "List constraint profiles of group"
+									r.operationID = "listGroupConstraintProfiles"
+									r.operationGroup = ""
+									r.pathPattern = "/groups/{groupId}/constraintProfiles"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "POST":
+									r.name = CreateGroupConstraintProfileOperation
+									r.summary = "Create constraint profile for group"
+									r.operationID = "createGroupConstraintProfile"
+									r.operationGroup = ""
+									r.pathPattern = "/groups/{groupId}/constraintProfiles"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "profileId"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[1] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "DELETE":
+										r.name = DeleteGroupConstraintProfileOperation
+										r.summary = "Delete constraint profile"
+										r.operationID = "deleteGroupConstraintProfile"
+										r.operationGroup = ""
+										r.pathPattern = "/groups/{groupId}/constraintProfiles/{profileId}"
+										r.args = args
+										r.count = 2
+										return r, true
+									case "PUT":
+										r.name = UpdateGroupConstraintProfileOperation
+										r.summary = "Update constraint profile"
+										r.operationID = "updateGroupConstraintProfile"
+										r.operationGroup = ""
+										r.pathPattern = "/groups/{groupId}/constraintProfiles/{profileId}"
+										r.args = args
+										r.count = 2
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
 						case 'm': // Prefix: "members"
 
 							if l := len("members"); len(elem) >= l && elem[0:l] == "members" {
@@ -3376,7 +3955,6 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									}
 
 									if len(elem) == 0 {
-										// Leaf node.
 										switch method {
 										case "GET":
 											r.name = GetPlaceConstraintsOperation
@@ -3400,6 +3978,33 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 											return
 										}
 									}
+									switch elem[0] {
+									case '/': // Prefix: "/effective"
+
+										if l := len("/effective"); len(elem) >= l && elem[0:l] == "/effective" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetEffectivePlaceConstraintsOperation
+												r.summary = "Get effective place constraints"
+												r.operationID = "getEffectivePlaceConstraints"
+												r.operationGroup = ""
+												r.pathPattern = "/places/{placeId}/constraints/effective"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
 
 								}
 
@@ -3455,7 +4060,6 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								}
 
 								if len(elem) == 0 {
-									// Leaf node.
 									switch method {
 									case "GET":
 										r.name = GetPlaceQrCodeOperation
@@ -3470,6 +4074,42 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										return
 									}
 								}
+								switch elem[0] {
+								case '/': // Prefix: "/key"
+
+									if l := len("/key"); len(elem) >= l && elem[0:l] == "/key" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetPlaceQrKeyOperation
+											r.summary = "Get the current QR key of a place"
+											r.operationID = "getPlaceQrKey"
+											r.operationGroup = ""
+											r.pathPattern = "/places/{placeId}/qrCode/key"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "POST":
+											r.name = RotatePlaceQrKeyOperation
+											r.summary = "Rotate the QR key of a place"
+											r.operationID = "rotatePlaceQrKey"
+											r.operationGroup = ""
+											r.pathPattern = "/places/{placeId}/qrCode/key"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
 
 							case 't': // Prefix: "timeSlots"
 
@@ -3990,6 +4630,49 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							break
 						}
 						switch elem[0] {
+						case 'c': // Prefix: "calendar-token"
+
+							if l := len("calendar-token"); len(elem) >= l && elem[0:l] == "calendar-token" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = RevokeCurrentUserCalendarTokenOperation
+									r.summary = "Revoke personal calendar feed token"
+									r.operationID = "revokeCurrentUserCalendarToken"
+									r.operationGroup = ""
+									r.pathPattern = "/users/me/calendar-token"
+									r.args = args
+									r.count = 0
+									return r, true
+								case "GET":
+									r.name = GetCurrentUserCalendarTokenOperation
+									r.summary = "Get personal calendar feed token status"
+									r.operationID = "getCurrentUserCalendarToken"
+									r.operationGroup = ""
+									r.pathPattern = "/users/me/calendar-token"
+									r.args = args
+									r.count = 0
+									return r, true
+								case "POST":
+									r.name = RotateCurrentUserCalendarTokenOperation
+									r.summary = "Create or rotate personal calendar feed token"
+									r.operationID = "rotateCurrentUserCalendarToken"
+									r.operationGroup = ""
+									r.pathPattern = "/users/me/calendar-token"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
 						case 'f': // Prefix: "favorites"
 
 							if l := len("favorites"); len(elem) >= l && elem[0:l] == "favorites" {
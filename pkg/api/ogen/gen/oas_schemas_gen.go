@@ -328,7 +328,8 @@ type AddGroupPermissionsOKApplicationJSON []Permission
 func (*AddGroupPermissionsOKApplicationJSON) addGroupPermissionsRes() {}
 
 type AddGroupPermissionsReq struct {
-	Permissions []string `json:"permissions"`
+	Permissions []string           `json:"permissions"`
+	Scope       OptPermissionScope `json:"scope"`
 }
 
 // GetPermissions returns the value of Permissions.
@@ -336,11 +337,21 @@ func (s *AddGroupPermissionsReq) GetPermissions() []string {
 	return s.Permissions
 }
 
+// GetScope returns the value of Scope.
+func (s *AddGroupPermissionsReq) GetScope() OptPermissionScope {
+	return s.Scope
+}
+
 // SetPermissions sets the value of Permissions.
 func (s *AddGroupPermissionsReq) SetPermissions(val []string) {
 	s.Permissions = val
 }
 
+// SetScope sets the value of Scope.
+func (s *AddGroupPermissionsReq) SetScope(val OptPermissionScope) {
+	s.Scope = val
+}
+
 type AddPlaceBlockingEntriesBadRequest ErrorResponse
 
 func (*AddPlaceBlockingEntriesBadRequest) addPlaceBlockingEntriesRes() {}
@@ -842,8 +853,35 @@ func (s *AuthResponse) SetUser(val User) {
 	s.User = val
 }
 
-func (*AuthResponse) handleOAuthCallbackRes() {}
-func (*AuthResponse) refreshTokenRes()        {}
+func (*AuthResponse) refreshTokenRes() {}
+
+// AuthResponseHeaders wraps AuthResponse with response headers.
+type AuthResponseHeaders struct {
+	SetCookie OptString
+	Response  AuthResponse
+}
+
+// GetSetCookie returns the value of SetCookie.
+func (s *AuthResponseHeaders) GetSetCookie() OptString {
+	return s.SetCookie
+}
+
+// GetResponse returns the value of Response.
+func (s *AuthResponseHeaders) GetResponse() AuthResponse {
+	return s.Response
+}
+
+// SetSetCookie sets the value of SetCookie.
+func (s *AuthResponseHeaders) SetSetCookie(val OptString) {
+	s.SetCookie = val
+}
+
+// SetResponse sets the value of Response.
+func (s *AuthResponseHeaders) SetResponse(val AuthResponse) {
+	s.Response = val
+}
+
+func (*AuthResponseHeaders) handleOAuthCallbackRes() {}
 
 // Ref: #/components/schemas/AvailabilitySlot
 type AvailabilitySlot struct {
@@ -1455,6 +1493,189 @@ func (*Building) createBuildingRes() {}
 func (*Building) getBuildingRes()    {}
 func (*Building) updateBuildingRes() {}
 
+// Ref: #/components/schemas/BuildingAvailability
+type BuildingAvailability struct {
+	// Merged time ranges in which the building is not blocked.
+	Ranges []TimeRange `json:"ranges"`
+	// Regular opening hours of each weekday within the range, starting on Monday.
+	OpeningHours []WeekdayOpeningHours `json:"openingHours"`
+	// Days whose opening hours differ from the regular hours of their weekday.
+	Exceptions []OpeningHoursException `json:"exceptions"`
+}
+
+// GetRanges returns the value of Ranges.
+func (s *BuildingAvailability) GetRanges() []TimeRange {
+	return s.Ranges
+}
+
+// GetOpeningHours returns the value of OpeningHours.
+func (s *BuildingAvailability) GetOpeningHours() []WeekdayOpeningHours {
+	return s.OpeningHours
+}
+
+// GetExceptions returns the value of Exceptions.
+func (s *BuildingAvailability) GetExceptions() []OpeningHoursException {
+	return s.Exceptions
+}
+
+// SetRanges sets the value of Ranges.
+func (s *BuildingAvailability) SetRanges(val []TimeRange) {
+	s.Ranges = val
+}
+
+// SetOpeningHours sets the value of OpeningHours.
+func (s *BuildingAvailability) SetOpeningHours(val []WeekdayOpeningHours) {
+	s.OpeningHours = val
+}
+
+// SetExceptions sets the value of Exceptions.
+func (s *BuildingAvailability) SetExceptions(val []OpeningHoursException) {
+	s.Exceptions = val
+}
+
+func (*BuildingAvailability) getBuildingAvailabilityRes() {}
+
+type CalendarFeed struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s CalendarFeed) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// CalendarFeedHeaders wraps CalendarFeed with response headers.
+type CalendarFeedHeaders struct {
+	ETag         OptString
+	LastModified OptString
+	Response     CalendarFeed
+}
+
+// GetETag returns the value of ETag.
+func (s *CalendarFeedHeaders) GetETag() OptString {
+	return s.ETag
+}
+
+// GetLastModified returns the value of LastModified.
+func (s *CalendarFeedHeaders) GetLastModified() OptString {
+	return s.LastModified
+}
+
+// GetResponse returns the value of Response.
+func (s *CalendarFeedHeaders) GetResponse() CalendarFeed {
+	return s.Response
+}
+
+// SetETag sets the value of ETag.
+func (s *CalendarFeedHeaders) SetETag(val OptString) {
+	s.ETag = val
+}
+
+// SetLastModified sets the value of LastModified.
+func (s *CalendarFeedHeaders) SetLastModified(val OptString) {
+	s.LastModified = val
+}
+
+// SetResponse sets the value of Response.
+func (s *CalendarFeedHeaders) SetResponse(val CalendarFeed) {
+	s.Response = val
+}
+
+func (*CalendarFeedHeaders) getAreaCalendarRes()     {}
+func (*CalendarFeedHeaders) getBuildingCalendarRes() {}
+func (*CalendarFeedHeaders) getPlaceCalendarRes()    {}
+func (*CalendarFeedHeaders) getUserCalendarRes()     {}
+
+// Ref: #/components/schemas/CalendarToken
+type CalendarToken struct {
+	CreatedAt  time.Time      `json:"createdAt"`
+	LastUsedAt OptNilDateTime `json:"lastUsedAt"`
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *CalendarToken) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetLastUsedAt returns the value of LastUsedAt.
+func (s *CalendarToken) GetLastUsedAt() OptNilDateTime {
+	return s.LastUsedAt
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *CalendarToken) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetLastUsedAt sets the value of LastUsedAt.
+func (s *CalendarToken) SetLastUsedAt(val OptNilDateTime) {
+	s.LastUsedAt = val
+}
+
+func (*CalendarToken) getCurrentUserCalendarTokenRes() {}
+
+// Merged schema.
+// Ref: #/components/schemas/CalendarTokenWithSecret
+type CalendarTokenWithSecret struct {
+	CreatedAt  time.Time      `json:"createdAt"`
+	LastUsedAt OptNilDateTime `json:"lastUsedAt"`
+	// Secret feed token (only shown once at creation).
+	Token string `json:"token"`
+	// Path of the feed relative to the API base URL.
+	Path string `json:"path"`
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *CalendarTokenWithSecret) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetLastUsedAt returns the value of LastUsedAt.
+func (s *CalendarTokenWithSecret) GetLastUsedAt() OptNilDateTime {
+	return s.LastUsedAt
+}
+
+// GetToken returns the value of Token.
+func (s *CalendarTokenWithSecret) GetToken() string {
+	return s.Token
+}
+
+// GetPath returns the value of Path.
+func (s *CalendarTokenWithSecret) GetPath() string {
+	return s.Path
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *CalendarTokenWithSecret) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetLastUsedAt sets the value of LastUsedAt.
+func (s *CalendarTokenWithSecret) SetLastUsedAt(val OptNilDateTime) {
+	s.LastUsedAt = val
+}
+
+// SetToken sets the value of Token.
+func (s *CalendarTokenWithSecret) SetToken(val string) {
+	s.Token = val
+}
+
+// SetPath sets the value of Path.
+func (s *CalendarTokenWithSecret) SetPath(val string) {
+	s.Path = val
+}
+
+func (*CalendarTokenWithSecret) rotateCurrentUserCalendarTokenRes() {}
+
+type CancelReservationConflict ErrorResponse
+
+func (*CancelReservationConflict) cancelReservationRes() {}
+
 type CancelReservationForbidden ErrorResponse
 
 func (*CancelReservationForbidden) cancelReservationRes() {}
@@ -1472,6 +1693,10 @@ type CheckInReservationBadRequest ErrorResponse
 
 func (*CheckInReservationBadRequest) checkInReservationRes() {}
 
+type CheckInReservationConflict ErrorResponse
+
+func (*CheckInReservationConflict) checkInReservationRes() {}
+
 type CheckInReservationForbidden ErrorResponse
 
 func (*CheckInReservationForbidden) checkInReservationRes() {}
@@ -1481,7 +1706,7 @@ type CheckInReservationNotFound ErrorResponse
 func (*CheckInReservationNotFound) checkInReservationRes() {}
 
 type CheckInReservationReq struct {
-	// Optional QR code for verification.
+	// Content of the scanned QR code of the place, either the check-in URL or its token.
 	QrCode OptString `json:"qrCode"`
 }
 
@@ -1495,6 +1720,209 @@ func (s *CheckInReservationReq) SetQrCode(val OptString) {
 	s.QrCode = val
 }
 
+type CheckInReservationUnauthorized ErrorResponse
+
+func (*CheckInReservationUnauthorized) checkInReservationRes() {}
+
+// Constraints that override those of places for the members of a
+// group, at all places or only within a building or area. Fields left
+// unset do not override anything; whitelistEnabled, quotaWindow,
+// checkInTimeoutMinutes and decayTimeoutMinutes cannot be set. The
+// server's profile strategy decides between the values of the place and
+// of the profiles of a user's groups: by default the most permissive
+// value applies, so profiles only relax limits; with the restrictive
+// strategy the most restrictive one applies. Unset limits are the most
+// permissive.
+// Ref: #/components/schemas/ConstraintProfile
+type ConstraintProfile struct {
+	ID      uuid.UUID `json:"id"`
+	GroupId uuid.UUID `json:"groupId"`
+	// Name shown in rejections of bookings the profile applied to.
+	Name        string                    `json:"name"`
+	Scope       OptConstraintProfileScope `json:"scope"`
+	Constraints PlaceConstraints          `json:"constraints"`
+	CreatedAt   time.Time                 `json:"createdAt"`
+	UpdatedAt   time.Time                 `json:"updatedAt"`
+}
+
+// GetID returns the value of ID.
+func (s *ConstraintProfile) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetGroupId returns the value of GroupId.
+func (s *ConstraintProfile) GetGroupId() uuid.UUID {
+	return s.GroupId
+}
+
+// GetName returns the value of Name.
+func (s *ConstraintProfile) GetName() string {
+	return s.Name
+}
+
+// GetScope returns the value of Scope.
+func (s *ConstraintProfile) GetScope() OptConstraintProfileScope {
+	return s.Scope
+}
+
+// GetConstraints returns the value of Constraints.
+func (s *ConstraintProfile) GetConstraints() PlaceConstraints {
+	return s.Constraints
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ConstraintProfile) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *ConstraintProfile) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *ConstraintProfile) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetGroupId sets the value of GroupId.
+func (s *ConstraintProfile) SetGroupId(val uuid.UUID) {
+	s.GroupId = val
+}
+
+// SetName sets the value of Name.
+func (s *ConstraintProfile) SetName(val string) {
+	s.Name = val
+}
+
+// SetScope sets the value of Scope.
+func (s *ConstraintProfile) SetScope(val OptConstraintProfileScope) {
+	s.Scope = val
+}
+
+// SetConstraints sets the value of Constraints.
+func (s *ConstraintProfile) SetConstraints(val PlaceConstraints) {
+	s.Constraints = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ConstraintProfile) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *ConstraintProfile) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+func (*ConstraintProfile) createGroupConstraintProfileRes() {}
+func (*ConstraintProfile) updateGroupConstraintProfileRes() {}
+
+// Ref: #/components/schemas/ConstraintProfileRequest
+type ConstraintProfileRequest struct {
+	Name        string                    `json:"name"`
+	Scope       OptConstraintProfileScope `json:"scope"`
+	Constraints PlaceConstraints          `json:"constraints"`
+}
+
+// GetName returns the value of Name.
+func (s *ConstraintProfileRequest) GetName() string {
+	return s.Name
+}
+
+// GetScope returns the value of Scope.
+func (s *ConstraintProfileRequest) GetScope() OptConstraintProfileScope {
+	return s.Scope
+}
+
+// GetConstraints returns the value of Constraints.
+func (s *ConstraintProfileRequest) GetConstraints() PlaceConstraints {
+	return s.Constraints
+}
+
+// SetName sets the value of Name.
+func (s *ConstraintProfileRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetScope sets the value of Scope.
+func (s *ConstraintProfileRequest) SetScope(val OptConstraintProfileScope) {
+	s.Scope = val
+}
+
+// SetConstraints sets the value of Constraints.
+func (s *ConstraintProfileRequest) SetConstraints(val PlaceConstraints) {
+	s.Constraints = val
+}
+
+// Restricts a constraint profile to the places of a building or area.
+// Ref: #/components/schemas/ConstraintProfileScope
+type ConstraintProfileScope struct {
+	Type ConstraintProfileScopeType `json:"type"`
+	ID   uuid.UUID                  `json:"id"`
+}
+
+// GetType returns the value of Type.
+func (s *ConstraintProfileScope) GetType() ConstraintProfileScopeType {
+	return s.Type
+}
+
+// GetID returns the value of ID.
+func (s *ConstraintProfileScope) GetID() uuid.UUID {
+	return s.ID
+}
+
+// SetType sets the value of Type.
+func (s *ConstraintProfileScope) SetType(val ConstraintProfileScopeType) {
+	s.Type = val
+}
+
+// SetID sets the value of ID.
+func (s *ConstraintProfileScope) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+type ConstraintProfileScopeType string
+
+const (
+	ConstraintProfileScopeTypeBuilding ConstraintProfileScopeType = "building"
+	ConstraintProfileScopeTypeArea     ConstraintProfileScopeType = "area"
+)
+
+// AllValues returns all ConstraintProfileScopeType values.
+func (ConstraintProfileScopeType) AllValues() []ConstraintProfileScopeType {
+	return []ConstraintProfileScopeType{
+		ConstraintProfileScopeTypeBuilding,
+		ConstraintProfileScopeTypeArea,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ConstraintProfileScopeType) MarshalText() ([]byte, error) {
+	switch s {
+	case ConstraintProfileScopeTypeBuilding:
+		return []byte(s), nil
+	case ConstraintProfileScopeTypeArea:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ConstraintProfileScopeType) UnmarshalText(data []byte) error {
+	switch ConstraintProfileScopeType(data) {
+	case ConstraintProfileScopeTypeBuilding:
+		*s = ConstraintProfileScopeTypeBuilding
+		return nil
+	case ConstraintProfileScopeTypeArea:
+		*s = ConstraintProfileScopeTypeArea
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // Ref: #/components/schemas/CreateAPIKeyRequest
 type CreateAPIKeyRequest struct {
 	Name      string      `json:"name"`
@@ -1593,7 +2021,7 @@ type CreateBlockingRequest struct {
 	StartTime    time.Time                         `json:"startTime"`
 	EndTime      time.Time                         `json:"endTime"`
 	IsRecurring  OptBool                           `json:"isRecurring"`
-	// ICal RRULE format (required if isRecurring=true).
+	// ICal RRULE format (required if isRecurring=true). FREQ must be DAILY or coarser.
 	RecurrenceRule OptString `json:"recurrenceRule"`
 	// ISO 8601 duration (required if isRecurring=true).
 	RecurrenceDuration OptString `json:"recurrenceDuration"`
@@ -1860,6 +2288,18 @@ type CreateGroupBadRequest ErrorResponse
 
 func (*CreateGroupBadRequest) createGroupRes() {}
 
+type CreateGroupConstraintProfileBadRequest ErrorResponse
+
+func (*CreateGroupConstraintProfileBadRequest) createGroupConstraintProfileRes() {}
+
+type CreateGroupConstraintProfileForbidden ErrorResponse
+
+func (*CreateGroupConstraintProfileForbidden) createGroupConstraintProfileRes() {}
+
+type CreateGroupConstraintProfileNotFound ErrorResponse
+
+func (*CreateGroupConstraintProfileNotFound) createGroupConstraintProfileRes() {}
+
 type CreateGroupForbidden ErrorResponse
 
 func (*CreateGroupForbidden) createGroupRes() {}
@@ -2155,7 +2595,8 @@ func (s *CreatePlaceRequestBookingMethod) UnmarshalText(data []byte) error {
 
 // Ref: #/components/schemas/CreateQRTemplateRequest
 type CreateQRTemplateRequest struct {
-	Name         string `json:"name"`
+	Name string `json:"name"`
+	// See QRTemplate.htmlTemplate. Templates are validated by rendering them with sample data.
 	HtmlTemplate string `json:"htmlTemplate"`
 }
 
@@ -2197,9 +2638,9 @@ func (*CreateReservationConflict) createReservationRes() {}
 
 // CreateReservationCreated represents sum type.
 type CreateReservationCreated struct {
-	Type             CreateReservationCreatedType // switch on this field
-	Reservation      Reservation
-	ReservationArray []Reservation
+	Type              CreateReservationCreatedType // switch on this field
+	Reservation       Reservation
+	ReservationSeries ReservationSeries
 }
 
 // CreateReservationCreatedType is oneOf type of CreateReservationCreated.
@@ -2207,8 +2648,8 @@ type CreateReservationCreatedType string
 
 // Possible values for CreateReservationCreatedType.
 const (
-	ReservationCreateReservationCreated      CreateReservationCreatedType = "Reservation"
-	ReservationArrayCreateReservationCreated CreateReservationCreatedType = "[]Reservation"
+	ReservationCreateReservationCreated       CreateReservationCreatedType = "Reservation"
+	ReservationSeriesCreateReservationCreated CreateReservationCreatedType = "ReservationSeries"
 )
 
 // IsReservation reports whether CreateReservationCreated is Reservation.
@@ -2216,9 +2657,9 @@ func (s CreateReservationCreated) IsReservation() bool {
 	return s.Type == ReservationCreateReservationCreated
 }
 
-// IsReservationArray reports whether CreateReservationCreated is []Reservation.
-func (s CreateReservationCreated) IsReservationArray() bool {
-	return s.Type == ReservationArrayCreateReservationCreated
+// IsReservationSeries reports whether CreateReservationCreated is ReservationSeries.
+func (s CreateReservationCreated) IsReservationSeries() bool {
+	return s.Type == ReservationSeriesCreateReservationCreated
 }
 
 // SetReservation sets CreateReservationCreated to Reservation.
@@ -2242,24 +2683,24 @@ func NewReservationCreateReservationCreated(v Reservation) CreateReservationCrea
 	return s
 }
 
-// SetReservationArray sets CreateReservationCreated to []Reservation.
-func (s *CreateReservationCreated) SetReservationArray(v []Reservation) {
-	s.Type = ReservationArrayCreateReservationCreated
-	s.ReservationArray = v
+// SetReservationSeries sets CreateReservationCreated to ReservationSeries.
+func (s *CreateReservationCreated) SetReservationSeries(v ReservationSeries) {
+	s.Type = ReservationSeriesCreateReservationCreated
+	s.ReservationSeries = v
 }
 
-// GetReservationArray returns []Reservation and true boolean if CreateReservationCreated is []Reservation.
-func (s CreateReservationCreated) GetReservationArray() (v []Reservation, ok bool) {
-	if !s.IsReservationArray() {
+// GetReservationSeries returns ReservationSeries and true boolean if CreateReservationCreated is ReservationSeries.
+func (s CreateReservationCreated) GetReservationSeries() (v ReservationSeries, ok bool) {
+	if !s.IsReservationSeries() {
 		return v, false
 	}
-	return s.ReservationArray, true
+	return s.ReservationSeries, true
 }
 
-// NewReservationArrayCreateReservationCreated returns new CreateReservationCreated from []Reservation.
-func NewReservationArrayCreateReservationCreated(v []Reservation) CreateReservationCreated {
+// NewReservationSeriesCreateReservationCreated returns new CreateReservationCreated from ReservationSeries.
+func NewReservationSeriesCreateReservationCreated(v ReservationSeries) CreateReservationCreated {
 	var s CreateReservationCreated
-	s.SetReservationArray(v)
+	s.SetReservationSeries(v)
 	return s
 }
 
@@ -2275,6 +2716,17 @@ type CreateReservationRequest struct {
 	StartTime  time.Time                             `json:"startTime"`
 	EndTime    time.Time                             `json:"endTime"`
 	Recurrence OptCreateReservationRequestRecurrence `json:"recurrence"`
+	// Books on behalf of this user instead of the caller. Requires
+	// manage:reservations for the place. Mutually exclusive with userEmail.
+	UserId OptUUID `json:"userId"`
+	// Like userId, but looks the user up by email address.
+	UserEmail OptString `json:"userEmail"`
+	// Ignores the user's quotas. Requires manage:reservations for the place
+	// and is recorded in the audit log.
+	BypassQuotas OptBool `json:"bypassQuotas"`
+	// Books despite blockings of the place. Requires manage:reservations for
+	// the place and is recorded in the audit log.
+	BypassBlockings OptBool `json:"bypassBlockings"`
 }
 
 // GetPlaceId returns the value of PlaceId.
@@ -2297,6 +2749,26 @@ func (s *CreateReservationRequest) GetRecurrence() OptCreateReservationRequestRe
 	return s.Recurrence
 }
 
+// GetUserId returns the value of UserId.
+func (s *CreateReservationRequest) GetUserId() OptUUID {
+	return s.UserId
+}
+
+// GetUserEmail returns the value of UserEmail.
+func (s *CreateReservationRequest) GetUserEmail() OptString {
+	return s.UserEmail
+}
+
+// GetBypassQuotas returns the value of BypassQuotas.
+func (s *CreateReservationRequest) GetBypassQuotas() OptBool {
+	return s.BypassQuotas
+}
+
+// GetBypassBlockings returns the value of BypassBlockings.
+func (s *CreateReservationRequest) GetBypassBlockings() OptBool {
+	return s.BypassBlockings
+}
+
 // SetPlaceId sets the value of PlaceId.
 func (s *CreateReservationRequest) SetPlaceId(val uuid.UUID) {
 	s.PlaceId = val
@@ -2317,20 +2789,44 @@ func (s *CreateReservationRequest) SetRecurrence(val OptCreateReservationRequest
 	s.Recurrence = val
 }
 
+// SetUserId sets the value of UserId.
+func (s *CreateReservationRequest) SetUserId(val OptUUID) {
+	s.UserId = val
+}
+
+// SetUserEmail sets the value of UserEmail.
+func (s *CreateReservationRequest) SetUserEmail(val OptString) {
+	s.UserEmail = val
+}
+
+// SetBypassQuotas sets the value of BypassQuotas.
+func (s *CreateReservationRequest) SetBypassQuotas(val OptBool) {
+	s.BypassQuotas = val
+}
+
+// SetBypassBlockings sets the value of BypassBlockings.
+func (s *CreateReservationRequest) SetBypassBlockings(val OptBool) {
+	s.BypassBlockings = val
+}
+
 type CreateReservationRequestRecurrence struct {
-	Pattern OptCreateReservationRequestRecurrencePattern `json:"pattern"`
-	EndDate OptDate                                      `json:"endDate"`
+	Pattern CreateReservationRequestRecurrencePattern `json:"pattern"`
+	EndDate time.Time                                 `json:"endDate"`
 	// Days of week (0=Sunday, 6=Saturday) for weekly patterns.
 	DaysOfWeek []int `json:"daysOfWeek"`
+	// With allOrNothing the whole series is rejected if any occurrence
+	// conflicts, with skipConflicts all free occurrences are booked and
+	// the others are reported as skipped.
+	Mode OptCreateReservationRequestRecurrenceMode `json:"mode"`
 }
 
 // GetPattern returns the value of Pattern.
-func (s *CreateReservationRequestRecurrence) GetPattern() OptCreateReservationRequestRecurrencePattern {
+func (s *CreateReservationRequestRecurrence) GetPattern() CreateReservationRequestRecurrencePattern {
 	return s.Pattern
 }
 
 // GetEndDate returns the value of EndDate.
-func (s *CreateReservationRequestRecurrence) GetEndDate() OptDate {
+func (s *CreateReservationRequestRecurrence) GetEndDate() time.Time {
 	return s.EndDate
 }
 
@@ -2339,13 +2835,18 @@ func (s *CreateReservationRequestRecurrence) GetDaysOfWeek() []int {
 	return s.DaysOfWeek
 }
 
+// GetMode returns the value of Mode.
+func (s *CreateReservationRequestRecurrence) GetMode() OptCreateReservationRequestRecurrenceMode {
+	return s.Mode
+}
+
 // SetPattern sets the value of Pattern.
-func (s *CreateReservationRequestRecurrence) SetPattern(val OptCreateReservationRequestRecurrencePattern) {
+func (s *CreateReservationRequestRecurrence) SetPattern(val CreateReservationRequestRecurrencePattern) {
 	s.Pattern = val
 }
 
 // SetEndDate sets the value of EndDate.
-func (s *CreateReservationRequestRecurrence) SetEndDate(val OptDate) {
+func (s *CreateReservationRequestRecurrence) SetEndDate(val time.Time) {
 	s.EndDate = val
 }
 
@@ -2354,7 +2855,56 @@ func (s *CreateReservationRequestRecurrence) SetDaysOfWeek(val []int) {
 	s.DaysOfWeek = val
 }
 
-type CreateReservationRequestRecurrencePattern string
+// SetMode sets the value of Mode.
+func (s *CreateReservationRequestRecurrence) SetMode(val OptCreateReservationRequestRecurrenceMode) {
+	s.Mode = val
+}
+
+// With allOrNothing the whole series is rejected if any occurrence
+// conflicts, with skipConflicts all free occurrences are booked and
+// the others are reported as skipped.
+type CreateReservationRequestRecurrenceMode string
+
+const (
+	CreateReservationRequestRecurrenceModeAllOrNothing  CreateReservationRequestRecurrenceMode = "allOrNothing"
+	CreateReservationRequestRecurrenceModeSkipConflicts CreateReservationRequestRecurrenceMode = "skipConflicts"
+)
+
+// AllValues returns all CreateReservationRequestRecurrenceMode values.
+func (CreateReservationRequestRecurrenceMode) AllValues() []CreateReservationRequestRecurrenceMode {
+	return []CreateReservationRequestRecurrenceMode{
+		CreateReservationRequestRecurrenceModeAllOrNothing,
+		CreateReservationRequestRecurrenceModeSkipConflicts,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CreateReservationRequestRecurrenceMode) MarshalText() ([]byte, error) {
+	switch s {
+	case CreateReservationRequestRecurrenceModeAllOrNothing:
+		return []byte(s), nil
+	case CreateReservationRequestRecurrenceModeSkipConflicts:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CreateReservationRequestRecurrenceMode) UnmarshalText(data []byte) error {
+	switch CreateReservationRequestRecurrenceMode(data) {
+	case CreateReservationRequestRecurrenceModeAllOrNothing:
+		*s = CreateReservationRequestRecurrenceModeAllOrNothing
+		return nil
+	case CreateReservationRequestRecurrenceModeSkipConflicts:
+		*s = CreateReservationRequestRecurrenceModeSkipConflicts
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type CreateReservationRequestRecurrencePattern string
 
 const (
 	CreateReservationRequestRecurrencePatternDaily    CreateReservationRequestRecurrencePattern = "daily"
@@ -2526,6 +3076,19 @@ type DeleteEquipmentNotFound ErrorResponse
 
 func (*DeleteEquipmentNotFound) deleteEquipmentRes() {}
 
+type DeleteGroupConstraintProfileForbidden ErrorResponse
+
+func (*DeleteGroupConstraintProfileForbidden) deleteGroupConstraintProfileRes() {}
+
+// DeleteGroupConstraintProfileNoContent is response for DeleteGroupConstraintProfile operation.
+type DeleteGroupConstraintProfileNoContent struct{}
+
+func (*DeleteGroupConstraintProfileNoContent) deleteGroupConstraintProfileRes() {}
+
+type DeleteGroupConstraintProfileNotFound ErrorResponse
+
+func (*DeleteGroupConstraintProfileNotFound) deleteGroupConstraintProfileRes() {}
+
 type DeleteGroupForbidden ErrorResponse
 
 func (*DeleteGroupForbidden) deleteGroupRes() {}
@@ -2573,6 +3136,95 @@ type DisableUserNotFound ErrorResponse
 
 func (*DisableUserNotFound) disableUserRes() {}
 
+// Ref: #/components/schemas/EffectivePlaceConstraints
+type EffectivePlaceConstraints struct {
+	Constraints PlaceConstraints `json:"constraints"`
+	// Level each set field of constraints is inherited from, by field name.
+	Sources EffectivePlaceConstraintsSources `json:"sources"`
+}
+
+// GetConstraints returns the value of Constraints.
+func (s *EffectivePlaceConstraints) GetConstraints() PlaceConstraints {
+	return s.Constraints
+}
+
+// GetSources returns the value of Sources.
+func (s *EffectivePlaceConstraints) GetSources() EffectivePlaceConstraintsSources {
+	return s.Sources
+}
+
+// SetConstraints sets the value of Constraints.
+func (s *EffectivePlaceConstraints) SetConstraints(val PlaceConstraints) {
+	s.Constraints = val
+}
+
+// SetSources sets the value of Sources.
+func (s *EffectivePlaceConstraints) SetSources(val EffectivePlaceConstraintsSources) {
+	s.Sources = val
+}
+
+func (*EffectivePlaceConstraints) getEffectivePlaceConstraintsRes() {}
+
+// Level each set field of constraints is inherited from, by field name.
+type EffectivePlaceConstraintsSources map[string]EffectivePlaceConstraintsSourcesItem
+
+func (s *EffectivePlaceConstraintsSources) init() EffectivePlaceConstraintsSources {
+	m := *s
+	if m == nil {
+		m = map[string]EffectivePlaceConstraintsSourcesItem{}
+		*s = m
+	}
+	return m
+}
+
+type EffectivePlaceConstraintsSourcesItem string
+
+const (
+	EffectivePlaceConstraintsSourcesItemPlace    EffectivePlaceConstraintsSourcesItem = "place"
+	EffectivePlaceConstraintsSourcesItemArea     EffectivePlaceConstraintsSourcesItem = "area"
+	EffectivePlaceConstraintsSourcesItemBuilding EffectivePlaceConstraintsSourcesItem = "building"
+)
+
+// AllValues returns all EffectivePlaceConstraintsSourcesItem values.
+func (EffectivePlaceConstraintsSourcesItem) AllValues() []EffectivePlaceConstraintsSourcesItem {
+	return []EffectivePlaceConstraintsSourcesItem{
+		EffectivePlaceConstraintsSourcesItemPlace,
+		EffectivePlaceConstraintsSourcesItemArea,
+		EffectivePlaceConstraintsSourcesItemBuilding,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s EffectivePlaceConstraintsSourcesItem) MarshalText() ([]byte, error) {
+	switch s {
+	case EffectivePlaceConstraintsSourcesItemPlace:
+		return []byte(s), nil
+	case EffectivePlaceConstraintsSourcesItemArea:
+		return []byte(s), nil
+	case EffectivePlaceConstraintsSourcesItemBuilding:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *EffectivePlaceConstraintsSourcesItem) UnmarshalText(data []byte) error {
+	switch EffectivePlaceConstraintsSourcesItem(data) {
+	case EffectivePlaceConstraintsSourcesItemPlace:
+		*s = EffectivePlaceConstraintsSourcesItemPlace
+		return nil
+	case EffectivePlaceConstraintsSourcesItemArea:
+		*s = EffectivePlaceConstraintsSourcesItemArea
+		return nil
+	case EffectivePlaceConstraintsSourcesItemBuilding:
+		*s = EffectivePlaceConstraintsSourcesItemBuilding
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 type EnableUserForbidden ErrorResponse
 
 func (*EnableUserForbidden) enableUserRes() {}
@@ -2648,42 +3300,45 @@ func (s *ErrorResponse) SetError(val ErrorResponseError) {
 	s.Error = val
 }
 
-func (*ErrorResponse) exportReservationsRes()          {}
-func (*ErrorResponse) getAreaAvailabilityRes()         {}
-func (*ErrorResponse) getAreaBlockingRes()             {}
-func (*ErrorResponse) getAreaCalendarRes()             {}
-func (*ErrorResponse) getAreaRes()                     {}
-func (*ErrorResponse) getAreaRoomPlanRes()             {}
-func (*ErrorResponse) getAuditLogRes()                 {}
-func (*ErrorResponse) getBuildingAvailabilityRes()     {}
-func (*ErrorResponse) getBuildingBlockingRes()         {}
-func (*ErrorResponse) getBuildingCalendarRes()         {}
-func (*ErrorResponse) getBuildingRes()                 {}
-func (*ErrorResponse) getCurrentOccupancyRes()         {}
-func (*ErrorResponse) getCurrentUserFavoritesRes()     {}
-func (*ErrorResponse) getCurrentUserNotificationsRes() {}
-func (*ErrorResponse) getCurrentUserRes()              {}
-func (*ErrorResponse) getDefaultGroupAssignmentRes()   {}
-func (*ErrorResponse) getEquipmentRes()                {}
-func (*ErrorResponse) getPlaceAvailabilityRes()        {}
-func (*ErrorResponse) getPlaceBlockingRes()            {}
-func (*ErrorResponse) getPlaceCalendarRes()            {}
-func (*ErrorResponse) getPlaceConstraintsRes()         {}
-func (*ErrorResponse) getPlaceEquipmentRes()           {}
-func (*ErrorResponse) getPlaceRes()                    {}
-func (*ErrorResponse) getPlaceTimeSlotsRes()           {}
-func (*ErrorResponse) getStatisticsRes()               {}
-func (*ErrorResponse) getUsageStatisticsRes()          {}
-func (*ErrorResponse) listApiKeysRes()                 {}
-func (*ErrorResponse) listAreaPlacesRes()              {}
-func (*ErrorResponse) listBuildingAreasRes()           {}
-func (*ErrorResponse) listGroupsRes()                  {}
-func (*ErrorResponse) listPermissionsRes()             {}
-func (*ErrorResponse) listQrTemplatesRes()             {}
-func (*ErrorResponse) listReservationsRes()            {}
-func (*ErrorResponse) listUsersRes()                   {}
-func (*ErrorResponse) refreshTokenRes()                {}
-func (*ErrorResponse) removeCurrentUserFavoritesRes()  {}
+func (*ErrorResponse) exportReservationsRes()             {}
+func (*ErrorResponse) getAreaBlockingRes()                {}
+func (*ErrorResponse) getAreaCalendarRes()                {}
+func (*ErrorResponse) getAreaConstraintsRes()             {}
+func (*ErrorResponse) getAreaRes()                        {}
+func (*ErrorResponse) getAreaRoomPlanRes()                {}
+func (*ErrorResponse) getAuditLogRes()                    {}
+func (*ErrorResponse) getBuildingBlockingRes()            {}
+func (*ErrorResponse) getBuildingCalendarRes()            {}
+func (*ErrorResponse) getBuildingConstraintsRes()         {}
+func (*ErrorResponse) getBuildingRes()                    {}
+func (*ErrorResponse) getCurrentOccupancyRes()            {}
+func (*ErrorResponse) getCurrentUserFavoritesRes()        {}
+func (*ErrorResponse) getCurrentUserNotificationsRes()    {}
+func (*ErrorResponse) getCurrentUserRes()                 {}
+func (*ErrorResponse) getDefaultGroupAssignmentRes()      {}
+func (*ErrorResponse) getEffectivePlaceConstraintsRes()   {}
+func (*ErrorResponse) getEquipmentRes()                   {}
+func (*ErrorResponse) getPlaceBlockingRes()               {}
+func (*ErrorResponse) getPlaceCalendarRes()               {}
+func (*ErrorResponse) getPlaceConstraintsRes()            {}
+func (*ErrorResponse) getPlaceEquipmentRes()              {}
+func (*ErrorResponse) getPlaceRes()                       {}
+func (*ErrorResponse) getPlaceTimeSlotsRes()              {}
+func (*ErrorResponse) getStatisticsRes()                  {}
+func (*ErrorResponse) getUsageStatisticsRes()             {}
+func (*ErrorResponse) getUserCalendarRes()                {}
+func (*ErrorResponse) listApiKeysRes()                    {}
+func (*ErrorResponse) listAreaPlacesRes()                 {}
+func (*ErrorResponse) listBuildingAreasRes()              {}
+func (*ErrorResponse) listGroupsRes()                     {}
+func (*ErrorResponse) listPermissionsRes()                {}
+func (*ErrorResponse) listQrTemplatesRes()                {}
+func (*ErrorResponse) listReservationsRes()               {}
+func (*ErrorResponse) listUsersRes()                      {}
+func (*ErrorResponse) refreshTokenRes()                   {}
+func (*ErrorResponse) removeCurrentUserFavoritesRes()     {}
+func (*ErrorResponse) revokeCurrentUserCalendarTokenRes() {}
+func (*ErrorResponse) rotateCurrentUserCalendarTokenRes() {}
 
 type ErrorResponseError struct {
 	Code    string                       `json:"code"`
@@ -2732,6 +3387,56 @@ func (s *ErrorResponseErrorDetails) init() ErrorResponseErrorDetails {
 	return m
 }
 
+// ErrorResponseHeaders wraps ErrorResponse with response headers.
+type ErrorResponseHeaders struct {
+	SetCookie OptString
+	Response  ErrorResponse
+}
+
+// GetSetCookie returns the value of SetCookie.
+func (s *ErrorResponseHeaders) GetSetCookie() OptString {
+	return s.SetCookie
+}
+
+// GetResponse returns the value of Response.
+func (s *ErrorResponseHeaders) GetResponse() ErrorResponse {
+	return s.Response
+}
+
+// SetSetCookie sets the value of SetCookie.
+func (s *ErrorResponseHeaders) SetSetCookie(val OptString) {
+	s.SetCookie = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ErrorResponseHeaders) SetResponse(val ErrorResponse) {
+	s.Response = val
+}
+
+type ExportAreaQrCodesBadRequest ErrorResponse
+
+func (*ExportAreaQrCodesBadRequest) exportAreaQrCodesRes() {}
+
+type ExportAreaQrCodesForbidden ErrorResponse
+
+func (*ExportAreaQrCodesForbidden) exportAreaQrCodesRes() {}
+
+type ExportAreaQrCodesNotFound ErrorResponse
+
+func (*ExportAreaQrCodesNotFound) exportAreaQrCodesRes() {}
+
+type ExportBuildingQrCodesBadRequest ErrorResponse
+
+func (*ExportBuildingQrCodesBadRequest) exportBuildingQrCodesRes() {}
+
+type ExportBuildingQrCodesForbidden ErrorResponse
+
+func (*ExportBuildingQrCodesForbidden) exportBuildingQrCodesRes() {}
+
+type ExportBuildingQrCodesNotFound ErrorResponse
+
+func (*ExportBuildingQrCodesNotFound) exportBuildingQrCodesRes() {}
+
 type ExportReservationsOK struct {
 	Data io.Reader
 }
@@ -2748,6 +3453,14 @@ func (s ExportReservationsOK) Read(p []byte) (n int, err error) {
 
 func (*ExportReservationsOK) exportReservationsRes() {}
 
+type GetAreaAvailabilityBadRequest ErrorResponse
+
+func (*GetAreaAvailabilityBadRequest) getAreaAvailabilityRes() {}
+
+type GetAreaAvailabilityNotFound ErrorResponse
+
+func (*GetAreaAvailabilityNotFound) getAreaAvailabilityRes() {}
+
 type GetAreaAvailabilityOKApplicationJSON []PlaceAvailability
 
 func (*GetAreaAvailabilityOKApplicationJSON) getAreaAvailabilityRes() {}
@@ -2756,22 +3469,6 @@ type GetAreaBlockingOKApplicationJSON []Blocking
 
 func (*GetAreaBlockingOKApplicationJSON) getAreaBlockingRes() {}
 
-type GetAreaCalendarOK struct {
-	Data io.Reader
-}
-
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s GetAreaCalendarOK) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
-	}
-	return s.Data.Read(p)
-}
-
-func (*GetAreaCalendarOK) getAreaCalendarRes() {}
-
 type GetAuditLogAction string
 
 const (
@@ -2889,29 +3586,25 @@ func (s *GetAuditLogEntityType) UnmarshalText(data []byte) error {
 	}
 }
 
-type GetBuildingAvailabilityOKApplicationJSON []TimeRange
+type GetBuildingAvailabilityBadRequest ErrorResponse
 
-func (*GetBuildingAvailabilityOKApplicationJSON) getBuildingAvailabilityRes() {}
+func (*GetBuildingAvailabilityBadRequest) getBuildingAvailabilityRes() {}
+
+type GetBuildingAvailabilityNotFound ErrorResponse
+
+func (*GetBuildingAvailabilityNotFound) getBuildingAvailabilityRes() {}
 
 type GetBuildingBlockingOKApplicationJSON []Blocking
 
 func (*GetBuildingBlockingOKApplicationJSON) getBuildingBlockingRes() {}
 
-type GetBuildingCalendarOK struct {
-	Data io.Reader
-}
+type GetCurrentUserCalendarTokenNotFound ErrorResponse
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s GetBuildingCalendarOK) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
-	}
-	return s.Data.Read(p)
-}
+func (*GetCurrentUserCalendarTokenNotFound) getCurrentUserCalendarTokenRes() {}
 
-func (*GetBuildingCalendarOK) getBuildingCalendarRes() {}
+type GetCurrentUserCalendarTokenUnauthorized ErrorResponse
+
+func (*GetCurrentUserCalendarTokenUnauthorized) getCurrentUserCalendarTokenRes() {}
 
 type GetCurrentUserFavoritesOKApplicationJSON []Place
 
@@ -2953,6 +3646,14 @@ type GetGroupPermissionsOKApplicationJSON []Permission
 
 func (*GetGroupPermissionsOKApplicationJSON) getGroupPermissionsRes() {}
 
+type GetPlaceAvailabilityBadRequest ErrorResponse
+
+func (*GetPlaceAvailabilityBadRequest) getPlaceAvailabilityRes() {}
+
+type GetPlaceAvailabilityNotFound ErrorResponse
+
+func (*GetPlaceAvailabilityNotFound) getPlaceAvailabilityRes() {}
+
 type GetPlaceAvailabilityOKApplicationJSON []AvailabilitySlot
 
 func (*GetPlaceAvailabilityOKApplicationJSON) getPlaceAvailabilityRes() {}
@@ -2961,22 +3662,6 @@ type GetPlaceBlockingOKApplicationJSON []Blocking
 
 func (*GetPlaceBlockingOKApplicationJSON) getPlaceBlockingRes() {}
 
-type GetPlaceCalendarOK struct {
-	Data io.Reader
-}
-
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s GetPlaceCalendarOK) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
-	}
-	return s.Data.Read(p)
-}
-
-func (*GetPlaceCalendarOK) getPlaceCalendarRes() {}
-
 type GetPlaceEquipmentOKApplicationJSON []Equipment
 
 func (*GetPlaceEquipmentOKApplicationJSON) getPlaceEquipmentRes() {}
@@ -3133,6 +3818,14 @@ func (s GetPlaceQrCodeOKImagePNG) Read(p []byte) (n int, err error) {
 
 func (*GetPlaceQrCodeOKImagePNG) getPlaceQrCodeRes() {}
 
+type GetPlaceQrKeyForbidden ErrorResponse
+
+func (*GetPlaceQrKeyForbidden) getPlaceQrKeyRes() {}
+
+type GetPlaceQrKeyNotFound ErrorResponse
+
+func (*GetPlaceQrKeyNotFound) getPlaceQrKeyRes() {}
+
 type GetPlaceWhitelistForbidden ErrorResponse
 
 func (*GetPlaceWhitelistForbidden) getPlaceWhitelistRes() {}
@@ -3453,16 +4146,43 @@ func (s *GroupWithMembers) SetMembers(val []UserReference) {
 
 func (*GroupWithMembers) getGroupRes() {}
 
-type HandleOAuthCallbackBadRequest ErrorResponse
+type HandleOAuthCallbackBadRequest ErrorResponseHeaders
 
 func (*HandleOAuthCallbackBadRequest) handleOAuthCallbackRes() {}
 
-type HandleOAuthCallbackUnauthorized ErrorResponse
+type HandleOAuthCallbackConflict ErrorResponseHeaders
+
+func (*HandleOAuthCallbackConflict) handleOAuthCallbackRes() {}
+
+type HandleOAuthCallbackUnauthorized ErrorResponseHeaders
 
 func (*HandleOAuthCallbackUnauthorized) handleOAuthCallbackRes() {}
 
 // InitiateOAuthLoginFound is response for InitiateOAuthLogin operation.
-type InitiateOAuthLoginFound struct{}
+type InitiateOAuthLoginFound struct {
+	Location  OptURI
+	SetCookie OptString
+}
+
+// GetLocation returns the value of Location.
+func (s *InitiateOAuthLoginFound) GetLocation() OptURI {
+	return s.Location
+}
+
+// GetSetCookie returns the value of SetCookie.
+func (s *InitiateOAuthLoginFound) GetSetCookie() OptString {
+	return s.SetCookie
+}
+
+// SetLocation sets the value of Location.
+func (s *InitiateOAuthLoginFound) SetLocation(val OptURI) {
+	s.Location = val
+}
+
+// SetSetCookie sets the value of SetCookie.
+func (s *InitiateOAuthLoginFound) SetSetCookie(val OptString) {
+	s.SetCookie = val
+}
 
 type ListApiKeysOKApplicationJSON []APIKey
 
@@ -3476,6 +4196,18 @@ type ListBuildingAreasOKApplicationJSON []Area
 
 func (*ListBuildingAreasOKApplicationJSON) listBuildingAreasRes() {}
 
+type ListGroupConstraintProfilesForbidden ErrorResponse
+
+func (*ListGroupConstraintProfilesForbidden) listGroupConstraintProfilesRes() {}
+
+type ListGroupConstraintProfilesNotFound ErrorResponse
+
+func (*ListGroupConstraintProfilesNotFound) listGroupConstraintProfilesRes() {}
+
+type ListGroupConstraintProfilesOKApplicationJSON []ConstraintProfile
+
+func (*ListGroupConstraintProfilesOKApplicationJSON) listGroupConstraintProfilesRes() {}
+
 type ListGroupsOKApplicationJSON []Group
 
 func (*ListGroupsOKApplicationJSON) listGroupsRes() {}
@@ -3587,6 +4319,26 @@ func (s *ListUsersStatus) UnmarshalText(data []byte) error {
 // LogoutNoContent is response for Logout operation.
 type LogoutNoContent struct{}
 
+// Ref: #/components/responses/NotModified
+type NotModified struct {
+	ETag OptString
+}
+
+// GetETag returns the value of ETag.
+func (s *NotModified) GetETag() OptString {
+	return s.ETag
+}
+
+// SetETag sets the value of ETag.
+func (s *NotModified) SetETag(val OptString) {
+	s.ETag = val
+}
+
+func (*NotModified) getAreaCalendarRes()     {}
+func (*NotModified) getBuildingCalendarRes() {}
+func (*NotModified) getPlaceCalendarRes()    {}
+func (*NotModified) getUserCalendarRes()     {}
+
 // Ref: #/components/schemas/NotificationPreferences
 type NotificationPreferences struct {
 	ReservationConfirmed  OptBool `json:"reservationConfirmed"`
@@ -3649,6 +4401,98 @@ func (s *NotificationPreferences) SetCheckInWarning(val OptBool) {
 func (*NotificationPreferences) getCurrentUserNotificationsRes()    {}
 func (*NotificationPreferences) updateCurrentUserNotificationsRes() {}
 
+// Ref: #/components/schemas/OccurrenceRejection
+type OccurrenceRejection struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *OccurrenceRejection) GetCode() string {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *OccurrenceRejection) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *OccurrenceRejection) SetCode(val string) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *OccurrenceRejection) SetMessage(val string) {
+	s.Message = val
+}
+
+// Ref: #/components/schemas/OpeningHoursException
+type OpeningHoursException struct {
+	Date time.Time `json:"date"`
+	// Open ranges of the day; empty if the building is closed.
+	Hours []OpeningHoursRange `json:"hours"`
+	// Reason or name of the blocking that causes the deviation.
+	Reason OptNilString `json:"reason"`
+}
+
+// GetDate returns the value of Date.
+func (s *OpeningHoursException) GetDate() time.Time {
+	return s.Date
+}
+
+// GetHours returns the value of Hours.
+func (s *OpeningHoursException) GetHours() []OpeningHoursRange {
+	return s.Hours
+}
+
+// GetReason returns the value of Reason.
+func (s *OpeningHoursException) GetReason() OptNilString {
+	return s.Reason
+}
+
+// SetDate sets the value of Date.
+func (s *OpeningHoursException) SetDate(val time.Time) {
+	s.Date = val
+}
+
+// SetHours sets the value of Hours.
+func (s *OpeningHoursException) SetHours(val []OpeningHoursRange) {
+	s.Hours = val
+}
+
+// SetReason sets the value of Reason.
+func (s *OpeningHoursException) SetReason(val OptNilString) {
+	s.Reason = val
+}
+
+// Wall clock times in the booking time zone; an endTime of "24:00" is the end of the day.
+// Ref: #/components/schemas/OpeningHoursRange
+type OpeningHoursRange struct {
+	StartTime string `json:"startTime"`
+	EndTime   string `json:"endTime"`
+}
+
+// GetStartTime returns the value of StartTime.
+func (s *OpeningHoursRange) GetStartTime() string {
+	return s.StartTime
+}
+
+// GetEndTime returns the value of EndTime.
+func (s *OpeningHoursRange) GetEndTime() string {
+	return s.EndTime
+}
+
+// SetStartTime sets the value of StartTime.
+func (s *OpeningHoursRange) SetStartTime(val string) {
+	s.StartTime = val
+}
+
+// SetEndTime sets the value of EndTime.
+func (s *OpeningHoursRange) SetEndTime(val string) {
+	s.EndTime = val
+}
+
 // NewOptAuditLogEntryChanges returns new OptAuditLogEntryChanges with value set to v.
 func NewOptAuditLogEntryChanges(v AuditLogEntryChanges) OptAuditLogEntryChanges {
 	return OptAuditLogEntryChanges{
@@ -3787,38 +4631,38 @@ func (o OptCheckInReservationReq) Or(d CheckInReservationReq) CheckInReservation
 	return d
 }
 
-// NewOptCreatePlaceRequestBookingMethod returns new OptCreatePlaceRequestBookingMethod with value set to v.
-func NewOptCreatePlaceRequestBookingMethod(v CreatePlaceRequestBookingMethod) OptCreatePlaceRequestBookingMethod {
-	return OptCreatePlaceRequestBookingMethod{
+// NewOptConstraintProfileScope returns new OptConstraintProfileScope with value set to v.
+func NewOptConstraintProfileScope(v ConstraintProfileScope) OptConstraintProfileScope {
+	return OptConstraintProfileScope{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptCreatePlaceRequestBookingMethod is optional CreatePlaceRequestBookingMethod.
-type OptCreatePlaceRequestBookingMethod struct {
-	Value CreatePlaceRequestBookingMethod
+// OptConstraintProfileScope is optional ConstraintProfileScope.
+type OptConstraintProfileScope struct {
+	Value ConstraintProfileScope
 	Set   bool
 }
 
-// IsSet returns true if OptCreatePlaceRequestBookingMethod was set.
-func (o OptCreatePlaceRequestBookingMethod) IsSet() bool { return o.Set }
+// IsSet returns true if OptConstraintProfileScope was set.
+func (o OptConstraintProfileScope) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptCreatePlaceRequestBookingMethod) Reset() {
-	var v CreatePlaceRequestBookingMethod
+func (o *OptConstraintProfileScope) Reset() {
+	var v ConstraintProfileScope
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptCreatePlaceRequestBookingMethod) SetTo(v CreatePlaceRequestBookingMethod) {
+func (o *OptConstraintProfileScope) SetTo(v ConstraintProfileScope) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptCreatePlaceRequestBookingMethod) Get() (v CreatePlaceRequestBookingMethod, ok bool) {
+func (o OptConstraintProfileScope) Get() (v ConstraintProfileScope, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -3826,22 +4670,68 @@ func (o OptCreatePlaceRequestBookingMethod) Get() (v CreatePlaceRequestBookingMe
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptCreatePlaceRequestBookingMethod) Or(d CreatePlaceRequestBookingMethod) CreatePlaceRequestBookingMethod {
+func (o OptConstraintProfileScope) Or(d ConstraintProfileScope) ConstraintProfileScope {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptCreateReservationRequestRecurrence returns new OptCreateReservationRequestRecurrence with value set to v.
-func NewOptCreateReservationRequestRecurrence(v CreateReservationRequestRecurrence) OptCreateReservationRequestRecurrence {
-	return OptCreateReservationRequestRecurrence{
+// NewOptCreatePlaceRequestBookingMethod returns new OptCreatePlaceRequestBookingMethod with value set to v.
+func NewOptCreatePlaceRequestBookingMethod(v CreatePlaceRequestBookingMethod) OptCreatePlaceRequestBookingMethod {
+	return OptCreatePlaceRequestBookingMethod{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptCreateReservationRequestRecurrence is optional CreateReservationRequestRecurrence.
+// OptCreatePlaceRequestBookingMethod is optional CreatePlaceRequestBookingMethod.
+type OptCreatePlaceRequestBookingMethod struct {
+	Value CreatePlaceRequestBookingMethod
+	Set   bool
+}
+
+// IsSet returns true if OptCreatePlaceRequestBookingMethod was set.
+func (o OptCreatePlaceRequestBookingMethod) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCreatePlaceRequestBookingMethod) Reset() {
+	var v CreatePlaceRequestBookingMethod
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCreatePlaceRequestBookingMethod) SetTo(v CreatePlaceRequestBookingMethod) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCreatePlaceRequestBookingMethod) Get() (v CreatePlaceRequestBookingMethod, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCreatePlaceRequestBookingMethod) Or(d CreatePlaceRequestBookingMethod) CreatePlaceRequestBookingMethod {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCreateReservationRequestRecurrence returns new OptCreateReservationRequestRecurrence with value set to v.
+func NewOptCreateReservationRequestRecurrence(v CreateReservationRequestRecurrence) OptCreateReservationRequestRecurrence {
+	return OptCreateReservationRequestRecurrence{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCreateReservationRequestRecurrence is optional CreateReservationRequestRecurrence.
 type OptCreateReservationRequestRecurrence struct {
 	Value CreateReservationRequestRecurrence
 	Set   bool
@@ -3879,38 +4769,38 @@ func (o OptCreateReservationRequestRecurrence) Or(d CreateReservationRequestRecu
 	return d
 }
 
-// NewOptCreateReservationRequestRecurrencePattern returns new OptCreateReservationRequestRecurrencePattern with value set to v.
-func NewOptCreateReservationRequestRecurrencePattern(v CreateReservationRequestRecurrencePattern) OptCreateReservationRequestRecurrencePattern {
-	return OptCreateReservationRequestRecurrencePattern{
+// NewOptCreateReservationRequestRecurrenceMode returns new OptCreateReservationRequestRecurrenceMode with value set to v.
+func NewOptCreateReservationRequestRecurrenceMode(v CreateReservationRequestRecurrenceMode) OptCreateReservationRequestRecurrenceMode {
+	return OptCreateReservationRequestRecurrenceMode{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptCreateReservationRequestRecurrencePattern is optional CreateReservationRequestRecurrencePattern.
-type OptCreateReservationRequestRecurrencePattern struct {
-	Value CreateReservationRequestRecurrencePattern
+// OptCreateReservationRequestRecurrenceMode is optional CreateReservationRequestRecurrenceMode.
+type OptCreateReservationRequestRecurrenceMode struct {
+	Value CreateReservationRequestRecurrenceMode
 	Set   bool
 }
 
-// IsSet returns true if OptCreateReservationRequestRecurrencePattern was set.
-func (o OptCreateReservationRequestRecurrencePattern) IsSet() bool { return o.Set }
+// IsSet returns true if OptCreateReservationRequestRecurrenceMode was set.
+func (o OptCreateReservationRequestRecurrenceMode) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptCreateReservationRequestRecurrencePattern) Reset() {
-	var v CreateReservationRequestRecurrencePattern
+func (o *OptCreateReservationRequestRecurrenceMode) Reset() {
+	var v CreateReservationRequestRecurrenceMode
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptCreateReservationRequestRecurrencePattern) SetTo(v CreateReservationRequestRecurrencePattern) {
+func (o *OptCreateReservationRequestRecurrenceMode) SetTo(v CreateReservationRequestRecurrenceMode) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptCreateReservationRequestRecurrencePattern) Get() (v CreateReservationRequestRecurrencePattern, ok bool) {
+func (o OptCreateReservationRequestRecurrenceMode) Get() (v CreateReservationRequestRecurrenceMode, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -3918,7 +4808,7 @@ func (o OptCreateReservationRequestRecurrencePattern) Get() (v CreateReservation
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptCreateReservationRequestRecurrencePattern) Or(d CreateReservationRequestRecurrencePattern) CreateReservationRequestRecurrencePattern {
+func (o OptCreateReservationRequestRecurrenceMode) Or(d CreateReservationRequestRecurrenceMode) CreateReservationRequestRecurrenceMode {
 	if v, ok := o.Get(); ok {
 		return v
 	}
@@ -4494,6 +5384,69 @@ func (o OptNilAvailabilitySlotBlockingSource) Or(d AvailabilitySlotBlockingSourc
 	return d
 }
 
+// NewOptNilBool returns new OptNilBool with value set to v.
+func NewOptNilBool(v bool) OptNilBool {
+	return OptNilBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilBool is optional nullable bool.
+type OptNilBool struct {
+	Value bool
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilBool was set.
+func (o OptNilBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilBool) SetTo(v bool) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilBool) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilBool) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v bool
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilBool) Get() (v bool, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptNilDate returns new OptNilDate with value set to v.
 func NewOptNilDate(v time.Time) OptNilDate {
 	return OptNilDate{
@@ -4746,6 +5699,69 @@ func (o OptNilPlaceAvailabilityBlockedSource) Or(d PlaceAvailabilityBlockedSourc
 	return d
 }
 
+// NewOptNilPlaceConstraintsQuotaWindow returns new OptNilPlaceConstraintsQuotaWindow with value set to v.
+func NewOptNilPlaceConstraintsQuotaWindow(v PlaceConstraintsQuotaWindow) OptNilPlaceConstraintsQuotaWindow {
+	return OptNilPlaceConstraintsQuotaWindow{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilPlaceConstraintsQuotaWindow is optional nullable PlaceConstraintsQuotaWindow.
+type OptNilPlaceConstraintsQuotaWindow struct {
+	Value PlaceConstraintsQuotaWindow
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilPlaceConstraintsQuotaWindow was set.
+func (o OptNilPlaceConstraintsQuotaWindow) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilPlaceConstraintsQuotaWindow) Reset() {
+	var v PlaceConstraintsQuotaWindow
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilPlaceConstraintsQuotaWindow) SetTo(v PlaceConstraintsQuotaWindow) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilPlaceConstraintsQuotaWindow) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilPlaceConstraintsQuotaWindow) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v PlaceConstraintsQuotaWindow
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilPlaceConstraintsQuotaWindow) Get() (v PlaceConstraintsQuotaWindow, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilPlaceConstraintsQuotaWindow) Or(d PlaceConstraintsQuotaWindow) PlaceConstraintsQuotaWindow {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptNilString returns new OptNilString with value set to v.
 func NewOptNilString(v string) OptNilString {
 	return OptNilString{
@@ -4848,16 +5864,309 @@ func (o OptNilTime) IsNull() bool { return o.Null }
 // SetToNull sets value to null.
 func (o *OptNilTime) SetToNull() {
 	o.Set = true
-	o.Null = true
-	var v time.Time
+	o.Null = true
+	var v time.Time
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilTime) Get() (v time.Time, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilUUID returns new OptNilUUID with value set to v.
+func NewOptNilUUID(v uuid.UUID) OptNilUUID {
+	return OptNilUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilUUID is optional nullable uuid.UUID.
+type OptNilUUID struct {
+	Value uuid.UUID
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilUUID was set.
+func (o OptNilUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilUUID) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilUUID) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v uuid.UUID
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilUUID) Get() (v uuid.UUID, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptOccurrenceRejection returns new OptOccurrenceRejection with value set to v.
+func NewOptOccurrenceRejection(v OccurrenceRejection) OptOccurrenceRejection {
+	return OptOccurrenceRejection{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptOccurrenceRejection is optional OccurrenceRejection.
+type OptOccurrenceRejection struct {
+	Value OccurrenceRejection
+	Set   bool
+}
+
+// IsSet returns true if OptOccurrenceRejection was set.
+func (o OptOccurrenceRejection) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptOccurrenceRejection) Reset() {
+	var v OccurrenceRejection
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptOccurrenceRejection) SetTo(v OccurrenceRejection) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOccurrenceRejection) Get() (v OccurrenceRejection, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptOccurrenceRejection) Or(d OccurrenceRejection) OccurrenceRejection {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPermissionScope returns new OptPermissionScope with value set to v.
+func NewOptPermissionScope(v PermissionScope) OptPermissionScope {
+	return OptPermissionScope{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPermissionScope is optional PermissionScope.
+type OptPermissionScope struct {
+	Value PermissionScope
+	Set   bool
+}
+
+// IsSet returns true if OptPermissionScope was set.
+func (o OptPermissionScope) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPermissionScope) Reset() {
+	var v PermissionScope
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPermissionScope) SetTo(v PermissionScope) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPermissionScope) Get() (v PermissionScope, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPermissionScope) Or(d PermissionScope) PermissionScope {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPlaceConstraints returns new OptPlaceConstraints with value set to v.
+func NewOptPlaceConstraints(v PlaceConstraints) OptPlaceConstraints {
+	return OptPlaceConstraints{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPlaceConstraints is optional PlaceConstraints.
+type OptPlaceConstraints struct {
+	Value PlaceConstraints
+	Set   bool
+}
+
+// IsSet returns true if OptPlaceConstraints was set.
+func (o OptPlaceConstraints) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPlaceConstraints) Reset() {
+	var v PlaceConstraints
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPlaceConstraints) SetTo(v PlaceConstraints) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPlaceConstraints) Get() (v PlaceConstraints, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPlaceConstraints) Or(d PlaceConstraints) PlaceConstraints {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPreviewQrTemplateFormat returns new OptPreviewQrTemplateFormat with value set to v.
+func NewOptPreviewQrTemplateFormat(v PreviewQrTemplateFormat) OptPreviewQrTemplateFormat {
+	return OptPreviewQrTemplateFormat{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPreviewQrTemplateFormat is optional PreviewQrTemplateFormat.
+type OptPreviewQrTemplateFormat struct {
+	Value PreviewQrTemplateFormat
+	Set   bool
+}
+
+// IsSet returns true if OptPreviewQrTemplateFormat was set.
+func (o OptPreviewQrTemplateFormat) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPreviewQrTemplateFormat) Reset() {
+	var v PreviewQrTemplateFormat
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPreviewQrTemplateFormat) SetTo(v PreviewQrTemplateFormat) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPreviewQrTemplateFormat) Get() (v PreviewQrTemplateFormat, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPreviewQrTemplateFormat) Or(d PreviewQrTemplateFormat) PreviewQrTemplateFormat {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptQrExportFormatParam returns new OptQrExportFormatParam with value set to v.
+func NewOptQrExportFormatParam(v QrExportFormatParam) OptQrExportFormatParam {
+	return OptQrExportFormatParam{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptQrExportFormatParam is optional QrExportFormatParam.
+type OptQrExportFormatParam struct {
+	Value QrExportFormatParam
+	Set   bool
+}
+
+// IsSet returns true if OptQrExportFormatParam was set.
+func (o OptQrExportFormatParam) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptQrExportFormatParam) Reset() {
+	var v QrExportFormatParam
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptQrExportFormatParam) SetTo(v QrExportFormatParam) {
+	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilTime) Get() (v time.Time, ok bool) {
-	if o.Null {
-		return v, false
-	}
+func (o OptQrExportFormatParam) Get() (v QrExportFormatParam, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -4865,62 +6174,45 @@ func (o OptNilTime) Get() (v time.Time, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilTime) Or(d time.Time) time.Time {
+func (o OptQrExportFormatParam) Or(d QrExportFormatParam) QrExportFormatParam {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilUUID returns new OptNilUUID with value set to v.
-func NewOptNilUUID(v uuid.UUID) OptNilUUID {
-	return OptNilUUID{
+// NewOptQrExportPaperParam returns new OptQrExportPaperParam with value set to v.
+func NewOptQrExportPaperParam(v QrExportPaperParam) OptQrExportPaperParam {
+	return OptQrExportPaperParam{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptNilUUID is optional nullable uuid.UUID.
-type OptNilUUID struct {
-	Value uuid.UUID
+// OptQrExportPaperParam is optional QrExportPaperParam.
+type OptQrExportPaperParam struct {
+	Value QrExportPaperParam
 	Set   bool
-	Null  bool
 }
 
-// IsSet returns true if OptNilUUID was set.
-func (o OptNilUUID) IsSet() bool { return o.Set }
+// IsSet returns true if OptQrExportPaperParam was set.
+func (o OptQrExportPaperParam) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptNilUUID) Reset() {
-	var v uuid.UUID
+func (o *OptQrExportPaperParam) Reset() {
+	var v QrExportPaperParam
 	o.Value = v
 	o.Set = false
-	o.Null = false
 }
 
 // SetTo sets value to v.
-func (o *OptNilUUID) SetTo(v uuid.UUID) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
-}
-
-// IsNull returns true if value is Null.
-func (o OptNilUUID) IsNull() bool { return o.Null }
-
-// SetToNull sets value to null.
-func (o *OptNilUUID) SetToNull() {
+func (o *OptQrExportPaperParam) SetTo(v QrExportPaperParam) {
 	o.Set = true
-	o.Null = true
-	var v uuid.UUID
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilUUID) Get() (v uuid.UUID, ok bool) {
-	if o.Null {
-		return v, false
-	}
+func (o OptQrExportPaperParam) Get() (v QrExportPaperParam, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -4928,45 +6220,45 @@ func (o OptNilUUID) Get() (v uuid.UUID, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilUUID) Or(d uuid.UUID) uuid.UUID {
+func (o OptQrExportPaperParam) Or(d QrExportPaperParam) QrExportPaperParam {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptPlaceConstraints returns new OptPlaceConstraints with value set to v.
-func NewOptPlaceConstraints(v PlaceConstraints) OptPlaceConstraints {
-	return OptPlaceConstraints{
+// NewOptReservation returns new OptReservation with value set to v.
+func NewOptReservation(v Reservation) OptReservation {
+	return OptReservation{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptPlaceConstraints is optional PlaceConstraints.
-type OptPlaceConstraints struct {
-	Value PlaceConstraints
+// OptReservation is optional Reservation.
+type OptReservation struct {
+	Value Reservation
 	Set   bool
 }
 
-// IsSet returns true if OptPlaceConstraints was set.
-func (o OptPlaceConstraints) IsSet() bool { return o.Set }
+// IsSet returns true if OptReservation was set.
+func (o OptReservation) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptPlaceConstraints) Reset() {
-	var v PlaceConstraints
+func (o *OptReservation) Reset() {
+	var v Reservation
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptPlaceConstraints) SetTo(v PlaceConstraints) {
+func (o *OptReservation) SetTo(v Reservation) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptPlaceConstraints) Get() (v PlaceConstraints, ok bool) {
+func (o OptReservation) Get() (v Reservation, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -4974,7 +6266,7 @@ func (o OptPlaceConstraints) Get() (v PlaceConstraints, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptPlaceConstraints) Or(d PlaceConstraints) PlaceConstraints {
+func (o OptReservation) Or(d Reservation) Reservation {
 	if v, ok := o.Get(); ok {
 		return v
 	}
@@ -5027,6 +6319,52 @@ func (o OptRoomPlan) Or(d RoomPlan) RoomPlan {
 	return d
 }
 
+// NewOptSeriesScopeParam returns new OptSeriesScopeParam with value set to v.
+func NewOptSeriesScopeParam(v SeriesScopeParam) OptSeriesScopeParam {
+	return OptSeriesScopeParam{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSeriesScopeParam is optional SeriesScopeParam.
+type OptSeriesScopeParam struct {
+	Value SeriesScopeParam
+	Set   bool
+}
+
+// IsSet returns true if OptSeriesScopeParam was set.
+func (o OptSeriesScopeParam) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSeriesScopeParam) Reset() {
+	var v SeriesScopeParam
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSeriesScopeParam) SetTo(v SeriesScopeParam) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSeriesScopeParam) Get() (v SeriesScopeParam, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSeriesScopeParam) Or(d SeriesScopeParam) SeriesScopeParam {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptString returns new OptString with value set to v.
 func NewOptString(v string) OptString {
 	return OptString{
@@ -5515,8 +6853,9 @@ func (s *PaginationMeta) SetTotalPages(val int) {
 
 // Ref: #/components/schemas/Permission
 type Permission struct {
-	Name        string `json:"name"`
-	Description string `json:"description"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Scope       OptPermissionScope `json:"scope"`
 }
 
 // GetName returns the value of Name.
@@ -5529,6 +6868,11 @@ func (s *Permission) GetDescription() string {
 	return s.Description
 }
 
+// GetScope returns the value of Scope.
+func (s *Permission) GetScope() OptPermissionScope {
+	return s.Scope
+}
+
 // SetName sets the value of Name.
 func (s *Permission) SetName(val string) {
 	s.Name = val
@@ -5539,6 +6883,87 @@ func (s *Permission) SetDescription(val string) {
 	s.Description = val
 }
 
+// SetScope sets the value of Scope.
+func (s *Permission) SetScope(val OptPermissionScope) {
+	s.Scope = val
+}
+
+// Restricts a permission to a single building, area or place.
+// Grants cascade down the Building → Area → Place hierarchy.
+// Ref: #/components/schemas/PermissionScope
+type PermissionScope struct {
+	Type PermissionScopeType `json:"type"`
+	ID   uuid.UUID           `json:"id"`
+}
+
+// GetType returns the value of Type.
+func (s *PermissionScope) GetType() PermissionScopeType {
+	return s.Type
+}
+
+// GetID returns the value of ID.
+func (s *PermissionScope) GetID() uuid.UUID {
+	return s.ID
+}
+
+// SetType sets the value of Type.
+func (s *PermissionScope) SetType(val PermissionScopeType) {
+	s.Type = val
+}
+
+// SetID sets the value of ID.
+func (s *PermissionScope) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+type PermissionScopeType string
+
+const (
+	PermissionScopeTypeBuilding PermissionScopeType = "building"
+	PermissionScopeTypeArea     PermissionScopeType = "area"
+	PermissionScopeTypePlace    PermissionScopeType = "place"
+)
+
+// AllValues returns all PermissionScopeType values.
+func (PermissionScopeType) AllValues() []PermissionScopeType {
+	return []PermissionScopeType{
+		PermissionScopeTypeBuilding,
+		PermissionScopeTypeArea,
+		PermissionScopeTypePlace,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PermissionScopeType) MarshalText() ([]byte, error) {
+	switch s {
+	case PermissionScopeTypeBuilding:
+		return []byte(s), nil
+	case PermissionScopeTypeArea:
+		return []byte(s), nil
+	case PermissionScopeTypePlace:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PermissionScopeType) UnmarshalText(data []byte) error {
+	switch PermissionScopeType(data) {
+	case PermissionScopeTypeBuilding:
+		*s = PermissionScopeTypeBuilding
+		return nil
+	case PermissionScopeTypeArea:
+		*s = PermissionScopeTypeArea
+		return nil
+	case PermissionScopeTypePlace:
+		*s = PermissionScopeTypePlace
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // Ref: #/components/schemas/Place
 type Place struct {
 	ID              uuid.UUID           `json:"id"`
@@ -5933,25 +7358,49 @@ func (s *PlaceBookingMethod) UnmarshalText(data []byte) error {
 	}
 }
 
+// Booking limits of places, set on a place, an area or a building. Places
+// inherit unset fields from their area and building; limits unset on all
+// levels do not apply. Quotas count the user's reservations that have not
+// been cancelled by their start at the places the quota was set for: the
+// place, all places of the area or building, or those a constraint profile
+// applies to. They count per calendar day, week (starting on Monday), month
+// and year of the booking time zone, or with a rolling quotaWindow in every
+// day, 7 day, month and year long window that contains the start of the
+// reservation. maxConcurrentReservations counts reservations at all places.
+// Reservations exceeding a quota are rejected with `QUOTA_EXCEEDED`.
 // Ref: #/components/schemas/PlaceConstraints
 type PlaceConstraints struct {
-	// ISO 8601 duration.
+	// ISO 8601 duration of whole minutes, in days, hours and minutes (e.g. PT4H).
 	MaxReservationDuration OptNilString `json:"maxReservationDuration"`
-	// ISO 8601 duration.
-	MinReservationDuration    OptNilString `json:"minReservationDuration"`
-	MaxReservationsPerDay     OptNilInt    `json:"maxReservationsPerDay"`
-	MaxReservationsPerWeek    OptNilInt    `json:"maxReservationsPerWeek"`
-	MaxReservationsPerMonth   OptNilInt    `json:"maxReservationsPerMonth"`
-	MaxReservationsPerYear    OptNilInt    `json:"maxReservationsPerYear"`
-	MaxHoursPerDay            OptNilInt    `json:"maxHoursPerDay"`
-	MaxHoursPerWeek           OptNilInt    `json:"maxHoursPerWeek"`
-	MaxHoursPerMonth          OptNilInt    `json:"maxHoursPerMonth"`
-	MaxHoursPerYear           OptNilInt    `json:"maxHoursPerYear"`
-	MaxConcurrentReservations OptNilInt    `json:"maxConcurrentReservations"`
-	MaxAdvanceBookingDays     OptNilInt    `json:"maxAdvanceBookingDays"`
-	CheckInTimeoutMinutes     OptNilInt    `json:"checkInTimeoutMinutes"`
-	DecayTimeoutMinutes       OptNilInt    `json:"decayTimeoutMinutes"`
-	WhitelistEnabled          OptBool      `json:"whitelistEnabled"`
+	// ISO 8601 duration of whole minutes, in days, hours and minutes (e.g. PT30M).
+	MinReservationDuration  OptNilString `json:"minReservationDuration"`
+	MaxReservationsPerDay   OptNilInt    `json:"maxReservationsPerDay"`
+	MaxReservationsPerWeek  OptNilInt    `json:"maxReservationsPerWeek"`
+	MaxReservationsPerMonth OptNilInt    `json:"maxReservationsPerMonth"`
+	MaxReservationsPerYear  OptNilInt    `json:"maxReservationsPerYear"`
+	MaxHoursPerDay          OptNilInt    `json:"maxHoursPerDay"`
+	MaxHoursPerWeek         OptNilInt    `json:"maxHoursPerWeek"`
+	MaxHoursPerMonth        OptNilInt    `json:"maxHoursPerMonth"`
+	MaxHoursPerYear         OptNilInt    `json:"maxHoursPerYear"`
+	// Maximum number of reservations of a user at any place that overlap each other.
+	MaxConcurrentReservations OptNilInt `json:"maxConcurrentReservations"`
+	// Number of calendar days after today on which reservations may
+	// start at the latest; 0 allows booking for today only.
+	MaxAdvanceBookingDays OptNilInt `json:"maxAdvanceBookingDays"`
+	// If the place requires a check-in, reservations that have not been
+	// checked in to within this many minutes after their start are
+	// cancelled.
+	CheckInTimeoutMinutes OptNilInt `json:"checkInTimeoutMinutes"`
+	// Reservations that have not been checked in to within this many
+	// minutes after their start are cancelled, whether or not the place
+	// requires a check-in.
+	DecayTimeoutMinutes OptNilInt `json:"decayTimeoutMinutes"`
+	// Only users on the whitelist of the place may book it.
+	WhitelistEnabled OptNilBool `json:"whitelistEnabled"`
+	// Whether quotas count calendar days, weeks, months and years or
+	// rolling windows of the same length; unset on all levels means
+	// calendar.
+	QuotaWindow OptNilPlaceConstraintsQuotaWindow `json:"quotaWindow"`
 }
 
 // GetMaxReservationDuration returns the value of MaxReservationDuration.
@@ -6025,10 +7474,15 @@ func (s *PlaceConstraints) GetDecayTimeoutMinutes() OptNilInt {
 }
 
 // GetWhitelistEnabled returns the value of WhitelistEnabled.
-func (s *PlaceConstraints) GetWhitelistEnabled() OptBool {
+func (s *PlaceConstraints) GetWhitelistEnabled() OptNilBool {
 	return s.WhitelistEnabled
 }
 
+// GetQuotaWindow returns the value of QuotaWindow.
+func (s *PlaceConstraints) GetQuotaWindow() OptNilPlaceConstraintsQuotaWindow {
+	return s.QuotaWindow
+}
+
 // SetMaxReservationDuration sets the value of MaxReservationDuration.
 func (s *PlaceConstraints) SetMaxReservationDuration(val OptNilString) {
 	s.MaxReservationDuration = val
@@ -6100,12 +7554,65 @@ func (s *PlaceConstraints) SetDecayTimeoutMinutes(val OptNilInt) {
 }
 
 // SetWhitelistEnabled sets the value of WhitelistEnabled.
-func (s *PlaceConstraints) SetWhitelistEnabled(val OptBool) {
+func (s *PlaceConstraints) SetWhitelistEnabled(val OptNilBool) {
 	s.WhitelistEnabled = val
 }
 
-func (*PlaceConstraints) getPlaceConstraintsRes()    {}
-func (*PlaceConstraints) updatePlaceConstraintsRes() {}
+// SetQuotaWindow sets the value of QuotaWindow.
+func (s *PlaceConstraints) SetQuotaWindow(val OptNilPlaceConstraintsQuotaWindow) {
+	s.QuotaWindow = val
+}
+
+func (*PlaceConstraints) getAreaConstraintsRes()        {}
+func (*PlaceConstraints) getBuildingConstraintsRes()    {}
+func (*PlaceConstraints) getPlaceConstraintsRes()       {}
+func (*PlaceConstraints) updateAreaConstraintsRes()     {}
+func (*PlaceConstraints) updateBuildingConstraintsRes() {}
+func (*PlaceConstraints) updatePlaceConstraintsRes()    {}
+
+// Whether quotas count calendar days, weeks, months and years or
+// rolling windows of the same length; unset on all levels means
+// calendar.
+type PlaceConstraintsQuotaWindow string
+
+const (
+	PlaceConstraintsQuotaWindowCalendar PlaceConstraintsQuotaWindow = "calendar"
+	PlaceConstraintsQuotaWindowRolling  PlaceConstraintsQuotaWindow = "rolling"
+)
+
+// AllValues returns all PlaceConstraintsQuotaWindow values.
+func (PlaceConstraintsQuotaWindow) AllValues() []PlaceConstraintsQuotaWindow {
+	return []PlaceConstraintsQuotaWindow{
+		PlaceConstraintsQuotaWindowCalendar,
+		PlaceConstraintsQuotaWindowRolling,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PlaceConstraintsQuotaWindow) MarshalText() ([]byte, error) {
+	switch s {
+	case PlaceConstraintsQuotaWindowCalendar:
+		return []byte(s), nil
+	case PlaceConstraintsQuotaWindowRolling:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PlaceConstraintsQuotaWindow) UnmarshalText(data []byte) error {
+	switch PlaceConstraintsQuotaWindow(data) {
+	case PlaceConstraintsQuotaWindowCalendar:
+		*s = PlaceConstraintsQuotaWindowCalendar
+		return nil
+	case PlaceConstraintsQuotaWindowRolling:
+		*s = PlaceConstraintsQuotaWindowRolling
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
 
 // Ref: #/components/schemas/PlaceMarker
 type PlaceMarker struct {
@@ -6231,10 +7738,82 @@ func (s *PlaceMarkerShape) UnmarshalText(data []byte) error {
 	}
 }
 
+// Ref: #/components/schemas/PlaceQrKey
+type PlaceQrKey struct {
+	// Version of the key QR codes of the place are signed for.
+	KeyVersion int `json:"keyVersion"`
+	// URL encoded in the QR code of the place.
+	CheckInUrl url.URL `json:"checkInUrl"`
+}
+
+// GetKeyVersion returns the value of KeyVersion.
+func (s *PlaceQrKey) GetKeyVersion() int {
+	return s.KeyVersion
+}
+
+// GetCheckInUrl returns the value of CheckInUrl.
+func (s *PlaceQrKey) GetCheckInUrl() url.URL {
+	return s.CheckInUrl
+}
+
+// SetKeyVersion sets the value of KeyVersion.
+func (s *PlaceQrKey) SetKeyVersion(val int) {
+	s.KeyVersion = val
+}
+
+// SetCheckInUrl sets the value of CheckInUrl.
+func (s *PlaceQrKey) SetCheckInUrl(val url.URL) {
+	s.CheckInUrl = val
+}
+
+func (*PlaceQrKey) getPlaceQrKeyRes()    {}
+func (*PlaceQrKey) rotatePlaceQrKeyRes() {}
+
 type PreviewQrTemplateForbidden ErrorResponse
 
 func (*PreviewQrTemplateForbidden) previewQrTemplateRes() {}
 
+type PreviewQrTemplateFormat string
+
+const (
+	PreviewQrTemplateFormatHTML PreviewQrTemplateFormat = "html"
+	PreviewQrTemplateFormatPNG  PreviewQrTemplateFormat = "png"
+)
+
+// AllValues returns all PreviewQrTemplateFormat values.
+func (PreviewQrTemplateFormat) AllValues() []PreviewQrTemplateFormat {
+	return []PreviewQrTemplateFormat{
+		PreviewQrTemplateFormatHTML,
+		PreviewQrTemplateFormatPNG,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PreviewQrTemplateFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case PreviewQrTemplateFormatHTML:
+		return []byte(s), nil
+	case PreviewQrTemplateFormatPNG:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PreviewQrTemplateFormat) UnmarshalText(data []byte) error {
+	switch PreviewQrTemplateFormat(data) {
+	case PreviewQrTemplateFormatHTML:
+		*s = PreviewQrTemplateFormatHTML
+		return nil
+	case PreviewQrTemplateFormatPNG:
+		*s = PreviewQrTemplateFormatPNG
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 type PreviewQrTemplateNotFound ErrorResponse
 
 func (*PreviewQrTemplateNotFound) previewQrTemplateRes() {}
@@ -6275,7 +7854,13 @@ func (*PreviewQrTemplateOKTextHTML) previewQrTemplateRes() {}
 type QRTemplate struct {
 	ID   uuid.UUID `json:"id"`
 	Name string    `json:"name"`
-	// HTML template with placeholders.
+	// Go html/template source rendered with .Place, .Area and .Building (each with Name,
+	// Description and Location), .QRCode (data URI of the QR symbol, for img src) and
+	// .CheckInURL. range, printf, call and nested templates are not available. The
+	// rendered HTML must not load external resources: scripts, frames, objects and link
+	// elements are rejected, images have to be data URIs, CSS must not use url(), @import
+	// or other functions and at-rules that can load resources, and links may only point to
+	// http, https and mailto URLs.
 	HtmlTemplate string    `json:"htmlTemplate"`
 	CreatedAt    time.Time `json:"createdAt"`
 	UpdatedAt    time.Time `json:"updatedAt"`
@@ -6296,44 +7881,160 @@ func (s *QRTemplate) GetHtmlTemplate() string {
 	return s.HtmlTemplate
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *QRTemplate) GetCreatedAt() time.Time {
-	return s.CreatedAt
-}
+// GetCreatedAt returns the value of CreatedAt.
+func (s *QRTemplate) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *QRTemplate) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *QRTemplate) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *QRTemplate) SetName(val string) {
+	s.Name = val
+}
+
+// SetHtmlTemplate sets the value of HtmlTemplate.
+func (s *QRTemplate) SetHtmlTemplate(val string) {
+	s.HtmlTemplate = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *QRTemplate) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *QRTemplate) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+func (*QRTemplate) createQrTemplateRes() {}
+func (*QRTemplate) getQrTemplateRes()    {}
+func (*QRTemplate) updateQrTemplateRes() {}
+
+type QrCodeExportApplicationPdf struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s QrCodeExportApplicationPdf) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*QrCodeExportApplicationPdf) exportAreaQrCodesRes()     {}
+func (*QrCodeExportApplicationPdf) exportBuildingQrCodesRes() {}
+
+type QrCodeExportApplicationZip struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s QrCodeExportApplicationZip) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*QrCodeExportApplicationZip) exportAreaQrCodesRes()     {}
+func (*QrCodeExportApplicationZip) exportBuildingQrCodesRes() {}
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *QRTemplate) GetUpdatedAt() time.Time {
-	return s.UpdatedAt
-}
+type QrExportFormatParam string
 
-// SetID sets the value of ID.
-func (s *QRTemplate) SetID(val uuid.UUID) {
-	s.ID = val
+const (
+	QrExportFormatParamPdf QrExportFormatParam = "pdf"
+	QrExportFormatParamZip QrExportFormatParam = "zip"
+)
+
+// AllValues returns all QrExportFormatParam values.
+func (QrExportFormatParam) AllValues() []QrExportFormatParam {
+	return []QrExportFormatParam{
+		QrExportFormatParamPdf,
+		QrExportFormatParamZip,
+	}
 }
 
-// SetName sets the value of Name.
-func (s *QRTemplate) SetName(val string) {
-	s.Name = val
+// MarshalText implements encoding.TextMarshaler.
+func (s QrExportFormatParam) MarshalText() ([]byte, error) {
+	switch s {
+	case QrExportFormatParamPdf:
+		return []byte(s), nil
+	case QrExportFormatParamZip:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetHtmlTemplate sets the value of HtmlTemplate.
-func (s *QRTemplate) SetHtmlTemplate(val string) {
-	s.HtmlTemplate = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *QrExportFormatParam) UnmarshalText(data []byte) error {
+	switch QrExportFormatParam(data) {
+	case QrExportFormatParamPdf:
+		*s = QrExportFormatParamPdf
+		return nil
+	case QrExportFormatParamZip:
+		*s = QrExportFormatParamZip
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *QRTemplate) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
+type QrExportPaperParam string
+
+const (
+	QrExportPaperParamA4     QrExportPaperParam = "a4"
+	QrExportPaperParamLetter QrExportPaperParam = "letter"
+)
+
+// AllValues returns all QrExportPaperParam values.
+func (QrExportPaperParam) AllValues() []QrExportPaperParam {
+	return []QrExportPaperParam{
+		QrExportPaperParamA4,
+		QrExportPaperParamLetter,
+	}
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *QRTemplate) SetUpdatedAt(val time.Time) {
-	s.UpdatedAt = val
+// MarshalText implements encoding.TextMarshaler.
+func (s QrExportPaperParam) MarshalText() ([]byte, error) {
+	switch s {
+	case QrExportPaperParamA4:
+		return []byte(s), nil
+	case QrExportPaperParamLetter:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-func (*QRTemplate) createQrTemplateRes() {}
-func (*QRTemplate) getQrTemplateRes()    {}
-func (*QRTemplate) updateQrTemplateRes() {}
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *QrExportPaperParam) UnmarshalText(data []byte) error {
+	switch QrExportPaperParam(data) {
+	case QrExportPaperParamA4:
+		*s = QrExportPaperParamA4
+		return nil
+	case QrExportPaperParamLetter:
+		*s = QrExportPaperParamLetter
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
 
 type RefreshTokenReq struct {
 	RefreshToken string `json:"refreshToken"`
@@ -6463,7 +8164,8 @@ type RemoveGroupPermissionsNotFound ErrorResponse
 func (*RemoveGroupPermissionsNotFound) removeGroupPermissionsRes() {}
 
 type RemoveGroupPermissionsReq struct {
-	Permissions []string `json:"permissions"`
+	Permissions []string           `json:"permissions"`
+	Scope       OptPermissionScope `json:"scope"`
 }
 
 // GetPermissions returns the value of Permissions.
@@ -6471,11 +8173,21 @@ func (s *RemoveGroupPermissionsReq) GetPermissions() []string {
 	return s.Permissions
 }
 
+// GetScope returns the value of Scope.
+func (s *RemoveGroupPermissionsReq) GetScope() OptPermissionScope {
+	return s.Scope
+}
+
 // SetPermissions sets the value of Permissions.
 func (s *RemoveGroupPermissionsReq) SetPermissions(val []string) {
 	s.Permissions = val
 }
 
+// SetScope sets the value of Scope.
+func (s *RemoveGroupPermissionsReq) SetScope(val OptPermissionScope) {
+	s.Scope = val
+}
+
 type RemovePlaceBlockingEntriesForbidden ErrorResponse
 
 func (*RemovePlaceBlockingEntriesForbidden) removePlaceBlockingEntriesRes() {}
@@ -6814,6 +8526,132 @@ func (*Reservation) checkInReservationRes() {}
 func (*Reservation) getReservationRes()     {}
 func (*Reservation) updateReservationRes()  {}
 
+// Ref: #/components/schemas/ReservationOccurrence
+type ReservationOccurrence struct {
+	StartTime   time.Time                   `json:"startTime"`
+	EndTime     time.Time                   `json:"endTime"`
+	Status      ReservationOccurrenceStatus `json:"status"`
+	Reservation OptReservation              `json:"reservation"`
+	Reason      OptOccurrenceRejection      `json:"reason"`
+}
+
+// GetStartTime returns the value of StartTime.
+func (s *ReservationOccurrence) GetStartTime() time.Time {
+	return s.StartTime
+}
+
+// GetEndTime returns the value of EndTime.
+func (s *ReservationOccurrence) GetEndTime() time.Time {
+	return s.EndTime
+}
+
+// GetStatus returns the value of Status.
+func (s *ReservationOccurrence) GetStatus() ReservationOccurrenceStatus {
+	return s.Status
+}
+
+// GetReservation returns the value of Reservation.
+func (s *ReservationOccurrence) GetReservation() OptReservation {
+	return s.Reservation
+}
+
+// GetReason returns the value of Reason.
+func (s *ReservationOccurrence) GetReason() OptOccurrenceRejection {
+	return s.Reason
+}
+
+// SetStartTime sets the value of StartTime.
+func (s *ReservationOccurrence) SetStartTime(val time.Time) {
+	s.StartTime = val
+}
+
+// SetEndTime sets the value of EndTime.
+func (s *ReservationOccurrence) SetEndTime(val time.Time) {
+	s.EndTime = val
+}
+
+// SetStatus sets the value of Status.
+func (s *ReservationOccurrence) SetStatus(val ReservationOccurrenceStatus) {
+	s.Status = val
+}
+
+// SetReservation sets the value of Reservation.
+func (s *ReservationOccurrence) SetReservation(val OptReservation) {
+	s.Reservation = val
+}
+
+// SetReason sets the value of Reason.
+func (s *ReservationOccurrence) SetReason(val OptOccurrenceRejection) {
+	s.Reason = val
+}
+
+type ReservationOccurrenceStatus string
+
+const (
+	ReservationOccurrenceStatusCreated ReservationOccurrenceStatus = "created"
+	ReservationOccurrenceStatusSkipped ReservationOccurrenceStatus = "skipped"
+)
+
+// AllValues returns all ReservationOccurrenceStatus values.
+func (ReservationOccurrenceStatus) AllValues() []ReservationOccurrenceStatus {
+	return []ReservationOccurrenceStatus{
+		ReservationOccurrenceStatusCreated,
+		ReservationOccurrenceStatusSkipped,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ReservationOccurrenceStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case ReservationOccurrenceStatusCreated:
+		return []byte(s), nil
+	case ReservationOccurrenceStatusSkipped:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ReservationOccurrenceStatus) UnmarshalText(data []byte) error {
+	switch ReservationOccurrenceStatus(data) {
+	case ReservationOccurrenceStatusCreated:
+		*s = ReservationOccurrenceStatusCreated
+		return nil
+	case ReservationOccurrenceStatusSkipped:
+		*s = ReservationOccurrenceStatusSkipped
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/ReservationSeries
+type ReservationSeries struct {
+	RecurringGroupId uuid.UUID               `json:"recurringGroupId"`
+	Occurrences      []ReservationOccurrence `json:"occurrences"`
+}
+
+// GetRecurringGroupId returns the value of RecurringGroupId.
+func (s *ReservationSeries) GetRecurringGroupId() uuid.UUID {
+	return s.RecurringGroupId
+}
+
+// GetOccurrences returns the value of Occurrences.
+func (s *ReservationSeries) GetOccurrences() []ReservationOccurrence {
+	return s.Occurrences
+}
+
+// SetRecurringGroupId sets the value of RecurringGroupId.
+func (s *ReservationSeries) SetRecurringGroupId(val uuid.UUID) {
+	s.RecurringGroupId = val
+}
+
+// SetOccurrences sets the value of Occurrences.
+func (s *ReservationSeries) SetOccurrences(val []ReservationOccurrence) {
+	s.Occurrences = val
+}
+
 type ReservationStatus string
 
 const (
@@ -6896,6 +8734,11 @@ type RevokeApiKeyNotFound ErrorResponse
 
 func (*RevokeApiKeyNotFound) revokeApiKeyRes() {}
 
+// RevokeCurrentUserCalendarTokenNoContent is response for RevokeCurrentUserCalendarToken operation.
+type RevokeCurrentUserCalendarTokenNoContent struct{}
+
+func (*RevokeCurrentUserCalendarTokenNoContent) revokeCurrentUserCalendarTokenRes() {}
+
 // Ref: #/components/schemas/RoomPlan
 type RoomPlan struct {
 	ImageUrl url.URL       `json:"imageUrl"`
@@ -6925,6 +8768,62 @@ func (s *RoomPlan) SetMarkers(val []PlaceMarker) {
 func (*RoomPlan) getAreaRoomPlanRes()    {}
 func (*RoomPlan) updateAreaRoomPlanRes() {}
 
+type RotatePlaceQrKeyForbidden ErrorResponse
+
+func (*RotatePlaceQrKeyForbidden) rotatePlaceQrKeyRes() {}
+
+type RotatePlaceQrKeyNotFound ErrorResponse
+
+func (*RotatePlaceQrKeyNotFound) rotatePlaceQrKeyRes() {}
+
+type SeriesScopeParam string
+
+const (
+	SeriesScopeParamOccurrence SeriesScopeParam = "occurrence"
+	SeriesScopeParamFollowing  SeriesScopeParam = "following"
+	SeriesScopeParamSeries     SeriesScopeParam = "series"
+)
+
+// AllValues returns all SeriesScopeParam values.
+func (SeriesScopeParam) AllValues() []SeriesScopeParam {
+	return []SeriesScopeParam{
+		SeriesScopeParamOccurrence,
+		SeriesScopeParamFollowing,
+		SeriesScopeParamSeries,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SeriesScopeParam) MarshalText() ([]byte, error) {
+	switch s {
+	case SeriesScopeParamOccurrence:
+		return []byte(s), nil
+	case SeriesScopeParamFollowing:
+		return []byte(s), nil
+	case SeriesScopeParamSeries:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SeriesScopeParam) UnmarshalText(data []byte) error {
+	switch SeriesScopeParam(data) {
+	case SeriesScopeParamOccurrence:
+		*s = SeriesScopeParamOccurrence
+		return nil
+	case SeriesScopeParamFollowing:
+		*s = SeriesScopeParamFollowing
+		return nil
+	case SeriesScopeParamSeries:
+		*s = SeriesScopeParamSeries
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 type SetDefaultGroupAssignmentBadRequest ErrorResponse
 
 func (*SetDefaultGroupAssignmentBadRequest) setDefaultGroupAssignmentRes() {}
@@ -7182,6 +9081,18 @@ type UpdateAreaBadRequest ErrorResponse
 
 func (*UpdateAreaBadRequest) updateAreaRes() {}
 
+type UpdateAreaConstraintsBadRequest ErrorResponse
+
+func (*UpdateAreaConstraintsBadRequest) updateAreaConstraintsRes() {}
+
+type UpdateAreaConstraintsForbidden ErrorResponse
+
+func (*UpdateAreaConstraintsForbidden) updateAreaConstraintsRes() {}
+
+type UpdateAreaConstraintsNotFound ErrorResponse
+
+func (*UpdateAreaConstraintsNotFound) updateAreaConstraintsRes() {}
+
 type UpdateAreaForbidden ErrorResponse
 
 func (*UpdateAreaForbidden) updateAreaRes() {}
@@ -7270,6 +9181,18 @@ type UpdateBuildingBadRequest ErrorResponse
 
 func (*UpdateBuildingBadRequest) updateBuildingRes() {}
 
+type UpdateBuildingConstraintsBadRequest ErrorResponse
+
+func (*UpdateBuildingConstraintsBadRequest) updateBuildingConstraintsRes() {}
+
+type UpdateBuildingConstraintsForbidden ErrorResponse
+
+func (*UpdateBuildingConstraintsForbidden) updateBuildingConstraintsRes() {}
+
+type UpdateBuildingConstraintsNotFound ErrorResponse
+
+func (*UpdateBuildingConstraintsNotFound) updateBuildingConstraintsRes() {}
+
 type UpdateBuildingForbidden ErrorResponse
 
 func (*UpdateBuildingForbidden) updateBuildingRes() {}
@@ -7376,6 +9299,18 @@ type UpdateGroupBadRequest ErrorResponse
 
 func (*UpdateGroupBadRequest) updateGroupRes() {}
 
+type UpdateGroupConstraintProfileBadRequest ErrorResponse
+
+func (*UpdateGroupConstraintProfileBadRequest) updateGroupConstraintProfileRes() {}
+
+type UpdateGroupConstraintProfileForbidden ErrorResponse
+
+func (*UpdateGroupConstraintProfileForbidden) updateGroupConstraintProfileRes() {}
+
+type UpdateGroupConstraintProfileNotFound ErrorResponse
+
+func (*UpdateGroupConstraintProfileNotFound) updateGroupConstraintProfileRes() {}
+
 type UpdateGroupForbidden ErrorResponse
 
 func (*UpdateGroupForbidden) updateGroupRes() {}
@@ -7581,7 +9516,8 @@ func (*UpdatePlaceTimeSlotsNotFound) updatePlaceTimeSlotsRes() {}
 
 // Ref: #/components/schemas/UpdateQRTemplateRequest
 type UpdateQRTemplateRequest struct {
-	Name         OptString `json:"name"`
+	Name OptString `json:"name"`
+	// See QRTemplate.htmlTemplate. Templates are validated by rendering them with sample data.
 	HtmlTemplate OptString `json:"htmlTemplate"`
 }
 
@@ -7637,6 +9573,12 @@ func (*UpdateReservationNotFound) updateReservationRes() {}
 type UpdateReservationRequest struct {
 	StartTime OptDateTime `json:"startTime"`
 	EndTime   OptDateTime `json:"endTime"`
+	// Ignores the user's quotas. Requires manage:reservations for the place
+	// and is recorded in the audit log.
+	BypassQuotas OptBool `json:"bypassQuotas"`
+	// Moves the reservation despite blockings of the place. Requires
+	// manage:reservations for the place and is recorded in the audit log.
+	BypassBlockings OptBool `json:"bypassBlockings"`
 }
 
 // GetStartTime returns the value of StartTime.
@@ -7649,6 +9591,16 @@ func (s *UpdateReservationRequest) GetEndTime() OptDateTime {
 	return s.EndTime
 }
 
+// GetBypassQuotas returns the value of BypassQuotas.
+func (s *UpdateReservationRequest) GetBypassQuotas() OptBool {
+	return s.BypassQuotas
+}
+
+// GetBypassBlockings returns the value of BypassBlockings.
+func (s *UpdateReservationRequest) GetBypassBlockings() OptBool {
+	return s.BypassBlockings
+}
+
 // SetStartTime sets the value of StartTime.
 func (s *UpdateReservationRequest) SetStartTime(val OptDateTime) {
 	s.StartTime = val
@@ -7659,6 +9611,16 @@ func (s *UpdateReservationRequest) SetEndTime(val OptDateTime) {
 	s.EndTime = val
 }
 
+// SetBypassQuotas sets the value of BypassQuotas.
+func (s *UpdateReservationRequest) SetBypassQuotas(val OptBool) {
+	s.BypassQuotas = val
+}
+
+// SetBypassBlockings sets the value of BypassBlockings.
+func (s *UpdateReservationRequest) SetBypassBlockings(val OptBool) {
+	s.BypassBlockings = val
+}
+
 type UpdateUserBadRequest ErrorResponse
 
 func (*UpdateUserBadRequest) updateUserRes() {}
@@ -7789,8 +9751,10 @@ type User struct {
 	OauthId        OptString `json:"oauthId"`
 	IsActive       bool      `json:"isActive"`
 	Groups         []Group   `json:"groups"`
-	CreatedAt      time.Time `json:"createdAt"`
-	UpdatedAt      time.Time `json:"updatedAt"`
+	// Effective permissions of the user, including scoped grants. Only set on /auth/me.
+	Permissions []Permission `json:"permissions"`
+	CreatedAt   time.Time    `json:"createdAt"`
+	UpdatedAt   time.Time    `json:"updatedAt"`
 }
 
 // GetID returns the value of ID.
@@ -7838,6 +9802,11 @@ func (s *User) GetGroups() []Group {
 	return s.Groups
 }
 
+// GetPermissions returns the value of Permissions.
+func (s *User) GetPermissions() []Permission {
+	return s.Permissions
+}
+
 // GetCreatedAt returns the value of CreatedAt.
 func (s *User) GetCreatedAt() time.Time {
 	return s.CreatedAt
@@ -7893,6 +9862,11 @@ func (s *User) SetGroups(val []Group) {
 	s.Groups = val
 }
 
+// SetPermissions sets the value of Permissions.
+func (s *User) SetPermissions(val []Permission) {
+	s.Permissions = val
+}
+
 // SetCreatedAt sets the value of CreatedAt.
 func (s *User) SetCreatedAt(val time.Time) {
 	s.CreatedAt = val
@@ -7945,3 +9919,106 @@ func (s *UserReference) SetEmail(val string) {
 func (s *UserReference) SetName(val string) {
 	s.Name = val
 }
+
+// Ref: #/components/schemas/WeekdayOpeningHours
+type WeekdayOpeningHours struct {
+	Weekday WeekdayOpeningHoursWeekday `json:"weekday"`
+	// Open ranges of the day; empty if the building is closed.
+	Hours []OpeningHoursRange `json:"hours"`
+}
+
+// GetWeekday returns the value of Weekday.
+func (s *WeekdayOpeningHours) GetWeekday() WeekdayOpeningHoursWeekday {
+	return s.Weekday
+}
+
+// GetHours returns the value of Hours.
+func (s *WeekdayOpeningHours) GetHours() []OpeningHoursRange {
+	return s.Hours
+}
+
+// SetWeekday sets the value of Weekday.
+func (s *WeekdayOpeningHours) SetWeekday(val WeekdayOpeningHoursWeekday) {
+	s.Weekday = val
+}
+
+// SetHours sets the value of Hours.
+func (s *WeekdayOpeningHours) SetHours(val []OpeningHoursRange) {
+	s.Hours = val
+}
+
+type WeekdayOpeningHoursWeekday string
+
+const (
+	WeekdayOpeningHoursWeekdayMonday    WeekdayOpeningHoursWeekday = "monday"
+	WeekdayOpeningHoursWeekdayTuesday   WeekdayOpeningHoursWeekday = "tuesday"
+	WeekdayOpeningHoursWeekdayWednesday WeekdayOpeningHoursWeekday = "wednesday"
+	WeekdayOpeningHoursWeekdayThursday  WeekdayOpeningHoursWeekday = "thursday"
+	WeekdayOpeningHoursWeekdayFriday    WeekdayOpeningHoursWeekday = "friday"
+	WeekdayOpeningHoursWeekdaySaturday  WeekdayOpeningHoursWeekday = "saturday"
+	WeekdayOpeningHoursWeekdaySunday    WeekdayOpeningHoursWeekday = "sunday"
+)
+
+// AllValues returns all WeekdayOpeningHoursWeekday values.
+func (WeekdayOpeningHoursWeekday) AllValues() []WeekdayOpeningHoursWeekday {
+	return []WeekdayOpeningHoursWeekday{
+		WeekdayOpeningHoursWeekdayMonday,
+		WeekdayOpeningHoursWeekdayTuesday,
+		WeekdayOpeningHoursWeekdayWednesday,
+		WeekdayOpeningHoursWeekdayThursday,
+		WeekdayOpeningHoursWeekdayFriday,
+		WeekdayOpeningHoursWeekdaySaturday,
+		WeekdayOpeningHoursWeekdaySunday,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s WeekdayOpeningHoursWeekday) MarshalText() ([]byte, error) {
+	switch s {
+	case WeekdayOpeningHoursWeekdayMonday:
+		return []byte(s), nil
+	case WeekdayOpeningHoursWeekdayTuesday:
+		return []byte(s), nil
+	case WeekdayOpeningHoursWeekdayWednesday:
+		return []byte(s), nil
+	case WeekdayOpeningHoursWeekdayThursday:
+		return []byte(s), nil
+	case WeekdayOpeningHoursWeekdayFriday:
+		return []byte(s), nil
+	case WeekdayOpeningHoursWeekdaySaturday:
+		return []byte(s), nil
+	case WeekdayOpeningHoursWeekdaySunday:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *WeekdayOpeningHoursWeekday) UnmarshalText(data []byte) error {
+	switch WeekdayOpeningHoursWeekday(data) {
+	case WeekdayOpeningHoursWeekdayMonday:
+		*s = WeekdayOpeningHoursWeekdayMonday
+		return nil
+	case WeekdayOpeningHoursWeekdayTuesday:
+		*s = WeekdayOpeningHoursWeekdayTuesday
+		return nil
+	case WeekdayOpeningHoursWeekdayWednesday:
+		*s = WeekdayOpeningHoursWeekdayWednesday
+		return nil
+	case WeekdayOpeningHoursWeekdayThursday:
+		*s = WeekdayOpeningHoursWeekdayThursday
+		return nil
+	case WeekdayOpeningHoursWeekdayFriday:
+		*s = WeekdayOpeningHoursWeekdayFriday
+		return nil
+	case WeekdayOpeningHoursWeekdaySaturday:
+		*s = WeekdayOpeningHoursWeekdaySaturday
+		return nil
+	case WeekdayOpeningHoursWeekdaySunday:
+		*s = WeekdayOpeningHoursWeekdaySunday
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
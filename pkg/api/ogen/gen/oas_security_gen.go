@@ -53,6 +53,7 @@ var operationRolesApiKeyAuth = map[string][]string{
 	CreateBuildingOperation:                 []string{},
 	CreateEquipmentOperation:                []string{},
 	CreateGroupOperation:                    []string{},
+	CreateGroupConstraintProfileOperation:   []string{},
 	CreatePlaceOperation:                    []string{},
 	CreateQrTemplateOperation:               []string{},
 	CreateReservationOperation:              []string{},
@@ -61,14 +62,18 @@ var operationRolesApiKeyAuth = map[string][]string{
 	DeleteBuildingOperation:                 []string{},
 	DeleteEquipmentOperation:                []string{},
 	DeleteGroupOperation:                    []string{},
+	DeleteGroupConstraintProfileOperation:   []string{},
 	DeletePlaceOperation:                    []string{},
 	DeleteQrTemplateOperation:               []string{},
 	DisableUserOperation:                    []string{},
 	EnableUserOperation:                     []string{},
+	ExportAreaQrCodesOperation:              []string{},
+	ExportBuildingQrCodesOperation:          []string{},
 	ExportReservationsOperation:             []string{},
 	GetAuditLogOperation:                    []string{},
 	GetCurrentOccupancyOperation:            []string{},
 	GetCurrentUserOperation:                 []string{},
+	GetCurrentUserCalendarTokenOperation:    []string{},
 	GetCurrentUserFavoritesOperation:        []string{},
 	GetCurrentUserNotificationsOperation:    []string{},
 	GetDefaultGroupAssignmentOperation:      []string{},
@@ -76,6 +81,7 @@ var operationRolesApiKeyAuth = map[string][]string{
 	GetGroupMembersOperation:                []string{},
 	GetGroupPermissionsOperation:            []string{},
 	GetPlaceQrCodeOperation:                 []string{},
+	GetPlaceQrKeyOperation:                  []string{},
 	GetPlaceWhitelistOperation:              []string{},
 	GetQrTemplateOperation:                  []string{},
 	GetReservationOperation:                 []string{},
@@ -85,6 +91,7 @@ var operationRolesApiKeyAuth = map[string][]string{
 	GetUserOperation:                        []string{},
 	GetUserGroupsOperation:                  []string{},
 	ListApiKeysOperation:                    []string{},
+	ListGroupConstraintProfilesOperation:    []string{},
 	ListGroupsOperation:                     []string{},
 	ListPermissionsOperation:                []string{},
 	ListQrTemplatesOperation:                []string{},
@@ -105,13 +112,19 @@ var operationRolesApiKeyAuth = map[string][]string{
 	ReplaceBuildingBlockingOperation:        []string{},
 	ReplacePlaceBlockingOperation:           []string{},
 	RevokeApiKeyOperation:                   []string{},
+	RevokeCurrentUserCalendarTokenOperation: []string{},
+	RotateCurrentUserCalendarTokenOperation: []string{},
+	RotatePlaceQrKeyOperation:               []string{},
 	SetDefaultGroupAssignmentOperation:      []string{},
 	UpdateAreaOperation:                     []string{},
+	UpdateAreaConstraintsOperation:          []string{},
 	UpdateAreaRoomPlanOperation:             []string{},
 	UpdateBuildingOperation:                 []string{},
+	UpdateBuildingConstraintsOperation:      []string{},
 	UpdateCurrentUserNotificationsOperation: []string{},
 	UpdateEquipmentOperation:                []string{},
 	UpdateGroupOperation:                    []string{},
+	UpdateGroupConstraintProfileOperation:   []string{},
 	UpdatePlaceOperation:                    []string{},
 	UpdatePlaceConstraintsOperation:         []string{},
 	UpdatePlaceTimeSlotsOperation:           []string{},
@@ -155,6 +168,7 @@ var operationRolesBearerAuth = map[string][]string{
 	CreateBuildingOperation:                 []string{},
 	CreateEquipmentOperation:                []string{},
 	CreateGroupOperation:                    []string{},
+	CreateGroupConstraintProfileOperation:   []string{},
 	CreatePlaceOperation:                    []string{},
 	CreateQrTemplateOperation:               []string{},
 	CreateReservationOperation:              []string{},
@@ -163,14 +177,18 @@ var operationRolesBearerAuth = map[string][]string{
 	DeleteBuildingOperation:                 []string{},
 	DeleteEquipmentOperation:                []string{},
 	DeleteGroupOperation:                    []string{},
+	DeleteGroupConstraintProfileOperation:   []string{},
 	DeletePlaceOperation:                    []string{},
 	DeleteQrTemplateOperation:               []string{},
 	DisableUserOperation:                    []string{},
 	EnableUserOperation:                     []string{},
+	ExportAreaQrCodesOperation:              []string{},
+	ExportBuildingQrCodesOperation:          []string{},
 	ExportReservationsOperation:             []string{},
 	GetAuditLogOperation:                    []string{},
 	GetCurrentOccupancyOperation:            []string{},
 	GetCurrentUserOperation:                 []string{},
+	GetCurrentUserCalendarTokenOperation:    []string{},
 	GetCurrentUserFavoritesOperation:        []string{},
 	GetCurrentUserNotificationsOperation:    []string{},
 	GetDefaultGroupAssignmentOperation:      []string{},
@@ -178,6 +196,7 @@ var operationRolesBearerAuth = map[string][]string{
 	GetGroupMembersOperation:                []string{},
 	GetGroupPermissionsOperation:            []string{},
 	GetPlaceQrCodeOperation:                 []string{},
+	GetPlaceQrKeyOperation:                  []string{},
 	GetPlaceWhitelistOperation:              []string{},
 	GetQrTemplateOperation:                  []string{},
 	GetReservationOperation:                 []string{},
@@ -187,6 +206,7 @@ var operationRolesBearerAuth = map[string][]string{
 	GetUserOperation:                        []string{},
 	GetUserGroupsOperation:                  []string{},
 	ListApiKeysOperation:                    []string{},
+	ListGroupConstraintProfilesOperation:    []string{},
 	ListGroupsOperation:                     []string{},
 	ListPermissionsOperation:                []string{},
 	ListQrTemplatesOperation:                []string{},
@@ -207,13 +227,19 @@ var operationRolesBearerAuth = map[string][]string{
 	ReplaceBuildingBlockingOperation:        []string{},
 	ReplacePlaceBlockingOperation:           []string{},
 	RevokeApiKeyOperation:                   []string{},
+	RevokeCurrentUserCalendarTokenOperation: []string{},
+	RotateCurrentUserCalendarTokenOperation: []string{},
+	RotatePlaceQrKeyOperation:               []string{},
 	SetDefaultGroupAssignmentOperation:      []string{},
 	UpdateAreaOperation:                     []string{},
+	UpdateAreaConstraintsOperation:          []string{},
 	UpdateAreaRoomPlanOperation:             []string{},
 	UpdateBuildingOperation:                 []string{},
+	UpdateBuildingConstraintsOperation:      []string{},
 	UpdateCurrentUserNotificationsOperation: []string{},
 	UpdateEquipmentOperation:                []string{},
 	UpdateGroupOperation:                    []string{},
+	UpdateGroupConstraintProfileOperation:   []string{},
 	UpdatePlaceOperation:                    []string{},
 	UpdatePlaceConstraintsOperation:         []string{},
 	UpdatePlaceTimeSlotsOperation:           []string{},
@@ -64,13 +64,24 @@ type Handler interface {
 	AddUserGroups(ctx context.Context, req *AddUserGroupsReq, params AddUserGroupsParams) (AddUserGroupsRes, error)
 	// CancelReservation implements cancelReservation operation.
 	//
-	// Cancel reservation.
+	// Cancels the reservation. Holders of manage:reservations for the place may
+	// cancel reservations of other users; this is recorded in the audit log and
+	// the user is notified who cancelled it. Reservations that have been
+	// cancelled, including no-shows that have been released, or have already
+	// ended are rejected (`RESERVATION_NOT_MODIFIABLE`).
 	//
 	// DELETE /reservations/{reservationId}
 	CancelReservation(ctx context.Context, params CancelReservationParams) (CancelReservationRes, error)
 	// CheckInReservation implements checkInReservation operation.
 	//
-	// Check in to reservation.
+	// Checks the holder of the reservation in. Checking in is possible from the configured
+	// time before the start until the configured time after the start, or the end of the
+	// reservation. Every check-in requires the scanned QR code of the place, which must belong
+	// to the reserved place and must not have been replaced by rotating the place's QR key,
+	// also for places that do not require a check-in. Failures carry one of the error codes
+	// QR_CODE_REQUIRED, QR_CODE_INVALID,
+	// QR_CODE_REVOKED, QR_CODE_WRONG_PLACE, CHECK_IN_TOO_EARLY, CHECK_IN_TOO_LATE (400),
+	// NOT_RESERVATION_OWNER (403), ALREADY_CHECKED_IN and RESERVATION_NOT_CHECKINABLE (409).
 	//
 	// POST /reservations/{reservationId}/checkIn
 	CheckInReservation(ctx context.Context, req OptCheckInReservationReq, params CheckInReservationParams) (CheckInReservationRes, error)
@@ -104,6 +115,12 @@ type Handler interface {
 	//
 	// POST /groups
 	CreateGroup(ctx context.Context, req *CreateGroupRequest) (CreateGroupRes, error)
+	// CreateGroupConstraintProfile implements createGroupConstraintProfile operation.
+	//
+	// Create constraint profile for group.
+	//
+	// POST /groups/{groupId}/constraintProfiles
+	CreateGroupConstraintProfile(ctx context.Context, req *ConstraintProfileRequest, params CreateGroupConstraintProfileParams) (CreateGroupConstraintProfileRes, error)
 	// CreatePlace implements createPlace operation.
 	//
 	// Create a new place.
@@ -121,7 +138,17 @@ type Handler interface {
 	// Creates a single reservation or multiple recurring reservations.
 	// For recurring reservations, provide the recurrence field with pattern details.
 	// Start/end times must align with the place's configured time slot intervals.
-	// Reservation will fail if any time slot is blocked or already reserved.
+	// A single reservation fails if its time slot is blocked or already reserved,
+	// or if it would exceed a quota of the place's constraints. Constraint profiles
+	// of the user's groups override the place's constraints; rejections name the
+	// profile a violated limit was taken from.
+	// Recurring reservations are created as a series; with mode skipConflicts,
+	// conflicting occurrences are skipped and reported instead.
+	// Holders of manage:reservations for the place may book on behalf of another
+	// user given by userId or userEmail, including places that can only be booked
+	// manually, and may bypass the user's quotas and blockings of the place. Such
+	// bookings are recorded in the audit log and the user is notified who booked
+	// for them.
 	//
 	// POST /reservations
 	CreateReservation(ctx context.Context, req *CreateReservationRequest) (CreateReservationRes, error)
@@ -155,6 +182,12 @@ type Handler interface {
 	//
 	// DELETE /groups/{groupId}
 	DeleteGroup(ctx context.Context, params DeleteGroupParams) (DeleteGroupRes, error)
+	// DeleteGroupConstraintProfile implements deleteGroupConstraintProfile operation.
+	//
+	// Delete constraint profile.
+	//
+	// DELETE /groups/{groupId}/constraintProfiles/{profileId}
+	DeleteGroupConstraintProfile(ctx context.Context, params DeleteGroupConstraintProfileParams) (DeleteGroupConstraintProfileRes, error)
 	// DeletePlace implements deletePlace operation.
 	//
 	// Delete place.
@@ -179,6 +212,22 @@ type Handler interface {
 	//
 	// POST /users/{userId}/enable
 	EnableUser(ctx context.Context, params EnableUserParams) (EnableUserRes, error)
+	// ExportAreaQrCodes implements exportAreaQrCodes operation.
+	//
+	// Renders the labels of all bookable, enabled places in the area, sorted by place name,
+	// either as PDF print sheets with as many labels per sheet as fit on a grid, or as ZIP
+	// archive of PNG images. At most 2000 places are exported at once.
+	//
+	// GET /areas/{areaId}/qrCodes
+	ExportAreaQrCodes(ctx context.Context, params ExportAreaQrCodesParams) (ExportAreaQrCodesRes, error)
+	// ExportBuildingQrCodes implements exportBuildingQrCodes operation.
+	//
+	// Renders the labels of all bookable, enabled places in the building, sorted by place name,
+	// either as PDF print sheets with as many labels per sheet as fit on a grid, or as ZIP
+	// archive of PNG images. At most 2000 places are exported at once.
+	//
+	// GET /buildings/{buildingId}/qrCodes
+	ExportBuildingQrCodes(ctx context.Context, params ExportBuildingQrCodesParams) (ExportBuildingQrCodesRes, error)
 	// ExportReservations implements exportReservations operation.
 	//
 	// Export reservations as CSV.
@@ -196,6 +245,8 @@ type Handler interface {
 	// Returns availability status for each place in the area within the given time range.
 	// Availability is calculated as: time slots NOT blocked (from place, area, or building)
 	// AND NOT reserved. Optimized for room plan visualization.
+	// The window defaults to the whole day; nextAvailableSlot is the first available
+	// slot on that day starting at or after the window start.
 	//
 	// GET /areas/{areaId}/availability
 	GetAreaAvailability(ctx context.Context, params GetAreaAvailabilityParams) (GetAreaAvailabilityRes, error)
@@ -209,9 +260,18 @@ type Handler interface {
 	// GetAreaCalendar implements getAreaCalendar operation.
 	//
 	// Returns .ics file with blocking periods and reservations for all places in area (next 3 months).
+	// Blockings of the area, its places and its building are included. Reservations never include
+	// details about the user holding them; recurring blockings are emitted as RRULE/EXDATE.
 	//
 	// GET /areas/{areaId}/calendar.ics
 	GetAreaCalendar(ctx context.Context, params GetAreaCalendarParams) (GetAreaCalendarRes, error)
+	// GetAreaConstraints implements getAreaConstraints operation.
+	//
+	// Returns the default constraints of all places in the area. Places
+	// inherit every field they do not set themselves, falling back to the building.
+	//
+	// GET /areas/{areaId}/constraints
+	GetAreaConstraints(ctx context.Context, params GetAreaConstraintsParams) (GetAreaConstraintsRes, error)
 	// GetAreaRoomPlan implements getAreaRoomPlan operation.
 	//
 	// Get room plan data.
@@ -234,6 +294,10 @@ type Handler interface {
 	//
 	// Returns time slots that are NOT blocked for the building.
 	// Useful for seeing when the building is open/operational.
+	// Only building-level blockings are considered, with recurrences expanded.
+	// Besides the merged open ranges, the response summarizes the regular opening
+	// hours per weekday and lists the days that deviate from them.
+	// Ranges longer than 31 days are truncated.
 	//
 	// GET /buildings/{buildingId}/availability
 	GetBuildingAvailability(ctx context.Context, params GetBuildingAvailabilityParams) (GetBuildingAvailabilityRes, error)
@@ -247,9 +311,18 @@ type Handler interface {
 	// GetBuildingCalendar implements getBuildingCalendar operation.
 	//
 	// Returns .ics file with blocking periods and reservations for all places (next 3 months).
+	// Blockings of the building and of its areas and places are included. Reservations never
+	// include details about the user holding them; recurring blockings are emitted as RRULE/EXDATE.
 	//
 	// GET /buildings/{buildingId}/calendar.ics
 	GetBuildingCalendar(ctx context.Context, params GetBuildingCalendarParams) (GetBuildingCalendarRes, error)
+	// GetBuildingConstraints implements getBuildingConstraints operation.
+	//
+	// Returns the default constraints of all places in the building. Places
+	// inherit every field they do not set themselves.
+	//
+	// GET /buildings/{buildingId}/constraints
+	GetBuildingConstraints(ctx context.Context, params GetBuildingConstraintsParams) (GetBuildingConstraintsRes, error)
 	// GetCurrentOccupancy implements getCurrentOccupancy operation.
 	//
 	// Get current occupancy.
@@ -262,6 +335,13 @@ type Handler interface {
 	//
 	// GET /auth/me
 	GetCurrentUser(ctx context.Context) (GetCurrentUserRes, error)
+	// GetCurrentUserCalendarToken implements getCurrentUserCalendarToken operation.
+	//
+	// Returns when the current user's calendar feed token was created and last used. The token itself is
+	// only shown once when it is created.
+	//
+	// GET /users/me/calendar-token
+	GetCurrentUserCalendarToken(ctx context.Context) (GetCurrentUserCalendarTokenRes, error)
 	// GetCurrentUserFavorites implements getCurrentUserFavorites operation.
 	//
 	// Get current user's favorite places.
@@ -280,6 +360,14 @@ type Handler interface {
 	//
 	// GET /groups/defaultAssignment
 	GetDefaultGroupAssignment(ctx context.Context) (GetDefaultGroupAssignmentRes, error)
+	// GetEffectivePlaceConstraints implements getEffectivePlaceConstraints operation.
+	//
+	// Returns the constraints that apply to bookings of the place. Every
+	// field is taken from the place if set there, otherwise from its area,
+	// otherwise from its building.
+	//
+	// GET /places/{placeId}/constraints/effective
+	GetEffectivePlaceConstraints(ctx context.Context, params GetEffectivePlaceConstraintsParams) (GetEffectivePlaceConstraintsRes, error)
 	// GetEquipment implements getEquipment operation.
 	//
 	// Get equipment type details.
@@ -316,6 +404,8 @@ type Handler interface {
 	// Availability is computed as: All valid time slot intervals
 	// MINUS blocking periods (own + inherited from area + building)
 	// MINUS existing reservations.
+	// Ranges longer than 31 days are truncated. Reserved slots never
+	// include details about the user holding the reservation.
 	//
 	// GET /places/{placeId}/availability
 	GetPlaceAvailability(ctx context.Context, params GetPlaceAvailabilityParams) (GetPlaceAvailabilityRes, error)
@@ -330,12 +420,16 @@ type Handler interface {
 	// GetPlaceCalendar implements getPlaceCalendar operation.
 	//
 	// Returns .ics file with blocking periods and reservations (next 3 months).
+	// Blockings inherited from the area and building are included. Reservations never include
+	// details about the user holding them; recurring blockings are emitted as RRULE/EXDATE.
 	//
 	// GET /places/{placeId}/calendar.ics
 	GetPlaceCalendar(ctx context.Context, params GetPlaceCalendarParams) (GetPlaceCalendarRes, error)
 	// GetPlaceConstraints implements getPlaceConstraints operation.
 	//
-	// Get place constraints.
+	// Returns the constraints set on the place itself. Unset fields are
+	// inherited from the area and the building of the place; see
+	// getEffectivePlaceConstraints for the constraints that apply.
 	//
 	// GET /places/{placeId}/constraints
 	GetPlaceConstraints(ctx context.Context, params GetPlaceConstraintsParams) (GetPlaceConstraintsRes, error)
@@ -347,10 +441,19 @@ type Handler interface {
 	GetPlaceEquipment(ctx context.Context, params GetPlaceEquipmentParams) (GetPlaceEquipmentRes, error)
 	// GetPlaceQrCode implements getPlaceQrCode operation.
 	//
-	// Generate QR code.
+	// Renders the printable label of the place at 300 DPI. The QR symbol encodes the check-in
+	// URL of the place; the requested details are printed below it in the order name,
+	// areaName, buildingName. PNG and JPEG images carry their resolution, PDFs consist of a
+	// single page of the size of the label.
 	//
 	// GET /places/{placeId}/qrCode
 	GetPlaceQrCode(ctx context.Context, params GetPlaceQrCodeParams) (GetPlaceQrCodeRes, error)
+	// GetPlaceQrKey implements getPlaceQrKey operation.
+	//
+	// Returns the key version and the check-in URL encoded in the place's QR code.
+	//
+	// GET /places/{placeId}/qrCode/key
+	GetPlaceQrKey(ctx context.Context, params GetPlaceQrKeyParams) (GetPlaceQrKeyRes, error)
 	// GetPlaceTimeSlots implements getPlaceTimeSlots operation.
 	//
 	// Returns the booking grid configuration for this place.
@@ -402,6 +505,15 @@ type Handler interface {
 	//
 	// GET /users/{userId}
 	GetUser(ctx context.Context, params GetUserParams) (GetUserRes, error)
+	// GetUserCalendar implements getUserCalendar operation.
+	//
+	// Returns .ics file with the reservations of the user owning the token, from three months
+	// ago until three months ahead, including place, area and building names and whether a
+	// check-in is required. Cancelled reservations are included with STATUS:CANCELLED so that
+	// subscribed calendars remove them.
+	//
+	// GET /calendar/users/{token}.ics
+	GetUserCalendar(ctx context.Context, params GetUserCalendarParams) (GetUserCalendarRes, error)
 	// GetUserGroups implements getUserGroups operation.
 	//
 	// Get user's groups.
@@ -410,16 +522,18 @@ type Handler interface {
 	GetUserGroups(ctx context.Context, params GetUserGroupsParams) (GetUserGroupsRes, error)
 	// HandleOAuthCallback implements handleOAuthCallback operation.
 	//
-	// Handles the OAuth callback from GitLab.
+	// Handles the OAuth callback from GitLab. The responses below expire the
+	// state cookie, since a state can only be used once.
 	//
 	// GET /auth/callback
 	HandleOAuthCallback(ctx context.Context, params HandleOAuthCallbackParams) (HandleOAuthCallbackRes, error)
 	// InitiateOAuthLogin implements initiateOAuthLogin operation.
 	//
-	// Redirects to GitLab authorization URL.
+	// Redirects to GitLab authorization URL. The state is bound to the browser
+	// by a cookie, which the callback requires.
 	//
 	// GET /auth/login
-	InitiateOAuthLogin(ctx context.Context) error
+	InitiateOAuthLogin(ctx context.Context) (*InitiateOAuthLoginFound, error)
 	// ListApiKeys implements listApiKeys operation.
 	//
 	// List user's API keys.
@@ -456,6 +570,12 @@ type Handler interface {
 	//
 	// GET /equipment
 	ListEquipment(ctx context.Context) ([]Equipment, error)
+	// ListGroupConstraintProfiles implements listGroupConstraintProfiles operation.
+	//
+	// List constraint profiles of group.
+	//
+	// GET /groups/{groupId}/constraintProfiles
+	ListGroupConstraintProfiles(ctx context.Context, params ListGroupConstraintProfilesParams) (ListGroupConstraintProfilesRes, error)
 	// ListGroups implements listGroups operation.
 	//
 	// List all permission groups.
@@ -500,7 +620,8 @@ type Handler interface {
 	Logout(ctx context.Context) error
 	// PreviewQrTemplate implements previewQrTemplate operation.
 	//
-	// Preview template with sample data.
+	// Renders the template with the data of a sample place, either as the HTML shown in
+	// browsers or as the label image printed by getPlaceQrCode.
 	//
 	// GET /qrTemplates/{templateId}/preview
 	PreviewQrTemplate(ctx context.Context, params PreviewQrTemplateParams) (PreviewQrTemplateRes, error)
@@ -588,6 +709,26 @@ type Handler interface {
 	//
 	// DELETE /apiKeys/{keyId}
 	RevokeApiKey(ctx context.Context, params RevokeApiKeyParams) (RevokeApiKeyRes, error)
+	// RevokeCurrentUserCalendarToken implements revokeCurrentUserCalendarToken operation.
+	//
+	// Revoke personal calendar feed token.
+	//
+	// DELETE /users/me/calendar-token
+	RevokeCurrentUserCalendarToken(ctx context.Context) (RevokeCurrentUserCalendarTokenRes, error)
+	// RotateCurrentUserCalendarToken implements rotateCurrentUserCalendarToken operation.
+	//
+	// Creates a new secret token for the current user's personal calendar feed.
+	// Any previous token is revoked, so subscriptions using the old feed URL stop working.
+	//
+	// POST /users/me/calendar-token
+	RotateCurrentUserCalendarToken(ctx context.Context) (RotateCurrentUserCalendarTokenRes, error)
+	// RotatePlaceQrKey implements rotatePlaceQrKey operation.
+	//
+	// Increments the key version of the place. All QR codes printed before are rejected at
+	// check-in with QR_CODE_REVOKED and have to be replaced by newly generated ones.
+	//
+	// POST /places/{placeId}/qrCode/key
+	RotatePlaceQrKey(ctx context.Context, params RotatePlaceQrKeyParams) (RotatePlaceQrKeyRes, error)
 	// SetDefaultGroupAssignment implements setDefaultGroupAssignment operation.
 	//
 	// Set default groups for new users.
@@ -600,6 +741,12 @@ type Handler interface {
 	//
 	// PUT /areas/{areaId}
 	UpdateArea(ctx context.Context, req *UpdateAreaRequest, params UpdateAreaParams) (UpdateAreaRes, error)
+	// UpdateAreaConstraints implements updateAreaConstraints operation.
+	//
+	// Update area constraints.
+	//
+	// PUT /areas/{areaId}/constraints
+	UpdateAreaConstraints(ctx context.Context, req *PlaceConstraints, params UpdateAreaConstraintsParams) (UpdateAreaConstraintsRes, error)
 	// UpdateAreaRoomPlan implements updateAreaRoomPlan operation.
 	//
 	// Update room plan.
@@ -612,6 +759,12 @@ type Handler interface {
 	//
 	// PUT /buildings/{buildingId}
 	UpdateBuilding(ctx context.Context, req *UpdateBuildingRequest, params UpdateBuildingParams) (UpdateBuildingRes, error)
+	// UpdateBuildingConstraints implements updateBuildingConstraints operation.
+	//
+	// Update building constraints.
+	//
+	// PUT /buildings/{buildingId}/constraints
+	UpdateBuildingConstraints(ctx context.Context, req *PlaceConstraints, params UpdateBuildingConstraintsParams) (UpdateBuildingConstraintsRes, error)
 	// UpdateCurrentUserNotifications implements updateCurrentUserNotifications operation.
 	//
 	// Update notification preferences.
@@ -630,6 +783,12 @@ type Handler interface {
 	//
 	// PUT /groups/{groupId}
 	UpdateGroup(ctx context.Context, req *UpdateGroupRequest, params UpdateGroupParams) (UpdateGroupRes, error)
+	// UpdateGroupConstraintProfile implements updateGroupConstraintProfile operation.
+	//
+	// Update constraint profile.
+	//
+	// PUT /groups/{groupId}/constraintProfiles/{profileId}
+	UpdateGroupConstraintProfile(ctx context.Context, req *ConstraintProfileRequest, params UpdateGroupConstraintProfileParams) (UpdateGroupConstraintProfileRes, error)
 	// UpdatePlace implements updatePlace operation.
 	//
 	// Update place.
@@ -660,6 +819,10 @@ type Handler interface {
 	// Extending is only allowed if the adjacent time slot is not blocked or reserved.
 	// Shortening is always allowed within minimum duration constraints.
 	// Times must align with the place's configured time slot intervals.
+	// Holders of manage:reservations for the place may move reservations of other
+	// users, also at places that can only be booked manually, and may bypass quotas
+	// and blockings. Such changes are recorded in the audit log and the user is
+	// notified who made them.
 	//
 	// PUT /reservations/{reservationId}
 	UpdateReservation(ctx context.Context, req *UpdateReservationRequest, params UpdateReservationParams) (UpdateReservationRes, error)
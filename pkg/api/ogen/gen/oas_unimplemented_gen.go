@@ -96,7 +96,11 @@ func (UnimplementedHandler) AddUserGroups(ctx context.Context, req *AddUserGroup
 
 // CancelReservation implements cancelReservation operation.
 //
-// Cancel reservation.
+// Cancels the reservation. Holders of manage:reservations for the place may
+// cancel reservations of other users; this is recorded in the audit log and
+// the user is notified who cancelled it. Reservations that have been
+// cancelled, including no-shows that have been released, or have already
+// ended are rejected (`RESERVATION_NOT_MODIFIABLE`).
 //
 // DELETE /reservations/{reservationId}
 func (UnimplementedHandler) CancelReservation(ctx context.Context, params CancelReservationParams) (r CancelReservationRes, _ error) {
@@ -105,7 +109,14 @@ func (UnimplementedHandler) CancelReservation(ctx context.Context, params Cancel
 
 // CheckInReservation implements checkInReservation operation.
 //
-// Check in to reservation.
+// Checks the holder of the reservation in. Checking in is possible from the configured
+// time before the start until the configured time after the start, or the end of the
+// reservation. Every check-in requires the scanned QR code of the place, which must belong
+// to the reserved place and must not have been replaced by rotating the place's QR key,
+// also for places that do not require a check-in. Failures carry one of the error codes
+// QR_CODE_REQUIRED, QR_CODE_INVALID,
+// QR_CODE_REVOKED, QR_CODE_WRONG_PLACE, CHECK_IN_TOO_EARLY, CHECK_IN_TOO_LATE (400),
+// NOT_RESERVATION_OWNER (403), ALREADY_CHECKED_IN and RESERVATION_NOT_CHECKINABLE (409).
 //
 // POST /reservations/{reservationId}/checkIn
 func (UnimplementedHandler) CheckInReservation(ctx context.Context, req OptCheckInReservationReq, params CheckInReservationParams) (r CheckInReservationRes, _ error) {
@@ -157,6 +168,15 @@ func (UnimplementedHandler) CreateGroup(ctx context.Context, req *CreateGroupReq
 	return r, ht.ErrNotImplemented
 }
 
+// CreateGroupConstraintProfile implements createGroupConstraintProfile operation.
+//
+// Create constraint profile for group.
+//
+// POST /groups/{groupId}/constraintProfiles
+func (UnimplementedHandler) CreateGroupConstraintProfile(ctx context.Context, req *ConstraintProfileRequest, params CreateGroupConstraintProfileParams) (r CreateGroupConstraintProfileRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CreatePlace implements createPlace operation.
 //
 // Create a new place.
@@ -180,7 +200,17 @@ func (UnimplementedHandler) CreateQrTemplate(ctx context.Context, req *CreateQRT
 // Creates a single reservation or multiple recurring reservations.
 // For recurring reservations, provide the recurrence field with pattern details.
 // Start/end times must align with the place's configured time slot intervals.
-// Reservation will fail if any time slot is blocked or already reserved.
+// A single reservation fails if its time slot is blocked or already reserved,
+// or if it would exceed a quota of the place's constraints. Constraint profiles
+// of the user's groups override the place's constraints; rejections name the
+// profile a violated limit was taken from.
+// Recurring reservations are created as a series; with mode skipConflicts,
+// conflicting occurrences are skipped and reported instead.
+// Holders of manage:reservations for the place may book on behalf of another
+// user given by userId or userEmail, including places that can only be booked
+// manually, and may bypass the user's quotas and blockings of the place. Such
+// bookings are recorded in the audit log and the user is notified who booked
+// for them.
 //
 // POST /reservations
 func (UnimplementedHandler) CreateReservation(ctx context.Context, req *CreateReservationRequest) (r CreateReservationRes, _ error) {
@@ -232,6 +262,15 @@ func (UnimplementedHandler) DeleteGroup(ctx context.Context, params DeleteGroupP
 	return r, ht.ErrNotImplemented
 }
 
+// DeleteGroupConstraintProfile implements deleteGroupConstraintProfile operation.
+//
+// Delete constraint profile.
+//
+// DELETE /groups/{groupId}/constraintProfiles/{profileId}
+func (UnimplementedHandler) DeleteGroupConstraintProfile(ctx context.Context, params DeleteGroupConstraintProfileParams) (r DeleteGroupConstraintProfileRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // DeletePlace implements deletePlace operation.
 //
 // Delete place.
@@ -268,6 +307,28 @@ func (UnimplementedHandler) EnableUser(ctx context.Context, params EnableUserPar
 	return r, ht.ErrNotImplemented
 }
 
+// ExportAreaQrCodes implements exportAreaQrCodes operation.
+//
+// Renders the labels of all bookable, enabled places in the area, sorted by place name,
+// either as PDF print sheets with as many labels per sheet as fit on a grid, or as ZIP
+// archive of PNG images. At most 2000 places are exported at once.
+//
+// GET /areas/{areaId}/qrCodes
+func (UnimplementedHandler) ExportAreaQrCodes(ctx context.Context, params ExportAreaQrCodesParams) (r ExportAreaQrCodesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ExportBuildingQrCodes implements exportBuildingQrCodes operation.
+//
+// Renders the labels of all bookable, enabled places in the building, sorted by place name,
+// either as PDF print sheets with as many labels per sheet as fit on a grid, or as ZIP
+// archive of PNG images. At most 2000 places are exported at once.
+//
+// GET /buildings/{buildingId}/qrCodes
+func (UnimplementedHandler) ExportBuildingQrCodes(ctx context.Context, params ExportBuildingQrCodesParams) (r ExportBuildingQrCodesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ExportReservations implements exportReservations operation.
 //
 // Export reservations as CSV.
@@ -291,6 +352,8 @@ func (UnimplementedHandler) GetArea(ctx context.Context, params GetAreaParams) (
 // Returns availability status for each place in the area within the given time range.
 // Availability is calculated as: time slots NOT blocked (from place, area, or building)
 // AND NOT reserved. Optimized for room plan visualization.
+// The window defaults to the whole day; nextAvailableSlot is the first available
+// slot on that day starting at or after the window start.
 //
 // GET /areas/{areaId}/availability
 func (UnimplementedHandler) GetAreaAvailability(ctx context.Context, params GetAreaAvailabilityParams) (r GetAreaAvailabilityRes, _ error) {
@@ -310,12 +373,24 @@ func (UnimplementedHandler) GetAreaBlocking(ctx context.Context, params GetAreaB
 // GetAreaCalendar implements getAreaCalendar operation.
 //
 // Returns .ics file with blocking periods and reservations for all places in area (next 3 months).
+// Blockings of the area, its places and its building are included. Reservations never include
+// details about the user holding them; recurring blockings are emitted as RRULE/EXDATE.
 //
 // GET /areas/{areaId}/calendar.ics
 func (UnimplementedHandler) GetAreaCalendar(ctx context.Context, params GetAreaCalendarParams) (r GetAreaCalendarRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// GetAreaConstraints implements getAreaConstraints operation.
+//
+// Returns the default constraints of all places in the area. Places
+// inherit every field they do not set themselves, falling back to the building.
+//
+// GET /areas/{areaId}/constraints
+func (UnimplementedHandler) GetAreaConstraints(ctx context.Context, params GetAreaConstraintsParams) (r GetAreaConstraintsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetAreaRoomPlan implements getAreaRoomPlan operation.
 //
 // Get room plan data.
@@ -347,6 +422,10 @@ func (UnimplementedHandler) GetBuilding(ctx context.Context, params GetBuildingP
 //
 // Returns time slots that are NOT blocked for the building.
 // Useful for seeing when the building is open/operational.
+// Only building-level blockings are considered, with recurrences expanded.
+// Besides the merged open ranges, the response summarizes the regular opening
+// hours per weekday and lists the days that deviate from them.
+// Ranges longer than 31 days are truncated.
 //
 // GET /buildings/{buildingId}/availability
 func (UnimplementedHandler) GetBuildingAvailability(ctx context.Context, params GetBuildingAvailabilityParams) (r GetBuildingAvailabilityRes, _ error) {
@@ -366,12 +445,24 @@ func (UnimplementedHandler) GetBuildingBlocking(ctx context.Context, params GetB
 // GetBuildingCalendar implements getBuildingCalendar operation.
 //
 // Returns .ics file with blocking periods and reservations for all places (next 3 months).
+// Blockings of the building and of its areas and places are included. Reservations never
+// include details about the user holding them; recurring blockings are emitted as RRULE/EXDATE.
 //
 // GET /buildings/{buildingId}/calendar.ics
 func (UnimplementedHandler) GetBuildingCalendar(ctx context.Context, params GetBuildingCalendarParams) (r GetBuildingCalendarRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// GetBuildingConstraints implements getBuildingConstraints operation.
+//
+// Returns the default constraints of all places in the building. Places
+// inherit every field they do not set themselves.
+//
+// GET /buildings/{buildingId}/constraints
+func (UnimplementedHandler) GetBuildingConstraints(ctx context.Context, params GetBuildingConstraintsParams) (r GetBuildingConstraintsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetCurrentOccupancy implements getCurrentOccupancy operation.
 //
 // Get current occupancy.
@@ -390,6 +481,16 @@ func (UnimplementedHandler) GetCurrentUser(ctx context.Context) (r GetCurrentUse
 	return r, ht.ErrNotImplemented
 }
 
+// GetCurrentUserCalendarToken implements getCurrentUserCalendarToken operation.
+//
+// Returns when the current user's calendar feed token was created and last used. The token itself is
+// only shown once when it is created.
+//
+// GET /users/me/calendar-token
+func (UnimplementedHandler) GetCurrentUserCalendarToken(ctx context.Context) (r GetCurrentUserCalendarTokenRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetCurrentUserFavorites implements getCurrentUserFavorites operation.
 //
 // Get current user's favorite places.
@@ -417,6 +518,17 @@ func (UnimplementedHandler) GetDefaultGroupAssignment(ctx context.Context) (r Ge
 	return r, ht.ErrNotImplemented
 }
 
+// GetEffectivePlaceConstraints implements getEffectivePlaceConstraints operation.
+//
+// Returns the constraints that apply to bookings of the place. Every
+// field is taken from the place if set there, otherwise from its area,
+// otherwise from its building.
+//
+// GET /places/{placeId}/constraints/effective
+func (UnimplementedHandler) GetEffectivePlaceConstraints(ctx context.Context, params GetEffectivePlaceConstraintsParams) (r GetEffectivePlaceConstraintsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetEquipment implements getEquipment operation.
 //
 // Get equipment type details.
@@ -468,6 +580,8 @@ func (UnimplementedHandler) GetPlace(ctx context.Context, params GetPlaceParams)
 // Availability is computed as: All valid time slot intervals
 // MINUS blocking periods (own + inherited from area + building)
 // MINUS existing reservations.
+// Ranges longer than 31 days are truncated. Reserved slots never
+// include details about the user holding the reservation.
 //
 // GET /places/{placeId}/availability
 func (UnimplementedHandler) GetPlaceAvailability(ctx context.Context, params GetPlaceAvailabilityParams) (r GetPlaceAvailabilityRes, _ error) {
@@ -488,6 +602,8 @@ func (UnimplementedHandler) GetPlaceBlocking(ctx context.Context, params GetPlac
 // GetPlaceCalendar implements getPlaceCalendar operation.
 //
 // Returns .ics file with blocking periods and reservations (next 3 months).
+// Blockings inherited from the area and building are included. Reservations never include
+// details about the user holding them; recurring blockings are emitted as RRULE/EXDATE.
 //
 // GET /places/{placeId}/calendar.ics
 func (UnimplementedHandler) GetPlaceCalendar(ctx context.Context, params GetPlaceCalendarParams) (r GetPlaceCalendarRes, _ error) {
@@ -496,7 +612,9 @@ func (UnimplementedHandler) GetPlaceCalendar(ctx context.Context, params GetPlac
 
 // GetPlaceConstraints implements getPlaceConstraints operation.
 //
-// Get place constraints.
+// Returns the constraints set on the place itself. Unset fields are
+// inherited from the area and the building of the place; see
+// getEffectivePlaceConstraints for the constraints that apply.
 //
 // GET /places/{placeId}/constraints
 func (UnimplementedHandler) GetPlaceConstraints(ctx context.Context, params GetPlaceConstraintsParams) (r GetPlaceConstraintsRes, _ error) {
@@ -514,13 +632,25 @@ func (UnimplementedHandler) GetPlaceEquipment(ctx context.Context, params GetPla
 
 // GetPlaceQrCode implements getPlaceQrCode operation.
 //
-// Generate QR code.
+// Renders the printable label of the place at 300 DPI. The QR symbol encodes the check-in
+// URL of the place; the requested details are printed below it in the order name,
+// areaName, buildingName. PNG and JPEG images carry their resolution, PDFs consist of a
+// single page of the size of the label.
 //
 // GET /places/{placeId}/qrCode
 func (UnimplementedHandler) GetPlaceQrCode(ctx context.Context, params GetPlaceQrCodeParams) (r GetPlaceQrCodeRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// GetPlaceQrKey implements getPlaceQrKey operation.
+//
+// Returns the key version and the check-in URL encoded in the place's QR code.
+//
+// GET /places/{placeId}/qrCode/key
+func (UnimplementedHandler) GetPlaceQrKey(ctx context.Context, params GetPlaceQrKeyParams) (r GetPlaceQrKeyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetPlaceTimeSlots implements getPlaceTimeSlots operation.
 //
 // Returns the booking grid configuration for this place.
@@ -596,6 +726,18 @@ func (UnimplementedHandler) GetUser(ctx context.Context, params GetUserParams) (
 	return r, ht.ErrNotImplemented
 }
 
+// GetUserCalendar implements getUserCalendar operation.
+//
+// Returns .ics file with the reservations of the user owning the token, from three months
+// ago until three months ahead, including place, area and building names and whether a
+// check-in is required. Cancelled reservations are included with STATUS:CANCELLED so that
+// subscribed calendars remove them.
+//
+// GET /calendar/users/{token}.ics
+func (UnimplementedHandler) GetUserCalendar(ctx context.Context, params GetUserCalendarParams) (r GetUserCalendarRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetUserGroups implements getUserGroups operation.
 //
 // Get user's groups.
@@ -607,7 +749,8 @@ func (UnimplementedHandler) GetUserGroups(ctx context.Context, params GetUserGro
 
 // HandleOAuthCallback implements handleOAuthCallback operation.
 //
-// Handles the OAuth callback from GitLab.
+// Handles the OAuth callback from GitLab. The responses below expire the
+// state cookie, since a state can only be used once.
 //
 // GET /auth/callback
 func (UnimplementedHandler) HandleOAuthCallback(ctx context.Context, params HandleOAuthCallbackParams) (r HandleOAuthCallbackRes, _ error) {
@@ -616,11 +759,12 @@ func (UnimplementedHandler) HandleOAuthCallback(ctx context.Context, params Hand
 
 // InitiateOAuthLogin implements initiateOAuthLogin operation.
 //
-// Redirects to GitLab authorization URL.
+// Redirects to GitLab authorization URL. The state is bound to the browser
+// by a cookie, which the callback requires.
 //
 // GET /auth/login
-func (UnimplementedHandler) InitiateOAuthLogin(ctx context.Context) error {
-	return ht.ErrNotImplemented
+func (UnimplementedHandler) InitiateOAuthLogin(ctx context.Context) (r *InitiateOAuthLoginFound, _ error) {
+	return r, ht.ErrNotImplemented
 }
 
 // ListApiKeys implements listApiKeys operation.
@@ -677,6 +821,15 @@ func (UnimplementedHandler) ListEquipment(ctx context.Context) (r []Equipment, _
 	return r, ht.ErrNotImplemented
 }
 
+// ListGroupConstraintProfiles implements listGroupConstraintProfiles operation.
+//
+// List constraint profiles of group.
+//
+// GET /groups/{groupId}/constraintProfiles
+func (UnimplementedHandler) ListGroupConstraintProfiles(ctx context.Context, params ListGroupConstraintProfilesParams) (r ListGroupConstraintProfilesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListGroups implements listGroups operation.
 //
 // List all permission groups.
@@ -742,7 +895,8 @@ func (UnimplementedHandler) Logout(ctx context.Context) error {
 
 // PreviewQrTemplate implements previewQrTemplate operation.
 //
-// Preview template with sample data.
+// Renders the template with the data of a sample place, either as the HTML shown in
+// browsers or as the label image printed by getPlaceQrCode.
 //
 // GET /qrTemplates/{templateId}/preview
 func (UnimplementedHandler) PreviewQrTemplate(ctx context.Context, params PreviewQrTemplateParams) (r PreviewQrTemplateRes, _ error) {
@@ -875,6 +1029,35 @@ func (UnimplementedHandler) RevokeApiKey(ctx context.Context, params RevokeApiKe
 	return r, ht.ErrNotImplemented
 }
 
+// RevokeCurrentUserCalendarToken implements revokeCurrentUserCalendarToken operation.
+//
+// Revoke personal calendar feed token.
+//
+// DELETE /users/me/calendar-token
+func (UnimplementedHandler) RevokeCurrentUserCalendarToken(ctx context.Context) (r RevokeCurrentUserCalendarTokenRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RotateCurrentUserCalendarToken implements rotateCurrentUserCalendarToken operation.
+//
+// Creates a new secret token for the current user's personal calendar feed.
+// Any previous token is revoked, so subscriptions using the old feed URL stop working.
+//
+// POST /users/me/calendar-token
+func (UnimplementedHandler) RotateCurrentUserCalendarToken(ctx context.Context) (r RotateCurrentUserCalendarTokenRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RotatePlaceQrKey implements rotatePlaceQrKey operation.
+//
+// Increments the key version of the place. All QR codes printed before are rejected at
+// check-in with QR_CODE_REVOKED and have to be replaced by newly generated ones.
+//
+// POST /places/{placeId}/qrCode/key
+func (UnimplementedHandler) RotatePlaceQrKey(ctx context.Context, params RotatePlaceQrKeyParams) (r RotatePlaceQrKeyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // SetDefaultGroupAssignment implements setDefaultGroupAssignment operation.
 //
 // Set default groups for new users.
@@ -893,6 +1076,15 @@ func (UnimplementedHandler) UpdateArea(ctx context.Context, req *UpdateAreaReque
 	return r, ht.ErrNotImplemented
 }
 
+// UpdateAreaConstraints implements updateAreaConstraints operation.
+//
+// Update area constraints.
+//
+// PUT /areas/{areaId}/constraints
+func (UnimplementedHandler) UpdateAreaConstraints(ctx context.Context, req *PlaceConstraints, params UpdateAreaConstraintsParams) (r UpdateAreaConstraintsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UpdateAreaRoomPlan implements updateAreaRoomPlan operation.
 //
 // Update room plan.
@@ -911,6 +1103,15 @@ func (UnimplementedHandler) UpdateBuilding(ctx context.Context, req *UpdateBuild
 	return r, ht.ErrNotImplemented
 }
 
+// UpdateBuildingConstraints implements updateBuildingConstraints operation.
+//
+// Update building constraints.
+//
+// PUT /buildings/{buildingId}/constraints
+func (UnimplementedHandler) UpdateBuildingConstraints(ctx context.Context, req *PlaceConstraints, params UpdateBuildingConstraintsParams) (r UpdateBuildingConstraintsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UpdateCurrentUserNotifications implements updateCurrentUserNotifications operation.
 //
 // Update notification preferences.
@@ -938,6 +1139,15 @@ func (UnimplementedHandler) UpdateGroup(ctx context.Context, req *UpdateGroupReq
 	return r, ht.ErrNotImplemented
 }
 
+// UpdateGroupConstraintProfile implements updateGroupConstraintProfile operation.
+//
+// Update constraint profile.
+//
+// PUT /groups/{groupId}/constraintProfiles/{profileId}
+func (UnimplementedHandler) UpdateGroupConstraintProfile(ctx context.Context, req *ConstraintProfileRequest, params UpdateGroupConstraintProfileParams) (r UpdateGroupConstraintProfileRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UpdatePlace implements updatePlace operation.
 //
 // Update place.
@@ -980,6 +1190,10 @@ func (UnimplementedHandler) UpdateQrTemplate(ctx context.Context, req *UpdateQRT
 // Extending is only allowed if the adjacent time slot is not blocked or reserved.
 // Shortening is always allowed within minimum duration constraints.
 // Times must align with the place's configured time slot intervals.
+// Holders of manage:reservations for the place may move reservations of other
+// users, also at places that can only be booked manually, and may bypass quotas
+// and blockings. Such changes are recorded in the audit log and the user is
+// notified who made them.
 //
 // PUT /reservations/{reservationId}
 func (UnimplementedHandler) UpdateReservation(ctx context.Context, req *UpdateReservationRequest, params UpdateReservationParams) (r UpdateReservationRes, _ error) {
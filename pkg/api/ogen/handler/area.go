@@ -1,37 +1,56 @@
 package handler
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pixlcrashr/roomy/pkg/api/ogen/gen"
 	"github.com/pixlcrashr/roomy/pkg/api/ogen/handler/converter"
+	"github.com/pixlcrashr/roomy/pkg/auth"
+	"github.com/pixlcrashr/roomy/pkg/calendar"
+	"github.com/pixlcrashr/roomy/pkg/constraints"
 	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
 	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"github.com/pixlcrashr/roomy/pkg/qr"
+	"github.com/pixlcrashr/roomy/pkg/reservation"
 	"gorm.io/gorm"
 )
 
 // AreaHandler handles area-related operations.
 type AreaHandler struct {
-	db *gorm.DB
+	db           *gorm.DB
+	reservations *reservation.Service
+	qrExporter   *qr.Exporter
 }
 
 // NewAreaHandler creates a new AreaHandler.
-func NewAreaHandler(db *gorm.DB) *AreaHandler {
-	return &AreaHandler{db: db}
+func NewAreaHandler(db *gorm.DB, reservations *reservation.Service, qrExporter *qr.Exporter) *AreaHandler {
+	return &AreaHandler{db: db, reservations: reservations, qrExporter: qrExporter}
 }
 
 // CreateArea creates a new area.
 // POST /areas
 func (h *AreaHandler) CreateArea(ctx context.Context, req *gen.CreateAreaRequest) (gen.CreateAreaRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManageAreas, auth.BuildingScope(req.BuildingId)); err != nil {
+		return nil, err
+	}
+
 	id := uuid.New()
-	var description *string
+	var description, location *string
 	if req.Description.IsSet() {
 		desc := req.Description.Value
 		description = &desc
 	}
+	if req.Location.IsSet() {
+		loc := req.Location.Value
+		location = &loc
+	}
 
-	if err := dbgen.AreaQuery[model.Area](h.db).Insert(ctx, id, req.BuildingId, req.Name, description, nil); err != nil {
+	if err := dbgen.AreaQuery[model.Area](h.db).Insert(ctx, id, req.BuildingId, req.Name, description, location, nil); err != nil {
 		return nil, err
 	}
 
@@ -51,7 +70,8 @@ func (h *AreaHandler) GetArea(ctx context.Context, params gen.GetAreaParams) (ge
 		return nil, err
 	}
 	if area == nil {
-		return &gen.GetAreaNotFound{}, nil
+		res := NotFoundError("area not found")
+		return &res, nil
 	}
 	return converter.AreaToAPI(area), nil
 }
@@ -59,6 +79,10 @@ func (h *AreaHandler) GetArea(ctx context.Context, params gen.GetAreaParams) (ge
 // UpdateArea updates an area.
 // PUT /areas/{areaId}
 func (h *AreaHandler) UpdateArea(ctx context.Context, req *gen.UpdateAreaRequest, params gen.UpdateAreaParams) (gen.UpdateAreaRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManageAreas, auth.AreaScope(params.AreaId)); err != nil {
+		return nil, err
+	}
+
 	existing, err := dbgen.AreaQuery[model.Area](h.db).GetByID(ctx, params.AreaId)
 	if err != nil {
 		return nil, err
@@ -67,7 +91,7 @@ func (h *AreaHandler) UpdateArea(ctx context.Context, req *gen.UpdateAreaRequest
 		return &gen.UpdateAreaNotFound{}, nil
 	}
 
-	var name, description *string
+	var name, description, location *string
 	if req.Name.IsSet() {
 		n := req.Name.Value
 		name = &n
@@ -76,8 +100,12 @@ func (h *AreaHandler) UpdateArea(ctx context.Context, req *gen.UpdateAreaRequest
 		desc := req.Description.Value
 		description = &desc
 	}
+	if req.Location.IsSet() {
+		loc := req.Location.Value
+		location = &loc
+	}
 
-	if err := dbgen.AreaQuery[model.Area](h.db).Save(ctx, params.AreaId, name, description, nil); err != nil {
+	if err := dbgen.AreaQuery[model.Area](h.db).Save(ctx, params.AreaId, name, description, location, nil); err != nil {
 		return nil, err
 	}
 
@@ -92,6 +120,10 @@ func (h *AreaHandler) UpdateArea(ctx context.Context, req *gen.UpdateAreaRequest
 // DeleteArea deletes an area.
 // DELETE /areas/{areaId}
 func (h *AreaHandler) DeleteArea(ctx context.Context, params gen.DeleteAreaParams) (gen.DeleteAreaRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManageAreas, auth.AreaScope(params.AreaId)); err != nil {
+		return nil, err
+	}
+
 	existing, err := dbgen.AreaQuery[model.Area](h.db).GetByID(ctx, params.AreaId)
 	if err != nil {
 		return nil, err
@@ -109,14 +141,7 @@ func (h *AreaHandler) DeleteArea(ctx context.Context, params gen.DeleteAreaParam
 // ListAreas lists all areas.
 // GET /areas
 func (h *AreaHandler) ListAreas(ctx context.Context, params gen.ListAreasParams) (*gen.PaginatedAreaList, error) {
-	limit := 20
-	offset := 0
-	if params.Limit.IsSet() {
-		limit = params.Limit.Value
-	}
-	if params.Offset.IsSet() {
-		offset = params.Offset.Value
-	}
+	page := newPagination(params.Page, params.Limit)
 
 	var buildingID *uuid.UUID
 	if params.BuildingId.IsSet() {
@@ -124,25 +149,19 @@ func (h *AreaHandler) ListAreas(ctx context.Context, params gen.ListAreasParams)
 		buildingID = &bid
 	}
 
-	var search *string
-	if params.Search.IsSet() {
-		s := "%" + params.Search.Value + "%"
-		search = &s
-	}
-
-	areas, err := dbgen.AreaQuery[model.Area](h.db).List(ctx, limit, offset, buildingID, search)
+	areas, err := dbgen.AreaQuery[model.Area](h.db).List(ctx, page.limit, page.offset(), buildingID, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	total, err := dbgen.AreaQuery[model.Area](h.db).CountAll(ctx, buildingID, search)
+	total, err := dbgen.AreaQuery[model.Area](h.db).CountAll(ctx, buildingID, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	return &gen.PaginatedAreaList{
-		Items: converter.AreaPointersToAPI(areas),
-		Total: int(total),
+		Data: converter.AreasToAPI(areas),
+		Meta: page.meta(total),
 	}, nil
 }
 
@@ -154,13 +173,34 @@ func (h *AreaHandler) GetAreaAvailability(ctx context.Context, params gen.GetAre
 		return nil, err
 	}
 	if area == nil {
-		return &gen.GetAreaAvailabilityNotFound{}, nil
+		res := gen.GetAreaAvailabilityNotFound(NotFoundError("area not found"))
+		return &res, nil
 	}
 
-	// TODO: Calculate availability per place
-	return &gen.AreaAvailabilityResponse{
-		Places: []gen.PlaceAvailability{},
-	}, nil
+	loc := h.reservations.Location()
+	y, m, d := params.Date.Date()
+	start := time.Date(y, m, d, 0, 0, 0, 0, loc)
+	end := start.AddDate(0, 0, 1)
+	if params.StartTime.IsSet() {
+		t := params.StartTime.Value
+		start = time.Date(y, m, d, t.Hour(), t.Minute(), t.Second(), 0, loc)
+	}
+	if params.EndTime.IsSet() {
+		t := params.EndTime.Value
+		end = time.Date(y, m, d, t.Hour(), t.Minute(), t.Second(), 0, loc)
+	}
+	if !end.After(start) {
+		res := gen.GetAreaAvailabilityBadRequest(BadRequestError("endTime must be after startTime"))
+		return &res, nil
+	}
+
+	summaries, err := h.reservations.AreaAvailability(ctx, area, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	res := gen.GetAreaAvailabilityOKApplicationJSON(converter.PlaceSummariesToAPI(summaries))
+	return &res, nil
 }
 
 // GetAreaBlocking returns blocking periods for the area.
@@ -171,7 +211,8 @@ func (h *AreaHandler) GetAreaBlocking(ctx context.Context, params gen.GetAreaBlo
 		return nil, err
 	}
 	if area == nil {
-		return &gen.GetAreaBlockingNotFound{}, nil
+		res := NotFoundError("area not found")
+		return &res, nil
 	}
 
 	blockings, err := dbgen.BlockingQuery[model.Blocking](h.db).ListByEntity(ctx, "area", params.AreaId)
@@ -180,21 +221,43 @@ func (h *AreaHandler) GetAreaBlocking(ctx context.Context, params gen.GetAreaBlo
 	}
 
 	// TODO: Include inherited blocking from building if requested
-	return &gen.BlockingResponse{
-		Entries: converter.BlockingsToAPI(blockings),
-	}, nil
+	res := gen.GetAreaBlockingOKApplicationJSON(converter.BlockingsToAPI(blockings))
+	return &res, nil
 }
 
 // GetAreaCalendar returns .ics file with blocking periods and reservations.
 // GET /areas/{areaId}/calendar.ics
 func (h *AreaHandler) GetAreaCalendar(ctx context.Context, params gen.GetAreaCalendarParams) (gen.GetAreaCalendarRes, error) {
-	// TODO: Generate ICS calendar
-	return &gen.GetAreaCalendarOKHeaders{}, nil
+	area, err := dbgen.AreaQuery[model.Area](h.db).GetByID(ctx, params.AreaId)
+	if err != nil {
+		return nil, err
+	}
+	if area == nil {
+		res := NotFoundError("area not found")
+		return &res, nil
+	}
+
+	loc := h.reservations.Location()
+	from, to := calendar.Window(time.Now(), loc)
+	feed, err := calendar.ForArea(ctx, h.db, area, from, to, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, notModified := calendarFeed(feed, params.IfNoneMatch)
+	if notModified != nil {
+		return notModified, nil
+	}
+	return res, nil
 }
 
 // AddAreaBlockingEntries adds blocking periods to area.
 // POST /areas/{areaId}/blocking/entries
 func (h *AreaHandler) AddAreaBlockingEntries(ctx context.Context, req *gen.AddAreaBlockingEntriesReq, params gen.AddAreaBlockingEntriesParams) (gen.AddAreaBlockingEntriesRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManageAreas, auth.AreaScope(params.AreaId)); err != nil {
+		return nil, err
+	}
+
 	area, err := dbgen.AreaQuery[model.Area](h.db).GetByID(ctx, params.AreaId)
 	if err != nil {
 		return nil, err
@@ -203,25 +266,13 @@ func (h *AreaHandler) AddAreaBlockingEntries(ctx context.Context, req *gen.AddAr
 		return &gen.AddAreaBlockingEntriesNotFound{}, nil
 	}
 
-	for _, entry := range req.Entries {
-		id := uuid.New()
-		var reason, recurrencePattern *string
-		if entry.Reason.IsSet() {
-			r := entry.Reason.Value
-			reason = &r
-		}
-		if entry.RecurrenceRule.IsSet() {
-			rp := entry.RecurrenceRule.Value
-			recurrencePattern = &rp
-		}
+	if err := validateBlockings("area", params.AreaId, req.Entries); err != nil {
+		res := gen.AddAreaBlockingEntriesBadRequest(BadRequestError(err.Error()))
+		return &res, nil
+	}
 
-		if err := dbgen.BlockingQuery[model.Blocking](h.db).Insert(
-			ctx, id, "area", params.AreaId, string(entry.BlockingType),
-			entry.StartTime, entry.EndTime, entry.IsRecurring.Or(false),
-			recurrencePattern, nil, reason, nil,
-		); err != nil {
-			return nil, err
-		}
+	if err := insertBlockings(ctx, h.db, "area", params.AreaId, req.Entries); err != nil {
+		return nil, err
 	}
 
 	blockings, err := dbgen.BlockingQuery[model.Blocking](h.db).ListByEntity(ctx, "area", params.AreaId)
@@ -229,15 +280,18 @@ func (h *AreaHandler) AddAreaBlockingEntries(ctx context.Context, req *gen.AddAr
 		return nil, err
 	}
 
-	return &gen.BlockingResponse{
-		Entries: converter.BlockingsToAPI(blockings),
-	}, nil
+	res := gen.AddAreaBlockingEntriesCreatedApplicationJSON(converter.BlockingsToAPI(blockings))
+	return &res, nil
 }
 
 // RemoveAreaBlockingEntries removes blocking periods by IDs.
 // DELETE /areas/{areaId}/blocking/entries
 func (h *AreaHandler) RemoveAreaBlockingEntries(ctx context.Context, req *gen.RemoveAreaBlockingEntriesReq, params gen.RemoveAreaBlockingEntriesParams) (gen.RemoveAreaBlockingEntriesRes, error) {
-	if err := dbgen.BlockingQuery[model.Blocking](h.db).DeleteByIDs(ctx, "area", params.AreaId, req.Ids); err != nil {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManageAreas, auth.AreaScope(params.AreaId)); err != nil {
+		return nil, err
+	}
+
+	if err := dbgen.BlockingQuery[model.Blocking](h.db).DeleteByIDs(ctx, "area", params.AreaId, req.BlockingIds); err != nil {
 		return nil, err
 	}
 	return &gen.RemoveAreaBlockingEntriesNoContent{}, nil
@@ -246,6 +300,10 @@ func (h *AreaHandler) RemoveAreaBlockingEntries(ctx context.Context, req *gen.Re
 // ReplaceAreaBlocking replaces all area blocking periods.
 // PUT /areas/{areaId}/blocking
 func (h *AreaHandler) ReplaceAreaBlocking(ctx context.Context, req *gen.ReplaceAreaBlockingReq, params gen.ReplaceAreaBlockingParams) (gen.ReplaceAreaBlockingRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManageAreas, auth.AreaScope(params.AreaId)); err != nil {
+		return nil, err
+	}
+
 	area, err := dbgen.AreaQuery[model.Area](h.db).GetByID(ctx, params.AreaId)
 	if err != nil {
 		return nil, err
@@ -254,29 +312,17 @@ func (h *AreaHandler) ReplaceAreaBlocking(ctx context.Context, req *gen.ReplaceA
 		return &gen.ReplaceAreaBlockingNotFound{}, nil
 	}
 
+	if err := validateBlockings("area", params.AreaId, req.Blockings); err != nil {
+		res := gen.ReplaceAreaBlockingBadRequest(BadRequestError(err.Error()))
+		return &res, nil
+	}
+
 	if err := dbgen.BlockingQuery[model.Blocking](h.db).DeleteByEntity(ctx, "area", params.AreaId); err != nil {
 		return nil, err
 	}
 
-	for _, entry := range req.Entries {
-		id := uuid.New()
-		var reason, recurrencePattern *string
-		if entry.Reason.IsSet() {
-			r := entry.Reason.Value
-			reason = &r
-		}
-		if entry.RecurrenceRule.IsSet() {
-			rp := entry.RecurrenceRule.Value
-			recurrencePattern = &rp
-		}
-
-		if err := dbgen.BlockingQuery[model.Blocking](h.db).Insert(
-			ctx, id, "area", params.AreaId, string(entry.BlockingType),
-			entry.StartTime, entry.EndTime, entry.IsRecurring.Or(false),
-			recurrencePattern, nil, reason, nil,
-		); err != nil {
-			return nil, err
-		}
+	if err := insertBlockings(ctx, h.db, "area", params.AreaId, req.Blockings); err != nil {
+		return nil, err
 	}
 
 	blockings, err := dbgen.BlockingQuery[model.Blocking](h.db).ListByEntity(ctx, "area", params.AreaId)
@@ -284,9 +330,57 @@ func (h *AreaHandler) ReplaceAreaBlocking(ctx context.Context, req *gen.ReplaceA
 		return nil, err
 	}
 
-	return &gen.BlockingResponse{
-		Entries: converter.BlockingsToAPI(blockings),
-	}, nil
+	res := gen.ReplaceAreaBlockingOKApplicationJSON(converter.BlockingsToAPI(blockings))
+	return &res, nil
+}
+
+// GetAreaConstraints returns the default constraints of the places in the area.
+// GET /areas/{{areaId}}/constraints
+func (h *AreaHandler) GetAreaConstraints(ctx context.Context, params gen.GetAreaConstraintsParams) (gen.GetAreaConstraintsRes, error) {
+	area, err := dbgen.AreaQuery[model.Area](h.db).GetByID(ctx, params.AreaId)
+	if err != nil {
+		return nil, err
+	}
+	if area == nil {
+		res := NotFoundError("area not found")
+		return &res, nil
+	}
+
+	c, err := constraints.Load(ctx, h.db, constraints.EntityArea, area.ID)
+	if err != nil {
+		return nil, err
+	}
+	return converter.PlaceConstraintsToAPI(c), nil
+}
+
+// UpdateAreaConstraints replaces the default constraints of the places in the area.
+// PUT /areas/{{areaId}}/constraints
+func (h *AreaHandler) UpdateAreaConstraints(ctx context.Context, req *gen.PlaceConstraints, params gen.UpdateAreaConstraintsParams) (gen.UpdateAreaConstraintsRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManageAreas, auth.AreaScope(params.AreaId)); err != nil {
+		return nil, err
+	}
+
+	area, err := dbgen.AreaQuery[model.Area](h.db).GetByID(ctx, params.AreaId)
+	if err != nil {
+		return nil, err
+	}
+	if area == nil {
+		res := gen.UpdateAreaConstraintsNotFound(NotFoundError("area not found"))
+		return &res, nil
+	}
+
+	c, err := constraints.Load(ctx, h.db, constraints.EntityArea, area.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := converter.UpdatePlaceConstraintsRequestToModel(req, c); err != nil {
+		res := gen.UpdateAreaConstraintsBadRequest(BadRequestError(err.Error()))
+		return &res, nil
+	}
+	if err := h.db.WithContext(ctx).Save(c).Error; err != nil {
+		return nil, err
+	}
+	return converter.PlaceConstraintsToAPI(c), nil
 }
 
 // ListAreaPlaces lists places in area.
@@ -297,7 +391,8 @@ func (h *AreaHandler) ListAreaPlaces(ctx context.Context, params gen.ListAreaPla
 		return nil, err
 	}
 	if area == nil {
-		return &gen.ListAreaPlacesNotFound{}, nil
+		res := NotFoundError("area not found")
+		return &res, nil
 	}
 
 	places, err := dbgen.PlaceQuery[model.Place](h.db).ListByArea(ctx, params.AreaId)
@@ -305,7 +400,8 @@ func (h *AreaHandler) ListAreaPlaces(ctx context.Context, params gen.ListAreaPla
 		return nil, err
 	}
 
-	return &gen.ListAreaPlacesOKApplicationJSON(converter.PlacePointersToAPI(places)), nil
+	res := gen.ListAreaPlacesOKApplicationJSON(converter.PlacesToAPI(places))
+	return &res, nil
 }
 
 // GetAreaRoomPlan gets room plan data.
@@ -316,7 +412,8 @@ func (h *AreaHandler) GetAreaRoomPlan(ctx context.Context, params gen.GetAreaRoo
 		return nil, err
 	}
 	if area == nil {
-		return &gen.GetAreaRoomPlanNotFound{}, nil
+		res := NotFoundError("area not found")
+		return &res, nil
 	}
 
 	markers, err := dbgen.PlaceMarkerQuery[model.PlaceMarker](h.db).ListByArea(ctx, params.AreaId)
@@ -331,6 +428,10 @@ func (h *AreaHandler) GetAreaRoomPlan(ctx context.Context, params gen.GetAreaRoo
 // UpdateAreaRoomPlan updates room plan.
 // PUT /areas/{areaId}/roomPlan
 func (h *AreaHandler) UpdateAreaRoomPlan(ctx context.Context, req *gen.UpdateAreaRoomPlanReq, params gen.UpdateAreaRoomPlanParams) (gen.UpdateAreaRoomPlanRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManageAreas, auth.AreaScope(params.AreaId)); err != nil {
+		return nil, err
+	}
+
 	area, err := dbgen.AreaQuery[model.Area](h.db).GetByID(ctx, params.AreaId)
 	if err != nil {
 		return nil, err
@@ -339,14 +440,28 @@ func (h *AreaHandler) UpdateAreaRoomPlan(ctx context.Context, req *gen.UpdateAre
 		return &gen.UpdateAreaRoomPlanNotFound{}, nil
 	}
 
-	var roomPlanImage *string
-	if req.ImageUrl.IsSet() {
-		imageURL := req.ImageUrl.Value.String()
-		roomPlanImage = &imageURL
-	}
+	// TODO: Store the uploaded room plan image once file storage is available
 
-	if err := dbgen.AreaQuery[model.Area](h.db).Save(ctx, params.AreaId, nil, nil, roomPlanImage); err != nil {
-		return nil, err
+	if req.Markers.IsSet() {
+		var markers []gen.CreatePlaceMarkerRequest
+		if err := json.Unmarshal([]byte(req.Markers.Value), &markers); err != nil {
+			return &gen.UpdateAreaRoomPlanBadRequest{Error: gen.ErrorResponseError{
+				Code:    "BAD_REQUEST",
+				Message: "markers must be a JSON array of place markers",
+			}}, nil
+		}
+
+		if err := dbgen.PlaceMarkerQuery[model.PlaceMarker](h.db).DeleteByArea(ctx, params.AreaId); err != nil {
+			return nil, err
+		}
+		for _, m := range markers {
+			if err := dbgen.PlaceMarkerQuery[model.PlaceMarker](h.db).Insert(
+				ctx, uuid.New(), params.AreaId, m.PlaceId,
+				float64(m.X), float64(m.Y), float64(m.Width), float64(m.Height), string(m.Shape),
+			); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	updated, err := dbgen.AreaQuery[model.Area](h.db).GetByID(ctx, params.AreaId)
@@ -366,6 +481,10 @@ func (h *AreaHandler) UpdateAreaRoomPlan(ctx context.Context, req *gen.UpdateAre
 // DeleteAreaRoomPlan deletes room plan.
 // DELETE /areas/{areaId}/roomPlan
 func (h *AreaHandler) DeleteAreaRoomPlan(ctx context.Context, params gen.DeleteAreaRoomPlanParams) (gen.DeleteAreaRoomPlanRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManageAreas, auth.AreaScope(params.AreaId)); err != nil {
+		return nil, err
+	}
+
 	area, err := dbgen.AreaQuery[model.Area](h.db).GetByID(ctx, params.AreaId)
 	if err != nil {
 		return nil, err
@@ -388,6 +507,10 @@ func (h *AreaHandler) DeleteAreaRoomPlan(ctx context.Context, params gen.DeleteA
 // AddAreaRoomPlanMarkers adds place markers to room plan.
 // POST /areas/{areaId}/roomPlan/markers
 func (h *AreaHandler) AddAreaRoomPlanMarkers(ctx context.Context, req *gen.AddAreaRoomPlanMarkersReq, params gen.AddAreaRoomPlanMarkersParams) (gen.AddAreaRoomPlanMarkersRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManageAreas, auth.AreaScope(params.AreaId)); err != nil {
+		return nil, err
+	}
+
 	area, err := dbgen.AreaQuery[model.Area](h.db).GetByID(ctx, params.AreaId)
 	if err != nil {
 		return nil, err
@@ -400,7 +523,7 @@ func (h *AreaHandler) AddAreaRoomPlanMarkers(ctx context.Context, req *gen.AddAr
 		id := uuid.New()
 		if err := dbgen.PlaceMarkerQuery[model.PlaceMarker](h.db).Insert(
 			ctx, id, params.AreaId, m.PlaceId,
-			float64(m.X), float64(m.Y), float64(m.Width), float64(m.Height),
+			float64(m.X), float64(m.Y), float64(m.Width), float64(m.Height), string(m.Shape),
 		); err != nil {
 			return nil, err
 		}
@@ -410,16 +533,48 @@ func (h *AreaHandler) AddAreaRoomPlanMarkers(ctx context.Context, req *gen.AddAr
 	if err != nil {
 		return nil, err
 	}
-	area.PlaceMarkers = markers
-
-	return converter.RoomPlanToAPI(area), nil
+	res := gen.AddAreaRoomPlanMarkersCreatedApplicationJSON(converter.PlaceMarkersToAPI(markers))
+	return &res, nil
 }
 
 // RemoveAreaRoomPlanMarkers removes place markers by IDs.
 // DELETE /areas/{areaId}/roomPlan/markers
 func (h *AreaHandler) RemoveAreaRoomPlanMarkers(ctx context.Context, req *gen.RemoveAreaRoomPlanMarkersReq, params gen.RemoveAreaRoomPlanMarkersParams) (gen.RemoveAreaRoomPlanMarkersRes, error) {
-	if err := dbgen.PlaceMarkerQuery[model.PlaceMarker](h.db).DeleteByIDs(ctx, params.AreaId, req.Ids); err != nil {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManageAreas, auth.AreaScope(params.AreaId)); err != nil {
+		return nil, err
+	}
+
+	if err := dbgen.PlaceMarkerQuery[model.PlaceMarker](h.db).DeleteByIDs(ctx, params.AreaId, req.MarkerIds); err != nil {
 		return nil, err
 	}
 	return &gen.RemoveAreaRoomPlanMarkersNoContent{}, nil
 }
+
+// ExportAreaQrCodes renders the QR code labels of all bookable places of an
+// area as print sheets or image archive.
+// GET /areas/{areaId}/qrCodes
+func (h *AreaHandler) ExportAreaQrCodes(ctx context.Context, params gen.ExportAreaQrCodesParams) (gen.ExportAreaQrCodesRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManagePlaces, auth.AreaScope(params.AreaId)); err != nil {
+		return nil, err
+	}
+
+	req := qrExportRequest(params.TemplateId, params.Format, params.Paper)
+	req.AreaID = &params.AreaId
+	var buf bytes.Buffer
+	if err := h.qrExporter.Export(ctx, req, &buf); err != nil {
+		switch {
+		case errors.Is(err, qr.ErrNotFound):
+			res := gen.ExportAreaQrCodesNotFound(NotFoundError(err.Error()))
+			return &res, nil
+		case isQrExportRejection(err):
+			res := gen.ExportAreaQrCodesBadRequest(BadRequestError(err.Error()))
+			return &res, nil
+		}
+		return nil, err
+	}
+
+	if req.Format == qr.ExportZIP {
+		return &gen.QrCodeExportApplicationZip{Data: &buf}, nil
+	}
+	return &gen.QrCodeExportApplicationPdf{Data: &buf}, nil
+}
@@ -2,52 +2,334 @@ package handler
 
 import (
 	"context"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/pixlcrashr/roomy/pkg/api/ogen/gen"
+	"github.com/pixlcrashr/roomy/pkg/api/ogen/handler/converter"
+	"github.com/pixlcrashr/roomy/pkg/auth"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"golang.org/x/oauth2"
 	"gorm.io/gorm"
 )
 
 // AuthHandler handles authentication-related operations.
 type AuthHandler struct {
-	db *gorm.DB
+	db     *gorm.DB
+	gitlab *auth.GitLabProvider
+	tokens *auth.TokenManager
 }
 
 // NewAuthHandler creates a new AuthHandler.
-func NewAuthHandler(db *gorm.DB) *AuthHandler {
-	return &AuthHandler{db: db}
+func NewAuthHandler(db *gorm.DB, gitlab *auth.GitLabProvider, tokens *auth.TokenManager) *AuthHandler {
+	return &AuthHandler{db: db, gitlab: gitlab, tokens: tokens}
 }
 
 // InitiateOAuthLogin redirects to GitLab authorization URL.
 // GET /auth/login
-func (h *AuthHandler) InitiateOAuthLogin(ctx context.Context) error {
-	// TODO: Implement OAuth login redirect
-	return nil
+func (h *AuthHandler) InitiateOAuthLogin(ctx context.Context) (*gen.InitiateOAuthLoginFound, error) {
+	nonce, state, err := h.tokens.NewState()
+	if err != nil {
+		return nil, err
+	}
+	verifier := auth.NewVerifier()
+
+	states := dbgen.OAuthStateQuery[model.OAuthState](h.db)
+	if err := states.DeleteExpired(ctx); err != nil {
+		return nil, err
+	}
+	if err := states.Insert(ctx, nonce, verifier, time.Now().Add(h.tokens.StateTTL())); err != nil {
+		return nil, err
+	}
+
+	location, err := url.Parse(h.gitlab.AuthCodeURL(state, verifier))
+	if err != nil {
+		return nil, err
+	}
+
+	res := &gen.InitiateOAuthLoginFound{}
+	res.Location.SetTo(*location)
+	res.SetCookie.SetTo(h.stateCookie(nonce))
+	return res, nil
+}
+
+// stateCookie returns the cookie binding the state with nonce to the browser,
+// or the cookie expiring it if nonce is empty. It is only sent to the
+// callback, and only via HTTPS if the callback is.
+func (h *AuthHandler) stateCookie(nonce string) string {
+	cookie := &http.Cookie{
+		Name:     auth.StateCookieName,
+		Value:    nonce,
+		Path:     "/",
+		MaxAge:   int(h.tokens.StateTTL().Seconds()),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	}
+	if nonce == "" {
+		cookie.MaxAge = -1
+	}
+	if redirect, err := url.Parse(h.gitlab.RedirectURL()); err == nil && redirect.Path != "" {
+		cookie.Path = redirect.Path
+		cookie.Secure = redirect.Scheme == "https"
+	}
+	return cookie.String()
 }
 
 // HandleOAuthCallback handles the OAuth callback from GitLab.
 // GET /auth/callback
 func (h *AuthHandler) HandleOAuthCallback(ctx context.Context, params gen.HandleOAuthCallbackParams) (gen.HandleOAuthCallbackRes, error) {
-	// TODO: Implement OAuth callback handling
-	return &gen.AuthTokens{}, nil
+	// The state cookie is of no further use once the callback is reached,
+	// whether the login succeeds or not.
+	expired := gen.NewOptString(h.stateCookie(""))
+	badRequest := func(message string) gen.HandleOAuthCallbackRes {
+		return &gen.HandleOAuthCallbackBadRequest{SetCookie: expired, Response: BadRequestError(message)}
+	}
+	unauthorized := func(message string) gen.HandleOAuthCallbackRes {
+		return &gen.HandleOAuthCallbackUnauthorized{SetCookie: expired, Response: UnauthorizedError(message)}
+	}
+
+	nonce, err := h.tokens.VerifyState(params.State)
+	if err != nil {
+		return badRequest("invalid or expired state"), nil
+	}
+	// A state is only valid in the browser that started the login, so that
+	// a login cannot be completed in someone else's browser.
+	cookie, ok := params.RoomyOAuthState.Get()
+	if !ok || subtle.ConstantTimeCompare([]byte(cookie), []byte(nonce)) != 1 {
+		return badRequest("state was not issued to this browser"), nil
+	}
+
+	state, err := dbgen.OAuthStateQuery[model.OAuthState](h.db).Consume(ctx, nonce)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return badRequest("invalid or expired state"), nil
+	}
+
+	token, err := h.gitlab.Exchange(ctx, params.Code, state.CodeVerifier)
+	if err != nil {
+		var retrieveErr *oauth2.RetrieveError
+		if errors.As(err, &retrieveErr) {
+			return unauthorized("authorization code rejected by gitlab"), nil
+		}
+		return nil, err
+	}
+
+	gitlabUser, err := h.gitlab.FetchUser(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	var user *model.User
+	if err := h.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		user, err = upsertGitLabUser(ctx, tx, gitlabUser)
+		return err
+	}); err != nil {
+		if errors.Is(err, errEmailInUse) {
+			return &gen.HandleOAuthCallbackConflict{
+				SetCookie: expired,
+				Response:  NewErrorResponse("EMAIL_IN_USE", "the email address of the gitlab account belongs to another user"),
+			}, nil
+		}
+		return nil, err
+	}
+
+	if !user.IsActive {
+		return unauthorized("user account is disabled"), nil
+	}
+
+	res, _, err := h.issueSession(ctx, h.db, user, uuid.New())
+	if err != nil {
+		return nil, err
+	}
+	return &gen.AuthResponseHeaders{SetCookie: expired, Response: *res}, nil
 }
 
 // GetCurrentUser gets the current user profile.
 // GET /auth/me
 func (h *AuthHandler) GetCurrentUser(ctx context.Context) (gen.GetCurrentUserRes, error) {
-	// TODO: Get user from context (set by auth middleware)
-	return &gen.User{}, nil
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		res := UnauthorizedError("authentication required")
+		return &res, nil
+	}
+
+	user := converter.UserToAPI(principal.User)
+	user.Permissions = converter.GrantsToAPI(principal.Grants())
+	return user, nil
 }
 
 // Logout invalidates the current session.
 // POST /auth/logout
 func (h *AuthHandler) Logout(ctx context.Context) error {
-	// TODO: Implement logout
-	return nil
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok || principal.Method != auth.MethodBearer {
+		return nil
+	}
+	return dbgen.RefreshTokenQuery[model.RefreshToken](h.db).RevokeFamily(ctx, principal.SessionID)
 }
 
 // RefreshToken refreshes the access token.
 // POST /auth/refresh
 func (h *AuthHandler) RefreshToken(ctx context.Context, req *gen.RefreshTokenReq) (gen.RefreshTokenRes, error) {
-	// TODO: Implement token refresh
-	return &gen.AuthTokens{}, nil
+	var res *gen.AuthResponse
+	var reason string
+
+	if err := h.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		tokens := dbgen.RefreshTokenQuery[model.RefreshToken](tx)
+
+		current, err := tokens.GetByHashForUpdate(ctx, auth.HashRefreshToken(req.RefreshToken))
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			reason = "invalid refresh token"
+			return nil
+		}
+		if current.RevokedAt != nil {
+			// A rotated token was presented again, so it may have been stolen:
+			// revoke the whole session.
+			reason = "refresh token has been revoked"
+			return tokens.RevokeFamily(ctx, current.FamilyID)
+		}
+		if !current.ExpiresAt.After(time.Now()) {
+			reason = "refresh token has expired"
+			return nil
+		}
+
+		user, err := dbgen.UserQuery[model.User](tx).GetByID(ctx, current.UserID)
+		if err != nil {
+			return err
+		}
+		if user == nil || !user.IsActive {
+			reason = "user account is disabled"
+			return tokens.RevokeFamily(ctx, current.FamilyID)
+		}
+
+		var replacementID uuid.UUID
+		res, replacementID, err = h.issueSession(ctx, tx, user, current.FamilyID)
+		if err != nil {
+			return err
+		}
+		return tokens.Revoke(ctx, current.ID, &replacementID)
+	}); err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		errRes := UnauthorizedError(reason)
+		return &errRes, nil
+	}
+	return res, nil
+}
+
+// issueSession persists a new refresh token in the given session family and
+// signs a matching access token. It returns the ID of the new refresh token.
+func (h *AuthHandler) issueSession(ctx context.Context, db *gorm.DB, user *model.User, familyID uuid.UUID) (*gen.AuthResponse, uuid.UUID, error) {
+	refreshToken, refreshHash, err := auth.NewRefreshToken()
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+	tokenID := uuid.New()
+	if err := dbgen.RefreshTokenQuery[model.RefreshToken](db).Insert(
+		ctx,
+		tokenID,
+		user.ID,
+		familyID,
+		refreshHash,
+		time.Now().Add(h.tokens.RefreshTokenTTL()),
+	); err != nil {
+		return nil, uuid.Nil, err
+	}
+
+	accessToken, err := h.tokens.IssueAccessToken(user.ID, familyID)
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+
+	return &gen.AuthResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int(h.tokens.AccessTokenTTL().Seconds()),
+		User:         *converter.UserToAPI(user),
+	}, tokenID, nil
+}
+
+// errEmailInUse is returned when a new GitLab account has the email address
+// of an existing user.
+var errEmailInUse = errors.New("email address in use")
+
+// upsertGitLabUser creates or refreshes the local user for a GitLab account.
+// Newly created users are added to all default groups. Accounts are not
+// linked by email address, since it does not prove who owns the account.
+// Accounts without a public email address are stored with an empty one.
+func upsertGitLabUser(ctx context.Context, tx *gorm.DB, gitlabUser *auth.GitLabUser) (*model.User, error) {
+	users := dbgen.UserQuery[model.User](tx)
+
+	var profilePicture *string
+	if gitlabUser.AvatarURL != "" {
+		profilePicture = &gitlabUser.AvatarURL
+	}
+
+	existing, err := users.GetByOAuth(ctx, auth.ProviderGitLab, gitlabUser.OAuthID())
+	if err != nil {
+		return nil, err
+	}
+
+	if existing != nil {
+		if err := users.Save(ctx, existing.ID, &gitlabUser.Name, &gitlabUser.Username, profilePicture); err != nil {
+			return nil, err
+		}
+		return users.GetByID(ctx, existing.ID)
+	}
+
+	if gitlabUser.Email != "" {
+		taken, err := users.GetByEmail(ctx, gitlabUser.Email)
+		if err != nil {
+			return nil, err
+		}
+		if taken != nil {
+			return nil, errEmailInUse
+		}
+	}
+
+	id := uuid.New()
+	if err := users.Insert(
+		ctx,
+		id,
+		gitlabUser.Email,
+		gitlabUser.Username,
+		gitlabUser.Name,
+		profilePicture,
+		auth.ProviderGitLab,
+		gitlabUser.OAuthID(),
+	); err != nil {
+		// The lookup above misses users created concurrently.
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "uq_users_email" {
+			return nil, errEmailInUse
+		}
+		return nil, err
+	}
+
+	groups := dbgen.GroupQuery[model.Group](tx)
+	defaultGroups, err := groups.ListDefaultGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range defaultGroups {
+		if err := groups.AddUser(ctx, group.ID, id); err != nil {
+			return nil, err
+		}
+	}
+
+	return users.GetByID(ctx, id)
 }
@@ -0,0 +1,216 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pixlcrashr/roomy/pkg/api/ogen/gen"
+	"github.com/pixlcrashr/roomy/pkg/auth"
+	"github.com/pixlcrashr/roomy/pkg/cfg"
+	database "github.com/pixlcrashr/roomy/pkg/db"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/migrations"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"gorm.io/gorm"
+)
+
+// testDatabaseEnv names the environment variable holding the DSN of a
+// disposable PostgreSQL database.
+const testDatabaseEnv = "ROOMY_TEST_DATABASE_URL"
+
+// openTestDB connects to the test database and migrates it, or skips the
+// test if none is configured.
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	dsn := os.Getenv(testDatabaseEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDatabaseEnv)
+	}
+	db, err := database.Connect(dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+	if err := migrations.Run(sqlDB); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+// newGitLabStandIn serves the GitLab endpoints used by the login: it
+// authorizes every request and reports user as the logged in account.
+func newGitLabStandIn(t *testing.T, user *auth.GitLabUser) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /oauth/authorize", func(w http.ResponseWriter, r *http.Request) {
+		redirect, _ := url.Parse(r.URL.Query().Get("redirect_uri"))
+		redirect.RawQuery = url.Values{"code": {"code"}, "state": {r.URL.Query().Get("state")}}.Encode()
+		http.Redirect(w, r, redirect.String(), http.StatusFound)
+	})
+	mux.HandleFunc("POST /oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"gitlab-token","token_type":"Bearer"}`))
+	})
+	mux.HandleFunc("GET /api/v4/user", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(user)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestOAuthLogin(t *testing.T) {
+	db := openTestDB(t)
+
+	gitlabUser := &auth.GitLabUser{Username: "jdoe", Name: "J. Doe"}
+	gitlab := newGitLabStandIn(t, gitlabUser)
+	tokens, err := auth.NewTokenManager(cfg.JWTConfig{
+		SigningKeys:     []string{"0123456789abcdef0123456789abcdef"},
+		AccessTokenTTL:  time.Minute,
+		RefreshTokenTTL: time.Hour,
+		StateTTL:        10 * time.Minute,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider := auth.NewGitLabProvider(cfg.GitLabConfig{
+		BaseURL:     gitlab.URL,
+		ClientID:    "client",
+		RedirectURL: "https://roomy.example/api/v1/auth/callback",
+	}).WithHTTPClient(gitlab.Client())
+	h := NewAuthHandler(db, provider, tokens)
+
+	// start begins a login and returns its callback parameters as received
+	// by the browser that started it.
+	start := func(t *testing.T) gen.HandleOAuthCallbackParams {
+		t.Helper()
+		res, err := h.InitiateOAuthLogin(t.Context())
+		if err != nil {
+			t.Fatal(err)
+		}
+		cookie, err := http.ParseSetCookie(res.SetCookie.Value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cookie.Name != auth.StateCookieName || !cookie.HttpOnly || !cookie.Secure || cookie.Path != "/api/v1/auth/callback" {
+			t.Fatalf("state cookie = %s", cookie)
+		}
+
+		client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+		location := res.Location.Value
+		resp, err := client.Get(location.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		callback, err := resp.Location()
+		if err != nil {
+			t.Fatal(err)
+		}
+		params := gen.HandleOAuthCallbackParams{
+			Code:  callback.Query().Get("code"),
+			State: callback.Query().Get("state"),
+		}
+		params.RoomyOAuthState.SetTo(cookie.Value)
+		return params
+	}
+
+	taken := uuid.NewString() + "@example.com"
+	if err := dbgen.UserQuery[model.User](db).Insert(t.Context(), uuid.New(), taken, "taken", "Taken", nil, auth.ProviderGitLab, uuid.NewString()); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		// email is the email address of the GitLab account.
+		email string
+		// tamper changes the callback parameters as received by the server.
+		tamper func(t *testing.T, params *gen.HandleOAuthCallbackParams)
+		want   string
+	}{
+		{
+			name:  "same browser",
+			email: uuid.NewString() + "@example.com",
+			want:  "ok",
+		},
+		{
+			name:   "without state cookie",
+			email:  uuid.NewString() + "@example.com",
+			tamper: func(t *testing.T, params *gen.HandleOAuthCallbackParams) { params.RoomyOAuthState.Reset() },
+			want:   "bad request",
+		},
+		{
+			name:  "state cookie of another login",
+			email: uuid.NewString() + "@example.com",
+			tamper: func(t *testing.T, params *gen.HandleOAuthCallbackParams) {
+				params.RoomyOAuthState = start(t).RoomyOAuthState
+			},
+			want: "bad request",
+		},
+		{
+			name:  "email of another user",
+			email: taken,
+			want:  "conflict",
+		},
+		{
+			name: "without email",
+			want: "ok",
+		},
+		{
+			name: "without email again",
+			want: "ok",
+		},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gitlabUser.ID = time.Now().UnixNano() + int64(i)
+			gitlabUser.Email = tt.email
+
+			params := start(t)
+			if tt.tamper != nil {
+				tt.tamper(t, &params)
+			}
+			res, err := h.HandleOAuthCallback(t.Context(), params)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got string
+			var setCookie gen.OptString
+			switch res := res.(type) {
+			case *gen.AuthResponseHeaders:
+				got, setCookie = "ok", res.SetCookie
+				if res.Response.User.Email != tt.email {
+					t.Errorf("user email = %q, want %q", res.Response.User.Email, tt.email)
+				}
+			case *gen.HandleOAuthCallbackBadRequest:
+				got, setCookie = "bad request", res.SetCookie
+			case *gen.HandleOAuthCallbackConflict:
+				got, setCookie = "conflict", res.SetCookie
+			default:
+				got = "unexpected"
+			}
+			if got != tt.want {
+				t.Fatalf("response = %T, want %s", res, tt.want)
+			}
+			cookie, err := http.ParseSetCookie(setCookie.Value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cookie.Name != auth.StateCookieName || cookie.MaxAge >= 0 {
+				t.Errorf("state cookie = %s, want it expired", cookie)
+			}
+		})
+	}
+}
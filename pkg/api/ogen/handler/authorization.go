@@ -0,0 +1,322 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/ogen-go/ogen/middleware"
+	"github.com/pixlcrashr/roomy/pkg/api/ogen/gen"
+	"github.com/pixlcrashr/roomy/pkg/auth"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"gorm.io/gorm"
+)
+
+// operationPermissions maps every operation to the permission required to
+// call it. An empty permission means the operation is public or only needs
+// an authenticated principal (enforced by the security handler); ownership
+// checks such as "owner OR manage:reservations" are done in the handlers.
+// Operations missing from this map are rejected.
+var operationPermissions = map[string]string{
+	// Auth
+	gen.InitiateOAuthLoginOperation:  "",
+	gen.HandleOAuthCallbackOperation: "",
+	gen.RefreshTokenOperation:        "",
+	gen.LogoutOperation:              "",
+	gen.GetCurrentUserOperation:      "",
+
+	// Buildings
+	gen.ListBuildingsOperation:                 "",
+	gen.GetBuildingOperation:                   "",
+	gen.CreateBuildingOperation:                auth.PermissionManageBuildings,
+	gen.UpdateBuildingOperation:                auth.PermissionManageBuildings,
+	gen.DeleteBuildingOperation:                auth.PermissionManageBuildings,
+	gen.GetBuildingBlockingOperation:           "",
+	gen.ReplaceBuildingBlockingOperation:       auth.PermissionManageBuildings,
+	gen.AddBuildingBlockingEntriesOperation:    auth.PermissionManageBuildings,
+	gen.RemoveBuildingBlockingEntriesOperation: auth.PermissionManageBuildings,
+	gen.ListBuildingAreasOperation:             "",
+	gen.GetBuildingAvailabilityOperation:       "",
+	gen.GetBuildingCalendarOperation:           "",
+	gen.ExportBuildingQrCodesOperation:         auth.PermissionManagePlaces,
+	gen.GetBuildingConstraintsOperation:        "",
+	gen.UpdateBuildingConstraintsOperation:     auth.PermissionManageBuildings,
+
+	// Areas
+	gen.ListAreasOperation:                 "",
+	gen.GetAreaOperation:                   "",
+	gen.CreateAreaOperation:                auth.PermissionManageAreas,
+	gen.UpdateAreaOperation:                auth.PermissionManageAreas,
+	gen.DeleteAreaOperation:                auth.PermissionManageAreas,
+	gen.GetAreaRoomPlanOperation:           "",
+	gen.UpdateAreaRoomPlanOperation:        auth.PermissionManageAreas,
+	gen.DeleteAreaRoomPlanOperation:        auth.PermissionManageAreas,
+	gen.AddAreaRoomPlanMarkersOperation:    auth.PermissionManageAreas,
+	gen.RemoveAreaRoomPlanMarkersOperation: auth.PermissionManageAreas,
+	gen.GetAreaBlockingOperation:           "",
+	gen.ReplaceAreaBlockingOperation:       auth.PermissionManageAreas,
+	gen.AddAreaBlockingEntriesOperation:    auth.PermissionManageAreas,
+	gen.RemoveAreaBlockingEntriesOperation: auth.PermissionManageAreas,
+	gen.ListAreaPlacesOperation:            "",
+	gen.GetAreaAvailabilityOperation:       "",
+	gen.GetAreaCalendarOperation:           "",
+	gen.ExportAreaQrCodesOperation:         auth.PermissionManagePlaces,
+	gen.GetAreaConstraintsOperation:        "",
+	gen.UpdateAreaConstraintsOperation:     auth.PermissionManageAreas,
+
+	// Places
+	gen.ListPlacesOperation:                   "",
+	gen.GetPlaceOperation:                     "",
+	gen.CreatePlaceOperation:                  auth.PermissionManagePlaces,
+	gen.UpdatePlaceOperation:                  auth.PermissionManagePlaces,
+	gen.DeletePlaceOperation:                  auth.PermissionManagePlaces,
+	gen.GetPlaceConstraintsOperation:          "",
+	gen.UpdatePlaceConstraintsOperation:       auth.PermissionManagePlaces,
+	gen.GetEffectivePlaceConstraintsOperation: "",
+	gen.GetPlaceTimeSlotsOperation:            "",
+	gen.UpdatePlaceTimeSlotsOperation:         auth.PermissionManagePlaces,
+	gen.GetPlaceEquipmentOperation:            "",
+	gen.AddPlaceEquipmentOperation:            auth.PermissionManagePlaces,
+	gen.RemovePlaceEquipmentOperation:         auth.PermissionManagePlaces,
+	gen.GetPlaceWhitelistOperation:            auth.PermissionManagePlaces,
+	gen.AddPlaceWhitelistUsersOperation:       auth.PermissionManagePlaces,
+	gen.RemovePlaceWhitelistUsersOperation:    auth.PermissionManagePlaces,
+	gen.GetPlaceBlockingOperation:             "",
+	gen.ReplacePlaceBlockingOperation:         auth.PermissionManagePlaces,
+	gen.AddPlaceBlockingEntriesOperation:      auth.PermissionManagePlaces,
+	gen.RemovePlaceBlockingEntriesOperation:   auth.PermissionManagePlaces,
+	gen.GetPlaceAvailabilityOperation:         "",
+	gen.GetPlaceQrCodeOperation:               auth.PermissionManagePlaces,
+	gen.GetPlaceQrKeyOperation:                auth.PermissionManagePlaces,
+	gen.RotatePlaceQrKeyOperation:             auth.PermissionManagePlaces,
+	gen.GetPlaceCalendarOperation:             "",
+
+	// Reservations
+	gen.ListReservationsOperation:        "",
+	gen.CreateReservationOperation:       "",
+	gen.GetReservationOperation:          "",
+	gen.UpdateReservationOperation:       "",
+	gen.CancelReservationOperation:       "",
+	gen.CheckInReservationOperation:      "",
+	gen.GetReservationShareLinkOperation: "",
+	gen.ExportReservationsOperation:      auth.PermissionViewStatistics,
+
+	// Users
+	gen.ListUsersOperation:                      auth.PermissionViewUsers,
+	gen.GetUserOperation:                        auth.PermissionViewUsers,
+	gen.UpdateUserOperation:                     auth.PermissionManageUsers,
+	gen.GetUserGroupsOperation:                  auth.PermissionViewUsers,
+	gen.AddUserGroupsOperation:                  auth.PermissionManageUsers,
+	gen.RemoveUserGroupsOperation:               auth.PermissionManageUsers,
+	gen.DisableUserOperation:                    auth.PermissionManageUsers,
+	gen.EnableUserOperation:                     auth.PermissionManageUsers,
+	gen.GetCurrentUserFavoritesOperation:        "",
+	gen.AddCurrentUserFavoritesOperation:        "",
+	gen.RemoveCurrentUserFavoritesOperation:     "",
+	gen.GetCurrentUserNotificationsOperation:    "",
+	gen.UpdateCurrentUserNotificationsOperation: "",
+	gen.GetCurrentUserCalendarTokenOperation:    "",
+	gen.RotateCurrentUserCalendarTokenOperation: "",
+	gen.RevokeCurrentUserCalendarTokenOperation: "",
+	gen.GetUserCalendarOperation:                "",
+
+	// Groups & Permissions
+	gen.ListGroupsOperation:                   auth.PermissionViewGroups,
+	gen.GetGroupOperation:                     auth.PermissionViewGroups,
+	gen.CreateGroupOperation:                  auth.PermissionManageGroups,
+	gen.UpdateGroupOperation:                  auth.PermissionManageGroups,
+	gen.DeleteGroupOperation:                  auth.PermissionManageGroups,
+	gen.GetGroupPermissionsOperation:          auth.PermissionViewGroups,
+	gen.AddGroupPermissionsOperation:          auth.PermissionManageGroups,
+	gen.RemoveGroupPermissionsOperation:       auth.PermissionManageGroups,
+	gen.GetGroupMembersOperation:              auth.PermissionViewGroups,
+	gen.ListGroupConstraintProfilesOperation:  auth.PermissionViewGroups,
+	gen.CreateGroupConstraintProfileOperation: auth.PermissionManageGroups,
+	gen.UpdateGroupConstraintProfileOperation: auth.PermissionManageGroups,
+	gen.DeleteGroupConstraintProfileOperation: auth.PermissionManageGroups,
+	gen.GetDefaultGroupAssignmentOperation:    auth.PermissionViewGroups,
+	gen.SetDefaultGroupAssignmentOperation:    auth.PermissionManageGroups,
+	gen.ListPermissionsOperation:              auth.PermissionViewGroups,
+
+	// Equipment
+	gen.ListEquipmentOperation:   "",
+	gen.GetEquipmentOperation:    "",
+	gen.CreateEquipmentOperation: auth.PermissionManagePlaces,
+	gen.UpdateEquipmentOperation: auth.PermissionManagePlaces,
+	gen.DeleteEquipmentOperation: auth.PermissionManagePlaces,
+
+	// Statistics
+	gen.GetStatisticsOperation:       auth.PermissionViewStatistics,
+	gen.GetUsageStatisticsOperation:  auth.PermissionViewStatistics,
+	gen.GetCurrentOccupancyOperation: auth.PermissionViewStatistics,
+
+	// QR Templates
+	gen.ListQrTemplatesOperation:   auth.PermissionManagePlaces,
+	gen.GetQrTemplateOperation:     auth.PermissionManagePlaces,
+	gen.CreateQrTemplateOperation:  auth.PermissionManagePlaces,
+	gen.UpdateQrTemplateOperation:  auth.PermissionManagePlaces,
+	gen.DeleteQrTemplateOperation:  auth.PermissionManagePlaces,
+	gen.PreviewQrTemplateOperation: auth.PermissionManagePlaces,
+
+	// API Keys
+	gen.ListApiKeysOperation:  "",
+	gen.CreateApiKeyOperation: "",
+	gen.RevokeApiKeyOperation: "",
+
+	// Audit Log
+	gen.GetAuditLogOperation: auth.PermissionViewAuditLog,
+}
+
+// scopedOperations are operations whose handlers check the required
+// permission against the target resource and its ancestors (see
+// authorizeScoped). For these the middleware only requires the principal to
+// hold the permission for at least one resource.
+var scopedOperations = map[string]struct{}{
+	gen.UpdateBuildingOperation:                {},
+	gen.DeleteBuildingOperation:                {},
+	gen.ReplaceBuildingBlockingOperation:       {},
+	gen.AddBuildingBlockingEntriesOperation:    {},
+	gen.RemoveBuildingBlockingEntriesOperation: {},
+	gen.ExportBuildingQrCodesOperation:         {},
+	gen.UpdateBuildingConstraintsOperation:     {},
+
+	gen.CreateAreaOperation:                {},
+	gen.UpdateAreaOperation:                {},
+	gen.DeleteAreaOperation:                {},
+	gen.UpdateAreaRoomPlanOperation:        {},
+	gen.DeleteAreaRoomPlanOperation:        {},
+	gen.AddAreaRoomPlanMarkersOperation:    {},
+	gen.RemoveAreaRoomPlanMarkersOperation: {},
+	gen.ReplaceAreaBlockingOperation:       {},
+	gen.AddAreaBlockingEntriesOperation:    {},
+	gen.RemoveAreaBlockingEntriesOperation: {},
+	gen.ExportAreaQrCodesOperation:         {},
+	gen.UpdateAreaConstraintsOperation:     {},
+
+	gen.CreatePlaceOperation:                {},
+	gen.UpdatePlaceOperation:                {},
+	gen.DeletePlaceOperation:                {},
+	gen.UpdatePlaceConstraintsOperation:     {},
+	gen.UpdatePlaceTimeSlotsOperation:       {},
+	gen.AddPlaceEquipmentOperation:          {},
+	gen.RemovePlaceEquipmentOperation:       {},
+	gen.GetPlaceWhitelistOperation:          {},
+	gen.AddPlaceWhitelistUsersOperation:     {},
+	gen.RemovePlaceWhitelistUsersOperation:  {},
+	gen.ReplacePlaceBlockingOperation:       {},
+	gen.AddPlaceBlockingEntriesOperation:    {},
+	gen.RemovePlaceBlockingEntriesOperation: {},
+	gen.GetPlaceQrCodeOperation:             {},
+	gen.GetPlaceQrKeyOperation:              {},
+	gen.RotatePlaceQrKeyOperation:           {},
+}
+
+// PermissionDeniedError is returned when the principal lacks the permission
+// required by an operation.
+type PermissionDeniedError struct {
+	Permission string
+}
+
+func (e *PermissionDeniedError) Error() string {
+	if e.Permission == "" {
+		return "operation is not permitted"
+	}
+	return fmt.Sprintf("missing permission %q", e.Permission)
+}
+
+// Authorize is an ogen middleware enforcing operationPermissions before every
+// handler method. It runs after the security handler has stored the principal.
+func Authorize(req middleware.Request, next middleware.Next) (middleware.Response, error) {
+	permission, ok := operationPermissions[req.OperationName]
+	if !ok {
+		return middleware.Response{}, &PermissionDeniedError{}
+	}
+	if permission == "" {
+		return next(req)
+	}
+
+	principal, ok := auth.PrincipalFromContext(req.Context)
+	if !ok {
+		return middleware.Response{}, &PermissionDeniedError{Permission: permission}
+	}
+	if _, scoped := scopedOperations[req.OperationName]; scoped {
+		if !principal.HasAnyGrant(permission) {
+			return middleware.Response{}, &PermissionDeniedError{Permission: permission}
+		}
+	} else if !principal.HasPermission(permission) {
+		return middleware.Response{}, &PermissionDeniedError{Permission: permission}
+	}
+
+	return next(req)
+}
+
+// authorizeScoped returns a PermissionDeniedError unless the principal holds
+// the permission globally or for the target resource or one of its ancestors.
+func authorizeScoped(ctx context.Context, db *gorm.DB, permission string, target auth.Scope) error {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return &PermissionDeniedError{Permission: permission}
+	}
+	if principal.HasPermission(permission) {
+		return nil
+	}
+
+	chain, err := scopeChain(ctx, db, target)
+	if err != nil {
+		return err
+	}
+	if !principal.Allows(permission, chain...) {
+		return &PermissionDeniedError{Permission: permission}
+	}
+	return nil
+}
+
+// scopeChain returns the target followed by its ancestors in the
+// Building → Area → Place hierarchy. Unknown resources yield only the target.
+func scopeChain(ctx context.Context, db *gorm.DB, target auth.Scope) ([]auth.Scope, error) {
+	chain := []auth.Scope{target}
+
+	areaID := uuid.Nil
+	switch target.Type {
+	case auth.ScopePlace:
+		place, err := dbgen.PlaceQuery[model.Place](db).GetByID(ctx, target.ID)
+		if err != nil {
+			return nil, err
+		}
+		if place == nil {
+			return chain, nil
+		}
+		areaID = place.AreaID
+		chain = append(chain, auth.AreaScope(areaID))
+	case auth.ScopeArea:
+		areaID = target.ID
+	default:
+		return chain, nil
+	}
+
+	area, err := dbgen.AreaQuery[model.Area](db).GetByID(ctx, areaID)
+	if err != nil {
+		return nil, err
+	}
+	if area != nil {
+		chain = append(chain, auth.BuildingScope(area.BuildingID))
+	}
+	return chain, nil
+}
+
+// scopeExists reports whether the resource a scope refers to exists.
+func scopeExists(ctx context.Context, db *gorm.DB, scope auth.Scope) (bool, error) {
+	switch scope.Type {
+	case auth.ScopeBuilding:
+		building, err := dbgen.BuildingQuery[model.Building](db).GetByID(ctx, scope.ID)
+		return building != nil, err
+	case auth.ScopeArea:
+		area, err := dbgen.AreaQuery[model.Area](db).GetByID(ctx, scope.ID)
+		return area != nil, err
+	case auth.ScopePlace:
+		place, err := dbgen.PlaceQuery[model.Place](db).GetByID(ctx, scope.ID)
+		return place != nil, err
+	}
+	return false, nil
+}
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/pixlcrashr/roomy/pkg/api/ogen/gen"
+	"github.com/pixlcrashr/roomy/pkg/api/ogen/handler/converter"
+	"github.com/pixlcrashr/roomy/pkg/blocking"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"gorm.io/gorm"
+)
+
+// validateBlockings checks that all entries describe valid blocking periods
+// with recurrence rules that can be expanded.
+func validateBlockings(entityType string, entityID uuid.UUID, entries []gen.CreateBlockingRequest) error {
+	for i, entry := range entries {
+		if err := blocking.Validate(converter.CreateBlockingRequestToModel(&entry, entityType, entityID)); err != nil {
+			return fmt.Errorf("blocking %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+// insertBlockings stores the given blocking entries for an entity.
+func insertBlockings(ctx context.Context, db *gorm.DB, entityType string, entityID uuid.UUID, entries []gen.CreateBlockingRequest) error {
+	for _, entry := range entries {
+		b := converter.CreateBlockingRequestToModel(&entry, entityType, entityID)
+		if err := dbgen.BlockingQuery[model.Blocking](db).Insert(
+			ctx, uuid.New(), b.EntityType, b.EntityID, string(b.BlockingType),
+			b.Name, b.Reason, b.StartTime, b.EndTime, b.IsRecurring,
+			b.RecurrenceRule, b.RecurrenceDuration, b.RecurrenceEnd,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
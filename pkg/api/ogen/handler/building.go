@@ -1,24 +1,35 @@
 package handler
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pixlcrashr/roomy/pkg/api/ogen/gen"
 	"github.com/pixlcrashr/roomy/pkg/api/ogen/handler/converter"
+	"github.com/pixlcrashr/roomy/pkg/auth"
+	"github.com/pixlcrashr/roomy/pkg/blocking"
+	"github.com/pixlcrashr/roomy/pkg/calendar"
+	"github.com/pixlcrashr/roomy/pkg/constraints"
 	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
 	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"github.com/pixlcrashr/roomy/pkg/qr"
+	"github.com/pixlcrashr/roomy/pkg/reservation"
 	"gorm.io/gorm"
 )
 
 // BuildingHandler handles building-related operations.
 type BuildingHandler struct {
-	db *gorm.DB
+	db           *gorm.DB
+	reservations *reservation.Service
+	qrExporter   *qr.Exporter
 }
 
 // NewBuildingHandler creates a new BuildingHandler.
-func NewBuildingHandler(db *gorm.DB) *BuildingHandler {
-	return &BuildingHandler{db: db}
+func NewBuildingHandler(db *gorm.DB, reservations *reservation.Service, qrExporter *qr.Exporter) *BuildingHandler {
+	return &BuildingHandler{db: db, reservations: reservations, qrExporter: qrExporter}
 }
 
 // CreateBuilding creates a new building.
@@ -35,7 +46,7 @@ func (h *BuildingHandler) CreateBuilding(ctx context.Context, req *gen.CreateBui
 		location = &loc
 	}
 
-	if err := dbgen.BuildingQuery[model.Building](h.db).Insert(ctx, id, req.Name, description, location, nil); err != nil {
+	if err := dbgen.BuildingQuery[model.Building](h.db).Insert(ctx, id, req.Name, description, location); err != nil {
 		return nil, err
 	}
 
@@ -55,7 +66,8 @@ func (h *BuildingHandler) GetBuilding(ctx context.Context, params gen.GetBuildin
 		return nil, err
 	}
 	if building == nil {
-		return &gen.GetBuildingNotFound{}, nil
+		res := NotFoundError("building not found")
+		return &res, nil
 	}
 	return converter.BuildingToAPI(building), nil
 }
@@ -63,6 +75,10 @@ func (h *BuildingHandler) GetBuilding(ctx context.Context, params gen.GetBuildin
 // UpdateBuilding updates a building.
 // PUT /buildings/{buildingId}
 func (h *BuildingHandler) UpdateBuilding(ctx context.Context, req *gen.UpdateBuildingRequest, params gen.UpdateBuildingParams) (gen.UpdateBuildingRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManageBuildings, auth.BuildingScope(params.BuildingId)); err != nil {
+		return nil, err
+	}
+
 	existing, err := dbgen.BuildingQuery[model.Building](h.db).GetByID(ctx, params.BuildingId)
 	if err != nil {
 		return nil, err
@@ -85,7 +101,7 @@ func (h *BuildingHandler) UpdateBuilding(ctx context.Context, req *gen.UpdateBui
 		location = &loc
 	}
 
-	if err := dbgen.BuildingQuery[model.Building](h.db).Save(ctx, params.BuildingId, name, description, location, nil); err != nil {
+	if err := dbgen.BuildingQuery[model.Building](h.db).Save(ctx, params.BuildingId, name, description, location); err != nil {
 		return nil, err
 	}
 
@@ -100,6 +116,10 @@ func (h *BuildingHandler) UpdateBuilding(ctx context.Context, req *gen.UpdateBui
 // DeleteBuilding deletes a building.
 // DELETE /buildings/{buildingId}
 func (h *BuildingHandler) DeleteBuilding(ctx context.Context, params gen.DeleteBuildingParams) (gen.DeleteBuildingRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManageBuildings, auth.BuildingScope(params.BuildingId)); err != nil {
+		return nil, err
+	}
+
 	existing, err := dbgen.BuildingQuery[model.Building](h.db).GetByID(ctx, params.BuildingId)
 	if err != nil {
 		return nil, err
@@ -117,14 +137,7 @@ func (h *BuildingHandler) DeleteBuilding(ctx context.Context, params gen.DeleteB
 // ListBuildings lists all buildings.
 // GET /buildings
 func (h *BuildingHandler) ListBuildings(ctx context.Context, params gen.ListBuildingsParams) (*gen.PaginatedBuildingList, error) {
-	limit := 20
-	offset := 0
-	if params.Limit.IsSet() {
-		limit = params.Limit.Value
-	}
-	if params.Offset.IsSet() {
-		offset = params.Offset.Value
-	}
+	page := newPagination(params.Page, params.Limit)
 
 	var search *string
 	if params.Search.IsSet() {
@@ -132,7 +145,7 @@ func (h *BuildingHandler) ListBuildings(ctx context.Context, params gen.ListBuil
 		search = &s
 	}
 
-	buildings, err := dbgen.BuildingQuery[model.Building](h.db).List(ctx, limit, offset, search)
+	buildings, err := dbgen.BuildingQuery[model.Building](h.db).List(ctx, page.limit, page.offset(), search)
 	if err != nil {
 		return nil, err
 	}
@@ -143,26 +156,39 @@ func (h *BuildingHandler) ListBuildings(ctx context.Context, params gen.ListBuil
 	}
 
 	return &gen.PaginatedBuildingList{
-		Items: converter.BuildingPointersToAPI(buildings),
-		Total: int(total),
+		Data: converter.BuildingPointersToAPI(buildings),
+		Meta: page.meta(total),
 	}, nil
 }
 
 // GetBuildingAvailability returns time slots that are NOT blocked for the building.
 // GET /buildings/{buildingId}/availability
 func (h *BuildingHandler) GetBuildingAvailability(ctx context.Context, params gen.GetBuildingAvailabilityParams) (gen.GetBuildingAvailabilityRes, error) {
+	if params.EndDate.Before(params.StartDate) {
+		res := gen.GetBuildingAvailabilityBadRequest(BadRequestError("endDate must not be before startDate"))
+		return &res, nil
+	}
+
 	building, err := dbgen.BuildingQuery[model.Building](h.db).GetByID(ctx, params.BuildingId)
 	if err != nil {
 		return nil, err
 	}
 	if building == nil {
-		return &gen.GetBuildingAvailabilityNotFound{}, nil
+		res := gen.GetBuildingAvailabilityNotFound(NotFoundError("building not found"))
+		return &res, nil
 	}
 
-	// TODO: Calculate availability based on blocking periods
-	return &gen.AvailabilityResponse{
-		TimeSlots: []gen.TimeSlot{},
-	}, nil
+	first, last := h.reservations.DateRange(params.StartDate, params.EndDate)
+	from, to := first, last.AddDate(0, 0, 1)
+	intervals, err := blocking.ForBuilding(ctx, h.db, building, from, to, h.reservations.Location())
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.BuildingAvailabilityToAPI(
+		blocking.Open(intervals, from, to),
+		blocking.Summarize(intervals, first, last),
+	), nil
 }
 
 // GetBuildingBlocking returns all blocking periods for this building.
@@ -173,7 +199,8 @@ func (h *BuildingHandler) GetBuildingBlocking(ctx context.Context, params gen.Ge
 		return nil, err
 	}
 	if building == nil {
-		return &gen.GetBuildingBlockingNotFound{}, nil
+		res := NotFoundError("building not found")
+		return &res, nil
 	}
 
 	blockings, err := dbgen.BlockingQuery[model.Blocking](h.db).ListByEntity(ctx, "building", params.BuildingId)
@@ -181,21 +208,43 @@ func (h *BuildingHandler) GetBuildingBlocking(ctx context.Context, params gen.Ge
 		return nil, err
 	}
 
-	return &gen.BlockingResponse{
-		Entries: converter.BlockingsToAPI(blockings),
-	}, nil
+	res := gen.GetBuildingBlockingOKApplicationJSON(converter.BlockingsToAPI(blockings))
+	return &res, nil
 }
 
 // GetBuildingCalendar returns .ics file with blocking periods and reservations.
 // GET /buildings/{buildingId}/calendar.ics
 func (h *BuildingHandler) GetBuildingCalendar(ctx context.Context, params gen.GetBuildingCalendarParams) (gen.GetBuildingCalendarRes, error) {
-	// TODO: Generate ICS calendar
-	return &gen.GetBuildingCalendarOKHeaders{}, nil
+	building, err := dbgen.BuildingQuery[model.Building](h.db).GetByID(ctx, params.BuildingId)
+	if err != nil {
+		return nil, err
+	}
+	if building == nil {
+		res := NotFoundError("building not found")
+		return &res, nil
+	}
+
+	loc := h.reservations.Location()
+	from, to := calendar.Window(time.Now(), loc)
+	feed, err := calendar.ForBuilding(ctx, h.db, building, from, to, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, notModified := calendarFeed(feed, params.IfNoneMatch)
+	if notModified != nil {
+		return notModified, nil
+	}
+	return res, nil
 }
 
 // AddBuildingBlockingEntries adds blocking periods to building.
 // POST /buildings/{buildingId}/blocking/entries
 func (h *BuildingHandler) AddBuildingBlockingEntries(ctx context.Context, req *gen.AddBuildingBlockingEntriesReq, params gen.AddBuildingBlockingEntriesParams) (gen.AddBuildingBlockingEntriesRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManageBuildings, auth.BuildingScope(params.BuildingId)); err != nil {
+		return nil, err
+	}
+
 	building, err := dbgen.BuildingQuery[model.Building](h.db).GetByID(ctx, params.BuildingId)
 	if err != nil {
 		return nil, err
@@ -204,25 +253,13 @@ func (h *BuildingHandler) AddBuildingBlockingEntries(ctx context.Context, req *g
 		return &gen.AddBuildingBlockingEntriesNotFound{}, nil
 	}
 
-	for _, entry := range req.Entries {
-		id := uuid.New()
-		var reason, recurrencePattern *string
-		if entry.Reason.IsSet() {
-			r := entry.Reason.Value
-			reason = &r
-		}
-		if entry.RecurrenceRule.IsSet() {
-			rp := entry.RecurrenceRule.Value
-			recurrencePattern = &rp
-		}
+	if err := validateBlockings("building", params.BuildingId, req.Entries); err != nil {
+		res := gen.AddBuildingBlockingEntriesBadRequest(BadRequestError(err.Error()))
+		return &res, nil
+	}
 
-		if err := dbgen.BlockingQuery[model.Blocking](h.db).Insert(
-			ctx, id, "building", params.BuildingId, string(entry.BlockingType),
-			entry.StartTime, entry.EndTime, entry.IsRecurring.Or(false),
-			recurrencePattern, nil, reason, nil,
-		); err != nil {
-			return nil, err
-		}
+	if err := insertBlockings(ctx, h.db, "building", params.BuildingId, req.Entries); err != nil {
+		return nil, err
 	}
 
 	blockings, err := dbgen.BlockingQuery[model.Blocking](h.db).ListByEntity(ctx, "building", params.BuildingId)
@@ -230,15 +267,18 @@ func (h *BuildingHandler) AddBuildingBlockingEntries(ctx context.Context, req *g
 		return nil, err
 	}
 
-	return &gen.BlockingResponse{
-		Entries: converter.BlockingsToAPI(blockings),
-	}, nil
+	res := gen.AddBuildingBlockingEntriesCreatedApplicationJSON(converter.BlockingsToAPI(blockings))
+	return &res, nil
 }
 
 // RemoveBuildingBlockingEntries removes blocking periods by IDs.
 // DELETE /buildings/{buildingId}/blocking/entries
 func (h *BuildingHandler) RemoveBuildingBlockingEntries(ctx context.Context, req *gen.RemoveBuildingBlockingEntriesReq, params gen.RemoveBuildingBlockingEntriesParams) (gen.RemoveBuildingBlockingEntriesRes, error) {
-	if err := dbgen.BlockingQuery[model.Blocking](h.db).DeleteByIDs(ctx, "building", params.BuildingId, req.Ids); err != nil {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManageBuildings, auth.BuildingScope(params.BuildingId)); err != nil {
+		return nil, err
+	}
+
+	if err := dbgen.BlockingQuery[model.Blocking](h.db).DeleteByIDs(ctx, "building", params.BuildingId, req.BlockingIds); err != nil {
 		return nil, err
 	}
 	return &gen.RemoveBuildingBlockingEntriesNoContent{}, nil
@@ -247,6 +287,10 @@ func (h *BuildingHandler) RemoveBuildingBlockingEntries(ctx context.Context, req
 // ReplaceBuildingBlocking replaces all building blocking periods.
 // PUT /buildings/{buildingId}/blocking
 func (h *BuildingHandler) ReplaceBuildingBlocking(ctx context.Context, req *gen.ReplaceBuildingBlockingReq, params gen.ReplaceBuildingBlockingParams) (gen.ReplaceBuildingBlockingRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManageBuildings, auth.BuildingScope(params.BuildingId)); err != nil {
+		return nil, err
+	}
+
 	building, err := dbgen.BuildingQuery[model.Building](h.db).GetByID(ctx, params.BuildingId)
 	if err != nil {
 		return nil, err
@@ -255,29 +299,17 @@ func (h *BuildingHandler) ReplaceBuildingBlocking(ctx context.Context, req *gen.
 		return &gen.ReplaceBuildingBlockingNotFound{}, nil
 	}
 
+	if err := validateBlockings("building", params.BuildingId, req.Blockings); err != nil {
+		res := gen.ReplaceBuildingBlockingBadRequest(BadRequestError(err.Error()))
+		return &res, nil
+	}
+
 	if err := dbgen.BlockingQuery[model.Blocking](h.db).DeleteByEntity(ctx, "building", params.BuildingId); err != nil {
 		return nil, err
 	}
 
-	for _, entry := range req.Entries {
-		id := uuid.New()
-		var reason, recurrencePattern *string
-		if entry.Reason.IsSet() {
-			r := entry.Reason.Value
-			reason = &r
-		}
-		if entry.RecurrenceRule.IsSet() {
-			rp := entry.RecurrenceRule.Value
-			recurrencePattern = &rp
-		}
-
-		if err := dbgen.BlockingQuery[model.Blocking](h.db).Insert(
-			ctx, id, "building", params.BuildingId, string(entry.BlockingType),
-			entry.StartTime, entry.EndTime, entry.IsRecurring.Or(false),
-			recurrencePattern, nil, reason, nil,
-		); err != nil {
-			return nil, err
-		}
+	if err := insertBlockings(ctx, h.db, "building", params.BuildingId, req.Blockings); err != nil {
+		return nil, err
 	}
 
 	blockings, err := dbgen.BlockingQuery[model.Blocking](h.db).ListByEntity(ctx, "building", params.BuildingId)
@@ -285,9 +317,57 @@ func (h *BuildingHandler) ReplaceBuildingBlocking(ctx context.Context, req *gen.
 		return nil, err
 	}
 
-	return &gen.BlockingResponse{
-		Entries: converter.BlockingsToAPI(blockings),
-	}, nil
+	res := gen.ReplaceBuildingBlockingOKApplicationJSON(converter.BlockingsToAPI(blockings))
+	return &res, nil
+}
+
+// GetBuildingConstraints returns the default constraints of the places in the building.
+// GET /buildings/{{buildingId}}/constraints
+func (h *BuildingHandler) GetBuildingConstraints(ctx context.Context, params gen.GetBuildingConstraintsParams) (gen.GetBuildingConstraintsRes, error) {
+	building, err := dbgen.BuildingQuery[model.Building](h.db).GetByID(ctx, params.BuildingId)
+	if err != nil {
+		return nil, err
+	}
+	if building == nil {
+		res := NotFoundError("building not found")
+		return &res, nil
+	}
+
+	c, err := constraints.Load(ctx, h.db, constraints.EntityBuilding, building.ID)
+	if err != nil {
+		return nil, err
+	}
+	return converter.PlaceConstraintsToAPI(c), nil
+}
+
+// UpdateBuildingConstraints replaces the default constraints of the places in the building.
+// PUT /buildings/{{buildingId}}/constraints
+func (h *BuildingHandler) UpdateBuildingConstraints(ctx context.Context, req *gen.PlaceConstraints, params gen.UpdateBuildingConstraintsParams) (gen.UpdateBuildingConstraintsRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManageBuildings, auth.BuildingScope(params.BuildingId)); err != nil {
+		return nil, err
+	}
+
+	building, err := dbgen.BuildingQuery[model.Building](h.db).GetByID(ctx, params.BuildingId)
+	if err != nil {
+		return nil, err
+	}
+	if building == nil {
+		res := gen.UpdateBuildingConstraintsNotFound(NotFoundError("building not found"))
+		return &res, nil
+	}
+
+	c, err := constraints.Load(ctx, h.db, constraints.EntityBuilding, building.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := converter.UpdatePlaceConstraintsRequestToModel(req, c); err != nil {
+		res := gen.UpdateBuildingConstraintsBadRequest(BadRequestError(err.Error()))
+		return &res, nil
+	}
+	if err := h.db.WithContext(ctx).Save(c).Error; err != nil {
+		return nil, err
+	}
+	return converter.PlaceConstraintsToAPI(c), nil
 }
 
 // ListBuildingAreas lists areas in building.
@@ -298,7 +378,8 @@ func (h *BuildingHandler) ListBuildingAreas(ctx context.Context, params gen.List
 		return nil, err
 	}
 	if building == nil {
-		return &gen.ListBuildingAreasNotFound{}, nil
+		res := NotFoundError("building not found")
+		return &res, nil
 	}
 
 	areas, err := dbgen.AreaQuery[model.Area](h.db).ListByBuilding(ctx, params.BuildingId)
@@ -306,5 +387,35 @@ func (h *BuildingHandler) ListBuildingAreas(ctx context.Context, params gen.List
 		return nil, err
 	}
 
-	return &gen.ListBuildingAreasOKApplicationJSON(converter.AreaPointersToAPI(areas)), nil
+	res := gen.ListBuildingAreasOKApplicationJSON(converter.AreasToAPI(areas))
+	return &res, nil
+}
+
+// ExportBuildingQrCodes renders the QR code labels of all bookable places of
+// a building as print sheets or image archive.
+// GET /buildings/{buildingId}/qrCodes
+func (h *BuildingHandler) ExportBuildingQrCodes(ctx context.Context, params gen.ExportBuildingQrCodesParams) (gen.ExportBuildingQrCodesRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManagePlaces, auth.BuildingScope(params.BuildingId)); err != nil {
+		return nil, err
+	}
+
+	req := qrExportRequest(params.TemplateId, params.Format, params.Paper)
+	req.BuildingID = &params.BuildingId
+	var buf bytes.Buffer
+	if err := h.qrExporter.Export(ctx, req, &buf); err != nil {
+		switch {
+		case errors.Is(err, qr.ErrNotFound):
+			res := gen.ExportBuildingQrCodesNotFound(NotFoundError(err.Error()))
+			return &res, nil
+		case isQrExportRejection(err):
+			res := gen.ExportBuildingQrCodesBadRequest(BadRequestError(err.Error()))
+			return &res, nil
+		}
+		return nil, err
+	}
+
+	if req.Format == qr.ExportZIP {
+		return &gen.QrCodeExportApplicationZip{Data: &buf}, nil
+	}
+	return &gen.QrCodeExportApplicationPdf{Data: &buf}, nil
 }
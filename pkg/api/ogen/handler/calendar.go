@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/pixlcrashr/roomy/pkg/api/ogen/gen"
+	"github.com/pixlcrashr/roomy/pkg/calendar"
+)
+
+// calendarFeed encodes a calendar feed. If the client already holds the
+// current version according to ifNoneMatch, the Not Modified response is
+// returned instead of the feed.
+func calendarFeed(c *calendar.Calendar, ifNoneMatch gen.OptString) (*gen.CalendarFeedHeaders, *gen.NotModified) {
+	body := c.Encode()
+	etag := calendar.ETag(body)
+	if v, ok := ifNoneMatch.Get(); ok && calendar.MatchesETag(v, etag) {
+		return nil, &gen.NotModified{ETag: gen.NewOptString(etag)}
+	}
+
+	res := &gen.CalendarFeedHeaders{
+		ETag:     gen.NewOptString(etag),
+		Response: gen.CalendarFeed{Data: bytes.NewReader(body)},
+	}
+	if modified := c.Modified(); !modified.IsZero() {
+		res.LastModified.SetTo(modified.UTC().Format(http.TimeFormat))
+	}
+	return res, nil
+}
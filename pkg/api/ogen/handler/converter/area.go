@@ -44,6 +44,22 @@ func AreasToAPI(models []*model.Area) []gen.Area {
 	return result
 }
 
+func RoomPlanToAPI(m *model.Area) *gen.RoomPlan {
+	if m == nil {
+		return nil
+	}
+	r := &gen.RoomPlan{
+		Markers: PlaceMarkersToAPI(m.PlaceMarkers),
+	}
+	if m.RoomPlanURL != nil {
+		parsedURL, err := url.Parse(*m.RoomPlanURL)
+		if err == nil {
+			r.ImageUrl = *parsedURL
+		}
+	}
+	return r
+}
+
 func CreateAreaRequestToModel(req *gen.CreateAreaRequest) *model.Area {
 	if req == nil {
 		return nil
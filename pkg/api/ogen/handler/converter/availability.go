@@ -0,0 +1,97 @@
+package converter
+
+import (
+	"strings"
+
+	"github.com/pixlcrashr/roomy/pkg/api/ogen/gen"
+	"github.com/pixlcrashr/roomy/pkg/blocking"
+	"github.com/pixlcrashr/roomy/pkg/reservation"
+)
+
+func AvailabilitySlotToAPI(s reservation.Slot) gen.AvailabilitySlot {
+	slot := gen.AvailabilitySlot{
+		StartTime: s.Start,
+		EndTime:   s.End,
+		Status:    gen.AvailabilitySlotStatus(s.Status),
+	}
+	if s.BlockingReason != "" {
+		slot.BlockingReason.SetTo(s.BlockingReason)
+	}
+	if s.BlockingSource != "" {
+		slot.BlockingSource.SetTo(gen.AvailabilitySlotBlockingSource(s.BlockingSource))
+	}
+	if s.ReservationID != nil {
+		slot.ReservationId.SetTo(*s.ReservationID)
+	}
+	return slot
+}
+
+func AvailabilitySlotsToAPI(slots []reservation.Slot) []gen.AvailabilitySlot {
+	result := make([]gen.AvailabilitySlot, len(slots))
+	for i, s := range slots {
+		result[i] = AvailabilitySlotToAPI(s)
+	}
+	return result
+}
+
+func PlaceSummaryToAPI(s reservation.PlaceSummary) gen.PlaceAvailability {
+	availability := gen.PlaceAvailability{
+		PlaceId:   s.Place.ID,
+		PlaceName: s.Place.Name,
+		Status:    gen.PlaceAvailabilityStatus(s.Status),
+	}
+	if s.NextAvailable != nil {
+		availability.NextAvailableSlot.SetTo(*s.NextAvailable)
+	}
+	if s.BlockingReason != "" {
+		availability.BlockedReason.SetTo(s.BlockingReason)
+	}
+	if s.BlockingSource != "" {
+		availability.BlockedSource.SetTo(gen.PlaceAvailabilityBlockedSource(s.BlockingSource))
+	}
+	return availability
+}
+
+func PlaceSummariesToAPI(summaries []reservation.PlaceSummary) []gen.PlaceAvailability {
+	result := make([]gen.PlaceAvailability, len(summaries))
+	for i, s := range summaries {
+		result[i] = PlaceSummaryToAPI(s)
+	}
+	return result
+}
+
+func BuildingAvailabilityToAPI(ranges []blocking.Range, hours blocking.OpeningHours) *gen.BuildingAvailability {
+	availability := &gen.BuildingAvailability{
+		Ranges:       make([]gen.TimeRange, len(ranges)),
+		OpeningHours: make([]gen.WeekdayOpeningHours, len(hours.Weekdays)),
+		Exceptions:   make([]gen.OpeningHoursException, len(hours.Exceptions)),
+	}
+	for i, r := range ranges {
+		availability.Ranges[i] = gen.TimeRange{StartTime: r.Start, EndTime: r.End}
+	}
+	for i, w := range hours.Weekdays {
+		availability.OpeningHours[i] = gen.WeekdayOpeningHours{
+			Weekday: gen.WeekdayOpeningHoursWeekday(strings.ToLower(w.Weekday.String())),
+			Hours:   ClockRangesToAPI(w.Hours),
+		}
+	}
+	for i, e := range hours.Exceptions {
+		exception := gen.OpeningHoursException{
+			Date:  e.Date,
+			Hours: ClockRangesToAPI(e.Hours),
+		}
+		if e.Reason != "" {
+			exception.Reason.SetTo(e.Reason)
+		}
+		availability.Exceptions[i] = exception
+	}
+	return availability
+}
+
+func ClockRangesToAPI(ranges []blocking.ClockRange) []gen.OpeningHoursRange {
+	result := make([]gen.OpeningHoursRange, len(ranges))
+	for i, r := range ranges {
+		result[i] = gen.OpeningHoursRange{StartTime: r.Start, EndTime: r.End}
+	}
+	return result
+}
@@ -0,0 +1,33 @@
+package converter
+
+import (
+	"github.com/pixlcrashr/roomy/pkg/api/ogen/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+)
+
+func CalendarTokenToAPI(m *model.CalendarToken) *gen.CalendarToken {
+	if m == nil {
+		return nil
+	}
+	t := &gen.CalendarToken{
+		CreatedAt: m.CreatedAt,
+	}
+	if m.LastUsedAt != nil {
+		t.LastUsedAt.SetTo(*m.LastUsedAt)
+	}
+	return t
+}
+
+// CalendarTokenWithSecretToAPI converts a freshly created token together with
+// its secret, which is not persisted and can only be returned once.
+func CalendarTokenWithSecretToAPI(m *model.CalendarToken, secret string) *gen.CalendarTokenWithSecret {
+	t := &gen.CalendarTokenWithSecret{
+		CreatedAt: m.CreatedAt,
+		Token:     secret,
+		Path:      "/calendar/users/" + secret + ".ics",
+	}
+	if m.LastUsedAt != nil {
+		t.LastUsedAt.SetTo(*m.LastUsedAt)
+	}
+	return t
+}
@@ -0,0 +1,87 @@
+package converter
+
+import (
+	"errors"
+
+	"github.com/pixlcrashr/roomy/pkg/api/ogen/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+)
+
+func ConstraintProfileToAPI(m *model.ConstraintProfile) *gen.ConstraintProfile {
+	if m == nil {
+		return nil
+	}
+	p := &gen.ConstraintProfile{
+		ID:      m.ID,
+		GroupId: m.GroupID,
+		Name:    m.Name,
+		Constraints: *PlaceConstraintsToAPI(&model.BookingConstraints{
+			MinReservationMinutes:     m.MinReservationMinutes,
+			MaxReservationMinutes:     m.MaxReservationMinutes,
+			MaxReservationsPerDay:     m.MaxReservationsPerDay,
+			MaxReservationsPerWeek:    m.MaxReservationsPerWeek,
+			MaxReservationsPerMonth:   m.MaxReservationsPerMonth,
+			MaxReservationsPerYear:    m.MaxReservationsPerYear,
+			MaxHoursPerDay:            m.MaxHoursPerDay,
+			MaxHoursPerWeek:           m.MaxHoursPerWeek,
+			MaxHoursPerMonth:          m.MaxHoursPerMonth,
+			MaxHoursPerYear:           m.MaxHoursPerYear,
+			MaxConcurrentReservations: m.MaxConcurrentReservations,
+			MaxAdvanceBookingDays:     m.MaxAdvanceBookingDays,
+		}),
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+	if m.ScopeType != nil && m.ScopeID != nil {
+		p.Scope.SetTo(gen.ConstraintProfileScope{
+			Type: gen.ConstraintProfileScopeType(*m.ScopeType),
+			ID:   *m.ScopeID,
+		})
+	}
+	return p
+}
+
+func ConstraintProfilesToAPI(models []*model.ConstraintProfile) []gen.ConstraintProfile {
+	result := make([]gen.ConstraintProfile, len(models))
+	for i, m := range models {
+		result[i] = *ConstraintProfileToAPI(m)
+	}
+	return result
+}
+
+// ConstraintProfileRequestToModel replaces the profile with the request. It
+// fails if the constraints are invalid or set fields profiles cannot
+// override.
+func ConstraintProfileRequestToModel(req *gen.ConstraintProfileRequest, existing *model.ConstraintProfile) error {
+	if req == nil || existing == nil {
+		return nil
+	}
+
+	var c model.BookingConstraints
+	if err := UpdatePlaceConstraintsRequestToModel(&req.Constraints, &c); err != nil {
+		return err
+	}
+	if c.WhitelistEnabled != nil || c.QuotaWindow != nil || c.CheckInTimeoutMinutes != nil || c.DecayTimeoutMinutes != nil {
+		return errors.New("whitelistEnabled, quotaWindow, checkInTimeoutMinutes and decayTimeoutMinutes cannot be set in constraint profiles")
+	}
+
+	existing.Name = req.Name
+	existing.ScopeType, existing.ScopeID = nil, nil
+	if scope, ok := req.Scope.Get(); ok {
+		scopeType := string(scope.Type)
+		existing.ScopeType, existing.ScopeID = &scopeType, &scope.ID
+	}
+	existing.MinReservationMinutes = c.MinReservationMinutes
+	existing.MaxReservationMinutes = c.MaxReservationMinutes
+	existing.MaxReservationsPerDay = c.MaxReservationsPerDay
+	existing.MaxReservationsPerWeek = c.MaxReservationsPerWeek
+	existing.MaxReservationsPerMonth = c.MaxReservationsPerMonth
+	existing.MaxReservationsPerYear = c.MaxReservationsPerYear
+	existing.MaxHoursPerDay = c.MaxHoursPerDay
+	existing.MaxHoursPerWeek = c.MaxHoursPerWeek
+	existing.MaxHoursPerMonth = c.MaxHoursPerMonth
+	existing.MaxHoursPerYear = c.MaxHoursPerYear
+	existing.MaxConcurrentReservations = c.MaxConcurrentReservations
+	existing.MaxAdvanceBookingDays = c.MaxAdvanceBookingDays
+	return nil
+}
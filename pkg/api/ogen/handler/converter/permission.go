@@ -0,0 +1,53 @@
+package converter
+
+import (
+	"github.com/google/uuid"
+	"github.com/pixlcrashr/roomy/pkg/api/ogen/gen"
+	"github.com/pixlcrashr/roomy/pkg/auth"
+)
+
+func PermissionToAPI(p auth.PermissionDefinition) gen.Permission {
+	return gen.Permission{
+		Name:        p.Name,
+		Description: p.Description,
+	}
+}
+
+func PermissionsToAPI(definitions []auth.PermissionDefinition) []gen.Permission {
+	result := make([]gen.Permission, len(definitions))
+	for i, p := range definitions {
+		result[i] = PermissionToAPI(p)
+	}
+	return result
+}
+
+// GrantsToAPI resolves permission grants against the registry, skipping
+// permissions that are no longer registered.
+func GrantsToAPI(grants []auth.Grant) []gen.Permission {
+	result := make([]gen.Permission, 0, len(grants))
+	for _, grant := range grants {
+		definition, ok := auth.LookupPermission(grant.Permission)
+		if !ok {
+			continue
+		}
+		p := PermissionToAPI(definition)
+		if grant.Scope != nil {
+			p.Scope.SetTo(gen.PermissionScope{
+				Type: gen.PermissionScopeType(grant.Scope.Type),
+				ID:   grant.Scope.ID,
+			})
+		}
+		result = append(result, p)
+	}
+	return result
+}
+
+// PermissionScopeToModel converts an optional API scope to its persisted columns.
+func PermissionScopeToModel(scope gen.OptPermissionScope) (*string, *uuid.UUID) {
+	if !scope.IsSet() {
+		return nil, nil
+	}
+	scopeType := string(scope.Value.Type)
+	scopeID := scope.Value.ID
+	return &scopeType, &scopeID
+}
@@ -0,0 +1,177 @@
+package converter
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pixlcrashr/roomy/pkg/api/ogen/gen"
+	"github.com/pixlcrashr/roomy/pkg/constraints"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+)
+
+func PlaceConstraintsToAPI(m *model.BookingConstraints) *gen.PlaceConstraints {
+	c := &gen.PlaceConstraints{}
+	if m == nil {
+		return c
+	}
+	if m.MinReservationMinutes != nil {
+		c.MinReservationDuration.SetTo(formatMinutes(*m.MinReservationMinutes))
+	}
+	if m.MaxReservationMinutes != nil {
+		c.MaxReservationDuration.SetTo(formatMinutes(*m.MaxReservationMinutes))
+	}
+	c.MaxReservationsPerDay = optNilInt(m.MaxReservationsPerDay)
+	c.MaxReservationsPerWeek = optNilInt(m.MaxReservationsPerWeek)
+	c.MaxReservationsPerMonth = optNilInt(m.MaxReservationsPerMonth)
+	c.MaxReservationsPerYear = optNilInt(m.MaxReservationsPerYear)
+	c.MaxHoursPerDay = optNilInt(m.MaxHoursPerDay)
+	c.MaxHoursPerWeek = optNilInt(m.MaxHoursPerWeek)
+	c.MaxHoursPerMonth = optNilInt(m.MaxHoursPerMonth)
+	c.MaxHoursPerYear = optNilInt(m.MaxHoursPerYear)
+	c.MaxConcurrentReservations = optNilInt(m.MaxConcurrentReservations)
+	c.MaxAdvanceBookingDays = optNilInt(m.MaxAdvanceBookingDays)
+	c.CheckInTimeoutMinutes = optNilInt(m.CheckInTimeoutMinutes)
+	c.DecayTimeoutMinutes = optNilInt(m.DecayTimeoutMinutes)
+	if m.WhitelistEnabled != nil {
+		c.WhitelistEnabled.SetTo(*m.WhitelistEnabled)
+	}
+	if m.QuotaWindow != nil {
+		c.QuotaWindow.SetTo(gen.PlaceConstraintsQuotaWindow(*m.QuotaWindow))
+	}
+	return c
+}
+
+func EffectivePlaceConstraintsToAPI(e *constraints.Effective) *gen.EffectivePlaceConstraints {
+	sources := make(gen.EffectivePlaceConstraintsSources, len(e.Sources))
+	for name, entityType := range e.Sources {
+		sources[name] = gen.EffectivePlaceConstraintsSourcesItem(entityType)
+	}
+	return &gen.EffectivePlaceConstraints{
+		Constraints: *PlaceConstraintsToAPI(&e.BookingConstraints),
+		Sources:     sources,
+	}
+}
+
+// UpdatePlaceConstraintsRequestToModel replaces the constraints with those
+// of the request; fields it leaves unset are inherited again. It fails if a
+// duration is invalid or the minimum duration exceeds the maximum.
+func UpdatePlaceConstraintsRequestToModel(req *gen.PlaceConstraints, existing *model.BookingConstraints) error {
+	if req == nil || existing == nil {
+		return nil
+	}
+
+	minMinutes, err := optNilMinutes(req.MinReservationDuration)
+	if err != nil {
+		return fmt.Errorf("minReservationDuration: %w", err)
+	}
+	maxMinutes, err := optNilMinutes(req.MaxReservationDuration)
+	if err != nil {
+		return fmt.Errorf("maxReservationDuration: %w", err)
+	}
+	if minMinutes != nil && maxMinutes != nil && *minMinutes > *maxMinutes {
+		return errors.New("minReservationDuration must not exceed maxReservationDuration")
+	}
+
+	existing.MinReservationMinutes = minMinutes
+	existing.MaxReservationMinutes = maxMinutes
+	existing.MaxReservationsPerDay = optNilIntPtr(req.MaxReservationsPerDay)
+	existing.MaxReservationsPerWeek = optNilIntPtr(req.MaxReservationsPerWeek)
+	existing.MaxReservationsPerMonth = optNilIntPtr(req.MaxReservationsPerMonth)
+	existing.MaxReservationsPerYear = optNilIntPtr(req.MaxReservationsPerYear)
+	existing.MaxHoursPerDay = optNilIntPtr(req.MaxHoursPerDay)
+	existing.MaxHoursPerWeek = optNilIntPtr(req.MaxHoursPerWeek)
+	existing.MaxHoursPerMonth = optNilIntPtr(req.MaxHoursPerMonth)
+	existing.MaxHoursPerYear = optNilIntPtr(req.MaxHoursPerYear)
+	existing.MaxConcurrentReservations = optNilIntPtr(req.MaxConcurrentReservations)
+	existing.MaxAdvanceBookingDays = optNilIntPtr(req.MaxAdvanceBookingDays)
+	existing.CheckInTimeoutMinutes = optNilIntPtr(req.CheckInTimeoutMinutes)
+	existing.DecayTimeoutMinutes = optNilIntPtr(req.DecayTimeoutMinutes)
+	existing.WhitelistEnabled = nil
+	if v, ok := req.WhitelistEnabled.Get(); ok {
+		existing.WhitelistEnabled = &v
+	}
+	existing.QuotaWindow = nil
+	if v, ok := req.QuotaWindow.Get(); ok {
+		window := string(v)
+		existing.QuotaWindow = &window
+	}
+	return nil
+}
+
+func optNilIntPtr(v gen.OptNilInt) *int {
+	if i, ok := v.Get(); ok {
+		return &i
+	}
+	return nil
+}
+
+func optNilInt(v *int) gen.OptNilInt {
+	var o gen.OptNilInt
+	if v != nil {
+		o.SetTo(*v)
+	}
+	return o
+}
+
+// isoDuration matches ISO 8601 durations of weeks, days, hours, minutes and
+// seconds. Years and months are left out, since their length varies.
+var isoDuration = regexp.MustCompile(`^P(?:(\d{1,6})W)?(?:(\d{1,6})D)?(?:T(?:(\d{1,6})H)?(?:(\d{1,6})M)?(?:(\d{1,8})S)?)?$`)
+
+// optNilMinutes parses an optional ISO 8601 duration of whole, positive
+// minutes.
+func optNilMinutes(v gen.OptNilString) (*int, error) {
+	s, ok := v.Get()
+	if !ok {
+		return nil, nil
+	}
+	match := isoDuration.FindStringSubmatch(s)
+	if match == nil || s == "P" || strings.HasSuffix(s, "T") {
+		return nil, fmt.Errorf("invalid ISO 8601 duration %q", s)
+	}
+
+	var seconds int
+	for i, unit := range []int{7 * 24 * 3600, 24 * 3600, 3600, 60, 1} {
+		if match[i+1] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(match[i+1])
+		if err != nil {
+			return nil, err
+		}
+		seconds += n * unit
+	}
+	if seconds <= 0 || seconds%60 != 0 {
+		return nil, fmt.Errorf("duration %q must be a positive number of whole minutes", s)
+	}
+	minutes := seconds / 60
+	if minutes > math.MaxInt32 {
+		return nil, fmt.Errorf("duration %q is too long", s)
+	}
+	return &minutes, nil
+}
+
+// formatMinutes formats minutes as ISO 8601 duration of days, hours and
+// minutes, such as "PT1H30M" or "P1D".
+func formatMinutes(minutes int) string {
+	days, hours, minutes := minutes/(24*60), minutes/60%24, minutes%60
+
+	var b strings.Builder
+	b.WriteString("P")
+	if days > 0 {
+		fmt.Fprintf(&b, "%dD", days)
+	}
+	if hours > 0 || minutes > 0 || days == 0 {
+		b.WriteString("T")
+	}
+	if hours > 0 {
+		fmt.Fprintf(&b, "%dH", hours)
+	}
+	if minutes > 0 || (days == 0 && hours == 0) {
+		fmt.Fprintf(&b, "%dM", minutes)
+	}
+	return b.String()
+}
@@ -0,0 +1,40 @@
+package converter
+
+import (
+	"net/url"
+
+	"github.com/pixlcrashr/roomy/pkg/api/ogen/gen"
+	"github.com/pixlcrashr/roomy/pkg/auth"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"github.com/pixlcrashr/roomy/pkg/qr"
+)
+
+func PlaceQrKeyToAPI(m *model.Place, qrCodes *auth.QRSigner) *gen.PlaceQrKey {
+	k := &gen.PlaceQrKey{KeyVersion: m.QRKeyVersion}
+	if u, err := url.Parse(qrCodes.URL(m.ID, m.QRKeyVersion)); err == nil {
+		k.CheckInUrl = *u
+	}
+	return k
+}
+
+// PlaceQrLabel returns the label of a place's QR code showing the requested
+// details. The place name is the title; the area and building names follow
+// in this order regardless of the order they were requested in. The area
+// and its building have to be preloaded.
+func PlaceQrLabel(m *model.Place, qrCodes *auth.QRSigner, details []gen.GetPlaceQrCodeIncludeDetailsItem) qr.Label {
+	label := qr.Label{URL: qrCodes.URL(m.ID, m.QRKeyVersion)}
+	include := make(map[gen.GetPlaceQrCodeIncludeDetailsItem]bool, len(details))
+	for _, d := range details {
+		include[d] = true
+	}
+	if include[gen.GetPlaceQrCodeIncludeDetailsItemName] {
+		label.Title = m.Name
+	}
+	if include[gen.GetPlaceQrCodeIncludeDetailsItemAreaName] && m.Area != nil {
+		label.Details = append(label.Details, m.Area.Name)
+	}
+	if include[gen.GetPlaceQrCodeIncludeDetailsItemBuildingName] && m.Area != nil && m.Area.Building != nil {
+		label.Details = append(label.Details, m.Area.Building.Name)
+	}
+	return label
+}
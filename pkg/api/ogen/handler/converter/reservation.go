@@ -1,9 +1,13 @@
 package converter
 
 import (
+	"time"
+
+	"github.com/go-faster/jx"
 	"github.com/google/uuid"
 	"github.com/pixlcrashr/roomy/pkg/api/ogen/gen"
 	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"github.com/pixlcrashr/roomy/pkg/reservation"
 )
 
 func ReservationToAPI(m *model.Reservation) *gen.Reservation {
@@ -41,6 +45,64 @@ func ReservationsToAPI(models []*model.Reservation) []gen.Reservation {
 	return result
 }
 
+func ReservationSeriesToAPI(series *reservation.Series) *gen.ReservationSeries {
+	if series == nil {
+		return nil
+	}
+	result := &gen.ReservationSeries{
+		RecurringGroupId: series.GroupID,
+		Occurrences:      make([]gen.ReservationOccurrence, len(series.Occurrences)),
+	}
+	for i, o := range series.Occurrences {
+		occurrence := gen.ReservationOccurrence{
+			StartTime: o.Start,
+			EndTime:   o.End,
+			Status:    gen.ReservationOccurrenceStatusCreated,
+		}
+		if o.Reservation != nil {
+			occurrence.Reservation.SetTo(*ReservationToAPI(o.Reservation))
+		}
+		if o.Rejection != nil {
+			occurrence.Status = gen.ReservationOccurrenceStatusSkipped
+			occurrence.Reason.SetTo(gen.OccurrenceRejection{
+				Code:    string(o.Rejection.Code),
+				Message: o.Rejection.Message,
+			})
+		}
+		result.Occurrences[i] = occurrence
+	}
+	return result
+}
+
+// OccurrenceConflictsToAPI returns error details listing the conflicting
+// occurrences of a rejected series.
+func OccurrenceConflictsToAPI(conflicts []reservation.OccurrenceResult) gen.ErrorResponseErrorDetails {
+	var e jx.Encoder
+	e.Arr(func(e *jx.Encoder) {
+		for _, c := range conflicts {
+			e.Obj(func(e *jx.Encoder) {
+				e.Field("startTime", func(e *jx.Encoder) { e.Str(c.Start.Format(time.RFC3339)) })
+				e.Field("endTime", func(e *jx.Encoder) { e.Str(c.End.Format(time.RFC3339)) })
+				e.Field("code", func(e *jx.Encoder) { e.Str(string(c.Rejection.Code)) })
+				e.Field("message", func(e *jx.Encoder) { e.Str(c.Rejection.Message) })
+			})
+		}
+	})
+	return gen.ErrorResponseErrorDetails{"occurrences": jx.Raw(e.Bytes())}
+}
+
+// RecurrenceToDomain converts the recurrence of a create request.
+func RecurrenceToDomain(r gen.CreateReservationRequestRecurrence) reservation.Recurrence {
+	rec := reservation.Recurrence{
+		Pattern: reservation.Pattern(r.Pattern),
+		EndDate: r.EndDate,
+	}
+	for _, day := range r.DaysOfWeek {
+		rec.DaysOfWeek = append(rec.DaysOfWeek, time.Weekday(day))
+	}
+	return rec
+}
+
 func CreateReservationRequestToModel(req *gen.CreateReservationRequest, userID uuid.UUID) *model.Reservation {
 	if req == nil {
 		return nil
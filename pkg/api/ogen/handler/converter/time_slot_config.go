@@ -0,0 +1,48 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/pixlcrashr/roomy/pkg/api/ogen/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+)
+
+// timeOfDayLayout is the "HH:MM" layout used to persist time slot boundaries.
+const timeOfDayLayout = "15:04"
+
+func TimeSlotConfigToAPI(m *model.TimeSlotConfig) *gen.TimeSlotConfig {
+	if m == nil {
+		return nil
+	}
+	t := &gen.TimeSlotConfig{
+		IntervalMinutes: m.IntervalMinutes,
+	}
+	if m.EarliestStartTime != nil {
+		if parsed, err := time.Parse(timeOfDayLayout, *m.EarliestStartTime); err == nil {
+			t.EarliestStartTime.SetTo(parsed)
+		}
+	}
+	if m.LatestEndTime != nil {
+		if parsed, err := time.Parse(timeOfDayLayout, *m.LatestEndTime); err == nil {
+			t.LatestEndTime.SetTo(parsed)
+		}
+	}
+	return t
+}
+
+func UpdateTimeSlotConfigRequestToModel(req *gen.TimeSlotConfig, existing *model.TimeSlotConfig) {
+	if req == nil || existing == nil {
+		return
+	}
+	existing.IntervalMinutes = req.IntervalMinutes
+	existing.EarliestStartTime = nil
+	if v, ok := req.EarliestStartTime.Get(); ok {
+		s := v.Format(timeOfDayLayout)
+		existing.EarliestStartTime = &s
+	}
+	existing.LatestEndTime = nil
+	if v, ok := req.LatestEndTime.Get(); ok {
+		s := v.Format(timeOfDayLayout)
+		existing.LatestEndTime = &s
+	}
+}
@@ -62,6 +62,14 @@ func UserReferencesToAPI(models []model.User) []gen.UserReference {
 	return result
 }
 
+func UserReferencePointersToAPI(models []*model.User) []gen.UserReference {
+	result := make([]gen.UserReference, len(models))
+	for i, m := range models {
+		result[i] = *UserReferenceToAPI(m)
+	}
+	return result
+}
+
 func NotificationPreferencesToAPI(m *model.User) *gen.NotificationPreferences {
 	if m == nil {
 		return nil
@@ -1,6 +1,16 @@
 package handler
 
-import "github.com/pixlcrashr/roomy/pkg/api/ogen/gen"
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/ogen-go/ogen/ogenerrors"
+	"github.com/pixlcrashr/roomy/pkg/api/ogen/gen"
+	"github.com/pixlcrashr/roomy/pkg/auth"
+)
 
 // NewErrorResponse creates a new ErrorResponse with the given code and message.
 func NewErrorResponse(code, message string) gen.ErrorResponse {
@@ -26,3 +36,74 @@ func NotFoundError(message string) gen.ErrorResponse {
 func BadRequestError(message string) gen.ErrorResponse {
 	return NewErrorResponse("BAD_REQUEST", message)
 }
+
+// UnauthorizedError creates an unauthorized error response.
+func UnauthorizedError(message string) gen.ErrorResponse {
+	return NewErrorResponse("UNAUTHORIZED", message)
+}
+
+// ForbiddenError creates a forbidden error response.
+func ForbiddenError(message string) gen.ErrorResponse {
+	return NewErrorResponse("FORBIDDEN", message)
+}
+
+// NotFound writes a JSON not found error response for unknown API routes.
+func NotFound(w http.ResponseWriter, r *http.Request) {
+	writeErrorResponse(w, http.StatusNotFound, NotFoundError("route not found"))
+}
+
+// ErrorHandler writes errors raised by the generated server (security,
+// decoding and handler errors) as JSON error responses.
+func ErrorHandler(ctx context.Context, w http.ResponseWriter, r *http.Request, err error) {
+	var deniedErr *PermissionDeniedError
+	if errors.As(err, &deniedErr) {
+		writeErrorResponse(w, http.StatusForbidden, ForbiddenError(deniedErr.Error()))
+		return
+	}
+
+	status := ogenerrors.ErrorCode(err)
+	// The security handler fails with other errors than rejected credentials
+	// if it cannot check them, e.g. because the database is unavailable.
+	var securityErr *ogenerrors.SecurityError
+	if errors.As(err, &securityErr) && !isAuthenticationFailure(securityErr.Err) {
+		status = http.StatusInternalServerError
+	}
+
+	var res gen.ErrorResponse
+	switch status {
+	case http.StatusUnauthorized:
+		message := "authentication required"
+		if securityErr != nil && !errors.Is(err, ogenerrors.ErrSecurityRequirementIsNotSatisfied) {
+			message = securityErr.Err.Error()
+		}
+		res = UnauthorizedError(message)
+	case http.StatusBadRequest:
+		res = BadRequestError(err.Error())
+	case http.StatusInternalServerError:
+		log.Errorf("%s %s failed: %v", r.Method, r.URL.Path, err)
+		res = InternalError("internal server error")
+	default:
+		res = NewErrorResponse(strings.ToUpper(strings.ReplaceAll(http.StatusText(status), " ", "_")), err.Error())
+	}
+
+	writeErrorResponse(w, status, res)
+}
+
+// isAuthenticationFailure reports whether a security handler error means
+// that the credentials were missing or rejected.
+func isAuthenticationFailure(err error) bool {
+	return errors.Is(err, ogenerrors.ErrSecurityRequirementIsNotSatisfied) ||
+		errors.Is(err, auth.ErrUserDisabled) ||
+		errors.Is(err, auth.ErrAPIKeyExpired)
+}
+
+func writeErrorResponse(w http.ResponseWriter, status int, res gen.ErrorResponse) {
+	body, err := res.MarshalJSON()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
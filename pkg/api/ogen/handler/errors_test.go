@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ogen-go/ogen/ogenerrors"
+	"github.com/pixlcrashr/roomy/pkg/auth"
+)
+
+func TestErrorHandler(t *testing.T) {
+	security := func(err error) error {
+		return &ogenerrors.SecurityError{Security: "ApiKeyAuth", Err: err}
+	}
+
+	tests := []struct {
+		name        string
+		err         error
+		wantStatus  int
+		wantMessage string
+	}{
+		{"missing credentials", security(ogenerrors.ErrSecurityRequirementIsNotSatisfied), http.StatusUnauthorized, "authentication required"},
+		{"disabled user", security(auth.ErrUserDisabled), http.StatusUnauthorized, auth.ErrUserDisabled.Error()},
+		{"expired api key", security(auth.ErrAPIKeyExpired), http.StatusUnauthorized, auth.ErrAPIKeyExpired.Error()},
+		{"database failure", security(errors.New(`pq: relation "api_keys" does not exist`)), http.StatusInternalServerError, "internal server error"},
+		{"permission denied", &PermissionDeniedError{Permission: auth.PermissionManageUsers}, http.StatusForbidden, ""},
+		{"handler failure", errors.New("connection refused"), http.StatusInternalServerError, "internal server error"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			ErrorHandler(t.Context(), w, httptest.NewRequest(http.MethodGet, "/api/v1/users", nil), tt.err)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			body := w.Body.String()
+			if tt.wantMessage != "" && !strings.Contains(body, `"message":"`+tt.wantMessage+`"`) {
+				t.Fatalf("body = %s, want message %q", body, tt.wantMessage)
+			}
+			if tt.wantStatus == http.StatusInternalServerError && strings.Contains(body, tt.err.Error()) {
+				t.Fatalf("body leaks the error: %s", body)
+			}
+		})
+	}
+}
@@ -2,8 +2,14 @@ package handler
 
 import (
 	"context"
+	"strconv"
 
+	"github.com/google/uuid"
 	"github.com/pixlcrashr/roomy/pkg/api/ogen/gen"
+	"github.com/pixlcrashr/roomy/pkg/api/ogen/handler/converter"
+	"github.com/pixlcrashr/roomy/pkg/auth"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
 	"gorm.io/gorm"
 )
 
@@ -64,26 +70,252 @@ func (h *GroupHandler) GetGroupMembers(ctx context.Context, params gen.GetGroupM
 // GetGroupPermissions gets permissions assigned to a group.
 // GET /groups/{groupId}/permissions
 func (h *GroupHandler) GetGroupPermissions(ctx context.Context, params gen.GetGroupPermissionsParams) (gen.GetGroupPermissionsRes, error) {
-	// TODO: Implement get group permissions
-	result := gen.GetGroupPermissionsOKApplicationJSON([]gen.Permission{})
+	group, err := dbgen.GroupQuery[model.Group](h.db).GetByID(ctx, params.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return &gen.GetGroupPermissionsNotFound{}, nil
+	}
+
+	permissions, err := h.groupPermissions(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+
+	result := gen.GetGroupPermissionsOKApplicationJSON(converter.GrantsToAPI(permissions))
 	return &result, nil
 }
 
 // AddGroupPermissions adds permissions to a group.
 // POST /groups/{groupId}/permissions
 func (h *GroupHandler) AddGroupPermissions(ctx context.Context, req *gen.AddGroupPermissionsReq, params gen.AddGroupPermissionsParams) (gen.AddGroupPermissionsRes, error) {
-	// TODO: Implement add group permissions
-	result := gen.AddGroupPermissionsOKApplicationJSON([]gen.Permission{})
+	group, err := dbgen.GroupQuery[model.Group](h.db).GetByID(ctx, params.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return &gen.AddGroupPermissionsNotFound{}, nil
+	}
+	if group.IsSystem {
+		res := gen.AddGroupPermissionsForbidden(ForbiddenError("permissions of the system group cannot be changed"))
+		return &res, nil
+	}
+	for _, name := range req.Permissions {
+		definition, ok := auth.LookupPermission(name)
+		if !ok {
+			res := gen.AddGroupPermissionsBadRequest(BadRequestError("unknown permission: " + name))
+			return &res, nil
+		}
+		if req.Scope.IsSet() && !definition.AllowsScope(auth.ScopeType(req.Scope.Value.Type)) {
+			res := gen.AddGroupPermissionsBadRequest(BadRequestError("permission " + name + " cannot be scoped to a " + string(req.Scope.Value.Type)))
+			return &res, nil
+		}
+	}
+	if req.Scope.IsSet() {
+		exists, err := scopeExists(ctx, h.db, auth.Scope{Type: auth.ScopeType(req.Scope.Value.Type), ID: req.Scope.Value.ID})
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			res := gen.AddGroupPermissionsBadRequest(BadRequestError(string(req.Scope.Value.Type) + " not found"))
+			return &res, nil
+		}
+	}
+
+	scopeType, scopeID := converter.PermissionScopeToModel(req.Scope)
+	if err := h.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, name := range req.Permissions {
+			if err := dbgen.GroupPermissionQuery[model.GroupPermission](tx).Insert(ctx, uuid.New(), group.ID, name, scopeType, scopeID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	permissions, err := h.groupPermissions(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+
+	result := gen.AddGroupPermissionsOKApplicationJSON(converter.GrantsToAPI(permissions))
 	return &result, nil
 }
 
 // RemoveGroupPermissions removes permissions from a group.
 // DELETE /groups/{groupId}/permissions
 func (h *GroupHandler) RemoveGroupPermissions(ctx context.Context, req *gen.RemoveGroupPermissionsReq, params gen.RemoveGroupPermissionsParams) (gen.RemoveGroupPermissionsRes, error) {
-	// TODO: Implement remove group permissions
+	group, err := dbgen.GroupQuery[model.Group](h.db).GetByID(ctx, params.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return &gen.RemoveGroupPermissionsNotFound{}, nil
+	}
+	if group.IsSystem {
+		res := gen.RemoveGroupPermissionsForbidden(ForbiddenError("permissions of the system group cannot be changed"))
+		return &res, nil
+	}
+
+	if len(req.Permissions) > 0 {
+		scopeType, scopeID := converter.PermissionScopeToModel(req.Scope)
+		if err := dbgen.GroupPermissionQuery[model.GroupPermission](h.db).Remove(ctx, group.ID, req.Permissions, scopeType, scopeID); err != nil {
+			return nil, err
+		}
+	}
+
 	return &gen.RemoveGroupPermissionsNoContent{}, nil
 }
 
+// groupPermissions returns the permission grants of a group. The system
+// group always holds every registered permission globally.
+func (h *GroupHandler) groupPermissions(ctx context.Context, group *model.Group) ([]auth.Grant, error) {
+	if group.IsSystem {
+		names := auth.PermissionNames()
+		grants := make([]auth.Grant, len(names))
+		for i, name := range names {
+			grants[i] = auth.Grant{Permission: name}
+		}
+		return grants, nil
+	}
+
+	rows, err := dbgen.GroupPermissionQuery[model.GroupPermission](h.db).ListByGroup(ctx, group.ID)
+	if err != nil {
+		return nil, err
+	}
+	return grantsFromModel(rows), nil
+}
+
+// ListGroupConstraintProfiles lists the constraint profiles of a group.
+// GET /groups/{groupId}/constraintProfiles
+func (h *GroupHandler) ListGroupConstraintProfiles(ctx context.Context, params gen.ListGroupConstraintProfilesParams) (gen.ListGroupConstraintProfilesRes, error) {
+	group, err := dbgen.GroupQuery[model.Group](h.db).GetByID(ctx, params.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		res := gen.ListGroupConstraintProfilesNotFound(NotFoundError("group not found"))
+		return &res, nil
+	}
+
+	profiles, err := dbgen.ConstraintProfileQuery[model.ConstraintProfile](h.db).ListByGroup(ctx, group.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := gen.ListGroupConstraintProfilesOKApplicationJSON(converter.ConstraintProfilesToAPI(profiles))
+	return &result, nil
+}
+
+// CreateGroupConstraintProfile creates a constraint profile for a group.
+// POST /groups/{groupId}/constraintProfiles
+func (h *GroupHandler) CreateGroupConstraintProfile(ctx context.Context, req *gen.ConstraintProfileRequest, params gen.CreateGroupConstraintProfileParams) (gen.CreateGroupConstraintProfileRes, error) {
+	group, err := dbgen.GroupQuery[model.Group](h.db).GetByID(ctx, params.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		res := gen.CreateGroupConstraintProfileNotFound(NotFoundError("group not found"))
+		return &res, nil
+	}
+
+	profile := &model.ConstraintProfile{GroupID: group.ID}
+	if err := converter.ConstraintProfileRequestToModel(req, profile); err != nil {
+		res := gen.CreateGroupConstraintProfileBadRequest(BadRequestError(err.Error()))
+		return &res, nil
+	}
+	reason, err := h.checkConstraintProfile(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+	if reason != "" {
+		res := gen.CreateGroupConstraintProfileBadRequest(BadRequestError(reason))
+		return &res, nil
+	}
+
+	if err := h.db.WithContext(ctx).Create(profile).Error; err != nil {
+		return nil, err
+	}
+	return converter.ConstraintProfileToAPI(profile), nil
+}
+
+// UpdateGroupConstraintProfile replaces a constraint profile of a group.
+// PUT /groups/{groupId}/constraintProfiles/{profileId}
+func (h *GroupHandler) UpdateGroupConstraintProfile(ctx context.Context, req *gen.ConstraintProfileRequest, params gen.UpdateGroupConstraintProfileParams) (gen.UpdateGroupConstraintProfileRes, error) {
+	profile, err := dbgen.ConstraintProfileQuery[model.ConstraintProfile](h.db).GetByGroupAndID(ctx, params.GroupId, params.ProfileId)
+	if err != nil {
+		return nil, err
+	}
+	if profile == nil {
+		res := gen.UpdateGroupConstraintProfileNotFound(NotFoundError("constraint profile not found"))
+		return &res, nil
+	}
+
+	if err := converter.ConstraintProfileRequestToModel(req, profile); err != nil {
+		res := gen.UpdateGroupConstraintProfileBadRequest(BadRequestError(err.Error()))
+		return &res, nil
+	}
+	reason, err := h.checkConstraintProfile(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+	if reason != "" {
+		res := gen.UpdateGroupConstraintProfileBadRequest(BadRequestError(reason))
+		return &res, nil
+	}
+
+	if err := h.db.WithContext(ctx).Save(profile).Error; err != nil {
+		return nil, err
+	}
+	return converter.ConstraintProfileToAPI(profile), nil
+}
+
+// DeleteGroupConstraintProfile deletes a constraint profile of a group.
+// DELETE /groups/{groupId}/constraintProfiles/{profileId}
+func (h *GroupHandler) DeleteGroupConstraintProfile(ctx context.Context, params gen.DeleteGroupConstraintProfileParams) (gen.DeleteGroupConstraintProfileRes, error) {
+	profiles := dbgen.ConstraintProfileQuery[model.ConstraintProfile](h.db)
+	profile, err := profiles.GetByGroupAndID(ctx, params.GroupId, params.ProfileId)
+	if err != nil {
+		return nil, err
+	}
+	if profile == nil {
+		res := gen.DeleteGroupConstraintProfileNotFound(NotFoundError("constraint profile not found"))
+		return &res, nil
+	}
+
+	if err := profiles.Remove(ctx, profile.ID); err != nil {
+		return nil, err
+	}
+	return &gen.DeleteGroupConstraintProfileNoContent{}, nil
+}
+
+// checkConstraintProfile returns why a profile cannot be stored, if it
+// cannot: its scope does not exist or its name is taken within the group.
+func (h *GroupHandler) checkConstraintProfile(ctx context.Context, profile *model.ConstraintProfile) (string, error) {
+	if profile.ScopeType != nil {
+		exists, err := scopeExists(ctx, h.db, auth.Scope{Type: auth.ScopeType(*profile.ScopeType), ID: *profile.ScopeID})
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return *profile.ScopeType + " not found", nil
+		}
+	}
+
+	siblings, err := dbgen.ConstraintProfileQuery[model.ConstraintProfile](h.db).ListByGroup(ctx, profile.GroupID)
+	if err != nil {
+		return "", err
+	}
+	for _, sibling := range siblings {
+		if sibling.ID != profile.ID && sibling.Name == profile.Name {
+			return "a constraint profile named " + strconv.Quote(profile.Name) + " already exists in the group", nil
+		}
+	}
+	return "", nil
+}
+
 // GetDefaultGroupAssignment gets the default group assignment for new users.
 // GET /groups/default
 func (h *GroupHandler) GetDefaultGroupAssignment(ctx context.Context) (gen.GetDefaultGroupAssignmentRes, error) {
@@ -2,6 +2,9 @@ package handler
 
 import (
 	"github.com/pixlcrashr/roomy/pkg/api/ogen/gen"
+	"github.com/pixlcrashr/roomy/pkg/auth"
+	"github.com/pixlcrashr/roomy/pkg/qr"
+	"github.com/pixlcrashr/roomy/pkg/reservation"
 	"gorm.io/gorm"
 )
 
@@ -29,20 +32,22 @@ type Handler struct {
 var _ gen.Handler = (*Handler)(nil)
 
 // NewHandler creates a new Handler with all domain handlers initialized.
-func NewHandler(db *gorm.DB) *Handler {
+func NewHandler(db *gorm.DB, gitlab *auth.GitLabProvider, tokens *auth.TokenManager, reservations *reservation.Service, qrCodes *auth.QRSigner) *Handler {
 	h := &Handler{
 		db: db,
 	}
 
-	h.AuthHandler = NewAuthHandler(db)
-	h.BuildingHandler = NewBuildingHandler(db)
-	h.AreaHandler = NewAreaHandler(db)
-	h.PlaceHandler = NewPlaceHandler(db)
-	h.ReservationHandler = NewReservationHandler(db)
-	h.UserHandler = NewUserHandler(db)
+	qrExporter := qr.NewExporter(db, qrCodes)
+
+	h.AuthHandler = NewAuthHandler(db, gitlab, tokens)
+	h.BuildingHandler = NewBuildingHandler(db, reservations, qrExporter)
+	h.AreaHandler = NewAreaHandler(db, reservations, qrExporter)
+	h.PlaceHandler = NewPlaceHandler(db, reservations, qrCodes)
+	h.ReservationHandler = NewReservationHandler(db, reservations)
+	h.UserHandler = NewUserHandler(db, reservations)
 	h.GroupHandler = NewGroupHandler(db)
 	h.EquipmentHandler = NewEquipmentHandler(db)
-	h.QRTemplateHandler = NewQRTemplateHandler(db)
+	h.QRTemplateHandler = NewQRTemplateHandler(db, qrCodes)
 	h.AuditLogHandler = NewAuditLogHandler(db)
 	h.APIKeyHandler = NewAPIKeyHandler(db)
 	h.StatisticsHandler = NewStatisticsHandler(db)
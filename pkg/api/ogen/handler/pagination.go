@@ -0,0 +1,37 @@
+package handler
+
+import "github.com/pixlcrashr/roomy/pkg/api/ogen/gen"
+
+const (
+	defaultPage  = 1
+	defaultLimit = 20
+)
+
+// pagination holds the resolved page and limit of a paginated list request.
+type pagination struct {
+	page  int
+	limit int
+}
+
+// newPagination resolves the page and limit query parameters, falling back to defaults.
+func newPagination(page, limit gen.OptInt) pagination {
+	return pagination{
+		page:  max(page.Or(defaultPage), 1),
+		limit: max(limit.Or(defaultLimit), 1),
+	}
+}
+
+// offset returns the number of rows to skip for the current page.
+func (p pagination) offset() int {
+	return (p.page - 1) * p.limit
+}
+
+// meta builds the pagination metadata for a list with the given total count.
+func (p pagination) meta(total int64) gen.PaginationMeta {
+	return gen.PaginationMeta{
+		Page:       p.page,
+		Limit:      p.limit,
+		Total:      int(total),
+		TotalPages: int((total + int64(p.limit) - 1) / int64(p.limit)),
+	}
+}
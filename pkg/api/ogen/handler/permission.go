@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/pixlcrashr/roomy/pkg/api/ogen/gen"
+	"github.com/pixlcrashr/roomy/pkg/api/ogen/handler/converter"
+	"github.com/pixlcrashr/roomy/pkg/auth"
 	"gorm.io/gorm"
 )
 
@@ -20,7 +22,6 @@ func NewPermissionHandler(db *gorm.DB) *PermissionHandler {
 // ListPermissions lists all available permissions.
 // GET /permissions
 func (h *PermissionHandler) ListPermissions(ctx context.Context) (gen.ListPermissionsRes, error) {
-	// TODO: Implement list permissions
-	result := gen.ListPermissionsOKApplicationJSON([]gen.Permission{})
+	result := gen.ListPermissionsOKApplicationJSON(converter.PermissionsToAPI(auth.Permissions))
 	return &result, nil
 }
@@ -1,27 +1,42 @@
 package handler
 
 import (
+	"bytes"
 	"context"
+	"image"
+	"time"
 
 	"github.com/pixlcrashr/roomy/pkg/api/ogen/gen"
 	"github.com/pixlcrashr/roomy/pkg/api/ogen/handler/converter"
+	"github.com/pixlcrashr/roomy/pkg/auth"
+	"github.com/pixlcrashr/roomy/pkg/calendar"
+	"github.com/pixlcrashr/roomy/pkg/constraints"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
 	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"github.com/pixlcrashr/roomy/pkg/qr"
+	"github.com/pixlcrashr/roomy/pkg/reservation"
 	"gorm.io/gorm"
 )
 
 // PlaceHandler handles place-related operations.
 type PlaceHandler struct {
-	db *gorm.DB
+	db           *gorm.DB
+	reservations *reservation.Service
+	qrCodes      *auth.QRSigner
 }
 
 // NewPlaceHandler creates a new PlaceHandler.
-func NewPlaceHandler(db *gorm.DB) *PlaceHandler {
-	return &PlaceHandler{db: db}
+func NewPlaceHandler(db *gorm.DB, reservations *reservation.Service, qrCodes *auth.QRSigner) *PlaceHandler {
+	return &PlaceHandler{db: db, reservations: reservations, qrCodes: qrCodes}
 }
 
 // CreatePlace creates a new place.
 // POST /places
 func (h *PlaceHandler) CreatePlace(ctx context.Context, req *gen.CreatePlaceRequest) (gen.CreatePlaceRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManagePlaces, auth.AreaScope(req.AreaId)); err != nil {
+		return nil, err
+	}
+
 	place := converter.CreatePlaceRequestToModel(req)
 	if err := h.db.WithContext(ctx).Create(place).Error; err != nil {
 		return nil, err
@@ -35,7 +50,8 @@ func (h *PlaceHandler) GetPlace(ctx context.Context, params gen.GetPlaceParams)
 	var place model.Place
 	if err := h.db.WithContext(ctx).Preload("Equipment").First(&place, "id = ?", params.PlaceId).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return &gen.GetPlaceNotFound{}, nil
+			res := NotFoundError("place not found")
+			return &res, nil
 		}
 		return nil, err
 	}
@@ -45,6 +61,10 @@ func (h *PlaceHandler) GetPlace(ctx context.Context, params gen.GetPlaceParams)
 // UpdatePlace updates a place.
 // PUT /places/{placeId}
 func (h *PlaceHandler) UpdatePlace(ctx context.Context, req *gen.UpdatePlaceRequest, params gen.UpdatePlaceParams) (gen.UpdatePlaceRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManagePlaces, auth.PlaceScope(params.PlaceId)); err != nil {
+		return nil, err
+	}
+
 	var place model.Place
 	if err := h.db.WithContext(ctx).First(&place, "id = ?", params.PlaceId).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -62,9 +82,13 @@ func (h *PlaceHandler) UpdatePlace(ctx context.Context, req *gen.UpdatePlaceRequ
 // DeletePlace deletes a place.
 // DELETE /places/{placeId}
 func (h *PlaceHandler) DeletePlace(ctx context.Context, params gen.DeletePlaceParams) (gen.DeletePlaceRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManagePlaces, auth.PlaceScope(params.PlaceId)); err != nil {
+		return nil, err
+	}
+
 	result := h.db.WithContext(ctx).Delete(&model.Place{}, "id = ?", params.PlaceId)
 	if result.Error != nil {
-		return &gen.Error{Message: result.Error.Error()}, nil
+		return nil, result.Error
 	}
 	if result.RowsAffected == 0 {
 		return &gen.DeletePlaceNotFound{}, nil
@@ -75,27 +99,30 @@ func (h *PlaceHandler) DeletePlace(ctx context.Context, params gen.DeletePlacePa
 // ListPlaces lists/searches places.
 // GET /places
 func (h *PlaceHandler) ListPlaces(ctx context.Context, params gen.ListPlacesParams) (*gen.PaginatedPlaceList, error) {
-	var places []model.Place
+	var places []*model.Place
 	var total int64
 
 	query := h.db.WithContext(ctx).Model(&model.Place{})
 
 	// Apply filters
 	if params.AreaId.IsSet() {
-		query = query.Where("area_id = ?", params.AreaId.Value)
+		query = query.Where("places.area_id = ?", params.AreaId.Value)
 	}
 	if params.BuildingId.IsSet() {
 		query = query.Joins("JOIN areas ON areas.id = places.area_id").Where("areas.building_id = ?", params.BuildingId.Value)
 	}
 	if params.Search.IsSet() {
 		search := "%" + params.Search.Value + "%"
-		query = query.Where("name ILIKE ? OR description ILIKE ?", search, search)
+		query = query.Where("places.name ILIKE ? OR places.description ILIKE ?", search, search)
 	}
 	if params.MinCapacity.IsSet() {
-		query = query.Where("seat_capacity >= ?", params.MinCapacity.Value)
+		query = query.Where("places.capacity >= ?", params.MinCapacity.Value)
 	}
-	if params.IsBookable.IsSet() {
-		query = query.Where("is_bookable = ?", params.IsBookable.Value)
+	if len(params.Equipment) > 0 {
+		query = query.Where(
+			"places.id IN (SELECT place_id FROM place_equipment WHERE equipment_id IN ? GROUP BY place_id HAVING COUNT(DISTINCT equipment_id) = ?)",
+			params.Equipment, len(params.Equipment),
+		)
 	}
 
 	// Count total
@@ -104,40 +131,42 @@ func (h *PlaceHandler) ListPlaces(ctx context.Context, params gen.ListPlacesPara
 	}
 
 	// Apply pagination
-	limit := 20
-	offset := 0
-	if params.Limit.IsSet() {
-		limit = params.Limit.Value
-	}
-	if params.Offset.IsSet() {
-		offset = params.Offset.Value
-	}
+	page := newPagination(params.Page, params.Limit)
 
-	if err := query.Limit(limit).Offset(offset).Preload("Equipment").Find(&places).Error; err != nil {
+	if err := query.Order("places.name").Limit(page.limit).Offset(page.offset()).Preload("Equipment").Find(&places).Error; err != nil {
 		return nil, err
 	}
 
 	return &gen.PaginatedPlaceList{
-		Items: converter.PlacesToAPI(places),
-		Total: int(total),
+		Data: converter.PlacesToAPI(places),
+		Meta: page.meta(total),
 	}, nil
 }
 
 // GetPlaceAvailability returns available time slots for this place.
 // GET /places/{placeId}/availability
 func (h *PlaceHandler) GetPlaceAvailability(ctx context.Context, params gen.GetPlaceAvailabilityParams) (gen.GetPlaceAvailabilityRes, error) {
-	var place model.Place
-	if err := h.db.WithContext(ctx).First(&place, "id = ?", params.PlaceId).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return &gen.GetPlaceAvailabilityNotFound{}, nil
-		}
+	if params.EndDate.Before(params.StartDate) {
+		res := gen.GetPlaceAvailabilityBadRequest(BadRequestError("endDate must not be before startDate"))
+		return &res, nil
+	}
+
+	place, err := dbgen.PlaceQuery[model.Place](h.db).GetByID(ctx, params.PlaceId)
+	if err != nil {
 		return nil, err
 	}
+	if place == nil {
+		res := gen.GetPlaceAvailabilityNotFound(NotFoundError("place not found"))
+		return &res, nil
+	}
 
-	// TODO: Calculate availability based on blocking and reservations
-	return &gen.AvailabilityResponse{
-		TimeSlots: []gen.TimeSlot{},
-	}, nil
+	slots, err := h.reservations.PlaceAvailability(ctx, place, params.StartDate, params.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	res := gen.GetPlaceAvailabilityOKApplicationJSON(converter.AvailabilitySlotsToAPI(slots))
+	return &res, nil
 }
 
 // GetPlaceBlocking returns blocking periods for this place.
@@ -146,12 +175,13 @@ func (h *PlaceHandler) GetPlaceBlocking(ctx context.Context, params gen.GetPlace
 	var place model.Place
 	if err := h.db.WithContext(ctx).Preload("Area").First(&place, "id = ?", params.PlaceId).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return &gen.GetPlaceBlockingNotFound{}, nil
+			res := NotFoundError("place not found")
+			return &res, nil
 		}
 		return nil, err
 	}
 
-	var blockings []model.Blocking
+	var blockings []*model.Blocking
 	query := h.db.WithContext(ctx).Where("entity_type = ? AND entity_id = ?", "place", params.PlaceId)
 
 	// Include inherited blocking from area and building
@@ -168,21 +198,85 @@ func (h *PlaceHandler) GetPlaceBlocking(ctx context.Context, params gen.GetPlace
 		return nil, err
 	}
 
-	return &gen.BlockingResponse{
-		Entries: converter.BlockingsToAPI(blockings),
-	}, nil
+	res := gen.GetPlaceBlockingOKApplicationJSON(converter.BlockingsToAPI(blockings))
+	return &res, nil
+}
+
+// GetPlaceQrKey returns the current QR key of a place.
+// GET /places/{placeId}/qrCode/key
+func (h *PlaceHandler) GetPlaceQrKey(ctx context.Context, params gen.GetPlaceQrKeyParams) (gen.GetPlaceQrKeyRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManagePlaces, auth.PlaceScope(params.PlaceId)); err != nil {
+		return nil, err
+	}
+
+	place, err := dbgen.PlaceQuery[model.Place](h.db).GetByID(ctx, params.PlaceId)
+	if err != nil {
+		return nil, err
+	}
+	if place == nil {
+		res := gen.GetPlaceQrKeyNotFound(NotFoundError("place not found"))
+		return &res, nil
+	}
+
+	return converter.PlaceQrKeyToAPI(place, h.qrCodes), nil
+}
+
+// RotatePlaceQrKey invalidates all QR codes of a place printed so far.
+// POST /places/{placeId}/qrCode/key
+func (h *PlaceHandler) RotatePlaceQrKey(ctx context.Context, params gen.RotatePlaceQrKeyParams) (gen.RotatePlaceQrKeyRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManagePlaces, auth.PlaceScope(params.PlaceId)); err != nil {
+		return nil, err
+	}
+
+	places := dbgen.PlaceQuery[model.Place](h.db)
+	if err := places.RotateQRKey(ctx, params.PlaceId); err != nil {
+		return nil, err
+	}
+	place, err := places.GetByID(ctx, params.PlaceId)
+	if err != nil {
+		return nil, err
+	}
+	if place == nil {
+		res := gen.RotatePlaceQrKeyNotFound(NotFoundError("place not found"))
+		return &res, nil
+	}
+
+	return converter.PlaceQrKeyToAPI(place, h.qrCodes), nil
 }
 
 // GetPlaceCalendar returns .ics file with blocking periods and reservations.
 // GET /places/{placeId}/calendar.ics
 func (h *PlaceHandler) GetPlaceCalendar(ctx context.Context, params gen.GetPlaceCalendarParams) (gen.GetPlaceCalendarRes, error) {
-	// TODO: Generate ICS calendar
-	return &gen.GetPlaceCalendarOKHeaders{}, nil
+	place, err := dbgen.PlaceQuery[model.Place](h.db).GetByID(ctx, params.PlaceId)
+	if err != nil {
+		return nil, err
+	}
+	if place == nil {
+		res := NotFoundError("place not found")
+		return &res, nil
+	}
+
+	loc := h.reservations.Location()
+	from, to := calendar.Window(time.Now(), loc)
+	feed, err := calendar.ForPlace(ctx, h.db, place, from, to, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, notModified := calendarFeed(feed, params.IfNoneMatch)
+	if notModified != nil {
+		return notModified, nil
+	}
+	return res, nil
 }
 
 // AddPlaceBlockingEntries adds blocking periods to place.
 // POST /places/{placeId}/blocking/entries
 func (h *PlaceHandler) AddPlaceBlockingEntries(ctx context.Context, req *gen.AddPlaceBlockingEntriesReq, params gen.AddPlaceBlockingEntriesParams) (gen.AddPlaceBlockingEntriesRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManagePlaces, auth.PlaceScope(params.PlaceId)); err != nil {
+		return nil, err
+	}
+
 	var place model.Place
 	if err := h.db.WithContext(ctx).First(&place, "id = ?", params.PlaceId).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -191,27 +285,36 @@ func (h *PlaceHandler) AddPlaceBlockingEntries(ctx context.Context, req *gen.Add
 		return nil, err
 	}
 
-	var blockings []model.Blocking
+	if err := validateBlockings("place", params.PlaceId, req.Entries); err != nil {
+		res := gen.AddPlaceBlockingEntriesBadRequest(BadRequestError(err.Error()))
+		return &res, nil
+	}
+
+	blockings := make([]*model.Blocking, 0, len(req.Entries))
 	for _, entry := range req.Entries {
-		blocking := converter.BlockingEntryToModel(&entry, "place", params.PlaceId)
-		blockings = append(blockings, *blocking)
+		blockings = append(blockings, converter.CreateBlockingRequestToModel(&entry, "place", params.PlaceId))
 	}
 
-	if err := h.db.WithContext(ctx).Create(&blockings).Error; err != nil {
-		return nil, err
+	if len(blockings) > 0 {
+		if err := h.db.WithContext(ctx).Create(&blockings).Error; err != nil {
+			return nil, err
+		}
 	}
 
-	return &gen.BlockingResponse{
-		Entries: converter.BlockingsToAPI(blockings),
-	}, nil
+	res := gen.AddPlaceBlockingEntriesCreatedApplicationJSON(converter.BlockingsToAPI(blockings))
+	return &res, nil
 }
 
 // RemovePlaceBlockingEntries removes blocking periods by IDs.
 // DELETE /places/{placeId}/blocking/entries
 func (h *PlaceHandler) RemovePlaceBlockingEntries(ctx context.Context, req *gen.RemovePlaceBlockingEntriesReq, params gen.RemovePlaceBlockingEntriesParams) (gen.RemovePlaceBlockingEntriesRes, error) {
-	result := h.db.WithContext(ctx).Where("entity_type = ? AND entity_id = ? AND id IN ?", "place", params.PlaceId, req.Ids).Delete(&model.Blocking{})
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManagePlaces, auth.PlaceScope(params.PlaceId)); err != nil {
+		return nil, err
+	}
+
+	result := h.db.WithContext(ctx).Where("entity_type = ? AND entity_id = ? AND id IN ?", "place", params.PlaceId, req.BlockingIds).Delete(&model.Blocking{})
 	if result.Error != nil {
-		return &gen.Error{Message: result.Error.Error()}, nil
+		return nil, result.Error
 	}
 	return &gen.RemovePlaceBlockingEntriesNoContent{}, nil
 }
@@ -219,6 +322,10 @@ func (h *PlaceHandler) RemovePlaceBlockingEntries(ctx context.Context, req *gen.
 // ReplacePlaceBlocking replaces all place blocking periods.
 // PUT /places/{placeId}/blocking
 func (h *PlaceHandler) ReplacePlaceBlocking(ctx context.Context, req *gen.ReplacePlaceBlockingReq, params gen.ReplacePlaceBlockingParams) (gen.ReplacePlaceBlockingRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManagePlaces, auth.PlaceScope(params.PlaceId)); err != nil {
+		return nil, err
+	}
+
 	var place model.Place
 	if err := h.db.WithContext(ctx).First(&place, "id = ?", params.PlaceId).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -227,16 +334,20 @@ func (h *PlaceHandler) ReplacePlaceBlocking(ctx context.Context, req *gen.Replac
 		return nil, err
 	}
 
+	if err := validateBlockings("place", params.PlaceId, req.Blockings); err != nil {
+		res := gen.ReplacePlaceBlockingBadRequest(BadRequestError(err.Error()))
+		return &res, nil
+	}
+
 	// Delete existing
 	if err := h.db.WithContext(ctx).Where("entity_type = ? AND entity_id = ?", "place", params.PlaceId).Delete(&model.Blocking{}).Error; err != nil {
 		return nil, err
 	}
 
 	// Create new
-	var blockings []model.Blocking
-	for _, entry := range req.Entries {
-		blocking := converter.BlockingEntryToModel(&entry, "place", params.PlaceId)
-		blockings = append(blockings, *blocking)
+	blockings := make([]*model.Blocking, 0, len(req.Blockings))
+	for _, entry := range req.Blockings {
+		blockings = append(blockings, converter.CreateBlockingRequestToModel(&entry, "place", params.PlaceId))
 	}
 
 	if len(blockings) > 0 {
@@ -245,9 +356,8 @@ func (h *PlaceHandler) ReplacePlaceBlocking(ctx context.Context, req *gen.Replac
 		}
 	}
 
-	return &gen.BlockingResponse{
-		Entries: converter.BlockingsToAPI(blockings),
-	}, nil
+	res := gen.ReplacePlaceBlockingOKApplicationJSON(converter.BlockingsToAPI(blockings))
+	return &res, nil
 }
 
 // GetPlaceConstraints gets place constraints.
@@ -256,16 +366,25 @@ func (h *PlaceHandler) GetPlaceConstraints(ctx context.Context, params gen.GetPl
 	var place model.Place
 	if err := h.db.WithContext(ctx).First(&place, "id = ?", params.PlaceId).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return &gen.GetPlaceConstraintsNotFound{}, nil
+			res := NotFoundError("place not found")
+			return &res, nil
 		}
 		return nil, err
 	}
-	return converter.PlaceConstraintsToAPI(&place), nil
+	c, err := constraints.Load(ctx, h.db, constraints.EntityPlace, place.ID)
+	if err != nil {
+		return nil, err
+	}
+	return converter.PlaceConstraintsToAPI(c), nil
 }
 
 // UpdatePlaceConstraints updates place constraints.
 // PUT /places/{placeId}/constraints
 func (h *PlaceHandler) UpdatePlaceConstraints(ctx context.Context, req *gen.PlaceConstraints, params gen.UpdatePlaceConstraintsParams) (gen.UpdatePlaceConstraintsRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManagePlaces, auth.PlaceScope(params.PlaceId)); err != nil {
+		return nil, err
+	}
+
 	var place model.Place
 	if err := h.db.WithContext(ctx).First(&place, "id = ?", params.PlaceId).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -274,13 +393,40 @@ func (h *PlaceHandler) UpdatePlaceConstraints(ctx context.Context, req *gen.Plac
 		return nil, err
 	}
 
-	converter.UpdatePlaceConstraintsToModel(req, &place)
+	c, err := constraints.Load(ctx, h.db, constraints.EntityPlace, place.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := converter.UpdatePlaceConstraintsRequestToModel(req, c); err != nil {
+		res := gen.UpdatePlaceConstraintsBadRequest(BadRequestError(err.Error()))
+		return &res, nil
+	}
 
-	if err := h.db.WithContext(ctx).Save(&place).Error; err != nil {
+	if err := h.db.WithContext(ctx).Save(c).Error; err != nil {
 		return nil, err
 	}
 
-	return converter.PlaceConstraintsToAPI(&place), nil
+	return converter.PlaceConstraintsToAPI(c), nil
+}
+
+// GetEffectivePlaceConstraints returns the constraints that apply to the
+// place, including those inherited from its area and building.
+// GET /places/{placeId}/constraints/effective
+func (h *PlaceHandler) GetEffectivePlaceConstraints(ctx context.Context, params gen.GetEffectivePlaceConstraintsParams) (gen.GetEffectivePlaceConstraintsRes, error) {
+	place, err := dbgen.PlaceQuery[model.Place](h.db).GetByID(ctx, params.PlaceId)
+	if err != nil {
+		return nil, err
+	}
+	if place == nil {
+		res := NotFoundError("place not found")
+		return &res, nil
+	}
+
+	effective, err := constraints.ForPlace(ctx, h.db, place)
+	if err != nil {
+		return nil, err
+	}
+	return converter.EffectivePlaceConstraintsToAPI(effective), nil
 }
 
 // GetPlaceTimeSlots returns the booking grid configuration for this place.
@@ -289,16 +435,26 @@ func (h *PlaceHandler) GetPlaceTimeSlots(ctx context.Context, params gen.GetPlac
 	var place model.Place
 	if err := h.db.WithContext(ctx).First(&place, "id = ?", params.PlaceId).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return &gen.GetPlaceTimeSlotsNotFound{}, nil
+			res := NotFoundError("place not found")
+			return &res, nil
 		}
 		return nil, err
 	}
-	return converter.TimeSlotConfigToAPI(&place), nil
+
+	config, err := h.reservations.TimeSlotConfig(ctx, place.ID)
+	if err != nil {
+		return nil, err
+	}
+	return converter.TimeSlotConfigToAPI(config), nil
 }
 
 // UpdatePlaceTimeSlots updates time slot configuration.
 // PUT /places/{placeId}/timeSlots
 func (h *PlaceHandler) UpdatePlaceTimeSlots(ctx context.Context, req *gen.TimeSlotConfig, params gen.UpdatePlaceTimeSlotsParams) (gen.UpdatePlaceTimeSlotsRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManagePlaces, auth.PlaceScope(params.PlaceId)); err != nil {
+		return nil, err
+	}
+
 	var place model.Place
 	if err := h.db.WithContext(ctx).First(&place, "id = ?", params.PlaceId).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -307,13 +463,17 @@ func (h *PlaceHandler) UpdatePlaceTimeSlots(ctx context.Context, req *gen.TimeSl
 		return nil, err
 	}
 
-	converter.UpdateTimeSlotConfigToModel(req, &place)
+	config, err := h.reservations.TimeSlotConfig(ctx, place.ID)
+	if err != nil {
+		return nil, err
+	}
+	converter.UpdateTimeSlotConfigRequestToModel(req, config)
 
-	if err := h.db.WithContext(ctx).Save(&place).Error; err != nil {
+	if err := h.db.WithContext(ctx).Save(config).Error; err != nil {
 		return nil, err
 	}
 
-	return converter.TimeSlotConfigToAPI(&place), nil
+	return converter.TimeSlotConfigToAPI(config), nil
 }
 
 // GetPlaceEquipment gets place equipment/amenities.
@@ -322,17 +482,23 @@ func (h *PlaceHandler) GetPlaceEquipment(ctx context.Context, params gen.GetPlac
 	var place model.Place
 	if err := h.db.WithContext(ctx).Preload("Equipment").First(&place, "id = ?", params.PlaceId).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return &gen.GetPlaceEquipmentNotFound{}, nil
+			res := NotFoundError("place not found")
+			return &res, nil
 		}
 		return nil, err
 	}
 
-	return &gen.GetPlaceEquipmentOKApplicationJSON(converter.EquipmentsToAPI(place.Equipment)), nil
+	res := gen.GetPlaceEquipmentOKApplicationJSON(converter.EquipmentSliceToAPI(place.Equipment))
+	return &res, nil
 }
 
 // AddPlaceEquipment adds equipment to place.
 // POST /places/{placeId}/equipment
 func (h *PlaceHandler) AddPlaceEquipment(ctx context.Context, req *gen.AddPlaceEquipmentReq, params gen.AddPlaceEquipmentParams) (gen.AddPlaceEquipmentRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManagePlaces, auth.PlaceScope(params.PlaceId)); err != nil {
+		return nil, err
+	}
+
 	var place model.Place
 	if err := h.db.WithContext(ctx).First(&place, "id = ?", params.PlaceId).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -357,12 +523,17 @@ func (h *PlaceHandler) AddPlaceEquipment(ctx context.Context, req *gen.AddPlaceE
 		return nil, err
 	}
 
-	return &gen.AddPlaceEquipmentOKApplicationJSON(converter.EquipmentsToAPI(place.Equipment)), nil
+	res := gen.AddPlaceEquipmentOKApplicationJSON(converter.EquipmentSliceToAPI(place.Equipment))
+	return &res, nil
 }
 
 // RemovePlaceEquipment removes equipment from place.
 // DELETE /places/{placeId}/equipment
 func (h *PlaceHandler) RemovePlaceEquipment(ctx context.Context, req *gen.RemovePlaceEquipmentReq, params gen.RemovePlaceEquipmentParams) (gen.RemovePlaceEquipmentRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManagePlaces, auth.PlaceScope(params.PlaceId)); err != nil {
+		return nil, err
+	}
+
 	var place model.Place
 	if err := h.db.WithContext(ctx).First(&place, "id = ?", params.PlaceId).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -388,20 +559,29 @@ func (h *PlaceHandler) RemovePlaceEquipment(ctx context.Context, req *gen.Remove
 // GetPlaceWhitelist gets user whitelist.
 // GET /places/{placeId}/whitelist
 func (h *PlaceHandler) GetPlaceWhitelist(ctx context.Context, params gen.GetPlaceWhitelistParams) (gen.GetPlaceWhitelistRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManagePlaces, auth.PlaceScope(params.PlaceId)); err != nil {
+		return nil, err
+	}
+
 	var place model.Place
-	if err := h.db.WithContext(ctx).Preload("WhitelistUsers").First(&place, "id = ?", params.PlaceId).Error; err != nil {
+	if err := h.db.WithContext(ctx).Preload("WhitelistedUsers").First(&place, "id = ?", params.PlaceId).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return &gen.GetPlaceWhitelistNotFound{}, nil
 		}
 		return nil, err
 	}
 
-	return &gen.GetPlaceWhitelistOKApplicationJSON(converter.UserReferencesToAPI(place.WhitelistUsers)), nil
+	res := gen.GetPlaceWhitelistOKApplicationJSON(converter.UserReferencePointersToAPI(place.WhitelistedUsers))
+	return &res, nil
 }
 
 // AddPlaceWhitelistUsers adds users to whitelist.
 // POST /places/{placeId}/whitelist
 func (h *PlaceHandler) AddPlaceWhitelistUsers(ctx context.Context, req *gen.AddPlaceWhitelistUsersReq, params gen.AddPlaceWhitelistUsersParams) (gen.AddPlaceWhitelistUsersRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManagePlaces, auth.PlaceScope(params.PlaceId)); err != nil {
+		return nil, err
+	}
+
 	var place model.Place
 	if err := h.db.WithContext(ctx).First(&place, "id = ?", params.PlaceId).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -417,21 +597,26 @@ func (h *PlaceHandler) AddPlaceWhitelistUsers(ctx context.Context, req *gen.AddP
 	}
 
 	// Add user association
-	if err := h.db.WithContext(ctx).Model(&place).Association("WhitelistUsers").Append(&users); err != nil {
+	if err := h.db.WithContext(ctx).Model(&place).Association("WhitelistedUsers").Append(&users); err != nil {
 		return nil, err
 	}
 
 	// Reload with whitelist
-	if err := h.db.WithContext(ctx).Preload("WhitelistUsers").First(&place, "id = ?", params.PlaceId).Error; err != nil {
+	if err := h.db.WithContext(ctx).Preload("WhitelistedUsers").First(&place, "id = ?", params.PlaceId).Error; err != nil {
 		return nil, err
 	}
 
-	return &gen.AddPlaceWhitelistUsersOKApplicationJSON(converter.UserReferencesToAPI(place.WhitelistUsers)), nil
+	res := gen.AddPlaceWhitelistUsersOKApplicationJSON(converter.UserReferencePointersToAPI(place.WhitelistedUsers))
+	return &res, nil
 }
 
 // RemovePlaceWhitelistUsers removes users from whitelist.
 // DELETE /places/{placeId}/whitelist
 func (h *PlaceHandler) RemovePlaceWhitelistUsers(ctx context.Context, req *gen.RemovePlaceWhitelistUsersReq, params gen.RemovePlaceWhitelistUsersParams) (gen.RemovePlaceWhitelistUsersRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManagePlaces, auth.PlaceScope(params.PlaceId)); err != nil {
+		return nil, err
+	}
+
 	var place model.Place
 	if err := h.db.WithContext(ctx).First(&place, "id = ?", params.PlaceId).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -447,24 +632,67 @@ func (h *PlaceHandler) RemovePlaceWhitelistUsers(ctx context.Context, req *gen.R
 	}
 
 	// Remove user association
-	if err := h.db.WithContext(ctx).Model(&place).Association("WhitelistUsers").Delete(&users); err != nil {
+	if err := h.db.WithContext(ctx).Model(&place).Association("WhitelistedUsers").Delete(&users); err != nil {
 		return nil, err
 	}
 
 	return &gen.RemovePlaceWhitelistUsersNoContent{}, nil
 }
 
-// GetPlaceQrCode generates a QR code.
+// GetPlaceQrCode renders the printable QR code label of a place. The symbol
+// encodes the check-in URL of the place; the requested details are printed
+// below it in a fixed order, unless a QR template is given to render the
+// label with.
 // GET /places/{placeId}/qrCode
 func (h *PlaceHandler) GetPlaceQrCode(ctx context.Context, params gen.GetPlaceQrCodeParams) (gen.GetPlaceQrCodeRes, error) {
+	if err := authorizeScoped(ctx, h.db, auth.PermissionManagePlaces, auth.PlaceScope(params.PlaceId)); err != nil {
+		return nil, err
+	}
+
 	var place model.Place
-	if err := h.db.WithContext(ctx).First(&place, "id = ?", params.PlaceId).Error; err != nil {
+	if err := h.db.WithContext(ctx).Preload("Area.Building").First(&place, "id = ?", params.PlaceId).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return &gen.GetPlaceQrCodeNotFound{}, nil
+			res := gen.GetPlaceQrCodeNotFound(NotFoundError("place not found"))
+			return &res, nil
+		}
+		return nil, err
+	}
+
+	var img *image.Gray
+	if params.TemplateId.IsSet() {
+		template, err := dbgen.QRTemplateQuery[model.QRTemplate](h.db).GetByID(ctx, params.TemplateId.Value)
+		if err != nil {
+			return nil, err
+		}
+		if template == nil {
+			res := gen.GetPlaceQrCodeNotFound(NotFoundError("QR template not found"))
+			return &res, nil
 		}
+		data, err := qr.NewTemplateData(&place, h.qrCodes.URL(place.ID, place.QRKeyVersion))
+		if err != nil {
+			return nil, err
+		}
+		if img, err = qr.RenderTemplate(ctx, template.HTMLTemplate, data); err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		if img, err = qr.Render(converter.PlaceQrLabel(&place, h.qrCodes, params.IncludeDetails)); err != nil {
+			return nil, err
+		}
+	}
+	format := qr.Format(params.Format.Or(gen.GetPlaceQrCodeFormatPNG))
+	var buf bytes.Buffer
+	if err := qr.Encode(&buf, img, format); err != nil {
 		return nil, err
 	}
 
-	// TODO: Generate QR code
-	return &gen.GetPlaceQrCodeOKHeaders{}, nil
+	switch format {
+	case qr.FormatJPEG:
+		return &gen.GetPlaceQrCodeOKImageJpeg{Data: &buf}, nil
+	case qr.FormatPDF:
+		return &gen.GetPlaceQrCodeOKApplicationPdf{Data: &buf}, nil
+	default:
+		return &gen.GetPlaceQrCodeOKImagePNG{Data: &buf}, nil
+	}
 }
@@ -1,61 +1,211 @@
 package handler
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"strings"
 
+	"github.com/google/uuid"
 	"github.com/pixlcrashr/roomy/pkg/api/ogen/gen"
+	"github.com/pixlcrashr/roomy/pkg/api/ogen/handler/converter"
+	"github.com/pixlcrashr/roomy/pkg/auth"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"github.com/pixlcrashr/roomy/pkg/qr"
 	"gorm.io/gorm"
 )
 
 // QRTemplateHandler handles QR code template operations.
 type QRTemplateHandler struct {
-	db *gorm.DB
+	db      *gorm.DB
+	qrCodes *auth.QRSigner
 }
 
 // NewQRTemplateHandler creates a new QRTemplateHandler.
-func NewQRTemplateHandler(db *gorm.DB) *QRTemplateHandler {
-	return &QRTemplateHandler{db: db}
+func NewQRTemplateHandler(db *gorm.DB, qrCodes *auth.QRSigner) *QRTemplateHandler {
+	return &QRTemplateHandler{db: db, qrCodes: qrCodes}
 }
 
 // CreateQrTemplate creates a new QR code template.
 // POST /qr-templates
 func (h *QRTemplateHandler) CreateQrTemplate(ctx context.Context, req *gen.CreateQRTemplateRequest) (gen.CreateQrTemplateRes, error) {
-	// TODO: Implement create QR template
-	return &gen.QRTemplate{}, nil
+	if err := h.validate(ctx, req.HtmlTemplate); err != nil {
+		if errors.Is(err, qr.ErrInvalidTemplate) {
+			res := gen.CreateQrTemplateBadRequest(BadRequestError(err.Error()))
+			return &res, nil
+		}
+		return nil, err
+	}
+
+	id := uuid.New()
+	templates := dbgen.QRTemplateQuery[model.QRTemplate](h.db)
+	if err := templates.Insert(ctx, id, req.Name, req.HtmlTemplate); err != nil {
+		return nil, err
+	}
+
+	template, err := templates.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return converter.QRTemplateToAPI(template), nil
 }
 
 // GetQrTemplate gets QR template details.
 // GET /qr-templates/{templateId}
 func (h *QRTemplateHandler) GetQrTemplate(ctx context.Context, params gen.GetQrTemplateParams) (gen.GetQrTemplateRes, error) {
-	// TODO: Implement get QR template
-	return &gen.GetQrTemplateNotFound{}, nil
+	template, err := dbgen.QRTemplateQuery[model.QRTemplate](h.db).GetByID(ctx, params.TemplateId)
+	if err != nil {
+		return nil, err
+	}
+	if template == nil {
+		res := gen.GetQrTemplateNotFound(NotFoundError("QR template not found"))
+		return &res, nil
+	}
+	return converter.QRTemplateToAPI(template), nil
 }
 
 // UpdateQrTemplate updates a QR template.
 // PUT /qr-templates/{templateId}
 func (h *QRTemplateHandler) UpdateQrTemplate(ctx context.Context, req *gen.UpdateQRTemplateRequest, params gen.UpdateQrTemplateParams) (gen.UpdateQrTemplateRes, error) {
-	// TODO: Implement update QR template
-	return &gen.UpdateQrTemplateNotFound{}, nil
+	templates := dbgen.QRTemplateQuery[model.QRTemplate](h.db)
+	existing, err := templates.GetByID(ctx, params.TemplateId)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		res := gen.UpdateQrTemplateNotFound(NotFoundError("QR template not found"))
+		return &res, nil
+	}
+
+	var name, htmlTemplate *string
+	if req.Name.IsSet() {
+		n := req.Name.Value
+		name = &n
+	}
+	if req.HtmlTemplate.IsSet() {
+		src := req.HtmlTemplate.Value
+		if err := h.validate(ctx, src); err != nil {
+			if errors.Is(err, qr.ErrInvalidTemplate) {
+				res := gen.UpdateQrTemplateBadRequest(BadRequestError(err.Error()))
+				return &res, nil
+			}
+			return nil, err
+		}
+		htmlTemplate = &src
+	}
+
+	if err := templates.Save(ctx, params.TemplateId, name, htmlTemplate); err != nil {
+		return nil, err
+	}
+
+	updated, err := templates.GetByID(ctx, params.TemplateId)
+	if err != nil {
+		return nil, err
+	}
+	return converter.QRTemplateToAPI(updated), nil
 }
 
 // DeleteQrTemplate deletes a QR template.
 // DELETE /qr-templates/{templateId}
 func (h *QRTemplateHandler) DeleteQrTemplate(ctx context.Context, params gen.DeleteQrTemplateParams) (gen.DeleteQrTemplateRes, error) {
-	// TODO: Implement delete QR template
+	templates := dbgen.QRTemplateQuery[model.QRTemplate](h.db)
+	existing, err := templates.GetByID(ctx, params.TemplateId)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		res := gen.DeleteQrTemplateNotFound(NotFoundError("QR template not found"))
+		return &res, nil
+	}
+
+	if err := templates.Remove(ctx, params.TemplateId); err != nil {
+		return nil, err
+	}
 	return &gen.DeleteQrTemplateNoContent{}, nil
 }
 
 // ListQrTemplates lists all QR templates.
 // GET /qr-templates
 func (h *QRTemplateHandler) ListQrTemplates(ctx context.Context) (gen.ListQrTemplatesRes, error) {
-	// TODO: Implement list QR templates
-	result := gen.ListQrTemplatesOKApplicationJSON([]gen.QRTemplate{})
+	templates, err := dbgen.QRTemplateQuery[model.QRTemplate](h.db).List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := gen.ListQrTemplatesOKApplicationJSON(converter.QRTemplatesToAPI(templates))
 	return &result, nil
 }
 
-// PreviewQrTemplate generates a preview of the QR code with the template.
+// PreviewQrTemplate renders a template with sample data, either as HTML or
+// as the label image it prints as.
 // GET /qr-templates/{templateId}/preview
 func (h *QRTemplateHandler) PreviewQrTemplate(ctx context.Context, params gen.PreviewQrTemplateParams) (gen.PreviewQrTemplateRes, error) {
-	// TODO: Implement QR template preview
-	return &gen.PreviewQrTemplateNotFound{}, nil
+	template, err := dbgen.QRTemplateQuery[model.QRTemplate](h.db).GetByID(ctx, params.TemplateId)
+	if err != nil {
+		return nil, err
+	}
+	if template == nil {
+		res := gen.PreviewQrTemplateNotFound(NotFoundError("QR template not found"))
+		return &res, nil
+	}
+
+	t, err := qr.ParseTemplate(template.HTMLTemplate)
+	if err != nil {
+		return nil, err
+	}
+	sample, err := h.sampleData()
+	if err != nil {
+		return nil, err
+	}
+	doc, err := t.Execute(ctx, sample)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Format.Or(gen.PreviewQrTemplateFormatHTML) == gen.PreviewQrTemplateFormatHTML {
+		return &gen.PreviewQrTemplateOKTextHTML{Data: strings.NewReader(doc)}, nil
+	}
+	img, err := qr.RenderHTML(doc, sample)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := qr.Encode(&buf, img, qr.FormatPNG); err != nil {
+		return nil, err
+	}
+	return &gen.PreviewQrTemplateOKImagePNG{Data: &buf}, nil
+}
+
+// validate checks that a template source renders with sample data.
+func (h *QRTemplateHandler) validate(ctx context.Context, src string) error {
+	sample, err := h.sampleData()
+	if err != nil {
+		return err
+	}
+	return qr.ValidateTemplate(ctx, src, sample)
+}
+
+// sampleData returns the sample data of previews. Its check-in URL is
+// signed for a place that does not exist.
+func (h *QRTemplateHandler) sampleData() (qr.TemplateData, error) {
+	return qr.SampleData(h.qrCodes.URL(uuid.Nil, 1))
+}
+
+// qrExportRequest returns the export request of the common query parameters
+// of QR code exports.
+func qrExportRequest(templateID gen.OptUUID, format gen.OptQrExportFormatParam, paper gen.OptQrExportPaperParam) qr.ExportRequest {
+	req := qr.ExportRequest{
+		Format: qr.ExportFormat(format.Or(gen.QrExportFormatParamPdf)),
+		Paper:  qr.Paper(paper.Or(gen.QrExportPaperParamA4)),
+	}
+	if templateID.IsSet() {
+		req.TemplateID = &templateID.Value
+	}
+	return req
+}
+
+// isQrExportRejection reports whether an export failed because of what was
+// requested rather than an internal error.
+func isQrExportRejection(err error) bool {
+	return errors.Is(err, qr.ErrNoPlaces) || errors.Is(err, qr.ErrTooManyPlaces) || errors.Is(err, qr.ErrInvalidTemplate)
 }
@@ -2,28 +2,98 @@ package handler
 
 import (
 	"context"
+	"errors"
 
 	"github.com/pixlcrashr/roomy/pkg/api/ogen/gen"
+	"github.com/pixlcrashr/roomy/pkg/api/ogen/handler/converter"
+	"github.com/pixlcrashr/roomy/pkg/auth"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"github.com/pixlcrashr/roomy/pkg/reservation"
 	"gorm.io/gorm"
 )
 
 // ReservationHandler handles reservation-related operations.
 type ReservationHandler struct {
-	db *gorm.DB
+	db           *gorm.DB
+	reservations *reservation.Service
 }
 
 // NewReservationHandler creates a new ReservationHandler.
-func NewReservationHandler(db *gorm.DB) *ReservationHandler {
-	return &ReservationHandler{db: db}
+func NewReservationHandler(db *gorm.DB, reservations *reservation.Service) *ReservationHandler {
+	return &ReservationHandler{db: db, reservations: reservations}
 }
 
-// CreateReservation creates a single reservation or multiple recurring reservations.
+// CreateReservation creates a single reservation or multiple recurring reservations,
+// for the caller or on behalf of another user.
 // POST /reservations
 func (h *ReservationHandler) CreateReservation(ctx context.Context, req *gen.CreateReservationRequest) (gen.CreateReservationRes, error) {
-	// TODO: Implement reservation creation
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		res := gen.CreateReservationUnauthorized(UnauthorizedError("authentication required"))
+		return &res, nil
+	}
+
+	request := reservation.Request{
+		PlaceID: req.PlaceId,
+		UserID:  principal.User.ID,
+		Start:   req.StartTime,
+		End:     req.EndTime,
+	}
+	bypassQuotas, bypassBlockings := req.BypassQuotas.Or(false), req.BypassBlockings.Or(false)
+	if req.UserId.Set || req.UserEmail.Set || bypassQuotas || bypassBlockings {
+		if req.UserId.Set && req.UserEmail.Set {
+			res := gen.CreateReservationBadRequest(BadRequestError("userId and userEmail are mutually exclusive"))
+			return &res, nil
+		}
+		if err := authorizeScoped(ctx, h.db, auth.PermissionManageReservations, auth.PlaceScope(req.PlaceId)); err != nil {
+			return nil, err
+		}
+		user := principal.User
+		if req.UserId.Set || req.UserEmail.Set {
+			var err error
+			if user, err = h.reservationUser(ctx, req); err != nil {
+				return nil, err
+			}
+		}
+		if user == nil {
+			return createReservationRejected(&reservation.Rejection{Code: reservation.CodeUserNotFound, Message: "user does not exist"}), nil
+		}
+		request.UserID = user.ID
+		request.Bypass = reservation.Bypass{Quotas: bypassQuotas, Blockings: bypassBlockings}
+		if user.ID != principal.User.ID {
+			request.OnBehalf = &reservation.OnBehalf{ActorID: principal.User.ID}
+		}
+	}
+
+	if recurrence, ok := req.Recurrence.Get(); ok {
+		mode := reservation.SeriesMode(recurrence.Mode.Or(gen.CreateReservationRequestRecurrenceModeAllOrNothing))
+		series, err := h.reservations.CreateSeries(ctx, request, converter.RecurrenceToDomain(recurrence), mode)
+		if err != nil {
+			var rejection *reservation.Rejection
+			if errors.As(err, &rejection) {
+				return createReservationRejected(rejection), nil
+			}
+			return nil, err
+		}
+		return &gen.CreateReservationCreated{
+			Type:              gen.ReservationSeriesCreateReservationCreated,
+			ReservationSeries: *converter.ReservationSeriesToAPI(series),
+		}, nil
+	}
+
+	created, err := h.reservations.Create(ctx, request)
+	if err != nil {
+		var rejection *reservation.Rejection
+		if errors.As(err, &rejection) {
+			return createReservationRejected(rejection), nil
+		}
+		return nil, err
+	}
+
 	return &gen.CreateReservationCreated{
 		Type:        gen.ReservationCreateReservationCreated,
-		Reservation: gen.Reservation{},
+		Reservation: *converter.ReservationToAPI(created),
 	}, nil
 }
 
@@ -37,14 +107,82 @@ func (h *ReservationHandler) GetReservation(ctx context.Context, params gen.GetR
 // UpdateReservation modifies the reservation's start and/or end time.
 // PUT /reservations/{reservationId}
 func (h *ReservationHandler) UpdateReservation(ctx context.Context, req *gen.UpdateReservationRequest, params gen.UpdateReservationParams) (gen.UpdateReservationRes, error) {
-	// TODO: Implement update reservation
-	return &gen.UpdateReservationNotFound{}, nil
+	existing, err := dbgen.ReservationQuery[model.Reservation](h.db).GetByID(ctx, params.ReservationId)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		res := gen.UpdateReservationNotFound(NotFoundError("reservation not found"))
+		return &res, nil
+	}
+	if err := authorizeReservation(ctx, h.db, existing); err != nil {
+		return nil, err
+	}
+	bypassQuotas, bypassBlockings := req.BypassQuotas.Or(false), req.BypassBlockings.Or(false)
+	if bypassQuotas || bypassBlockings {
+		// Owners may move their reservations, but only managers bypass rules.
+		if err := authorizeScoped(ctx, h.db, auth.PermissionManageReservations, auth.PlaceScope(existing.PlaceID)); err != nil {
+			return nil, err
+		}
+	}
+	bypass := reservation.Bypass{Quotas: bypassQuotas, Blockings: bypassBlockings}
+
+	scope := reservation.Scope(params.Scope.Or(gen.SeriesScopeParamOccurrence))
+	updated, err := h.reservations.Update(ctx, existing.ID, req.StartTime.Or(existing.StartTime), req.EndTime.Or(existing.EndTime), scope, bypass, reservationActor(ctx, existing))
+	if err != nil {
+		if errors.Is(err, reservation.ErrNotFound) {
+			res := gen.UpdateReservationNotFound(NotFoundError("reservation not found"))
+			return &res, nil
+		}
+		var rejection *reservation.Rejection
+		if errors.As(err, &rejection) {
+			body := rejectionError(rejection)
+			switch {
+			case rejection.IsConflict():
+				res := gen.UpdateReservationConflict(body)
+				return &res, nil
+			case rejection.IsForbidden():
+				res := gen.UpdateReservationForbidden(body)
+				return &res, nil
+			default:
+				res := gen.UpdateReservationBadRequest(body)
+				return &res, nil
+			}
+		}
+		return nil, err
+	}
+
+	return converter.ReservationToAPI(updated), nil
 }
 
 // CancelReservation cancels a reservation.
 // DELETE /reservations/{reservationId}
 func (h *ReservationHandler) CancelReservation(ctx context.Context, params gen.CancelReservationParams) (gen.CancelReservationRes, error) {
-	// TODO: Implement cancel reservation
+	existing, err := dbgen.ReservationQuery[model.Reservation](h.db).GetByID(ctx, params.ReservationId)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		res := gen.CancelReservationNotFound(NotFoundError("reservation not found"))
+		return &res, nil
+	}
+	if err := authorizeReservation(ctx, h.db, existing); err != nil {
+		return nil, err
+	}
+
+	scope := reservation.Scope(params.Scope.Or(gen.SeriesScopeParamOccurrence))
+	if err := h.reservations.Cancel(ctx, existing.ID, scope, nil, reservationActor(ctx, existing)); err != nil {
+		if errors.Is(err, reservation.ErrNotFound) {
+			res := gen.CancelReservationNotFound(NotFoundError("reservation not found"))
+			return &res, nil
+		}
+		var rejection *reservation.Rejection
+		if errors.As(err, &rejection) {
+			res := gen.CancelReservationConflict(rejectionError(rejection))
+			return &res, nil
+		}
+		return nil, err
+	}
 	return &gen.CancelReservationNoContent{}, nil
 }
 
@@ -66,8 +204,42 @@ func (h *ReservationHandler) ListReservations(ctx context.Context, params gen.Li
 // CheckInReservation checks in to a reservation.
 // POST /reservations/{reservationId}/checkIn
 func (h *ReservationHandler) CheckInReservation(ctx context.Context, req gen.OptCheckInReservationReq, params gen.CheckInReservationParams) (gen.CheckInReservationRes, error) {
-	// TODO: Implement check-in
-	return &gen.CheckInReservationNotFound{}, nil
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		res := gen.CheckInReservationUnauthorized(UnauthorizedError("authentication required"))
+		return &res, nil
+	}
+
+	request := reservation.CheckInRequest{ReservationID: params.ReservationId, UserID: principal.User.ID}
+	if qrCode, ok := req.Value.QrCode.Get(); req.Set && ok {
+		request.QRCode = &qrCode
+	}
+
+	checkedIn, err := h.reservations.CheckIn(ctx, request)
+	if err != nil {
+		if errors.Is(err, reservation.ErrNotFound) {
+			res := gen.CheckInReservationNotFound(NotFoundError("reservation not found"))
+			return &res, nil
+		}
+		var rejection *reservation.Rejection
+		if errors.As(err, &rejection) {
+			body := rejectionError(rejection)
+			switch {
+			case rejection.IsConflict():
+				res := gen.CheckInReservationConflict(body)
+				return &res, nil
+			case rejection.IsForbidden():
+				res := gen.CheckInReservationForbidden(body)
+				return &res, nil
+			default:
+				res := gen.CheckInReservationBadRequest(body)
+				return &res, nil
+			}
+		}
+		return nil, err
+	}
+
+	return converter.ReservationToAPI(checkedIn), nil
 }
 
 // GetReservationShareLink returns a shareable URL and metadata.
@@ -85,3 +257,60 @@ func (h *ReservationHandler) ExportReservations(ctx context.Context, params gen.
 		Data: nil,
 	}, nil
 }
+
+// createReservationRejected maps a booking rule violation to the matching
+// error response.
+func createReservationRejected(rejection *reservation.Rejection) gen.CreateReservationRes {
+	body := rejectionError(rejection)
+	switch {
+	case rejection.IsConflict():
+		res := gen.CreateReservationConflict(body)
+		return &res
+	case rejection.IsForbidden():
+		res := gen.CreateReservationForbidden(body)
+		return &res
+	default:
+		res := gen.CreateReservationBadRequest(body)
+		return &res
+	}
+}
+
+// rejectionError converts a booking rule violation into an error response
+// that uses the rejection code as error code.
+func rejectionError(rejection *reservation.Rejection) gen.ErrorResponse {
+	res := NewErrorResponse(string(rejection.Code), rejection.Message)
+	if len(rejection.Conflicts) > 0 {
+		res.Error.Details.SetTo(converter.OccurrenceConflictsToAPI(rejection.Conflicts))
+	}
+	return res
+}
+
+// reservationUser returns the user a reservation is booked for, given by ID
+// or email, or nil if no such user exists.
+func (h *ReservationHandler) reservationUser(ctx context.Context, req *gen.CreateReservationRequest) (*model.User, error) {
+	users := dbgen.UserQuery[model.User](h.db)
+	if email, ok := req.UserEmail.Get(); ok {
+		return users.GetByEmail(ctx, email)
+	}
+	return users.GetByID(ctx, req.UserId.Value)
+}
+
+// reservationActor describes a change of a reservation by the principal. It
+// is made on behalf of the owner if the principal is someone else, and nil
+// otherwise.
+func reservationActor(ctx context.Context, r *model.Reservation) *reservation.OnBehalf {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok || principal.User.ID == r.UserID {
+		return nil
+	}
+	return &reservation.OnBehalf{ActorID: principal.User.ID}
+}
+
+// authorizeReservation allows the owner of a reservation and principals
+// that may manage reservations of its place to modify it.
+func authorizeReservation(ctx context.Context, db *gorm.DB, r *model.Reservation) error {
+	if principal, ok := auth.PrincipalFromContext(ctx); ok && principal.User.ID == r.UserID {
+		return nil
+	}
+	return authorizeScoped(ctx, db, auth.PermissionManageReservations, auth.PlaceScope(r.PlaceID))
+}
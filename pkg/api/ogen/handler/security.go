@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ogen-go/ogen/ogenerrors"
+	"github.com/pixlcrashr/roomy/pkg/api/ogen/gen"
+	"github.com/pixlcrashr/roomy/pkg/auth"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"gorm.io/gorm"
+)
+
+// SecurityHandler implements the gen.SecurityHandler interface.
+// Both schemes resolve to an auth.Principal stored in the request context.
+type SecurityHandler struct {
+	db     *gorm.DB
+	tokens *auth.TokenManager
+	usage  *auth.APIKeyUsageTracker
+}
+
+// Verify that SecurityHandler implements gen.SecurityHandler at compile time.
+var _ gen.SecurityHandler = (*SecurityHandler)(nil)
+
+// NewSecurityHandler creates a new SecurityHandler.
+func NewSecurityHandler(db *gorm.DB, tokens *auth.TokenManager, usage *auth.APIKeyUsageTracker) *SecurityHandler {
+	return &SecurityHandler{db: db, tokens: tokens, usage: usage}
+}
+
+// HandleApiKeyAuth authenticates a request using the X-Api-Key header.
+func (h *SecurityHandler) HandleApiKeyAuth(ctx context.Context, operationName gen.OperationName, t gen.ApiKeyAuth) (context.Context, error) {
+	if t.APIKey == "" {
+		return ctx, ogenerrors.ErrSkipServerSecurity
+	}
+
+	key, err := dbgen.APIKeyQuery[model.APIKey](h.db).GetByValue(ctx, t.APIKey)
+	if err != nil {
+		return ctx, err
+	}
+	if key == nil {
+		return ctx, ogenerrors.ErrSkipServerSecurity
+	}
+	if key.ExpiresAt != nil && !key.ExpiresAt.After(time.Now()) {
+		return ctx, auth.ErrAPIKeyExpired
+	}
+
+	principal, err := h.loadPrincipal(ctx, key.UserID, auth.MethodAPIKey)
+	if err != nil {
+		return ctx, err
+	}
+	if principal == nil {
+		return ctx, ogenerrors.ErrSkipServerSecurity
+	}
+	principal.APIKeyID = key.ID
+
+	h.usage.Touch(key.ID)
+
+	return auth.WithPrincipal(ctx, principal), nil
+}
+
+// HandleBearerAuth authenticates a request using a bearer JWT.
+func (h *SecurityHandler) HandleBearerAuth(ctx context.Context, operationName gen.OperationName, t gen.BearerAuth) (context.Context, error) {
+	claims, err := h.tokens.ParseAccessToken(t.Token)
+	if err != nil {
+		return ctx, ogenerrors.ErrSkipServerSecurity
+	}
+	userID, err := claims.UserID()
+	if err != nil {
+		return ctx, ogenerrors.ErrSkipServerSecurity
+	}
+
+	principal, err := h.loadPrincipal(ctx, userID, auth.MethodBearer)
+	if err != nil {
+		return ctx, err
+	}
+	if principal == nil {
+		return ctx, ogenerrors.ErrSkipServerSecurity
+	}
+	principal.SessionID = claims.SessionID
+
+	return auth.WithPrincipal(ctx, principal), nil
+}
+
+// loadPrincipal loads the user and their effective permissions. It returns
+// nil if the user does not exist and auth.ErrUserDisabled if it is disabled.
+func (h *SecurityHandler) loadPrincipal(ctx context.Context, userID uuid.UUID, method auth.Method) (*auth.Principal, error) {
+	user, err := dbgen.UserQuery[model.User](h.db).GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, nil
+	}
+	if !user.IsActive {
+		return nil, auth.ErrUserDisabled
+	}
+
+	grants, err := effectiveGrants(ctx, h.db, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return auth.NewPrincipal(user, method, grants), nil
+}
+
+// effectiveGrants returns the union of the permission grants of all groups the
+// user belongs to. Members of the system group hold every permission globally.
+func effectiveGrants(ctx context.Context, db *gorm.DB, userID uuid.UUID) ([]auth.Grant, error) {
+	groups, err := dbgen.GroupQuery[model.Group](db).ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		if group.IsSystem {
+			names := auth.PermissionNames()
+			grants := make([]auth.Grant, len(names))
+			for i, name := range names {
+				grants[i] = auth.Grant{Permission: name}
+			}
+			return grants, nil
+		}
+	}
+
+	rows, err := dbgen.GroupPermissionQuery[model.GroupPermission](db).ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return grantsFromModel(rows), nil
+}
+
+// grantsFromModel converts persisted group permissions to grants.
+func grantsFromModel(rows []*model.GroupPermission) []auth.Grant {
+	grants := make([]auth.Grant, len(rows))
+	for i, row := range rows {
+		grants[i] = auth.Grant{Permission: row.Permission}
+		if row.ScopeType != nil && row.ScopeID != nil {
+			grants[i].Scope = &auth.Scope{Type: auth.ScopeType(*row.ScopeType), ID: *row.ScopeID}
+		}
+	}
+	return grants
+}
@@ -2,19 +2,27 @@ package handler
 
 import (
 	"context"
+	"time"
 
 	"github.com/pixlcrashr/roomy/pkg/api/ogen/gen"
+	"github.com/pixlcrashr/roomy/pkg/api/ogen/handler/converter"
+	"github.com/pixlcrashr/roomy/pkg/auth"
+	"github.com/pixlcrashr/roomy/pkg/calendar"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"github.com/pixlcrashr/roomy/pkg/reservation"
 	"gorm.io/gorm"
 )
 
 // UserHandler handles user-related operations.
 type UserHandler struct {
-	db *gorm.DB
+	db           *gorm.DB
+	reservations *reservation.Service
 }
 
 // NewUserHandler creates a new UserHandler.
-func NewUserHandler(db *gorm.DB) *UserHandler {
-	return &UserHandler{db: db}
+func NewUserHandler(db *gorm.DB, reservations *reservation.Service) *UserHandler {
+	return &UserHandler{db: db, reservations: reservations}
 }
 
 // ListUsers lists all users.
@@ -116,3 +124,101 @@ func (h *UserHandler) UpdateCurrentUserNotifications(ctx context.Context, req *g
 	// TODO: Implement update notifications
 	return &gen.NotificationPreferences{}, nil
 }
+
+// GetCurrentUserCalendarToken returns the status of the personal calendar feed token.
+// GET /users/me/calendar-token
+func (h *UserHandler) GetCurrentUserCalendarToken(ctx context.Context) (gen.GetCurrentUserCalendarTokenRes, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		res := gen.GetCurrentUserCalendarTokenUnauthorized(UnauthorizedError("authentication required"))
+		return &res, nil
+	}
+
+	token, err := dbgen.CalendarTokenQuery[model.CalendarToken](h.db).GetByUser(ctx, principal.User.ID)
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		res := gen.GetCurrentUserCalendarTokenNotFound(NotFoundError("no calendar token exists"))
+		return &res, nil
+	}
+	return converter.CalendarTokenToAPI(token), nil
+}
+
+// RotateCurrentUserCalendarToken creates a new personal calendar feed token,
+// revoking any previous one.
+// POST /users/me/calendar-token
+func (h *UserHandler) RotateCurrentUserCalendarToken(ctx context.Context) (gen.RotateCurrentUserCalendarTokenRes, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		res := UnauthorizedError("authentication required")
+		return &res, nil
+	}
+
+	secret, hash, err := auth.NewCalendarToken()
+	if err != nil {
+		return nil, err
+	}
+	tokens := dbgen.CalendarTokenQuery[model.CalendarToken](h.db)
+	if err := tokens.Upsert(ctx, principal.User.ID, hash); err != nil {
+		return nil, err
+	}
+	token, err := tokens.GetByUser(ctx, principal.User.ID)
+	if err != nil {
+		return nil, err
+	}
+	return converter.CalendarTokenWithSecretToAPI(token, secret), nil
+}
+
+// RevokeCurrentUserCalendarToken revokes the personal calendar feed token.
+// DELETE /users/me/calendar-token
+func (h *UserHandler) RevokeCurrentUserCalendarToken(ctx context.Context) (gen.RevokeCurrentUserCalendarTokenRes, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		res := UnauthorizedError("authentication required")
+		return &res, nil
+	}
+
+	if err := dbgen.CalendarTokenQuery[model.CalendarToken](h.db).DeleteByUser(ctx, principal.User.ID); err != nil {
+		return nil, err
+	}
+	return &gen.RevokeCurrentUserCalendarTokenNoContent{}, nil
+}
+
+// GetUserCalendar returns the personal .ics feed of the user owning the token.
+// GET /calendar/users/{token}.ics
+func (h *UserHandler) GetUserCalendar(ctx context.Context, params gen.GetUserCalendarParams) (gen.GetUserCalendarRes, error) {
+	tokens := dbgen.CalendarTokenQuery[model.CalendarToken](h.db)
+	token, err := tokens.GetByHash(ctx, auth.HashCalendarToken(params.Token))
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		res := NotFoundError("calendar not found")
+		return &res, nil
+	}
+	user, err := dbgen.UserQuery[model.User](h.db).GetByID(ctx, token.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || !user.IsActive {
+		res := NotFoundError("calendar not found")
+		return &res, nil
+	}
+
+	loc := h.reservations.Location()
+	from, to := calendar.PersonalWindow(time.Now(), loc)
+	feed, err := calendar.ForUser(ctx, h.db, user, from, to, loc)
+	if err != nil {
+		return nil, err
+	}
+	if err := tokens.MarkUsed(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	res, notModified := calendarFeed(feed, params.IfNoneMatch)
+	if notModified != nil {
+		return notModified, nil
+	}
+	return res, nil
+}
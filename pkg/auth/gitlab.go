@@ -0,0 +1,115 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/pixlcrashr/roomy/pkg/cfg"
+	"golang.org/x/oauth2"
+)
+
+// ProviderGitLab is the OAuth provider name stored on users created via GitLab.
+const ProviderGitLab = "gitlab"
+
+// GitLabUser is the subset of the GitLab /api/v4/user response used by Roomy.
+type GitLabUser struct {
+	ID        int64  `json:"id"`
+	Username  string `json:"username"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	AvatarURL string `json:"avatar_url"`
+}
+
+// OAuthID returns the GitLab user ID as stored in model.User.OAuthID.
+func (u *GitLabUser) OAuthID() string {
+	return strconv.FormatInt(u.ID, 10)
+}
+
+// GitLabProvider performs the OAuth authorization-code flow with PKCE against a GitLab instance.
+type GitLabProvider struct {
+	baseURL    string
+	oauth      *oauth2.Config
+	httpClient *http.Client
+}
+
+// NewGitLabProvider creates a new GitLabProvider from the given configuration.
+func NewGitLabProvider(config cfg.GitLabConfig) *GitLabProvider {
+	baseURL := strings.TrimRight(config.BaseURL, "/")
+	return &GitLabProvider{
+		baseURL: baseURL,
+		oauth: &oauth2.Config{
+			ClientID:     config.ClientID,
+			ClientSecret: config.ClientSecret,
+			RedirectURL:  config.RedirectURL,
+			Scopes:       config.Scopes,
+			Endpoint: oauth2.Endpoint{
+				AuthURL:   baseURL + "/oauth/authorize",
+				TokenURL:  baseURL + "/oauth/token",
+				AuthStyle: oauth2.AuthStyleInParams,
+			},
+		},
+		httpClient: http.DefaultClient,
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for token exchange and user lookups.
+func (p *GitLabProvider) WithHTTPClient(client *http.Client) *GitLabProvider {
+	p.httpClient = client
+	return p
+}
+
+// RedirectURL returns the URL GitLab redirects to after authorization.
+func (p *GitLabProvider) RedirectURL() string {
+	return p.oauth.RedirectURL
+}
+
+// AuthCodeURL returns the GitLab authorization URL for the given state and PKCE verifier.
+func (p *GitLabProvider) AuthCodeURL(state, verifier string) string {
+	return p.oauth.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier))
+}
+
+// Exchange trades an authorization code and its PKCE verifier for a GitLab access token.
+func (p *GitLabProvider) Exchange(ctx context.Context, code, verifier string) (*oauth2.Token, error) {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.httpClient)
+	return p.oauth.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+}
+
+// FetchUser loads the profile of the user the token was issued for.
+func (p *GitLabProvider) FetchUser(ctx context.Context, token *oauth2.Token) (*GitLabUser, error) {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.httpClient)
+	client := p.oauth.Client(ctx, token)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseURL+"/api/v4/user", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch gitlab user: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch gitlab user: unexpected status %d", resp.StatusCode)
+	}
+
+	var user GitLabUser
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return nil, fmt.Errorf("failed to decode gitlab user: %w", err)
+	}
+	if user.ID == 0 {
+		return nil, fmt.Errorf("failed to fetch gitlab user: missing id")
+	}
+
+	return &user, nil
+}
+
+// NewVerifier returns a new random PKCE code verifier.
+func NewVerifier() string {
+	return oauth2.GenerateVerifier()
+}
@@ -0,0 +1,237 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pixlcrashr/roomy/pkg/cfg"
+	"golang.org/x/oauth2"
+)
+
+// fakeGitLab is a GitLab stand-in implementing the authorize, token and user
+// endpoints of the PKCE authorization-code flow.
+type fakeGitLab struct {
+	*httptest.Server
+	user GitLabUser
+	// status, if set, is returned by the user endpoint instead of the user.
+	status int
+
+	mu         sync.Mutex
+	challenges map[string]string // code → PKCE challenge
+}
+
+func newFakeGitLab(t *testing.T, user GitLabUser) *fakeGitLab {
+	t.Helper()
+	f := &fakeGitLab{user: user, challenges: map[string]string{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /oauth/authorize", f.authorize)
+	mux.HandleFunc("POST /oauth/token", f.token)
+	mux.HandleFunc("GET /api/v4/user", f.fetchUser)
+	f.Server = httptest.NewServer(mux)
+	t.Cleanup(f.Close)
+	return f
+}
+
+// authorize approves every request and redirects with a code bound to the
+// PKCE challenge.
+func (f *fakeGitLab) authorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "" {
+		http.Error(w, "pkce required", http.StatusBadRequest)
+		return
+	}
+	code := "code-" + q.Get("state")
+	f.mu.Lock()
+	f.challenges[code] = q.Get("code_challenge")
+	f.mu.Unlock()
+
+	redirect, _ := url.Parse(q.Get("redirect_uri"))
+	redirect.RawQuery = url.Values{"code": {code}, "state": {q.Get("state")}}.Encode()
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+func (f *fakeGitLab) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	f.mu.Lock()
+	challenge, ok := f.challenges[r.PostForm.Get("code")]
+	delete(f.challenges, r.PostForm.Get("code"))
+	f.mu.Unlock()
+
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if !ok || base64.RawURLEncoding.EncodeToString(sum[:]) != challenge || r.PostForm.Get("client_id") != "client" {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(`{"access_token":"gitlab-token","token_type":"Bearer","expires_in":7200}`))
+}
+
+func (f *fakeGitLab) fetchUser(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer gitlab-token" {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	if f.status != 0 {
+		http.Error(w, http.StatusText(f.status), f.status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(f.user)
+}
+
+// login runs the authorization step in place of a browser and returns the
+// code GitLab redirected with.
+func (f *fakeGitLab) login(t *testing.T, authURL string) string {
+	t.Helper()
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	resp, err := client.Get(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusFound {
+		t.Fatalf("authorize: status %d", resp.StatusCode)
+	}
+	location, err := resp.Location()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return location.Query().Get("code")
+}
+
+func newTestProvider(f *fakeGitLab) *GitLabProvider {
+	return NewGitLabProvider(cfg.GitLabConfig{
+		BaseURL:      f.URL + "/",
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RedirectURL:  "https://roomy.example/api/v1/auth/callback",
+		Scopes:       []string{"read_user"},
+	}).WithHTTPClient(f.Client())
+}
+
+func TestGitLabProviderFlow(t *testing.T) {
+	user := GitLabUser{ID: 42, Username: "jdoe", Name: "J. Doe", Email: "jdoe@example.com"}
+
+	tests := []struct {
+		name string
+		// verifier returns the verifier sent with the exchange.
+		verifier   func(original string) string
+		userStatus int
+		wantUser   bool
+		wantReject bool
+	}{
+		{name: "valid", verifier: func(v string) string { return v }, wantUser: true},
+		{name: "wrong verifier", verifier: func(string) string { return NewVerifier() }, wantReject: true},
+		{name: "user lookup fails", verifier: func(v string) string { return v }, userStatus: http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFakeGitLab(t, user)
+			f.status = tt.userStatus
+			provider := newTestProvider(f)
+
+			verifier := NewVerifier()
+			authURL := provider.AuthCodeURL("state-value", verifier)
+			parsed, err := url.Parse(authURL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := parsed.Query().Get("state"); got != "state-value" {
+				t.Fatalf("state = %q", got)
+			}
+			if got := parsed.Query().Get("redirect_uri"); got != provider.RedirectURL() {
+				t.Fatalf("redirect_uri = %q", got)
+			}
+			code := f.login(t, authURL)
+
+			token, err := provider.Exchange(t.Context(), code, tt.verifier(verifier))
+			var retrieveErr *oauth2.RetrieveError
+			if tt.wantReject {
+				if !errors.As(err, &retrieveErr) {
+					t.Fatalf("exchange error = %v, want a rejection", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("exchange: %v", err)
+			}
+
+			got, err := provider.FetchUser(t.Context(), token)
+			if !tt.wantUser {
+				if err == nil {
+					t.Fatal("fetch user succeeded")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("fetch user: %v", err)
+			}
+			if *got != user || got.OAuthID() != "42" {
+				t.Fatalf("user = %+v", got)
+			}
+		})
+	}
+}
+
+func TestVerifyState(t *testing.T) {
+	tokens, err := NewTokenManager(cfg.JWTConfig{
+		SigningKeys: []string{"0123456789abcdef0123456789abcdef"},
+		StateTTL:    10 * time.Minute,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := NewTokenManager(cfg.JWTConfig{
+		SigningKeys: []string{"fedcba9876543210fedcba9876543210"},
+		StateTTL:    10 * time.Minute,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	nonce, state, err := tokens.NewState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, foreign, err := other.NewState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, _, _ := strings.Cut(state, ".")
+
+	tests := []struct {
+		name  string
+		state string
+		valid bool
+	}{
+		{"valid", state, true},
+		{"tampered payload", "x" + state, false},
+		{"foreign key", foreign, false},
+		{"no signature", payload, false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tokens.VerifyState(tt.state)
+			if tt.valid != (err == nil) {
+				t.Fatalf("error = %v", err)
+			}
+			if tt.valid && got != nonce {
+				t.Fatalf("nonce = %q, want %q", got, nonce)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package auth
+
+// Permission keys that can be granted to groups.
+const (
+	PermissionManageBuildings    = "manage:buildings"
+	PermissionManageAreas        = "manage:areas"
+	PermissionManagePlaces       = "manage:places"
+	PermissionManageReservations = "manage:reservations"
+	PermissionViewStatistics     = "view:statistics"
+	PermissionViewAuditLog       = "view:auditLog"
+	PermissionViewUsers          = "view:users"
+	PermissionManageUsers        = "manage:users"
+	PermissionViewGroups         = "view:groups"
+	PermissionManageGroups       = "manage:groups"
+)
+
+// PermissionDefinition describes a permission of the registry.
+type PermissionDefinition struct {
+	Name        string
+	Description string
+	// Scopes lists the resource types the permission may be restricted to.
+	// Permissions without scopes can only be granted globally.
+	Scopes []ScopeType
+}
+
+// AllowsScope reports whether the permission may be restricted to the given scope type.
+func (p PermissionDefinition) AllowsScope(scopeType ScopeType) bool {
+	for _, t := range p.Scopes {
+		if t == scopeType {
+			return true
+		}
+	}
+	return false
+}
+
+// Permissions is the registry of all permissions known to Roomy.
+var Permissions = []PermissionDefinition{
+	{
+		Name:        PermissionManageBuildings,
+		Description: "Create, update, and delete buildings and their blocking periods",
+		Scopes:      []ScopeType{ScopeBuilding},
+	},
+	{
+		Name:        PermissionManageAreas,
+		Description: "Create, update, and delete areas, room plans and their blocking periods",
+		Scopes:      []ScopeType{ScopeBuilding, ScopeArea},
+	},
+	{
+		Name:        PermissionManagePlaces,
+		Description: "Create, update, and delete places, equipment, QR codes and templates",
+		Scopes:      []ScopeType{ScopeBuilding, ScopeArea, ScopePlace},
+	},
+	{
+		Name:        PermissionManageReservations,
+		Description: "View and manage reservations of all users",
+		Scopes:      []ScopeType{ScopeBuilding, ScopeArea, ScopePlace},
+	},
+	{Name: PermissionViewStatistics, Description: "View usage statistics and export reservations"},
+	{Name: PermissionViewAuditLog, Description: "View the audit log"},
+	{Name: PermissionViewUsers, Description: "View users and their group memberships"},
+	{Name: PermissionManageUsers, Description: "Update, enable, and disable users and manage their groups"},
+	{Name: PermissionViewGroups, Description: "View groups, their members and permissions"},
+	{Name: PermissionManageGroups, Description: "Create, update, and delete groups and assign permissions"},
+}
+
+// PermissionNames returns the names of all registered permissions.
+func PermissionNames() []string {
+	names := make([]string, len(Permissions))
+	for i, p := range Permissions {
+		names[i] = p.Name
+	}
+	return names
+}
+
+// LookupPermission returns the registered permission with the given name.
+func LookupPermission(name string) (PermissionDefinition, bool) {
+	for _, p := range Permissions {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return PermissionDefinition{}, false
+}
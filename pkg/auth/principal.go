@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+)
+
+var (
+	// ErrUserDisabled is returned when valid credentials belong to a disabled user.
+	ErrUserDisabled = errors.New("user account is disabled")
+	// ErrAPIKeyExpired is returned when an API key is past its expiry date.
+	ErrAPIKeyExpired = errors.New("api key has expired")
+)
+
+// Method identifies how a principal authenticated.
+type Method string
+
+const (
+	MethodBearer Method = "bearer"
+	MethodAPIKey Method = "apiKey"
+)
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	User   *model.User
+	Method Method
+
+	// SessionID is the refresh token family of a bearer token, uuid.Nil otherwise.
+	SessionID uuid.UUID
+	// APIKeyID is the key used for API key authentication, uuid.Nil otherwise.
+	APIKeyID uuid.UUID
+
+	global map[string]struct{}
+	scoped map[string][]Scope
+}
+
+// NewPrincipal creates a principal for the given user and effective grants.
+func NewPrincipal(user *model.User, method Method, grants []Grant) *Principal {
+	p := &Principal{
+		User:   user,
+		Method: method,
+		global: make(map[string]struct{}),
+		scoped: make(map[string][]Scope),
+	}
+	for _, grant := range grants {
+		if grant.Scope == nil {
+			p.global[grant.Permission] = struct{}{}
+		} else {
+			p.scoped[grant.Permission] = append(p.scoped[grant.Permission], *grant.Scope)
+		}
+	}
+	return p
+}
+
+// UserID returns the ID of the authenticated user.
+func (p *Principal) UserID() uuid.UUID {
+	return p.User.ID
+}
+
+// IsActive reports whether the authenticated user account is enabled.
+func (p *Principal) IsActive() bool {
+	return p.User.IsActive
+}
+
+// HasPermission reports whether the principal holds the given permission globally.
+func (p *Principal) HasPermission(permission string) bool {
+	_, ok := p.global[permission]
+	return ok
+}
+
+// HasAnyGrant reports whether the principal holds the given permission
+// globally or for at least one resource.
+func (p *Principal) HasAnyGrant(permission string) bool {
+	return p.HasPermission(permission) || len(p.scoped[permission]) > 0
+}
+
+// Allows reports whether the principal holds the permission for a resource.
+// chain is the resource followed by its ancestors, e.g. place, area, building,
+// so grants on a building cascade down to its areas and places.
+func (p *Principal) Allows(permission string, chain ...Scope) bool {
+	if p.HasPermission(permission) {
+		return true
+	}
+	for _, granted := range p.scoped[permission] {
+		for _, target := range chain {
+			if granted == target {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Grants returns the effective grants of the principal.
+func (p *Principal) Grants() []Grant {
+	grants := make([]Grant, 0, len(p.global)+len(p.scoped))
+	for permission := range p.global {
+		grants = append(grants, Grant{Permission: permission})
+	}
+	for permission, scopes := range p.scoped {
+		for _, scope := range scopes {
+			grants = append(grants, Grant{Permission: permission, Scope: &scope})
+		}
+	}
+	slices.SortFunc(grants, compareGrants)
+	return grants
+}
+
+func compareGrants(a, b Grant) int {
+	if c := strings.Compare(a.Permission, b.Permission); c != 0 {
+		return c
+	}
+	switch {
+	case a.Scope == nil && b.Scope == nil:
+		return 0
+	case a.Scope == nil:
+		return -1
+	case b.Scope == nil:
+		return 1
+	}
+	if c := strings.Compare(string(a.Scope.Type), string(b.Scope.Type)); c != 0 {
+		return c
+	}
+	return strings.Compare(a.Scope.ID.String(), b.Scope.ID.String())
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying the given principal.
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal stored in ctx, if any.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pixlcrashr/roomy/pkg/cfg"
+)
+
+// CheckInPath is the path of the web app page QR codes link to. The token
+// is passed in the "token" query parameter.
+const CheckInPath = "/checkIn/qr"
+
+// qrPayloadSize is the size of a QR token payload: the place ID followed by
+// the key version as big-endian uint32.
+const qrPayloadSize = 16 + 4
+
+// qrMACSize is the size of the truncated HMAC of a QR token, which keeps the
+// encoded symbol small.
+const qrMACSize = 16
+
+// QRSigner signs and verifies the tokens encoded in place QR codes. A token
+// binds a place ID to the key version of the place, so that incrementing the
+// version invalidates all codes printed before.
+type QRSigner struct {
+	keys      [][]byte
+	publicURL string
+}
+
+// NewQRSigner creates a new QRSigner from the given configuration. Check-in
+// URLs are built relative to publicURL.
+func NewQRSigner(config cfg.CheckInConfig, publicURL string) (*QRSigner, error) {
+	if len(config.SigningKeys) == 0 {
+		return nil, errors.New("at least one check-in signing key is required")
+	}
+
+	keys := make([][]byte, len(config.SigningKeys))
+	for i, secret := range config.SigningKeys {
+		if len(secret) < 32 {
+			return nil, fmt.Errorf("check-in signing key %d must be at least 32 bytes", i)
+		}
+		keys[i] = []byte(secret)
+	}
+	return &QRSigner{keys: keys, publicURL: strings.TrimSuffix(publicURL, "/")}, nil
+}
+
+// Token returns the token of a place's QR code for the given key version.
+func (s *QRSigner) Token(placeID uuid.UUID, keyVersion int) string {
+	payload := make([]byte, qrPayloadSize, qrPayloadSize+qrMACSize)
+	copy(payload, placeID[:])
+	binary.BigEndian.PutUint32(payload[16:], uint32(keyVersion))
+	return base64.RawURLEncoding.EncodeToString(append(payload, qrMAC(s.keys[0], payload)...))
+}
+
+// URL returns the check-in URL encoded in a place's QR code.
+func (s *QRSigner) URL(placeID uuid.UUID, keyVersion int) string {
+	return s.publicURL + CheckInPath + "?token=" + s.Token(placeID, keyVersion)
+}
+
+// Verify returns the place ID and key version of the token read from a QR
+// code. The content may be the token itself or a check-in URL carrying it.
+// ErrInvalidToken is returned if the token has not been signed by any of
+// the configured keys.
+func (s *QRSigner) Verify(content string) (uuid.UUID, int, error) {
+	token := strings.TrimSpace(content)
+	if u, err := url.Parse(token); err == nil && u.Query().Has("token") {
+		token = u.Query().Get("token")
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(raw) != qrPayloadSize+qrMACSize {
+		return uuid.Nil, 0, ErrInvalidToken
+	}
+	payload, mac := raw[:qrPayloadSize], raw[qrPayloadSize:]
+	for _, key := range s.keys {
+		if hmac.Equal(mac, qrMAC(key, payload)) {
+			placeID, _ := uuid.FromBytes(payload[:16])
+			return placeID, int(binary.BigEndian.Uint32(payload[16:])), nil
+		}
+	}
+	return uuid.Nil, 0, ErrInvalidToken
+}
+
+func qrMAC(key, payload []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("roomy-qr:"))
+	mac.Write(payload)
+	return mac.Sum(nil)[:qrMACSize]
+}
@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/pixlcrashr/roomy/pkg/cfg"
+)
+
+const (
+	testQRKey    = "0123456789abcdef0123456789abcdef"
+	testQRKeyOld = "fedcba9876543210fedcba9876543210"
+)
+
+func newTestQRSigner(t *testing.T, keys ...string) *QRSigner {
+	t.Helper()
+	signer, err := NewQRSigner(cfg.CheckInConfig{SigningKeys: keys}, "https://roomy.example.com/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signer
+}
+
+// tamper flips a bit of the i-th byte of a token.
+func tamper(t *testing.T, token string, i int) string {
+	t.Helper()
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw[i] ^= 1
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func TestNewQRSigner(t *testing.T) {
+	if _, err := NewQRSigner(cfg.CheckInConfig{}, ""); err == nil {
+		t.Error("NewQRSigner() without keys succeeded")
+	}
+	if _, err := NewQRSigner(cfg.CheckInConfig{SigningKeys: []string{"short"}}, ""); err == nil {
+		t.Error("NewQRSigner() with a short key succeeded")
+	}
+}
+
+func TestQRSignerVerify(t *testing.T) {
+	signer := newTestQRSigner(t, testQRKey, testQRKeyOld)
+	placeID := uuid.New()
+	token := signer.Token(placeID, 3)
+
+	tests := []struct {
+		name    string
+		content string
+		valid   bool
+	}{
+		{"token", token, true},
+		{"token with whitespace", " " + token + "\n", true},
+		{"url", signer.URL(placeID, 3), true},
+		{"signed with old key", newTestQRSigner(t, testQRKeyOld).Token(placeID, 3), true},
+		{"foreign key", newTestQRSigner(t, strings.Repeat("x", 32)).Token(placeID, 3), false},
+		{"tampered place", tamper(t, token, 0), false},
+		{"tampered key version", tamper(t, token, qrPayloadSize-1), false},
+		{"tampered mac", tamper(t, token, qrPayloadSize), false},
+		{"truncated", token[:len(token)-2], false},
+		{"url without token", "https://roomy.example.com" + CheckInPath, false},
+		{"not base64", "not a token!", false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotPlace, gotVersion, err := signer.Verify(tt.content)
+			if !tt.valid {
+				if !errors.Is(err, ErrInvalidToken) {
+					t.Fatalf("Verify() error = %v, want ErrInvalidToken", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if gotPlace != placeID || gotVersion != 3 {
+				t.Fatalf("Verify() = %s, %d, want %s, 3", gotPlace, gotVersion, placeID)
+			}
+		})
+	}
+}
+
+func TestQRSignerURL(t *testing.T) {
+	signer := newTestQRSigner(t, testQRKey)
+	placeID := uuid.New()
+	want := "https://roomy.example.com" + CheckInPath + "?token=" + signer.Token(placeID, 1)
+	if got := signer.URL(placeID, 1); got != want {
+		t.Fatalf("URL() = %q, want %q", got, want)
+	}
+}
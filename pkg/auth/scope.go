@@ -0,0 +1,40 @@
+package auth
+
+import "github.com/google/uuid"
+
+// ScopeType is the kind of resource a permission grant can be restricted to.
+type ScopeType string
+
+const (
+	ScopeBuilding ScopeType = "building"
+	ScopeArea     ScopeType = "area"
+	ScopePlace    ScopeType = "place"
+)
+
+// Scope restricts a permission grant to a single building, area or place.
+type Scope struct {
+	Type ScopeType
+	ID   uuid.UUID
+}
+
+// BuildingScope returns the scope of a single building.
+func BuildingScope(id uuid.UUID) Scope {
+	return Scope{Type: ScopeBuilding, ID: id}
+}
+
+// AreaScope returns the scope of a single area.
+func AreaScope(id uuid.UUID) Scope {
+	return Scope{Type: ScopeArea, ID: id}
+}
+
+// PlaceScope returns the scope of a single place.
+func PlaceScope(id uuid.UUID) Scope {
+	return Scope{Type: ScopePlace, ID: id}
+}
+
+// Grant is a permission held by a principal, either globally (nil Scope) or
+// restricted to one resource and everything below it.
+type Grant struct {
+	Permission string
+	Scope      *Scope
+}
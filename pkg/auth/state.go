@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+// ErrInvalidState is returned when an OAuth state value is malformed, forged or expired.
+var ErrInvalidState = errors.New("invalid state")
+
+// StateCookieName is the cookie that binds an OAuth state to the browser
+// that started the login. It holds the nonce of the state.
+const StateCookieName = "roomy_oauth_state"
+
+type statePayload struct {
+	Nonce     string `json:"n"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// NewState creates a random nonce and returns it together with its signed state value.
+// The nonce is used to look up the PKCE verifier when the callback arrives.
+func (m *TokenManager) NewState() (nonce string, state string, err error) {
+	nonce, err = randomString(32)
+	if err != nil {
+		return "", "", err
+	}
+
+	payload, err := json.Marshal(statePayload{
+		Nonce:     nonce,
+		ExpiresAt: time.Now().Add(m.stateTTL).Unix(),
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return nonce, encoded + "." + signState(m.keys[0].secret, encoded), nil
+}
+
+// VerifyState checks the signature and expiry of a state value and returns its nonce.
+func (m *TokenManager) VerifyState(state string) (string, error) {
+	encoded, signature, ok := strings.Cut(state, ".")
+	if !ok {
+		return "", ErrInvalidState
+	}
+
+	valid := false
+	for _, key := range m.keys {
+		if hmac.Equal([]byte(signature), []byte(signState(key.secret, encoded))) {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return "", ErrInvalidState
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", ErrInvalidState
+	}
+
+	var payload statePayload
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return "", ErrInvalidState
+	}
+	if payload.Nonce == "" || time.Now().Unix() >= payload.ExpiresAt {
+		return "", ErrInvalidState
+	}
+
+	return payload.Nonce, nil
+}
+
+func signState(secret []byte, encoded string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte("oauth-state."))
+	mac.Write([]byte(encoded))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,174 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/pixlcrashr/roomy/pkg/cfg"
+)
+
+// ErrInvalidToken is returned when a token cannot be verified.
+var ErrInvalidToken = errors.New("invalid token")
+
+// Claims are the claims carried by Roomy access tokens.
+type Claims struct {
+	jwt.RegisteredClaims
+
+	// SessionID identifies the refresh token family the access token was issued for.
+	SessionID uuid.UUID `json:"sid"`
+}
+
+// UserID returns the ID of the user the token was issued to.
+func (c *Claims) UserID() (uuid.UUID, error) {
+	return uuid.Parse(c.Subject)
+}
+
+type signingKey struct {
+	id     string
+	secret []byte
+}
+
+// TokenManager issues and verifies access tokens, refresh tokens and OAuth state values.
+type TokenManager struct {
+	keys            []signingKey
+	issuer          string
+	accessTokenTTL  time.Duration
+	refreshTokenTTL time.Duration
+	stateTTL        time.Duration
+}
+
+// NewTokenManager creates a new TokenManager from the given configuration.
+func NewTokenManager(config cfg.JWTConfig) (*TokenManager, error) {
+	if len(config.SigningKeys) == 0 {
+		return nil, errors.New("at least one jwt signing key is required")
+	}
+
+	keys := make([]signingKey, len(config.SigningKeys))
+	for i, secret := range config.SigningKeys {
+		if len(secret) < 32 {
+			return nil, fmt.Errorf("jwt signing key %d must be at least 32 bytes", i)
+		}
+		sum := sha256.Sum256([]byte(secret))
+		keys[i] = signingKey{
+			id:     hex.EncodeToString(sum[:8]),
+			secret: []byte(secret),
+		}
+	}
+
+	return &TokenManager{
+		keys:            keys,
+		issuer:          config.Issuer,
+		accessTokenTTL:  config.AccessTokenTTL,
+		refreshTokenTTL: config.RefreshTokenTTL,
+		stateTTL:        config.StateTTL,
+	}, nil
+}
+
+// AccessTokenTTL returns the lifetime of issued access tokens.
+func (m *TokenManager) AccessTokenTTL() time.Duration {
+	return m.accessTokenTTL
+}
+
+// RefreshTokenTTL returns the lifetime of issued refresh tokens.
+func (m *TokenManager) RefreshTokenTTL() time.Duration {
+	return m.refreshTokenTTL
+}
+
+// StateTTL returns how long an OAuth login may take before its state expires.
+func (m *TokenManager) StateTTL() time.Duration {
+	return m.stateTTL
+}
+
+// IssueAccessToken signs a new access token for the given user and session.
+func (m *TokenManager) IssueAccessToken(userID, sessionID uuid.UUID) (string, error) {
+	now := time.Now()
+	claims := Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    m.issuer,
+			Subject:   userID.String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(m.accessTokenTTL)),
+			ID:        uuid.NewString(),
+		},
+		SessionID: sessionID,
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token.Header["kid"] = m.keys[0].id
+	return token.SignedString(m.keys[0].secret)
+}
+
+// ParseAccessToken verifies the given access token and returns its claims.
+func (m *TokenManager) ParseAccessToken(tokenString string) (*Claims, error) {
+	var claims Claims
+	_, err := jwt.ParseWithClaims(
+		tokenString,
+		&claims,
+		func(token *jwt.Token) (any, error) {
+			kid, _ := token.Header["kid"].(string)
+			for _, key := range m.keys {
+				if key.id == kid {
+					return key.secret, nil
+				}
+			}
+			return nil, fmt.Errorf("unknown signing key %q", kid)
+		},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(m.issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if _, err := claims.UserID(); err != nil {
+		return nil, fmt.Errorf("%w: invalid subject", ErrInvalidToken)
+	}
+
+	return &claims, nil
+}
+
+// NewRefreshToken returns a new opaque refresh token and the hash to persist for it.
+func NewRefreshToken() (token string, hash string, err error) {
+	token, err = randomString(32)
+	if err != nil {
+		return "", "", err
+	}
+	return token, HashRefreshToken(token), nil
+}
+
+// HashRefreshToken returns the hash under which a refresh token is persisted.
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// NewCalendarToken returns a new secret calendar feed token and the hash to persist for it.
+func NewCalendarToken() (token string, hash string, err error) {
+	token, err = randomString(32)
+	if err != nil {
+		return "", "", err
+	}
+	return token, HashCalendarToken(token), nil
+}
+
+// HashCalendarToken returns the hash under which a calendar feed token is persisted.
+func HashCalendarToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
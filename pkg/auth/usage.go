@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/google/uuid"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"gorm.io/gorm"
+)
+
+// DefaultUsageFlushInterval is how often API key usage is written to the database.
+const DefaultUsageFlushInterval = time.Minute
+
+// APIKeyUsageTracker collects API key usage in memory and periodically
+// persists the last-used timestamp of every key in a single update, so authenticating
+// with an API key does not write to the database on every request.
+type APIKeyUsageTracker struct {
+	db       *gorm.DB
+	interval time.Duration
+
+	mu   sync.Mutex
+	used map[uuid.UUID]time.Time
+}
+
+// NewAPIKeyUsageTracker creates a new APIKeyUsageTracker.
+func NewAPIKeyUsageTracker(db *gorm.DB, interval time.Duration) *APIKeyUsageTracker {
+	return &APIKeyUsageTracker{
+		db:       db,
+		interval: interval,
+		used:     make(map[uuid.UUID]time.Time),
+	}
+}
+
+// Touch records that the given API key was used now.
+func (t *APIKeyUsageTracker) Touch(id uuid.UUID) {
+	t.mu.Lock()
+	t.used[id] = time.Now()
+	t.mu.Unlock()
+}
+
+// Run flushes recorded usage every interval until ctx is done, then flushes
+// one last time.
+func (t *APIKeyUsageTracker) Run(ctx context.Context) {
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := t.Flush(ctx); err != nil {
+				log.Errorf("failed to flush api key usage: %v", err)
+			}
+		case <-ctx.Done():
+			if err := t.Flush(context.WithoutCancel(ctx)); err != nil {
+				log.Errorf("failed to flush api key usage: %v", err)
+			}
+			return
+		}
+	}
+}
+
+// Flush persists all usage recorded since the previous flush.
+func (t *APIKeyUsageTracker) Flush(ctx context.Context) error {
+	t.mu.Lock()
+	used := t.used
+	t.used = make(map[uuid.UUID]time.Time)
+	t.mu.Unlock()
+
+	if len(used) == 0 {
+		return nil
+	}
+
+	usages := make([]model.APIKeyUsage, 0, len(used))
+	for id, usedAt := range used {
+		usages = append(usages, model.APIKeyUsage{ID: id, UsedAt: usedAt})
+	}
+
+	return dbgen.APIKeyQuery[model.APIKey](t.db).UpdateLastUsed(ctx, usages)
+}
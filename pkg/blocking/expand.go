@@ -0,0 +1,119 @@
+// Package blocking expands stored blocking periods, including recurring
+// ones, into the concrete intervals they cover.
+package blocking
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+)
+
+// Entity types a blocking can be attached to.
+const (
+	EntityBuilding = "building"
+	EntityArea     = "area"
+	EntityPlace    = "place"
+)
+
+// Occurrence is a concrete occurrence of a blocking.
+type Occurrence struct {
+	Start    time.Time
+	End      time.Time
+	Blocking *model.Blocking
+}
+
+// Overlaps reports whether the occurrence overlaps [start, end).
+func (o Occurrence) Overlaps(start, end time.Time) bool {
+	return o.Start.Before(end) && o.End.After(start)
+}
+
+// Validate checks that a blocking describes a non-empty period and, if it is
+// recurring, carries a rule and duration that can be expanded.
+func Validate(b *model.Blocking) error {
+	if !b.EndTime.After(b.StartTime) {
+		return errors.New("end time must be after start time")
+	}
+	if !b.IsRecurring {
+		return nil
+	}
+	if b.RecurrenceRule == nil || *b.RecurrenceRule == "" {
+		return errors.New("recurring blockings require a recurrence rule")
+	}
+	if duration(b) <= 0 {
+		return errors.New("recurrence duration must be positive")
+	}
+	_, err := parseRule(b, time.UTC)
+	return err
+}
+
+// Expand returns all occurrences of the given blockings that overlap
+// [from, to), sorted by start time. Recurrence rules are evaluated in loc, so
+// that occurrences keep their wall clock start and end times across DST
+// changes.
+func Expand(blockings []*model.Blocking, from, to time.Time, loc *time.Location) ([]Occurrence, error) {
+	var occurrences []Occurrence
+	for _, b := range blockings {
+		expanded, err := expandOne(b, from, to, loc)
+		if err != nil {
+			return nil, err
+		}
+		occurrences = append(occurrences, expanded...)
+	}
+
+	sort.SliceStable(occurrences, func(i, j int) bool {
+		return occurrences[i].Start.Before(occurrences[j].Start)
+	})
+	return occurrences, nil
+}
+
+func expandOne(b *model.Blocking, from, to time.Time, loc *time.Location) ([]Occurrence, error) {
+	if !b.IsRecurring || b.RecurrenceRule == nil || *b.RecurrenceRule == "" {
+		o := Occurrence{Start: b.StartTime, End: b.EndTime, Blocking: b}
+		if o.Overlaps(from, to) {
+			return []Occurrence{o}, nil
+		}
+		return nil, nil
+	}
+
+	r, err := parseRule(b, loc)
+	if err != nil {
+		return nil, fmt.Errorf("blocking %s: %w", b.ID, err)
+	}
+	d := duration(b)
+	if d <= 0 {
+		return nil, fmt.Errorf("blocking %s: recurrence duration must be positive", b.ID)
+	}
+
+	// Occurrences starting up to one duration before the window may still
+	// reach into it; the extra day covers DST shifts.
+	var occurrences []Occurrence
+	for _, start := range r.rule.Between(from.Add(-d-24*time.Hour), to, true) {
+		if r.excluded(start) {
+			continue
+		}
+		o := Occurrence{Start: start, End: addWallClock(start, d), Blocking: b}
+		if o.Overlaps(from, to) {
+			occurrences = append(occurrences, o)
+		}
+	}
+	return occurrences, nil
+}
+
+// duration returns the length of each occurrence of a blocking.
+func duration(b *model.Blocking) time.Duration {
+	if b.RecurrenceDuration != nil {
+		return time.Duration(*b.RecurrenceDuration)
+	}
+	return b.EndTime.Sub(b.StartTime)
+}
+
+// addWallClock adds d to the wall clock time of t, so that an occurrence
+// from 18:00 to 08:00 still ends at 08:00 on the night of a DST change.
+func addWallClock(t time.Time, d time.Duration) time.Time {
+	seconds := int(d / time.Second)
+	nanos := int(d % time.Second)
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second()+seconds, t.Nanosecond()+nanos, t.Location())
+}
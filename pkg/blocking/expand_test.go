@@ -0,0 +1,238 @@
+package blocking
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+)
+
+func loadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return loc
+}
+
+// recurring returns a blocking recurring by rule from start, each occurrence
+// lasting d.
+func recurring(start time.Time, d time.Duration, rule string) *model.Blocking {
+	return &model.Blocking{
+		ID:             uuid.New(),
+		EntityType:     EntityPlace,
+		EntityID:       uuid.New(),
+		BlockingType:   model.BlockingTypeClosedHours,
+		StartTime:      start,
+		EndTime:        start.Add(d),
+		IsRecurring:    true,
+		RecurrenceRule: &rule,
+	}
+}
+
+// startDays returns the local days of month on which the occurrences start.
+func startDays(occurrences []Occurrence, loc *time.Location) []int {
+	days := make([]int, 0, len(occurrences))
+	for _, o := range occurrences {
+		days = append(days, o.Start.In(loc).Day())
+	}
+	return days
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestAddWallClock(t *testing.T) {
+	berlin := loadLocation(t, "Europe/Berlin")
+
+	tests := []struct {
+		name    string
+		start   time.Time
+		d       time.Duration
+		want    time.Time
+		elapsed time.Duration
+	}{
+		{
+			name:    "regular night",
+			start:   time.Date(2026, 6, 1, 18, 0, 0, 0, berlin),
+			d:       14 * time.Hour,
+			want:    time.Date(2026, 6, 2, 8, 0, 0, 0, berlin),
+			elapsed: 14 * time.Hour,
+		},
+		{
+			name:    "clocks go forward",
+			start:   time.Date(2026, 3, 28, 18, 0, 0, 0, berlin),
+			d:       14 * time.Hour,
+			want:    time.Date(2026, 3, 29, 8, 0, 0, 0, berlin),
+			elapsed: 13 * time.Hour,
+		},
+		{
+			name:    "clocks go back",
+			start:   time.Date(2026, 10, 24, 18, 0, 0, 0, berlin),
+			d:       14 * time.Hour,
+			want:    time.Date(2026, 10, 25, 8, 0, 0, 0, berlin),
+			elapsed: 15 * time.Hour,
+		},
+		{
+			name:    "weekend across the change",
+			start:   time.Date(2026, 3, 27, 18, 0, 0, 0, berlin),
+			d:       62 * time.Hour,
+			want:    time.Date(2026, 3, 30, 8, 0, 0, 0, berlin),
+			elapsed: 61 * time.Hour,
+		},
+		{
+			name:    "UTC",
+			start:   time.Date(2026, 3, 28, 18, 0, 0, 0, time.UTC),
+			d:       14 * time.Hour,
+			want:    time.Date(2026, 3, 29, 8, 0, 0, 0, time.UTC),
+			elapsed: 14 * time.Hour,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := addWallClock(tt.start, tt.d)
+			if !got.Equal(tt.want) {
+				t.Fatalf("end = %s, want %s", got, tt.want)
+			}
+			if elapsed := got.Sub(tt.start); elapsed != tt.elapsed {
+				t.Fatalf("elapsed = %s, want %s", elapsed, tt.elapsed)
+			}
+		})
+	}
+}
+
+func TestExpandAcrossDST(t *testing.T) {
+	berlin := loadLocation(t, "Europe/Berlin")
+	b := recurring(time.Date(2026, 3, 26, 18, 0, 0, 0, berlin), 14*time.Hour, "FREQ=DAILY")
+
+	occurrences, err := Expand([]*model.Blocking{b}, time.Date(2026, 3, 27, 0, 0, 0, 0, berlin), time.Date(2026, 3, 31, 0, 0, 0, 0, berlin), berlin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The night of the 26th reaches into the window.
+	if got, want := startDays(occurrences, berlin), []int{26, 27, 28, 29, 30}; !equalInts(got, want) {
+		t.Fatalf("start days = %v, want %v", got, want)
+	}
+	for _, o := range occurrences {
+		start, end := o.Start.In(berlin), o.End.In(berlin)
+		if start.Hour() != 18 || end.Hour() != 8 || end.Minute() != 0 {
+			t.Errorf("occurrence %s – %s does not keep its wall clock times", start, end)
+		}
+	}
+}
+
+func TestExpandExceptions(t *testing.T) {
+	berlin := loadLocation(t, "Europe/Berlin")
+	start := time.Date(2026, 12, 20, 18, 0, 0, 0, berlin)
+	from, to := start, time.Date(2026, 12, 27, 0, 0, 0, 0, berlin)
+
+	tests := []struct {
+		name   string
+		exdate string
+		want   []int
+	}{
+		{"none", "", []int{20, 21, 22, 23, 24, 25, 26}},
+		{"date", "EXDATE;VALUE=DATE:20261224", []int{20, 21, 22, 23, 25, 26}},
+		{"bare date", "EXDATE:20261224,20261226", []int{20, 21, 22, 23, 25}},
+		{"floating date-time", "EXDATE:20261224T180000", []int{20, 21, 22, 23, 25, 26}},
+		{"date-time in zone", "EXDATE;TZID=Europe/Berlin:20261224T180000,20261225T180000", []int{20, 21, 22, 23, 26}},
+		{"date-time in another zone", "EXDATE;VALUE=DATE-TIME;TZID=America/New_York:20261224T120000", []int{20, 21, 22, 23, 25, 26}},
+		{"UTC date-time", "EXDATE:20261224T170000Z", []int{20, 21, 22, 23, 25, 26}},
+		{"date-time off the occurrence", "EXDATE;TZID=Europe/Berlin:20261224T190000", []int{20, 21, 22, 23, 24, 25, 26}},
+		{"several properties", "EXDATE;VALUE=DATE:20261221\nEXDATE;TZID=Europe/Berlin:20261222T180000", []int{20, 23, 24, 25, 26}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := recurring(start, 14*time.Hour, "RRULE:FREQ=DAILY\n"+tt.exdate)
+			occurrences, err := Expand([]*model.Blocking{b}, from, to, berlin)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := startDays(occurrences, berlin); !equalInts(got, tt.want) {
+				t.Fatalf("start days = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpandEnd(t *testing.T) {
+	berlin := loadLocation(t, "Europe/Berlin")
+	start := time.Date(2026, 12, 1, 18, 0, 0, 0, berlin)
+	from, to := start, time.Date(2027, 1, 1, 0, 0, 0, 0, berlin)
+	// RecurrenceEnd is a DATE column and comes back as midnight UTC.
+	date := func(day int) *time.Time {
+		d := time.Date(2026, 12, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+
+	tests := []struct {
+		name          string
+		rule          string
+		recurrenceEnd *time.Time
+		wantLast      int
+		wantCount     int
+	}{
+		{name: "open", rule: "FREQ=DAILY", wantLast: 31, wantCount: 31},
+		{name: "recurrence end", rule: "FREQ=DAILY", recurrenceEnd: date(24), wantLast: 24, wantCount: 24},
+		{name: "until", rule: "FREQ=DAILY;UNTIL=20261226T235959Z", wantLast: 26, wantCount: 26},
+		{name: "recurrence end before until", rule: "FREQ=DAILY;UNTIL=20261226T235959Z", recurrenceEnd: date(24), wantLast: 24, wantCount: 24},
+		{name: "until before recurrence end", rule: "FREQ=DAILY;UNTIL=20261222T235959Z", recurrenceEnd: date(24), wantLast: 22, wantCount: 22},
+		{name: "count before recurrence end", rule: "FREQ=DAILY;COUNT=3", recurrenceEnd: date(24), wantLast: 3, wantCount: 3},
+		{name: "recurrence end before count", rule: "FREQ=DAILY;COUNT=10", recurrenceEnd: date(4), wantLast: 4, wantCount: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := recurring(start, 14*time.Hour, tt.rule)
+			b.RecurrenceEnd = tt.recurrenceEnd
+			occurrences, err := Expand([]*model.Blocking{b}, from, to, berlin)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(occurrences) != tt.wantCount {
+				t.Fatalf("occurrences = %d, want %d", len(occurrences), tt.wantCount)
+			}
+			if last := occurrences[len(occurrences)-1].Start.In(berlin); last.Day() != tt.wantLast || last.Hour() != 18 {
+				t.Fatalf("last occurrence starts %s, want 18:00 on day %d", last, tt.wantLast)
+			}
+		})
+	}
+}
+
+func TestValidateFrequency(t *testing.T) {
+	start := time.Date(2026, 12, 1, 18, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		rule  string
+		valid bool
+	}{
+		{"FREQ=SECONDLY", false},
+		{"FREQ=MINUTELY;INTERVAL=30", false},
+		{"FREQ=HOURLY", false},
+		{"RRULE:FREQ=HOURLY;BYHOUR=18", false},
+		{"FREQ=DAILY;BYMINUTE=0,30", false},
+		{"FREQ=DAILY;BYSECOND=0,1,2", false},
+		{"FREQ=DAILY", true},
+		{"FREQ=DAILY;BYHOUR=8,18;BYMINUTE=0", true},
+		{"FREQ=WEEKLY;BYDAY=SA,SU", true},
+		{"FREQ=YEARLY;BYMONTH=12;BYMONTHDAY=24", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			err := Validate(recurring(start, time.Hour, tt.rule))
+			if tt.valid != (err == nil) {
+				t.Fatalf("error = %v, want valid %t", err, tt.valid)
+			}
+		})
+	}
+}
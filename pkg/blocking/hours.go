@@ -0,0 +1,172 @@
+package blocking
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Range is a time range that is not blocked.
+type Range struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Open returns the parts of [from, to) that are not covered by the given
+// intervals, which must be sorted and merged as returned by Merge.
+func Open(intervals []Interval, from, to time.Time) []Range {
+	ranges := []Range{}
+	cursor := from
+	for _, interval := range intervals {
+		if !interval.End.After(cursor) {
+			continue
+		}
+		if !interval.Start.Before(to) {
+			break
+		}
+		if interval.Start.After(cursor) {
+			ranges = append(ranges, Range{Start: cursor, End: interval.Start})
+		}
+		cursor = interval.End
+	}
+	if cursor.Before(to) {
+		ranges = append(ranges, Range{Start: cursor, End: to})
+	}
+	return ranges
+}
+
+// ClockRange is an open range within a single day as "HH:MM" wall clock
+// times. An End of "24:00" is the end of the day.
+type ClockRange struct {
+	Start string
+	End   string
+}
+
+// WeekdayHours are the regular opening hours of a weekday. Empty Hours mean
+// the weekday is closed.
+type WeekdayHours struct {
+	Weekday time.Weekday
+	Hours   []ClockRange
+}
+
+// Exception is a day whose opening hours differ from the regular hours of
+// its weekday. Reason names the blocking causing the deviation, if one can
+// be identified.
+type Exception struct {
+	Date   time.Time
+	Hours  []ClockRange
+	Reason string
+}
+
+// OpeningHours summarizes the open ranges of a period per weekday.
+type OpeningHours struct {
+	// Weekdays holds the regular hours of each weekday in the period,
+	// starting on Monday.
+	Weekdays   []WeekdayHours
+	Exceptions []Exception
+}
+
+// day is the opening hours of a single calendar day.
+type day struct {
+	date    time.Time
+	hours   []ClockRange
+	key     string
+	sources []Source
+}
+
+// Summarize derives the opening hours of the days from first to last, given
+// as local midnights, from the merged blocked intervals. The regular hours
+// of a weekday are those it has on most days of the period; all other days
+// are reported as exceptions.
+func Summarize(intervals []Interval, first, last time.Time) OpeningHours {
+	byWeekday := map[time.Weekday][]day{}
+	for date := first; !date.After(last); date = date.AddDate(0, 0, 1) {
+		d := openingDay(intervals, date)
+		byWeekday[date.Weekday()] = append(byWeekday[date.Weekday()], d)
+	}
+
+	summary := OpeningHours{Weekdays: []WeekdayHours{}, Exceptions: []Exception{}}
+	for i := 1; i <= 7; i++ {
+		weekday := time.Weekday(i % 7)
+		days := byWeekday[weekday]
+		if len(days) == 0 {
+			continue
+		}
+
+		regular := regularDay(days)
+		summary.Weekdays = append(summary.Weekdays, WeekdayHours{Weekday: weekday, Hours: regular.hours})
+
+		// Blockings that shape the regular hours do not explain a deviation.
+		usual := map[uuid.UUID]bool{}
+		for _, d := range days {
+			if d.key == regular.key {
+				for _, s := range d.sources {
+					usual[s.BlockingID] = true
+				}
+			}
+		}
+		for _, d := range days {
+			if d.key == regular.key {
+				continue
+			}
+			exception := Exception{Date: d.date, Hours: d.hours}
+			for _, s := range d.sources {
+				if !usual[s.BlockingID] {
+					exception.Reason = s.Label()
+					if s.Reason != nil && *s.Reason != "" {
+						exception.Reason = *s.Reason
+					}
+					break
+				}
+			}
+			summary.Exceptions = append(summary.Exceptions, exception)
+		}
+	}
+
+	sort.SliceStable(summary.Exceptions, func(i, j int) bool {
+		return summary.Exceptions[i].Date.Before(summary.Exceptions[j].Date)
+	})
+	return summary
+}
+
+// openingDay returns the open ranges of the day starting at date and the
+// sources of the intervals overlapping it.
+func openingDay(intervals []Interval, date time.Time) day {
+	from, to := date, date.AddDate(0, 0, 1)
+	d := day{date: date, hours: []ClockRange{}}
+
+	keys := []string{}
+	for _, r := range Open(intervals, from, to) {
+		end := r.End.In(date.Location()).Format("15:04")
+		if !r.End.Before(to) {
+			end = "24:00"
+		}
+		hours := ClockRange{Start: r.Start.In(date.Location()).Format("15:04"), End: end}
+		d.hours = append(d.hours, hours)
+		keys = append(keys, hours.Start+"-"+hours.End)
+	}
+	d.key = strings.Join(keys, ",")
+
+	for _, interval := range intervals {
+		if interval.Overlaps(from, to) {
+			d.sources = append(d.sources, interval.Sources...)
+		}
+	}
+	return d
+}
+
+// regularDay returns the most frequent opening hours among days, preferring
+// the earliest day on a tie.
+func regularDay(days []day) day {
+	counts := map[string]int{}
+	best := days[0]
+	for _, d := range days {
+		counts[d.key]++
+		if counts[d.key] > counts[best.key] {
+			best = d
+		}
+	}
+	return best
+}
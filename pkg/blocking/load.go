@@ -0,0 +1,85 @@
+package blocking
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"gorm.io/gorm"
+)
+
+// LoadForPlace returns the blockings of a place together with those
+// inherited from its area and building.
+func LoadForPlace(ctx context.Context, db *gorm.DB, place *model.Place) ([]*model.Blocking, error) {
+	area, err := dbgen.AreaQuery[model.Area](db).GetByID(ctx, place.AreaID)
+	if err != nil {
+		return nil, err
+	}
+	if area == nil {
+		return nil, fmt.Errorf("area %s of place %s does not exist", place.AreaID, place.ID)
+	}
+	return dbgen.BlockingQuery[model.Blocking](db).ListInheritedForPlace(ctx, place.ID, area.ID, area.BuildingID)
+}
+
+// ForPlace returns the merged blocked intervals of a place, including
+// inherited blockings, that overlap [from, to).
+func ForPlace(ctx context.Context, db *gorm.DB, place *model.Place, from, to time.Time, loc *time.Location) ([]Interval, error) {
+	blockings, err := LoadForPlace(ctx, db, place)
+	if err != nil {
+		return nil, err
+	}
+	occurrences, err := Expand(blockings, from, to, loc)
+	if err != nil {
+		return nil, err
+	}
+	return Merge(occurrences), nil
+}
+
+// ForArea returns the merged blocked intervals that overlap [from, to) for
+// each of the given places of an area, including blockings inherited from
+// the area and its building. All blockings are loaded with a single query.
+func ForArea(ctx context.Context, db *gorm.DB, area *model.Area, places []*model.Place, from, to time.Time, loc *time.Location) (map[uuid.UUID][]Interval, error) {
+	blockings, err := dbgen.BlockingQuery[model.Blocking](db).ListInheritedForArea(ctx, area.ID, area.BuildingID)
+	if err != nil {
+		return nil, err
+	}
+	occurrences, err := Expand(blockings, from, to, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Area and building occurrences apply to every place of the area.
+	var shared []Occurrence
+	own := map[uuid.UUID][]Occurrence{}
+	for _, o := range occurrences {
+		if o.Blocking.EntityType == EntityPlace {
+			own[o.Blocking.EntityID] = append(own[o.Blocking.EntityID], o)
+			continue
+		}
+		shared = append(shared, o)
+	}
+
+	intervals := make(map[uuid.UUID][]Interval, len(places))
+	for _, place := range places {
+		placeOccurrences := append(append([]Occurrence{}, shared...), own[place.ID]...)
+		intervals[place.ID] = Merge(placeOccurrences)
+	}
+	return intervals, nil
+}
+
+// ForBuilding returns the merged blocked intervals of a building's own
+// blockings that overlap [from, to).
+func ForBuilding(ctx context.Context, db *gorm.DB, building *model.Building, from, to time.Time, loc *time.Location) ([]Interval, error) {
+	blockings, err := dbgen.BlockingQuery[model.Blocking](db).ListByEntity(ctx, EntityBuilding, building.ID)
+	if err != nil {
+		return nil, err
+	}
+	occurrences, err := Expand(blockings, from, to, loc)
+	if err != nil {
+		return nil, err
+	}
+	return Merge(occurrences), nil
+}
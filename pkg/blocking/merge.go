@@ -0,0 +1,113 @@
+package blocking
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+)
+
+// Source identifies a blocking that contributes to an interval.
+type Source struct {
+	BlockingID   uuid.UUID
+	EntityType   string
+	EntityID     uuid.UUID
+	BlockingType model.BlockingType
+	Name         *string
+	Reason       *string
+}
+
+// Label returns the name of the blocking, or its type if it has no name.
+func (s Source) Label() string {
+	if s.Name != nil && *s.Name != "" {
+		return *s.Name
+	}
+	return string(s.BlockingType)
+}
+
+// Interval is a blocked time range made up of one or more overlapping
+// occurrences.
+type Interval struct {
+	Start time.Time
+	End   time.Time
+	// Sources are the contributing blockings, most specific entity first.
+	Sources []Source
+}
+
+// Overlaps reports whether the interval overlaps [start, end).
+func (i Interval) Overlaps(start, end time.Time) bool {
+	return i.Start.Before(end) && i.End.After(start)
+}
+
+// Primary returns the most specific source of the interval: a blocking of
+// the place itself wins over one inherited from its area or building.
+func (i Interval) Primary() Source {
+	return i.Sources[0]
+}
+
+// Merge combines overlapping or adjacent occurrences into intervals sorted by
+// start time. Each interval keeps the blockings it was built from.
+func Merge(occurrences []Occurrence) []Interval {
+	sorted := make([]Occurrence, len(occurrences))
+	copy(sorted, occurrences)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Start.Before(sorted[j].Start)
+	})
+
+	var intervals []Interval
+	for _, o := range sorted {
+		if n := len(intervals); n > 0 && !o.Start.After(intervals[n-1].End) {
+			last := &intervals[n-1]
+			if o.End.After(last.End) {
+				last.End = o.End
+			}
+			last.Sources = addSource(last.Sources, o.Blocking)
+			continue
+		}
+		intervals = append(intervals, Interval{
+			Start:   o.Start,
+			End:     o.End,
+			Sources: addSource(nil, o.Blocking),
+		})
+	}
+
+	for i := range intervals {
+		sources := intervals[i].Sources
+		sort.SliceStable(sources, func(a, b int) bool {
+			return specificity(sources[a].EntityType) < specificity(sources[b].EntityType)
+		})
+	}
+	return intervals
+}
+
+// addSource appends the blocking to sources unless it is already listed.
+func addSource(sources []Source, b *model.Blocking) []Source {
+	for _, s := range sources {
+		if s.BlockingID == b.ID {
+			return sources
+		}
+	}
+	return append(sources, Source{
+		BlockingID:   b.ID,
+		EntityType:   b.EntityType,
+		EntityID:     b.EntityID,
+		BlockingType: b.BlockingType,
+		Name:         b.Name,
+		Reason:       b.Reason,
+	})
+}
+
+// specificity orders entity types from the most to the least specific.
+func specificity(entityType string) int {
+	switch entityType {
+	case EntityPlace:
+		return 0
+	case EntityArea:
+		return 1
+	case EntityBuilding:
+		return 2
+	default:
+		return 3
+	}
+}
@@ -0,0 +1,98 @@
+package blocking
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+)
+
+func TestMerge(t *testing.T) {
+	day := time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC)
+	at := func(hour int) time.Time { return day.Add(time.Duration(hour) * time.Hour) }
+
+	named := func(entityType, name string) *model.Blocking {
+		return &model.Blocking{ID: uuid.New(), EntityType: entityType, EntityID: uuid.New(), BlockingType: model.BlockingTypeEvent, Name: &name}
+	}
+	building := named(EntityBuilding, "building")
+	area := named(EntityArea, "area")
+	otherArea := named(EntityArea, "other area")
+	place := named(EntityPlace, "place")
+	occurrence := func(b *model.Blocking, start, end int) Occurrence {
+		return Occurrence{Start: at(start), End: at(end), Blocking: b}
+	}
+
+	tests := []struct {
+		name        string
+		occurrences []Occurrence
+		// want lists the intervals as "start-end:source,source".
+		want []string
+	}{
+		{
+			name: "most specific source first",
+			occurrences: []Occurrence{
+				occurrence(building, 8, 12),
+				occurrence(area, 9, 10),
+				occurrence(place, 11, 13),
+			},
+			want: []string{"8-13:place,area,building"},
+		},
+		{
+			name: "equally specific sources by start",
+			occurrences: []Occurrence{
+				occurrence(otherArea, 9, 11),
+				occurrence(building, 7, 9),
+				occurrence(area, 8, 10),
+			},
+			want: []string{"7-11:area,other area,building"},
+		},
+		{
+			name: "adjacent occurrences",
+			occurrences: []Occurrence{
+				occurrence(building, 10, 12),
+				occurrence(place, 8, 10),
+			},
+			want: []string{"8-12:place,building"},
+		},
+		{
+			name: "separate occurrences",
+			occurrences: []Occurrence{
+				occurrence(place, 13, 14),
+				occurrence(building, 8, 9),
+			},
+			want: []string{"8-9:building", "13-14:place"},
+		},
+		{
+			name: "blocking listed once",
+			occurrences: []Occurrence{
+				occurrence(area, 8, 10),
+				occurrence(area, 9, 11),
+				occurrence(place, 10, 12),
+			},
+			want: []string{"8-12:place,area"},
+		},
+		{
+			name:        "contained occurrence",
+			occurrences: []Occurrence{occurrence(building, 8, 18), occurrence(place, 9, 10)},
+			want:        []string{"8-18:place,building"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, i := range Merge(tt.occurrences) {
+				labels := make([]string, 0, len(i.Sources))
+				for _, s := range i.Sources {
+					labels = append(labels, s.Label())
+				}
+				got = append(got, fmt.Sprintf("%d-%d:%s", i.Start.Hour(), i.End.Hour(), strings.Join(labels, ",")))
+			}
+			if strings.Join(got, " ") != strings.Join(tt.want, " ") {
+				t.Fatalf("intervals = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
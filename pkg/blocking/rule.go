@@ -0,0 +1,213 @@
+package blocking
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"github.com/teambition/rrule-go"
+)
+
+// rule is a parsed recurrence rule together with its exceptions.
+type rule struct {
+	rule *rrule.RRule
+	// exTimes are excluded occurrence start times.
+	exTimes []time.Time
+	// exDates are excluded calendar days (EXDATE;VALUE=DATE), as local
+	// midnight.
+	exDates []time.Time
+}
+
+// parseRule parses the recurrence rule of a blocking. The rule is either a
+// bare RRULE value such as "FREQ=WEEKLY;BYDAY=SA,SU" or a set of iCalendar
+// lines consisting of one RRULE and any number of EXDATE properties:
+//
+//	RRULE:FREQ=DAILY;BYHOUR=18
+//	EXDATE;TZID=Europe/Berlin:20261224T180000,20261231T180000
+//	EXDATE;VALUE=DATE:20261225
+//
+// The blocking's start time is the first occurrence (DTSTART) and its
+// RecurrenceEnd is the last day on which an occurrence may start. Floating
+// times are interpreted in loc.
+func parseRule(b *model.Blocking, loc *time.Location) (*rule, error) {
+	dtstart := b.StartTime.In(loc)
+	r := &rule{}
+
+	var option *rrule.ROption
+	for _, line := range strings.FieldsFunc(*b.RecurrenceRule, func(c rune) bool { return c == '\n' || c == '\r' }) {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		name, value := splitProperty(line)
+
+		switch name {
+		case "RRULE":
+			if option != nil {
+				return nil, fmt.Errorf("recurrence rule must contain exactly one RRULE")
+			}
+			var err error
+			option, err = rrule.StrToROptionInLocation(value, loc)
+			if err != nil {
+				return nil, fmt.Errorf("invalid RRULE: %w", err)
+			}
+		case "EXDATE":
+			if err := r.addExceptions(value, loc); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("unsupported recurrence property %q", name)
+		}
+	}
+	if option == nil {
+		return nil, fmt.Errorf("recurrence rule must contain an RRULE")
+	}
+	if err := checkFrequency(option); err != nil {
+		return nil, err
+	}
+
+	option.Dtstart = dtstart
+	if b.RecurrenceEnd != nil {
+		// RecurrenceEnd is a calendar date; take its day as stored.
+		y, m, d := b.RecurrenceEnd.Date()
+		until := time.Date(y, m, d, 23, 59, 59, 0, loc)
+		if option.Until.IsZero() || until.Before(option.Until) {
+			option.Until = until
+		}
+	}
+
+	var err error
+	r.rule, err = rrule.NewRRule(*option)
+	if err != nil {
+		return nil, fmt.Errorf("invalid RRULE: %w", err)
+	}
+	return r, nil
+}
+
+// checkFrequency rejects rules that recur more often than daily. Blockings
+// cover days, nights and weekends; finer rules only produce unbounded
+// numbers of occurrences.
+func checkFrequency(option *rrule.ROption) error {
+	if option.Freq > rrule.DAILY {
+		return fmt.Errorf("recurrence frequency %s is not supported; use DAILY or coarser", option.Freq)
+	}
+	if len(option.Byminute) > 1 || len(option.Bysecond) > 1 {
+		return fmt.Errorf("BYMINUTE and BYSECOND may list at most one value")
+	}
+	return nil
+}
+
+// splitProperty splits an iCalendar content line into its upper-case name
+// and the remainder after the name. Lines without a property name are bare
+// RRULE values.
+func splitProperty(line string) (name, value string) {
+	i := strings.IndexAny(line, ";:")
+	if i <= 0 || strings.Contains(line[:i], "=") {
+		return "RRULE", line
+	}
+	name = strings.ToUpper(line[:i])
+	if name == "RRULE" {
+		// RRULE has no parameters; drop the separator.
+		return name, line[i+1:]
+	}
+	return name, strings.TrimPrefix(line[i:], ";")
+}
+
+// addExceptions parses the parameters and value of an EXDATE property.
+func (r *rule) addExceptions(value string, loc *time.Location) error {
+	params, dates := "", value
+	if i := strings.LastIndex(value, ":"); i >= 0 {
+		params, dates = value[:i], value[i+1:]
+	}
+
+	dateOnly := false
+	for _, param := range strings.Split(params, ";") {
+		switch {
+		case param == "":
+		case strings.EqualFold(param, "VALUE=DATE"):
+			dateOnly = true
+		case strings.EqualFold(param, "VALUE=DATE-TIME"):
+		case strings.HasPrefix(strings.ToUpper(param), "TZID="):
+			tz, err := time.LoadLocation(param[len("TZID="):])
+			if err != nil {
+				return fmt.Errorf("invalid EXDATE time zone: %w", err)
+			}
+			loc = tz
+		default:
+			return fmt.Errorf("unsupported EXDATE parameter %q", param)
+		}
+	}
+
+	for _, date := range strings.Split(dates, ",") {
+		date = strings.TrimSpace(date)
+		if dateOnly || len(date) == len("20060102") {
+			t, err := time.ParseInLocation("20060102", date, loc)
+			if err != nil {
+				return fmt.Errorf("invalid EXDATE %q: %w", date, err)
+			}
+			r.exDates = append(r.exDates, t)
+			continue
+		}
+
+		var t time.Time
+		var err error
+		if strings.HasSuffix(date, "Z") {
+			t, err = time.Parse("20060102T150405Z", date)
+		} else {
+			t, err = time.ParseInLocation("20060102T150405", date, loc)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid EXDATE %q: %w", date, err)
+		}
+		r.exTimes = append(r.exTimes, t)
+	}
+	return nil
+}
+
+// excluded reports whether an occurrence starting at start is removed by an
+// EXDATE.
+func (r *rule) excluded(start time.Time) bool {
+	for _, t := range r.exTimes {
+		if t.Equal(start) {
+			return true
+		}
+	}
+	for _, d := range r.exDates {
+		local := start.In(d.Location())
+		if local.Year() == d.Year() && local.Month() == d.Month() && local.Day() == d.Day() {
+			return true
+		}
+	}
+	return false
+}
+
+// Recurrence returns the RRULE value of a recurring blocking for export to
+// iCalendar, together with the start times of the occurrences removed by its
+// EXDATEs. Excluded calendar days are resolved to the occurrences starting on
+// them, since EXDATE must match the value type of DTSTART.
+func Recurrence(b *model.Blocking, loc *time.Location) (string, []time.Time, error) {
+	r, err := parseRule(b, loc)
+	if err != nil {
+		return "", nil, err
+	}
+
+	option := r.rule.OrigOptions
+	if option.Count != 0 && !option.Until.IsZero() {
+		// COUNT and UNTIL must not both occur; the occurrences up to UNTIL
+		// are bounded by COUNT, so counting them gives an equivalent rule.
+		option.Count = len(r.rule.All())
+		option.Until = time.Time{}
+	}
+
+	exdates := append([]time.Time{}, r.exTimes...)
+	for _, d := range r.exDates {
+		next := d.AddDate(0, 0, 1)
+		for _, t := range r.rule.Between(d, next, true) {
+			if t.Before(next) {
+				exdates = append(exdates, t)
+			}
+		}
+	}
+	return option.RRuleString(), exdates, nil
+}
@@ -0,0 +1,215 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pixlcrashr/roomy/pkg/blocking"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"gorm.io/gorm"
+)
+
+// FeedMonths is how many months ahead public feeds reach.
+const FeedMonths = 3
+
+// Window returns the range covered by a public feed: from the start of the
+// current day until FeedMonths later.
+func Window(now time.Time, loc *time.Location) (time.Time, time.Time) {
+	y, m, d := now.In(loc).Date()
+	from := time.Date(y, m, d, 0, 0, 0, 0, loc)
+	return from, from.AddDate(0, FeedMonths, 0)
+}
+
+// ForPlace returns the public feed of a place with its own and inherited
+// blockings and its reservations overlapping [from, to).
+func ForPlace(ctx context.Context, db *gorm.DB, place *model.Place, from, to time.Time, loc *time.Location) (*Calendar, error) {
+	blockings, err := blocking.LoadForPlace(ctx, db, place)
+	if err != nil {
+		return nil, err
+	}
+	reservations, err := dbgen.ReservationQuery[model.Reservation](db).ListOverlapping(ctx, place.ID, from, to, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	f := newFeed(place.Name, from, to, loc, []*model.Place{place})
+	return f.build(blockings, reservations)
+}
+
+// ForArea returns the public feed of an area with the blockings of the area,
+// its places and its building and the reservations of its places.
+func ForArea(ctx context.Context, db *gorm.DB, area *model.Area, from, to time.Time, loc *time.Location) (*Calendar, error) {
+	places, err := dbgen.PlaceQuery[model.Place](db).ListByArea(ctx, area.ID)
+	if err != nil {
+		return nil, err
+	}
+	blockings, err := dbgen.BlockingQuery[model.Blocking](db).ListInheritedForArea(ctx, area.ID, area.BuildingID)
+	if err != nil {
+		return nil, err
+	}
+	reservations, err := dbgen.ReservationQuery[model.Reservation](db).ListOverlappingInArea(ctx, area.ID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	f := newFeed(area.Name, from, to, loc, places)
+	f.multiplePlaces = true
+	f.names[area.ID] = area.Name
+	return f.build(blockings, reservations)
+}
+
+// ForBuilding returns the public feed of a building with the blockings of
+// the building, its areas and places and the reservations of its places.
+func ForBuilding(ctx context.Context, db *gorm.DB, building *model.Building, from, to time.Time, loc *time.Location) (*Calendar, error) {
+	areas, err := dbgen.AreaQuery[model.Area](db).ListByBuilding(ctx, building.ID)
+	if err != nil {
+		return nil, err
+	}
+	places, err := dbgen.PlaceQuery[model.Place](db).ListByBuilding(ctx, building.ID)
+	if err != nil {
+		return nil, err
+	}
+	blockings, err := dbgen.BlockingQuery[model.Blocking](db).ListWithinBuilding(ctx, building.ID)
+	if err != nil {
+		return nil, err
+	}
+	reservations, err := dbgen.ReservationQuery[model.Reservation](db).ListOverlappingInBuilding(ctx, building.ID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	f := newFeed(building.Name, from, to, loc, places)
+	f.multiplePlaces = true
+	for _, area := range areas {
+		f.names[area.ID] = area.Name
+	}
+	return f.build(blockings, reservations)
+}
+
+// feed collects the events of a public calendar.
+type feed struct {
+	name     string
+	from, to time.Time
+	loc      *time.Location
+	places   map[uuid.UUID]*model.Place
+	// names maps the IDs of the entities blockings may belong to onto
+	// their names, which become the event location.
+	names map[uuid.UUID]string
+	// multiplePlaces prefixes reservation summaries with the place name.
+	multiplePlaces bool
+}
+
+func newFeed(name string, from, to time.Time, loc *time.Location, places []*model.Place) *feed {
+	f := &feed{
+		name:   name,
+		from:   from,
+		to:     to,
+		loc:    loc,
+		places: map[uuid.UUID]*model.Place{},
+		names:  map[uuid.UUID]string{},
+	}
+	for _, place := range places {
+		f.places[place.ID] = place
+		f.names[place.ID] = place.Name
+	}
+	return f
+}
+
+func (f *feed) build(blockings []*model.Blocking, reservations []*model.Reservation) (*Calendar, error) {
+	calendar := &Calendar{Name: f.name, Location: f.loc, Events: []Event{}}
+
+	for _, b := range blockings {
+		event, ok, err := f.blockingEvent(b)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			calendar.Events = append(calendar.Events, event)
+		}
+	}
+	for _, r := range reservations {
+		calendar.Events = append(calendar.Events, f.reservationEvent(r))
+	}
+
+	sort.SliceStable(calendar.Events, func(i, j int) bool {
+		a, b := calendar.Events[i], calendar.Events[j]
+		if !a.Start.Equal(b.Start) {
+			return a.Start.Before(b.Start)
+		}
+		return a.UID < b.UID
+	})
+	return calendar, nil
+}
+
+// blockingEvent returns the event of a blocking, or false if it has no
+// occurrence within the feed. Recurring blockings are emitted once with
+// their recurrence rule.
+func (f *feed) blockingEvent(b *model.Blocking) (Event, bool, error) {
+	occurrences, err := blocking.Expand([]*model.Blocking{b}, f.from, f.to, f.loc)
+	if err != nil {
+		return Event{}, false, err
+	}
+	if len(occurrences) == 0 {
+		return Event{}, false, nil
+	}
+
+	source := blocking.Source{BlockingType: b.BlockingType, Name: b.Name}
+	event := Event{
+		UID:        fmt.Sprintf("blocking-%s@roomy", b.ID),
+		Summary:    source.Label(),
+		Location:   f.names[b.EntityID],
+		Categories: []string{string(b.BlockingType)},
+		Status:     StatusConfirmed,
+		Start:      b.StartTime,
+		End:        b.EndTime,
+		Modified:   b.UpdatedAt,
+	}
+	if b.Reason != nil {
+		event.Description = *b.Reason
+	}
+
+	if b.IsRecurring && b.RecurrenceRule != nil && *b.RecurrenceRule != "" {
+		rule, exdates, err := blocking.Recurrence(b, f.loc)
+		if err != nil {
+			return Event{}, false, fmt.Errorf("blocking %s: %w", b.ID, err)
+		}
+		event.RRule = rule
+		event.ExDates = exdates
+		if b.RecurrenceDuration != nil {
+			event.End = b.StartTime.Add(time.Duration(*b.RecurrenceDuration))
+		}
+	}
+	return event, true, nil
+}
+
+// reservationEvent returns the event of a reservation. It deliberately
+// carries no details about the user holding the reservation.
+func (f *feed) reservationEvent(r *model.Reservation) Event {
+	summary := "Reserved"
+	location := ""
+	if place := f.places[r.PlaceID]; place != nil {
+		location = place.Name
+		if f.multiplePlaces {
+			summary = "Reserved: " + place.Name
+		}
+	}
+
+	status := StatusConfirmed
+	if r.Status == model.ReservationStatusPending {
+		status = StatusTentative
+	}
+	return Event{
+		UID:        fmt.Sprintf("reservation-%s@roomy", r.ID),
+		Summary:    summary,
+		Location:   location,
+		Categories: []string{"reservation"},
+		Status:     status,
+		Start:      r.StartTime,
+		End:        r.EndTime,
+		Modified:   r.UpdatedAt,
+	}
+}
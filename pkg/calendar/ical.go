@@ -0,0 +1,219 @@
+// Package calendar renders places, areas and buildings together with their
+// blockings and reservations as RFC 5545 iCalendar feeds.
+package calendar
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ProductID identifies Roomy as the producer of a feed.
+const ProductID = "-//Roomy//Roomy Calendar//EN"
+
+// Methods of an iCalendar object as defined by RFC 5546.
+const (
+	MethodPublish = "PUBLISH"
+	MethodRequest = "REQUEST"
+	MethodCancel  = "CANCEL"
+)
+
+// Event statuses.
+const (
+	StatusTentative = "TENTATIVE"
+	StatusConfirmed = "CONFIRMED"
+	StatusCancelled = "CANCELLED"
+)
+
+// Calendar is an iCalendar object with its events.
+type Calendar struct {
+	Name string
+	// Method is the iTIP method of the object, MethodPublish if empty.
+	Method string
+	// Location is the time zone event times are written in. A VTIMEZONE
+	// component is included unless it is UTC.
+	Location *time.Location
+	Events   []Event
+}
+
+// Event is a VEVENT.
+type Event struct {
+	// UID must be stable across feed refreshes.
+	UID         string
+	Summary     string
+	Description string
+	Location    string
+	Categories  []string
+	Status      string
+	Start       time.Time
+	End         time.Time
+	// RRule is the value of the RRULE property of a recurring event and
+	// ExDates the start times of excluded occurrences.
+	RRule   string
+	ExDates []time.Time
+	// Modified is the last modification of the underlying entity. It is
+	// used as DTSTAMP as well, so that an unchanged entity always renders
+	// to the same bytes.
+	Modified time.Time
+	// Sequence is the revision of the event. Invitations must increase it
+	// with every change, so that clients apply updates in order.
+	Sequence int
+	// Organizer and Attendee are the mail addresses of the organizer and the
+	// single attendee of an invitation.
+	Organizer string
+	Attendee  string
+}
+
+// Modified returns the latest modification of any event of the calendar.
+func (c *Calendar) Modified() time.Time {
+	var modified time.Time
+	for _, e := range c.Events {
+		if e.Modified.After(modified) {
+			modified = e.Modified
+		}
+	}
+	return modified
+}
+
+// Encode renders the calendar as an iCalendar stream.
+func (c *Calendar) Encode() []byte {
+	w := &writer{loc: c.Location}
+	if w.loc == nil {
+		w.loc = time.UTC
+	}
+
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:" + ProductID)
+	w.line("CALSCALE:GREGORIAN")
+	method := c.Method
+	if method == "" {
+		method = MethodPublish
+	}
+	w.line("METHOD:" + method)
+	if c.Name != "" {
+		w.line("X-WR-CALNAME:" + escape(c.Name))
+	}
+	if w.loc != time.UTC {
+		w.line("X-WR-TIMEZONE:" + w.loc.String())
+		w.timezone(c.Events)
+	}
+	for _, e := range c.Events {
+		w.event(e)
+	}
+	w.line("END:VCALENDAR")
+	return w.buf.Bytes()
+}
+
+// ETag returns a strong entity tag for an encoded calendar.
+func ETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// MatchesETag reports whether an If-None-Match header value matches etag.
+// Weak comparison is used, as RFC 9110 requires for If-None-Match.
+func MatchesETag(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+type writer struct {
+	buf bytes.Buffer
+	loc *time.Location
+}
+
+func (w *writer) event(e Event) {
+	w.line("BEGIN:VEVENT")
+	w.line("UID:" + e.UID)
+	w.line("DTSTAMP:" + utc(e.Modified))
+	w.line("LAST-MODIFIED:" + utc(e.Modified))
+	w.line("DTSTART" + w.datetime(e.Start))
+	w.line("DTEND" + w.datetime(e.End))
+	if e.RRule != "" {
+		w.line("RRULE:" + e.RRule)
+		for _, t := range e.ExDates {
+			w.line("EXDATE" + w.datetime(t))
+		}
+	}
+	if e.Sequence > 0 {
+		w.line("SEQUENCE:" + strconv.Itoa(e.Sequence))
+	}
+	if e.Organizer != "" {
+		w.line("ORGANIZER:mailto:" + e.Organizer)
+	}
+	if e.Attendee != "" {
+		w.line("ATTENDEE;ROLE=REQ-PARTICIPANT;PARTSTAT=ACCEPTED:mailto:" + e.Attendee)
+	}
+	w.line("SUMMARY:" + escape(e.Summary))
+	if e.Description != "" {
+		w.line("DESCRIPTION:" + escape(e.Description))
+	}
+	if e.Location != "" {
+		w.line("LOCATION:" + escape(e.Location))
+	}
+	if len(e.Categories) > 0 {
+		categories := make([]string, len(e.Categories))
+		for i, c := range e.Categories {
+			categories[i] = escape(c)
+		}
+		w.line("CATEGORIES:" + strings.Join(categories, ","))
+	}
+	if e.Status != "" {
+		w.line("STATUS:" + e.Status)
+	}
+	w.line("TRANSP:OPAQUE")
+	w.line("END:VEVENT")
+}
+
+// datetime returns the parameters and value of a DATE-TIME property in the
+// calendar's time zone, starting with the separator after the name.
+func (w *writer) datetime(t time.Time) string {
+	if w.loc == time.UTC {
+		return ":" + utc(t)
+	}
+	return ";TZID=" + w.loc.String() + ":" + t.In(w.loc).Format("20060102T150405")
+}
+
+// line writes a content line, folding it after 75 octets without splitting
+// UTF-8 sequences.
+func (w *writer) line(s string) {
+	limit := 75
+	for len(s) > limit {
+		i := limit
+		for i > 0 && !utf8.RuneStart(s[i]) {
+			i--
+		}
+		w.buf.WriteString(s[:i])
+		w.buf.WriteString("\r\n ")
+		s = s[i:]
+		// The leading space of a continuation line counts against its length.
+		limit = 74
+	}
+	w.buf.WriteString(s)
+	w.buf.WriteString("\r\n")
+}
+
+func utc(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// escape escapes a TEXT value.
+func escape(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s)
+}
@@ -0,0 +1,83 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"gorm.io/gorm"
+)
+
+// PersonalWindow returns the range covered by a personal feed: FeedMonths
+// back from the start of the current day and FeedMonths ahead, so that past
+// bookings do not vanish from subscribed calendars right away.
+func PersonalWindow(now time.Time, loc *time.Location) (time.Time, time.Time) {
+	from, to := Window(now, loc)
+	return from.AddDate(0, -FeedMonths, 0), to
+}
+
+// ForUser returns the personal feed of a user with all of their reservations
+// overlapping [from, to). Cancelled reservations are included with status
+// CANCELLED, so that subscribed calendars remove them.
+func ForUser(ctx context.Context, db *gorm.DB, user *model.User, from, to time.Time, loc *time.Location) (*Calendar, error) {
+	var reservations []*model.Reservation
+	if err := db.WithContext(ctx).
+		Preload("Place.Area.Building").
+		Where("user_id = ? AND start_time < ? AND end_time > ?", user.ID, to, from).
+		Order("start_time").
+		Find(&reservations).Error; err != nil {
+		return nil, err
+	}
+
+	calendar := &Calendar{Name: "Roomy – " + user.Name, Location: loc, Events: []Event{}}
+	for _, r := range reservations {
+		calendar.Events = append(calendar.Events, personalEvent(r))
+	}
+	return calendar, nil
+}
+
+// personalEvent returns the event of one of the user's own reservations.
+func personalEvent(r *model.Reservation) Event {
+	event := Event{
+		UID:        fmt.Sprintf("reservation-%s@roomy", r.ID),
+		Summary:    "Reservation",
+		Categories: []string{"reservation"},
+		Status:     StatusConfirmed,
+		Start:      r.StartTime,
+		End:        r.EndTime,
+		Modified:   r.UpdatedAt,
+	}
+	switch r.Status {
+	case model.ReservationStatusPending:
+		event.Status = StatusTentative
+	case model.ReservationStatusCancelled:
+		event.Status = StatusCancelled
+	}
+
+	var location, description []string
+	if place := r.Place; place != nil {
+		event.Summary = "Reservation: " + place.Name
+		location = append(location, place.Name)
+		description = append(description, "Place: "+place.Name)
+		if area := place.Area; area != nil {
+			location = append(location, area.Name)
+			description = append(description, "Area: "+area.Name)
+			if building := area.Building; building != nil {
+				location = append(location, building.Name)
+				description = append(description, "Building: "+building.Name)
+			}
+		}
+		if place.RequiresCheckIn {
+			description = append(description, "Check-in required.")
+		}
+	}
+	if r.Status == model.ReservationStatusCancelled && r.CancelReason != nil && *r.CancelReason != "" {
+		description = append(description, "Cancelled: "+*r.CancelReason)
+	}
+
+	event.Location = strings.Join(location, ", ")
+	event.Description = strings.Join(description, "\n")
+	return event
+}
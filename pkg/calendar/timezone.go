@@ -0,0 +1,126 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+)
+
+// transition is a change of the UTC offset of a time zone.
+type transition struct {
+	at   time.Time
+	from int
+	to   int
+	name string
+	dst  bool
+}
+
+// timezone writes the VTIMEZONE component of the calendar's time zone. Go
+// does not expose the rules of a zone, so the transitions of the year of
+// the first event are observed and written as yearly rules.
+func (w *writer) timezone(events []Event) {
+	year := time.Now().In(w.loc).Year()
+	if len(events) > 0 {
+		year = events[0].Start.In(w.loc).Year()
+	}
+
+	w.line("BEGIN:VTIMEZONE")
+	w.line("TZID:" + w.loc.String())
+
+	transitions := transitionsIn(year, w.loc)
+	if len(transitions) == 0 {
+		name, offset := time.Date(year, time.January, 1, 0, 0, 0, 0, w.loc).Zone()
+		w.line("BEGIN:STANDARD")
+		w.line("DTSTART:19700101T000000")
+		w.line("TZOFFSETFROM:" + formatOffset(offset))
+		w.line("TZOFFSETTO:" + formatOffset(offset))
+		w.line("TZNAME:" + name)
+		w.line("END:STANDARD")
+	}
+	for _, t := range transitions {
+		component := "STANDARD"
+		if t.dst {
+			component = "DAYLIGHT"
+		}
+		// The transition is given in the wall clock time before it.
+		local := t.at.In(time.FixedZone("", t.from))
+		n := nthWeekday(local)
+		first := weekdayOfMonth(1970, local.Month(), local.Weekday(), n)
+
+		w.line("BEGIN:" + component)
+		w.line("DTSTART:" + first.Format("20060102") + local.Format("T150405"))
+		w.line(fmt.Sprintf("RRULE:FREQ=YEARLY;BYMONTH=%d;BYDAY=%d%s", int(local.Month()), n, weekdayCodes[local.Weekday()]))
+		w.line("TZOFFSETFROM:" + formatOffset(t.from))
+		w.line("TZOFFSETTO:" + formatOffset(t.to))
+		w.line("TZNAME:" + t.name)
+		w.line("END:" + component)
+	}
+	w.line("END:VTIMEZONE")
+}
+
+// transitionsIn returns the offset changes of loc within year.
+func transitionsIn(year int, loc *time.Location) []transition {
+	var transitions []transition
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	end := start.AddDate(1, 0, 0)
+	for t := start; t.Before(end); t = t.Add(24 * time.Hour) {
+		next := t.Add(24 * time.Hour)
+		_, before := t.Zone()
+		if _, after := next.Zone(); after == before {
+			continue
+		}
+
+		// Narrow the change down to the second.
+		lo, hi := t, next
+		for hi.Sub(lo) > time.Second {
+			mid := lo.Add(hi.Sub(lo) / 2)
+			if _, offset := mid.Zone(); offset == before {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		name, after := hi.Zone()
+		transitions = append(transitions, transition{at: hi, from: before, to: after, name: name, dst: hi.IsDST()})
+	}
+	return transitions
+}
+
+// nthWeekday returns the occurrence of t's weekday within its month, or -1
+// for the last one.
+func nthWeekday(t time.Time) int {
+	if t.AddDate(0, 0, 7).Month() != t.Month() {
+		return -1
+	}
+	return (t.Day()-1)/7 + 1
+}
+
+// weekdayOfMonth returns the n-th weekday of a month, counting from the end
+// if n is negative.
+func weekdayOfMonth(year int, month time.Month, weekday time.Weekday, n int) time.Time {
+	if n < 0 {
+		last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC)
+		return last.AddDate(0, 0, -((int(last.Weekday()) - int(weekday) + 7) % 7))
+	}
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	return first.AddDate(0, 0, (int(weekday)-int(first.Weekday())+7)%7+(n-1)*7)
+}
+
+var weekdayCodes = map[time.Weekday]string{
+	time.Monday:    "MO",
+	time.Tuesday:   "TU",
+	time.Wednesday: "WE",
+	time.Thursday:  "TH",
+	time.Friday:    "FR",
+	time.Saturday:  "SA",
+	time.Sunday:    "SU",
+}
+
+// formatOffset formats a UTC offset in seconds as ±HHMM.
+func formatOffset(offset int) string {
+	sign := '+'
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+	return fmt.Sprintf("%c%02d%02d", sign, offset/3600, offset%3600/60)
+}
@@ -3,6 +3,7 @@ package cfg
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -10,16 +11,93 @@ import (
 type Config struct {
 	Server   ServerConfig   `mapstructure:"server"`
 	Database DatabaseConfig `mapstructure:"database"`
+	GitLab   GitLabConfig   `mapstructure:"gitlab"`
+	JWT      JWTConfig      `mapstructure:"jwt"`
+	Booking  BookingConfig  `mapstructure:"booking"`
+	Mail     MailConfig     `mapstructure:"mail"`
+	Jobs     JobsConfig     `mapstructure:"jobs"`
+	CheckIn  CheckInConfig  `mapstructure:"checkIn"`
 }
 
 type ServerConfig struct {
-	Address string `mapstructure:"address"`
+	Address         string        `mapstructure:"address"`
+	ShutdownTimeout time.Duration `mapstructure:"shutdownTimeout"`
+	// PublicURL is the URL under which users reach Roomy. Links printed on
+	// QR codes and sent by mail point to it.
+	PublicURL string `mapstructure:"publicUrl"`
 }
 
 type DatabaseConfig struct {
 	URL string `mapstructure:"url"`
 }
 
+type GitLabConfig struct {
+	BaseURL      string   `mapstructure:"baseUrl"`
+	ClientID     string   `mapstructure:"clientId"`
+	ClientSecret string   `mapstructure:"clientSecret"`
+	RedirectURL  string   `mapstructure:"redirectUrl"`
+	Scopes       []string `mapstructure:"scopes"`
+}
+
+type JWTConfig struct {
+	// SigningKeys are HMAC secrets; the first key signs new tokens and all
+	// keys are accepted for verification to allow key rotation.
+	SigningKeys     []string      `mapstructure:"signingKeys"`
+	Issuer          string        `mapstructure:"issuer"`
+	AccessTokenTTL  time.Duration `mapstructure:"accessTokenTtl"`
+	RefreshTokenTTL time.Duration `mapstructure:"refreshTokenTtl"`
+	StateTTL        time.Duration `mapstructure:"stateTtl"`
+}
+
+type BookingConfig struct {
+	// Timezone is the IANA time zone in which booking hours, time slot grids
+	// and recurrence rules are evaluated.
+	Timezone string `mapstructure:"timezone"`
+	// ProfileStrategy decides which value applies if a place and constraint
+	// profiles of a user's groups set the same limit: "permissive" applies
+	// the most permissive, "restrictive" the most restrictive one.
+	ProfileStrategy string `mapstructure:"profileStrategy"`
+}
+
+type CheckInConfig struct {
+	// SigningKeys are HMAC secrets of the tokens encoded in place QR codes;
+	// the first key signs new tokens and all keys are accepted for
+	// verification to allow key rotation.
+	SigningKeys []string `mapstructure:"signingKeys"`
+	// EarlyWindow is how long before the start of a reservation checking in
+	// is allowed.
+	EarlyWindow time.Duration `mapstructure:"earlyWindow"`
+	// LateWindow is how long after the start of a reservation checking in
+	// is allowed. Zero allows checking in until the reservation ends.
+	LateWindow time.Duration `mapstructure:"lateWindow"`
+}
+
+type MailConfig struct {
+	// Enabled starts the delivery worker. Notifications are written to the
+	// outbox regardless and are delivered once the worker runs.
+	Enabled  bool   `mapstructure:"enabled"`
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	// Security is one of "none", "starttls" or "tls".
+	Security string `mapstructure:"security"`
+	// From is the sender address, e.g. "Roomy <roomy@example.com>". It is
+	// also the organizer of attached calendar invitations.
+	From         string        `mapstructure:"from"`
+	PollInterval time.Duration `mapstructure:"pollInterval"`
+	// MaxAttempts is how often delivery of a message is tried before it is
+	// dead-lettered.
+	MaxAttempts int `mapstructure:"maxAttempts"`
+}
+
+type JobsConfig struct {
+	// Enabled runs the background jobs within `roomy serve`. They can run
+	// in a separate `roomy worker` process instead.
+	Enabled  bool          `mapstructure:"enabled"`
+	Interval time.Duration `mapstructure:"interval"`
+}
+
 func Load(cfgFile string) (*Config, error) {
 	if cfgFile != "" {
 		viper.SetConfigFile(cfgFile)
@@ -36,7 +114,27 @@ func Load(cfgFile string) (*Config, error) {
 	viper.AutomaticEnv()
 
 	viper.SetDefault("server.address", "127.0.0.1:8080")
+	viper.SetDefault("server.shutdownTimeout", 30*time.Second)
+	viper.SetDefault("server.publicUrl", "http://127.0.0.1:8080")
 	viper.SetDefault("database.url", "")
+	viper.SetDefault("gitlab.baseUrl", "https://gitlab.com")
+	viper.SetDefault("gitlab.scopes", []string{"read_user"})
+	viper.SetDefault("jwt.issuer", "roomy")
+	viper.SetDefault("jwt.accessTokenTtl", 15*time.Minute)
+	viper.SetDefault("jwt.refreshTokenTtl", 30*24*time.Hour)
+	viper.SetDefault("jwt.stateTtl", 10*time.Minute)
+	viper.SetDefault("booking.timezone", "UTC")
+	viper.SetDefault("booking.profileStrategy", "permissive")
+	viper.SetDefault("checkIn.earlyWindow", 15*time.Minute)
+	viper.SetDefault("checkIn.lateWindow", time.Duration(0))
+	viper.SetDefault("mail.enabled", false)
+	viper.SetDefault("mail.port", 587)
+	viper.SetDefault("mail.security", "starttls")
+	viper.SetDefault("mail.from", "Roomy <roomy@localhost>")
+	viper.SetDefault("mail.pollInterval", 10*time.Second)
+	viper.SetDefault("mail.maxAttempts", 8)
+	viper.SetDefault("jobs.enabled", true)
+	viper.SetDefault("jobs.interval", time.Minute)
 
 	if err := viper.ReadInConfig(); err != nil {
 		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
@@ -0,0 +1,185 @@
+// Package constraints resolves the booking constraints of a place from those
+// set on the place itself, its area and its building, and overrides them with
+// the constraint profiles of a user's groups.
+package constraints
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"gorm.io/gorm"
+)
+
+// Entity types constraints can be set on.
+const (
+	EntityBuilding = "building"
+	EntityArea     = "area"
+	EntityPlace    = "place"
+)
+
+// Windows quotas can be counted in.
+const (
+	// QuotaWindowCalendar counts reservations per calendar day, week
+	// (starting on Monday), month and year. It is the default.
+	QuotaWindowCalendar = "calendar"
+	// QuotaWindowRolling counts reservations in every day, week (7 days),
+	// month and year long window around the start of a reservation.
+	QuotaWindowRolling = "rolling"
+)
+
+// Effective are the constraints that apply to a place. Sources maps the API
+// name of every set field to the entity type it was taken from, Profiles
+// that of every field overridden by a constraint profile to the profile.
+// Levels holds the IDs of the place, its area and its building by entity
+// type.
+type Effective struct {
+	model.BookingConstraints
+	Sources  map[string]string
+	Profiles map[string]*model.ConstraintProfile
+	Levels   map[string]uuid.UUID
+}
+
+// Scope returns the entity type and ID of the scope a field applies to: the
+// building, area or place it was set on, or the scope of the constraint
+// profile that overrides it. Fields of profiles for all places, and unset
+// fields, return an empty entity type.
+func (e *Effective) Scope(name string) (string, uuid.UUID) {
+	if p := e.Profiles[name]; p != nil {
+		if p.ScopeType == nil || p.ScopeID == nil {
+			return "", uuid.Nil
+		}
+		return *p.ScopeType, *p.ScopeID
+	}
+	entityType, ok := e.Sources[name]
+	if !ok {
+		return "", uuid.Nil
+	}
+	return entityType, e.Levels[entityType]
+}
+
+// Load returns the constraints set on an entity, or unsaved empty
+// constraints if none have been stored yet.
+func Load(ctx context.Context, db *gorm.DB, entityType string, entityID uuid.UUID) (*model.BookingConstraints, error) {
+	c, err := dbgen.BookingConstraintsQuery[model.BookingConstraints](db).GetByEntity(ctx, entityType, entityID)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return &model.BookingConstraints{EntityType: entityType, EntityID: entityID}, nil
+	}
+	return c, nil
+}
+
+// ForPlace returns the effective constraints of a place.
+func ForPlace(ctx context.Context, db *gorm.DB, place *model.Place) (*Effective, error) {
+	area, err := loadArea(ctx, db, place)
+	if err != nil {
+		return nil, err
+	}
+	return forArea(ctx, db, place, area)
+}
+
+// ForUser returns the effective constraints of a place for a user: those of
+// the place, overridden by the constraint profiles of the user's groups
+// that apply to the place.
+func ForUser(ctx context.Context, db *gorm.DB, place *model.Place, userID uuid.UUID, strategy Strategy) (*Effective, error) {
+	area, err := loadArea(ctx, db, place)
+	if err != nil {
+		return nil, err
+	}
+	e, err := forArea(ctx, db, place, area)
+	if err != nil {
+		return nil, err
+	}
+	profiles, err := dbgen.ConstraintProfileQuery[model.ConstraintProfile](db).ListForUser(ctx, userID, area.ID, area.BuildingID)
+	if err != nil {
+		return nil, err
+	}
+	e.Apply(profiles, strategy)
+	return e, nil
+}
+
+func loadArea(ctx context.Context, db *gorm.DB, place *model.Place) (*model.Area, error) {
+	area, err := dbgen.AreaQuery[model.Area](db).GetByID(ctx, place.AreaID)
+	if err != nil {
+		return nil, err
+	}
+	if area == nil {
+		return nil, fmt.Errorf("area %s of place %s does not exist", place.AreaID, place.ID)
+	}
+	return area, nil
+}
+
+func forArea(ctx context.Context, db *gorm.DB, place *model.Place, area *model.Area) (*Effective, error) {
+	levels, err := dbgen.BookingConstraintsQuery[model.BookingConstraints](db).ListInheritedForPlace(ctx, place.ID, area.ID, area.BuildingID)
+	if err != nil {
+		return nil, err
+	}
+	e := Resolve(place.ID, levels)
+	e.Levels = map[string]uuid.UUID{
+		EntityPlace:    place.ID,
+		EntityArea:     area.ID,
+		EntityBuilding: area.BuildingID,
+	}
+	return e, nil
+}
+
+// order is the order in which levels are looked at, most specific first.
+var order = []string{EntityPlace, EntityArea, EntityBuilding}
+
+// Resolve merges the constraints of a place's levels field by field, each
+// taken from the most specific level that sets it.
+func Resolve(placeID uuid.UUID, levels []*model.BookingConstraints) *Effective {
+	e := &Effective{
+		BookingConstraints: model.BookingConstraints{EntityType: EntityPlace, EntityID: placeID},
+		Sources:            map[string]string{},
+		Profiles:           map[string]*model.ConstraintProfile{},
+	}
+	for _, entityType := range order {
+		for _, level := range levels {
+			if level.EntityType != entityType {
+				continue
+			}
+			for name, field := range intFields(&e.BookingConstraints) {
+				if *field == nil {
+					if v := intFields(level)[name]; *v != nil {
+						*field = *v
+						e.Sources[name] = entityType
+					}
+				}
+			}
+			if e.WhitelistEnabled == nil && level.WhitelistEnabled != nil {
+				e.WhitelistEnabled = level.WhitelistEnabled
+				e.Sources["whitelistEnabled"] = entityType
+			}
+			if e.QuotaWindow == nil && level.QuotaWindow != nil {
+				e.QuotaWindow = level.QuotaWindow
+				e.Sources["quotaWindow"] = entityType
+			}
+		}
+	}
+	return e
+}
+
+// intFields returns the integer fields of constraints by their API name.
+func intFields(c *model.BookingConstraints) map[string]**int {
+	return map[string]**int{
+		"minReservationDuration":    &c.MinReservationMinutes,
+		"maxReservationDuration":    &c.MaxReservationMinutes,
+		"maxReservationsPerDay":     &c.MaxReservationsPerDay,
+		"maxReservationsPerWeek":    &c.MaxReservationsPerWeek,
+		"maxReservationsPerMonth":   &c.MaxReservationsPerMonth,
+		"maxReservationsPerYear":    &c.MaxReservationsPerYear,
+		"maxHoursPerDay":            &c.MaxHoursPerDay,
+		"maxHoursPerWeek":           &c.MaxHoursPerWeek,
+		"maxHoursPerMonth":          &c.MaxHoursPerMonth,
+		"maxHoursPerYear":           &c.MaxHoursPerYear,
+		"maxConcurrentReservations": &c.MaxConcurrentReservations,
+		"maxAdvanceBookingDays":     &c.MaxAdvanceBookingDays,
+		"checkInTimeoutMinutes":     &c.CheckInTimeoutMinutes,
+		"decayTimeoutMinutes":       &c.DecayTimeoutMinutes,
+	}
+}
@@ -0,0 +1,82 @@
+package constraints
+
+import (
+	"fmt"
+
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+)
+
+// Strategy decides which value applies if the constraints of a place and
+// constraint profiles of a user's groups set the same field.
+type Strategy string
+
+const (
+	// StrategyPermissive applies the most permissive value, so that
+	// profiles can only relax the limits of a place.
+	StrategyPermissive Strategy = "permissive"
+	// StrategyRestrictive applies the most restrictive value, so that
+	// profiles can only tighten the limits of a place.
+	StrategyRestrictive Strategy = "restrictive"
+)
+
+// ParseStrategy parses the name of a strategy.
+func ParseStrategy(name string) (Strategy, error) {
+	switch s := Strategy(name); s {
+	case StrategyPermissive, StrategyRestrictive:
+		return s, nil
+	}
+	return "", fmt.Errorf("unknown constraint profile strategy %q, expected %s or %s", name, StrategyPermissive, StrategyRestrictive)
+}
+
+// Apply overrides the fields for which strategy prefers the value of one of
+// the profiles over that of the place. Unset fields are the most permissive
+// value. Ties go to the place and then to the first profile.
+func (e *Effective) Apply(profiles []*model.ConstraintProfile, strategy Strategy) {
+	fields := intFields(&e.BookingConstraints)
+	for _, p := range profiles {
+		for name, v := range profileFields(p) {
+			if *v == nil {
+				continue
+			}
+			current := *fields[name]
+			if current == nil && strategy != StrategyRestrictive {
+				continue
+			}
+			if current == nil || strategy.prefers(name, **v, *current) {
+				value := **v
+				*fields[name] = &value
+				e.Profiles[name] = p
+			}
+		}
+	}
+}
+
+// prefers reports whether the strategy prefers v over current for a field.
+// Higher values are more permissive, except for the minimum duration.
+func (s Strategy) prefers(name string, v, current int) bool {
+	if name == "minReservationDuration" {
+		v, current = current, v
+	}
+	if s == StrategyRestrictive {
+		return v < current
+	}
+	return v > current
+}
+
+// profileFields returns the fields of a profile by their API name.
+func profileFields(p *model.ConstraintProfile) map[string]**int {
+	return map[string]**int{
+		"minReservationDuration":    &p.MinReservationMinutes,
+		"maxReservationDuration":    &p.MaxReservationMinutes,
+		"maxReservationsPerDay":     &p.MaxReservationsPerDay,
+		"maxReservationsPerWeek":    &p.MaxReservationsPerWeek,
+		"maxReservationsPerMonth":   &p.MaxReservationsPerMonth,
+		"maxReservationsPerYear":    &p.MaxReservationsPerYear,
+		"maxHoursPerDay":            &p.MaxHoursPerDay,
+		"maxHoursPerWeek":           &p.MaxHoursPerWeek,
+		"maxHoursPerMonth":          &p.MaxHoursPerMonth,
+		"maxHoursPerYear":           &p.MaxHoursPerYear,
+		"maxConcurrentReservations": &p.MaxConcurrentReservations,
+		"maxAdvanceBookingDays":     &p.MaxAdvanceBookingDays,
+	}
+}
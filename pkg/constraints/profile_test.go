@@ -0,0 +1,92 @@
+package constraints
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+)
+
+func intPtr(v int) *int { return &v }
+
+func TestApply(t *testing.T) {
+	staff := &model.ConstraintProfile{Name: "Staff", MaxHoursPerWeek: intPtr(10), MinReservationMinutes: intPtr(60)}
+	students := &model.ConstraintProfile{Name: "Students", MaxHoursPerWeek: intPtr(4), MinReservationMinutes: intPtr(15)}
+	guests := &model.ConstraintProfile{Name: "Guests", MaxHoursPerWeek: intPtr(4)}
+
+	tests := []struct {
+		name     string
+		place    model.BookingConstraints
+		profiles []*model.ConstraintProfile
+		strategy Strategy
+		field    string
+		want     *int
+		// from is the name of the profile the value is taken from, if any.
+		from string
+	}{
+		{name: "permissive looser profile", place: model.BookingConstraints{MaxHoursPerWeek: intPtr(6)}, profiles: []*model.ConstraintProfile{staff},
+			strategy: StrategyPermissive, field: "maxHoursPerWeek", want: intPtr(10), from: "Staff"},
+		{name: "permissive stricter profile", place: model.BookingConstraints{MaxHoursPerWeek: intPtr(6)}, profiles: []*model.ConstraintProfile{students},
+			strategy: StrategyPermissive, field: "maxHoursPerWeek", want: intPtr(6)},
+		{name: "permissive several profiles", place: model.BookingConstraints{MaxHoursPerWeek: intPtr(6)}, profiles: []*model.ConstraintProfile{students, staff},
+			strategy: StrategyPermissive, field: "maxHoursPerWeek", want: intPtr(10), from: "Staff"},
+		{name: "permissive unlimited place", profiles: []*model.ConstraintProfile{staff},
+			strategy: StrategyPermissive, field: "maxHoursPerWeek"},
+		{name: "permissive tie goes to place", place: model.BookingConstraints{MaxHoursPerWeek: intPtr(4)}, profiles: []*model.ConstraintProfile{students},
+			strategy: StrategyPermissive, field: "maxHoursPerWeek", want: intPtr(4)},
+		{name: "permissive lower minimum", place: model.BookingConstraints{MinReservationMinutes: intPtr(30)}, profiles: []*model.ConstraintProfile{staff, students},
+			strategy: StrategyPermissive, field: "minReservationDuration", want: intPtr(15), from: "Students"},
+		{name: "permissive no minimum", profiles: []*model.ConstraintProfile{students},
+			strategy: StrategyPermissive, field: "minReservationDuration"},
+		{name: "restrictive stricter profile", place: model.BookingConstraints{MaxHoursPerWeek: intPtr(6)}, profiles: []*model.ConstraintProfile{students},
+			strategy: StrategyRestrictive, field: "maxHoursPerWeek", want: intPtr(4), from: "Students"},
+		{name: "restrictive looser profile", place: model.BookingConstraints{MaxHoursPerWeek: intPtr(6)}, profiles: []*model.ConstraintProfile{staff},
+			strategy: StrategyRestrictive, field: "maxHoursPerWeek", want: intPtr(6)},
+		{name: "restrictive several profiles", place: model.BookingConstraints{MaxHoursPerWeek: intPtr(6)}, profiles: []*model.ConstraintProfile{staff, students},
+			strategy: StrategyRestrictive, field: "maxHoursPerWeek", want: intPtr(4), from: "Students"},
+		{name: "restrictive unlimited place", profiles: []*model.ConstraintProfile{staff},
+			strategy: StrategyRestrictive, field: "maxHoursPerWeek", want: intPtr(10), from: "Staff"},
+		{name: "restrictive tie goes to first profile", profiles: []*model.ConstraintProfile{students, guests},
+			strategy: StrategyRestrictive, field: "maxHoursPerWeek", want: intPtr(4), from: "Students"},
+		{name: "restrictive higher minimum", place: model.BookingConstraints{MinReservationMinutes: intPtr(30)}, profiles: []*model.ConstraintProfile{students, staff},
+			strategy: StrategyRestrictive, field: "minReservationDuration", want: intPtr(60), from: "Staff"},
+		{name: "field unset by profiles", place: model.BookingConstraints{MaxReservationsPerDay: intPtr(2)}, profiles: []*model.ConstraintProfile{staff},
+			strategy: StrategyRestrictive, field: "maxReservationsPerDay", want: intPtr(2)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			place := tt.place
+			place.EntityType = EntityPlace
+			place.EntityID = uuid.New()
+			e := Resolve(place.EntityID, []*model.BookingConstraints{&place})
+			e.Apply(tt.profiles, tt.strategy)
+
+			got := *intFields(&e.BookingConstraints)[tt.field]
+			switch {
+			case got == nil && tt.want != nil:
+				t.Fatalf("%s = nil, want %d", tt.field, *tt.want)
+			case got != nil && tt.want == nil:
+				t.Fatalf("%s = %d, want nil", tt.field, *got)
+			case got != nil && *got != *tt.want:
+				t.Fatalf("%s = %d, want %d", tt.field, *got, *tt.want)
+			}
+			var from string
+			if p := e.Profiles[tt.field]; p != nil {
+				from = p.Name
+			}
+			if from != tt.from {
+				t.Fatalf("%s taken from profile %q, want %q", tt.field, from, tt.from)
+			}
+		})
+	}
+}
+
+func TestApplyKeepsProfilesUnchanged(t *testing.T) {
+	profile := &model.ConstraintProfile{Name: "Staff", MaxHoursPerWeek: intPtr(10)}
+	e := Resolve(uuid.New(), nil)
+	e.Apply([]*model.ConstraintProfile{profile}, StrategyRestrictive)
+	*e.MaxHoursPerWeek = 1
+	if *profile.MaxHoursPerWeek != 10 {
+		t.Fatalf("profile value = %d after changing the effective value, want 10", *profile.MaxHoursPerWeek)
+	}
+}
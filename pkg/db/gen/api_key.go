@@ -26,7 +26,7 @@ type _APIKeyQueryInterface[T any] interface {
 	GetByValue(ctx context.Context, value string) (*model.APIKey, error)
 	ListByUser(ctx context.Context, userID uuid.UUID) ([]*model.APIKey, error)
 	Insert(ctx context.Context, id uuid.UUID, userID uuid.UUID, name string, value string, expiresAt *time.Time) error
-	UpdateLastUsed(ctx context.Context, id uuid.UUID) error
+	UpdateLastUsed(ctx context.Context, usages []model.APIKeyUsage) error
 	Remove(ctx context.Context, id uuid.UUID, userID uuid.UUID) error
 }
 
@@ -74,20 +74,30 @@ func (e _APIKeyQueryImpl[T]) Insert(ctx context.Context, id uuid.UUID, userID uu
 	var sb strings.Builder
 	_params := make([]any, 0, 6)
 
-	sb.WriteString("INSERT INTO ? (id, user_id, name, value, expires_at, created_at)")
+	sb.WriteString("INSERT INTO ? (id, user_id, name, value, expires_at, created_at, updated_at)")
 	_params = append(_params, clause.Table{Name: clause.CurrentTable})
-	sb.WriteString(" VALUES (?, ?, ?, ?, ?, NOW())")
+	sb.WriteString(" VALUES (?, ?, ?, ?, ?, NOW(), NOW())")
 	_params = append(_params, id, userID, name, value, expiresAt)
 
 	return e.Exec(ctx, sb.String(), _params...)
 }
 
-func (e _APIKeyQueryImpl[T]) UpdateLastUsed(ctx context.Context, id uuid.UUID) error {
+func (e _APIKeyQueryImpl[T]) UpdateLastUsed(ctx context.Context, usages []model.APIKeyUsage) error {
 	var sb strings.Builder
-	_params := make([]any, 0, 2)
+	_params := make([]any, 0, 3)
 
-	sb.WriteString("UPDATE ? SET last_used_at = NOW() WHERE id = ?")
-	_params = append(_params, clause.Table{Name: clause.CurrentTable}, id)
+	sb.WriteString("UPDATE ? AS k SET last_used_at = u.used_at")
+	_params = append(_params, clause.Table{Name: clause.CurrentTable})
+	sb.WriteString(" FROM (VALUES")
+	for i, usage := range usages {
+		if i > 0 {
+			sb.WriteString(" ,")
+		}
+		sb.WriteString(" (CAST(? AS uuid), CAST(? AS timestamptz))")
+		_params = append(_params, usage.ID, usage.UsedAt)
+	}
+	sb.WriteString(" ) AS u(id, used_at)")
+	sb.WriteString(" WHERE k.id = u.id AND (k.last_used_at IS NULL OR k.last_used_at < u.used_at)")
 
 	return e.Exec(ctx, sb.String(), _params...)
 }
@@ -26,8 +26,8 @@ type _AreaQueryInterface[T any] interface {
 	List(ctx context.Context, limit int, offset int, buildingID *uuid.UUID, search *string) ([]*model.Area, error)
 	CountAll(ctx context.Context, buildingID *uuid.UUID, search *string) (int64, error)
 	ListByBuilding(ctx context.Context, buildingID uuid.UUID) ([]*model.Area, error)
-	Insert(ctx context.Context, id uuid.UUID, buildingID uuid.UUID, name string, description *string, roomPlanImage *string) error
-	Save(ctx context.Context, id uuid.UUID, name *string, description *string, roomPlanImage *string) error
+	Insert(ctx context.Context, id uuid.UUID, buildingID uuid.UUID, name string, description *string, location *string, roomPlanURL *string) error
+	Save(ctx context.Context, id uuid.UUID, name *string, description *string, location *string, roomPlanURL *string) error
 	ClearRoomPlan(ctx context.Context, id uuid.UUID) error
 	Remove(ctx context.Context, id uuid.UUID) error
 }
@@ -123,21 +123,21 @@ func (e _AreaQueryImpl[T]) ListByBuilding(ctx context.Context, buildingID uuid.U
 	return result, err
 }
 
-func (e _AreaQueryImpl[T]) Insert(ctx context.Context, id uuid.UUID, buildingID uuid.UUID, name string, description *string, roomPlanImage *string) error {
+func (e _AreaQueryImpl[T]) Insert(ctx context.Context, id uuid.UUID, buildingID uuid.UUID, name string, description *string, location *string, roomPlanURL *string) error {
 	var sb strings.Builder
-	_params := make([]any, 0, 6)
+	_params := make([]any, 0, 7)
 
-	sb.WriteString("INSERT INTO ? (id, building_id, name, description, room_plan_image, created_at, updated_at)")
+	sb.WriteString("INSERT INTO ? (id, building_id, name, description, location, room_plan_url, created_at, updated_at)")
 	_params = append(_params, clause.Table{Name: clause.CurrentTable})
-	sb.WriteString(" VALUES (?, ?, ?, ?, ?, NOW(), NOW())")
-	_params = append(_params, id, buildingID, name, description, roomPlanImage)
+	sb.WriteString(" VALUES (?, ?, ?, ?, ?, ?, NOW(), NOW())")
+	_params = append(_params, id, buildingID, name, description, location, roomPlanURL)
 
 	return e.Exec(ctx, sb.String(), _params...)
 }
 
-func (e _AreaQueryImpl[T]) Save(ctx context.Context, id uuid.UUID, name *string, description *string, roomPlanImage *string) error {
+func (e _AreaQueryImpl[T]) Save(ctx context.Context, id uuid.UUID, name *string, description *string, location *string, roomPlanURL *string) error {
 	var sb strings.Builder
-	_params := make([]any, 0, 5)
+	_params := make([]any, 0, 6)
 
 	sb.WriteString("UPDATE ?")
 	_params = append(_params, clause.Table{Name: clause.CurrentTable})
@@ -151,9 +151,13 @@ func (e _AreaQueryImpl[T]) Save(ctx context.Context, id uuid.UUID, name *string,
 			tmp.WriteString(" description = ?,")
 			_params = append(_params, description)
 		}
-		if roomPlanImage != nil {
-			tmp.WriteString(" room_plan_image = ?,")
-			_params = append(_params, roomPlanImage)
+		if location != nil {
+			tmp.WriteString(" location = ?,")
+			_params = append(_params, location)
+		}
+		if roomPlanURL != nil {
+			tmp.WriteString(" room_plan_url = ?,")
+			_params = append(_params, roomPlanURL)
 		}
 		tmp.WriteString(" updated_at = NOW()")
 		c := strings.TrimSpace(tmp.String())
@@ -173,7 +177,7 @@ func (e _AreaQueryImpl[T]) ClearRoomPlan(ctx context.Context, id uuid.UUID) erro
 	var sb strings.Builder
 	_params := make([]any, 0, 2)
 
-	sb.WriteString("UPDATE ? SET room_plan_image = NULL, updated_at = NOW() WHERE id = ?")
+	sb.WriteString("UPDATE ? SET room_plan_url = NULL, updated_at = NOW() WHERE id = ?")
 	_params = append(_params, clause.Table{Name: clause.CurrentTable}, id)
 
 	return e.Exec(ctx, sb.String(), _params...)
@@ -25,7 +25,7 @@ type _AuditLogQueryInterface[T any] interface {
 	typed.Interface[T]
 	List(ctx context.Context, limit int, offset int, userID *uuid.UUID, action *string, entityType *string, entityID *uuid.UUID, startDate *time.Time, endDate *time.Time) ([]*model.AuditLogEntry, error)
 	CountAll(ctx context.Context, userID *uuid.UUID, action *string, entityType *string, entityID *uuid.UUID, startDate *time.Time, endDate *time.Time) (int64, error)
-	Insert(ctx context.Context, id uuid.UUID, userID *uuid.UUID, action string, entityType string, entityID uuid.UUID, oldValue *string, newValue *string, ipAddress *string, userAgent *string) error
+	Insert(ctx context.Context, id uuid.UUID, userID *uuid.UUID, action string, entityType string, entityID uuid.UUID, changes *string) error
 }
 
 type _AuditLogQueryImpl[T any] struct {
@@ -57,11 +57,11 @@ func (e _AuditLogQueryImpl[T]) List(ctx context.Context, limit int, offset int,
 			_params = append(_params, entityID)
 		}
 		if startDate != nil {
-			tmp.WriteString(" AND created_at >= ?")
+			tmp.WriteString(" AND \"timestamp\" >= ?")
 			_params = append(_params, startDate)
 		}
 		if endDate != nil {
-			tmp.WriteString(" AND created_at <= ?")
+			tmp.WriteString(" AND \"timestamp\" <= ?")
 			_params = append(_params, endDate)
 		}
 		c := strings.TrimSpace(tmp.String())
@@ -72,7 +72,7 @@ func (e _AuditLogQueryImpl[T]) List(ctx context.Context, limit int, offset int,
 			sb.WriteString(c)
 		}
 	}
-	sb.WriteString(" ORDER BY created_at DESC")
+	sb.WriteString(" ORDER BY \"timestamp\" DESC")
 	sb.WriteString(" LIMIT ? OFFSET ?")
 	_params = append(_params, limit, offset)
 
@@ -106,11 +106,11 @@ func (e _AuditLogQueryImpl[T]) CountAll(ctx context.Context, userID *uuid.UUID,
 			_params = append(_params, entityID)
 		}
 		if startDate != nil {
-			tmp.WriteString(" AND created_at >= ?")
+			tmp.WriteString(" AND \"timestamp\" >= ?")
 			_params = append(_params, startDate)
 		}
 		if endDate != nil {
-			tmp.WriteString(" AND created_at <= ?")
+			tmp.WriteString(" AND \"timestamp\" <= ?")
 			_params = append(_params, endDate)
 		}
 		c := strings.TrimSpace(tmp.String())
@@ -127,14 +127,14 @@ func (e _AuditLogQueryImpl[T]) CountAll(ctx context.Context, userID *uuid.UUID,
 	return result, err
 }
 
-func (e _AuditLogQueryImpl[T]) Insert(ctx context.Context, id uuid.UUID, userID *uuid.UUID, action string, entityType string, entityID uuid.UUID, oldValue *string, newValue *string, ipAddress *string, userAgent *string) error {
+func (e _AuditLogQueryImpl[T]) Insert(ctx context.Context, id uuid.UUID, userID *uuid.UUID, action string, entityType string, entityID uuid.UUID, changes *string) error {
 	var sb strings.Builder
-	_params := make([]any, 0, 10)
+	_params := make([]any, 0, 7)
 
-	sb.WriteString("INSERT INTO ? (id, user_id, action, entity_type, entity_id, old_value, new_value, ip_address, user_agent, created_at)")
+	sb.WriteString("INSERT INTO ? (id, user_id, action, entity_type, entity_id, changes, \"timestamp\")")
 	_params = append(_params, clause.Table{Name: clause.CurrentTable})
-	sb.WriteString(" VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, NOW())")
-	_params = append(_params, id, userID, action, entityType, entityID, oldValue, newValue, ipAddress, userAgent)
+	sb.WriteString(" VALUES (?, ?, ?, ?, ?, ?, NOW())")
+	_params = append(_params, id, userID, action, entityType, entityID, changes)
 
 	return e.Exec(ctx, sb.String(), _params...)
 }
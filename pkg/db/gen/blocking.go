@@ -27,7 +27,9 @@ type _BlockingQueryInterface[T any] interface {
 	ListByEntity(ctx context.Context, entityType string, entityID uuid.UUID) ([]*model.Blocking, error)
 	ListByEntityAndTimeRange(ctx context.Context, entityType string, entityID uuid.UUID, startAfter *time.Time, endBefore *time.Time) ([]*model.Blocking, error)
 	ListInheritedForPlace(ctx context.Context, placeID uuid.UUID, areaID uuid.UUID, buildingID uuid.UUID) ([]*model.Blocking, error)
-	Insert(ctx context.Context, id uuid.UUID, entityType string, entityID uuid.UUID, blockingType string, startTime time.Time, endTime time.Time, isRecurring bool, recurrencePattern *string, recurrenceEnd *time.Time, reason *string, createdBy *uuid.UUID) error
+	ListInheritedForArea(ctx context.Context, areaID uuid.UUID, buildingID uuid.UUID) ([]*model.Blocking, error)
+	ListWithinBuilding(ctx context.Context, buildingID uuid.UUID) ([]*model.Blocking, error)
+	Insert(ctx context.Context, id uuid.UUID, entityType string, entityID uuid.UUID, blockingType string, name *string, reason *string, startTime time.Time, endTime time.Time, isRecurring bool, recurrenceRule *string, recurrenceDuration *int64, recurrenceEnd *time.Time) error
 	Remove(ctx context.Context, id uuid.UUID) error
 	DeleteByIDs(ctx context.Context, entityType string, entityID uuid.UUID, ids []uuid.UUID) error
 	DeleteByEntity(ctx context.Context, entityType string, entityID uuid.UUID) error
@@ -114,19 +116,59 @@ func (e _BlockingQueryImpl[T]) ListInheritedForPlace(ctx context.Context, placeI
 	return result, err
 }
 
-func (e _BlockingQueryImpl[T]) Insert(ctx context.Context, id uuid.UUID, entityType string, entityID uuid.UUID, blockingType string, startTime time.Time, endTime time.Time, isRecurring bool, recurrencePattern *string, recurrenceEnd *time.Time, reason *string, createdBy *uuid.UUID) error {
+func (e _BlockingQueryImpl[T]) ListInheritedForArea(ctx context.Context, areaID uuid.UUID, buildingID uuid.UUID) ([]*model.Blocking, error) {
 	var sb strings.Builder
-	_params := make([]any, 0, 12)
+	_params := make([]any, 0, 4)
+
+	sb.WriteString("SELECT * FROM ? WHERE")
+	_params = append(_params, clause.Table{Name: clause.CurrentTable})
+	sb.WriteString(" (entity_type = 'place' AND entity_id IN (SELECT id FROM places WHERE area_id = ?))")
+	_params = append(_params, areaID)
+	sb.WriteString(" OR (entity_type = 'area' AND entity_id = ?)")
+	_params = append(_params, areaID)
+	sb.WriteString(" OR (entity_type = 'building' AND entity_id = ?)")
+	_params = append(_params, buildingID)
+	sb.WriteString(" ORDER BY start_time")
+
+	var result []*model.Blocking
+	err := e.Raw(sb.String(), _params...).Scan(ctx, &result)
+	return result, err
+}
+
+func (e _BlockingQueryImpl[T]) ListWithinBuilding(ctx context.Context, buildingID uuid.UUID) ([]*model.Blocking, error) {
+	var sb strings.Builder
+	_params := make([]any, 0, 4)
+
+	sb.WriteString("SELECT * FROM ? WHERE")
+	_params = append(_params, clause.Table{Name: clause.CurrentTable})
+	sb.WriteString(" (entity_type = 'building' AND entity_id = ?)")
+	_params = append(_params, buildingID)
+	sb.WriteString(" OR (entity_type = 'area' AND entity_id IN (SELECT id FROM areas WHERE building_id = ?))")
+	_params = append(_params, buildingID)
+	sb.WriteString(" OR (entity_type = 'place' AND entity_id IN (")
+	sb.WriteString(" SELECT p.id FROM places p JOIN areas a ON a.id = p.area_id WHERE a.building_id = ?")
+	_params = append(_params, buildingID)
+	sb.WriteString(" ))")
+	sb.WriteString(" ORDER BY start_time")
+
+	var result []*model.Blocking
+	err := e.Raw(sb.String(), _params...).Scan(ctx, &result)
+	return result, err
+}
+
+func (e _BlockingQueryImpl[T]) Insert(ctx context.Context, id uuid.UUID, entityType string, entityID uuid.UUID, blockingType string, name *string, reason *string, startTime time.Time, endTime time.Time, isRecurring bool, recurrenceRule *string, recurrenceDuration *int64, recurrenceEnd *time.Time) error {
+	var sb strings.Builder
+	_params := make([]any, 0, 13)
 
 	sb.WriteString("INSERT INTO ? (")
 	_params = append(_params, clause.Table{Name: clause.CurrentTable})
-	sb.WriteString(" id, entity_type, entity_id, blocking_type, start_time, end_time,")
-	sb.WriteString(" is_recurring, recurrence_pattern, recurrence_end, reason, created_by, created_at")
+	sb.WriteString(" id, entity_type, entity_id, blocking_type, name, reason, start_time, end_time,")
+	sb.WriteString(" is_recurring, recurrence_rule, recurrence_duration, recurrence_end, created_at, updated_at")
 	sb.WriteString(" ) VALUES (")
-	sb.WriteString(" ?, ?, ?, ?, ?, ?,")
-	_params = append(_params, id, entityType, entityID, blockingType, startTime, endTime)
-	sb.WriteString(" ?, ?, ?, ?, ?, NOW()")
-	_params = append(_params, isRecurring, recurrencePattern, recurrenceEnd, reason, createdBy)
+	sb.WriteString(" ?, ?, ?, ?, ?, ?, ?, ?,")
+	_params = append(_params, id, entityType, entityID, blockingType, name, reason, startTime, endTime)
+	sb.WriteString(" ?, ?, ?, ?, NOW(), NOW()")
+	_params = append(_params, isRecurring, recurrenceRule, recurrenceDuration, recurrenceEnd)
 	sb.WriteString(" )")
 
 	return e.Exec(ctx, sb.String(), _params...)
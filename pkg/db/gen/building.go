@@ -25,8 +25,8 @@ type _BuildingQueryInterface[T any] interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*model.Building, error)
 	List(ctx context.Context, limit int, offset int, search *string) ([]*model.Building, error)
 	CountAll(ctx context.Context, search *string) (int64, error)
-	Insert(ctx context.Context, id uuid.UUID, name string, description *string, location *string, openingHours *string) error
-	Save(ctx context.Context, id uuid.UUID, name *string, description *string, location *string, openingHours *string) error
+	Insert(ctx context.Context, id uuid.UUID, name string, description *string, location *string) error
+	Save(ctx context.Context, id uuid.UUID, name *string, description *string, location *string) error
 	Remove(ctx context.Context, id uuid.UUID) error
 }
 
@@ -101,21 +101,21 @@ func (e _BuildingQueryImpl[T]) CountAll(ctx context.Context, search *string) (in
 	return result, err
 }
 
-func (e _BuildingQueryImpl[T]) Insert(ctx context.Context, id uuid.UUID, name string, description *string, location *string, openingHours *string) error {
+func (e _BuildingQueryImpl[T]) Insert(ctx context.Context, id uuid.UUID, name string, description *string, location *string) error {
 	var sb strings.Builder
-	_params := make([]any, 0, 6)
+	_params := make([]any, 0, 5)
 
-	sb.WriteString("INSERT INTO ? (id, name, description, location, opening_hours, created_at, updated_at)")
+	sb.WriteString("INSERT INTO ? (id, name, description, location, created_at, updated_at)")
 	_params = append(_params, clause.Table{Name: clause.CurrentTable})
-	sb.WriteString(" VALUES (?, ?, ?, ?, ?, NOW(), NOW())")
-	_params = append(_params, id, name, description, location, openingHours)
+	sb.WriteString(" VALUES (?, ?, ?, ?, NOW(), NOW())")
+	_params = append(_params, id, name, description, location)
 
 	return e.Exec(ctx, sb.String(), _params...)
 }
 
-func (e _BuildingQueryImpl[T]) Save(ctx context.Context, id uuid.UUID, name *string, description *string, location *string, openingHours *string) error {
+func (e _BuildingQueryImpl[T]) Save(ctx context.Context, id uuid.UUID, name *string, description *string, location *string) error {
 	var sb strings.Builder
-	_params := make([]any, 0, 6)
+	_params := make([]any, 0, 5)
 
 	sb.WriteString("UPDATE ?")
 	_params = append(_params, clause.Table{Name: clause.CurrentTable})
@@ -133,10 +133,6 @@ func (e _BuildingQueryImpl[T]) Save(ctx context.Context, id uuid.UUID, name *str
 			tmp.WriteString(" location = ?,")
 			_params = append(_params, location)
 		}
-		if openingHours != nil {
-			tmp.WriteString(" opening_hours = ?,")
-			_params = append(_params, openingHours)
-		}
 		tmp.WriteString(" updated_at = NOW()")
 		c := strings.TrimSpace(tmp.String())
 		if c != "" {
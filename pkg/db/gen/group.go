@@ -24,10 +24,12 @@ type _GroupQueryInterface[T any] interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*model.Group, error)
 	List(ctx context.Context) ([]*model.Group, error)
 	ListDefaultGroups(ctx context.Context) ([]*model.Group, error)
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*model.Group, error)
 	GetSystemGroup(ctx context.Context) (*model.Group, error)
 	Insert(ctx context.Context, id uuid.UUID, name string, description *string, isDefault bool) error
 	Save(ctx context.Context, id uuid.UUID, name *string, description *string) error
 	SetDefault(ctx context.Context, id uuid.UUID, isDefault bool) error
+	AddUser(ctx context.Context, groupID uuid.UUID, userID uuid.UUID) error
 	Remove(ctx context.Context, id uuid.UUID) error
 }
 
@@ -71,6 +73,22 @@ func (e _GroupQueryImpl[T]) ListDefaultGroups(ctx context.Context) ([]*model.Gro
 	return result, err
 }
 
+func (e _GroupQueryImpl[T]) ListByUser(ctx context.Context, userID uuid.UUID) ([]*model.Group, error) {
+	var sb strings.Builder
+	_params := make([]any, 0, 2)
+
+	sb.WriteString("SELECT g.* FROM ? g")
+	_params = append(_params, clause.Table{Name: clause.CurrentTable})
+	sb.WriteString(" JOIN user_groups ug ON ug.group_id = g.id")
+	sb.WriteString(" WHERE ug.user_id = ?")
+	_params = append(_params, userID)
+	sb.WriteString(" ORDER BY g.name")
+
+	var result []*model.Group
+	err := e.Raw(sb.String(), _params...).Scan(ctx, &result)
+	return result, err
+}
+
 func (e _GroupQueryImpl[T]) GetSystemGroup(ctx context.Context) (*model.Group, error) {
 	var sb strings.Builder
 	_params := make([]any, 0, 1)
@@ -135,6 +153,17 @@ func (e _GroupQueryImpl[T]) SetDefault(ctx context.Context, id uuid.UUID, isDefa
 	return e.Exec(ctx, sb.String(), _params...)
 }
 
+func (e _GroupQueryImpl[T]) AddUser(ctx context.Context, groupID uuid.UUID, userID uuid.UUID) error {
+	var sb strings.Builder
+	_params := make([]any, 0, 2)
+
+	sb.WriteString("INSERT INTO user_groups (user_id, group_id) VALUES (?, ?)")
+	_params = append(_params, userID, groupID)
+	sb.WriteString(" ON CONFLICT DO NOTHING")
+
+	return e.Exec(ctx, sb.String(), _params...)
+}
+
 func (e _GroupQueryImpl[T]) Remove(ctx context.Context, id uuid.UUID) error {
 	var sb strings.Builder
 	_params := make([]any, 0, 2)
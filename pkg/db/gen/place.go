@@ -26,10 +26,11 @@ type _PlaceQueryInterface[T any] interface {
 	List(ctx context.Context, limit int, offset int, areaID *uuid.UUID, buildingID *uuid.UUID, search *string, minCapacity *int, isBookable *bool) ([]*model.Place, error)
 	CountAll(ctx context.Context, areaID *uuid.UUID, buildingID *uuid.UUID, search *string, minCapacity *int, isBookable *bool) (int64, error)
 	ListByArea(ctx context.Context, areaID uuid.UUID) ([]*model.Place, error)
-	Insert(ctx context.Context, id uuid.UUID, areaID uuid.UUID, name string, description *string, seatCapacity int, isBookable bool, isDisabled bool, maxReservationDuration *int, maxReservationsPerDay *int, maxReservationsPerWeek *int, maxReservationsPerMonth *int, maxReservationsPerYear *int, requiresCheckIn bool, checkInTimeoutMinutes *int, hasWhitelist bool, bookingWindowDays *int, minBookingDuration *int, timeSlotIntervalMinutes int, dayStartHour int, dayEndHour int) error
-	Save(ctx context.Context, id uuid.UUID, name *string, description *string, seatCapacity *int, isBookable *bool, isDisabled *bool) error
-	UpdateConstraints(ctx context.Context, id uuid.UUID, maxReservationDuration *int, maxReservationsPerDay *int, maxReservationsPerWeek *int, maxReservationsPerMonth *int, maxReservationsPerYear *int, requiresCheckIn *bool, checkInTimeoutMinutes *int, hasWhitelist *bool, bookingWindowDays *int, minBookingDuration *int) error
-	UpdateTimeSlots(ctx context.Context, id uuid.UUID, timeSlotIntervalMinutes *int, dayStartHour *int, dayEndHour *int) error
+	ListByBuilding(ctx context.Context, buildingID uuid.UUID) ([]*model.Place, error)
+	Insert(ctx context.Context, id uuid.UUID, areaID uuid.UUID, name string, description *string, location *string, capacity int, isBookable bool, bookingMethod string, isDisabled bool, requiresCheckIn bool) error
+	Save(ctx context.Context, id uuid.UUID, name *string, description *string, location *string, capacity *int, isBookable *bool, isDisabled *bool) error
+	CountWhitelist(ctx context.Context, placeID uuid.UUID, userID *uuid.UUID) (int64, error)
+	RotateQRKey(ctx context.Context, id uuid.UUID) error
 	Remove(ctx context.Context, id uuid.UUID) error
 }
 
@@ -71,7 +72,7 @@ func (e _PlaceQueryImpl[T]) List(ctx context.Context, limit int, offset int, are
 			_params = append(_params, search, search)
 		}
 		if minCapacity != nil {
-			tmp.WriteString(" AND p.seat_capacity >= ?")
+			tmp.WriteString(" AND p.capacity >= ?")
 			_params = append(_params, minCapacity)
 		}
 		if isBookable != nil {
@@ -117,7 +118,7 @@ func (e _PlaceQueryImpl[T]) CountAll(ctx context.Context, areaID *uuid.UUID, bui
 			_params = append(_params, search, search)
 		}
 		if minCapacity != nil {
-			tmp.WriteString(" AND p.seat_capacity >= ?")
+			tmp.WriteString(" AND p.capacity >= ?")
 			_params = append(_params, minCapacity)
 		}
 		if isBookable != nil {
@@ -150,36 +151,43 @@ func (e _PlaceQueryImpl[T]) ListByArea(ctx context.Context, areaID uuid.UUID) ([
 	return result, err
 }
 
-func (e _PlaceQueryImpl[T]) Insert(ctx context.Context, id uuid.UUID, areaID uuid.UUID, name string, description *string, seatCapacity int, isBookable bool, isDisabled bool, maxReservationDuration *int, maxReservationsPerDay *int, maxReservationsPerWeek *int, maxReservationsPerMonth *int, maxReservationsPerYear *int, requiresCheckIn bool, checkInTimeoutMinutes *int, hasWhitelist bool, bookingWindowDays *int, minBookingDuration *int, timeSlotIntervalMinutes int, dayStartHour int, dayEndHour int) error {
+func (e _PlaceQueryImpl[T]) ListByBuilding(ctx context.Context, buildingID uuid.UUID) ([]*model.Place, error) {
 	var sb strings.Builder
-	_params := make([]any, 0, 21)
+	_params := make([]any, 0, 2)
+
+	sb.WriteString("SELECT p.* FROM ? p")
+	_params = append(_params, clause.Table{Name: clause.CurrentTable})
+	sb.WriteString(" JOIN areas a ON a.id = p.area_id")
+	sb.WriteString(" WHERE a.building_id = ?")
+	_params = append(_params, buildingID)
+	sb.WriteString(" ORDER BY p.created_at DESC")
+
+	var result []*model.Place
+	err := e.Raw(sb.String(), _params...).Scan(ctx, &result)
+	return result, err
+}
+
+func (e _PlaceQueryImpl[T]) Insert(ctx context.Context, id uuid.UUID, areaID uuid.UUID, name string, description *string, location *string, capacity int, isBookable bool, bookingMethod string, isDisabled bool, requiresCheckIn bool) error {
+	var sb strings.Builder
+	_params := make([]any, 0, 11)
 
 	sb.WriteString("INSERT INTO ? (")
 	_params = append(_params, clause.Table{Name: clause.CurrentTable})
-	sb.WriteString(" id, area_id, name, description, seat_capacity, is_bookable, is_disabled,")
-	sb.WriteString(" max_reservation_duration, max_reservations_per_day, max_reservations_per_week,")
-	sb.WriteString(" max_reservations_per_month, max_reservations_per_year, requires_check_in,")
-	sb.WriteString(" check_in_timeout_minutes, has_whitelist, booking_window_days, min_booking_duration,")
-	sb.WriteString(" time_slot_interval_minutes, day_start_hour, day_end_hour, created_at, updated_at")
+	sb.WriteString(" id, area_id, name, description, location, capacity, is_bookable,")
+	sb.WriteString(" booking_method, is_disabled, requires_check_in, created_at, updated_at")
 	sb.WriteString(" ) VALUES (")
 	sb.WriteString(" ?, ?, ?, ?, ?, ?, ?,")
-	_params = append(_params, id, areaID, name, description, seatCapacity, isBookable, isDisabled)
-	sb.WriteString(" ?, ?, ?,")
-	_params = append(_params, maxReservationDuration, maxReservationsPerDay, maxReservationsPerWeek)
-	sb.WriteString(" ?, ?, ?,")
-	_params = append(_params, maxReservationsPerMonth, maxReservationsPerYear, requiresCheckIn)
-	sb.WriteString(" ?, ?, ?, ?,")
-	_params = append(_params, checkInTimeoutMinutes, hasWhitelist, bookingWindowDays, minBookingDuration)
+	_params = append(_params, id, areaID, name, description, location, capacity, isBookable)
 	sb.WriteString(" ?, ?, ?, NOW(), NOW()")
-	_params = append(_params, timeSlotIntervalMinutes, dayStartHour, dayEndHour)
+	_params = append(_params, bookingMethod, isDisabled, requiresCheckIn)
 	sb.WriteString(" )")
 
 	return e.Exec(ctx, sb.String(), _params...)
 }
 
-func (e _PlaceQueryImpl[T]) Save(ctx context.Context, id uuid.UUID, name *string, description *string, seatCapacity *int, isBookable *bool, isDisabled *bool) error {
+func (e _PlaceQueryImpl[T]) Save(ctx context.Context, id uuid.UUID, name *string, description *string, location *string, capacity *int, isBookable *bool, isDisabled *bool) error {
 	var sb strings.Builder
-	_params := make([]any, 0, 7)
+	_params := make([]any, 0, 8)
 
 	sb.WriteString("UPDATE ?")
 	_params = append(_params, clause.Table{Name: clause.CurrentTable})
@@ -193,9 +201,13 @@ func (e _PlaceQueryImpl[T]) Save(ctx context.Context, id uuid.UUID, name *string
 			tmp.WriteString(" description = ?,")
 			_params = append(_params, description)
 		}
-		if seatCapacity != nil {
-			tmp.WriteString(" seat_capacity = ?,")
-			_params = append(_params, seatCapacity)
+		if location != nil {
+			tmp.WriteString(" location = ?,")
+			_params = append(_params, location)
+		}
+		if capacity != nil {
+			tmp.WriteString(" capacity = ?,")
+			_params = append(_params, capacity)
 		}
 		if isBookable != nil {
 			tmp.WriteString(" is_bookable = ?,")
@@ -219,98 +231,29 @@ func (e _PlaceQueryImpl[T]) Save(ctx context.Context, id uuid.UUID, name *string
 	return e.Exec(ctx, sb.String(), _params...)
 }
 
-func (e _PlaceQueryImpl[T]) UpdateConstraints(ctx context.Context, id uuid.UUID, maxReservationDuration *int, maxReservationsPerDay *int, maxReservationsPerWeek *int, maxReservationsPerMonth *int, maxReservationsPerYear *int, requiresCheckIn *bool, checkInTimeoutMinutes *int, hasWhitelist *bool, bookingWindowDays *int, minBookingDuration *int) error {
+func (e _PlaceQueryImpl[T]) CountWhitelist(ctx context.Context, placeID uuid.UUID, userID *uuid.UUID) (int64, error) {
 	var sb strings.Builder
-	_params := make([]any, 0, 12)
+	_params := make([]any, 0, 2)
 
-	sb.WriteString("UPDATE ?")
-	_params = append(_params, clause.Table{Name: clause.CurrentTable})
-	{
-		var tmp strings.Builder
-		if maxReservationDuration != nil {
-			tmp.WriteString(" max_reservation_duration = ?,")
-			_params = append(_params, maxReservationDuration)
-		}
-		if maxReservationsPerDay != nil {
-			tmp.WriteString(" max_reservations_per_day = ?,")
-			_params = append(_params, maxReservationsPerDay)
-		}
-		if maxReservationsPerWeek != nil {
-			tmp.WriteString(" max_reservations_per_week = ?,")
-			_params = append(_params, maxReservationsPerWeek)
-		}
-		if maxReservationsPerMonth != nil {
-			tmp.WriteString(" max_reservations_per_month = ?,")
-			_params = append(_params, maxReservationsPerMonth)
-		}
-		if maxReservationsPerYear != nil {
-			tmp.WriteString(" max_reservations_per_year = ?,")
-			_params = append(_params, maxReservationsPerYear)
-		}
-		if requiresCheckIn != nil {
-			tmp.WriteString(" requires_check_in = ?,")
-			_params = append(_params, requiresCheckIn)
-		}
-		if checkInTimeoutMinutes != nil {
-			tmp.WriteString(" check_in_timeout_minutes = ?,")
-			_params = append(_params, checkInTimeoutMinutes)
-		}
-		if hasWhitelist != nil {
-			tmp.WriteString(" has_whitelist = ?,")
-			_params = append(_params, hasWhitelist)
-		}
-		if bookingWindowDays != nil {
-			tmp.WriteString(" booking_window_days = ?,")
-			_params = append(_params, bookingWindowDays)
-		}
-		if minBookingDuration != nil {
-			tmp.WriteString(" min_booking_duration = ?,")
-			_params = append(_params, minBookingDuration)
-		}
-		tmp.WriteString(" updated_at = NOW()")
-		c := strings.TrimSpace(tmp.String())
-		if c != "" {
-			c = strings.Trim(c, ", ")
-			sb.WriteString(" SET ")
-			sb.WriteString(c)
-		}
+	sb.WriteString("SELECT COUNT(*) FROM place_whitelist")
+	sb.WriteString(" WHERE place_id = ?")
+	_params = append(_params, placeID)
+	if userID != nil {
+		sb.WriteString(" AND user_id = ?")
+		_params = append(_params, userID)
 	}
-	sb.WriteString(" WHERE id = ?")
-	_params = append(_params, id)
 
-	return e.Exec(ctx, sb.String(), _params...)
+	var result int64
+	err := e.Raw(sb.String(), _params...).Scan(ctx, &result)
+	return result, err
 }
 
-func (e _PlaceQueryImpl[T]) UpdateTimeSlots(ctx context.Context, id uuid.UUID, timeSlotIntervalMinutes *int, dayStartHour *int, dayEndHour *int) error {
+func (e _PlaceQueryImpl[T]) RotateQRKey(ctx context.Context, id uuid.UUID) error {
 	var sb strings.Builder
-	_params := make([]any, 0, 5)
+	_params := make([]any, 0, 2)
 
-	sb.WriteString("UPDATE ?")
-	_params = append(_params, clause.Table{Name: clause.CurrentTable})
-	{
-		var tmp strings.Builder
-		if timeSlotIntervalMinutes != nil {
-			tmp.WriteString(" time_slot_interval_minutes = ?,")
-			_params = append(_params, timeSlotIntervalMinutes)
-		}
-		if dayStartHour != nil {
-			tmp.WriteString(" day_start_hour = ?,")
-			_params = append(_params, dayStartHour)
-		}
-		if dayEndHour != nil {
-			tmp.WriteString(" day_end_hour = ?,")
-			_params = append(_params, dayEndHour)
-		}
-		tmp.WriteString(" updated_at = NOW()")
-		c := strings.TrimSpace(tmp.String())
-		if c != "" {
-			c = strings.Trim(c, ", ")
-			sb.WriteString(" SET ")
-			sb.WriteString(c)
-		}
-	}
-	sb.WriteString(" WHERE id = ?")
-	_params = append(_params, id)
+	sb.WriteString("UPDATE ? SET qr_key_version = qr_key_version + 1, updated_at = NOW() WHERE id = ?")
+	_params = append(_params, clause.Table{Name: clause.CurrentTable}, id)
 
 	return e.Exec(ctx, sb.String(), _params...)
 }
@@ -23,7 +23,7 @@ type _PlaceMarkerQueryInterface[T any] interface {
 	typed.Interface[T]
 	GetByID(ctx context.Context, id uuid.UUID) (*model.PlaceMarker, error)
 	ListByArea(ctx context.Context, areaID uuid.UUID) ([]*model.PlaceMarker, error)
-	Insert(ctx context.Context, id uuid.UUID, areaID uuid.UUID, placeID uuid.UUID, x float64, y float64, width float64, height float64) error
+	Insert(ctx context.Context, id uuid.UUID, areaID uuid.UUID, placeID uuid.UUID, x float64, y float64, width float64, height float64, shape string) error
 	Save(ctx context.Context, id uuid.UUID, x float64, y float64, width float64, height float64) error
 	Remove(ctx context.Context, id uuid.UUID) error
 	DeleteByIDs(ctx context.Context, areaID uuid.UUID, ids []uuid.UUID) error
@@ -58,14 +58,14 @@ func (e _PlaceMarkerQueryImpl[T]) ListByArea(ctx context.Context, areaID uuid.UU
 	return result, err
 }
 
-func (e _PlaceMarkerQueryImpl[T]) Insert(ctx context.Context, id uuid.UUID, areaID uuid.UUID, placeID uuid.UUID, x float64, y float64, width float64, height float64) error {
+func (e _PlaceMarkerQueryImpl[T]) Insert(ctx context.Context, id uuid.UUID, areaID uuid.UUID, placeID uuid.UUID, x float64, y float64, width float64, height float64, shape string) error {
 	var sb strings.Builder
-	_params := make([]any, 0, 8)
+	_params := make([]any, 0, 9)
 
-	sb.WriteString("INSERT INTO ? (id, area_id, place_id, x, y, width, height, created_at)")
+	sb.WriteString("INSERT INTO ? (id, area_id, place_id, x, y, width, height, shape, created_at, updated_at)")
 	_params = append(_params, clause.Table{Name: clause.CurrentTable})
-	sb.WriteString(" VALUES (?, ?, ?, ?, ?, ?, ?, NOW())")
-	_params = append(_params, id, areaID, placeID, x, y, width, height)
+	sb.WriteString(" VALUES (?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW())")
+	_params = append(_params, id, areaID, placeID, x, y, width, height, shape)
 
 	return e.Exec(ctx, sb.String(), _params...)
 }
@@ -74,7 +74,7 @@ func (e _PlaceMarkerQueryImpl[T]) Save(ctx context.Context, id uuid.UUID, x floa
 	var sb strings.Builder
 	_params := make([]any, 0, 6)
 
-	sb.WriteString("UPDATE ? SET x = ?, y = ?, width = ?, height = ? WHERE id = ?")
+	sb.WriteString("UPDATE ? SET x = ?, y = ?, width = ?, height = ?, updated_at = NOW() WHERE id = ?")
 	_params = append(_params, clause.Table{Name: clause.CurrentTable}, x, y, width, height, id)
 
 	return e.Exec(ctx, sb.String(), _params...)
@@ -26,12 +26,27 @@ type _ReservationQueryInterface[T any] interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*model.Reservation, error)
 	List(ctx context.Context, limit int, offset int, placeID *uuid.UUID, userID *uuid.UUID, status *string, startAfter *time.Time, endBefore *time.Time) ([]*model.Reservation, error)
 	CountAll(ctx context.Context, placeID *uuid.UUID, userID *uuid.UUID, status *string, startAfter *time.Time, endBefore *time.Time) (int64, error)
+	ListByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.Reservation, error)
+	GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*model.Reservation, error)
+	ListSeriesForUpdate(ctx context.Context, groupID uuid.UUID, startAfter time.Time) ([]*model.Reservation, error)
 	ListByUser(ctx context.Context, userID uuid.UUID) ([]*model.Reservation, error)
 	ListByPlaceAndTimeRange(ctx context.Context, placeID uuid.UUID, startTime time.Time, endTime time.Time) ([]*model.Reservation, error)
+	ListOverlapping(ctx context.Context, placeID uuid.UUID, startTime time.Time, endTime time.Time, excludeIDs []uuid.UUID) ([]*model.Reservation, error)
+	Usage(ctx context.Context, scopeType string, scopeID uuid.UUID, userID uuid.UUID, startTime time.Time, timezone string, excludeID *uuid.UUID) (*model.ReservationUsage, error)
+	RollingUsage(ctx context.Context, scopeType string, scopeID uuid.UUID, userID uuid.UUID, startTime time.Time, excludeID *uuid.UUID) (*model.ReservationUsage, error)
+	CountOverlappingByUser(ctx context.Context, userID uuid.UUID, startTime time.Time, endTime time.Time, excludeID *uuid.UUID) (int64, error)
+	LockUser(ctx context.Context, userID uuid.UUID) error
+	ListOverlappingInArea(ctx context.Context, areaID uuid.UUID, startTime time.Time, endTime time.Time) ([]*model.Reservation, error)
+	ListOverlappingInBuilding(ctx context.Context, buildingID uuid.UUID, startTime time.Time, endTime time.Time) ([]*model.Reservation, error)
+	ListDueReminders(ctx context.Context, limit int) ([]*model.Reservation, error)
+	MarkReminded(ctx context.Context, ids []uuid.UUID) error
+	ListNotCheckedIn(ctx context.Context, released bool, limit int) ([]*model.Reservation, error)
+	MarkCheckInWarned(ctx context.Context, ids []uuid.UUID) error
 	Insert(ctx context.Context, id uuid.UUID, placeID uuid.UUID, userID uuid.UUID, startTime time.Time, endTime time.Time, status string, isRecurring bool, recurringGroupID *uuid.UUID) error
 	Save(ctx context.Context, id uuid.UUID, startTime *time.Time, endTime *time.Time) error
 	UpdateStatus(ctx context.Context, id uuid.UUID, status string) error
 	Cancel(ctx context.Context, id uuid.UUID, reason *string) error
+	CancelMany(ctx context.Context, ids []uuid.UUID, reason *string) error
 	CheckIn(ctx context.Context, id uuid.UUID) error
 	Remove(ctx context.Context, id uuid.UUID) error
 }
@@ -139,6 +154,48 @@ func (e _ReservationQueryImpl[T]) CountAll(ctx context.Context, placeID *uuid.UU
 	return result, err
 }
 
+func (e _ReservationQueryImpl[T]) ListByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.Reservation, error) {
+	var sb strings.Builder
+	_params := make([]any, 0, 2)
+
+	sb.WriteString("SELECT * FROM ? WHERE id IN ? ORDER BY start_time")
+	_params = append(_params, clause.Table{Name: clause.CurrentTable}, ids)
+
+	var result []*model.Reservation
+	err := e.Raw(sb.String(), _params...).Scan(ctx, &result)
+	return result, err
+}
+
+func (e _ReservationQueryImpl[T]) GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*model.Reservation, error) {
+	var sb strings.Builder
+	_params := make([]any, 0, 2)
+
+	sb.WriteString("SELECT * FROM ? WHERE id = ? FOR UPDATE")
+	_params = append(_params, clause.Table{Name: clause.CurrentTable}, id)
+
+	var result *model.Reservation
+	err := e.Raw(sb.String(), _params...).Scan(ctx, &result)
+	return result, err
+}
+
+func (e _ReservationQueryImpl[T]) ListSeriesForUpdate(ctx context.Context, groupID uuid.UUID, startAfter time.Time) ([]*model.Reservation, error) {
+	var sb strings.Builder
+	_params := make([]any, 0, 3)
+
+	sb.WriteString("SELECT * FROM ?")
+	_params = append(_params, clause.Table{Name: clause.CurrentTable})
+	sb.WriteString(" WHERE recurring_group_id = ? AND status IN ('pending', 'confirmed')")
+	_params = append(_params, groupID)
+	sb.WriteString(" AND start_time >= ?")
+	_params = append(_params, startAfter)
+	sb.WriteString(" ORDER BY start_time")
+	sb.WriteString(" FOR UPDATE")
+
+	var result []*model.Reservation
+	err := e.Raw(sb.String(), _params...).Scan(ctx, &result)
+	return result, err
+}
+
 func (e _ReservationQueryImpl[T]) ListByUser(ctx context.Context, userID uuid.UUID) ([]*model.Reservation, error) {
 	var sb strings.Builder
 	_params := make([]any, 0, 2)
@@ -163,6 +220,284 @@ func (e _ReservationQueryImpl[T]) ListByPlaceAndTimeRange(ctx context.Context, p
 	return result, err
 }
 
+func (e _ReservationQueryImpl[T]) ListOverlapping(ctx context.Context, placeID uuid.UUID, startTime time.Time, endTime time.Time, excludeIDs []uuid.UUID) ([]*model.Reservation, error) {
+	var sb strings.Builder
+	_params := make([]any, 0, 5)
+
+	sb.WriteString("SELECT * FROM ?")
+	_params = append(_params, clause.Table{Name: clause.CurrentTable})
+	sb.WriteString(" WHERE place_id = ? AND status <> 'cancelled'")
+	_params = append(_params, placeID)
+	sb.WriteString(" AND start_time < ? AND end_time > ?")
+	_params = append(_params, endTime, startTime)
+	if len(excludeIDs) > 0 {
+		sb.WriteString(" AND id NOT IN ?")
+		_params = append(_params, excludeIDs)
+	}
+	sb.WriteString(" ORDER BY start_time")
+
+	var result []*model.Reservation
+	err := e.Raw(sb.String(), _params...).Scan(ctx, &result)
+	return result, err
+}
+
+func (e _ReservationQueryImpl[T]) Usage(ctx context.Context, scopeType string, scopeID uuid.UUID, userID uuid.UUID, startTime time.Time, timezone string, excludeID *uuid.UUID) (*model.ReservationUsage, error) {
+	var sb strings.Builder
+	_params := make([]any, 0, 18)
+
+	sb.WriteString("SELECT")
+	sb.WriteString(" COUNT(*) FILTER (WHERE u.same_day) AS day_count,")
+	sb.WriteString(" CAST(COALESCE(SUM(u.minutes) FILTER (WHERE u.same_day), 0) AS bigint) AS day_minutes,")
+	sb.WriteString(" COUNT(*) FILTER (WHERE u.same_week) AS week_count,")
+	sb.WriteString(" CAST(COALESCE(SUM(u.minutes) FILTER (WHERE u.same_week), 0) AS bigint) AS week_minutes,")
+	sb.WriteString(" COUNT(*) FILTER (WHERE u.same_month) AS month_count,")
+	sb.WriteString(" CAST(COALESCE(SUM(u.minutes) FILTER (WHERE u.same_month), 0) AS bigint) AS month_minutes,")
+	sb.WriteString(" COUNT(*) FILTER (WHERE u.same_year) AS year_count,")
+	sb.WriteString(" CAST(COALESCE(SUM(u.minutes) FILTER (WHERE u.same_year), 0) AS bigint) AS year_minutes")
+	sb.WriteString(" FROM (")
+	sb.WriteString(" SELECT")
+	sb.WriteString(" EXTRACT(EPOCH FROM r.end_time - r.start_time) / 60 AS minutes,")
+	sb.WriteString(" date_trunc('day', r.start_time AT TIME ZONE ?) = date_trunc('day', CAST(? AS timestamptz) AT TIME ZONE ?) AS same_day,")
+	_params = append(_params, timezone, startTime, timezone)
+	sb.WriteString(" date_trunc('week', r.start_time AT TIME ZONE ?) = date_trunc('week', CAST(? AS timestamptz) AT TIME ZONE ?) AS same_week,")
+	_params = append(_params, timezone, startTime, timezone)
+	sb.WriteString(" date_trunc('month', r.start_time AT TIME ZONE ?) = date_trunc('month', CAST(? AS timestamptz) AT TIME ZONE ?) AS same_month,")
+	_params = append(_params, timezone, startTime, timezone)
+	sb.WriteString(" date_trunc('year', r.start_time AT TIME ZONE ?) = date_trunc('year', CAST(? AS timestamptz) AT TIME ZONE ?) AS same_year")
+	_params = append(_params, timezone, startTime, timezone)
+	sb.WriteString(" FROM ? r")
+	_params = append(_params, clause.Table{Name: clause.CurrentTable})
+	sb.WriteString(" JOIN places p ON p.id = r.place_id")
+	sb.WriteString(" JOIN areas a ON a.id = p.area_id")
+	sb.WriteString(" WHERE r.user_id = ? AND r.status <> 'cancelled'")
+	_params = append(_params, userID)
+	if scopeType == "place" {
+		sb.WriteString(" AND r.place_id = ?")
+		_params = append(_params, scopeID)
+	} else if scopeType == "area" {
+		sb.WriteString(" AND p.area_id = ?")
+		_params = append(_params, scopeID)
+	} else if scopeType == "building" {
+		sb.WriteString(" AND a.building_id = ?")
+		_params = append(_params, scopeID)
+	}
+	if excludeID != nil {
+		sb.WriteString(" AND r.id <> ?")
+		_params = append(_params, excludeID)
+	}
+	sb.WriteString(" ) u")
+
+	var result *model.ReservationUsage
+	err := e.Raw(sb.String(), _params...).Scan(ctx, &result)
+	return result, err
+}
+
+func (e _ReservationQueryImpl[T]) RollingUsage(ctx context.Context, scopeType string, scopeID uuid.UUID, userID uuid.UUID, startTime time.Time, excludeID *uuid.UUID) (*model.ReservationUsage, error) {
+	var sb strings.Builder
+	_params := make([]any, 0, 11)
+
+	sb.WriteString("WITH r AS (")
+	sb.WriteString(" SELECT r.start_time, EXTRACT(EPOCH FROM r.end_time - r.start_time) / 60 AS minutes")
+	sb.WriteString(" FROM ? r")
+	_params = append(_params, clause.Table{Name: clause.CurrentTable})
+	sb.WriteString(" JOIN places p ON p.id = r.place_id")
+	sb.WriteString(" JOIN areas a ON a.id = p.area_id")
+	sb.WriteString(" WHERE r.user_id = ? AND r.status <> 'cancelled'")
+	_params = append(_params, userID)
+	if scopeType == "place" {
+		sb.WriteString(" AND r.place_id = ?")
+		_params = append(_params, scopeID)
+	} else if scopeType == "area" {
+		sb.WriteString(" AND p.area_id = ?")
+		_params = append(_params, scopeID)
+	} else if scopeType == "building" {
+		sb.WriteString(" AND a.building_id = ?")
+		_params = append(_params, scopeID)
+	}
+	sb.WriteString(" AND r.start_time > CAST(? AS timestamptz) - interval '1 year'")
+	_params = append(_params, startTime)
+	sb.WriteString(" AND r.start_time < CAST(? AS timestamptz) + interval '1 year'")
+	_params = append(_params, startTime)
+	if excludeID != nil {
+		sb.WriteString(" AND r.id <> ?")
+		_params = append(_params, excludeID)
+	}
+	sb.WriteString(" ),")
+	sb.WriteString(" w AS (")
+	sb.WriteString(" SELECT p.period, COUNT(r.start_time) AS n, COALESCE(SUM(r.minutes), 0) AS minutes")
+	sb.WriteString(" FROM (VALUES ('day', interval '1 day'), ('week', interval '7 days'), ('month', interval '1 month'), ('year', interval '1 year')) AS p(period, length)")
+	sb.WriteString(" CROSS JOIN LATERAL (")
+	sb.WriteString(" SELECT CAST(? AS timestamptz) AS start_time")
+	_params = append(_params, startTime)
+	sb.WriteString(" UNION")
+	sb.WriteString(" SELECT c.start_time FROM r c")
+	sb.WriteString(" WHERE c.start_time > CAST(? AS timestamptz) - p.length AND c.start_time <= ?")
+	_params = append(_params, startTime, startTime)
+	sb.WriteString(" ) s")
+	sb.WriteString(" LEFT JOIN r ON r.start_time >= s.start_time AND r.start_time < s.start_time + p.length")
+	sb.WriteString(" GROUP BY p.period, s.start_time")
+	sb.WriteString(" )")
+	sb.WriteString(" SELECT")
+	sb.WriteString(" COALESCE(MAX(w.n) FILTER (WHERE w.period = 'day'), 0) AS day_count,")
+	sb.WriteString(" CAST(COALESCE(MAX(w.minutes) FILTER (WHERE w.period = 'day'), 0) AS bigint) AS day_minutes,")
+	sb.WriteString(" COALESCE(MAX(w.n) FILTER (WHERE w.period = 'week'), 0) AS week_count,")
+	sb.WriteString(" CAST(COALESCE(MAX(w.minutes) FILTER (WHERE w.period = 'week'), 0) AS bigint) AS week_minutes,")
+	sb.WriteString(" COALESCE(MAX(w.n) FILTER (WHERE w.period = 'month'), 0) AS month_count,")
+	sb.WriteString(" CAST(COALESCE(MAX(w.minutes) FILTER (WHERE w.period = 'month'), 0) AS bigint) AS month_minutes,")
+	sb.WriteString(" COALESCE(MAX(w.n) FILTER (WHERE w.period = 'year'), 0) AS year_count,")
+	sb.WriteString(" CAST(COALESCE(MAX(w.minutes) FILTER (WHERE w.period = 'year'), 0) AS bigint) AS year_minutes")
+	sb.WriteString(" FROM w")
+
+	var result *model.ReservationUsage
+	err := e.Raw(sb.String(), _params...).Scan(ctx, &result)
+	return result, err
+}
+
+func (e _ReservationQueryImpl[T]) CountOverlappingByUser(ctx context.Context, userID uuid.UUID, startTime time.Time, endTime time.Time, excludeID *uuid.UUID) (int64, error) {
+	var sb strings.Builder
+	_params := make([]any, 0, 5)
+
+	sb.WriteString("SELECT COUNT(*) FROM ?")
+	_params = append(_params, clause.Table{Name: clause.CurrentTable})
+	sb.WriteString(" WHERE user_id = ? AND status <> 'cancelled'")
+	_params = append(_params, userID)
+	sb.WriteString(" AND start_time < ? AND end_time > ?")
+	_params = append(_params, endTime, startTime)
+	if excludeID != nil {
+		sb.WriteString(" AND id <> ?")
+		_params = append(_params, excludeID)
+	}
+
+	var result int64
+	err := e.Raw(sb.String(), _params...).Scan(ctx, &result)
+	return result, err
+}
+
+func (e _ReservationQueryImpl[T]) LockUser(ctx context.Context, userID uuid.UUID) error {
+	var sb strings.Builder
+	_params := make([]any, 0, 1)
+
+	sb.WriteString("SELECT pg_advisory_xact_lock(hashtextextended('reservations:' || CAST(? AS text), 0))")
+	_params = append(_params, userID)
+
+	return e.Exec(ctx, sb.String(), _params...)
+}
+
+func (e _ReservationQueryImpl[T]) ListOverlappingInArea(ctx context.Context, areaID uuid.UUID, startTime time.Time, endTime time.Time) ([]*model.Reservation, error) {
+	var sb strings.Builder
+	_params := make([]any, 0, 4)
+
+	sb.WriteString("SELECT r.* FROM ? r")
+	_params = append(_params, clause.Table{Name: clause.CurrentTable})
+	sb.WriteString(" JOIN places p ON p.id = r.place_id")
+	sb.WriteString(" WHERE p.area_id = ? AND r.status <> 'cancelled'")
+	_params = append(_params, areaID)
+	sb.WriteString(" AND r.start_time < ? AND r.end_time > ?")
+	_params = append(_params, endTime, startTime)
+	sb.WriteString(" ORDER BY r.start_time")
+
+	var result []*model.Reservation
+	err := e.Raw(sb.String(), _params...).Scan(ctx, &result)
+	return result, err
+}
+
+func (e _ReservationQueryImpl[T]) ListOverlappingInBuilding(ctx context.Context, buildingID uuid.UUID, startTime time.Time, endTime time.Time) ([]*model.Reservation, error) {
+	var sb strings.Builder
+	_params := make([]any, 0, 4)
+
+	sb.WriteString("SELECT r.* FROM ? r")
+	_params = append(_params, clause.Table{Name: clause.CurrentTable})
+	sb.WriteString(" JOIN places p ON p.id = r.place_id")
+	sb.WriteString(" JOIN areas a ON a.id = p.area_id")
+	sb.WriteString(" WHERE a.building_id = ? AND r.status <> 'cancelled'")
+	_params = append(_params, buildingID)
+	sb.WriteString(" AND r.start_time < ? AND r.end_time > ?")
+	_params = append(_params, endTime, startTime)
+	sb.WriteString(" ORDER BY r.start_time")
+
+	var result []*model.Reservation
+	err := e.Raw(sb.String(), _params...).Scan(ctx, &result)
+	return result, err
+}
+
+func (e _ReservationQueryImpl[T]) ListDueReminders(ctx context.Context, limit int) ([]*model.Reservation, error) {
+	var sb strings.Builder
+	_params := make([]any, 0, 2)
+
+	sb.WriteString("SELECT r.* FROM ? r")
+	_params = append(_params, clause.Table{Name: clause.CurrentTable})
+	sb.WriteString(" JOIN users u ON u.id = r.user_id")
+	sb.WriteString(" WHERE r.status IN ('pending', 'confirmed') AND r.reminded_at IS NULL")
+	sb.WriteString(" AND u.is_active AND u.notify_reservation_reminder")
+	sb.WriteString(" AND r.start_time > NOW()")
+	sb.WriteString(" AND r.start_time <= NOW() + make_interval(mins => u.reminder_minutes_before)")
+	sb.WriteString(" AND r.created_at <= r.start_time - make_interval(mins => u.reminder_minutes_before)")
+	sb.WriteString(" ORDER BY r.start_time")
+	sb.WriteString(" LIMIT ?")
+	_params = append(_params, limit)
+	sb.WriteString(" FOR UPDATE OF r SKIP LOCKED")
+
+	var result []*model.Reservation
+	err := e.Raw(sb.String(), _params...).Scan(ctx, &result)
+	return result, err
+}
+
+func (e _ReservationQueryImpl[T]) MarkReminded(ctx context.Context, ids []uuid.UUID) error {
+	var sb strings.Builder
+	_params := make([]any, 0, 2)
+
+	sb.WriteString("UPDATE ? SET reminded_at = NOW() WHERE id IN ?")
+	_params = append(_params, clause.Table{Name: clause.CurrentTable}, ids)
+
+	return e.Exec(ctx, sb.String(), _params...)
+}
+
+func (e _ReservationQueryImpl[T]) ListNotCheckedIn(ctx context.Context, released bool, limit int) ([]*model.Reservation, error) {
+	var sb strings.Builder
+	_params := make([]any, 0, 2)
+
+	sb.WriteString("SELECT r.* FROM ? r")
+	_params = append(_params, clause.Table{Name: clause.CurrentTable})
+	sb.WriteString(" JOIN places p ON p.id = r.place_id")
+	sb.WriteString(" JOIN areas a ON a.id = p.area_id")
+	sb.WriteString(" LEFT JOIN booking_constraints pc ON pc.entity_type = 'place' AND pc.entity_id = p.id")
+	sb.WriteString(" LEFT JOIN booking_constraints ac ON ac.entity_type = 'area' AND ac.entity_id = a.id")
+	sb.WriteString(" LEFT JOIN booking_constraints bc ON bc.entity_type = 'building' AND bc.entity_id = a.building_id")
+	sb.WriteString(" WHERE r.status IN ('pending', 'confirmed')")
+	sb.WriteString(" AND r.start_time <= NOW() AND r.end_time > NOW()")
+	sb.WriteString(" AND r.start_time + make_interval(mins => LEAST(")
+	sb.WriteString(" CASE WHEN p.requires_check_in THEN COALESCE(pc.check_in_timeout_minutes, ac.check_in_timeout_minutes, bc.check_in_timeout_minutes) END,")
+	sb.WriteString(" COALESCE(pc.decay_timeout_minutes, ac.decay_timeout_minutes, bc.decay_timeout_minutes)")
+	sb.WriteString(" ))")
+	if released {
+		sb.WriteString(" <=")
+	} else {
+		sb.WriteString(" >")
+	}
+	sb.WriteString(" NOW()")
+	if !released {
+		sb.WriteString(" AND r.check_in_warned_at IS NULL")
+	}
+	sb.WriteString(" ORDER BY r.start_time")
+	sb.WriteString(" LIMIT ?")
+	_params = append(_params, limit)
+	sb.WriteString(" FOR UPDATE OF r SKIP LOCKED")
+
+	var result []*model.Reservation
+	err := e.Raw(sb.String(), _params...).Scan(ctx, &result)
+	return result, err
+}
+
+func (e _ReservationQueryImpl[T]) MarkCheckInWarned(ctx context.Context, ids []uuid.UUID) error {
+	var sb strings.Builder
+	_params := make([]any, 0, 2)
+
+	sb.WriteString("UPDATE ? SET check_in_warned_at = NOW() WHERE id IN ?")
+	_params = append(_params, clause.Table{Name: clause.CurrentTable}, ids)
+
+	return e.Exec(ctx, sb.String(), _params...)
+}
+
 func (e _ReservationQueryImpl[T]) Insert(ctx context.Context, id uuid.UUID, placeID uuid.UUID, userID uuid.UUID, startTime time.Time, endTime time.Time, status string, isRecurring bool, recurringGroupID *uuid.UUID) error {
 	var sb strings.Builder
 	_params := make([]any, 0, 9)
@@ -183,7 +518,7 @@ func (e _ReservationQueryImpl[T]) Insert(ctx context.Context, id uuid.UUID, plac
 
 func (e _ReservationQueryImpl[T]) Save(ctx context.Context, id uuid.UUID, startTime *time.Time, endTime *time.Time) error {
 	var sb strings.Builder
-	_params := make([]any, 0, 4)
+	_params := make([]any, 0, 6)
 
 	sb.WriteString("UPDATE ?")
 	_params = append(_params, clause.Table{Name: clause.CurrentTable})
@@ -192,6 +527,10 @@ func (e _ReservationQueryImpl[T]) Save(ctx context.Context, id uuid.UUID, startT
 		if startTime != nil {
 			tmp.WriteString(" start_time = ?,")
 			_params = append(_params, startTime)
+			tmp.WriteString(" reminded_at = CASE WHEN start_time = ? THEN reminded_at END,")
+			_params = append(_params, startTime)
+			tmp.WriteString(" check_in_warned_at = CASE WHEN start_time = ? THEN check_in_warned_at END,")
+			_params = append(_params, startTime)
 		}
 		if endTime != nil {
 			tmp.WriteString(" end_time = ?,")
@@ -225,12 +564,22 @@ func (e _ReservationQueryImpl[T]) Cancel(ctx context.Context, id uuid.UUID, reas
 	var sb strings.Builder
 	_params := make([]any, 0, 3)
 
-	sb.WriteString("UPDATE ? SET status = 'cancelled', cancel_reason = ?, updated_at = NOW() WHERE id = ?")
+	sb.WriteString("UPDATE ? SET status = 'cancelled', cancel_reason = ?, cancel_time = NOW(), updated_at = NOW() WHERE id = ?")
 	_params = append(_params, clause.Table{Name: clause.CurrentTable}, reason, id)
 
 	return e.Exec(ctx, sb.String(), _params...)
 }
 
+func (e _ReservationQueryImpl[T]) CancelMany(ctx context.Context, ids []uuid.UUID, reason *string) error {
+	var sb strings.Builder
+	_params := make([]any, 0, 3)
+
+	sb.WriteString("UPDATE ? SET status = 'cancelled', cancel_reason = ?, cancel_time = NOW(), updated_at = NOW() WHERE id IN ?")
+	_params = append(_params, clause.Table{Name: clause.CurrentTable}, reason, ids)
+
+	return e.Exec(ctx, sb.String(), _params...)
+}
+
 func (e _ReservationQueryImpl[T]) CheckIn(ctx context.Context, id uuid.UUID) error {
 	var sb strings.Builder
 	_params := make([]any, 0, 2)
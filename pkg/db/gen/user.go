@@ -55,7 +55,7 @@ func (e _UserQueryImpl[T]) GetByEmail(ctx context.Context, email string) (*model
 	var sb strings.Builder
 	_params := make([]any, 0, 2)
 
-	sb.WriteString("SELECT * FROM ? WHERE email = ?")
+	sb.WriteString("SELECT * FROM ? WHERE email = ? AND email <> ''")
 	_params = append(_params, clause.Table{Name: clause.CurrentTable}, email)
 
 	var result *model.User
@@ -100,8 +100,8 @@ func Force(db *sql.DB, version int) error {
 
 // newMigrator creates a new migrate instance using the embedded PostgreSQL migrations.
 func newMigrator(db *sql.DB) (*migrate.Migrate, error) {
-	// Create a sub-filesystem for the postgresql directory
-	subFS, err := fs.Sub(postgresFS, "postgresql")
+	// Create a sub-filesystem for the postgres directory
+	subFS, err := fs.Sub(postgresFS, "postgres")
 	if err != nil {
 		return nil, fmt.Errorf("failed to create sub filesystem: %w", err)
 	}
@@ -0,0 +1,146 @@
+package migrations
+
+import (
+	"database/sql"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// testDatabaseEnv names the environment variable holding the DSN of a
+// disposable PostgreSQL database. The tests drop everything in it.
+const testDatabaseEnv = "ROOMY_TEST_DATABASE_URL"
+
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	dsn := os.Getenv(testDatabaseEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDatabaseEnv)
+	}
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if err := db.Ping(); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+// latestVersion returns the highest version of the embedded migrations.
+func latestVersion(t *testing.T) uint {
+	t.Helper()
+	names, err := fs.Glob(postgresFS, "postgres/*.up.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var latest uint
+	for _, name := range names {
+		prefix, _, _ := strings.Cut(strings.TrimPrefix(name, "postgres/"), "_")
+		v, err := strconv.ParseUint(prefix, 10, 32)
+		if err != nil {
+			t.Fatalf("migration %s: %v", name, err)
+		}
+		latest = max(latest, uint(v))
+	}
+	return latest
+}
+
+func tables(t *testing.T, db *sql.DB) []string {
+	t.Helper()
+	rows, err := db.Query(`SELECT table_name FROM information_schema.tables
+		WHERE table_schema = current_schema() AND table_name <> 'schema_migrations'
+		ORDER BY table_name`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, name)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return names
+}
+
+func TestUpDownUp(t *testing.T) {
+	db := openTestDB(t)
+	latest := latestVersion(t)
+
+	if err := RollbackAll(db); err != nil {
+		t.Fatalf("reset: %v", err)
+	}
+
+	steps := []struct {
+		name    string
+		migrate func(*sql.DB) error
+		version uint
+	}{
+		{"up", Run, latest},
+		{"down", RollbackAll, 0},
+		{"up again", Run, latest},
+	}
+	var created []string
+	for _, step := range steps {
+		if err := step.migrate(db); err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		version, dirty, err := Version(db)
+		if err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		if version != step.version || dirty {
+			t.Fatalf("%s: version = %d (dirty %t), want %d", step.name, version, dirty, step.version)
+		}
+
+		names := tables(t, db)
+		switch {
+		case step.version == 0 && len(names) > 0:
+			t.Fatalf("%s: tables left behind: %v", step.name, names)
+		case step.version != 0 && len(names) == 0:
+			t.Fatalf("%s: no tables created", step.name)
+		case step.version != 0 && created != nil && strings.Join(names, ",") != strings.Join(created, ","):
+			t.Fatalf("%s: tables = %v, want %v", step.name, names, created)
+		}
+		if step.version != 0 {
+			created = names
+		}
+	}
+}
+
+func TestStepwiseDown(t *testing.T) {
+	db := openTestDB(t)
+	latest := latestVersion(t)
+
+	if err := Run(db); err != nil {
+		t.Fatalf("up: %v", err)
+	}
+	// Every migration has to roll back on its own, not only as part of a
+	// full rollback.
+	for v := latest; v > 0; v-- {
+		if err := Rollback(db); err != nil {
+			t.Fatalf("rollback of %d: %v", v, err)
+		}
+		if err := Steps(db, 1); err != nil {
+			t.Fatalf("reapply of %d: %v", v, err)
+		}
+		if err := Rollback(db); err != nil {
+			t.Fatalf("second rollback of %d: %v", v, err)
+		}
+	}
+	if names := tables(t, db); len(names) > 0 {
+		t.Fatalf("tables left behind: %v", names)
+	}
+	if err := Run(db); err != nil {
+		t.Fatalf("up: %v", err)
+	}
+}
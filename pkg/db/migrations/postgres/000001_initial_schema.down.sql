@@ -0,0 +1,18 @@
+DROP TABLE IF EXISTS public.audit_log;
+DROP TABLE IF EXISTS public.qr_templates;
+DROP TABLE IF EXISTS public.reservations;
+DROP TABLE IF EXISTS public.api_keys;
+DROP TABLE IF EXISTS public.user_favorites;
+DROP TABLE IF EXISTS public.place_whitelist;
+DROP TABLE IF EXISTS public.group_permissions;
+DROP TABLE IF EXISTS public.user_groups;
+DROP TABLE IF EXISTS public.groups;
+DROP TABLE IF EXISTS public.users;
+DROP TABLE IF EXISTS public.place_equipment;
+DROP TABLE IF EXISTS public.equipment;
+DROP TABLE IF EXISTS public.blockings;
+DROP TABLE IF EXISTS public.time_slot_configs;
+DROP TABLE IF EXISTS public.place_markers;
+DROP TABLE IF EXISTS public.places;
+DROP TABLE IF EXISTS public.areas;
+DROP TABLE IF EXISTS public.buildings;
@@ -0,0 +1,267 @@
+-- Locations
+
+CREATE TABLE IF NOT EXISTS public.buildings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    location VARCHAR(255),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS public.areas (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    building_id UUID NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    location VARCHAR(255),
+    room_plan_url VARCHAR(1024),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT fk_areas_building FOREIGN KEY (building_id) REFERENCES public.buildings(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_areas_building_id ON public.areas(building_id);
+
+CREATE TABLE IF NOT EXISTS public.places (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    area_id UUID NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    location VARCHAR(255),
+    capacity INTEGER NOT NULL DEFAULT 1,
+    is_bookable BOOLEAN NOT NULL DEFAULT true,
+    booking_method VARCHAR(50) NOT NULL DEFAULT 'selfService',
+    is_disabled BOOLEAN NOT NULL DEFAULT false,
+    requires_check_in BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT fk_places_area FOREIGN KEY (area_id) REFERENCES public.areas(id) ON DELETE CASCADE,
+    CONSTRAINT chk_places_capacity CHECK (capacity >= 1),
+    CONSTRAINT chk_places_booking_method CHECK (booking_method IN ('selfService', 'manual'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_places_area_id ON public.places(area_id);
+
+CREATE TABLE IF NOT EXISTS public.place_markers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    area_id UUID NOT NULL,
+    place_id UUID NOT NULL,
+    x DOUBLE PRECISION NOT NULL,
+    y DOUBLE PRECISION NOT NULL,
+    width DOUBLE PRECISION NOT NULL,
+    height DOUBLE PRECISION NOT NULL,
+    shape VARCHAR(20) NOT NULL DEFAULT 'rectangle',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT fk_place_markers_area FOREIGN KEY (area_id) REFERENCES public.areas(id) ON DELETE CASCADE,
+    CONSTRAINT fk_place_markers_place FOREIGN KEY (place_id) REFERENCES public.places(id) ON DELETE CASCADE,
+    CONSTRAINT chk_place_markers_shape CHECK (shape IN ('rectangle', 'circle'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_place_markers_area_id ON public.place_markers(area_id);
+CREATE INDEX IF NOT EXISTS idx_place_markers_place_id ON public.place_markers(place_id);
+
+CREATE TABLE IF NOT EXISTS public.time_slot_configs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    place_id UUID NOT NULL,
+    interval_minutes INTEGER NOT NULL DEFAULT 30,
+    earliest_start_time VARCHAR(10),
+    latest_end_time VARCHAR(10),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT fk_time_slot_configs_place FOREIGN KEY (place_id) REFERENCES public.places(id) ON DELETE CASCADE,
+    CONSTRAINT uq_time_slot_configs_place_id UNIQUE (place_id),
+    CONSTRAINT chk_time_slot_configs_interval CHECK (interval_minutes >= 5)
+);
+
+-- Blocking periods are attached polymorphically to a building, area or place,
+-- so entity_id cannot carry a foreign key.
+CREATE TABLE IF NOT EXISTS public.blockings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    entity_type VARCHAR(20) NOT NULL,
+    entity_id UUID NOT NULL,
+    blocking_type VARCHAR(20) NOT NULL,
+    name VARCHAR(255),
+    reason TEXT,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    is_recurring BOOLEAN NOT NULL DEFAULT false,
+    recurrence_rule TEXT,
+    recurrence_duration BIGINT,
+    recurrence_end DATE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT chk_blockings_entity_type CHECK (entity_type IN ('building', 'area', 'place')),
+    CONSTRAINT chk_blockings_blocking_type CHECK (blocking_type IN ('closedHours', 'weekend', 'holiday', 'maintenance', 'event', 'disabled', 'custom')),
+    CONSTRAINT chk_blockings_time_range CHECK (end_time > start_time)
+);
+
+CREATE INDEX IF NOT EXISTS idx_blockings_entity ON public.blockings(entity_type, entity_id);
+CREATE INDEX IF NOT EXISTS idx_blockings_start_time ON public.blockings(start_time);
+CREATE INDEX IF NOT EXISTS idx_blockings_end_time ON public.blockings(end_time);
+
+-- Equipment
+
+CREATE TABLE IF NOT EXISTS public.equipment (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(100) NOT NULL,
+    icon VARCHAR(50),
+    description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT uq_equipment_name UNIQUE (name)
+);
+
+CREATE TABLE IF NOT EXISTS public.place_equipment (
+    place_id UUID NOT NULL,
+    equipment_id UUID NOT NULL,
+    PRIMARY KEY (place_id, equipment_id),
+    CONSTRAINT fk_place_equipment_place FOREIGN KEY (place_id) REFERENCES public.places(id) ON DELETE CASCADE,
+    CONSTRAINT fk_place_equipment_equipment FOREIGN KEY (equipment_id) REFERENCES public.equipment(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_place_equipment_equipment_id ON public.place_equipment(equipment_id);
+
+-- Users and groups
+
+CREATE TABLE IF NOT EXISTS public.users (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    email VARCHAR(255) NOT NULL,
+    username VARCHAR(100) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    profile_picture VARCHAR(512),
+    oauth_provider VARCHAR(50) NOT NULL DEFAULT 'gitlab',
+    oauth_id VARCHAR(255) NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    notify_reservation_confirmed BOOLEAN NOT NULL DEFAULT true,
+    notify_reservation_cancelled BOOLEAN NOT NULL DEFAULT true,
+    notify_reservation_reminder BOOLEAN NOT NULL DEFAULT true,
+    reminder_minutes_before INTEGER NOT NULL DEFAULT 15,
+    notify_check_in_warning BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT uq_users_email UNIQUE (email),
+    CONSTRAINT uq_users_oauth UNIQUE (oauth_provider, oauth_id)
+);
+
+CREATE TABLE IF NOT EXISTS public.groups (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    is_system BOOLEAN NOT NULL DEFAULT false,
+    is_default BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT uq_groups_name UNIQUE (name)
+);
+
+-- At most one immutable system group may exist.
+CREATE UNIQUE INDEX IF NOT EXISTS uq_groups_system ON public.groups(is_system) WHERE is_system;
+
+CREATE TABLE IF NOT EXISTS public.user_groups (
+    user_id UUID NOT NULL,
+    group_id UUID NOT NULL,
+    PRIMARY KEY (user_id, group_id),
+    CONSTRAINT fk_user_groups_user FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_user_groups_group FOREIGN KEY (group_id) REFERENCES public.groups(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_groups_group_id ON public.user_groups(group_id);
+
+CREATE TABLE IF NOT EXISTS public.group_permissions (
+    group_id UUID NOT NULL,
+    permission VARCHAR(100) NOT NULL,
+    PRIMARY KEY (group_id, permission),
+    CONSTRAINT fk_group_permissions_group FOREIGN KEY (group_id) REFERENCES public.groups(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS public.place_whitelist (
+    place_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    PRIMARY KEY (place_id, user_id),
+    CONSTRAINT fk_place_whitelist_place FOREIGN KEY (place_id) REFERENCES public.places(id) ON DELETE CASCADE,
+    CONSTRAINT fk_place_whitelist_user FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_place_whitelist_user_id ON public.place_whitelist(user_id);
+
+CREATE TABLE IF NOT EXISTS public.user_favorites (
+    user_id UUID NOT NULL,
+    place_id UUID NOT NULL,
+    PRIMARY KEY (user_id, place_id),
+    CONSTRAINT fk_user_favorites_user FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_user_favorites_place FOREIGN KEY (place_id) REFERENCES public.places(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_favorites_place_id ON public.user_favorites(place_id);
+
+CREATE TABLE IF NOT EXISTS public.api_keys (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    value VARCHAR(128) NOT NULL,
+    last_used_at TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT fk_api_keys_user FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE,
+    CONSTRAINT uq_api_keys_value UNIQUE (value)
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_keys_user_id ON public.api_keys(user_id);
+
+-- Reservations
+
+CREATE TABLE IF NOT EXISTS public.reservations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    place_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    check_in_time TIMESTAMPTZ,
+    cancel_reason TEXT,
+    cancel_time TIMESTAMPTZ,
+    is_recurring BOOLEAN NOT NULL DEFAULT false,
+    recurring_group_id UUID,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT fk_reservations_place FOREIGN KEY (place_id) REFERENCES public.places(id) ON DELETE CASCADE,
+    CONSTRAINT fk_reservations_user FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE,
+    CONSTRAINT chk_reservations_status CHECK (status IN ('pending', 'confirmed', 'checkedIn', 'cancelled')),
+    CONSTRAINT chk_reservations_time_range CHECK (end_time > start_time)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reservations_place_time ON public.reservations(place_id, start_time, end_time);
+CREATE INDEX IF NOT EXISTS idx_reservations_user_id ON public.reservations(user_id);
+CREATE INDEX IF NOT EXISTS idx_reservations_start_time ON public.reservations(start_time);
+CREATE INDEX IF NOT EXISTS idx_reservations_end_time ON public.reservations(end_time);
+CREATE INDEX IF NOT EXISTS idx_reservations_recurring_group_id ON public.reservations(recurring_group_id);
+
+-- Administration
+
+CREATE TABLE IF NOT EXISTS public.qr_templates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL,
+    html_template TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Audit entries outlive the user that caused them.
+CREATE TABLE IF NOT EXISTS public.audit_log (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id UUID NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    user_id UUID,
+    changes JSONB,
+    "timestamp" TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT fk_audit_log_user FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE SET NULL,
+    CONSTRAINT chk_audit_log_action CHECK (action IN ('create', 'update', 'delete'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_log_entity ON public.audit_log(entity_type, entity_id);
+CREATE INDEX IF NOT EXISTS idx_audit_log_user_id ON public.audit_log(user_id);
+CREATE INDEX IF NOT EXISTS idx_audit_log_timestamp ON public.audit_log("timestamp");
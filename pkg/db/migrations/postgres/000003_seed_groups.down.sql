@@ -0,0 +1,2 @@
+DELETE FROM public.groups WHERE name = 'default' AND is_system = false;
+DELETE FROM public.groups WHERE is_system = true;
@@ -0,0 +1,10 @@
+-- Built-in groups: the immutable system group implicitly holds every
+-- permission, the default group is assigned to new users on first login.
+
+INSERT INTO public.groups (name, description, is_system, is_default)
+VALUES ('system', 'Immutable group holding all permissions', true, false)
+ON CONFLICT DO NOTHING;
+
+INSERT INTO public.groups (name, description, is_system, is_default)
+VALUES ('default', 'Baseline group assigned to new users', false, true)
+ON CONFLICT DO NOTHING;
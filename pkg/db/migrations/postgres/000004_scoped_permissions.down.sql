@@ -0,0 +1,17 @@
+DELETE FROM public.group_permissions WHERE scope_type IS NOT NULL;
+
+DROP INDEX IF EXISTS public.idx_group_permissions_scope;
+DROP INDEX IF EXISTS public.uq_group_permissions_scoped;
+DROP INDEX IF EXISTS public.uq_group_permissions_global;
+
+ALTER TABLE public.group_permissions
+    DROP CONSTRAINT IF EXISTS chk_group_permissions_scope,
+    DROP CONSTRAINT IF EXISTS chk_group_permissions_scope_type,
+    DROP CONSTRAINT IF EXISTS group_permissions_pkey;
+
+ALTER TABLE public.group_permissions
+    DROP COLUMN IF EXISTS scope_id,
+    DROP COLUMN IF EXISTS scope_type,
+    DROP COLUMN IF EXISTS id;
+
+ALTER TABLE public.group_permissions ADD CONSTRAINT group_permissions_pkey PRIMARY KEY (group_id, permission);
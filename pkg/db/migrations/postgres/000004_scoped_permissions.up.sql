@@ -0,0 +1,20 @@
+-- Group permissions may optionally be scoped to a single building, area or
+-- place. Scoped grants cascade down the Building -> Area -> Place hierarchy.
+
+ALTER TABLE public.group_permissions DROP CONSTRAINT IF EXISTS group_permissions_pkey;
+
+ALTER TABLE public.group_permissions
+    ADD COLUMN IF NOT EXISTS id UUID NOT NULL DEFAULT gen_random_uuid(),
+    ADD COLUMN IF NOT EXISTS scope_type VARCHAR(20),
+    ADD COLUMN IF NOT EXISTS scope_id UUID;
+
+ALTER TABLE public.group_permissions
+    ADD CONSTRAINT group_permissions_pkey PRIMARY KEY (id),
+    ADD CONSTRAINT chk_group_permissions_scope_type CHECK (scope_type IN ('building', 'area', 'place')),
+    ADD CONSTRAINT chk_group_permissions_scope CHECK ((scope_type IS NULL) = (scope_id IS NULL));
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_group_permissions_global
+    ON public.group_permissions(group_id, permission) WHERE scope_type IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_group_permissions_scoped
+    ON public.group_permissions(group_id, permission, scope_type, scope_id) WHERE scope_type IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_group_permissions_scope ON public.group_permissions(scope_type, scope_id);
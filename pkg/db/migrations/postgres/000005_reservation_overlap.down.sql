@@ -0,0 +1 @@
+ALTER TABLE public.reservations DROP CONSTRAINT IF EXISTS excl_reservations_place_time;
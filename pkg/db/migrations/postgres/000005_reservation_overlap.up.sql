@@ -0,0 +1,11 @@
+-- Active reservations of a place must never overlap. The exclusion constraint
+-- enforces this atomically, so concurrent bookings of the same slot cannot
+-- both succeed.
+
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+ALTER TABLE public.reservations
+    ADD CONSTRAINT excl_reservations_place_time EXCLUDE USING gist (
+        place_id WITH =,
+        tstzrange(start_time, end_time, '[)') WITH &&
+    ) WHERE (status <> 'cancelled');
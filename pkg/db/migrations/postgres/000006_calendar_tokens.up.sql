@@ -0,0 +1,10 @@
+-- Personal calendar feed tokens (one per user, rotated on demand, stored hashed)
+
+CREATE TABLE IF NOT EXISTS public.calendar_tokens (
+    user_id UUID PRIMARY KEY,
+    token_hash VARCHAR(64) NOT NULL,
+    last_used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT fk_calendar_tokens_user FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE,
+    CONSTRAINT uq_calendar_tokens_token_hash UNIQUE (token_hash)
+);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS public.idx_reservations_reminder_due;
+
+ALTER TABLE public.reservations DROP COLUMN IF EXISTS reminded_at;
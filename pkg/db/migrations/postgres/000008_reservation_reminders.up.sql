@@ -0,0 +1,8 @@
+-- Remember which reservations have been reminded of, so that the reminder
+-- job enqueues exactly one reminder per reservation across restarts and
+-- replicas.
+
+ALTER TABLE public.reservations ADD COLUMN IF NOT EXISTS reminded_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_reservations_reminder_due ON public.reservations(start_time)
+    WHERE reminded_at IS NULL AND status IN ('pending', 'confirmed');
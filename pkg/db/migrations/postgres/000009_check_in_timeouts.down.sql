@@ -0,0 +1,3 @@
+ALTER TABLE public.reservations DROP COLUMN IF EXISTS check_in_warned_at;
+
+DROP TABLE IF EXISTS public.place_constraints;
@@ -0,0 +1,17 @@
+-- Booking constraints of places. For now only the timeouts after which
+-- reservations that have not been checked in to are released.
+
+CREATE TABLE IF NOT EXISTS public.place_constraints (
+    place_id UUID PRIMARY KEY,
+    check_in_timeout_minutes INTEGER,
+    decay_timeout_minutes INTEGER,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT fk_place_constraints_place FOREIGN KEY (place_id) REFERENCES public.places(id) ON DELETE CASCADE,
+    CONSTRAINT chk_place_constraints_check_in_timeout CHECK (check_in_timeout_minutes > 0),
+    CONSTRAINT chk_place_constraints_decay_timeout CHECK (decay_timeout_minutes > 0)
+);
+
+-- Remember which reservations have been warned about a missing check-in, so
+-- that the warning is sent only once.
+ALTER TABLE public.reservations ADD COLUMN IF NOT EXISTS check_in_warned_at TIMESTAMPTZ;
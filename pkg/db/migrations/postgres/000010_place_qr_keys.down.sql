@@ -0,0 +1 @@
+ALTER TABLE public.places DROP COLUMN IF EXISTS qr_key_version;
@@ -0,0 +1,4 @@
+-- Version of the key of a place's QR code. QR tokens carry the version they
+-- were signed for; incrementing it invalidates all printed codes.
+
+ALTER TABLE public.places ADD COLUMN IF NOT EXISTS qr_key_version INTEGER NOT NULL DEFAULT 1;
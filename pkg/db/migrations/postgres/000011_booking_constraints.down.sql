@@ -0,0 +1,20 @@
+ALTER TABLE public.place_constraints
+    DROP CONSTRAINT IF EXISTS chk_place_constraints_min_reservation,
+    DROP CONSTRAINT IF EXISTS chk_place_constraints_max_reservation,
+    DROP CONSTRAINT IF EXISTS chk_place_constraints_reservations_per_period,
+    DROP CONSTRAINT IF EXISTS chk_place_constraints_hours_per_period,
+    DROP CONSTRAINT IF EXISTS chk_place_constraints_concurrent,
+    DROP CONSTRAINT IF EXISTS chk_place_constraints_advance,
+    DROP COLUMN IF EXISTS min_reservation_minutes,
+    DROP COLUMN IF EXISTS max_reservation_minutes,
+    DROP COLUMN IF EXISTS max_reservations_per_day,
+    DROP COLUMN IF EXISTS max_reservations_per_week,
+    DROP COLUMN IF EXISTS max_reservations_per_month,
+    DROP COLUMN IF EXISTS max_reservations_per_year,
+    DROP COLUMN IF EXISTS max_hours_per_day,
+    DROP COLUMN IF EXISTS max_hours_per_week,
+    DROP COLUMN IF EXISTS max_hours_per_month,
+    DROP COLUMN IF EXISTS max_hours_per_year,
+    DROP COLUMN IF EXISTS max_concurrent_reservations,
+    DROP COLUMN IF EXISTS max_advance_booking_days,
+    DROP COLUMN IF EXISTS whitelist_enabled;
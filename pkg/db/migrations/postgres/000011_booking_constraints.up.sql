@@ -0,0 +1,37 @@
+-- Booking limits of places: the duration of single reservations, how many
+-- reservations and hours a user may book per calendar day, week, month and
+-- year, how many upcoming reservations a user may hold at once and how far
+-- in advance places may be booked. NULL means unlimited.
+
+ALTER TABLE public.place_constraints
+    ADD COLUMN IF NOT EXISTS min_reservation_minutes INTEGER,
+    ADD COLUMN IF NOT EXISTS max_reservation_minutes INTEGER,
+    ADD COLUMN IF NOT EXISTS max_reservations_per_day INTEGER,
+    ADD COLUMN IF NOT EXISTS max_reservations_per_week INTEGER,
+    ADD COLUMN IF NOT EXISTS max_reservations_per_month INTEGER,
+    ADD COLUMN IF NOT EXISTS max_reservations_per_year INTEGER,
+    ADD COLUMN IF NOT EXISTS max_hours_per_day INTEGER,
+    ADD COLUMN IF NOT EXISTS max_hours_per_week INTEGER,
+    ADD COLUMN IF NOT EXISTS max_hours_per_month INTEGER,
+    ADD COLUMN IF NOT EXISTS max_hours_per_year INTEGER,
+    ADD COLUMN IF NOT EXISTS max_concurrent_reservations INTEGER,
+    ADD COLUMN IF NOT EXISTS max_advance_booking_days INTEGER,
+    ADD COLUMN IF NOT EXISTS whitelist_enabled BOOLEAN NOT NULL DEFAULT false,
+    ADD CONSTRAINT chk_place_constraints_min_reservation CHECK (min_reservation_minutes > 0),
+    ADD CONSTRAINT chk_place_constraints_max_reservation CHECK (max_reservation_minutes >= min_reservation_minutes AND max_reservation_minutes > 0),
+    ADD CONSTRAINT chk_place_constraints_reservations_per_period CHECK (
+        max_reservations_per_day > 0 AND max_reservations_per_week > 0 AND
+        max_reservations_per_month > 0 AND max_reservations_per_year > 0
+    ),
+    ADD CONSTRAINT chk_place_constraints_hours_per_period CHECK (
+        max_hours_per_day > 0 AND max_hours_per_week > 0 AND
+        max_hours_per_month > 0 AND max_hours_per_year > 0
+    ),
+    ADD CONSTRAINT chk_place_constraints_concurrent CHECK (max_concurrent_reservations > 0),
+    ADD CONSTRAINT chk_place_constraints_advance CHECK (max_advance_booking_days >= 0);
+
+-- Whitelists used to apply as soon as they had an entry. Keep restricting
+-- the places that have one.
+INSERT INTO public.place_constraints (place_id, whitelist_enabled)
+SELECT DISTINCT place_id, true FROM public.place_whitelist
+ON CONFLICT (place_id) DO UPDATE SET whitelist_enabled = true;
@@ -0,0 +1,53 @@
+CREATE TABLE IF NOT EXISTS public.place_constraints (
+    place_id UUID PRIMARY KEY,
+    check_in_timeout_minutes INTEGER,
+    decay_timeout_minutes INTEGER,
+    min_reservation_minutes INTEGER,
+    max_reservation_minutes INTEGER,
+    max_reservations_per_day INTEGER,
+    max_reservations_per_week INTEGER,
+    max_reservations_per_month INTEGER,
+    max_reservations_per_year INTEGER,
+    max_hours_per_day INTEGER,
+    max_hours_per_week INTEGER,
+    max_hours_per_month INTEGER,
+    max_hours_per_year INTEGER,
+    max_concurrent_reservations INTEGER,
+    max_advance_booking_days INTEGER,
+    whitelist_enabled BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT fk_place_constraints_place FOREIGN KEY (place_id) REFERENCES public.places(id) ON DELETE CASCADE,
+    CONSTRAINT chk_place_constraints_check_in_timeout CHECK (check_in_timeout_minutes > 0),
+    CONSTRAINT chk_place_constraints_decay_timeout CHECK (decay_timeout_minutes > 0),
+    CONSTRAINT chk_place_constraints_min_reservation CHECK (min_reservation_minutes > 0),
+    CONSTRAINT chk_place_constraints_max_reservation CHECK (max_reservation_minutes >= min_reservation_minutes AND max_reservation_minutes > 0),
+    CONSTRAINT chk_place_constraints_reservations_per_period CHECK (
+        max_reservations_per_day > 0 AND max_reservations_per_week > 0 AND
+        max_reservations_per_month > 0 AND max_reservations_per_year > 0
+    ),
+    CONSTRAINT chk_place_constraints_hours_per_period CHECK (
+        max_hours_per_day > 0 AND max_hours_per_week > 0 AND
+        max_hours_per_month > 0 AND max_hours_per_year > 0
+    ),
+    CONSTRAINT chk_place_constraints_concurrent CHECK (max_concurrent_reservations > 0),
+    CONSTRAINT chk_place_constraints_advance CHECK (max_advance_booking_days >= 0)
+);
+
+-- Constraints of areas and buildings are lost.
+INSERT INTO public.place_constraints (
+    place_id, check_in_timeout_minutes, decay_timeout_minutes, min_reservation_minutes, max_reservation_minutes,
+    max_reservations_per_day, max_reservations_per_week, max_reservations_per_month, max_reservations_per_year,
+    max_hours_per_day, max_hours_per_week, max_hours_per_month, max_hours_per_year,
+    max_concurrent_reservations, max_advance_booking_days, whitelist_enabled, created_at, updated_at
+)
+SELECT
+    c.entity_id, c.check_in_timeout_minutes, c.decay_timeout_minutes, c.min_reservation_minutes, c.max_reservation_minutes,
+    c.max_reservations_per_day, c.max_reservations_per_week, c.max_reservations_per_month, c.max_reservations_per_year,
+    c.max_hours_per_day, c.max_hours_per_week, c.max_hours_per_month, c.max_hours_per_year,
+    c.max_concurrent_reservations, c.max_advance_booking_days, COALESCE(c.whitelist_enabled, false), c.created_at, c.updated_at
+FROM public.booking_constraints c
+JOIN public.places p ON p.id = c.entity_id
+WHERE c.entity_type = 'place';
+
+DROP TABLE IF EXISTS public.booking_constraints;
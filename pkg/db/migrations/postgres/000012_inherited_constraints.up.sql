@@ -0,0 +1,61 @@
+-- Booking constraints are attached polymorphically to a building, area or
+-- place, like blockings, so entity_id cannot carry a foreign key. Places
+-- inherit every field they leave NULL from their area, and areas from their
+-- building.
+
+CREATE TABLE IF NOT EXISTS public.booking_constraints (
+    entity_type VARCHAR(20) NOT NULL,
+    entity_id UUID NOT NULL,
+    min_reservation_minutes INTEGER,
+    max_reservation_minutes INTEGER,
+    max_reservations_per_day INTEGER,
+    max_reservations_per_week INTEGER,
+    max_reservations_per_month INTEGER,
+    max_reservations_per_year INTEGER,
+    max_hours_per_day INTEGER,
+    max_hours_per_week INTEGER,
+    max_hours_per_month INTEGER,
+    max_hours_per_year INTEGER,
+    max_concurrent_reservations INTEGER,
+    max_advance_booking_days INTEGER,
+    check_in_timeout_minutes INTEGER,
+    decay_timeout_minutes INTEGER,
+    whitelist_enabled BOOLEAN,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (entity_type, entity_id),
+    CONSTRAINT chk_booking_constraints_entity_type CHECK (entity_type IN ('building', 'area', 'place')),
+    CONSTRAINT chk_booking_constraints_min_reservation CHECK (min_reservation_minutes > 0),
+    CONSTRAINT chk_booking_constraints_max_reservation CHECK (max_reservation_minutes >= min_reservation_minutes AND max_reservation_minutes > 0),
+    CONSTRAINT chk_booking_constraints_reservations_per_period CHECK (
+        max_reservations_per_day > 0 AND max_reservations_per_week > 0 AND
+        max_reservations_per_month > 0 AND max_reservations_per_year > 0
+    ),
+    CONSTRAINT chk_booking_constraints_hours_per_period CHECK (
+        max_hours_per_day > 0 AND max_hours_per_week > 0 AND
+        max_hours_per_month > 0 AND max_hours_per_year > 0
+    ),
+    CONSTRAINT chk_booking_constraints_concurrent CHECK (max_concurrent_reservations > 0),
+    CONSTRAINT chk_booking_constraints_advance CHECK (max_advance_booking_days >= 0),
+    CONSTRAINT chk_booking_constraints_check_in_timeout CHECK (check_in_timeout_minutes > 0),
+    CONSTRAINT chk_booking_constraints_decay_timeout CHECK (decay_timeout_minutes > 0)
+);
+
+-- A disabled whitelist used to be the default; leave it unset, so that it
+-- can be inherited.
+INSERT INTO public.booking_constraints (
+    entity_type, entity_id, min_reservation_minutes, max_reservation_minutes,
+    max_reservations_per_day, max_reservations_per_week, max_reservations_per_month, max_reservations_per_year,
+    max_hours_per_day, max_hours_per_week, max_hours_per_month, max_hours_per_year,
+    max_concurrent_reservations, max_advance_booking_days,
+    check_in_timeout_minutes, decay_timeout_minutes, whitelist_enabled, created_at, updated_at
+)
+SELECT
+    'place', place_id, min_reservation_minutes, max_reservation_minutes,
+    max_reservations_per_day, max_reservations_per_week, max_reservations_per_month, max_reservations_per_year,
+    max_hours_per_day, max_hours_per_week, max_hours_per_month, max_hours_per_year,
+    max_concurrent_reservations, max_advance_booking_days,
+    check_in_timeout_minutes, decay_timeout_minutes, NULLIF(whitelist_enabled, false), created_at, updated_at
+FROM public.place_constraints;
+
+DROP TABLE IF EXISTS public.place_constraints;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS public.group_constraint_profiles;
@@ -0,0 +1,42 @@
+-- Constraint profiles override the booking constraints of places for the
+-- members of a group, everywhere or only within one building or area.
+
+CREATE TABLE IF NOT EXISTS public.group_constraint_profiles (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    group_id UUID NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    scope_type VARCHAR(20),
+    scope_id UUID,
+    min_reservation_minutes INTEGER,
+    max_reservation_minutes INTEGER,
+    max_reservations_per_day INTEGER,
+    max_reservations_per_week INTEGER,
+    max_reservations_per_month INTEGER,
+    max_reservations_per_year INTEGER,
+    max_hours_per_day INTEGER,
+    max_hours_per_week INTEGER,
+    max_hours_per_month INTEGER,
+    max_hours_per_year INTEGER,
+    max_concurrent_reservations INTEGER,
+    max_advance_booking_days INTEGER,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT fk_group_constraint_profiles_group FOREIGN KEY (group_id) REFERENCES public.groups(id) ON DELETE CASCADE,
+    CONSTRAINT uq_group_constraint_profiles_name UNIQUE (group_id, name),
+    CONSTRAINT chk_group_constraint_profiles_scope_type CHECK (scope_type IN ('building', 'area')),
+    CONSTRAINT chk_group_constraint_profiles_scope CHECK ((scope_type IS NULL) = (scope_id IS NULL)),
+    CONSTRAINT chk_group_constraint_profiles_min_reservation CHECK (min_reservation_minutes > 0),
+    CONSTRAINT chk_group_constraint_profiles_max_reservation CHECK (max_reservation_minutes >= min_reservation_minutes AND max_reservation_minutes > 0),
+    CONSTRAINT chk_group_constraint_profiles_reservations_per_period CHECK (
+        max_reservations_per_day > 0 AND max_reservations_per_week > 0 AND
+        max_reservations_per_month > 0 AND max_reservations_per_year > 0
+    ),
+    CONSTRAINT chk_group_constraint_profiles_hours_per_period CHECK (
+        max_hours_per_day > 0 AND max_hours_per_week > 0 AND
+        max_hours_per_month > 0 AND max_hours_per_year > 0
+    ),
+    CONSTRAINT chk_group_constraint_profiles_concurrent CHECK (max_concurrent_reservations > 0),
+    CONSTRAINT chk_group_constraint_profiles_advance CHECK (max_advance_booking_days >= 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_group_constraint_profiles_scope ON public.group_constraint_profiles(scope_type, scope_id);
@@ -0,0 +1,3 @@
+ALTER TABLE public.booking_constraints
+    DROP CONSTRAINT IF EXISTS chk_booking_constraints_quota_window,
+    DROP COLUMN IF EXISTS quota_window;
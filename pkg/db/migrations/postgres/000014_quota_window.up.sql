@@ -0,0 +1,7 @@
+-- Quotas count reservations per calendar day, week, month and year, or per
+-- rolling window of the same length around each reservation. NULL is
+-- inherited from the next broader level and defaults to calendar.
+
+ALTER TABLE public.booking_constraints
+    ADD COLUMN IF NOT EXISTS quota_window VARCHAR(20),
+    ADD CONSTRAINT chk_booking_constraints_quota_window CHECK (quota_window IN ('calendar', 'rolling'));
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS public.uq_users_email;
+
+ALTER TABLE public.users
+    ADD CONSTRAINT uq_users_email UNIQUE (email);
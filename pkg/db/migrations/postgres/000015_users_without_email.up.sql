@@ -0,0 +1,7 @@
+-- GitLab accounts may have no public email address. Such users are stored
+-- with an empty email, which does not have to be unique.
+
+ALTER TABLE public.users
+    DROP CONSTRAINT IF EXISTS uq_users_email;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_users_email ON public.users(email) WHERE email <> '';
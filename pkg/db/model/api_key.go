@@ -33,3 +33,9 @@ func (m *APIKey) BeforeCreate(tx *gorm.DB) error {
 func (m *APIKey) Exists() bool {
 	return m != nil && m.ID != uuid.Nil
 }
+
+// APIKeyUsage is the last use of an API key.
+type APIKeyUsage struct {
+	ID     uuid.UUID
+	UsedAt time.Time
+}
@@ -18,10 +18,11 @@ type Area struct {
 	UpdatedAt   time.Time `gorm:"not null"`
 
 	// Relations
-	Building     *Building      `gorm:"foreignKey:BuildingID"`
-	Places       []*Place       `gorm:"foreignKey:AreaID;constraint:OnDelete:CASCADE"`
-	PlaceMarkers []*PlaceMarker `gorm:"foreignKey:AreaID;constraint:OnDelete:CASCADE"`
-	Blockings    []*Blocking    `gorm:"polymorphic:Entity;polymorphicValue:area"`
+	Building     *Building           `gorm:"foreignKey:BuildingID"`
+	Places       []*Place            `gorm:"foreignKey:AreaID;constraint:OnDelete:CASCADE"`
+	PlaceMarkers []*PlaceMarker      `gorm:"foreignKey:AreaID;constraint:OnDelete:CASCADE"`
+	Blockings    []*Blocking         `gorm:"polymorphic:Entity;polymorphicValue:area"`
+	Constraints  *BookingConstraints `gorm:"polymorphic:Entity;polymorphicValue:area"`
 }
 
 func (Area) TableName() string { return "areas" }
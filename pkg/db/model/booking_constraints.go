@@ -0,0 +1,38 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BookingConstraints are the booking limits set on a building, area or
+// place. Nil fields are inherited from the next broader level.
+type BookingConstraints struct {
+	EntityType                string    `gorm:"primaryKey;size:20"` // building, area, place
+	EntityID                  uuid.UUID `gorm:"type:uuid;primaryKey"`
+	MinReservationMinutes     *int
+	MaxReservationMinutes     *int
+	MaxReservationsPerDay     *int
+	MaxReservationsPerWeek    *int
+	MaxReservationsPerMonth   *int
+	MaxReservationsPerYear    *int
+	MaxHoursPerDay            *int
+	MaxHoursPerWeek           *int
+	MaxHoursPerMonth          *int
+	MaxHoursPerYear           *int
+	MaxConcurrentReservations *int
+	MaxAdvanceBookingDays     *int
+	CheckInTimeoutMinutes     *int
+	DecayTimeoutMinutes       *int
+	WhitelistEnabled          *bool
+	QuotaWindow               *string   `gorm:"size:20"` // calendar, rolling
+	CreatedAt                 time.Time `gorm:"not null;default:now()"`
+	UpdatedAt                 time.Time `gorm:"not null"`
+}
+
+func (BookingConstraints) TableName() string { return "booking_constraints" }
+
+func (m *BookingConstraints) Exists() bool {
+	return m != nil && m.EntityID != uuid.Nil
+}
@@ -16,8 +16,9 @@ type Building struct {
 	UpdatedAt   time.Time `gorm:"not null"`
 
 	// Relations
-	Areas     []*Area     `gorm:"foreignKey:BuildingID;constraint:OnDelete:CASCADE"`
-	Blockings []*Blocking `gorm:"polymorphic:Entity;polymorphicValue:building"`
+	Areas       []*Area             `gorm:"foreignKey:BuildingID;constraint:OnDelete:CASCADE"`
+	Blockings   []*Blocking         `gorm:"polymorphic:Entity;polymorphicValue:building"`
+	Constraints *BookingConstraints `gorm:"polymorphic:Entity;polymorphicValue:building"`
 }
 
 func (Building) TableName() string { return "buildings" }
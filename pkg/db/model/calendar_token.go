@@ -0,0 +1,23 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CalendarToken struct {
+	UserID     uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	TokenHash  string     `gorm:"not null;uniqueIndex;size:64"`
+	LastUsedAt *time.Time `gorm:"type:timestamp"`
+	CreatedAt  time.Time  `gorm:"not null;default:now()"`
+
+	// Relations
+	User *User `gorm:"foreignKey:UserID"`
+}
+
+func (CalendarToken) TableName() string { return "calendar_tokens" }
+
+func (m *CalendarToken) Exists() bool {
+	return m != nil && m.UserID != uuid.Nil
+}
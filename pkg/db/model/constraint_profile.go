@@ -0,0 +1,48 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ConstraintProfile overrides the booking constraints of places for the
+// members of a group. Nil fields do not override anything.
+type ConstraintProfile struct {
+	ID                        uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	GroupID                   uuid.UUID  `gorm:"type:uuid;not null;index"`
+	Name                      string     `gorm:"not null;size:100"`
+	ScopeType                 *string    `gorm:"size:20"` // "building", "area" or nil for all places
+	ScopeID                   *uuid.UUID `gorm:"type:uuid"`
+	MinReservationMinutes     *int
+	MaxReservationMinutes     *int
+	MaxReservationsPerDay     *int
+	MaxReservationsPerWeek    *int
+	MaxReservationsPerMonth   *int
+	MaxReservationsPerYear    *int
+	MaxHoursPerDay            *int
+	MaxHoursPerWeek           *int
+	MaxHoursPerMonth          *int
+	MaxHoursPerYear           *int
+	MaxConcurrentReservations *int
+	MaxAdvanceBookingDays     *int
+	CreatedAt                 time.Time `gorm:"not null;default:now()"`
+	UpdatedAt                 time.Time `gorm:"not null"`
+
+	// Relations
+	Group *Group `gorm:"foreignKey:GroupID"`
+}
+
+func (ConstraintProfile) TableName() string { return "group_constraint_profiles" }
+
+func (m *ConstraintProfile) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+	return nil
+}
+
+func (m *ConstraintProfile) Exists() bool {
+	return m != nil && m.ID != uuid.Nil
+}
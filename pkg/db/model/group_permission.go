@@ -0,0 +1,30 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type GroupPermission struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	GroupID    uuid.UUID  `gorm:"type:uuid;not null;index"`
+	Permission string     `gorm:"not null;size:100"`
+	ScopeType  *string    `gorm:"size:20"` // "building", "area", "place" or nil for a global grant
+	ScopeID    *uuid.UUID `gorm:"type:uuid"`
+
+	// Relations
+	Group *Group `gorm:"foreignKey:GroupID"`
+}
+
+func (GroupPermission) TableName() string { return "group_permissions" }
+
+func (m *GroupPermission) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+	return nil
+}
+
+func (m *GroupPermission) Exists() bool {
+	return m != nil && m.ID != uuid.Nil
+}
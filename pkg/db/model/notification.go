@@ -0,0 +1,39 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotificationStatus string
+
+const (
+	NotificationStatusPending NotificationStatus = "pending"
+	NotificationStatusSent    NotificationStatus = "sent"
+	NotificationStatusDead    NotificationStatus = "dead"
+)
+
+type Notification struct {
+	ID            uuid.UUID          `gorm:"type:uuid;primaryKey"`
+	UserID        uuid.UUID          `gorm:"type:uuid;not null;index"`
+	Kind          string             `gorm:"not null;size:50"`
+	Recipient     string             `gorm:"not null;size:255"`
+	Payload       string             `gorm:"type:jsonb;not null"`
+	Status        NotificationStatus `gorm:"not null;size:20;default:'pending'"`
+	Attempts      int                `gorm:"not null;default:0"`
+	NextAttemptAt time.Time          `gorm:"not null;default:now()"`
+	LastError     *string            `gorm:"type:text"`
+	SentAt        *time.Time         `gorm:"type:timestamp"`
+	CreatedAt     time.Time          `gorm:"not null;default:now()"`
+	UpdatedAt     time.Time          `gorm:"not null"`
+
+	// Relations
+	User *User `gorm:"foreignKey:UserID"`
+}
+
+func (Notification) TableName() string { return "notifications" }
+
+func (m *Notification) Exists() bool {
+	return m != nil && m.ID != uuid.Nil
+}
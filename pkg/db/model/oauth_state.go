@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+type OAuthState struct {
+	Nonce        string    `gorm:"primaryKey;size:64"`
+	CodeVerifier string    `gorm:"not null;size:128"`
+	ExpiresAt    time.Time `gorm:"not null;index"`
+	CreatedAt    time.Time `gorm:"not null;default:now()"`
+}
+
+func (OAuthState) TableName() string { return "oauth_states" }
@@ -25,17 +25,19 @@ type Place struct {
 	BookingMethod   BookingMethod `gorm:"not null;default:'selfService';size:50"`
 	IsDisabled      bool          `gorm:"not null;default:false"`
 	RequiresCheckIn bool          `gorm:"not null;default:false"`
+	QRKeyVersion    int           `gorm:"column:qr_key_version;not null;default:1"`
 	CreatedAt       time.Time     `gorm:"not null;default:now()"`
 	UpdatedAt       time.Time     `gorm:"not null"`
 
 	// Relations
-	Area             *Area           `gorm:"foreignKey:AreaID"`
-	TimeSlotConfig   *TimeSlotConfig `gorm:"foreignKey:PlaceID;constraint:OnDelete:CASCADE"`
-	Equipment        []*Equipment    `gorm:"many2many:place_equipment;"`
-	WhitelistedUsers []*User         `gorm:"many2many:place_whitelist;"`
-	Reservations     []*Reservation  `gorm:"foreignKey:PlaceID;constraint:OnDelete:CASCADE"`
-	Blockings        []*Blocking     `gorm:"polymorphic:Entity;polymorphicValue:place"`
-	FavoritedBy      []*User         `gorm:"many2many:user_favorites;"`
+	Area             *Area               `gorm:"foreignKey:AreaID"`
+	TimeSlotConfig   *TimeSlotConfig     `gorm:"foreignKey:PlaceID;constraint:OnDelete:CASCADE"`
+	Constraints      *BookingConstraints `gorm:"polymorphic:Entity;polymorphicValue:place"`
+	Equipment        []*Equipment        `gorm:"many2many:place_equipment;"`
+	WhitelistedUsers []*User             `gorm:"many2many:place_whitelist;"`
+	Reservations     []*Reservation      `gorm:"foreignKey:PlaceID;constraint:OnDelete:CASCADE"`
+	Blockings        []*Blocking         `gorm:"polymorphic:Entity;polymorphicValue:place"`
+	FavoritedBy      []*User             `gorm:"many2many:user_favorites;"`
 }
 
 func (Place) TableName() string { return "places" }
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type RefreshToken struct {
+	ID           uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	UserID       uuid.UUID  `gorm:"type:uuid;not null;index"`
+	FamilyID     uuid.UUID  `gorm:"type:uuid;not null;index"` // Shared by all tokens rotated from the same login
+	TokenHash    string     `gorm:"not null;uniqueIndex;size:64"`
+	ExpiresAt    time.Time  `gorm:"not null"`
+	RevokedAt    *time.Time `gorm:"type:timestamp"`
+	ReplacedByID *uuid.UUID `gorm:"type:uuid"`
+	CreatedAt    time.Time  `gorm:"not null;default:now()"`
+
+	// Relations
+	User *User `gorm:"foreignKey:UserID"`
+}
+
+func (RefreshToken) TableName() string { return "refresh_tokens" }
+
+func (m *RefreshToken) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+	return nil
+}
+
+func (m *RefreshToken) Exists() bool {
+	return m != nil && m.ID != uuid.Nil
+}
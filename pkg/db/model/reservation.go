@@ -28,6 +28,8 @@ type Reservation struct {
 	CancelTime       *time.Time        `gorm:"type:timestamp"`
 	IsRecurring      bool              `gorm:"not null;default:false"`
 	RecurringGroupID *uuid.UUID        `gorm:"type:uuid;index"`
+	RemindedAt       *time.Time        `gorm:"type:timestamp"`
+	CheckInWarnedAt  *time.Time        `gorm:"type:timestamp"`
 	CreatedAt        time.Time         `gorm:"not null;default:now()"`
 	UpdatedAt        time.Time         `gorm:"not null"`
 
@@ -48,3 +50,17 @@ func (m *Reservation) BeforeCreate(tx *gorm.DB) error {
 func (m *Reservation) Exists() bool {
 	return m != nil && m.ID != uuid.Nil
 }
+
+// ReservationUsage sums up the active reservations of a user that start in
+// the same day, week, month and year as a reservation, either calendar
+// aligned or as rolling windows around its start.
+type ReservationUsage struct {
+	DayCount     int64
+	DayMinutes   int64
+	WeekCount    int64
+	WeekMinutes  int64
+	MonthCount   int64
+	MonthMinutes int64
+	YearCount    int64
+	YearMinutes  int64
+}
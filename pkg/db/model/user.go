@@ -13,8 +13,8 @@ type User struct {
 	Username       string    `gorm:"not null;size:100"`
 	Name           string    `gorm:"not null;size:255"`
 	ProfilePicture *string   `gorm:"size:512"`
-	OAuthProvider  string    `gorm:"not null;size:50;default:'gitlab'"`
-	OAuthID        string    `gorm:"not null;size:255;index"`
+	OAuthProvider  string    `gorm:"column:oauth_provider;not null;size:50;default:'gitlab'"`
+	OAuthID        string    `gorm:"column:oauth_id;not null;size:255;index"`
 	IsActive       bool      `gorm:"not null;default:true"`
 	CreatedAt      time.Time `gorm:"not null;default:now()"`
 	UpdatedAt      time.Time `gorm:"not null"`
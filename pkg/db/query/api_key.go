@@ -18,12 +18,18 @@ type APIKeyQuery interface {
 	// SELECT * FROM @@table WHERE user_id = @userID ORDER BY created_at DESC
 	ListByUser(ctx context.Context, userID uuid.UUID) ([]*model.APIKey, error)
 
-	// INSERT INTO @@table (id, user_id, name, value, expires_at, created_at)
-	// VALUES (@id, @userID, @name, @value, @expiresAt, NOW())
+	// INSERT INTO @@table (id, user_id, name, value, expires_at, created_at, updated_at)
+	// VALUES (@id, @userID, @name, @value, @expiresAt, NOW(), NOW())
 	Insert(ctx context.Context, id uuid.UUID, userID uuid.UUID, name string, value string, expiresAt *time.Time) error
 
-	// UPDATE @@table SET last_used_at = NOW() WHERE id = @id
-	UpdateLastUsed(ctx context.Context, id uuid.UUID) error
+	// UPDATE @@table AS k SET last_used_at = u.used_at
+	// FROM (VALUES
+	//   {{for i, usage := range usages}}
+	//     {{if i > 0}} , {{end}} (CAST(@usage.ID AS uuid), CAST(@usage.UsedAt AS timestamptz))
+	//   {{end}}
+	// ) AS u(id, used_at)
+	// WHERE k.id = u.id AND (k.last_used_at IS NULL OR k.last_used_at < u.used_at)
+	UpdateLastUsed(ctx context.Context, usages []model.APIKeyUsage) error
 
 	// DELETE FROM @@table WHERE id = @id AND user_id = @userID
 	Remove(ctx context.Context, id uuid.UUID, userID uuid.UUID) error
@@ -30,21 +30,22 @@ type AreaQuery interface {
 	// SELECT * FROM @@table WHERE building_id = @buildingID ORDER BY created_at DESC
 	ListByBuilding(ctx context.Context, buildingID uuid.UUID) ([]*model.Area, error)
 
-	// INSERT INTO @@table (id, building_id, name, description, room_plan_image, created_at, updated_at)
-	// VALUES (@id, @buildingID, @name, @description, @roomPlanImage, NOW(), NOW())
-	Insert(ctx context.Context, id uuid.UUID, buildingID uuid.UUID, name string, description *string, roomPlanImage *string) error
+	// INSERT INTO @@table (id, building_id, name, description, location, room_plan_url, created_at, updated_at)
+	// VALUES (@id, @buildingID, @name, @description, @location, @roomPlanURL, NOW(), NOW())
+	Insert(ctx context.Context, id uuid.UUID, buildingID uuid.UUID, name string, description *string, location *string, roomPlanURL *string) error
 
 	// UPDATE @@table
 	// {{set}}
 	//   {{if name != nil}} name = @name, {{end}}
 	//   {{if description != nil}} description = @description, {{end}}
-	//   {{if roomPlanImage != nil}} room_plan_image = @roomPlanImage, {{end}}
+	//   {{if location != nil}} location = @location, {{end}}
+	//   {{if roomPlanURL != nil}} room_plan_url = @roomPlanURL, {{end}}
 	//   updated_at = NOW()
 	// {{end}}
 	// WHERE id = @id
-	Save(ctx context.Context, id uuid.UUID, name *string, description *string, roomPlanImage *string) error
+	Save(ctx context.Context, id uuid.UUID, name *string, description *string, location *string, roomPlanURL *string) error
 
-	// UPDATE @@table SET room_plan_image = NULL, updated_at = NOW() WHERE id = @id
+	// UPDATE @@table SET room_plan_url = NULL, updated_at = NOW() WHERE id = @id
 	ClearRoomPlan(ctx context.Context, id uuid.UUID) error
 
 	// DELETE FROM @@table WHERE id = @id
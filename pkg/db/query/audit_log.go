@@ -15,10 +15,10 @@ type AuditLogQuery interface {
 	//   {{if action != nil}} AND action = @action {{end}}
 	//   {{if entityType != nil}} AND entity_type = @entityType {{end}}
 	//   {{if entityID != nil}} AND entity_id = @entityID {{end}}
-	//   {{if startDate != nil}} AND created_at >= @startDate {{end}}
-	//   {{if endDate != nil}} AND created_at <= @endDate {{end}}
+	//   {{if startDate != nil}} AND "timestamp" >= @startDate {{end}}
+	//   {{if endDate != nil}} AND "timestamp" <= @endDate {{end}}
 	// {{end}}
-	// ORDER BY created_at DESC
+	// ORDER BY "timestamp" DESC
 	// LIMIT @limit OFFSET @offset
 	List(
 		ctx context.Context,
@@ -38,8 +38,8 @@ type AuditLogQuery interface {
 	//   {{if action != nil}} AND action = @action {{end}}
 	//   {{if entityType != nil}} AND entity_type = @entityType {{end}}
 	//   {{if entityID != nil}} AND entity_id = @entityID {{end}}
-	//   {{if startDate != nil}} AND created_at >= @startDate {{end}}
-	//   {{if endDate != nil}} AND created_at <= @endDate {{end}}
+	//   {{if startDate != nil}} AND "timestamp" >= @startDate {{end}}
+	//   {{if endDate != nil}} AND "timestamp" <= @endDate {{end}}
 	// {{end}}
 	CountAll(
 		ctx context.Context,
@@ -51,8 +51,8 @@ type AuditLogQuery interface {
 		endDate *time.Time,
 	) (int64, error)
 
-	// INSERT INTO @@table (id, user_id, action, entity_type, entity_id, old_value, new_value, ip_address, user_agent, created_at)
-	// VALUES (@id, @userID, @action, @entityType, @entityID, @oldValue, @newValue, @ipAddress, @userAgent, NOW())
+	// INSERT INTO @@table (id, user_id, action, entity_type, entity_id, changes, "timestamp")
+	// VALUES (@id, @userID, @action, @entityType, @entityID, @changes, NOW())
 	Insert(
 		ctx context.Context,
 		id uuid.UUID,
@@ -60,9 +60,6 @@ type AuditLogQuery interface {
 		action string,
 		entityType string,
 		entityID uuid.UUID,
-		oldValue *string,
-		newValue *string,
-		ipAddress *string,
-		userAgent *string,
+		changes *string,
 	) error
 }
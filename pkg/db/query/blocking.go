@@ -38,12 +38,28 @@ type BlockingQuery interface {
 	// ORDER BY start_time
 	ListInheritedForPlace(ctx context.Context, placeID uuid.UUID, areaID uuid.UUID, buildingID uuid.UUID) ([]*model.Blocking, error)
 
+	// SELECT * FROM @@table WHERE
+	// (entity_type = 'place' AND entity_id IN (SELECT id FROM places WHERE area_id = @areaID))
+	// OR (entity_type = 'area' AND entity_id = @areaID)
+	// OR (entity_type = 'building' AND entity_id = @buildingID)
+	// ORDER BY start_time
+	ListInheritedForArea(ctx context.Context, areaID uuid.UUID, buildingID uuid.UUID) ([]*model.Blocking, error)
+
+	// SELECT * FROM @@table WHERE
+	// (entity_type = 'building' AND entity_id = @buildingID)
+	// OR (entity_type = 'area' AND entity_id IN (SELECT id FROM areas WHERE building_id = @buildingID))
+	// OR (entity_type = 'place' AND entity_id IN (
+	//   SELECT p.id FROM places p JOIN areas a ON a.id = p.area_id WHERE a.building_id = @buildingID
+	// ))
+	// ORDER BY start_time
+	ListWithinBuilding(ctx context.Context, buildingID uuid.UUID) ([]*model.Blocking, error)
+
 	// INSERT INTO @@table (
-	//   id, entity_type, entity_id, blocking_type, start_time, end_time,
-	//   is_recurring, recurrence_pattern, recurrence_end, reason, created_by, created_at
+	//   id, entity_type, entity_id, blocking_type, name, reason, start_time, end_time,
+	//   is_recurring, recurrence_rule, recurrence_duration, recurrence_end, created_at, updated_at
 	// ) VALUES (
-	//   @id, @entityType, @entityID, @blockingType, @startTime, @endTime,
-	//   @isRecurring, @recurrencePattern, @recurrenceEnd, @reason, @createdBy, NOW()
+	//   @id, @entityType, @entityID, @blockingType, @name, @reason, @startTime, @endTime,
+	//   @isRecurring, @recurrenceRule, @recurrenceDuration, @recurrenceEnd, NOW(), NOW()
 	// )
 	Insert(
 		ctx context.Context,
@@ -51,13 +67,14 @@ type BlockingQuery interface {
 		entityType string,
 		entityID uuid.UUID,
 		blockingType string,
+		name *string,
+		reason *string,
 		startTime time.Time,
 		endTime time.Time,
 		isRecurring bool,
-		recurrencePattern *string,
+		recurrenceRule *string,
+		recurrenceDuration *int64,
 		recurrenceEnd *time.Time,
-		reason *string,
-		createdBy *uuid.UUID,
 	) error
 
 	// DELETE FROM @@table WHERE id = @id
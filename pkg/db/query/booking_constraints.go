@@ -0,0 +1,19 @@
+package query
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+)
+
+type BookingConstraintsQuery interface {
+	// SELECT * FROM @@table WHERE entity_type = @entityType AND entity_id = @entityID
+	GetByEntity(ctx context.Context, entityType string, entityID uuid.UUID) (*model.BookingConstraints, error)
+
+	// SELECT * FROM @@table WHERE
+	// (entity_type = 'place' AND entity_id = @placeID)
+	// OR (entity_type = 'area' AND entity_id = @areaID)
+	// OR (entity_type = 'building' AND entity_id = @buildingID)
+	ListInheritedForPlace(ctx context.Context, placeID uuid.UUID, areaID uuid.UUID, buildingID uuid.UUID) ([]*model.BookingConstraints, error)
+}
@@ -25,20 +25,19 @@ type BuildingQuery interface {
 	// {{end}}
 	CountAll(ctx context.Context, search *string) (int64, error)
 
-	// INSERT INTO @@table (id, name, description, location, opening_hours, created_at, updated_at)
-	// VALUES (@id, @name, @description, @location, @openingHours, NOW(), NOW())
-	Insert(ctx context.Context, id uuid.UUID, name string, description *string, location *string, openingHours *string) error
+	// INSERT INTO @@table (id, name, description, location, created_at, updated_at)
+	// VALUES (@id, @name, @description, @location, NOW(), NOW())
+	Insert(ctx context.Context, id uuid.UUID, name string, description *string, location *string) error
 
 	// UPDATE @@table
 	// {{set}}
 	//   {{if name != nil}} name = @name, {{end}}
 	//   {{if description != nil}} description = @description, {{end}}
 	//   {{if location != nil}} location = @location, {{end}}
-	//   {{if openingHours != nil}} opening_hours = @openingHours, {{end}}
 	//   updated_at = NOW()
 	// {{end}}
 	// WHERE id = @id
-	Save(ctx context.Context, id uuid.UUID, name *string, description *string, location *string, openingHours *string) error
+	Save(ctx context.Context, id uuid.UUID, name *string, description *string, location *string) error
 
 	// DELETE FROM @@table WHERE id = @id
 	Remove(ctx context.Context, id uuid.UUID) error
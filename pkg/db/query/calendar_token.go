@@ -0,0 +1,28 @@
+package query
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+)
+
+type CalendarTokenQuery interface {
+	// SELECT * FROM @@table WHERE user_id = @userID
+	GetByUser(ctx context.Context, userID uuid.UUID) (*model.CalendarToken, error)
+
+	// SELECT * FROM @@table WHERE token_hash = @tokenHash
+	GetByHash(ctx context.Context, tokenHash string) (*model.CalendarToken, error)
+
+	// INSERT INTO @@table (user_id, token_hash, created_at)
+	// VALUES (@userID, @tokenHash, NOW())
+	// ON CONFLICT (user_id) DO UPDATE
+	// SET token_hash = EXCLUDED.token_hash, last_used_at = NULL, created_at = NOW()
+	Upsert(ctx context.Context, userID uuid.UUID, tokenHash string) error
+
+	// UPDATE @@table SET last_used_at = NOW() WHERE user_id = @userID
+	MarkUsed(ctx context.Context, userID uuid.UUID) error
+
+	// DELETE FROM @@table WHERE user_id = @userID
+	DeleteByUser(ctx context.Context, userID uuid.UUID) error
+}
@@ -0,0 +1,29 @@
+package query
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+)
+
+type ConstraintProfileQuery interface {
+	// SELECT * FROM @@table WHERE id = @id AND group_id = @groupID
+	GetByGroupAndID(ctx context.Context, groupID uuid.UUID, id uuid.UUID) (*model.ConstraintProfile, error)
+
+	// SELECT * FROM @@table WHERE group_id = @groupID ORDER BY name
+	ListByGroup(ctx context.Context, groupID uuid.UUID) ([]*model.ConstraintProfile, error)
+
+	// SELECT cp.* FROM @@table cp
+	// JOIN user_groups ug ON ug.group_id = cp.group_id
+	// WHERE ug.user_id = @userID AND (
+	//   cp.scope_type IS NULL
+	//   OR (cp.scope_type = 'area' AND cp.scope_id = @areaID)
+	//   OR (cp.scope_type = 'building' AND cp.scope_id = @buildingID)
+	// )
+	// ORDER BY cp.name, cp.id
+	ListForUser(ctx context.Context, userID uuid.UUID, areaID uuid.UUID, buildingID uuid.UUID) ([]*model.ConstraintProfile, error)
+
+	// DELETE FROM @@table WHERE id = @id
+	Remove(ctx context.Context, id uuid.UUID) error
+}
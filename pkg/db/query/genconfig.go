@@ -18,12 +18,19 @@ var _ = genconfig.Config{
 		PlaceQuery(nil),
 		PlaceMarkerQuery(nil),
 		BlockingQuery(nil),
+		BookingConstraintsQuery(nil),
 		ReservationQuery(nil),
 		UserQuery(nil),
 		GroupQuery(nil),
+		GroupPermissionQuery(nil),
+		ConstraintProfileQuery(nil),
 		EquipmentQuery(nil),
 		APIKeyQuery(nil),
 		QRTemplateQuery(nil),
 		AuditLogQuery(nil),
+		OAuthStateQuery(nil),
+		RefreshTokenQuery(nil),
+		CalendarTokenQuery(nil),
+		NotificationQuery(nil),
 	},
 }
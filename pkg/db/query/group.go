@@ -17,6 +17,12 @@ type GroupQuery interface {
 	// SELECT * FROM @@table WHERE is_default = true
 	ListDefaultGroups(ctx context.Context) ([]*model.Group, error)
 
+	// SELECT g.* FROM @@table g
+	// JOIN user_groups ug ON ug.group_id = g.id
+	// WHERE ug.user_id = @userID
+	// ORDER BY g.name
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*model.Group, error)
+
 	// SELECT * FROM @@table WHERE is_system = true
 	GetSystemGroup(ctx context.Context) (*model.Group, error)
 
@@ -36,6 +42,10 @@ type GroupQuery interface {
 	// UPDATE @@table SET is_default = @isDefault, updated_at = NOW() WHERE id = @id
 	SetDefault(ctx context.Context, id uuid.UUID, isDefault bool) error
 
+	// INSERT INTO user_groups (user_id, group_id) VALUES (@userID, @groupID)
+	// ON CONFLICT DO NOTHING
+	AddUser(ctx context.Context, groupID uuid.UUID, userID uuid.UUID) error
+
 	// DELETE FROM @@table WHERE id = @id AND is_system = false
 	Remove(ctx context.Context, id uuid.UUID) error
 }
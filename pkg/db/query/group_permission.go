@@ -0,0 +1,27 @@
+package query
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+)
+
+type GroupPermissionQuery interface {
+	// SELECT * FROM @@table WHERE group_id = @groupID ORDER BY permission, scope_type, scope_id
+	ListByGroup(ctx context.Context, groupID uuid.UUID) ([]*model.GroupPermission, error)
+
+	// SELECT gp.* FROM @@table gp
+	// JOIN user_groups ug ON ug.group_id = gp.group_id
+	// WHERE ug.user_id = @userID
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*model.GroupPermission, error)
+
+	// INSERT INTO @@table (id, group_id, permission, scope_type, scope_id)
+	// VALUES (@id, @groupID, @permission, @scopeType, @scopeID)
+	// ON CONFLICT DO NOTHING
+	Insert(ctx context.Context, id uuid.UUID, groupID uuid.UUID, permission string, scopeType *string, scopeID *uuid.UUID) error
+
+	// DELETE FROM @@table WHERE group_id = @groupID AND permission IN @permissions
+	// {{if scopeType != nil}} AND scope_type = @scopeType AND scope_id = @scopeID {{else}} AND scope_type IS NULL {{end}}
+	Remove(ctx context.Context, groupID uuid.UUID, permissions []string, scopeType *string, scopeID *uuid.UUID) error
+}
@@ -0,0 +1,32 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+)
+
+type NotificationQuery interface {
+	// INSERT INTO @@table (id, user_id, kind, recipient, payload, status, attempts, next_attempt_at, created_at, updated_at)
+	// VALUES (@id, @userID, @kind, @recipient, CAST(@payload AS jsonb), 'pending', 0, NOW(), NOW(), NOW())
+	Insert(ctx context.Context, id uuid.UUID, userID uuid.UUID, kind string, recipient string, payload string) error
+
+	// SELECT * FROM @@table
+	// WHERE status = 'pending' AND next_attempt_at <= NOW()
+	// ORDER BY next_attempt_at
+	// LIMIT 1
+	// FOR UPDATE SKIP LOCKED
+	ClaimNext(ctx context.Context) (*model.Notification, error)
+
+	// UPDATE @@table
+	// SET status = 'sent', attempts = attempts + 1, last_error = NULL, sent_at = NOW(), updated_at = NOW()
+	// WHERE id = @id
+	MarkSent(ctx context.Context, id uuid.UUID) error
+
+	// UPDATE @@table
+	// SET status = @status, attempts = attempts + 1, next_attempt_at = @nextAttemptAt, last_error = @lastError, updated_at = NOW()
+	// WHERE id = @id
+	MarkFailed(ctx context.Context, id uuid.UUID, status string, nextAttemptAt time.Time, lastError string) error
+}
@@ -0,0 +1,20 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+)
+
+type OAuthStateQuery interface {
+	// INSERT INTO @@table (nonce, code_verifier, expires_at, created_at)
+	// VALUES (@nonce, @codeVerifier, @expiresAt, NOW())
+	Insert(ctx context.Context, nonce string, codeVerifier string, expiresAt time.Time) error
+
+	// DELETE FROM @@table WHERE nonce = @nonce AND expires_at > NOW() RETURNING *
+	Consume(ctx context.Context, nonce string) (*model.OAuthState, error)
+
+	// DELETE FROM @@table WHERE expires_at <= NOW()
+	DeleteExpired(ctx context.Context) error
+}
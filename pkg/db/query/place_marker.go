@@ -14,11 +14,11 @@ type PlaceMarkerQuery interface {
 	// SELECT * FROM @@table WHERE area_id = @areaID ORDER BY created_at
 	ListByArea(ctx context.Context, areaID uuid.UUID) ([]*model.PlaceMarker, error)
 
-	// INSERT INTO @@table (id, area_id, place_id, x, y, width, height, created_at)
-	// VALUES (@id, @areaID, @placeID, @x, @y, @width, @height, NOW())
-	Insert(ctx context.Context, id uuid.UUID, areaID uuid.UUID, placeID uuid.UUID, x float64, y float64, width float64, height float64) error
+	// INSERT INTO @@table (id, area_id, place_id, x, y, width, height, shape, created_at, updated_at)
+	// VALUES (@id, @areaID, @placeID, @x, @y, @width, @height, @shape, NOW(), NOW())
+	Insert(ctx context.Context, id uuid.UUID, areaID uuid.UUID, placeID uuid.UUID, x float64, y float64, width float64, height float64, shape string) error
 
-	// UPDATE @@table SET x = @x, y = @y, width = @width, height = @height WHERE id = @id
+	// UPDATE @@table SET x = @x, y = @y, width = @width, height = @height, updated_at = NOW() WHERE id = @id
 	Save(ctx context.Context, id uuid.UUID, x float64, y float64, width float64, height float64) error
 
 	// DELETE FROM @@table WHERE id = @id
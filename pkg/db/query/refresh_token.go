@@ -0,0 +1,31 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+)
+
+type RefreshTokenQuery interface {
+	// SELECT * FROM @@table WHERE token_hash = @tokenHash FOR UPDATE
+	GetByHashForUpdate(ctx context.Context, tokenHash string) (*model.RefreshToken, error)
+
+	// INSERT INTO @@table (id, user_id, family_id, token_hash, expires_at, created_at)
+	// VALUES (@id, @userID, @familyID, @tokenHash, @expiresAt, NOW())
+	Insert(ctx context.Context, id uuid.UUID, userID uuid.UUID, familyID uuid.UUID, tokenHash string, expiresAt time.Time) error
+
+	// UPDATE @@table SET revoked_at = NOW(), replaced_by_id = @replacedByID
+	// WHERE id = @id AND revoked_at IS NULL
+	Revoke(ctx context.Context, id uuid.UUID, replacedByID *uuid.UUID) error
+
+	// UPDATE @@table SET revoked_at = NOW() WHERE family_id = @familyID AND revoked_at IS NULL
+	RevokeFamily(ctx context.Context, familyID uuid.UUID) error
+
+	// UPDATE @@table SET revoked_at = NOW() WHERE user_id = @userID AND revoked_at IS NULL
+	RevokeAllForUser(ctx context.Context, userID uuid.UUID) error
+
+	// DELETE FROM @@table WHERE expires_at <= NOW()
+	DeleteExpired(ctx context.Context) error
+}
@@ -50,12 +50,158 @@ type ReservationQuery interface {
 		endBefore *time.Time,
 	) (int64, error)
 
+	// SELECT * FROM @@table WHERE id IN @ids ORDER BY start_time
+	ListByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.Reservation, error)
+
+	// SELECT * FROM @@table WHERE id = @id FOR UPDATE
+	GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*model.Reservation, error)
+
+	// SELECT * FROM @@table
+	// WHERE recurring_group_id = @groupID AND status IN ('pending', 'confirmed')
+	//   AND start_time >= @startAfter
+	// ORDER BY start_time
+	// FOR UPDATE
+	ListSeriesForUpdate(ctx context.Context, groupID uuid.UUID, startAfter time.Time) ([]*model.Reservation, error)
+
 	// SELECT * FROM @@table WHERE user_id = @userID ORDER BY start_time DESC
 	ListByUser(ctx context.Context, userID uuid.UUID) ([]*model.Reservation, error)
 
 	// SELECT * FROM @@table WHERE place_id = @placeID AND start_time >= @startTime AND end_time <= @endTime ORDER BY start_time
 	ListByPlaceAndTimeRange(ctx context.Context, placeID uuid.UUID, startTime time.Time, endTime time.Time) ([]*model.Reservation, error)
 
+	// SELECT * FROM @@table
+	// WHERE place_id = @placeID AND status <> 'cancelled'
+	//   AND start_time < @endTime AND end_time > @startTime
+	//   {{if len(excludeIDs) > 0}} AND id NOT IN @excludeIDs {{end}}
+	// ORDER BY start_time
+	ListOverlapping(ctx context.Context, placeID uuid.UUID, startTime time.Time, endTime time.Time, excludeIDs []uuid.UUID) ([]*model.Reservation, error)
+
+	// SELECT
+	//   COUNT(*) FILTER (WHERE u.same_day) AS day_count,
+	//   CAST(COALESCE(SUM(u.minutes) FILTER (WHERE u.same_day), 0) AS bigint) AS day_minutes,
+	//   COUNT(*) FILTER (WHERE u.same_week) AS week_count,
+	//   CAST(COALESCE(SUM(u.minutes) FILTER (WHERE u.same_week), 0) AS bigint) AS week_minutes,
+	//   COUNT(*) FILTER (WHERE u.same_month) AS month_count,
+	//   CAST(COALESCE(SUM(u.minutes) FILTER (WHERE u.same_month), 0) AS bigint) AS month_minutes,
+	//   COUNT(*) FILTER (WHERE u.same_year) AS year_count,
+	//   CAST(COALESCE(SUM(u.minutes) FILTER (WHERE u.same_year), 0) AS bigint) AS year_minutes
+	// FROM (
+	//   SELECT
+	//     EXTRACT(EPOCH FROM r.end_time - r.start_time) / 60 AS minutes,
+	//     date_trunc('day', r.start_time AT TIME ZONE @timezone) = date_trunc('day', CAST(@startTime AS timestamptz) AT TIME ZONE @timezone) AS same_day,
+	//     date_trunc('week', r.start_time AT TIME ZONE @timezone) = date_trunc('week', CAST(@startTime AS timestamptz) AT TIME ZONE @timezone) AS same_week,
+	//     date_trunc('month', r.start_time AT TIME ZONE @timezone) = date_trunc('month', CAST(@startTime AS timestamptz) AT TIME ZONE @timezone) AS same_month,
+	//     date_trunc('year', r.start_time AT TIME ZONE @timezone) = date_trunc('year', CAST(@startTime AS timestamptz) AT TIME ZONE @timezone) AS same_year
+	//   FROM @@table r
+	//   JOIN places p ON p.id = r.place_id
+	//   JOIN areas a ON a.id = p.area_id
+	//   WHERE r.user_id = @userID AND r.status <> 'cancelled'
+	//     {{if scopeType == "place"}} AND r.place_id = @scopeID
+	//     {{else if scopeType == "area"}} AND p.area_id = @scopeID
+	//     {{else if scopeType == "building"}} AND a.building_id = @scopeID
+	//     {{end}}
+	//     {{if excludeID != nil}} AND r.id <> @excludeID {{end}}
+	// ) u
+	Usage(ctx context.Context, scopeType string, scopeID uuid.UUID, userID uuid.UUID, startTime time.Time, timezone string, excludeID *uuid.UUID) (*model.ReservationUsage, error)
+
+	// WITH r AS (
+	//   SELECT r.start_time, EXTRACT(EPOCH FROM r.end_time - r.start_time) / 60 AS minutes
+	//   FROM @@table r
+	//   JOIN places p ON p.id = r.place_id
+	//   JOIN areas a ON a.id = p.area_id
+	//   WHERE r.user_id = @userID AND r.status <> 'cancelled'
+	//     {{if scopeType == "place"}} AND r.place_id = @scopeID
+	//     {{else if scopeType == "area"}} AND p.area_id = @scopeID
+	//     {{else if scopeType == "building"}} AND a.building_id = @scopeID
+	//     {{end}}
+	//     AND r.start_time > CAST(@startTime AS timestamptz) - interval '1 year'
+	//     AND r.start_time < CAST(@startTime AS timestamptz) + interval '1 year'
+	//     {{if excludeID != nil}} AND r.id <> @excludeID {{end}}
+	// ),
+	// w AS (
+	//   SELECT p.period, COUNT(r.start_time) AS n, COALESCE(SUM(r.minutes), 0) AS minutes
+	//   FROM (VALUES ('day', interval '1 day'), ('week', interval '7 days'), ('month', interval '1 month'), ('year', interval '1 year')) AS p(period, length)
+	//   CROSS JOIN LATERAL (
+	//     SELECT CAST(@startTime AS timestamptz) AS start_time
+	//     UNION
+	//     SELECT c.start_time FROM r c
+	//     WHERE c.start_time > CAST(@startTime AS timestamptz) - p.length AND c.start_time <= @startTime
+	//   ) s
+	//   LEFT JOIN r ON r.start_time >= s.start_time AND r.start_time < s.start_time + p.length
+	//   GROUP BY p.period, s.start_time
+	// )
+	// SELECT
+	//   COALESCE(MAX(w.n) FILTER (WHERE w.period = 'day'), 0) AS day_count,
+	//   CAST(COALESCE(MAX(w.minutes) FILTER (WHERE w.period = 'day'), 0) AS bigint) AS day_minutes,
+	//   COALESCE(MAX(w.n) FILTER (WHERE w.period = 'week'), 0) AS week_count,
+	//   CAST(COALESCE(MAX(w.minutes) FILTER (WHERE w.period = 'week'), 0) AS bigint) AS week_minutes,
+	//   COALESCE(MAX(w.n) FILTER (WHERE w.period = 'month'), 0) AS month_count,
+	//   CAST(COALESCE(MAX(w.minutes) FILTER (WHERE w.period = 'month'), 0) AS bigint) AS month_minutes,
+	//   COALESCE(MAX(w.n) FILTER (WHERE w.period = 'year'), 0) AS year_count,
+	//   CAST(COALESCE(MAX(w.minutes) FILTER (WHERE w.period = 'year'), 0) AS bigint) AS year_minutes
+	// FROM w
+	RollingUsage(ctx context.Context, scopeType string, scopeID uuid.UUID, userID uuid.UUID, startTime time.Time, excludeID *uuid.UUID) (*model.ReservationUsage, error)
+
+	// SELECT COUNT(*) FROM @@table
+	// WHERE user_id = @userID AND status <> 'cancelled'
+	//   AND start_time < @endTime AND end_time > @startTime
+	//   {{if excludeID != nil}} AND id <> @excludeID {{end}}
+	CountOverlappingByUser(ctx context.Context, userID uuid.UUID, startTime time.Time, endTime time.Time, excludeID *uuid.UUID) (int64, error)
+
+	// SELECT pg_advisory_xact_lock(hashtextextended('reservations:' || CAST(@userID AS text), 0))
+	LockUser(ctx context.Context, userID uuid.UUID) error
+
+	// SELECT r.* FROM @@table r
+	// JOIN places p ON p.id = r.place_id
+	// WHERE p.area_id = @areaID AND r.status <> 'cancelled'
+	//   AND r.start_time < @endTime AND r.end_time > @startTime
+	// ORDER BY r.start_time
+	ListOverlappingInArea(ctx context.Context, areaID uuid.UUID, startTime time.Time, endTime time.Time) ([]*model.Reservation, error)
+
+	// SELECT r.* FROM @@table r
+	// JOIN places p ON p.id = r.place_id
+	// JOIN areas a ON a.id = p.area_id
+	// WHERE a.building_id = @buildingID AND r.status <> 'cancelled'
+	//   AND r.start_time < @endTime AND r.end_time > @startTime
+	// ORDER BY r.start_time
+	ListOverlappingInBuilding(ctx context.Context, buildingID uuid.UUID, startTime time.Time, endTime time.Time) ([]*model.Reservation, error)
+
+	// SELECT r.* FROM @@table r
+	// JOIN users u ON u.id = r.user_id
+	// WHERE r.status IN ('pending', 'confirmed') AND r.reminded_at IS NULL
+	//   AND u.is_active AND u.notify_reservation_reminder
+	//   AND r.start_time > NOW()
+	//   AND r.start_time <= NOW() + make_interval(mins => u.reminder_minutes_before)
+	//   AND r.created_at <= r.start_time - make_interval(mins => u.reminder_minutes_before)
+	// ORDER BY r.start_time
+	// LIMIT @limit
+	// FOR UPDATE OF r SKIP LOCKED
+	ListDueReminders(ctx context.Context, limit int) ([]*model.Reservation, error)
+
+	// UPDATE @@table SET reminded_at = NOW() WHERE id IN @ids
+	MarkReminded(ctx context.Context, ids []uuid.UUID) error
+
+	// SELECT r.* FROM @@table r
+	// JOIN places p ON p.id = r.place_id
+	// JOIN areas a ON a.id = p.area_id
+	// LEFT JOIN booking_constraints pc ON pc.entity_type = 'place' AND pc.entity_id = p.id
+	// LEFT JOIN booking_constraints ac ON ac.entity_type = 'area' AND ac.entity_id = a.id
+	// LEFT JOIN booking_constraints bc ON bc.entity_type = 'building' AND bc.entity_id = a.building_id
+	// WHERE r.status IN ('pending', 'confirmed')
+	//   AND r.start_time <= NOW() AND r.end_time > NOW()
+	//   AND r.start_time + make_interval(mins => LEAST(
+	//     CASE WHEN p.requires_check_in THEN COALESCE(pc.check_in_timeout_minutes, ac.check_in_timeout_minutes, bc.check_in_timeout_minutes) END,
+	//     COALESCE(pc.decay_timeout_minutes, ac.decay_timeout_minutes, bc.decay_timeout_minutes)
+	//   )) {{if released}} <= {{else}} > {{end}} NOW()
+	//   {{if !released}} AND r.check_in_warned_at IS NULL {{end}}
+	// ORDER BY r.start_time
+	// LIMIT @limit
+	// FOR UPDATE OF r SKIP LOCKED
+	ListNotCheckedIn(ctx context.Context, released bool, limit int) ([]*model.Reservation, error)
+
+	// UPDATE @@table SET check_in_warned_at = NOW() WHERE id IN @ids
+	MarkCheckInWarned(ctx context.Context, ids []uuid.UUID) error
+
 	// INSERT INTO @@table (
 	//   id, place_id, user_id, start_time, end_time, status, is_recurring,
 	//   recurring_group_id, created_at, updated_at
@@ -77,7 +223,11 @@ type ReservationQuery interface {
 
 	// UPDATE @@table
 	// {{set}}
-	//   {{if startTime != nil}} start_time = @startTime, {{end}}
+	//   {{if startTime != nil}}
+	//     start_time = @startTime,
+	//     reminded_at = CASE WHEN start_time = @startTime THEN reminded_at END,
+	//     check_in_warned_at = CASE WHEN start_time = @startTime THEN check_in_warned_at END,
+	//   {{end}}
 	//   {{if endTime != nil}} end_time = @endTime, {{end}}
 	//   updated_at = NOW()
 	// {{end}}
@@ -87,9 +237,12 @@ type ReservationQuery interface {
 	// UPDATE @@table SET status = @status, updated_at = NOW() WHERE id = @id
 	UpdateStatus(ctx context.Context, id uuid.UUID, status string) error
 
-	// UPDATE @@table SET status = 'cancelled', cancel_reason = @reason, updated_at = NOW() WHERE id = @id
+	// UPDATE @@table SET status = 'cancelled', cancel_reason = @reason, cancel_time = NOW(), updated_at = NOW() WHERE id = @id
 	Cancel(ctx context.Context, id uuid.UUID, reason *string) error
 
+	// UPDATE @@table SET status = 'cancelled', cancel_reason = @reason, cancel_time = NOW(), updated_at = NOW() WHERE id IN @ids
+	CancelMany(ctx context.Context, ids []uuid.UUID, reason *string) error
+
 	// UPDATE @@table SET status = 'checkedIn', check_in_time = NOW(), updated_at = NOW() WHERE id = @id
 	CheckIn(ctx context.Context, id uuid.UUID) error
 
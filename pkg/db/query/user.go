@@ -11,7 +11,7 @@ type UserQuery interface {
 	// SELECT * FROM @@table WHERE id = @id
 	GetByID(ctx context.Context, id uuid.UUID) (*model.User, error)
 
-	// SELECT * FROM @@table WHERE email = @email
+	// SELECT * FROM @@table WHERE email = @email AND email <> ''
 	GetByEmail(ctx context.Context, email string) (*model.User, error)
 
 	// SELECT * FROM @@table WHERE oauth_provider = @provider AND oauth_id = @oauthID
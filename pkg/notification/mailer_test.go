@@ -0,0 +1,185 @@
+package notification
+
+import (
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/pixlcrashr/roomy/pkg/cfg"
+)
+
+// received is a message as accepted by the fake SMTP server.
+type received struct {
+	From string
+	To   []string
+	Data string
+}
+
+// fakeSMTP is a minimal SMTP server without TLS and authentication. It
+// accepts every message unless told to reject recipients.
+type fakeSMTP struct {
+	listener net.Listener
+
+	mu       sync.Mutex
+	reject   string // reply to RCPT TO, e.g. "451 4.3.0 try again later"
+	messages []received
+}
+
+func newFakeSMTP(t *testing.T) *fakeSMTP {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &fakeSMTP{listener: listener}
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+// config returns the mail configuration pointing at the server.
+func (s *fakeSMTP) config() cfg.MailConfig {
+	host, port, _ := net.SplitHostPort(s.listener.Addr().String())
+	p, _ := strconv.Atoi(port)
+	return cfg.MailConfig{Host: host, Port: p, Security: SecurityNone}
+}
+
+// rejectRecipients makes the server answer RCPT TO with reply; an empty
+// reply accepts recipients again.
+func (s *fakeSMTP) rejectRecipients(reply string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reject = reply
+}
+
+func (s *fakeSMTP) received() []received {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]received(nil), s.messages...)
+}
+
+func (s *fakeSMTP) serve(conn net.Conn) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	reply := func(line string) { _ = tp.PrintfLine("%s", line) }
+
+	reply("220 fake ESMTP")
+	var msg received
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			reply("250 fake")
+		case "MAIL":
+			msg = received{From: address(arg, "FROM:")}
+			reply("250 OK")
+		case "RCPT":
+			s.mu.Lock()
+			reject := s.reject
+			s.mu.Unlock()
+			if reject != "" {
+				reply(reject)
+				continue
+			}
+			msg.To = append(msg.To, address(arg, "TO:"))
+			reply("250 OK")
+		case "DATA":
+			reply("354 go ahead")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			msg.Data = string(data)
+			s.mu.Lock()
+			s.messages = append(s.messages, msg)
+			s.mu.Unlock()
+			reply("250 queued")
+		case "RSET", "NOOP":
+			reply("250 OK")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+// address returns the path of a MAIL FROM or RCPT TO argument.
+func address(arg, prefix string) string {
+	path, _, _ := strings.Cut(strings.TrimPrefix(arg, prefix), " ")
+	return strings.Trim(path, "<>")
+}
+
+func TestMailerSend(t *testing.T) {
+	msg := &Message{
+		From: "roomy@example.com",
+		To:   "jdoe@example.com",
+		Data: []byte("Subject: Reservation confirmed\r\n\r\nSee you there.\r\n"),
+	}
+
+	tests := []struct {
+		name   string
+		reject string
+		closed bool
+		// wantErr is part of the expected error, "*" for any error.
+		wantErr string
+	}{
+		{name: "delivered"},
+		{name: "recipient rejected", reject: "550 5.1.1 no such user", wantErr: "550"},
+		{name: "temporarily rejected", reject: "451 4.3.0 try again later", wantErr: "451"},
+		{name: "server unreachable", closed: true, wantErr: "*"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeSMTP(t)
+			server.rejectRecipients(tt.reject)
+			if tt.closed {
+				server.listener.Close()
+			}
+			mailer, err := NewMailer(server.config())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = mailer.Send(t.Context(), msg)
+			if tt.wantErr != "" {
+				if err == nil || tt.wantErr != "*" && !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want %q", err, tt.wantErr)
+				}
+				if got := server.received(); len(got) != 0 {
+					t.Fatalf("received %d messages", len(got))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := server.received()
+			if len(got) != 1 {
+				t.Fatalf("received %d messages, want 1", len(got))
+			}
+			if got[0].From != msg.From || len(got[0].To) != 1 || got[0].To[0] != msg.To {
+				t.Fatalf("envelope = %s → %v", got[0].From, got[0].To)
+			}
+			if !strings.Contains(got[0].Data, "Subject: Reservation confirmed") || !strings.Contains(got[0].Data, "See you there.") {
+				t.Fatalf("data = %q", got[0].Data)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package notification
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pixlcrashr/roomy/pkg/calendar"
+)
+
+// Invitations returns the calendar objects attached to a notification, one
+// per reservation. They request the reservations to be added to, or for
+// cancellations removed from, the recipient's calendar.
+func Invitations(kind Kind, payload *Payload, organizer, attendee string, loc *time.Location) []*calendar.Calendar {
+	method, status := calendar.MethodRequest, calendar.StatusConfirmed
+	if kind == KindReservationCancelled {
+		method, status = calendar.MethodCancel, calendar.StatusCancelled
+	}
+
+	var location, description []string
+	for _, name := range []string{payload.Place, payload.Area, payload.Building} {
+		if name != "" {
+			location = append(location, name)
+		}
+	}
+	if payload.RequiresCheckIn {
+		description = append(description, "Check-in required.")
+	}
+	if kind == KindReservationCancelled && payload.Reason != "" {
+		description = append(description, "Cancelled: "+payload.Reason)
+	}
+
+	invitations := make([]*calendar.Calendar, len(payload.Reservations))
+	for i, r := range payload.Reservations {
+		invitations[i] = &calendar.Calendar{Method: method, Location: loc, Events: []calendar.Event{{
+			// The UID matches the personal feed, so that both end up as the
+			// same event in the recipient's calendar.
+			UID:         fmt.Sprintf("reservation-%s@roomy", r.ID),
+			Summary:     "Reservation: " + payload.Place,
+			Description: strings.Join(description, "\n"),
+			Location:    strings.Join(location, ", "),
+			Categories:  []string{"reservation"},
+			Status:      status,
+			Start:       r.Start,
+			End:         r.End,
+			Modified:    sequenceEpoch.Add(time.Duration(r.Sequence) * time.Second),
+			Sequence:    r.Sequence,
+			Organizer:   organizer,
+			Attendee:    attendee,
+		}}}
+	}
+	return invitations
+}
+
+// Message is a mail ready to be sent.
+type Message struct {
+	From string
+	To   string
+	Data []byte
+}
+
+// NewMessage composes the MIME message of a notification. The body is sent
+// together with the invitation as alternatives, as calendar clients expect,
+// and the invitation is attached as an .ics file as well. iTIP requires one
+// event per calendar object, so notifications about several reservations
+// carry one attachment each instead of an alternative.
+func NewMessage(id uuid.UUID, from *mail.Address, to string, rendered *Rendered, invitations []*calendar.Calendar) (*Message, error) {
+	var buf bytes.Buffer
+	header := func(name, value string) {
+		fmt.Fprintf(&buf, "%s: %s\r\n", name, value)
+	}
+
+	domain := "roomy"
+	if i := strings.LastIndex(from.Address, "@"); i >= 0 {
+		domain = from.Address[i+1:]
+	}
+
+	mixed := multipart.NewWriter(&buf)
+	header("From", from.String())
+	header("To", to)
+	header("Subject", mime.QEncoding.Encode("utf-8", rendered.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("Message-ID", fmt.Sprintf("<%s@%s>", id, domain))
+	header("MIME-Version", "1.0")
+	header("Content-Type", `multipart/mixed; boundary="`+mixed.Boundary()+`"`)
+	buf.WriteString("\r\n")
+
+	var alternative bytes.Buffer
+	alternatives := multipart.NewWriter(&alternative)
+	if err := writeText(alternatives, rendered.Body); err != nil {
+		return nil, err
+	}
+	if len(invitations) == 1 {
+		if err := writeCalendar(alternatives, invitations[0], ""); err != nil {
+			return nil, err
+		}
+	}
+	if err := alternatives.Close(); err != nil {
+		return nil, err
+	}
+	part, err := mixed.CreatePart(textproto.MIMEHeader{
+		"Content-Type": {`multipart/alternative; boundary="` + alternatives.Boundary() + `"`},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := part.Write(alternative.Bytes()); err != nil {
+		return nil, err
+	}
+
+	for i, invitation := range invitations {
+		filename := "invite.ics"
+		if len(invitations) > 1 {
+			filename = fmt.Sprintf("invite-%d.ics", i+1)
+		}
+		if err := writeCalendar(mixed, invitation, filename); err != nil {
+			return nil, err
+		}
+	}
+	if err := mixed.Close(); err != nil {
+		return nil, err
+	}
+
+	return &Message{From: from.Address, To: to, Data: buf.Bytes()}, nil
+}
+
+func writeText(w *multipart.Writer, body string) error {
+	part, err := w.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {"text/plain; charset=utf-8"},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return err
+	}
+	qp := quotedprintable.NewWriter(part)
+	if _, err := qp.Write([]byte(strings.ReplaceAll(body, "\n", "\r\n"))); err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+// writeCalendar writes an encoded calendar as a part, as an attachment if a
+// filename is given.
+func writeCalendar(w *multipart.Writer, c *calendar.Calendar, filename string) error {
+	contentType := "text/calendar; charset=utf-8; method=" + c.Method
+	header := textproto.MIMEHeader{"Content-Transfer-Encoding": {"base64"}}
+	if filename != "" {
+		contentType += `; name="` + filename + `"`
+		header.Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+	}
+	header.Set("Content-Type", contentType)
+
+	part, err := w.CreatePart(header)
+	if err != nil {
+		return err
+	}
+	encoded := base64.StdEncoding.EncodeToString(c.Encode())
+	for len(encoded) > 76 {
+		if _, err := part.Write([]byte(encoded[:76] + "\r\n")); err != nil {
+			return err
+		}
+		encoded = encoded[76:]
+	}
+	_, err = part.Write([]byte(encoded + "\r\n"))
+	return err
+}
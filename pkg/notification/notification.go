@@ -0,0 +1,164 @@
+// Package notification announces reservation changes by mail. Changes are
+// written to an outbox table in the same transaction as the change itself
+// and delivered asynchronously by a Worker.
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"gorm.io/gorm"
+)
+
+// Kind is the occasion of a notification.
+type Kind string
+
+const (
+	KindReservationConfirmed Kind = "reservationConfirmed"
+	KindReservationUpdated   Kind = "reservationUpdated"
+	KindReservationCancelled Kind = "reservationCancelled"
+	KindReservationReminder  Kind = "reservationReminder"
+	KindCheckInWarning       Kind = "checkInWarning"
+)
+
+// Enabled reports whether the user wants to receive notifications of kind k.
+// Changed reservations are confirmed anew, so they follow the preference for
+// confirmations.
+func (k Kind) Enabled(user *model.User) bool {
+	switch k {
+	case KindReservationConfirmed, KindReservationUpdated:
+		return user.NotifyReservationConfirmed
+	case KindReservationCancelled:
+		return user.NotifyReservationCancelled
+	case KindReservationReminder:
+		return user.NotifyReservationReminder
+	case KindCheckInWarning:
+		return user.NotifyCheckInWarning
+	}
+	return false
+}
+
+// Payload is the snapshot stored with a notification. It holds everything
+// needed to render the message, so that later changes to the reservations
+// do not alter what is announced.
+type Payload struct {
+	UserName        string            `json:"userName"`
+	Place           string            `json:"place"`
+	Area            string            `json:"area,omitempty"`
+	Building        string            `json:"building,omitempty"`
+	RequiresCheckIn bool              `json:"requiresCheckIn"`
+	Reason          string            `json:"reason,omitempty"`
+	Reservations    []ReservationInfo `json:"reservations"`
+
+	// Actor is the name of the user that made the change on behalf of the
+	// recipient, if any.
+	Actor string `json:"actor,omitempty"`
+}
+
+// ReservationInfo is a reservation as announced by a notification.
+type ReservationInfo struct {
+	ID    uuid.UUID `json:"id"`
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+	// Sequence is the revision of the calendar event of the reservation.
+	Sequence int `json:"sequence"`
+}
+
+// sequenceEpoch is the origin of event sequence numbers. Seconds since then
+// fit into the 32 bit SEQUENCE values clients expect for decades.
+var sequenceEpoch = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// Sequence returns the calendar event revision of a reservation, which grows
+// with every modification.
+func Sequence(r *model.Reservation) int {
+	if r.UpdatedAt.Before(sequenceEpoch) {
+		return 0
+	}
+	return int(r.UpdatedAt.Sub(sequenceEpoch) / time.Second)
+}
+
+// Enqueue writes notifications of kind about the given reservations to the
+// outbox using tx. Reservations are announced together per user and place.
+// Users that are inactive or have opted out of kind are skipped.
+func Enqueue(ctx context.Context, tx *gorm.DB, kind Kind, reservations []*model.Reservation, reason *string) error {
+	return enqueue(ctx, tx, kind, reservations, reason, nil)
+}
+
+// EnqueueOnBehalf is like Enqueue for changes that actorID made on behalf of
+// the users holding the reservations. The notifications name the actor,
+// unless it is the recipient itself.
+func EnqueueOnBehalf(ctx context.Context, tx *gorm.DB, kind Kind, reservations []*model.Reservation, reason *string, actorID uuid.UUID) error {
+	actor, err := dbgen.UserQuery[model.User](tx).GetByID(ctx, actorID)
+	if err != nil {
+		return err
+	}
+	return enqueue(ctx, tx, kind, reservations, reason, actor)
+}
+
+func enqueue(ctx context.Context, tx *gorm.DB, kind Kind, reservations []*model.Reservation, reason *string, actor *model.User) error {
+	type key struct{ userID, placeID uuid.UUID }
+	var keys []key
+	groups := map[key][]*model.Reservation{}
+	for _, r := range reservations {
+		k := key{r.UserID, r.PlaceID}
+		if _, ok := groups[k]; !ok {
+			keys = append(keys, k)
+		}
+		groups[k] = append(groups[k], r)
+	}
+
+	for _, k := range keys {
+		user, err := dbgen.UserQuery[model.User](tx).GetByID(ctx, k.userID)
+		if err != nil {
+			return err
+		}
+		// Users without an email address cannot be notified.
+		if user == nil || !user.IsActive || user.Email == "" || !kind.Enabled(user) {
+			continue
+		}
+
+		var place model.Place
+		if err := tx.WithContext(ctx).Preload("Area.Building").First(&place, "id = ?", k.placeID).Error; err != nil {
+			return err
+		}
+
+		payload := newPayload(user, &place, groups[k], reason)
+		if actor != nil && actor.ID != user.ID {
+			payload.Actor = actor.Name
+		}
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		if err := dbgen.NotificationQuery[model.Notification](tx).Insert(ctx, uuid.New(), user.ID, string(kind), user.Email, string(data)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newPayload(user *model.User, place *model.Place, reservations []*model.Reservation, reason *string) Payload {
+	payload := Payload{
+		UserName:        user.Name,
+		Place:           place.Name,
+		RequiresCheckIn: place.RequiresCheckIn,
+		Reservations:    make([]ReservationInfo, len(reservations)),
+	}
+	if area := place.Area; area != nil {
+		payload.Area = area.Name
+		if building := area.Building; building != nil {
+			payload.Building = building.Name
+		}
+	}
+	if reason != nil {
+		payload.Reason = *reason
+	}
+	for i, r := range reservations {
+		payload.Reservations[i] = ReservationInfo{ID: r.ID, Start: r.StartTime, End: r.EndTime, Sequence: Sequence(r)}
+	}
+	return payload
+}
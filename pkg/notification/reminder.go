@@ -0,0 +1,56 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"github.com/pixlcrashr/roomy/pkg/scheduler"
+	"gorm.io/gorm"
+)
+
+// reminderBatchSize limits how many reservations are reminded of per batch.
+const reminderBatchSize = 100
+
+// ReminderJob enqueues a reminder for every reservation whose start lies
+// within the ReminderMinutesBefore of its user.
+//
+// Every reservation is reminded of once per start time: the reminder is
+// marked on the reservation in the same transaction it is enqueued in, and
+// the mark is cleared when the start time changes. As due reminders are
+// selected by the current start time, a moved reservation is reminded of at
+// its new time, whether or not it had been reminded of before. Reservations
+// booked within the reminder period are not reminded of, as their
+// confirmation has just been sent.
+func ReminderJob() scheduler.Job {
+	return scheduler.Job{Name: "reservation-reminders", Run: EnqueueReminders}
+}
+
+// EnqueueReminders enqueues all due reminders using tx.
+func EnqueueReminders(ctx context.Context, tx *gorm.DB) error {
+	reservations := dbgen.ReservationQuery[model.Reservation](tx)
+	for {
+		due, err := reservations.ListDueReminders(ctx, reminderBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(due) == 0 {
+			return nil
+		}
+
+		ids := make([]uuid.UUID, len(due))
+		for i, r := range due {
+			ids[i] = r.ID
+			if err := Enqueue(ctx, tx, KindReservationReminder, []*model.Reservation{r}, nil); err != nil {
+				return err
+			}
+		}
+		if err := reservations.MarkReminded(ctx, ids); err != nil {
+			return err
+		}
+		if len(due) < reminderBatchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package notification
+
+import (
+	"embed"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+)
+
+//go:embed templates/*.txt
+var templateFS embed.FS
+
+// Rendered is the text of a notification.
+type Rendered struct {
+	Subject string
+	Body    string
+}
+
+// Renderer renders notifications from the embedded templates. Every kind
+// has a template file named after it defining a "subject" and a "body".
+type Renderer struct {
+	location  *time.Location
+	templates map[Kind]*template.Template
+}
+
+// NewRenderer parses the templates of all kinds. Times are formatted in the
+// given location.
+func NewRenderer(location *time.Location) (*Renderer, error) {
+	if location == nil {
+		location = time.UTC
+	}
+	r := &Renderer{location: location, templates: map[Kind]*template.Template{}}
+
+	funcs := template.FuncMap{"span": r.span}
+	for _, kind := range []Kind{
+		KindReservationConfirmed,
+		KindReservationUpdated,
+		KindReservationCancelled,
+		KindReservationReminder,
+		KindCheckInWarning,
+	} {
+		t, err := template.New(string(kind)).Funcs(funcs).ParseFS(templateFS, "templates/common.txt", "templates/"+string(kind)+".txt")
+		if err != nil {
+			return nil, err
+		}
+		r.templates[kind] = t
+	}
+	return r, nil
+}
+
+// Render renders the subject and body of a notification.
+func (r *Renderer) Render(kind Kind, payload *Payload) (*Rendered, error) {
+	t, ok := r.templates[kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown notification kind %q", kind)
+	}
+
+	var subject, body strings.Builder
+	if err := t.ExecuteTemplate(&subject, "subject", payload); err != nil {
+		return nil, err
+	}
+	if err := t.ExecuteTemplate(&body, "body", payload); err != nil {
+		return nil, err
+	}
+	return &Rendered{Subject: strings.TrimSpace(subject.String()), Body: body.String()}, nil
+}
+
+// span formats a time range with its time zone, omitting the date of the end
+// if it is the same day as the start.
+func (r *Renderer) span(start, end time.Time) string {
+	start, end = start.In(r.location), end.In(r.location)
+	const day, clock = "Mon, 2 Jan 2006", "15:04"
+	if y, m, d := start.Date(); end.Year() == y && end.Month() == m && end.Day() == d {
+		return start.Format(day+", "+clock) + "–" + end.Format(clock+" MST")
+	}
+	return start.Format(day+", "+clock) + " – " + end.Format(day+", "+clock+" MST")
+}
@@ -0,0 +1,154 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"gorm.io/gorm"
+)
+
+const (
+	// sendTimeout bounds a single SMTP session.
+	sendTimeout = time.Minute
+	// minBackoff is the delay before the first retry; it doubles with every
+	// further attempt up to maxBackoff.
+	minBackoff = 30 * time.Second
+	maxBackoff = 2 * time.Hour
+)
+
+// Worker delivers the notifications of the outbox. Every notification is
+// claimed with FOR UPDATE SKIP LOCKED in its own transaction, so that any
+// number of workers can run side by side. Delivery is at least once: a
+// message may be sent again if the transaction fails to commit after it was
+// handed to the SMTP server.
+type Worker struct {
+	db          *gorm.DB
+	mailer      *Mailer
+	renderer    *Renderer
+	from        *mail.Address
+	location    *time.Location
+	interval    time.Duration
+	maxAttempts int
+}
+
+// NewWorker creates a new Worker sending from the configured address.
+// Message times are formatted in the given location.
+func NewWorker(db *gorm.DB, mailer *Mailer, location *time.Location, from string, interval time.Duration, maxAttempts int) (*Worker, error) {
+	address, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address %q: %w", from, err)
+	}
+	renderer, err := NewRenderer(location)
+	if err != nil {
+		return nil, err
+	}
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	return &Worker{
+		db:          db,
+		mailer:      mailer,
+		renderer:    renderer,
+		from:        address,
+		location:    renderer.location,
+		interval:    interval,
+		maxAttempts: maxAttempts,
+	}, nil
+}
+
+// Run delivers due notifications every interval until ctx is done.
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		for ctx.Err() == nil {
+			delivered, err := w.DeliverNext(ctx)
+			if err != nil {
+				log.Errorf("failed to deliver notification: %v", err)
+				break
+			}
+			if !delivered {
+				break
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// DeliverNext claims and delivers the next due notification. It reports
+// false if none is due. Failed deliveries are rescheduled with exponential
+// backoff and dead-lettered after the maximum number of attempts; only
+// database errors are returned.
+func (w *Worker) DeliverNext(ctx context.Context) (bool, error) {
+	claimed := false
+	err := w.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		notifications := dbgen.NotificationQuery[model.Notification](tx)
+
+		n, err := notifications.ClaimNext(ctx)
+		if err != nil {
+			return err
+		}
+		if n == nil {
+			return nil
+		}
+		claimed = true
+
+		msg, err := w.compose(n)
+		if err != nil {
+			// Rendering will not succeed on a later attempt either.
+			log.Errorf("failed to render notification %s: %v", n.ID, err)
+			return notifications.MarkFailed(ctx, n.ID, string(model.NotificationStatusDead), n.NextAttemptAt, err.Error())
+		}
+
+		sendCtx, cancel := context.WithTimeout(ctx, sendTimeout)
+		defer cancel()
+		if err := w.mailer.Send(sendCtx, msg); err != nil {
+			status := model.NotificationStatusPending
+			if n.Attempts+1 >= w.maxAttempts {
+				status = model.NotificationStatusDead
+				log.Errorf("giving up on notification %s after %d attempts: %v", n.ID, n.Attempts+1, err)
+			}
+			return notifications.MarkFailed(ctx, n.ID, string(status), time.Now().Add(backoff(n.Attempts)), err.Error())
+		}
+		return notifications.MarkSent(ctx, n.ID)
+	})
+	return claimed, err
+}
+
+// compose renders the message of a notification.
+func (w *Worker) compose(n *model.Notification) (*Message, error) {
+	var payload Payload
+	if err := json.Unmarshal([]byte(n.Payload), &payload); err != nil {
+		return nil, err
+	}
+
+	kind := Kind(n.Kind)
+	rendered, err := w.renderer.Render(kind, &payload)
+	if err != nil {
+		return nil, err
+	}
+	invitations := Invitations(kind, &payload, w.from.Address, n.Recipient, w.location)
+	return NewMessage(n.ID, w.from, n.Recipient, rendered, invitations)
+}
+
+// backoff returns the delay before the next attempt after the given number
+// of failed attempts.
+func backoff(attempts int) time.Duration {
+	delay := minBackoff
+	for i := 0; i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, maxBackoff)
+}
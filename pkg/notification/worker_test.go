@@ -0,0 +1,234 @@
+package notification
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	database "github.com/pixlcrashr/roomy/pkg/db"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/migrations"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"gorm.io/gorm"
+)
+
+// testDatabaseEnv names the environment variable holding the DSN of a
+// disposable PostgreSQL database.
+const testDatabaseEnv = "ROOMY_TEST_DATABASE_URL"
+
+// openTestDB connects to the test database and migrates it, or skips the
+// test if none is configured.
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	dsn := os.Getenv(testDatabaseEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDatabaseEnv)
+	}
+	db, err := database.Connect(dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+	if err := migrations.Run(sqlDB); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{0, 30 * time.Second},
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{7, 64 * time.Minute},
+		{8, 2 * time.Hour},
+		{50, 2 * time.Hour},
+	}
+	for _, tt := range tests {
+		if got := backoff(tt.attempts); got != tt.want {
+			t.Errorf("backoff(%d) = %s, want %s", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestWorkerDelivery(t *testing.T) {
+	db := openTestDB(t)
+	server := newFakeSMTP(t)
+	mailer, err := NewMailer(server.config())
+	if err != nil {
+		t.Fatal(err)
+	}
+	worker, err := NewWorker(db, mailer, time.UTC, "Roomy <roomy@example.com>", time.Second, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userID := uuid.New()
+	email := userID.String() + "@example.com"
+	if err := dbgen.UserQuery[model.User](db).Insert(t.Context(), userID, email, userID.String(), "J. Doe", nil, "gitlab", userID.String()); err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	payload, err := json.Marshal(Payload{
+		UserName:     "J. Doe",
+		Place:        "Desk 1",
+		Reservations: []ReservationInfo{{ID: uuid.New(), Start: start, End: start.Add(2 * time.Hour)}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Other tests may leave due notifications behind; the server accepts
+	// them so that only the notifications of this test are left.
+	for {
+		delivered, err := worker.DeliverNext(t.Context())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !delivered {
+			break
+		}
+	}
+
+	enqueue := func(t *testing.T) uuid.UUID {
+		t.Helper()
+		id := uuid.New()
+		if err := dbgen.NotificationQuery[model.Notification](db).Insert(t.Context(), id, userID, string(KindReservationConfirmed), email, string(payload)); err != nil {
+			t.Fatal(err)
+		}
+		return id
+	}
+	get := func(t *testing.T, id uuid.UUID) *model.Notification {
+		t.Helper()
+		var n model.Notification
+		if err := db.First(&n, "id = ?", id).Error; err != nil {
+			t.Fatal(err)
+		}
+		return &n
+	}
+	// deliver runs the worker until it has attempted the notification and
+	// returns its state together with the time of the attempt.
+	deliver := func(t *testing.T, id uuid.UUID) (*model.Notification, time.Time) {
+		t.Helper()
+		attempts := get(t, id).Attempts
+		for {
+			at := time.Now()
+			delivered, err := worker.DeliverNext(t.Context())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n := get(t, id); n.Attempts != attempts {
+				return n, at
+			}
+			if !delivered {
+				t.Fatal("notification was not attempted")
+			}
+		}
+	}
+	// elapse makes a rescheduled notification due.
+	elapse := func(t *testing.T, id uuid.UUID) {
+		t.Helper()
+		if err := db.Model(&model.Notification{}).Where("id = ?", id).Update("next_attempt_at", time.Now()).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	// rescheduled checks that a failed attempt at was retried after delay.
+	rescheduled := func(t *testing.T, n *model.Notification, at time.Time, delay time.Duration) {
+		t.Helper()
+		if n.Status != model.NotificationStatusPending {
+			t.Fatalf("status = %s, want pending", n.Status)
+		}
+		if earliest := at.Add(delay); n.NextAttemptAt.Before(earliest.Add(-time.Second)) || n.NextAttemptAt.After(earliest.Add(5*time.Second)) {
+			t.Fatalf("next attempt at %s, want about %s", n.NextAttemptAt, earliest)
+		}
+		if n.LastError == nil || !strings.Contains(*n.LastError, "451") {
+			t.Fatalf("last error = %v", n.LastError)
+		}
+	}
+	sentTo := func(t *testing.T, id uuid.UUID) int {
+		t.Helper()
+		count := 0
+		for _, m := range server.received() {
+			if strings.Contains(m.Data, "Message-ID: <"+id.String()+"@example.com>") {
+				if len(m.To) != 1 || m.To[0] != email || m.From != "roomy@example.com" {
+					t.Fatalf("envelope = %s → %v", m.From, m.To)
+				}
+				count++
+			}
+		}
+		return count
+	}
+
+	t.Run("delivered", func(t *testing.T) {
+		server.rejectRecipients("")
+		id := enqueue(t)
+
+		n, _ := deliver(t, id)
+		if n.Status != model.NotificationStatusSent || n.Attempts != 1 || n.SentAt == nil || n.LastError != nil {
+			t.Fatalf("notification = %s after %d attempts, sent at %v, error %v", n.Status, n.Attempts, n.SentAt, n.LastError)
+		}
+		if got := sentTo(t, id); got != 1 {
+			t.Fatalf("sent %d times, want once", got)
+		}
+	})
+
+	t.Run("retried with backoff", func(t *testing.T) {
+		server.rejectRecipients("451 4.3.0 try again later")
+		id := enqueue(t)
+
+		n, at := deliver(t, id)
+		rescheduled(t, n, at, minBackoff)
+		if _, err := worker.DeliverNext(t.Context()); err != nil {
+			t.Fatal(err)
+		}
+		if n := get(t, id); n.Attempts != 1 {
+			t.Fatalf("attempted %d times before it was due", n.Attempts)
+		}
+
+		server.rejectRecipients("")
+		elapse(t, id)
+		n, _ = deliver(t, id)
+		if n.Status != model.NotificationStatusSent || n.Attempts != 2 || n.LastError != nil {
+			t.Fatalf("notification = %s after %d attempts, error %v", n.Status, n.Attempts, n.LastError)
+		}
+		if got := sentTo(t, id); got != 1 {
+			t.Fatalf("sent %d times, want once", got)
+		}
+	})
+
+	t.Run("dead-lettered", func(t *testing.T) {
+		server.rejectRecipients("451 4.3.0 try again later")
+		id := enqueue(t)
+
+		n, at := deliver(t, id)
+		rescheduled(t, n, at, minBackoff)
+
+		elapse(t, id)
+		n, _ = deliver(t, id)
+		if n.Status != model.NotificationStatusDead || n.Attempts != 2 || n.LastError == nil {
+			t.Fatalf("notification = %s after %d attempts, error %v", n.Status, n.Attempts, n.LastError)
+		}
+
+		server.rejectRecipients("")
+		elapse(t, id)
+		if _, err := worker.DeliverNext(t.Context()); err != nil {
+			t.Fatal(err)
+		}
+		if n := get(t, id); n.Status != model.NotificationStatusDead || n.Attempts != 2 {
+			t.Fatalf("dead notification was attempted again: %s after %d attempts", n.Status, n.Attempts)
+		}
+		if got := sentTo(t, id); got != 0 {
+			t.Fatalf("sent %d times", got)
+		}
+	})
+}
@@ -0,0 +1,264 @@
+package qr
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// allowedCSSFunctions are the CSS functions templates may use. None of them
+// loads a resource; url(), image-set(), image(), element() and friends are
+// left out.
+var allowedCSSFunctions = map[string]bool{
+	"rgb": true, "rgba": true, "hsl": true, "hsla": true, "hwb": true,
+	"lab": true, "lch": true, "oklab": true, "oklch": true, "color": true,
+	"calc": true, "min": true, "max": true, "clamp": true, "var": true,
+	"counter": true, "counters": true, "attr": true,
+	"translate": true, "translatex": true, "translatey": true, "rotate": true,
+	"scale": true, "scalex": true, "scaley": true, "skew": true, "skewx": true,
+	"skewy": true, "matrix": true,
+	"linear-gradient": true, "radial-gradient": true, "conic-gradient": true,
+	"repeating-linear-gradient": true, "repeating-radial-gradient": true,
+	"repeat": true, "minmax": true, "fit-content": true,
+	"cubic-bezier": true, "steps": true,
+	"not": true, "is": true, "where": true, "has": true, "lang": true,
+	"nth-child": true, "nth-last-child": true, "nth-of-type": true, "nth-last-of-type": true,
+}
+
+// allowedAtRules are the CSS at-rules templates may use.
+var allowedAtRules = map[string]bool{
+	"media": true, "page": true, "supports": true,
+}
+
+// checkCSS checks that a style sheet or declaration list does not load
+// external resources. The CSS is tokenized as a browser would, so escapes
+// and comments cannot hide function names or at-rules.
+func checkCSS(css string) error {
+	for _, t := range tokenizeCSS(css) {
+		switch t.kind {
+		case cssURL:
+			return fmt.Errorf("CSS url is not allowed")
+		case cssFunction:
+			if !allowedCSSFunctions[t.value] {
+				return fmt.Errorf("CSS function %s() is not allowed", t.value)
+			}
+		case cssAtKeyword:
+			if !allowedAtRules[t.value] {
+				return fmt.Errorf("CSS @%s is not allowed", t.value)
+			}
+		}
+	}
+	return nil
+}
+
+type cssTokenKind int
+
+const (
+	cssOther cssTokenKind = iota
+	cssIdent
+	cssFunction
+	cssAtKeyword
+	cssURL
+)
+
+// cssToken is a token of a style sheet. The value of identifiers, functions
+// and at-keywords is their name with escapes decoded, in lower case.
+type cssToken struct {
+	kind  cssTokenKind
+	value string
+}
+
+// tokenizeCSS splits css into tokens following CSS Syntax Level 3. Only the
+// tokens that can name a function or at-rule are told apart; everything else
+// is returned as cssOther.
+func tokenizeCSS(css string) []cssToken {
+	t := cssTokenizer{src: preprocessCSS(css)}
+	var tokens []cssToken
+	for t.pos < len(t.src) {
+		if tok, ok := t.next(); ok {
+			tokens = append(tokens, tok)
+		}
+	}
+	return tokens
+}
+
+// preprocessCSS normalizes newlines and replaces NUL as a browser does
+// before tokenizing.
+func preprocessCSS(css string) []rune {
+	css = strings.NewReplacer("\r\n", "\n", "\r", "\n", "\f", "\n", "\x00", "\uFFFD").Replace(css)
+	return []rune(css)
+}
+
+type cssTokenizer struct {
+	src []rune
+	pos int
+}
+
+func (t *cssTokenizer) at(i int) rune {
+	if i < len(t.src) {
+		return t.src[i]
+	}
+	return -1
+}
+
+// next consumes the next token. It reports false for comments and
+// whitespace.
+func (t *cssTokenizer) next() (cssToken, bool) {
+	c := t.at(t.pos)
+	switch {
+	case c == '/' && t.at(t.pos+1) == '*':
+		t.pos += 2
+		for t.pos < len(t.src) && (t.src[t.pos] != '*' || t.at(t.pos+1) != '/') {
+			t.pos++
+		}
+		t.pos += 2
+		return cssToken{}, false
+	case isCSSWhitespace(c):
+		t.pos++
+		return cssToken{}, false
+	case c == '"' || c == '\'':
+		t.consumeString(c)
+		return cssToken{kind: cssOther}, true
+	case c == '@' && t.startsIdent(t.pos+1):
+		t.pos++
+		return cssToken{kind: cssAtKeyword, value: t.consumeName()}, true
+	case t.startsIdent(t.pos):
+		return t.consumeIdentLike(), true
+	}
+	t.pos++
+	return cssToken{kind: cssOther}, true
+}
+
+// consumeIdentLike consumes an identifier, function or url token.
+func (t *cssTokenizer) consumeIdentLike() cssToken {
+	name := t.consumeName()
+	if t.at(t.pos) != '(' {
+		return cssToken{kind: cssIdent, value: name}
+	}
+	t.pos++
+	if name != "url" {
+		return cssToken{kind: cssFunction, value: name}
+	}
+	for isCSSWhitespace(t.at(t.pos)) {
+		t.pos++
+	}
+	if c := t.at(t.pos); c == '"' || c == '\'' {
+		return cssToken{kind: cssFunction, value: name}
+	}
+	for t.pos < len(t.src) && t.src[t.pos] != ')' {
+		if t.validEscape(t.pos) {
+			t.pos++
+		}
+		t.pos++
+	}
+	t.pos++
+	return cssToken{kind: cssURL, value: name}
+}
+
+// consumeString consumes a string token up to its closing quote or an
+// unescaped newline.
+func (t *cssTokenizer) consumeString(quote rune) {
+	t.pos++
+	for t.pos < len(t.src) {
+		c := t.src[t.pos]
+		switch {
+		case c == quote:
+			t.pos++
+			return
+		case c == '\n':
+			return
+		case c == '\\':
+			t.pos += 2
+		default:
+			t.pos++
+		}
+	}
+}
+
+// consumeName consumes a name, decoding escapes, and returns it in lower
+// case.
+func (t *cssTokenizer) consumeName() string {
+	var b strings.Builder
+	for {
+		c := t.at(t.pos)
+		switch {
+		case isCSSNameChar(c):
+			b.WriteRune(c)
+			t.pos++
+		case t.validEscape(t.pos):
+			t.pos++
+			b.WriteRune(t.consumeEscape())
+		default:
+			return strings.ToLower(b.String())
+		}
+	}
+}
+
+// consumeEscape consumes the escape after a backslash and returns the code
+// point it stands for.
+func (t *cssTokenizer) consumeEscape() rune {
+	c := t.at(t.pos)
+	if !isHexDigit(c) {
+		if c < 0 {
+			return utf8.RuneError
+		}
+		t.pos++
+		return c
+	}
+	var value rune
+	for i := 0; i < 6 && isHexDigit(t.at(t.pos)); i++ {
+		value = value*16 + hexValue(t.at(t.pos))
+		t.pos++
+	}
+	if isCSSWhitespace(t.at(t.pos)) {
+		t.pos++
+	}
+	if value == 0 || value > utf8.MaxRune || value >= 0xD800 && value <= 0xDFFF {
+		return utf8.RuneError
+	}
+	return value
+}
+
+// validEscape reports whether a valid escape starts at i.
+func (t *cssTokenizer) validEscape(i int) bool {
+	return t.at(i) == '\\' && t.at(i+1) != '\n' && t.at(i+1) >= 0
+}
+
+// startsIdent reports whether an identifier starts at i.
+func (t *cssTokenizer) startsIdent(i int) bool {
+	switch c := t.at(i); {
+	case c == '-':
+		next := t.at(i + 1)
+		return isCSSNameStart(next) || next == '-' || t.validEscape(i+1)
+	case isCSSNameStart(c):
+		return true
+	default:
+		return t.validEscape(i)
+	}
+}
+
+func isCSSWhitespace(c rune) bool {
+	return c == ' ' || c == '\t' || c == '\n'
+}
+
+func isCSSNameStart(c rune) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_' || c >= 0x80
+}
+
+func isCSSNameChar(c rune) bool {
+	return isCSSNameStart(c) || c >= '0' && c <= '9' || c == '-'
+}
+
+func isHexDigit(c rune) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
+
+func hexValue(c rune) rune {
+	switch {
+	case c >= 'a':
+		return c - 'a' + 10
+	case c >= 'A':
+		return c - 'A' + 10
+	}
+	return c - '0'
+}
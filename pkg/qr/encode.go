@@ -0,0 +1,118 @@
+package qr
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"math"
+)
+
+// Format is an output format of labels.
+type Format string
+
+const (
+	FormatPNG  Format = "png"
+	FormatJPEG Format = "jpg"
+	FormatPDF  Format = "pdf"
+)
+
+// ContentType returns the MIME type of the format.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatJPEG:
+		return "image/jpeg"
+	case FormatPDF:
+		return "application/pdf"
+	default:
+		return "image/png"
+	}
+}
+
+// jpegQuality is high enough to keep the module edges of QR symbols clean.
+const jpegQuality = 95
+
+// Encode writes img in the given format. Images are tagged with DPI so that
+// they print at their intended size; PDFs consist of a single page of the
+// size of the image.
+func Encode(w io.Writer, img image.Image, format Format) error {
+	switch format {
+	case FormatPNG:
+		return encodePNG(w, img)
+	case FormatJPEG:
+		return encodeJPEG(w, img)
+	case FormatPDF:
+		return WritePDF(w, []image.Image{img})
+	default:
+		return fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+// encodePNG encodes img as PNG with a pHYs chunk declaring DPI.
+func encodePNG(w io.Writer, img image.Image) error {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return err
+	}
+
+	// The pHYs chunk has to precede the image data, so it is placed right
+	// after the signature and the IHDR chunk.
+	const ihdrEnd = 8 + 4 + 4 + 13 + 4
+	data := buf.Bytes()
+	ppm := uint32(math.Round(DPI / 0.0254))
+	phys := make([]byte, 9)
+	binary.BigEndian.PutUint32(phys[0:], ppm)
+	binary.BigEndian.PutUint32(phys[4:], ppm)
+	phys[8] = 1 // unit: metre
+
+	if _, err := w.Write(data[:ihdrEnd]); err != nil {
+		return err
+	}
+	if err := writePNGChunk(w, "pHYs", phys); err != nil {
+		return err
+	}
+	_, err := w.Write(data[ihdrEnd:])
+	return err
+}
+
+func writePNGChunk(w io.Writer, kind string, data []byte) error {
+	chunk := make([]byte, 0, 12+len(data))
+	chunk = binary.BigEndian.AppendUint32(chunk, uint32(len(data)))
+	chunk = append(chunk, kind...)
+	chunk = append(chunk, data...)
+	chunk = binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+	_, err := w.Write(chunk)
+	return err
+}
+
+// encodeJPEG encodes img as JPEG with a JFIF header declaring DPI.
+func encodeJPEG(w io.Writer, img image.Image) error {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return err
+	}
+
+	// The JFIF APP0 segment has to follow the SOI marker directly.
+	data := buf.Bytes()
+	app0 := []byte{
+		0xff, 0xe0, 0x00, 0x10, // APP0, length 16
+		'J', 'F', 'I', 'F', 0x00,
+		0x01, 0x02, // version 1.2
+		0x01,                 // unit: dots per inch
+		DPI >> 8, DPI & 0xff, // horizontal density
+		DPI >> 8, DPI & 0xff, // vertical density
+		0x00, 0x00, // no thumbnail
+	}
+	if _, err := w.Write(data[:2]); err != nil {
+		return err
+	}
+	if _, err := w.Write(app0); err != nil {
+		return err
+	}
+	_, err := w.Write(data[2:])
+	return err
+}
@@ -0,0 +1,172 @@
+package qr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+
+	"github.com/google/uuid"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"gorm.io/gorm"
+)
+
+// MaxExportPlaces is the maximum number of places exported at once.
+const MaxExportPlaces = 2000
+
+var (
+	// ErrNotFound is returned if the area, building or template of an export
+	// does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrNoPlaces is returned if an export does not contain any place.
+	ErrNoPlaces = errors.New("no bookable places")
+	// ErrTooManyPlaces is returned if an export contains more than
+	// MaxExportPlaces places.
+	ErrTooManyPlaces = fmt.Errorf("more than %d places", MaxExportPlaces)
+)
+
+// ExportFormat is the output format of exports.
+type ExportFormat string
+
+const (
+	// ExportPDF exports print sheets as PDF.
+	ExportPDF ExportFormat = "pdf"
+	// ExportZIP exports a ZIP archive of PNG images.
+	ExportZIP ExportFormat = "zip"
+)
+
+// URLSigner returns the check-in URL of a place's QR code.
+type URLSigner interface {
+	URL(placeID uuid.UUID, keyVersion int) string
+}
+
+// ExportRequest describes an export of the labels of all bookable places
+// of an area or a building. Exactly one of AreaID and BuildingID is set.
+type ExportRequest struct {
+	AreaID     *uuid.UUID
+	BuildingID *uuid.UUID
+	// TemplateID is the QR template to render the labels with, nil for the
+	// default layout showing the place, area and building names.
+	TemplateID *uuid.UUID
+	Format     ExportFormat
+	// Paper is the paper size of PDF sheets.
+	Paper Paper
+}
+
+// Exporter exports the labels of many places at once.
+type Exporter struct {
+	db   *gorm.DB
+	urls URLSigner
+}
+
+// NewExporter creates a new Exporter.
+func NewExporter(db *gorm.DB, urls URLSigner) *Exporter {
+	return &Exporter{db: db, urls: urls}
+}
+
+// Export writes the labels of all bookable places of the requested area or
+// building, sorted by place name, to w. Disabled places and places that
+// cannot be booked are left out.
+func (e *Exporter) Export(ctx context.Context, req ExportRequest, w io.Writer) error {
+	places, err := e.places(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	render := func(place *model.Place) (*image.Gray, error) {
+		return Render(Label{
+			URL:     e.urls.URL(place.ID, place.QRKeyVersion),
+			Title:   place.Name,
+			Details: []string{place.Area.Name, place.Area.Building.Name},
+		})
+	}
+	if req.TemplateID != nil {
+		template, err := dbgen.QRTemplateQuery[model.QRTemplate](e.db).GetByID(ctx, *req.TemplateID)
+		if err != nil {
+			return err
+		}
+		if template == nil {
+			return fmt.Errorf("QR template %w", ErrNotFound)
+		}
+		t, err := ParseTemplate(template.HTMLTemplate)
+		if err != nil {
+			return err
+		}
+		render = func(place *model.Place) (*image.Gray, error) {
+			data, err := NewTemplateData(place, e.urls.URL(place.ID, place.QRKeyVersion))
+			if err != nil {
+				return nil, err
+			}
+			doc, err := t.Execute(ctx, data)
+			if err != nil {
+				return nil, err
+			}
+			return RenderHTML(doc, data)
+		}
+	}
+
+	labels := make([]*SheetLabel, len(places))
+	for i, place := range places {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		img, err := render(place)
+		if err != nil {
+			return fmt.Errorf("place %s: %w", place.Name, err)
+		}
+		if labels[i], err = NewSheetLabel(place.Name, img); err != nil {
+			return err
+		}
+	}
+
+	if req.Format == ExportZIP {
+		return WriteZIP(w, labels)
+	}
+	return WriteSheets(w, labels, req.Paper)
+}
+
+// places returns the bookable places of the request with their area and
+// building, sorted by name.
+func (e *Exporter) places(ctx context.Context, req ExportRequest) ([]*model.Place, error) {
+	q := e.db.WithContext(ctx).
+		Preload("Area.Building").
+		Joins("JOIN areas ON areas.id = places.area_id").
+		Where("places.is_bookable AND NOT places.is_disabled")
+
+	switch {
+	case req.AreaID != nil:
+		area, err := dbgen.AreaQuery[model.Area](e.db).GetByID(ctx, *req.AreaID)
+		if err != nil {
+			return nil, err
+		}
+		if area == nil {
+			return nil, fmt.Errorf("area %w", ErrNotFound)
+		}
+		q = q.Where("places.area_id = ?", area.ID)
+	case req.BuildingID != nil:
+		building, err := dbgen.BuildingQuery[model.Building](e.db).GetByID(ctx, *req.BuildingID)
+		if err != nil {
+			return nil, err
+		}
+		if building == nil {
+			return nil, fmt.Errorf("building %w", ErrNotFound)
+		}
+		q = q.Where("areas.building_id = ?", building.ID)
+	default:
+		return nil, errors.New("either an area or a building is required")
+	}
+
+	var places []*model.Place
+	if err := q.Order("places.name, places.id").Limit(MaxExportPlaces + 1).Find(&places).Error; err != nil {
+		return nil, err
+	}
+	if len(places) == 0 {
+		return nil, ErrNoPlaces
+	}
+	if len(places) > MaxExportPlaces {
+		return nil, ErrTooManyPlaces
+	}
+	return places, nil
+}
@@ -0,0 +1,185 @@
+// Package qr renders the printable QR code labels of places. Labels are
+// rendered in pure Go at a fixed print resolution with a deterministic
+// layout, so that the same place always yields the same image.
+package qr
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"sync"
+
+	"github.com/skip2/go-qrcode"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// DPI is the resolution labels are rendered at.
+const DPI = 300
+
+// Label dimensions in millimetres.
+const (
+	labelWidthMM  = 60
+	labelMarginMM = 4
+	symbolSizeMM  = 52
+	lineSpacingMM = 1.5
+)
+
+// Font sizes of the detail lines in points.
+const (
+	titleSizePt  = 14
+	detailSizePt = 10
+)
+
+// ErrContentTooLong is returned if the content of a label does not fit into
+// a QR code.
+var ErrContentTooLong = errors.New("content too long for a QR code")
+
+// Label is the content of a place label.
+type Label struct {
+	// URL is the content encoded in the QR symbol.
+	URL string
+	// Title is printed in bold below the symbol. It is omitted if empty.
+	Title string
+	// Details are printed below the title, one per line.
+	Details []string
+}
+
+// Render renders the label as a grayscale image at DPI. The QR symbol
+// including its quiet zone is centred at the top; the title and details
+// follow centred below it, each truncated to the width of the label.
+func Render(label Label) (*image.Gray, error) {
+	symbol, err := Symbol(label.URL, mm(symbolSizeMM))
+	if err != nil {
+		return nil, err
+	}
+
+	faces, err := loadFaces()
+	if err != nil {
+		return nil, err
+	}
+	type line struct {
+		text string
+		face font.Face
+	}
+	var lines []line
+	if label.Title != "" {
+		lines = append(lines, line{label.Title, faces.title})
+	}
+	for _, d := range label.Details {
+		if d != "" {
+			lines = append(lines, line{d, faces.detail})
+		}
+	}
+
+	width, margin, spacing := mm(labelWidthMM), mm(labelMarginMM), mm(lineSpacingMM)
+	height := margin + symbol.Bounds().Dy()
+	for _, l := range lines {
+		height += spacing + l.face.Metrics().Height.Ceil()
+	}
+	height += margin
+
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), image.White, image.Point{}, draw.Src)
+
+	x := (width - symbol.Bounds().Dx()) / 2
+	draw.Draw(img, symbol.Bounds().Add(image.Pt(x, margin)), symbol, image.Point{}, draw.Src)
+
+	y := margin + symbol.Bounds().Dy()
+	for _, l := range lines {
+		m := l.face.Metrics()
+		y += spacing
+		drawCentered(img, l.face, truncate(l.face, l.text, width-2*margin), width/2, y+m.Ascent.Ceil())
+		y += m.Height.Ceil()
+	}
+	return img, nil
+}
+
+// Symbol renders the QR symbol of content, including its quiet zone, at
+// most size pixels wide. Every module is drawn as a whole number of pixels
+// so that edges stay sharp when printed.
+func Symbol(content string, size int) (*image.Gray, error) {
+	code, err := qrcode.New(content, qrcode.Medium)
+	if err != nil {
+		return nil, ErrContentTooLong
+	}
+	bitmap := code.Bitmap()
+
+	modules := len(bitmap)
+	scale := max(size/modules, 1)
+	img := image.NewGray(image.Rect(0, 0, modules*scale, modules*scale))
+	for y, row := range bitmap {
+		for x, dark := range row {
+			c := color.White
+			if dark {
+				c = color.Black
+			}
+			draw.Draw(img, image.Rect(x*scale, y*scale, (x+1)*scale, (y+1)*scale), image.NewUniform(c), image.Point{}, draw.Src)
+		}
+	}
+	return img, nil
+}
+
+// mm converts millimetres to pixels at DPI.
+func mm(v float64) int {
+	return int(v * DPI / 25.4)
+}
+
+type faces struct {
+	title, detail font.Face
+}
+
+// loadFonts parses the embedded Go fonts once. Parsed fonts are safe for
+// concurrent use, faces are not and are created per render.
+var loadFonts = sync.OnceValues(func() ([2]*opentype.Font, error) {
+	regular, err := opentype.Parse(goregular.TTF)
+	if err != nil {
+		return [2]*opentype.Font{}, err
+	}
+	bold, err := opentype.Parse(gobold.TTF)
+	if err != nil {
+		return [2]*opentype.Font{}, err
+	}
+	return [2]*opentype.Font{regular, bold}, nil
+})
+
+func loadFaces() (*faces, error) {
+	fonts, err := loadFonts()
+	if err != nil {
+		return nil, err
+	}
+	title, err := opentype.NewFace(fonts[1], &opentype.FaceOptions{Size: titleSizePt, DPI: DPI, Hinting: font.HintingFull})
+	if err != nil {
+		return nil, err
+	}
+	detail, err := opentype.NewFace(fonts[0], &opentype.FaceOptions{Size: detailSizePt, DPI: DPI, Hinting: font.HintingFull})
+	if err != nil {
+		return nil, err
+	}
+	return &faces{title: title, detail: detail}, nil
+}
+
+func drawCentered(dst draw.Image, face font.Face, text string, cx, baseline int) {
+	d := &font.Drawer{Dst: dst, Src: image.Black, Face: face}
+	d.Dot = fixed.P(cx-d.MeasureString(text).Ceil()/2, baseline)
+	d.DrawString(text)
+}
+
+// truncate shortens text with an ellipsis until it fits into width pixels.
+func truncate(face font.Face, text string, width int) string {
+	if font.MeasureString(face, text).Ceil() <= width {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		if s := string(runes) + "…"; font.MeasureString(face, s).Ceil() <= width {
+			return s
+		}
+	}
+	return ""
+}
@@ -0,0 +1,124 @@
+package qr
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"image/draw"
+	"io"
+)
+
+// WritePDF writes a PDF with one page per image. Every page has the size of
+// its image at DPI and shows the image as a lossless grayscale raster. The
+// output carries no timestamps or IDs, so equal images yield equal files.
+func WritePDF(w io.Writer, pages []image.Image) error {
+	return writePDF(w, len(pages), func(i int) (image.Image, error) {
+		return pages[i], nil
+	})
+}
+
+// writePDF writes a PDF of n pages like WritePDF. Pages are requested one
+// after another, so that only one of them has to be kept in memory.
+func writePDF(w io.Writer, n int, page func(i int) (image.Image, error)) error {
+	p := &pdfWriter{w: w}
+	p.printf("%%PDF-1.4\n%%\xe2\xe3\xcf\xd3\n")
+
+	// Objects 1 and 2 are the catalog and the page tree; every page takes
+	// three objects: the page, its content stream and its image.
+	kids := make([]byte, 0, n*8)
+	for i := range n {
+		kids = fmt.Appendf(kids, "%d 0 R ", 3+3*i)
+	}
+	p.object("<< /Type /Catalog /Pages 2 0 R >>")
+	p.object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", bytes.TrimSpace(kids), n))
+
+	for i := range n {
+		img, err := page(i)
+		if err != nil {
+			return err
+		}
+		contents, xobject := 4+3*i, 5+3*i
+		b := img.Bounds()
+		width, height := points(b.Dx()), points(b.Dy())
+
+		p.object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /XObject << /Im0 %d 0 R >> >> /Contents %d 0 R >>",
+			width, height, xobject, contents))
+		p.stream("", []byte(fmt.Sprintf("q %s 0 0 %s 0 0 cm /Im0 Do Q", width, height)))
+
+		data, err := deflateGray(img)
+		if err != nil {
+			return err
+		}
+		p.stream(fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceGray /BitsPerComponent 8 /Filter /FlateDecode ",
+			b.Dx(), b.Dy()), data)
+	}
+
+	xref := p.n
+	p.printf("xref\n0 %d\n0000000000 65535 f \n", len(p.offsets)+1)
+	for _, off := range p.offsets {
+		p.printf("%010d 00000 n \n", off)
+	}
+	p.printf("trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(p.offsets)+1, xref)
+	return p.err
+}
+
+// points converts pixels at DPI to PDF points.
+func points(px int) string {
+	return fmt.Sprintf("%.2f", float64(px)*72/DPI)
+}
+
+func deflateGray(img image.Image) ([]byte, error) {
+	gray, ok := img.(*image.Gray)
+	if !ok || gray.Rect.Min != (image.Point{}) || gray.Stride != gray.Rect.Dx() {
+		gray = image.NewGray(image.Rect(0, 0, img.Bounds().Dx(), img.Bounds().Dy()))
+		draw.Draw(gray, gray.Bounds(), img, img.Bounds().Min, draw.Src)
+	}
+
+	var buf bytes.Buffer
+	zw, err := zlib.NewWriterLevel(&buf, zlib.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := zw.Write(gray.Pix); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// pdfWriter writes numbered PDF objects and records their offsets for the
+// cross-reference table.
+type pdfWriter struct {
+	w       io.Writer
+	n       int
+	offsets []int
+	err     error
+}
+
+func (p *pdfWriter) printf(format string, args ...any) {
+	p.write(fmt.Appendf(nil, format, args...))
+}
+
+func (p *pdfWriter) write(b []byte) {
+	if p.err != nil {
+		return
+	}
+	n, err := p.w.Write(b)
+	p.n += n
+	p.err = err
+}
+
+func (p *pdfWriter) object(body string) {
+	p.offsets = append(p.offsets, p.n)
+	p.printf("%d 0 obj\n%s\nendobj\n", len(p.offsets), body)
+}
+
+func (p *pdfWriter) stream(dict string, data []byte) {
+	p.offsets = append(p.offsets, p.n)
+	p.printf("%d 0 obj\n<< %s/Length %d >>\nstream\n", len(p.offsets), dict, len(data))
+	p.write(data)
+	p.printf("\nendstream\nendobj\n")
+}
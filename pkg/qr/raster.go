@@ -0,0 +1,483 @@
+package qr
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	_ "image/gif" // decodes GIF data URIs
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+	"golang.org/x/net/html"
+)
+
+// Limits of rasterized templates.
+const (
+	maxLabelHeightMM = 250
+	maxImageSide     = 4096
+	// cssDPI is the resolution of CSS pixels.
+	cssDPI = 96
+)
+
+// skippedElements are elements whose content is not rendered.
+var skippedElements = map[string]bool{
+	"head": true, "title": true, "style": true, "script": true,
+	"template": true, "noscript": true, "svg": true,
+}
+
+// blockElements are elements starting and ending a line.
+var blockElements = map[string]bool{
+	"html": true, "body": true, "div": true, "p": true, "section": true,
+	"article": true, "header": true, "footer": true, "main": true,
+	"aside": true, "nav": true, "address": true, "blockquote": true,
+	"center": true, "figure": true, "figcaption": true, "caption": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"ul": true, "ol": true, "li": true, "dl": true, "dt": true, "dd": true,
+	"table": true, "tr": true, "pre": true,
+}
+
+// headingSizes are the font sizes of headings in points.
+var headingSizes = map[string]float64{
+	"h1": 16, "h2": 14, "h3": 12, "h4": detailSizePt, "h5": detailSizePt, "h6": detailSizePt,
+}
+
+// RenderHTML rasterizes HTML rendered from a template with data at DPI, in
+// the width of the default label. Only the layout-relevant subset of HTML is
+// supported: block elements start new lines and inline text wraps within
+// them; headings, b and strong are bold, small is smaller; the text-align,
+// font-weight, font-size and width CSS properties of style attributes are
+// honoured. Images are only taken from data URIs; the QR code of data is
+// redrawn at the requested size so that it stays sharp. Everything else is
+// ignored, so the image approximates what a browser would show.
+func RenderHTML(doc string, data TemplateData) (*image.Gray, error) {
+	root, err := html.Parse(strings.NewReader(doc))
+	if err != nil {
+		return nil, err
+	}
+	fonts, err := loadFonts()
+	if err != nil {
+		return nil, err
+	}
+
+	width, margin := mm(labelWidthMM), mm(labelMarginMM)
+	l := &layouter{
+		data:  data,
+		fonts: fonts,
+		faces: map[faceKey]font.Face{},
+		width: width - 2*margin,
+	}
+	if err := l.walk(root, style{size: detailSizePt, align: "center"}); err != nil {
+		return nil, err
+	}
+	l.flush()
+
+	spacing := mm(lineSpacingMM)
+	height := 2 * margin
+	for i, b := range l.blocks {
+		if i > 0 {
+			height += spacing
+		}
+		height += b.height()
+	}
+	if height > mm(maxLabelHeightMM) {
+		return nil, fmt.Errorf("%w: label exceeds %d mm in height", ErrInvalidTemplate, maxLabelHeightMM)
+	}
+
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), image.White, image.Point{}, draw.Src)
+	y := margin
+	for i, b := range l.blocks {
+		if i > 0 {
+			y += spacing
+		}
+		b.draw(img, margin, y, l.width)
+		y += b.height()
+	}
+	return img, nil
+}
+
+type style struct {
+	bold  bool
+	size  float64
+	align string
+	width int
+}
+
+type faceKey struct {
+	bold bool
+	size float64
+}
+
+type layouter struct {
+	data   TemplateData
+	fonts  [2]*opentype.Font
+	faces  map[faceKey]font.Face
+	width  int
+	blocks []layoutBlock
+	// words, align and space describe the text block being collected.
+	words []word
+	align string
+	space bool
+}
+
+type layoutBlock interface {
+	height() int
+	draw(dst *image.Gray, x, y, width int)
+}
+
+type word struct {
+	text  string
+	face  font.Face
+	space bool
+}
+
+func (l *layouter) face(s style) (font.Face, error) {
+	key := faceKey{bold: s.bold, size: s.size}
+	if f, ok := l.faces[key]; ok {
+		return f, nil
+	}
+	f := l.fonts[0]
+	if s.bold {
+		f = l.fonts[1]
+	}
+	face, err := opentype.NewFace(f, &opentype.FaceOptions{Size: s.size, DPI: DPI, Hinting: font.HintingFull})
+	if err != nil {
+		return nil, err
+	}
+	l.faces[key] = face
+	return face, nil
+}
+
+func (l *layouter) walk(n *html.Node, s style) error {
+	switch n.Type {
+	case html.TextNode:
+		return l.text(n.Data, s)
+	case html.ElementNode:
+	case html.DocumentNode:
+		return l.children(n, s)
+	default:
+		return nil
+	}
+
+	tag := n.Data
+	if skippedElements[tag] {
+		return nil
+	}
+	s = applyStyle(n, s)
+
+	switch tag {
+	case "br":
+		if len(l.words) == 0 {
+			face, err := l.face(s)
+			if err != nil {
+				return err
+			}
+			l.blocks = append(l.blocks, &textBlock{lines: []line{{height: face.Metrics().Height.Ceil()}}})
+			return nil
+		}
+		l.flush()
+		return nil
+	case "hr":
+		l.flush()
+		l.blocks = append(l.blocks, ruleBlock{thickness: max(mm(0.3), 1)})
+		return nil
+	case "img":
+		l.flush()
+		return l.image(n, s)
+	}
+
+	if !blockElements[tag] {
+		return l.children(n, s)
+	}
+	l.flush()
+	if err := l.children(n, s); err != nil {
+		return err
+	}
+	l.flush()
+	return nil
+}
+
+func (l *layouter) children(n *html.Node, s style) error {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if err := l.walk(c, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *layouter) text(text string, s style) error {
+	space := l.space || strings.TrimLeftFunc(text, unicode.IsSpace) != text
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		l.space = space
+		return nil
+	}
+	face, err := l.face(s)
+	if err != nil {
+		return err
+	}
+	if len(l.words) == 0 {
+		l.align = s.align
+	}
+	for i, f := range fields {
+		l.words = append(l.words, word{text: f, face: face, space: len(l.words) > 0 && (i > 0 || space)})
+	}
+	l.space = strings.TrimRightFunc(text, unicode.IsSpace) != text
+	return nil
+}
+
+// flush lays out the words collected so far as a text block.
+func (l *layouter) flush() {
+	if len(l.words) == 0 {
+		return
+	}
+	b := &textBlock{align: l.align}
+	var cur line
+	for _, w := range l.words {
+		text := truncate(w.face, w.text, l.width)
+		advance := font.MeasureString(w.face, text).Ceil()
+		gap := 0
+		if w.space && len(cur.words) > 0 {
+			gap = font.MeasureString(w.face, " ").Ceil()
+		}
+		if len(cur.words) > 0 && cur.width+gap+advance > l.width {
+			b.lines = append(b.lines, cur)
+			cur, gap = line{}, 0
+		}
+		cur.words = append(cur.words, placedWord{text: text, face: w.face, x: cur.width + gap})
+		cur.width += gap + advance
+		m := w.face.Metrics()
+		cur.ascent = max(cur.ascent, m.Ascent.Ceil())
+		cur.height = max(cur.height, m.Height.Ceil())
+	}
+	b.lines = append(b.lines, cur)
+	l.blocks = append(l.blocks, b)
+	l.words, l.space = nil, false
+}
+
+func (l *layouter) image(n *html.Node, s style) error {
+	src := attr(n, "src")
+	width := s.width
+	if width == 0 {
+		if px, err := strconv.Atoi(attr(n, "width")); err == nil && px > 0 {
+			width = px * DPI / cssDPI
+		}
+	}
+
+	var img image.Image
+	if src == string(l.data.QRCode) && src != "" {
+		if width == 0 {
+			width = mm(symbolSizeMM)
+		}
+		symbol, err := Symbol(l.data.CheckInURL, min(width, l.width))
+		if err != nil {
+			return err
+		}
+		img = symbol
+	} else {
+		decoded, err := decodeDataURI(src)
+		if err != nil || decoded == nil {
+			return err
+		}
+		b := decoded.Bounds()
+		if width == 0 {
+			width = b.Dx() * DPI / cssDPI
+		}
+		width = min(width, l.width)
+		height := max(b.Dy()*width/max(b.Dx(), 1), 1)
+		scaled := image.NewGray(image.Rect(0, 0, width, height))
+		draw.Draw(scaled, scaled.Bounds(), image.White, image.Point{}, draw.Src)
+		draw.ApproxBiLinear.Scale(scaled, scaled.Bounds(), decoded, b, draw.Over, nil)
+		img = scaled
+	}
+	l.blocks = append(l.blocks, imageBlock{img: img, align: s.align})
+	return nil
+}
+
+// decodeDataURI decodes an image given as base64 data URI. Other sources
+// yield no image.
+func decodeDataURI(src string) (image.Image, error) {
+	header, payload, ok := strings.Cut(src, ",")
+	if !ok || !strings.HasPrefix(header, "data:image/") || !strings.HasSuffix(header, ";base64") {
+		return nil, nil
+	}
+	raw, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid image data URI", ErrInvalidTemplate)
+	}
+	config, _, err := image.DecodeConfig(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("%w: unsupported image data URI", ErrInvalidTemplate)
+	}
+	if config.Width > maxImageSide || config.Height > maxImageSide {
+		return nil, fmt.Errorf("%w: images must not exceed %d pixels per side", ErrInvalidTemplate, maxImageSide)
+	}
+	img, _, err := image.Decode(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("%w: unsupported image data URI", ErrInvalidTemplate)
+	}
+	return img, nil
+}
+
+// applyStyle returns s as changed by the element n.
+func applyStyle(n *html.Node, s style) style {
+	s.width = 0
+	switch n.Data {
+	case "b", "strong", "th":
+		s.bold = true
+	case "small":
+		s.size *= 0.8
+	case "center":
+		s.align = "center"
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		s.bold = true
+		s.size = headingSizes[n.Data]
+	}
+	if a := strings.ToLower(attr(n, "align")); a == "left" || a == "center" || a == "right" {
+		s.align = a
+	}
+
+	for _, decl := range strings.Split(attr(n, "style"), ";") {
+		key, value, ok := strings.Cut(decl, ":")
+		if !ok {
+			continue
+		}
+		key, value = strings.ToLower(strings.TrimSpace(key)), strings.ToLower(strings.TrimSpace(value))
+		switch key {
+		case "text-align":
+			if value == "left" || value == "center" || value == "right" {
+				s.align = value
+			}
+		case "font-weight":
+			if w, err := strconv.Atoi(value); err == nil {
+				s.bold = w >= 600
+			} else {
+				s.bold = value == "bold" || value == "bolder"
+			}
+		case "font-size":
+			if px := parseLength(value); px > 0 {
+				s.size = min(max(float64(px)*72/DPI, 4), 72)
+			}
+		case "width":
+			s.width = parseLength(value)
+		}
+	}
+	return s
+}
+
+// parseLength returns a CSS length in pixels at DPI, or 0 if it is not an
+// absolute length.
+func parseLength(v string) int {
+	for _, u := range cssUnits {
+		if n, ok := strings.CutSuffix(v, u.suffix); ok {
+			if f, err := strconv.ParseFloat(strings.TrimSpace(n), 64); err == nil && f > 0 {
+				return int(f * u.pixels)
+			}
+		}
+	}
+	return 0
+}
+
+// cssUnits are the absolute CSS length units with their size in pixels at
+// DPI.
+var cssUnits = []struct {
+	suffix string
+	pixels float64
+}{
+	{"mm", DPI / 25.4},
+	{"cm", DPI / 2.54},
+	{"in", DPI},
+	{"pt", DPI / 72.0},
+	{"px", DPI / float64(cssDPI)},
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+type placedWord struct {
+	text string
+	face font.Face
+	x    int
+}
+
+type line struct {
+	words  []placedWord
+	width  int
+	ascent int
+	height int
+}
+
+type textBlock struct {
+	lines []line
+	align string
+}
+
+func (b *textBlock) height() int {
+	h := 0
+	for _, l := range b.lines {
+		h += l.height
+	}
+	return h
+}
+
+func (b *textBlock) draw(dst *image.Gray, x, y, width int) {
+	for _, l := range b.lines {
+		offset := alignOffset(b.align, width, l.width)
+		for _, w := range l.words {
+			d := &font.Drawer{Dst: dst, Src: image.Black, Face: w.face, Dot: fixed.P(x+offset+w.x, y+l.ascent)}
+			d.DrawString(w.text)
+		}
+		y += l.height
+	}
+}
+
+type imageBlock struct {
+	img   image.Image
+	align string
+}
+
+func (b imageBlock) height() int {
+	return b.img.Bounds().Dy()
+}
+
+func (b imageBlock) draw(dst *image.Gray, x, y, width int) {
+	bounds := b.img.Bounds()
+	at := image.Pt(x+alignOffset(b.align, width, bounds.Dx()), y)
+	draw.Draw(dst, bounds.Sub(bounds.Min).Add(at), b.img, bounds.Min, draw.Src)
+}
+
+type ruleBlock struct {
+	thickness int
+}
+
+func (b ruleBlock) height() int {
+	return b.thickness
+}
+
+func (b ruleBlock) draw(dst *image.Gray, x, y, width int) {
+	draw.Draw(dst, image.Rect(x, y, x+width, y+b.thickness), image.Black, image.Point{}, draw.Src)
+}
+
+func alignOffset(align string, width, content int) int {
+	switch align {
+	case "left":
+		return 0
+	case "right":
+		return width - content
+	default:
+		return (width - content) / 2
+	}
+}
@@ -0,0 +1,146 @@
+package qr
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// Paper is the paper size of print sheets.
+type Paper string
+
+const (
+	PaperA4     Paper = "a4"
+	PaperLetter Paper = "letter"
+)
+
+// size returns the size of the paper in pixels at DPI.
+func (p Paper) size() image.Point {
+	if p == PaperLetter {
+		return image.Pt(mm(215.9), mm(279.4))
+	}
+	return image.Pt(mm(210), mm(297))
+}
+
+// sheetMarginMM is the unprintable margin of print sheets.
+const sheetMarginMM = 10
+
+// cutLine is the colour of the lines around the labels on print sheets.
+var cutLine = color.Gray{Y: 0xc0}
+
+// SheetLabel is a rendered label of a print sheet. Labels are kept PNG
+// encoded, so that batches of hundreds of labels fit into memory.
+type SheetLabel struct {
+	// Name is the name of the label's file in ZIP archives, without the
+	// extension.
+	Name string
+	png  []byte
+	size image.Point
+}
+
+// NewSheetLabel encodes a rendered label.
+func NewSheetLabel(name string, img image.Image) (*SheetLabel, error) {
+	var buf bytes.Buffer
+	if err := encodePNG(&buf, img); err != nil {
+		return nil, err
+	}
+	return &SheetLabel{Name: name, png: buf.Bytes(), size: img.Bounds().Size()}, nil
+}
+
+// WriteSheets writes the labels as PDF of sheets of the given paper size.
+// The labels are laid out row by row on a grid whose cells fit the largest
+// label, so that every sheet holds as many labels as fit on it. Thin gray
+// lines around the cells mark where to cut.
+func WriteSheets(w io.Writer, labels []*SheetLabel, paper Paper) error {
+	page := paper.size()
+	margin := mm(sheetMarginMM)
+
+	var cell image.Point
+	for _, l := range labels {
+		cell.X, cell.Y = max(cell.X, l.size.X), max(cell.Y, l.size.Y)
+	}
+	columns := max((page.X-2*margin)/max(cell.X, 1), 1)
+	rows := max((page.Y-2*margin)/max(cell.Y, 1), 1)
+	perPage := columns * rows
+	pages := max((len(labels)+perPage-1)/perPage, 1)
+
+	// The grid is centred on the sheet.
+	origin := image.Pt((page.X-columns*cell.X)/2, (page.Y-rows*cell.Y)/2)
+
+	return writePDF(w, pages, func(p int) (image.Image, error) {
+		sheet := image.NewGray(image.Rectangle{Max: page})
+		draw.Draw(sheet, sheet.Bounds(), image.White, image.Point{}, draw.Src)
+
+		for i, l := range labels[p*perPage : min((p+1)*perPage, len(labels))] {
+			img, err := png.Decode(bytes.NewReader(l.png))
+			if err != nil {
+				return nil, err
+			}
+			at := origin.Add(image.Pt(i%columns*cell.X, i/columns*cell.Y))
+			strokeRect(sheet, image.Rectangle{Min: at, Max: at.Add(cell)})
+
+			offset := image.Pt((cell.X-l.size.X)/2, (cell.Y-l.size.Y)/2)
+			draw.Draw(sheet, image.Rectangle{Min: at.Add(offset), Max: at.Add(offset).Add(l.size)}, img, img.Bounds().Min, draw.Src)
+		}
+		return sheet, nil
+	})
+}
+
+// strokeRect draws the outline of r in the cut line colour.
+func strokeRect(dst *image.Gray, r image.Rectangle) {
+	src := image.NewUniform(cutLine)
+	const w = 2
+	for _, edge := range []image.Rectangle{
+		{Min: r.Min, Max: image.Pt(r.Max.X, r.Min.Y+w)},
+		{Min: image.Pt(r.Min.X, r.Max.Y-w), Max: r.Max},
+		{Min: r.Min, Max: image.Pt(r.Min.X+w, r.Max.Y)},
+		{Min: image.Pt(r.Max.X-w, r.Min.Y), Max: r.Max},
+	} {
+		draw.Draw(dst, edge, src, image.Point{}, draw.Src)
+	}
+}
+
+// WriteZIP writes the labels as ZIP archive of PNG images. The files are
+// numbered in the order of labels, so that they sort the same way.
+func WriteZIP(w io.Writer, labels []*SheetLabel) error {
+	zw := zip.NewWriter(w)
+	for i, l := range labels {
+		f, err := zw.CreateHeader(&zip.FileHeader{
+			Name: fmt.Sprintf("%03d-%s.png", i+1, fileName(l.Name)),
+			// PNG data is compressed already.
+			Method: zip.Store,
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := f.Write(l.png); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+// fileName returns name with everything but letters, digits, dots, dashes
+// and underscores replaced, cut to a reasonable length.
+func fileName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '.' || r == '-' || r == '_' {
+			return r
+		}
+		return '_'
+	}, strings.TrimSpace(name))
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64])
+	}
+	if name == "" {
+		return "label"
+	}
+	return name
+}
@@ -0,0 +1,381 @@
+package qr
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"html/template"
+	"image"
+	"strings"
+	"text/template/parse"
+	"time"
+
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"golang.org/x/net/html"
+)
+
+// Limits of QR templates.
+const (
+	// MaxTemplateSize is the maximum size of a template source in bytes.
+	MaxTemplateSize = 64 << 10
+	// maxOutputSize is the maximum size of a rendered template in bytes.
+	maxOutputSize = 512 << 10
+	// executionTimeout is how long rendering a template may take.
+	executionTimeout = 2 * time.Second
+)
+
+// ErrInvalidTemplate is returned if a template cannot be parsed, uses
+// constructs that are not allowed or renders HTML that would load external
+// resources.
+var ErrInvalidTemplate = errors.New("invalid QR template")
+
+// allowedFuncs are the template functions available to templates. Functions
+// that call into the data, print formatted values of arbitrary size or loop
+// are left out, so that the cost of rendering is bounded by the template.
+var allowedFuncs = map[string]bool{
+	"and": true, "or": true, "not": true,
+	"eq": true, "ne": true, "lt": true, "le": true, "gt": true, "ge": true,
+	"len": true, "index": true, "slice": true,
+	"html": true, "js": true, "urlquery": true,
+}
+
+// TemplateData is the data QR templates are rendered with. It only holds
+// plain values, so that templates cannot reach anything beyond them.
+type TemplateData struct {
+	Place    TemplateEntity
+	Area     TemplateEntity
+	Building TemplateEntity
+	// QRCode is the QR symbol encoding CheckInURL as PNG data URI, meant to
+	// be used as src of an img element.
+	QRCode template.URL
+	// CheckInURL is the check-in URL of the place.
+	CheckInURL string
+}
+
+// TemplateEntity describes a place, area or building in TemplateData.
+type TemplateEntity struct {
+	Name        string
+	Description string
+	Location    string
+}
+
+// NewTemplateData returns the template data of a place. The area of the
+// place and its building have to be preloaded.
+func NewTemplateData(place *model.Place, checkInURL string) (TemplateData, error) {
+	data := TemplateData{
+		Place:      TemplateEntity{Name: place.Name, Description: deref(place.Description), Location: deref(place.Location)},
+		CheckInURL: checkInURL,
+	}
+	if a := place.Area; a != nil {
+		data.Area = TemplateEntity{Name: a.Name, Description: deref(a.Description), Location: deref(a.Location)}
+		if b := a.Building; b != nil {
+			data.Building = TemplateEntity{Name: b.Name, Description: deref(b.Description), Location: deref(b.Location)}
+		}
+	}
+
+	symbol, err := Symbol(checkInURL, mm(symbolSizeMM))
+	if err != nil {
+		return TemplateData{}, err
+	}
+	var buf bytes.Buffer
+	if err := encodePNG(&buf, symbol); err != nil {
+		return TemplateData{}, err
+	}
+	data.QRCode = template.URL("data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes()))
+	return data, nil
+}
+
+// SampleData returns the template data templates are previewed and
+// validated with.
+func SampleData(checkInURL string) (TemplateData, error) {
+	return NewTemplateData(&model.Place{
+		Name:     "Desk 1.01",
+		Location: ptr("Window row"),
+		Area: &model.Area{
+			Name:     "Open Space",
+			Location: ptr("1st floor"),
+			Building: &model.Building{Name: "Main Building", Location: ptr("1 Example Street")},
+		},
+	}, checkInURL)
+}
+
+// Template is a parsed QR template.
+type Template struct {
+	tmpl *template.Template
+}
+
+// ParseTemplate parses a QR template. Besides the syntax, it checks that the
+// template only uses allowed functions, neither defines nor includes
+// templates and does not use range; failures wrap ErrInvalidTemplate.
+func ParseTemplate(src string) (*Template, error) {
+	if len(src) > MaxTemplateSize {
+		return nil, fmt.Errorf("%w: template exceeds %d bytes", ErrInvalidTemplate, MaxTemplateSize)
+	}
+	tmpl, err := template.New("qr").Parse(src)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+	if len(tmpl.Templates()) > 1 {
+		return nil, fmt.Errorf("%w: defining templates is not allowed", ErrInvalidTemplate)
+	}
+	if tmpl.Tree != nil {
+		if err := checkNode(tmpl.Tree.Root); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+		}
+	}
+	return &Template{tmpl: tmpl}, nil
+}
+
+// ValidateTemplate checks that src parses and renders sandboxed HTML that
+// can be rasterized with the sample data.
+func ValidateTemplate(ctx context.Context, src string, sample TemplateData) error {
+	t, err := ParseTemplate(src)
+	if err != nil {
+		return err
+	}
+	doc, err := t.Execute(ctx, sample)
+	if err != nil {
+		return err
+	}
+	_, err = RenderHTML(doc, sample)
+	return err
+}
+
+// RenderTemplate renders the template source src with data and rasterizes
+// the result.
+func RenderTemplate(ctx context.Context, src string, data TemplateData) (*image.Gray, error) {
+	t, err := ParseTemplate(src)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := t.Execute(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	return RenderHTML(doc, data)
+}
+
+// Execute renders the template with data. Rendering is aborted once it
+// exceeds the output size limit or the execution timeout. The output must
+// not load any external resource: scripts, frames, objects, links and base
+// elements are rejected, resources may only be given as data URIs, CSS may
+// only use harmless functions and at-rules, and hyperlinks may only point to
+// http, https and mailto URLs.
+func (t *Template) Execute(ctx context.Context, data TemplateData) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, executionTimeout)
+	defer cancel()
+
+	w := &limitedWriter{ctx: ctx, limit: maxOutputSize}
+	done := make(chan error, 1)
+	go func() { done <- t.tmpl.Execute(w, data) }()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return "", fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+		}
+	case <-ctx.Done():
+		return "", fmt.Errorf("%w: rendering exceeded %s", ErrInvalidTemplate, executionTimeout)
+	}
+
+	out := w.buf.String()
+	if err := checkHTML(out); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+	return out, nil
+}
+
+// limitedWriter buffers the output of a template until it exceeds limit or
+// ctx is done.
+type limitedWriter struct {
+	ctx   context.Context
+	buf   bytes.Buffer
+	limit int
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if err := w.ctx.Err(); err != nil {
+		return 0, err
+	}
+	if w.buf.Len()+len(p) > w.limit {
+		return 0, fmt.Errorf("output exceeds %d bytes", w.limit)
+	}
+	return w.buf.Write(p)
+}
+
+func checkNode(node parse.Node) error {
+	switch n := node.(type) {
+	case nil:
+		return nil
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, c := range n.Nodes {
+			if err := checkNode(c); err != nil {
+				return err
+			}
+		}
+	case *parse.ActionNode:
+		return checkNode(n.Pipe)
+	case *parse.IfNode:
+		return checkBranch(&n.BranchNode)
+	case *parse.WithNode:
+		return checkBranch(&n.BranchNode)
+	case *parse.RangeNode:
+		return fmt.Errorf("range is not allowed")
+	case *parse.TemplateNode:
+		return fmt.Errorf("including templates is not allowed")
+	case *parse.PipeNode:
+		if n == nil {
+			return nil
+		}
+		for _, cmd := range n.Cmds {
+			for _, arg := range cmd.Args {
+				if err := checkNode(arg); err != nil {
+					return err
+				}
+			}
+		}
+	case *parse.ChainNode:
+		return checkNode(n.Node)
+	case *parse.IdentifierNode:
+		if !allowedFuncs[n.Ident] {
+			return fmt.Errorf("function %q is not allowed", n.Ident)
+		}
+	}
+	return nil
+}
+
+func checkBranch(n *parse.BranchNode) error {
+	if err := checkNode(n.Pipe); err != nil {
+		return err
+	}
+	if err := checkNode(n.List); err != nil {
+		return err
+	}
+	return checkNode(n.ElseList)
+}
+
+// forbiddenElements are elements that load or execute external content.
+var forbiddenElements = map[string]bool{
+	"script": true, "iframe": true, "frame": true, "frameset": true,
+	"object": true, "embed": true, "applet": true, "link": true,
+	"base": true, "audio": true, "video": true,
+	"source": true, "track": true, "portal": true, "form": true,
+}
+
+// resourceAttrs are attributes that make the browser fetch their value.
+var resourceAttrs = map[string]bool{
+	"src": true, "srcset": true, "poster": true, "background": true,
+	"action": true, "formaction": true, "data": true, "xlink:href": true,
+	"ping": true, "manifest": true, "codebase": true, "cite": true,
+}
+
+// checkHTML checks that the rendered HTML does not load external resources.
+func checkHTML(doc string) error {
+	z := html.NewTokenizer(strings.NewReader(doc))
+	inStyle := false
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return nil
+		case html.StartTagToken, html.SelfClosingTagToken:
+			t := z.Token()
+			if forbiddenElements[t.Data] {
+				return fmt.Errorf("element <%s> is not allowed", t.Data)
+			}
+			if t.Data == "meta" && hasAttr(t, "http-equiv") {
+				return fmt.Errorf("element <meta http-equiv> is not allowed")
+			}
+			inStyle = t.Data == "style"
+			for _, a := range t.Attr {
+				if err := checkAttr(t.Data, a); err != nil {
+					return err
+				}
+			}
+		case html.EndTagToken:
+			inStyle = false
+		case html.TextToken:
+			if !inStyle {
+				continue
+			}
+			// Style elements inside SVG decode character references, those
+			// in HTML do not; the CSS has to pass either way.
+			css := string(z.Text())
+			if err := checkCSS(css); err != nil {
+				return err
+			}
+			if err := checkCSS(html.UnescapeString(css)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// linkSchemes are the URL schemes links may use. Links without a scheme are
+// relative to the document and allowed as well.
+var linkSchemes = map[string]bool{
+	"http": true, "https": true, "mailto": true,
+}
+
+// checkAttr checks an attribute of element. Attribute values have their
+// character references decoded by the tokenizer already.
+func checkAttr(element string, a html.Attribute) error {
+	key := strings.ToLower(a.Key)
+	switch {
+	case strings.HasPrefix(key, "on"):
+		return fmt.Errorf("event handler %s is not allowed", key)
+	case key == "style":
+		return checkCSS(a.Val)
+	case key == "href" && element != "a":
+		return fmt.Errorf("attribute href is only allowed on links")
+	case key == "href":
+		if scheme := urlScheme(a.Val); scheme != "" && !linkSchemes[scheme] {
+			return fmt.Errorf("links must use http, https or mailto, not %s", scheme)
+		}
+	case resourceAttrs[key] && urlScheme(a.Val) != "data":
+		return fmt.Errorf("attribute %s of <%s> must be a data URI", key, element)
+	}
+	return nil
+}
+
+// urlScheme returns the scheme of a URL in lower case, or "" if it is
+// relative. Browsers ignore control characters and spaces around a URL and
+// tabs and newlines within it; all of them are dropped before looking for
+// the scheme, so that "java\tscript:" is taken for what it is.
+func urlScheme(url string) string {
+	url = strings.Map(func(r rune) rune {
+		if r <= ' ' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, url)
+	i := strings.IndexAny(url, ":/?#")
+	if i <= 0 || url[i] != ':' {
+		return ""
+	}
+	return strings.ToLower(url[:i])
+}
+
+func hasAttr(t html.Token, key string) bool {
+	for _, a := range t.Attr {
+		if strings.EqualFold(a.Key, key) {
+			return true
+		}
+	}
+	return false
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func ptr(s string) *string {
+	return &s
+}
@@ -0,0 +1,143 @@
+package qr
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseTemplate(t *testing.T) {
+	tests := []struct {
+		name  string
+		src   string
+		valid bool
+	}{
+		{"fields", `<h1>{{.Place.Name}}</h1>{{if .Area.Name}}<p>{{.Area.Name}}</p>{{end}}`, true},
+		{"allowed functions", `{{if and (gt (len .Place.Name) 3) (ne .Area.Name "")}}{{slice .Place.Name 0 3}}{{end}}`, true},
+		{"with", `{{with .Building}}{{.Name}}{{else}}-{{end}}`, true},
+		{"range", `{{range .Place.Name}}x{{end}}`, false},
+		{"define", `{{define "x"}}x{{end}}`, false},
+		{"template", `{{template "qr"}}`, false},
+		{"block", `{{block "x" .}}x{{end}}`, false},
+		{"printf", `{{printf "%0999999d" 1}}`, false},
+		{"call", `{{call .QRCode}}`, false},
+		{"function in branch", `{{if .Place.Name}}{{print .Place.Name}}{{end}}`, false},
+		{"function in else", `{{if .Place.Name}}x{{else}}{{println 1}}{{end}}`, false},
+		{"syntax error", `{{if .Place.Name}}`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseTemplate(tt.src)
+			if tt.valid != (err == nil) {
+				t.Fatalf("error = %v, want valid %t", err, tt.valid)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidTemplate) {
+				t.Fatalf("error %v does not wrap ErrInvalidTemplate", err)
+			}
+		})
+	}
+}
+
+func TestExecuteRejectsExternalResources(t *testing.T) {
+	tests := []struct {
+		name  string
+		src   string
+		valid bool
+	}{
+		{"plain markup", `<div style="text-align: center"><b>Desk</b><img src="{{.QRCode}}" width="100"></div>`, true},
+
+		// Elements and attributes.
+		{"script", `<script>alert(1)</script>`, false},
+		{"iframe", `<iframe srcdoc="x"></iframe>`, false},
+		{"upper-case element", `<SCRIPT>alert(1)</SCRIPT>`, false},
+		{"svg script", `<svg><script>alert(1)</script></svg>`, false},
+		{"link element", `<link rel="stylesheet" href="https://example.com/a.css">`, false},
+		{"base", `<base href="https://example.com/">`, false},
+		{"meta refresh", `<meta http-equiv="refresh" content="0; url=https://example.com">`, false},
+		{"event handler", `<img src="data:image/png;base64,AA==" onerror="alert(1)">`, false},
+		{"upper-case event handler", `<div ONCLICK="alert(1)">x</div>`, false},
+		{"remote image", `<img src="https://example.com/a.png">`, false},
+		{"protocol-relative image", `<img src="//example.com/a.png">`, false},
+		{"padded data image", "<img src=\" \tdata:image/png;base64,AA==\">", true},
+		{"remote srcset", `<img src="data:image/png;base64,AA==" srcset="https://example.com/a.png 2x">`, false},
+		{"svg image href", `<svg><image href="https://example.com/a.png"/></svg>`, false},
+
+		// Links.
+		{"https link", `<a href="https://example.com/help">help</a>`, true},
+		{"mailto link", `<a href="mailto:facilities@example.com">mail</a>`, true},
+		{"relative link", `<a href="/places">places</a>`, true},
+		{"fragment link", `<a href="#top">top</a>`, true},
+		{"javascript link", `<a href="javascript:alert(1)">x</a>`, false},
+		{"upper-case javascript link", `<a href="JavaScript:alert(1)">x</a>`, false},
+		{"javascript link with tab reference", `<a href="java&#9;script:alert(1)">x</a>`, false},
+		{"javascript link with newline reference", `<a href="java&#x0A;script:alert(1)">x</a>`, false},
+		{"javascript link with encoded letter", `<a href="&#106;avascript:alert(1)">x</a>`, false},
+		{"javascript link with leading control character", `<a href="&#1;javascript:alert(1)">x</a>`, false},
+		{"javascript link with leading space", `<a href="  javascript:alert(1)">x</a>`, false},
+		{"vbscript link", `<a href="vbscript:msgbox(1)">x</a>`, false},
+		{"data link", `<a href="data:text/html,<script>alert(1)</script>">x</a>`, false},
+
+		// Style attributes.
+		{"allowed CSS functions", `<div style="color: rgb(0, 0, 0); width: calc(100% - 2mm)">x</div>`, true},
+		{"url", `<div style="background: url(https://example.com/a.png)">x</div>`, false},
+		{"quoted url", `<div style="background: url('https://example.com/a.png')">x</div>`, false},
+		{"upper-case url", `<div style="background: URL(https://example.com/a.png)">x</div>`, false},
+		{"escaped url", `<div style="background: \75 rl(https://example.com/a.png)">x</div>`, false},
+		{"escaped url without space", `<div style="background: u\72l(https://example.com/a.png)">x</div>`, false},
+		{"six-digit escapes", `<div style="background: \000075\000072\00006C(x)">x</div>`, false},
+		{"url with character reference", `<div style="background: u&#114;l(https://example.com/a.png)">x</div>`, false},
+		{"image-set", `<div style="background: image-set('a.png' 1x)">x</div>`, false},
+		{"prefixed image-set", `<div style="background: -webkit-image-set('a.png' 1x)">x</div>`, false},
+		{"expression", `<div style="width: expression(alert(1))">x</div>`, false},
+		{"escaped expression", `<div style="width: e\78pression(alert(1))">x</div>`, false},
+		{"url in a string", `<div style="font-family: 'url(x)'">x</div>`, true},
+
+		// Style elements.
+		{"allowed style sheet", `<style>@media print { .a:not(.b) { color: rgb(0,0,0) } }</style><p class="a">x</p>`, true},
+		{"import", `<style>@import "https://example.com/a.css";</style>`, false},
+		{"escaped import", `<style>@\69mport "https://example.com/a.css";</style>`, false},
+		{"upper-case import", `<style>@IMPORT url(a.css);</style>`, false},
+		{"import after comment", `<style>/* @media */@import "a.css";</style>`, false},
+		{"font-face", `<style>@font-face { font-family: x; src: local(Arial) }</style>`, false},
+		{"url in style element", `<style>p { background: url(a.png) }</style>`, false},
+		{"url split by comment", `<style>p { background: u/**/rl(a.png) }</style>`, false},
+		{"svg style with references", `<svg><style>&#64;import "https://example.com/a.css";</style></svg>`, false},
+		{"comment hiding nothing", `<style>/* url(a.png) */ p { color: black }</style>`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := ParseTemplate(tt.src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = tmpl.Execute(t.Context(), TemplateData{QRCode: "data:image/png;base64,AA=="})
+			if tt.valid != (err == nil) {
+				t.Fatalf("error = %v, want valid %t", err, tt.valid)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidTemplate) {
+				t.Fatalf("error %v does not wrap ErrInvalidTemplate", err)
+			}
+		})
+	}
+}
+
+func TestURLScheme(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"https://example.com", "https"},
+		{"MAILTO:a@example.com", "mailto"},
+		{"java\tscript:alert(1)", "javascript"},
+		{"\x00 javascript:alert(1)", "javascript"},
+		{"/path:with-colon", ""},
+		{"?q=a:b", ""},
+		{"#a:b", ""},
+		{"relative", ""},
+		{":no-scheme", ""},
+	}
+	for _, tt := range tests {
+		if got := urlScheme(tt.url); got != tt.want {
+			t.Errorf("urlScheme(%q) = %q, want %q", tt.url, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package reservation
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pixlcrashr/roomy/pkg/blocking"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"gorm.io/gorm"
+)
+
+// PlaceStatus summarizes the availability of a place within a time window.
+type PlaceStatus string
+
+const (
+	PlaceAvailable          PlaceStatus = "available"
+	PlacePartiallyAvailable PlaceStatus = "partiallyAvailable"
+	PlaceFullyBooked        PlaceStatus = "fullyBooked"
+	PlaceBlocked            PlaceStatus = "blocked"
+	PlaceDisabled           PlaceStatus = "disabled"
+)
+
+// PlaceSummary is the availability of a single place within a time window.
+type PlaceSummary struct {
+	Place  *model.Place
+	Status PlaceStatus
+	// NextAvailable is the start of the first available slot on the day of
+	// the window that starts at or after the window start and has not ended
+	// yet, if any.
+	NextAvailable *time.Time
+	// BlockingReason and BlockingSource describe the first blocking within
+	// the window. BlockingSource is empty if no blocking applies.
+	BlockingReason string
+	BlockingSource string
+}
+
+// AreaAvailability summarizes the availability of every place in an area
+// within [start, end), which must lie on the day of start. Places, time slot
+// configurations, blockings and reservations are each loaded with a single
+// query, independent of the number of places in the area.
+func (s *Service) AreaAvailability(ctx context.Context, area *model.Area, start, end time.Time) ([]PlaceSummary, error) {
+	places, err := dbgen.PlaceQuery[model.Place](s.db).ListByArea(ctx, area.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(places) == 0 {
+		return []PlaceSummary{}, nil
+	}
+
+	configs, err := s.loadTimeSlotConfigs(ctx, s.db, places)
+	if err != nil {
+		return nil, err
+	}
+
+	day := s.midnight(start.In(s.location))
+	from, to := day, day.AddDate(0, 0, 1)
+	intervals, err := blocking.ForArea(ctx, s.db, area, places, from, to, s.location)
+	if err != nil {
+		return nil, err
+	}
+	reservations, err := dbgen.ReservationQuery[model.Reservation](s.db).ListOverlappingInArea(ctx, area.ID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	byPlace := map[uuid.UUID][]*model.Reservation{}
+	for _, r := range reservations {
+		byPlace[r.PlaceID] = append(byPlace[r.PlaceID], r)
+	}
+
+	now := s.now()
+	summaries := make([]PlaceSummary, 0, len(places))
+	for _, place := range places {
+		summary := PlaceSummary{Place: place}
+		switch {
+		case place.IsDisabled:
+			summary.Status = PlaceDisabled
+		case !place.IsBookable:
+			summary.Status = PlaceBlocked
+			summary.BlockingReason = unbookableReason(place)
+			summary.BlockingSource = blocking.EntityPlace
+		default:
+			slots, err := s.grid(configs[place.ID], day, day)
+			if err != nil {
+				return nil, err
+			}
+			markSlots(slots, intervals[place.ID], byPlace[place.ID])
+			summarize(&summary, slots, start, end, now)
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, nil
+}
+
+// summarize derives the status of a place from its marked slots of a day.
+func summarize(summary *PlaceSummary, slots []Slot, start, end, now time.Time) {
+	var total, available, reserved int
+	for i := range slots {
+		slot := &slots[i]
+		if slot.Status == SlotAvailable && summary.NextAvailable == nil &&
+			!slot.Start.Before(start) && slot.End.After(now) {
+			t := slot.Start
+			summary.NextAvailable = &t
+		}
+		if !slot.Start.Before(end) || !slot.End.After(start) {
+			continue
+		}
+
+		total++
+		switch slot.Status {
+		case SlotAvailable:
+			available++
+		case SlotReserved:
+			reserved++
+		case SlotBlocked:
+			if summary.BlockingSource == "" {
+				summary.BlockingReason = slot.BlockingReason
+				summary.BlockingSource = slot.BlockingSource
+			}
+		}
+	}
+
+	switch {
+	case total == 0:
+		// The window lies outside the booking hours of the place.
+		summary.Status = PlaceBlocked
+		summary.BlockingReason = "Outside booking hours"
+	case available == total:
+		summary.Status = PlaceAvailable
+	case available > 0:
+		summary.Status = PlacePartiallyAvailable
+	case reserved > 0:
+		summary.Status = PlaceFullyBooked
+	default:
+		summary.Status = PlaceBlocked
+	}
+}
+
+// loadTimeSlotConfigs returns the time slot configuration of each place,
+// falling back to the default for places without one.
+func (s *Service) loadTimeSlotConfigs(ctx context.Context, tx *gorm.DB, places []*model.Place) (map[uuid.UUID]*model.TimeSlotConfig, error) {
+	ids := make([]uuid.UUID, len(places))
+	for i, place := range places {
+		ids[i] = place.ID
+	}
+
+	var found []*model.TimeSlotConfig
+	if err := tx.WithContext(ctx).Where("place_id IN ?", ids).Find(&found).Error; err != nil {
+		return nil, err
+	}
+
+	configs := make(map[uuid.UUID]*model.TimeSlotConfig, len(places))
+	for _, config := range found {
+		configs[config.PlaceID] = config
+	}
+	for _, id := range ids {
+		if configs[id] == nil {
+			configs[id] = &model.TimeSlotConfig{PlaceID: id, IntervalMinutes: DefaultIntervalMinutes}
+		}
+	}
+	return configs, nil
+}
@@ -0,0 +1,177 @@
+package reservation
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pixlcrashr/roomy/pkg/blocking"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+)
+
+// MaxAvailabilityDays limits the number of days an availability query
+// covers; longer ranges are truncated.
+const MaxAvailabilityDays = 31
+
+// SlotStatus is the state of a time slot.
+type SlotStatus string
+
+const (
+	SlotAvailable SlotStatus = "available"
+	SlotBlocked   SlotStatus = "blocked"
+	SlotReserved  SlotStatus = "reserved"
+)
+
+// Slot is a single time slot of a place's grid.
+type Slot struct {
+	Start  time.Time
+	End    time.Time
+	Status SlotStatus
+	// BlockingReason and BlockingSource describe why a blocked slot is
+	// unavailable. BlockingSource is the entity type the blocking belongs to.
+	BlockingReason string
+	BlockingSource string
+	// ReservationID is set for reserved slots. Slots deliberately carry no
+	// details about the user holding the reservation.
+	ReservationID *uuid.UUID
+}
+
+// DateRange returns the first and last calendar day of an availability
+// query as local midnight, truncating it to MaxAvailabilityDays. Both
+// dates are taken as given, independent of their time zone.
+func (s *Service) DateRange(startDate, endDate time.Time) (time.Time, time.Time) {
+	first := s.midnight(startDate)
+	last := s.midnight(endDate)
+	if limit := first.AddDate(0, 0, MaxAvailabilityDays-1); last.After(limit) {
+		last = limit
+	}
+	return first, last
+}
+
+// PlaceAvailability walks the time slot grid of a place from startDate to
+// endDate (inclusive calendar days) and marks each slot as available,
+// blocked or reserved. Blockings and reservations are loaded once for the
+// whole range.
+func (s *Service) PlaceAvailability(ctx context.Context, place *model.Place, startDate, endDate time.Time) ([]Slot, error) {
+	first, last := s.DateRange(startDate, endDate)
+	if last.Before(first) {
+		return []Slot{}, nil
+	}
+	from, to := first, last.AddDate(0, 0, 1)
+
+	config, err := s.loadTimeSlotConfig(ctx, s.db, place.ID)
+	if err != nil {
+		return nil, err
+	}
+	slots, err := s.grid(config, first, last)
+	if err != nil {
+		return nil, err
+	}
+
+	// Places that cannot be booked at all are blocked as a whole.
+	if reason := unbookableReason(place); reason != "" {
+		for i := range slots {
+			slots[i].Status = SlotBlocked
+			slots[i].BlockingReason = reason
+			slots[i].BlockingSource = blocking.EntityPlace
+		}
+		return slots, nil
+	}
+
+	intervals, err := blocking.ForPlace(ctx, s.db, place, from, to, s.location)
+	if err != nil {
+		return nil, err
+	}
+	reservations, err := dbgen.ReservationQuery[model.Reservation](s.db).ListOverlapping(ctx, place.ID, from, to, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	markSlots(slots, intervals, reservations)
+	return slots, nil
+}
+
+// grid returns the empty time slots of every day from first to last.
+func (s *Service) grid(config *model.TimeSlotConfig, first, last time.Time) ([]Slot, error) {
+	interval := time.Duration(config.IntervalMinutes) * time.Minute
+	if interval <= 0 {
+		interval = DefaultIntervalMinutes * time.Minute
+	}
+
+	slots := []Slot{}
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		dayStart, dayEnd := day, day.AddDate(0, 0, 1)
+		if config.EarliestStartTime != nil {
+			earliest, err := clockOn(day, *config.EarliestStartTime)
+			if err != nil {
+				return nil, err
+			}
+			dayStart = earliest
+		}
+		if config.LatestEndTime != nil {
+			latest, err := clockOn(day, *config.LatestEndTime)
+			if err != nil {
+				return nil, err
+			}
+			dayEnd = latest
+		}
+
+		for start := dayStart; !start.Add(interval).After(dayEnd); start = start.Add(interval) {
+			slots = append(slots, Slot{Start: start, End: start.Add(interval), Status: SlotAvailable})
+		}
+	}
+	return slots, nil
+}
+
+// markSlots sets the status of the ordered slots from the ordered blocked
+// intervals and reservations in a single sweep. Blockings take precedence
+// over reservations.
+func markSlots(slots []Slot, intervals []blocking.Interval, reservations []*model.Reservation) {
+	b, r := 0, 0
+	for i := range slots {
+		slot := &slots[i]
+
+		for b < len(intervals) && !intervals[b].End.After(slot.Start) {
+			b++
+		}
+		if b < len(intervals) && intervals[b].Overlaps(slot.Start, slot.End) {
+			source := intervals[b].Primary()
+			slot.Status = SlotBlocked
+			slot.BlockingSource = source.EntityType
+			slot.BlockingReason = source.Label()
+			if source.Reason != nil && *source.Reason != "" {
+				slot.BlockingReason = *source.Reason
+			}
+			continue
+		}
+
+		for r < len(reservations) && !reservations[r].EndTime.After(slot.Start) {
+			r++
+		}
+		if r < len(reservations) && reservations[r].StartTime.Before(slot.End) {
+			id := reservations[r].ID
+			slot.Status = SlotReserved
+			slot.ReservationID = &id
+		}
+	}
+}
+
+// unbookableReason returns why a place cannot be booked by anyone, or an
+// empty string.
+func unbookableReason(place *model.Place) string {
+	switch {
+	case place.IsDisabled:
+		return "Place is disabled"
+	case !place.IsBookable:
+		return "Place is not bookable"
+	}
+	return ""
+}
+
+// midnight returns the start of the calendar day of t in the service
+// location.
+func (s *Service) midnight(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, s.location)
+}
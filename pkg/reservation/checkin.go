@@ -0,0 +1,119 @@
+package reservation
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"gorm.io/gorm"
+)
+
+// QRVerifier resolves the content of a scanned place QR code into the place
+// and key version it was signed for.
+type QRVerifier interface {
+	Verify(content string) (placeID uuid.UUID, keyVersion int, err error)
+}
+
+// CheckInPolicy configures when and how reservations can be checked in to.
+type CheckInPolicy struct {
+	QRCodes QRVerifier
+	// EarlyWindow is how long before the start checking in is allowed.
+	EarlyWindow time.Duration
+	// LateWindow is how long after the start checking in is allowed. Zero
+	// allows checking in until the reservation ends.
+	LateWindow time.Duration
+}
+
+// CheckInRequest describes a check-in.
+type CheckInRequest struct {
+	ReservationID uuid.UUID
+	UserID        uuid.UUID
+	// QRCode is the content of the scanned QR code of the place. It proves
+	// that the user is at the place and is required for every check-in.
+	QRCode *string
+}
+
+// CheckIn checks the user in to their reservation. The scanned QR code must
+// belong to the reserved place and carry its current key version, whether or
+// not the place requires a check-in. Failures are returned as *Rejection.
+func (s *Service) CheckIn(ctx context.Context, req CheckInRequest) (*model.Reservation, error) {
+	var checkedIn *model.Reservation
+	if err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		reservations := dbgen.ReservationQuery[model.Reservation](tx)
+
+		r, err := reservations.GetByIDForUpdate(ctx, req.ReservationID)
+		if err != nil {
+			return err
+		}
+		if r == nil {
+			return ErrNotFound
+		}
+		if r.UserID != req.UserID {
+			return reject(CodeNotOwner, "only the holder of a reservation can check in to it")
+		}
+		switch r.Status {
+		case model.ReservationStatusCheckedIn:
+			return reject(CodeAlreadyCheckedIn, "reservation has already been checked in to")
+		case model.ReservationStatusCancelled:
+			return reject(CodeNotCheckInable, "reservation has been cancelled")
+		}
+
+		place, err := dbgen.PlaceQuery[model.Place](tx).GetByID(ctx, r.PlaceID)
+		if err != nil {
+			return err
+		}
+		if err := s.checkQRCode(place, req.QRCode); err != nil {
+			return err
+		}
+		if err := s.checkCheckInTime(r); err != nil {
+			return err
+		}
+
+		if err := reservations.CheckIn(ctx, r.ID); err != nil {
+			return err
+		}
+		checkedIn, err = reservations.GetByID(ctx, r.ID)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return checkedIn, nil
+}
+
+func (s *Service) checkQRCode(place *model.Place, content *string) error {
+	if content == nil || *content == "" {
+		return reject(CodeQRCodeRequired, "checking in requires scanning the QR code of the place")
+	}
+	if s.checkIn.QRCodes == nil {
+		return reject(CodeQRCodeInvalid, "QR codes cannot be verified")
+	}
+
+	placeID, keyVersion, err := s.checkIn.QRCodes.Verify(*content)
+	if err != nil {
+		return reject(CodeQRCodeInvalid, "the QR code is not a valid Roomy check-in code")
+	}
+	if placeID != place.ID {
+		return reject(CodeQRCodeWrongPlace, "the QR code belongs to a different place")
+	}
+	if keyVersion != place.QRKeyVersion {
+		return reject(CodeQRCodeRevoked, "the QR code has been replaced; use the current code of the place")
+	}
+	return nil
+}
+
+func (s *Service) checkCheckInTime(r *model.Reservation) error {
+	now := s.now()
+	if opens := r.StartTime.Add(-s.checkIn.EarlyWindow); now.Before(opens) {
+		return reject(CodeCheckInTooEarly, "check-in opens at %s", s.format(opens))
+	}
+	closes := r.EndTime
+	if s.checkIn.LateWindow > 0 {
+		closes = r.StartTime.Add(s.checkIn.LateWindow)
+	}
+	if !now.Before(closes) {
+		return reject(CodeCheckInTooLate, "check-in closed at %s", s.format(closes))
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package reservation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+)
+
+// stubVerifier resolves every QR code to a fixed place and key version.
+type stubVerifier struct {
+	placeID    uuid.UUID
+	keyVersion int
+	err        error
+}
+
+func (v stubVerifier) Verify(string) (uuid.UUID, int, error) {
+	return v.placeID, v.keyVersion, v.err
+}
+
+func TestCheckQRCode(t *testing.T) {
+	place := &model.Place{ID: uuid.New(), QRKeyVersion: 2}
+	code := func(s string) *string { return &s }
+
+	tests := []struct {
+		name     string
+		verifier QRVerifier
+		content  *string
+		want     Code
+	}{
+		{name: "valid", verifier: stubVerifier{placeID: place.ID, keyVersion: 2}, content: code("token")},
+		{name: "missing", verifier: stubVerifier{placeID: place.ID, keyVersion: 2}, want: CodeQRCodeRequired},
+		{name: "empty", verifier: stubVerifier{placeID: place.ID, keyVersion: 2}, content: code(""), want: CodeQRCodeRequired},
+		{name: "no verifier", content: code("token"), want: CodeQRCodeInvalid},
+		{name: "invalid signature", verifier: stubVerifier{err: errors.New("invalid token")}, content: code("token"), want: CodeQRCodeInvalid},
+		{name: "wrong place", verifier: stubVerifier{placeID: uuid.New(), keyVersion: 2}, content: code("token"), want: CodeQRCodeWrongPlace},
+		{name: "revoked key version", verifier: stubVerifier{placeID: place.ID, keyVersion: 1}, content: code("token"), want: CodeQRCodeRevoked},
+		{name: "future key version", verifier: stubVerifier{placeID: place.ID, keyVersion: 3}, content: code("token"), want: CodeQRCodeRevoked},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestService(nil, CheckInPolicy{QRCodes: tt.verifier})
+			err := s.checkQRCode(place, tt.content)
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("checkQRCode() = %v, want nil", err)
+				}
+				return
+			}
+			if got := rejectionCode(t, err); got != tt.want {
+				t.Fatalf("checkQRCode() code = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckCheckInTime(t *testing.T) {
+	r := &model.Reservation{StartTime: testNow, EndTime: testNow.Add(2 * time.Hour)}
+
+	tests := []struct {
+		name   string
+		policy CheckInPolicy
+		now    time.Time
+		want   Code
+	}{
+		{name: "at start", now: testNow},
+		{name: "before start without early window", now: testNow.Add(-time.Nanosecond), want: CodeCheckInTooEarly},
+		{name: "early window opens", policy: CheckInPolicy{EarlyWindow: 15 * time.Minute}, now: testNow.Add(-15 * time.Minute)},
+		{name: "before early window", policy: CheckInPolicy{EarlyWindow: 15 * time.Minute}, now: testNow.Add(-15*time.Minute - time.Nanosecond), want: CodeCheckInTooEarly},
+		{name: "within late window", policy: CheckInPolicy{LateWindow: 10 * time.Minute}, now: testNow.Add(10*time.Minute - time.Nanosecond)},
+		{name: "late window closes", policy: CheckInPolicy{LateWindow: 10 * time.Minute}, now: testNow.Add(10 * time.Minute), want: CodeCheckInTooLate},
+		{name: "zero late window before end", now: r.EndTime.Add(-time.Nanosecond)},
+		{name: "zero late window at end", now: r.EndTime, want: CodeCheckInTooLate},
+		{name: "late window beyond end", policy: CheckInPolicy{LateWindow: 3 * time.Hour}, now: r.EndTime.Add(30 * time.Minute)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestService(nil, tt.policy)
+			s.now = func() time.Time { return tt.now }
+			err := s.checkCheckInTime(r)
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("checkCheckInTime() = %v, want nil", err)
+				}
+				return
+			}
+			if got := rejectionCode(t, err); got != tt.want {
+				t.Fatalf("checkCheckInTime() code = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package reservation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pixlcrashr/roomy/pkg/constraints"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"gorm.io/gorm"
+)
+
+// quota is a limit of the reservations a user may hold in a period.
+type quota struct {
+	// name is the name of the limit as in the API.
+	name  string
+	limit *int
+	// used returns how much of the quota is used, including the requested
+	// reservation.
+	used func(usage *model.ReservationUsage, minutes int64) int64
+	// hours reports whether the quota limits hours instead of reservations.
+	hours bool
+}
+
+// quotas returns the period quotas of constraints that are limited.
+func quotas(c *model.BookingConstraints) []quota {
+	all := []quota{
+		{name: "maxReservationsPerDay", limit: c.MaxReservationsPerDay, used: func(u *model.ReservationUsage, _ int64) int64 { return u.DayCount + 1 }},
+		{name: "maxReservationsPerWeek", limit: c.MaxReservationsPerWeek, used: func(u *model.ReservationUsage, _ int64) int64 { return u.WeekCount + 1 }},
+		{name: "maxReservationsPerMonth", limit: c.MaxReservationsPerMonth, used: func(u *model.ReservationUsage, _ int64) int64 { return u.MonthCount + 1 }},
+		{name: "maxReservationsPerYear", limit: c.MaxReservationsPerYear, used: func(u *model.ReservationUsage, _ int64) int64 { return u.YearCount + 1 }},
+		{name: "maxHoursPerDay", limit: c.MaxHoursPerDay, hours: true, used: func(u *model.ReservationUsage, m int64) int64 { return u.DayMinutes + m }},
+		{name: "maxHoursPerWeek", limit: c.MaxHoursPerWeek, hours: true, used: func(u *model.ReservationUsage, m int64) int64 { return u.WeekMinutes + m }},
+		{name: "maxHoursPerMonth", limit: c.MaxHoursPerMonth, hours: true, used: func(u *model.ReservationUsage, m int64) int64 { return u.MonthMinutes + m }},
+		{name: "maxHoursPerYear", limit: c.MaxHoursPerYear, hours: true, used: func(u *model.ReservationUsage, m int64) int64 { return u.YearMinutes + m }},
+	}
+	var limited []quota
+	for _, q := range all {
+		if q.limit != nil {
+			limited = append(limited, q)
+		}
+	}
+	return limited
+}
+
+// check rejects a request lasting minutes if it would exceed the quota,
+// given the usage of the user's other reservations.
+func (q quota) check(limits *constraints.Effective, usage *model.ReservationUsage, minutes int64) error {
+	used := q.used(usage, minutes)
+	if q.hours {
+		if used > int64(*q.limit)*60 {
+			return reject(CodeQuotaExceeded, "%s (%sh used of %dh%s)", q.name, formatHours(used), *q.limit, scopeSuffix(limits, q.name))
+		}
+		return nil
+	}
+	if used > int64(*q.limit) {
+		return reject(CodeQuotaExceeded, "%s (%d used of %d%s)", q.name, used, *q.limit, scopeSuffix(limits, q.name))
+	}
+	return nil
+}
+
+// checkConcurrent rejects a request if, together with the overlapping
+// reservations of the user, it would exceed the concurrency quota.
+func checkConcurrent(limits *constraints.Effective, overlapping int64) error {
+	limit := limits.MaxConcurrentReservations
+	if limit == nil || overlapping+1 <= int64(*limit) {
+		return nil
+	}
+	return reject(CodeQuotaExceeded, "maxConcurrentReservations (%d used of %d%s)", overlapping+1, *limit, profileSuffix(limits, "maxConcurrentReservations"))
+}
+
+// checkConstraints checks the duration and the advance booking limit of the
+// constraints that apply to the user at a place.
+func (s *Service) checkConstraints(limits *constraints.Effective, req Request, checkAdvance bool) error {
+	minutes := int64(req.End.Sub(req.Start) / time.Minute)
+	if c := limits.MinReservationMinutes; c != nil && minutes < int64(*c) {
+		return reject(CodeDurationTooShort, "reservation must last at least %d minutes%s", *c, profileNote(limits, "minReservationDuration"))
+	}
+	if c := limits.MaxReservationMinutes; c != nil && minutes > int64(*c) {
+		return reject(CodeDurationTooLong, "reservation must not last longer than %d minutes%s", *c, profileNote(limits, "maxReservationDuration"))
+	}
+
+	if c := limits.MaxAdvanceBookingDays; c != nil && checkAdvance {
+		today := s.now().In(s.location)
+		limit := time.Date(today.Year(), today.Month(), today.Day()+*c+1, 0, 0, 0, 0, s.location)
+		if !req.Start.Before(limit) {
+			return reject(CodeTooFarInAdvance, "reservation must not start more than %d days in advance%s", *c, profileNote(limits, "maxAdvanceBookingDays"))
+		}
+	}
+	return nil
+}
+
+// checkQuotas checks the quotas of the constraints that apply to the user
+// at a place. Each period quota counts the user's active reservations within
+// the scope that set it: the place, all places of the area or building whose
+// constraints set it, or those of the constraint profile overriding it. They
+// count the reservations that start in the same calendar day, week (starting
+// on Monday), month and year as the request, in the service's location, or,
+// with a rolling quota window, in any day, week, month or year long window
+// that contains the start of the request. The concurrency quota counts the
+// user's reservations at any place that overlap the request. existing is left
+// out of the counts.
+func (s *Service) checkQuotas(ctx context.Context, tx *gorm.DB, limits *constraints.Effective, req Request, existing *model.Reservation) error {
+	limited := quotas(&limits.BookingConstraints)
+	if len(limited) == 0 && limits.MaxConcurrentReservations == nil {
+		return nil
+	}
+
+	reservations := dbgen.ReservationQuery[model.Reservation](tx)
+	// Concurrent bookings of the same user would not see each other's rows;
+	// they are serialized until the end of the transaction.
+	if err := reservations.LockUser(ctx, req.UserID); err != nil {
+		return err
+	}
+	var excludeID *uuid.UUID
+	if existing != nil {
+		excludeID = &existing.ID
+	}
+	rolling := limits.QuotaWindow != nil && *limits.QuotaWindow == constraints.QuotaWindowRolling
+
+	// Quotas set by the same level or profile share their usage.
+	type scope struct {
+		entityType string
+		entityID   uuid.UUID
+	}
+	usages := map[scope]*model.ReservationUsage{}
+	minutes := int64(req.End.Sub(req.Start) / time.Minute)
+	for _, q := range limited {
+		var sc scope
+		sc.entityType, sc.entityID = limits.Scope(q.name)
+		usage, ok := usages[sc]
+		if !ok {
+			var err error
+			if rolling {
+				usage, err = reservations.RollingUsage(ctx, sc.entityType, sc.entityID, req.UserID, req.Start, excludeID)
+			} else {
+				usage, err = reservations.Usage(ctx, sc.entityType, sc.entityID, req.UserID, req.Start, s.location.String(), excludeID)
+			}
+			if err != nil {
+				return err
+			}
+			if usage == nil {
+				return errors.New("reservation usage is missing")
+			}
+			usages[sc] = usage
+		}
+		if err := q.check(limits, usage, minutes); err != nil {
+			return err
+		}
+	}
+
+	if limits.MaxConcurrentReservations == nil {
+		return nil
+	}
+	overlapping, err := reservations.CountOverlappingByUser(ctx, req.UserID, req.Start, req.End, excludeID)
+	if err != nil {
+		return err
+	}
+	return checkConcurrent(limits, overlapping)
+}
+
+// scopeSuffix names the constraint profile or the level a quota was taken
+// from, if it counts reservations beyond the place, for appending within
+// parentheses.
+func scopeSuffix(limits *constraints.Effective, name string) string {
+	if suffix := profileSuffix(limits, name); suffix != "" {
+		return suffix
+	}
+	if entityType, _ := limits.Scope(name); entityType != constraints.EntityPlace {
+		return " in this " + entityType
+	}
+	return ""
+}
+
+// profileNote names the constraint profile a field was taken from, if any,
+// as parenthesized note to append to a message.
+func profileNote(limits *constraints.Effective, name string) string {
+	if p := limits.Profiles[name]; p != nil {
+		return fmt.Sprintf(" (profile %q)", p.Name)
+	}
+	return ""
+}
+
+// profileSuffix is like profileNote, for appending within parentheses.
+func profileSuffix(limits *constraints.Effective, name string) string {
+	if p := limits.Profiles[name]; p != nil {
+		return fmt.Sprintf(", profile %q", p.Name)
+	}
+	return ""
+}
+
+// formatHours formats minutes as hours with at most two decimals.
+func formatHours(minutes int64) string {
+	return strconv.FormatFloat(math.Round(float64(minutes)/60*100)/100, 'f', -1, 64)
+}
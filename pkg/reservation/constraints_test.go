@@ -0,0 +1,242 @@
+package reservation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pixlcrashr/roomy/pkg/constraints"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"gorm.io/gorm"
+)
+
+func intPtr(v int) *int { return &v }
+
+func TestQuotaCheck(t *testing.T) {
+	place := func(c model.BookingConstraints, name string) *constraints.Effective {
+		return &constraints.Effective{BookingConstraints: c, Sources: map[string]string{name: constraints.EntityPlace}}
+	}
+	area := func(c model.BookingConstraints, name string) *constraints.Effective {
+		return &constraints.Effective{BookingConstraints: c, Sources: map[string]string{name: constraints.EntityArea}}
+	}
+	profile := func(c model.BookingConstraints, name string) *constraints.Effective {
+		return &constraints.Effective{
+			BookingConstraints: c,
+			Sources:            map[string]string{name: constraints.EntityPlace},
+			Profiles:           map[string]*model.ConstraintProfile{name: {Name: "Staff"}},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		limits  func(model.BookingConstraints, string) *constraints.Effective
+		c       model.BookingConstraints
+		usage   model.ReservationUsage
+		minutes int64
+		want    string
+	}{
+		{name: "count below limit", limits: place, c: model.BookingConstraints{MaxReservationsPerDay: intPtr(2)}, usage: model.ReservationUsage{DayCount: 0}},
+		{name: "count reaches limit", limits: place, c: model.BookingConstraints{MaxReservationsPerDay: intPtr(2)}, usage: model.ReservationUsage{DayCount: 1}},
+		{name: "count exceeds limit", limits: place, c: model.BookingConstraints{MaxReservationsPerDay: intPtr(2)}, usage: model.ReservationUsage{DayCount: 2},
+			want: "QUOTA_EXCEEDED: maxReservationsPerDay (3 used of 2)"},
+		{name: "other period", limits: place, c: model.BookingConstraints{MaxReservationsPerWeek: intPtr(2)}, usage: model.ReservationUsage{DayCount: 5, WeekCount: 1}},
+		{name: "hours reach limit", limits: place, c: model.BookingConstraints{MaxHoursPerWeek: intPtr(3)}, usage: model.ReservationUsage{WeekMinutes: 120}, minutes: 60},
+		{name: "hours exceed limit by a minute", limits: place, c: model.BookingConstraints{MaxHoursPerWeek: intPtr(3)}, usage: model.ReservationUsage{WeekMinutes: 121}, minutes: 60,
+			want: "QUOTA_EXCEEDED: maxHoursPerWeek (3.02h used of 3h)"},
+		{name: "hours count minutes, not reservations", limits: place, c: model.BookingConstraints{MaxHoursPerDay: intPtr(1)}, usage: model.ReservationUsage{DayCount: 3, DayMinutes: 30}, minutes: 30},
+		{name: "set by area", limits: area, c: model.BookingConstraints{MaxReservationsPerMonth: intPtr(1)}, usage: model.ReservationUsage{MonthCount: 1},
+			want: "QUOTA_EXCEEDED: maxReservationsPerMonth (2 used of 1 in this area)"},
+		{name: "set by profile", limits: profile, c: model.BookingConstraints{MaxHoursPerYear: intPtr(1)}, usage: model.ReservationUsage{YearMinutes: 45}, minutes: 30,
+			want: `QUOTA_EXCEEDED: maxHoursPerYear (1.25h used of 1h, profile "Staff")`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limited := quotas(&tt.c)
+			if len(limited) != 1 {
+				t.Fatalf("quotas() returned %d quotas, want 1", len(limited))
+			}
+			q := limited[0]
+			err := q.check(tt.limits(tt.c, q.name), &tt.usage, tt.minutes)
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("check() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.want {
+				t.Fatalf("check() = %v, want %s", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckConcurrent(t *testing.T) {
+	tests := []struct {
+		limit       *int
+		overlapping int64
+		want        bool
+	}{
+		{nil, 10, false},
+		{intPtr(1), 0, false},
+		{intPtr(1), 1, true},
+		{intPtr(2), 1, false},
+		{intPtr(2), 2, true},
+	}
+	for _, tt := range tests {
+		limits := &constraints.Effective{BookingConstraints: model.BookingConstraints{MaxConcurrentReservations: tt.limit}}
+		err := checkConcurrent(limits, tt.overlapping)
+		if got := err != nil; got != tt.want {
+			t.Errorf("checkConcurrent(%v, %d) = %v, want rejection %v", tt.limit, tt.overlapping, err, tt.want)
+		}
+	}
+}
+
+func TestCheckQuotas(t *testing.T) {
+	db := openTestDB(t)
+	s := newTestService(db, CheckInPolicy{})
+	rolling := constraints.QuotaWindowRolling
+	on := func(day, hour, minute int) time.Time {
+		return time.Date(2030, time.January, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	// booking is a reservation of the user at the constrained place (0),
+	// another place of its area (1) or a place in another building (2).
+	type booking struct {
+		place      int
+		start, end time.Time
+		want       Code
+	}
+	tests := []struct {
+		name    string
+		level   string
+		c       model.BookingConstraints
+		booking []booking
+	}{
+		{
+			name:  "reservations per day",
+			level: constraints.EntityPlace,
+			c:     model.BookingConstraints{MaxReservationsPerDay: intPtr(2)},
+			booking: []booking{
+				{0, on(8, 9, 0), on(8, 10, 0), ""},
+				{0, on(8, 11, 0), on(8, 12, 0), ""},
+				{0, on(8, 13, 0), on(8, 14, 0), CodeQuotaExceeded},
+				{0, on(9, 9, 0), on(9, 10, 0), ""},
+			},
+		},
+		{
+			name:  "place quota ignores other places",
+			level: constraints.EntityPlace,
+			c:     model.BookingConstraints{MaxReservationsPerDay: intPtr(1)},
+			booking: []booking{
+				{1, on(8, 9, 0), on(8, 10, 0), ""},
+				{0, on(8, 11, 0), on(8, 12, 0), ""},
+			},
+		},
+		{
+			name:  "area quota counts the area",
+			level: constraints.EntityArea,
+			c:     model.BookingConstraints{MaxReservationsPerDay: intPtr(1)},
+			booking: []booking{
+				{2, on(8, 7, 0), on(8, 8, 0), ""},
+				{1, on(8, 9, 0), on(8, 10, 0), ""},
+				{0, on(8, 11, 0), on(8, 12, 0), CodeQuotaExceeded},
+			},
+		},
+		{
+			name:  "hours per week",
+			level: constraints.EntityPlace,
+			c:     model.BookingConstraints{MaxHoursPerWeek: intPtr(3)},
+			booking: []booking{
+				{0, on(8, 9, 0), on(8, 11, 0), ""},
+				{0, on(9, 9, 0), on(9, 10, 0), ""},
+				{0, on(10, 9, 0), on(10, 9, 30), CodeQuotaExceeded},
+				{0, on(14, 9, 0), on(14, 12, 0), ""},
+			},
+		},
+		{
+			name:  "calendar week",
+			level: constraints.EntityPlace,
+			c:     model.BookingConstraints{MaxReservationsPerWeek: intPtr(1)},
+			booking: []booking{
+				{0, on(13, 9, 0), on(13, 10, 0), ""},
+				{0, on(14, 9, 0), on(14, 10, 0), ""},
+			},
+		},
+		{
+			name:  "rolling week",
+			level: constraints.EntityPlace,
+			c:     model.BookingConstraints{MaxReservationsPerWeek: intPtr(1), QuotaWindow: &rolling},
+			booking: []booking{
+				{0, on(13, 9, 0), on(13, 10, 0), ""},
+				{0, on(14, 9, 0), on(14, 10, 0), CodeQuotaExceeded},
+				{0, on(20, 9, 0), on(20, 10, 0), ""},
+			},
+		},
+		{
+			name:  "concurrent reservations at all places",
+			level: constraints.EntityBuilding,
+			c:     model.BookingConstraints{MaxConcurrentReservations: intPtr(1)},
+			booking: []booking{
+				{2, on(8, 9, 0), on(8, 10, 0), ""},
+				{0, on(8, 9, 30), on(8, 10, 30), CodeQuotaExceeded},
+				{0, on(8, 10, 0), on(8, 11, 0), ""},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			places := newTestQuotaPlaces(t, db, tt.level, tt.c)
+			userID := newTestUser(t, db)
+			for i, b := range tt.booking {
+				_, err := s.Create(t.Context(), Request{PlaceID: places[b.place].ID, UserID: userID, Start: b.start, End: b.end})
+				if b.want == "" {
+					if err != nil {
+						t.Fatalf("booking %d: Create() = %v, want nil", i, err)
+					}
+					continue
+				}
+				if got := rejectionCode(t, err); got != b.want {
+					t.Fatalf("booking %d: Create() code = %s, want %s", i, got, b.want)
+				}
+			}
+		})
+	}
+
+	t.Run("moved reservation is not counted", func(t *testing.T) {
+		places := newTestQuotaPlaces(t, db, constraints.EntityPlace, model.BookingConstraints{
+			MaxReservationsPerDay:     intPtr(1),
+			MaxConcurrentReservations: intPtr(1),
+		})
+		userID := newTestUser(t, db)
+		r, err := s.Create(t.Context(), Request{PlaceID: places[0].ID, UserID: userID, Start: on(8, 9, 0), End: on(8, 10, 0)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.Update(t.Context(), r.ID, on(8, 9, 30), on(8, 10, 30), ScopeOccurrence, Bypass{}, nil); err != nil {
+			t.Fatalf("Update() = %v, want nil", err)
+		}
+	})
+}
+
+// newTestQuotaPlaces stores a place with constraints set on the given level,
+// another place in its area and a place in another building.
+func newTestQuotaPlaces(t *testing.T, db *gorm.DB, level string, c model.BookingConstraints) []*model.Place {
+	t.Helper()
+	place := newTestPlace(t, db, nil)
+	sibling := &model.Place{AreaID: place.AreaID, Name: "Sibling"}
+	if err := db.Create(sibling).Error; err != nil {
+		t.Fatal(err)
+	}
+	other := newTestPlace(t, db, nil)
+
+	c.EntityType = level
+	c.EntityID = map[string]uuid.UUID{
+		constraints.EntityPlace:    place.ID,
+		constraints.EntityArea:     place.AreaID,
+		constraints.EntityBuilding: place.Area.BuildingID,
+	}[level]
+	if err := db.Create(&c).Error; err != nil {
+		t.Fatal(err)
+	}
+	return []*model.Place{place, sibling, other}
+}
@@ -0,0 +1,122 @@
+package reservation
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"github.com/pixlcrashr/roomy/pkg/notification"
+	"github.com/pixlcrashr/roomy/pkg/scheduler"
+	"gorm.io/gorm"
+)
+
+// NoShowReason is the cancel reason of reservations released for not having
+// been checked in to in time.
+const NoShowReason = "Released automatically: not checked in in time"
+
+// noShowBatchSize limits how many reservations are handled per batch.
+const noShowBatchSize = 100
+
+// NoShowJob warns users of reservations that have started without a
+// check-in and releases those that have not been checked in to within the
+// timeout of their place, so that the place becomes available again.
+//
+// The timeout is the checkInTimeoutMinutes of places requiring a check-in
+// or the decayTimeoutMinutes of any place, whichever is shorter, each
+// inherited from the area and building if the place does not set it. The
+// warning is sent once the reservation has started, at most once per
+// reservation and start time: moving a reservation allows another warning
+// for its new slot. Reservations that have already ended are left alone.
+func NoShowJob() scheduler.Job {
+	return scheduler.Job{Name: "no-show-release", Run: func(ctx context.Context, tx *gorm.DB) error {
+		if err := ReleaseNoShows(ctx, tx); err != nil {
+			return err
+		}
+		return WarnNoShows(ctx, tx)
+	}}
+}
+
+// WarnNoShows enqueues check-in warnings for all started reservations that
+// have not been checked in to and are not due for release yet.
+func WarnNoShows(ctx context.Context, tx *gorm.DB) error {
+	reservations := dbgen.ReservationQuery[model.Reservation](tx)
+	for {
+		due, err := reservations.ListNotCheckedIn(ctx, false, noShowBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(due) == 0 {
+			return nil
+		}
+
+		ids := make([]uuid.UUID, len(due))
+		for i, r := range due {
+			ids[i] = r.ID
+			if err := notification.Enqueue(ctx, tx, notification.KindCheckInWarning, []*model.Reservation{r}, nil); err != nil {
+				return err
+			}
+		}
+		if err := reservations.MarkCheckInWarned(ctx, ids); err != nil {
+			return err
+		}
+		if len(due) < noShowBatchSize {
+			return nil
+		}
+	}
+}
+
+// ReleaseNoShows cancels all started reservations that have not been
+// checked in to within their timeout, records the cancellation in the audit
+// log and notifies their users.
+func ReleaseNoShows(ctx context.Context, tx *gorm.DB) error {
+	reservations := dbgen.ReservationQuery[model.Reservation](tx)
+	reason := NoShowReason
+	for {
+		due, err := reservations.ListNotCheckedIn(ctx, true, noShowBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(due) == 0 {
+			return nil
+		}
+
+		ids := make([]uuid.UUID, len(due))
+		for i, r := range due {
+			ids[i] = r.ID
+		}
+		if err := reservations.CancelMany(ctx, ids, &reason); err != nil {
+			return err
+		}
+		for _, r := range due {
+			if err := auditCancel(ctx, tx, r, reason); err != nil {
+				return err
+			}
+		}
+
+		cancelled, err := reservations.ListByIDs(ctx, ids)
+		if err != nil {
+			return err
+		}
+		if err := notification.Enqueue(ctx, tx, notification.KindReservationCancelled, cancelled, &reason); err != nil {
+			return err
+		}
+		if len(due) < noShowBatchSize {
+			return nil
+		}
+	}
+}
+
+// auditCancel records the cancellation of a reservation by the system.
+func auditCancel(ctx context.Context, tx *gorm.DB, r *model.Reservation, reason string) error {
+	changes, err := json.Marshal(map[string]any{
+		"before": map[string]any{"status": r.Status},
+		"after":  map[string]any{"status": model.ReservationStatusCancelled, "cancelReason": reason},
+	})
+	if err != nil {
+		return err
+	}
+	c := string(changes)
+	return dbgen.AuditLogQuery[model.AuditLogEntry](tx).Insert(ctx, uuid.New(), nil, string(model.AuditActionUpdate), "reservation", r.ID, &c)
+}
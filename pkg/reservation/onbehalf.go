@@ -0,0 +1,78 @@
+package reservation
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"github.com/pixlcrashr/roomy/pkg/notification"
+	"gorm.io/gorm"
+)
+
+// OnBehalf marks a change that a user, typically an administrator, makes to
+// reservations of another user. Such changes may book places that can only
+// be booked manually, are recorded in the audit log and name the actor in
+// the notifications of the user.
+type OnBehalf struct {
+	ActorID uuid.UUID
+}
+
+// Bypass selects booking rules a change skips. Only users that may manage
+// reservations of the place may bypass rules, so such changes may also book
+// places that can only be booked manually; they are recorded in the audit
+// log.
+type Bypass struct {
+	// Quotas skips the quotas of the user, Blockings the blockings of the
+	// place.
+	Quotas    bool
+	Blockings bool
+}
+
+// rules returns the API names of the booking rules that are skipped.
+func (b Bypass) rules() []string {
+	var rules []string
+	if b.Quotas {
+		rules = append(rules, "quotas")
+	}
+	if b.Blockings {
+		rules = append(rules, "blockings")
+	}
+	return rules
+}
+
+// audit records a change made to r on behalf of its user or bypassing
+// booking rules, if any. before and after hold the changed values.
+func audit(ctx context.Context, tx *gorm.DB, onBehalf *OnBehalf, bypass Bypass, action model.AuditAction, r *model.Reservation, before, after map[string]any) error {
+	if onBehalf == nil && bypass == (Bypass{}) {
+		return nil
+	}
+	actorID := r.UserID
+	entry := map[string]any{"after": after}
+	if onBehalf != nil {
+		actorID = onBehalf.ActorID
+		entry["onBehalfOf"] = r.UserID
+	}
+	if before != nil {
+		entry["before"] = before
+	}
+	if rules := bypass.rules(); len(rules) > 0 {
+		entry["bypassed"] = rules
+	}
+	changes, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	c := string(changes)
+	return dbgen.AuditLogQuery[model.AuditLogEntry](tx).Insert(ctx, uuid.New(), &actorID, string(action), "reservation", r.ID, &c)
+}
+
+// enqueue notifies the users holding reservations of a change, naming the
+// actor if it was made on their behalf.
+func enqueue(ctx context.Context, tx *gorm.DB, kind notification.Kind, reservations []*model.Reservation, reason *string, onBehalf *OnBehalf) error {
+	if onBehalf == nil {
+		return notification.Enqueue(ctx, tx, kind, reservations, reason)
+	}
+	return notification.EnqueueOnBehalf(ctx, tx, kind, reservations, reason, onBehalf.ActorID)
+}
@@ -0,0 +1,80 @@
+package reservation
+
+import "fmt"
+
+// Code is a machine-readable reason why a reservation was rejected.
+type Code string
+
+const (
+	CodePlaceNotFound       Code = "PLACE_NOT_FOUND"
+	CodePlaceNotBookable    Code = "PLACE_NOT_BOOKABLE"
+	CodePlaceDisabled       Code = "PLACE_DISABLED"
+	CodeManualBookingOnly   Code = "MANUAL_BOOKING_ONLY"
+	CodeUserNotFound        Code = "USER_NOT_FOUND"
+	CodeUserInactive        Code = "USER_INACTIVE"
+	CodeNotWhitelisted      Code = "NOT_WHITELISTED"
+	CodeInvalidTimeRange    Code = "INVALID_TIME_RANGE"
+	CodeInPast              Code = "IN_THE_PAST"
+	CodeNotAligned          Code = "NOT_ALIGNED_TO_TIME_SLOTS"
+	CodeOutsideBookingHours Code = "OUTSIDE_BOOKING_HOURS"
+	CodeBlocked             Code = "BLOCKED"
+	CodeOverlapping         Code = "OVERLAPPING_RESERVATION"
+	CodeInvalidRecurrence   Code = "INVALID_RECURRENCE"
+	CodeSeriesConflict      Code = "SERIES_CONFLICT"
+	CodeNotModifiable       Code = "RESERVATION_NOT_MODIFIABLE"
+	CodeDurationTooShort    Code = "DURATION_TOO_SHORT"
+	CodeDurationTooLong     Code = "DURATION_TOO_LONG"
+	CodeTooFarInAdvance     Code = "TOO_FAR_IN_ADVANCE"
+	CodeQuotaExceeded       Code = "QUOTA_EXCEEDED"
+
+	// Check-in failures.
+	CodeNotOwner         Code = "NOT_RESERVATION_OWNER"
+	CodeNotCheckInable   Code = "RESERVATION_NOT_CHECKINABLE"
+	CodeAlreadyCheckedIn Code = "ALREADY_CHECKED_IN"
+	CodeQRCodeRequired   Code = "QR_CODE_REQUIRED"
+	CodeQRCodeInvalid    Code = "QR_CODE_INVALID"
+	CodeQRCodeRevoked    Code = "QR_CODE_REVOKED"
+	CodeQRCodeWrongPlace Code = "QR_CODE_WRONG_PLACE"
+	CodeCheckInTooEarly  Code = "CHECK_IN_TOO_EARLY"
+	CodeCheckInTooLate   Code = "CHECK_IN_TOO_LATE"
+)
+
+// Rejection is returned when a reservation request violates a booking rule.
+type Rejection struct {
+	Code    Code
+	Message string
+
+	// Conflicts lists the occurrences that could not be booked when a
+	// recurring series is rejected.
+	Conflicts []OccurrenceResult
+}
+
+func (r *Rejection) Error() string {
+	return string(r.Code) + ": " + r.Message
+}
+
+// IsConflict reports whether the requested time is taken by a blocking or
+// another reservation, or the user's other reservations exhaust a quota, as
+// opposed to the request itself being invalid.
+func (r *Rejection) IsConflict() bool {
+	switch r.Code {
+	case CodeBlocked, CodeOverlapping, CodeSeriesConflict, CodeNotModifiable,
+		CodeNotCheckInable, CodeAlreadyCheckedIn, CodeQuotaExceeded:
+		return true
+	}
+	return false
+}
+
+// IsForbidden reports whether the user is not allowed to book the place or
+// to check in to the reservation.
+func (r *Rejection) IsForbidden() bool {
+	switch r.Code {
+	case CodeNotWhitelisted, CodeUserInactive, CodeNotOwner:
+		return true
+	}
+	return false
+}
+
+func reject(code Code, format string, args ...any) *Rejection {
+	return &Rejection{Code: code, Message: fmt.Sprintf(format, args...)}
+}
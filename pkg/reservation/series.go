@@ -0,0 +1,444 @@
+package reservation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"github.com/pixlcrashr/roomy/pkg/notification"
+	"github.com/teambition/rrule-go"
+	"gorm.io/gorm"
+)
+
+// MaxSeriesOccurrences limits the number of reservations a single recurring
+// series may create.
+const MaxSeriesOccurrences = 366
+
+// ErrNotFound is returned when the addressed reservation does not exist.
+var ErrNotFound = errors.New("reservation not found")
+
+// Pattern is the repetition pattern of a recurring series.
+type Pattern string
+
+const (
+	PatternDaily    Pattern = "daily"
+	PatternWeekly   Pattern = "weekly"
+	PatternBiweekly Pattern = "biweekly"
+	PatternMonthly  Pattern = "monthly"
+)
+
+// Recurrence describes how a reservation repeats.
+type Recurrence struct {
+	Pattern Pattern
+	// DaysOfWeek restricts daily, weekly and biweekly series to the given
+	// weekdays. Empty means the weekday of the first occurrence.
+	DaysOfWeek []time.Weekday
+	// EndDate is the last calendar day on which an occurrence may start.
+	EndDate time.Time
+}
+
+// SeriesMode controls how conflicting occurrences of a series are handled.
+type SeriesMode string
+
+const (
+	// SeriesAllOrNothing rejects the whole series if any occurrence conflicts.
+	SeriesAllOrNothing SeriesMode = "allOrNothing"
+	// SeriesSkipConflicts books all free occurrences and skips the others.
+	SeriesSkipConflicts SeriesMode = "skipConflicts"
+)
+
+// Scope selects which occurrences of a series an edit applies to.
+type Scope string
+
+const (
+	ScopeOccurrence Scope = "occurrence"
+	ScopeFollowing  Scope = "following"
+	ScopeSeries     Scope = "series"
+)
+
+// Occurrence is a single time range of a recurring series.
+type Occurrence struct {
+	Start time.Time
+	End   time.Time
+}
+
+// OccurrenceResult is the outcome of booking one occurrence of a series.
+// Exactly one of Reservation and Rejection is set.
+type OccurrenceResult struct {
+	Occurrence
+	Reservation *model.Reservation
+	Rejection   *Rejection
+}
+
+// Series is the outcome of booking a recurring series.
+type Series struct {
+	GroupID     uuid.UUID
+	Occurrences []OccurrenceResult
+}
+
+// Conflicts returns the occurrences that could not be booked.
+func (s *Series) Conflicts() []OccurrenceResult {
+	var conflicts []OccurrenceResult
+	for _, o := range s.Occurrences {
+		if o.Rejection != nil {
+			conflicts = append(conflicts, o)
+		}
+	}
+	return conflicts
+}
+
+// Occurrences expands a recurrence starting with the time range [start, end).
+// The wall clock time of the first occurrence is kept across DST changes.
+func (s *Service) Occurrences(start, end time.Time, rec Recurrence) ([]Occurrence, error) {
+	if !end.After(start) {
+		return nil, reject(CodeInvalidTimeRange, "end time must be after start time")
+	}
+
+	localStart := start.In(s.location)
+	until := time.Date(rec.EndDate.Year(), rec.EndDate.Month(), rec.EndDate.Day(), 23, 59, 59, 0, s.location)
+	if until.Before(localStart) {
+		return nil, reject(CodeInvalidRecurrence, "end date must not be before the first occurrence")
+	}
+
+	option := rrule.ROption{
+		Dtstart: localStart,
+		Until:   until,
+		// One more than allowed, to detect series that are too long.
+		Count: MaxSeriesOccurrences + 1,
+	}
+	switch rec.Pattern {
+	case PatternDaily:
+		option.Freq = rrule.DAILY
+	case PatternWeekly:
+		option.Freq = rrule.WEEKLY
+	case PatternBiweekly:
+		option.Freq = rrule.WEEKLY
+		option.Interval = 2
+	case PatternMonthly:
+		option.Freq = rrule.MONTHLY
+	default:
+		return nil, reject(CodeInvalidRecurrence, "unknown recurrence pattern %q", rec.Pattern)
+	}
+	if rec.Pattern != PatternMonthly {
+		for _, day := range rec.DaysOfWeek {
+			option.Byweekday = append(option.Byweekday, weekdays[day])
+		}
+	}
+
+	rule, err := rrule.NewRRule(option)
+	if err != nil {
+		return nil, reject(CodeInvalidRecurrence, "%s", err)
+	}
+
+	starts := rule.All()
+	if len(starts) == 0 {
+		return nil, reject(CodeInvalidRecurrence, "recurrence has no occurrences")
+	}
+	if len(starts) > MaxSeriesOccurrences {
+		return nil, reject(CodeInvalidRecurrence, "recurrence must not have more than %d occurrences", MaxSeriesOccurrences)
+	}
+
+	duration := end.Sub(start)
+	occurrences := make([]Occurrence, len(starts))
+	for i, start := range starts {
+		occurrences[i] = Occurrence{Start: start, End: start.Add(duration)}
+	}
+	return occurrences, nil
+}
+
+// CreateSeries books all occurrences of a recurring series in one
+// transaction. Occurrences that conflict with blockings or other
+// reservations either reject the whole series or are skipped, depending on
+// mode. A series of which no occurrence could be booked is always rejected.
+// The user is notified of all booked occurrences at once.
+func (s *Service) CreateSeries(ctx context.Context, req Request, rec Recurrence, mode SeriesMode) (*Series, error) {
+	occurrences, err := s.Occurrences(req.Start, req.End, rec)
+	if err != nil {
+		return nil, err
+	}
+
+	groupID := uuid.New()
+	req.RecurringGroupID = &groupID
+
+	var series *Series
+	if err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		series = &Series{GroupID: groupID}
+		for _, occurrence := range occurrences {
+			occurrenceReq := req
+			occurrenceReq.Start, occurrenceReq.End = occurrence.Start, occurrence.End
+
+			// Every occurrence is booked in a savepoint, so that a violated
+			// exclusion constraint does not abort the whole transaction.
+			var created *model.Reservation
+			err := tx.Transaction(func(tx *gorm.DB) error {
+				var err error
+				created, err = s.CreateTx(ctx, tx, occurrenceReq)
+				return err
+			})
+
+			var rejection *Rejection
+			if errors.As(err, &rejection) && rejection.IsConflict() {
+				series.Occurrences = append(series.Occurrences, OccurrenceResult{Occurrence: occurrence, Rejection: rejection})
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			series.Occurrences = append(series.Occurrences, OccurrenceResult{Occurrence: occurrence, Reservation: created})
+		}
+
+		conflicts := series.Conflicts()
+		if len(conflicts) > 0 && (mode != SeriesSkipConflicts || len(conflicts) == len(series.Occurrences)) {
+			return &Rejection{
+				Code:      CodeSeriesConflict,
+				Message:   fmt.Sprintf("%d of %d occurrences conflict", len(conflicts), len(series.Occurrences)),
+				Conflicts: conflicts,
+			}
+		}
+
+		var created []*model.Reservation
+		for _, o := range series.Occurrences {
+			if o.Reservation != nil {
+				created = append(created, o.Reservation)
+			}
+		}
+		return enqueue(ctx, tx, notification.KindReservationConfirmed, created, nil, req.OnBehalf)
+	}); err != nil {
+		return nil, err
+	}
+	return series, nil
+}
+
+// Update moves the reservation and, depending on scope, further occurrences
+// of its series. The addressed reservation is moved to [start, end); the other
+// occurrences are moved by the same calendar days to the same wall clock
+// times. Either all affected occurrences are moved or none. The user is
+// notified of the new times. bypass selects the booking rules the moved
+// reservations are exempt from; onBehalf is set when the reservation is moved
+// by another user than its owner.
+func (s *Service) Update(ctx context.Context, id uuid.UUID, start, end time.Time, scope Scope, bypass Bypass, onBehalf *OnBehalf) (*model.Reservation, error) {
+	var updated *model.Reservation
+	if err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		reservations := dbgen.ReservationQuery[model.Reservation](tx)
+
+		target, err := reservations.GetByIDForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+		if target == nil {
+			return ErrNotFound
+		}
+		if err := s.checkModifiable(target, start); err != nil {
+			return err
+		}
+
+		affected, err := s.selectScope(ctx, tx, target, scope)
+		if err != nil {
+			return err
+		}
+		ids := make([]uuid.UUID, len(affected))
+		for i, r := range affected {
+			ids[i] = r.ID
+		}
+
+		// An occurrence may move into the slot of the next one, so the
+		// occurrences are moved starting with the one furthest in the
+		// direction of the move: that slot has been vacated by then.
+		ordered := slices.Clone(affected)
+		slices.SortFunc(ordered, func(a, b *model.Reservation) int { return a.StartTime.Compare(b.StartTime) })
+		if start.After(target.StartTime) {
+			slices.Reverse(ordered)
+		}
+		for _, r := range ordered {
+			newStart, newEnd := start, end
+			if r.ID != target.ID {
+				newStart = s.shiftWallClock(r.StartTime, target.StartTime, start)
+				newEnd = s.shiftWallClock(r.EndTime, target.EndTime, end)
+			}
+			if err := s.move(ctx, tx, r, newStart, newEnd, ids, bypass, onBehalf); err != nil {
+				var rejection *Rejection
+				if r.ID != target.ID && errors.As(err, &rejection) {
+					rejection.Message = fmt.Sprintf("occurrence on %s: %s", r.StartTime.In(s.location).Format(time.DateOnly), rejection.Message)
+				}
+				return err
+			}
+		}
+
+		moved, err := reservations.ListByIDs(ctx, ids)
+		if err != nil {
+			return err
+		}
+		if err := enqueue(ctx, tx, notification.KindReservationUpdated, moved, nil, onBehalf); err != nil {
+			return err
+		}
+
+		updated, err = reservations.GetByID(ctx, target.ID)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// Cancel cancels the reservation and, depending on scope, further upcoming
+// occurrences of its series in one transaction and notifies the user.
+// Reservations that have been cancelled or have ended are rejected.
+// onBehalf is set when the reservation is cancelled by another user than its
+// owner.
+func (s *Service) Cancel(ctx context.Context, id uuid.UUID, scope Scope, reason *string, onBehalf *OnBehalf) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		reservations := dbgen.ReservationQuery[model.Reservation](tx)
+
+		target, err := reservations.GetByIDForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+		if target == nil {
+			return ErrNotFound
+		}
+		if err := s.checkCancellable(target); err != nil {
+			return err
+		}
+
+		affected, err := s.selectScope(ctx, tx, target, scope)
+		if err != nil {
+			return err
+		}
+		var ids []uuid.UUID
+		for _, r := range affected {
+			if r.Status == model.ReservationStatusCancelled {
+				continue
+			}
+			ids = append(ids, r.ID)
+			after := map[string]any{"status": model.ReservationStatusCancelled}
+			if reason != nil {
+				after["cancelReason"] = *reason
+			}
+			if err := audit(ctx, tx, onBehalf, Bypass{}, model.AuditActionUpdate, r, map[string]any{"status": r.Status}, after); err != nil {
+				return err
+			}
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		if err := reservations.CancelMany(ctx, ids, reason); err != nil {
+			return err
+		}
+
+		cancelled, err := reservations.ListByIDs(ctx, ids)
+		if err != nil {
+			return err
+		}
+		return enqueue(ctx, tx, notification.KindReservationCancelled, cancelled, reason, onBehalf)
+	})
+}
+
+// checkCancellable rejects cancelling reservations that are cancelled,
+// including released no-shows, or over.
+func (s *Service) checkCancellable(r *model.Reservation) error {
+	switch {
+	case r.Status == model.ReservationStatusCancelled:
+		return reject(CodeNotModifiable, "reservation has already been cancelled")
+	case !r.EndTime.After(s.now()):
+		return reject(CodeNotModifiable, "reservation has already ended")
+	}
+	return nil
+}
+
+// checkModifiable rejects moving reservations that are cancelled or over,
+// and moving the start of reservations that have been checked in to.
+func (s *Service) checkModifiable(r *model.Reservation, start time.Time) error {
+	switch {
+	case r.Status == model.ReservationStatusCancelled:
+		return reject(CodeNotModifiable, "reservation has been cancelled")
+	case !r.EndTime.After(s.now()):
+		return reject(CodeNotModifiable, "reservation has already ended")
+	case r.Status == model.ReservationStatusCheckedIn && !r.StartTime.Equal(start):
+		return reject(CodeNotModifiable, "start time of a checked-in reservation cannot be changed")
+	}
+	return nil
+}
+
+// move validates and stores new times of an existing reservation that is
+// moved together with the reservations with IDs moving. Moves on behalf of
+// the user or bypassing booking rules are recorded in the audit log.
+func (s *Service) move(ctx context.Context, tx *gorm.DB, r *model.Reservation, start, end time.Time, moving []uuid.UUID, bypass Bypass, onBehalf *OnBehalf) error {
+	if err := s.Check(ctx, tx, Request{
+		PlaceID:          r.PlaceID,
+		UserID:           r.UserID,
+		Start:            start,
+		End:              end,
+		RecurringGroupID: r.RecurringGroupID,
+		OnBehalf:         onBehalf,
+		Bypass:           bypass,
+		moving:           moving,
+	}, r); err != nil {
+		return err
+	}
+
+	if err := dbgen.ReservationQuery[model.Reservation](tx).Save(ctx, r.ID, &start, &end); err != nil {
+		if isExclusionViolation(err) {
+			return reject(CodeOverlapping, "the place is already reserved for the requested time")
+		}
+		return err
+	}
+	return audit(ctx, tx, onBehalf, bypass, model.AuditActionUpdate, r,
+		map[string]any{"startTime": r.StartTime, "endTime": r.EndTime},
+		map[string]any{"startTime": start, "endTime": end})
+}
+
+// selectScope returns the reservations an edit of target applies to, locked
+// for update. Besides target only upcoming, active occurrences of its series
+// are included.
+func (s *Service) selectScope(ctx context.Context, tx *gorm.DB, target *model.Reservation, scope Scope) ([]*model.Reservation, error) {
+	if target.RecurringGroupID == nil || scope == ScopeOccurrence || scope == "" {
+		return []*model.Reservation{target}, nil
+	}
+
+	from := s.now()
+	if scope == ScopeFollowing && target.StartTime.After(from) {
+		from = target.StartTime
+	}
+	series, err := dbgen.ReservationQuery[model.Reservation](tx).ListSeriesForUpdate(ctx, *target.RecurringGroupID, from)
+	if err != nil {
+		return nil, err
+	}
+
+	affected := []*model.Reservation{target}
+	for _, r := range series {
+		if r.ID != target.ID {
+			affected = append(affected, r)
+		}
+	}
+	return affected, nil
+}
+
+// shiftWallClock moves t by the calendar days between from and to and sets
+// it to the wall clock time of to.
+func (s *Service) shiftWallClock(t, from, to time.Time) time.Time {
+	t, from, to = t.In(s.location), from.In(s.location), to.In(s.location)
+	days := civilDay(to) - civilDay(from)
+	return time.Date(t.Year(), t.Month(), t.Day()+days, to.Hour(), to.Minute(), to.Second(), to.Nanosecond(), s.location)
+}
+
+// civilDay returns the number of days between the Unix epoch and the
+// calendar date of t.
+func civilDay(t time.Time) int {
+	y, m, d := t.Date()
+	return int(time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
+
+var weekdays = map[time.Weekday]rrule.Weekday{
+	time.Sunday:    rrule.SU,
+	time.Monday:    rrule.MO,
+	time.Tuesday:   rrule.TU,
+	time.Wednesday: rrule.WE,
+	time.Thursday:  rrule.TH,
+	time.Friday:    rrule.FR,
+	time.Saturday:  rrule.SA,
+}
@@ -0,0 +1,38 @@
+package reservation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+)
+
+func TestCheckCancellable(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     model.ReservationStatus
+		start, end time.Time
+		want       Code
+	}{
+		{name: "upcoming", status: model.ReservationStatusConfirmed, start: at(9, 0), end: at(10, 0)},
+		{name: "running", status: model.ReservationStatusCheckedIn, start: testNow.Add(-time.Hour), end: testNow.Add(time.Minute)},
+		{name: "ended", status: model.ReservationStatusCheckedIn, start: testNow.Add(-time.Hour), end: testNow, want: CodeNotModifiable},
+		{name: "cancelled", status: model.ReservationStatusCancelled, start: at(9, 0), end: at(10, 0), want: CodeNotModifiable},
+		{name: "released no-show", status: model.ReservationStatusCancelled, start: testNow.Add(-10 * time.Minute), end: testNow.Add(time.Hour), want: CodeNotModifiable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestService(nil, CheckInPolicy{})
+			err := s.checkCancellable(&model.Reservation{Status: tt.status, StartTime: tt.start, EndTime: tt.end})
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("checkCancellable() = %v, want nil", err)
+				}
+				return
+			}
+			if got := rejectionCode(t, err); got != tt.want {
+				t.Fatalf("checkCancellable() code = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,336 @@
+// Package reservation implements the booking rules that every reservation
+// has to satisfy before it is stored.
+package reservation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pixlcrashr/roomy/pkg/blocking"
+	"github.com/pixlcrashr/roomy/pkg/constraints"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"github.com/pixlcrashr/roomy/pkg/notification"
+	"gorm.io/gorm"
+)
+
+// DefaultIntervalMinutes is the slot length used for places without a
+// stored time slot configuration.
+const DefaultIntervalMinutes = 30
+
+// pgExclusionViolation is the SQLSTATE raised when the reservation overlap
+// exclusion constraint rejects a row.
+const pgExclusionViolation = "23P01"
+
+// Request describes a reservation to be booked.
+type Request struct {
+	PlaceID          uuid.UUID
+	UserID           uuid.UUID
+	Start            time.Time
+	End              time.Time
+	RecurringGroupID *uuid.UUID
+	// OnBehalf is set when the reservation is booked for UserID by
+	// another user.
+	OnBehalf *OnBehalf
+	// Bypass selects the booking rules the reservation is exempt from.
+	Bypass Bypass
+
+	// moving are further reservations moved together with the checked one.
+	// They are left out of the overlap check, since they free their slots.
+	moving []uuid.UUID
+}
+
+// Service validates and books reservations.
+type Service struct {
+	db       *gorm.DB
+	location *time.Location
+	checkIn  CheckInPolicy
+	profiles constraints.Strategy
+	now      func() time.Time
+}
+
+// NewService creates a new Service that evaluates booking hours and time
+// slot grids in the given location, checks in according to checkIn and
+// decides between the constraint profiles of a user's groups with profiles.
+func NewService(db *gorm.DB, location *time.Location, checkIn CheckInPolicy, profiles constraints.Strategy) *Service {
+	if location == nil {
+		location = time.UTC
+	}
+	if profiles == "" {
+		profiles = constraints.StrategyPermissive
+	}
+	return &Service{db: db, location: location, checkIn: checkIn, profiles: profiles, now: time.Now}
+}
+
+// Location returns the time zone booking rules are evaluated in.
+func (s *Service) Location() *time.Location {
+	return s.location
+}
+
+// Create validates and books a reservation in its own transaction and
+// notifies its user. Rule violations are returned as *Rejection.
+func (s *Service) Create(ctx context.Context, req Request) (*model.Reservation, error) {
+	var reservation *model.Reservation
+	if err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		reservation, err = s.CreateTx(ctx, tx, req)
+		if err != nil {
+			return err
+		}
+		return enqueue(ctx, tx, notification.KindReservationConfirmed, []*model.Reservation{reservation}, nil, req.OnBehalf)
+	}); err != nil {
+		return nil, err
+	}
+	return reservation, nil
+}
+
+// CreateTx validates and books a reservation using the given transaction.
+// Bookings on behalf of the user or bypassing booking rules are recorded in
+// the audit log; notifying the user is left to the caller.
+func (s *Service) CreateTx(ctx context.Context, tx *gorm.DB, req Request) (*model.Reservation, error) {
+	if err := s.Check(ctx, tx, req, nil); err != nil {
+		return nil, err
+	}
+
+	reservations := dbgen.ReservationQuery[model.Reservation](tx)
+	id := uuid.New()
+	if err := reservations.Insert(
+		ctx,
+		id,
+		req.PlaceID,
+		req.UserID,
+		req.Start,
+		req.End,
+		string(model.ReservationStatusConfirmed),
+		req.RecurringGroupID != nil,
+		req.RecurringGroupID,
+	); err != nil {
+		// The overlap check above cannot see rows of concurrent transactions;
+		// the exclusion constraint is the final arbiter.
+		if isExclusionViolation(err) {
+			return nil, reject(CodeOverlapping, "the place is already reserved for the requested time")
+		}
+		return nil, err
+	}
+	created, err := reservations.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := audit(ctx, tx, req.OnBehalf, req.Bypass, model.AuditActionCreate, created, nil, map[string]any{
+		"placeId":   created.PlaceID,
+		"userId":    created.UserID,
+		"startTime": created.StartTime,
+		"endTime":   created.EndTime,
+	}); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// Check validates a reservation request without booking it. When existing is
+// set, the request moves that reservation: it is ignored by the overlap check
+// and a start time it already had is not rejected for lying in the past.
+// Requests on behalf of the user or bypassing booking rules may book places
+// that can only be booked manually, and skip the checks they bypass; moves
+// are accepted at such places too.
+func (s *Service) Check(ctx context.Context, tx *gorm.DB, req Request, existing *model.Reservation) error {
+	// Reservations booked manually may be moved by their owners.
+	manual := req.OnBehalf != nil || req.Bypass != (Bypass{}) || existing != nil
+	place, err := s.checkPlace(ctx, tx, req.PlaceID, manual)
+	if err != nil {
+		return err
+	}
+	limits, err := constraints.ForUser(ctx, tx, place, req.UserID, s.profiles)
+	if err != nil {
+		return err
+	}
+	if err := s.checkUser(ctx, tx, place, &limits.BookingConstraints, req.UserID); err != nil {
+		return err
+	}
+
+	config, err := s.loadTimeSlotConfig(ctx, tx, place.ID)
+	if err != nil {
+		return err
+	}
+	checkPast := existing == nil || !existing.StartTime.Equal(req.Start)
+	if err := s.checkTimes(config, req.Start, req.End, checkPast); err != nil {
+		return err
+	}
+	if err := s.checkConstraints(limits, req, checkPast); err != nil {
+		return err
+	}
+	if !req.Bypass.Blockings {
+		if err := s.checkBlockings(ctx, tx, place, req.Start, req.End); err != nil {
+			return err
+		}
+	}
+
+	excludeIDs := req.moving
+	if existing != nil {
+		excludeIDs = append(excludeIDs[:len(excludeIDs):len(excludeIDs)], existing.ID)
+	}
+	overlapping, err := dbgen.ReservationQuery[model.Reservation](tx).ListOverlapping(ctx, place.ID, req.Start, req.End, excludeIDs)
+	if err != nil {
+		return err
+	}
+	if len(overlapping) > 0 {
+		return reject(CodeOverlapping, "the place is already reserved from %s to %s",
+			s.format(overlapping[0].StartTime), s.format(overlapping[0].EndTime))
+	}
+	if req.Bypass.Quotas {
+		return nil
+	}
+	return s.checkQuotas(ctx, tx, limits, req, existing)
+}
+
+// checkPlace loads a place and rejects it if it cannot be booked. Places that
+// can only be booked manually are accepted if manual is set.
+func (s *Service) checkPlace(ctx context.Context, tx *gorm.DB, placeID uuid.UUID, manual bool) (*model.Place, error) {
+	place, err := dbgen.PlaceQuery[model.Place](tx).GetByID(ctx, placeID)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case place == nil:
+		return nil, reject(CodePlaceNotFound, "place %s does not exist", placeID)
+	case !place.IsBookable:
+		return nil, reject(CodePlaceNotBookable, "place is not bookable")
+	case place.IsDisabled:
+		return nil, reject(CodePlaceDisabled, "place is disabled")
+	case place.BookingMethod != model.BookingMethodSelfService && !manual:
+		return nil, reject(CodeManualBookingOnly, "place can only be booked manually")
+	}
+	return place, nil
+}
+
+func (s *Service) checkUser(ctx context.Context, tx *gorm.DB, place *model.Place, limits *model.BookingConstraints, userID uuid.UUID) error {
+	user, err := dbgen.UserQuery[model.User](tx).GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return reject(CodeUserNotFound, "user %s does not exist", userID)
+	}
+	if !user.IsActive {
+		return reject(CodeUserInactive, "user account is disabled")
+	}
+
+	if limits.WhitelistEnabled == nil || !*limits.WhitelistEnabled {
+		return nil
+	}
+	listed, err := dbgen.PlaceQuery[model.Place](tx).CountWhitelist(ctx, place.ID, &userID)
+	if err != nil {
+		return err
+	}
+	if listed == 0 {
+		return reject(CodeNotWhitelisted, "user is not on the whitelist of this place")
+	}
+	return nil
+}
+
+func (s *Service) checkTimes(config *model.TimeSlotConfig, start, end time.Time, checkPast bool) error {
+	if !end.After(start) {
+		return reject(CodeInvalidTimeRange, "end time must be after start time")
+	}
+
+	interval := time.Duration(config.IntervalMinutes) * time.Minute
+	if interval <= 0 {
+		interval = DefaultIntervalMinutes * time.Minute
+	}
+
+	// The currently running slot may still be booked.
+	if checkPast && !start.Add(interval).After(s.now()) {
+		return reject(CodeInPast, "reservation must not start in the past")
+	}
+
+	localStart, localEnd := start.In(s.location), end.In(s.location)
+	if !onGrid(localStart, interval) || !onGrid(localEnd, interval) {
+		return reject(CodeNotAligned, "start and end time must be aligned to %d minute slots", int(interval.Minutes()))
+	}
+
+	if config.EarliestStartTime != nil {
+		earliest, err := clockOn(localStart, *config.EarliestStartTime)
+		if err != nil {
+			return err
+		}
+		if localStart.Before(earliest) {
+			return reject(CodeOutsideBookingHours, "reservation must not start before %s", *config.EarliestStartTime)
+		}
+	}
+	if config.LatestEndTime != nil {
+		latest, err := clockOn(localStart, *config.LatestEndTime)
+		if err != nil {
+			return err
+		}
+		if localEnd.After(latest) {
+			return reject(CodeOutsideBookingHours, "reservation must end by %s", *config.LatestEndTime)
+		}
+	}
+	return nil
+}
+
+func (s *Service) checkBlockings(ctx context.Context, tx *gorm.DB, place *model.Place, start, end time.Time) error {
+	intervals, err := blocking.ForPlace(ctx, tx, place, start, end, s.location)
+	if err != nil {
+		return err
+	}
+	if len(intervals) == 0 {
+		return nil
+	}
+
+	first := intervals[0]
+	source := first.Primary()
+	return reject(CodeBlocked, "the %s is blocked by %q from %s to %s",
+		source.EntityType, source.Label(), s.format(first.Start), s.format(first.End))
+}
+
+// TimeSlotConfig returns the time slot configuration of a place, or an
+// unsaved default configuration if none has been stored yet.
+func (s *Service) TimeSlotConfig(ctx context.Context, placeID uuid.UUID) (*model.TimeSlotConfig, error) {
+	return s.loadTimeSlotConfig(ctx, s.db, placeID)
+}
+
+// loadTimeSlotConfig returns the time slot configuration of a place, or an
+// unsaved default configuration if none has been stored yet.
+func (s *Service) loadTimeSlotConfig(ctx context.Context, tx *gorm.DB, placeID uuid.UUID) (*model.TimeSlotConfig, error) {
+	var config model.TimeSlotConfig
+	if err := tx.WithContext(ctx).First(&config, "place_id = ?", placeID).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		return &model.TimeSlotConfig{PlaceID: placeID, IntervalMinutes: DefaultIntervalMinutes}, nil
+	}
+	return &config, nil
+}
+
+func (s *Service) format(t time.Time) string {
+	return t.In(s.location).Format(time.RFC3339)
+}
+
+// onGrid reports whether the wall clock time of t is a multiple of interval
+// since midnight.
+func onGrid(t time.Time, interval time.Duration) bool {
+	sinceMidnight := time.Duration(t.Hour())*time.Hour +
+		time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second +
+		time.Duration(t.Nanosecond())
+	return sinceMidnight%interval == 0
+}
+
+// clockOn returns the "HH:MM" wall clock time on the day of t.
+func clockOn(t time.Time, clock string) (time.Time, error) {
+	parsed, err := time.Parse("15:04", clock)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time of day %q: %w", clock, err)
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), parsed.Hour(), parsed.Minute(), 0, 0, t.Location()), nil
+}
+
+func isExclusionViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgExclusionViolation
+}
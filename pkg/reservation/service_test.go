@@ -0,0 +1,322 @@
+package reservation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	database "github.com/pixlcrashr/roomy/pkg/db"
+	dbgen "github.com/pixlcrashr/roomy/pkg/db/gen"
+	"github.com/pixlcrashr/roomy/pkg/db/migrations"
+	"github.com/pixlcrashr/roomy/pkg/db/model"
+	"gorm.io/gorm"
+)
+
+// testDatabaseEnv names the environment variable holding the DSN of a
+// disposable PostgreSQL database.
+const testDatabaseEnv = "ROOMY_TEST_DATABASE_URL"
+
+// testNow is the current time of the services under test.
+var testNow = time.Date(2030, time.January, 7, 10, 10, 0, 0, time.UTC)
+
+// openTestDB connects to the test database and migrates it, or skips the
+// test if none is configured.
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	dsn := os.Getenv(testDatabaseEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDatabaseEnv)
+	}
+	db, err := database.Connect(dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+	if err := migrations.Run(sqlDB); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+// newTestService returns a service evaluating rules in UTC at testNow.
+func newTestService(db *gorm.DB, checkIn CheckInPolicy) *Service {
+	s := NewService(db, time.UTC, checkIn, "")
+	s.now = func() time.Time { return testNow }
+	return s
+}
+
+// newTestPlace stores a bookable place in a new building and area and
+// applies updates, given as column values, to it.
+func newTestPlace(t *testing.T, db *gorm.DB, updates map[string]any) *model.Place {
+	t.Helper()
+	building := &model.Building{Name: "Building " + uuid.NewString()}
+	if err := db.Create(building).Error; err != nil {
+		t.Fatal(err)
+	}
+	area := &model.Area{BuildingID: building.ID, Name: "Area"}
+	if err := db.Create(area).Error; err != nil {
+		t.Fatal(err)
+	}
+	place := &model.Place{AreaID: area.ID, Name: "Place"}
+	if err := db.Create(place).Error; err != nil {
+		t.Fatal(err)
+	}
+	// Zero values would be replaced by the column defaults on create.
+	if len(updates) > 0 {
+		if err := db.Model(place).Updates(updates).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	place.Area = area
+	return place
+}
+
+// newTestUser stores an active user.
+func newTestUser(t *testing.T, db *gorm.DB) uuid.UUID {
+	t.Helper()
+	id := uuid.New()
+	if err := dbgen.UserQuery[model.User](db).Insert(t.Context(), id, id.String()+"@example.com", id.String(), "J. Doe", nil, "gitlab", id.String()); err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
+
+// at returns the time on the day after testNow.
+func at(hour, minute int) time.Time {
+	return time.Date(2030, time.January, 8, hour, minute, 0, 0, time.UTC)
+}
+
+// rejectionCode returns the code of a *Rejection, or fails the test.
+func rejectionCode(t *testing.T, err error) Code {
+	t.Helper()
+	var rejection *Rejection
+	if !errors.As(err, &rejection) {
+		t.Fatalf("error = %v, want a rejection", err)
+	}
+	return rejection.Code
+}
+
+func TestCheckTimes(t *testing.T) {
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	clock := func(s string) *string { return &s }
+	today := func(hour, minute int) time.Time {
+		return time.Date(2030, time.January, 7, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name       string
+		location   *time.Location
+		config     model.TimeSlotConfig
+		start, end time.Time
+		moving     bool
+		want       Code
+	}{
+		{name: "valid", start: at(9, 0), end: at(10, 30)},
+		{name: "empty range", start: at(9, 0), end: at(9, 0), want: CodeInvalidTimeRange},
+		{name: "reversed range", start: at(10, 0), end: at(9, 0), want: CodeInvalidTimeRange},
+		{name: "running slot", start: today(10, 0), end: today(11, 0)},
+		{name: "past slot", start: today(9, 30), end: today(11, 0), want: CodeInPast},
+		{name: "past start of moved reservation", start: today(9, 30), end: today(11, 0), moving: true},
+		{name: "start off grid", start: at(9, 15), end: at(10, 0), want: CodeNotAligned},
+		{name: "end off grid", start: at(9, 0), end: at(10, 10), want: CodeNotAligned},
+		{name: "seconds off grid", start: at(9, 0).Add(time.Second), end: at(10, 0), want: CodeNotAligned},
+		{name: "coarse interval", config: model.TimeSlotConfig{IntervalMinutes: 60}, start: at(9, 30), end: at(11, 0), want: CodeNotAligned},
+		{name: "grid in location", location: kolkata, config: model.TimeSlotConfig{IntervalMinutes: 60}, start: at(9, 0), end: at(10, 0), want: CodeNotAligned},
+		{name: "grid in location aligned", location: kolkata, config: model.TimeSlotConfig{IntervalMinutes: 60}, start: at(8, 30), end: at(9, 30)},
+		{name: "before earliest start", config: model.TimeSlotConfig{EarliestStartTime: clock("08:00")}, start: at(7, 30), end: at(9, 0), want: CodeOutsideBookingHours},
+		{name: "at earliest start", config: model.TimeSlotConfig{EarliestStartTime: clock("08:00")}, start: at(8, 0), end: at(9, 0)},
+		{name: "after latest end", config: model.TimeSlotConfig{LatestEndTime: clock("18:00")}, start: at(17, 0), end: at(18, 30), want: CodeOutsideBookingHours},
+		{name: "at latest end", config: model.TimeSlotConfig{LatestEndTime: clock("18:00")}, start: at(17, 0), end: at(18, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestService(nil, CheckInPolicy{})
+			if tt.location != nil {
+				s.location = tt.location
+			}
+			err := s.checkTimes(&tt.config, tt.start, tt.end, !tt.moving)
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("checkTimes() = %v, want nil", err)
+				}
+				return
+			}
+			if got := rejectionCode(t, err); got != tt.want {
+				t.Fatalf("checkTimes() code = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsExclusionViolation(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"exclusion violation", &pgconn.PgError{Code: pgExclusionViolation}, true},
+		{"wrapped", fmt.Errorf("insert: %w", &pgconn.PgError{Code: pgExclusionViolation}), true},
+		{"unique violation", &pgconn.PgError{Code: "23505"}, false},
+		{"other error", errors.New("23P01"), false},
+	}
+	for _, tt := range tests {
+		if got := isExclusionViolation(tt.err); got != tt.want {
+			t.Errorf("%s: isExclusionViolation() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCreateRejections(t *testing.T) {
+	db := openTestDB(t)
+	s := newTestService(db, CheckInPolicy{})
+
+	blockPlace := func(t *testing.T, place *model.Place) {
+		name := "Closed"
+		if err := db.Create(&model.Blocking{
+			EntityType:   "building",
+			EntityID:     place.Area.BuildingID,
+			BlockingType: model.BlockingTypeClosedHours,
+			Name:         &name,
+			StartTime:    at(12, 0),
+			EndTime:      at(14, 0),
+		}).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	reservePlace := func(t *testing.T, place *model.Place) {
+		if _, err := s.Create(t.Context(), Request{PlaceID: place.ID, UserID: newTestUser(t, db), Start: at(12, 30), End: at(13, 30)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name       string
+		place      map[string]any
+		setup      func(t *testing.T, place *model.Place)
+		start, end time.Time
+		want       Code
+	}{
+		{name: "not bookable", place: map[string]any{"is_bookable": false}, start: at(9, 0), end: at(10, 0), want: CodePlaceNotBookable},
+		{name: "disabled", place: map[string]any{"is_disabled": true}, start: at(9, 0), end: at(10, 0), want: CodePlaceDisabled},
+		{name: "manual booking only", place: map[string]any{"booking_method": model.BookingMethodManual}, start: at(9, 0), end: at(10, 0), want: CodeManualBookingOnly},
+		{name: "blocked", setup: blockPlace, start: at(13, 0), end: at(15, 0), want: CodeBlocked},
+		{name: "next to blocking", setup: blockPlace, start: at(14, 0), end: at(15, 0)},
+		{name: "off grid", start: at(9, 10), end: at(10, 0), want: CodeNotAligned},
+		{name: "in the past", start: testNow.Add(-time.Hour).Truncate(30 * time.Minute), end: testNow.Add(time.Hour).Truncate(30 * time.Minute), want: CodeInPast},
+		{name: "overlapping", setup: reservePlace, start: at(13, 0), end: at(14, 0), want: CodeOverlapping},
+		{name: "adjacent", setup: reservePlace, start: at(13, 30), end: at(14, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			place := newTestPlace(t, db, tt.place)
+			if tt.setup != nil {
+				tt.setup(t, place)
+			}
+			_, err := s.Create(t.Context(), Request{PlaceID: place.ID, UserID: newTestUser(t, db), Start: tt.start, End: tt.end})
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("Create() = %v, want nil", err)
+				}
+				return
+			}
+			if got := rejectionCode(t, err); got != tt.want {
+				t.Fatalf("Create() code = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("unknown place", func(t *testing.T) {
+		_, err := s.Create(t.Context(), Request{PlaceID: uuid.New(), UserID: newTestUser(t, db), Start: at(9, 0), End: at(10, 0)})
+		if got := rejectionCode(t, err); got != CodePlaceNotFound {
+			t.Fatalf("Create() code = %s, want %s", got, CodePlaceNotFound)
+		}
+	})
+}
+
+func TestMoveAtManualPlace(t *testing.T) {
+	db := openTestDB(t)
+	s := newTestService(db, CheckInPolicy{})
+	place := newTestPlace(t, db, map[string]any{"booking_method": model.BookingMethodManual})
+	userID := newTestUser(t, db)
+
+	_, err := s.Create(t.Context(), Request{PlaceID: place.ID, UserID: userID, Start: at(9, 0), End: at(10, 0)})
+	if got := rejectionCode(t, err); got != CodeManualBookingOnly {
+		t.Fatalf("Create() code = %s, want %s", got, CodeManualBookingOnly)
+	}
+	r, err := s.Create(t.Context(), Request{PlaceID: place.ID, UserID: userID, Start: at(9, 0), End: at(10, 0), OnBehalf: &OnBehalf{ActorID: newTestUser(t, db)}})
+	if err != nil {
+		t.Fatalf("Create() on behalf = %v, want nil", err)
+	}
+	if _, err := s.Update(t.Context(), r.ID, at(11, 0), at(12, 0), ScopeOccurrence, Bypass{}, nil); err != nil {
+		t.Fatalf("Update() by owner = %v, want nil", err)
+	}
+}
+
+// TestCreateExclusionViolation books the same slot in two concurrent
+// transactions. The second one passes the overlap check, since the first
+// one's row is not visible to it, and is rejected by the exclusion
+// constraint once the first one commits.
+func TestCreateExclusionViolation(t *testing.T) {
+	db := openTestDB(t)
+	s := newTestService(db, CheckInPolicy{})
+	place := newTestPlace(t, db, nil)
+	req := Request{PlaceID: place.ID, Start: at(9, 0), End: at(10, 0)}
+
+	first := db.Begin()
+	defer first.Rollback()
+	req.UserID = newTestUser(t, db)
+	if _, err := s.CreateTx(t.Context(), first, req); err != nil {
+		t.Fatal(err)
+	}
+
+	req.UserID = newTestUser(t, db)
+	done := make(chan error, 1)
+	go func() {
+		done <- db.Transaction(func(tx *gorm.DB) error {
+			_, err := s.CreateTx(context.Background(), tx, req)
+			return err
+		})
+	}()
+	waitForLock(t, db)
+	if err := first.Commit().Error; err != nil {
+		t.Fatal(err)
+	}
+
+	err := <-done
+	if got := rejectionCode(t, err); got != CodeOverlapping {
+		t.Fatalf("CreateTx() code = %s, want %s", got, CodeOverlapping)
+	}
+	if !strings.Contains(err.Error(), "requested time") {
+		t.Fatalf("CreateTx() = %v, want the exclusion constraint's rejection", err)
+	}
+}
+
+// waitForLock waits until a session of the test database waits for a lock.
+func waitForLock(t *testing.T, db *gorm.DB) {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		var waiting int64
+		if err := db.Raw("SELECT count(*) FROM pg_stat_activity WHERE datname = current_database() AND wait_event_type = 'Lock'").Scan(&waiting).Error; err != nil {
+			t.Fatal(err)
+		}
+		if waiting > 0 {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("no session waits for a lock")
+}
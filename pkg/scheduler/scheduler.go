@@ -0,0 +1,91 @@
+// Package scheduler runs periodic background jobs. Every run of a job takes
+// place in a transaction holding a Postgres advisory lock of the job, so
+// that any number of Roomy processes can run the scheduler while each job
+// runs in at most one of them at a time.
+package scheduler
+
+import (
+	"context"
+	"hash/fnv"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+)
+
+// DefaultInterval is how often jobs run by default.
+const DefaultInterval = time.Minute
+
+// Job is a periodic background task. Its state lives in the database, so
+// that a job picks up where it left off after a restart.
+type Job struct {
+	Name string
+	// Run performs one run of the job using tx. If it returns an error, all
+	// of its changes are rolled back.
+	Run func(ctx context.Context, tx *gorm.DB) error
+}
+
+// Scheduler runs jobs every interval.
+type Scheduler struct {
+	db       *gorm.DB
+	interval time.Duration
+	jobs     []Job
+}
+
+// New creates a new Scheduler.
+func New(db *gorm.DB, interval time.Duration, jobs ...Job) *Scheduler {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Scheduler{db: db, interval: interval, jobs: jobs}
+}
+
+// Run runs all jobs right away and then every interval until ctx is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.RunOnce(ctx)
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunOnce runs every job once. Jobs currently running in another process
+// are skipped.
+func (s *Scheduler) RunOnce(ctx context.Context) {
+	for _, job := range s.jobs {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := s.run(ctx, job); err != nil {
+			log.Errorf("job %s failed: %v", job.Name, err)
+		}
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context, job Job) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// The lock is released with the end of the transaction.
+		var acquired bool
+		if err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", lockKey(job.Name)).Scan(&acquired).Error; err != nil {
+			return err
+		}
+		if !acquired {
+			return nil
+		}
+		return job.Run(ctx, tx)
+	})
+}
+
+// lockKey returns the advisory lock key of a job.
+func lockKey(name string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte("roomy:job:" + name))
+	return int64(h.Sum64())
+}
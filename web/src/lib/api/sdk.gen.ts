@@ -2,7 +2,7 @@
 
 import { type Client, formDataBodySerializer, type Options as Options2, type TDataShape } from './client';
 import { client } from './client.gen';
-import type { AddAreaBlockingEntriesData, AddAreaBlockingEntriesErrors, AddAreaBlockingEntriesResponses, AddAreaRoomPlanMarkersData, AddAreaRoomPlanMarkersErrors, AddAreaRoomPlanMarkersResponses, AddBuildingBlockingEntriesData, AddBuildingBlockingEntriesErrors, AddBuildingBlockingEntriesResponses, AddCurrentUserFavoritesData, AddCurrentUserFavoritesErrors, AddCurrentUserFavoritesResponses, AddGroupPermissionsData, AddGroupPermissionsErrors, AddGroupPermissionsResponses, AddPlaceBlockingEntriesData, AddPlaceBlockingEntriesErrors, AddPlaceBlockingEntriesResponses, AddPlaceEquipmentData, AddPlaceEquipmentErrors, AddPlaceEquipmentResponses, AddPlaceWhitelistUsersData, AddPlaceWhitelistUsersErrors, AddPlaceWhitelistUsersResponses, AddUserGroupsData, AddUserGroupsErrors, AddUserGroupsResponses, CancelReservationData, CancelReservationErrors, CancelReservationResponses, CheckInReservationData, CheckInReservationErrors, CheckInReservationResponses, CreateApiKeyData, CreateApiKeyErrors, CreateApiKeyResponses, CreateAreaData, CreateAreaErrors, CreateAreaResponses, CreateBuildingData, CreateBuildingErrors, CreateBuildingResponses, CreateEquipmentData, CreateEquipmentErrors, CreateEquipmentResponses, CreateGroupData, CreateGroupErrors, CreateGroupResponses, CreatePlaceData, CreatePlaceErrors, CreatePlaceResponses, CreateQrTemplateData, CreateQrTemplateErrors, CreateQrTemplateResponses, CreateReservationData, CreateReservationErrors, CreateReservationResponses, DeleteAreaData, DeleteAreaErrors, DeleteAreaResponses, DeleteAreaRoomPlanData, DeleteAreaRoomPlanErrors, DeleteAreaRoomPlanResponses, DeleteBuildingData, DeleteBuildingErrors, DeleteBuildingResponses, DeleteEquipmentData, DeleteEquipmentErrors, DeleteEquipmentResponses, DeleteGroupData, DeleteGroupErrors, DeleteGroupResponses, DeletePlaceData, DeletePlaceErrors, DeletePlaceResponses, DeleteQrTemplateData, DeleteQrTemplateErrors, DeleteQrTemplateResponses, DisableUserData, DisableUserErrors, DisableUserResponses, EnableUserData, EnableUserErrors, EnableUserResponses, ExportReservationsData, ExportReservationsErrors, ExportReservationsResponses, GetAreaAvailabilityData, GetAreaAvailabilityErrors, GetAreaAvailabilityResponses, GetAreaBlockingData, GetAreaBlockingErrors, GetAreaBlockingResponses, GetAreaCalendarData, GetAreaCalendarErrors, GetAreaCalendarResponses, GetAreaData, GetAreaErrors, GetAreaResponses, GetAreaRoomPlanData, GetAreaRoomPlanErrors, GetAreaRoomPlanResponses, GetAuditLogData, GetAuditLogErrors, GetAuditLogResponses, GetBuildingAvailabilityData, GetBuildingAvailabilityErrors, GetBuildingAvailabilityResponses, GetBuildingBlockingData, GetBuildingBlockingErrors, GetBuildingBlockingResponses, GetBuildingCalendarData, GetBuildingCalendarErrors, GetBuildingCalendarResponses, GetBuildingData, GetBuildingErrors, GetBuildingResponses, GetCurrentOccupancyData, GetCurrentOccupancyErrors, GetCurrentOccupancyResponses, GetCurrentUserData, GetCurrentUserErrors, GetCurrentUserFavoritesData, GetCurrentUserFavoritesErrors, GetCurrentUserFavoritesResponses, GetCurrentUserNotificationsData, GetCurrentUserNotificationsErrors, GetCurrentUserNotificationsResponses, GetCurrentUserResponses, GetDefaultGroupAssignmentData, GetDefaultGroupAssignmentErrors, GetDefaultGroupAssignmentResponses, GetEquipmentData, GetEquipmentErrors, GetEquipmentResponses, GetGroupData, GetGroupErrors, GetGroupMembersData, GetGroupMembersErrors, GetGroupMembersResponses, GetGroupPermissionsData, GetGroupPermissionsErrors, GetGroupPermissionsResponses, GetGroupResponses, GetPlaceAvailabilityData, GetPlaceAvailabilityErrors, GetPlaceAvailabilityResponses, GetPlaceBlockingData, GetPlaceBlockingErrors, GetPlaceBlockingResponses, GetPlaceCalendarData, GetPlaceCalendarErrors, GetPlaceCalendarResponses, GetPlaceConstraintsData, GetPlaceConstraintsErrors, GetPlaceConstraintsResponses, GetPlaceData, GetPlaceEquipmentData, GetPlaceEquipmentErrors, GetPlaceEquipmentResponses, GetPlaceErrors, GetPlaceQrCodeData, GetPlaceQrCodeErrors, GetPlaceQrCodeResponses, GetPlaceResponses, GetPlaceTimeSlotsData, GetPlaceTimeSlotsErrors, GetPlaceTimeSlotsResponses, GetPlaceWhitelistData, GetPlaceWhitelistErrors, GetPlaceWhitelistResponses, GetQrTemplateData, GetQrTemplateErrors, GetQrTemplateResponses, GetReservationData, GetReservationErrors, GetReservationResponses, GetReservationShareLinkData, GetReservationShareLinkErrors, GetReservationShareLinkResponses, GetStatisticsData, GetStatisticsErrors, GetStatisticsResponses, GetUsageStatisticsData, GetUsageStatisticsErrors, GetUsageStatisticsResponses, GetUserData, GetUserErrors, GetUserGroupsData, GetUserGroupsErrors, GetUserGroupsResponses, GetUserResponses, HandleOAuthCallbackData, HandleOAuthCallbackErrors, HandleOAuthCallbackResponses, InitiateOAuthLoginData, ListApiKeysData, ListApiKeysErrors, ListApiKeysResponses, ListAreaPlacesData, ListAreaPlacesErrors, ListAreaPlacesResponses, ListAreasData, ListAreasResponses, ListBuildingAreasData, ListBuildingAreasErrors, ListBuildingAreasResponses, ListBuildingsData, ListBuildingsResponses, ListEquipmentData, ListEquipmentResponses, ListGroupsData, ListGroupsErrors, ListGroupsResponses, ListPermissionsData, ListPermissionsErrors, ListPermissionsResponses, ListPlacesData, ListPlacesResponses, ListQrTemplatesData, ListQrTemplatesErrors, ListQrTemplatesResponses, ListReservationsData, ListReservationsErrors, ListReservationsResponses, ListUsersData, ListUsersErrors, ListUsersResponses, LogoutData, LogoutResponses, PreviewQrTemplateData, PreviewQrTemplateErrors, PreviewQrTemplateResponses, RefreshTokenData, RefreshTokenErrors, RefreshTokenResponses, RemoveAreaBlockingEntriesData, RemoveAreaBlockingEntriesErrors, RemoveAreaBlockingEntriesResponses, RemoveAreaRoomPlanMarkersData, RemoveAreaRoomPlanMarkersErrors, RemoveAreaRoomPlanMarkersResponses, RemoveBuildingBlockingEntriesData, RemoveBuildingBlockingEntriesErrors, RemoveBuildingBlockingEntriesResponses, RemoveCurrentUserFavoritesData, RemoveCurrentUserFavoritesErrors, RemoveCurrentUserFavoritesResponses, RemoveGroupPermissionsData, RemoveGroupPermissionsErrors, RemoveGroupPermissionsResponses, RemovePlaceBlockingEntriesData, RemovePlaceBlockingEntriesErrors, RemovePlaceBlockingEntriesResponses, RemovePlaceEquipmentData, RemovePlaceEquipmentErrors, RemovePlaceEquipmentResponses, RemovePlaceWhitelistUsersData, RemovePlaceWhitelistUsersErrors, RemovePlaceWhitelistUsersResponses, RemoveUserGroupsData, RemoveUserGroupsErrors, RemoveUserGroupsResponses, ReplaceAreaBlockingData, ReplaceAreaBlockingErrors, ReplaceAreaBlockingResponses, ReplaceBuildingBlockingData, ReplaceBuildingBlockingErrors, ReplaceBuildingBlockingResponses, ReplacePlaceBlockingData, ReplacePlaceBlockingErrors, ReplacePlaceBlockingResponses, RevokeApiKeyData, RevokeApiKeyErrors, RevokeApiKeyResponses, SetDefaultGroupAssignmentData, SetDefaultGroupAssignmentErrors, SetDefaultGroupAssignmentResponses, UpdateAreaData, UpdateAreaErrors, UpdateAreaResponses, UpdateAreaRoomPlanData, UpdateAreaRoomPlanErrors, UpdateAreaRoomPlanResponses, UpdateBuildingData, UpdateBuildingErrors, UpdateBuildingResponses, UpdateCurrentUserNotificationsData, UpdateCurrentUserNotificationsErrors, UpdateCurrentUserNotificationsResponses, UpdateEquipmentData, UpdateEquipmentErrors, UpdateEquipmentResponses, UpdateGroupData, UpdateGroupErrors, UpdateGroupResponses, UpdatePlaceConstraintsData, UpdatePlaceConstraintsErrors, UpdatePlaceConstraintsResponses, UpdatePlaceData, UpdatePlaceErrors, UpdatePlaceResponses, UpdatePlaceTimeSlotsData, UpdatePlaceTimeSlotsErrors, UpdatePlaceTimeSlotsResponses, UpdateQrTemplateData, UpdateQrTemplateErrors, UpdateQrTemplateResponses, UpdateReservationData, UpdateReservationErrors, UpdateReservationResponses, UpdateUserData, UpdateUserErrors, UpdateUserResponses } from './types.gen';
+import type { AddAreaBlockingEntriesData, AddAreaBlockingEntriesErrors, AddAreaBlockingEntriesResponses, AddAreaRoomPlanMarkersData, AddAreaRoomPlanMarkersErrors, AddAreaRoomPlanMarkersResponses, AddBuildingBlockingEntriesData, AddBuildingBlockingEntriesErrors, AddBuildingBlockingEntriesResponses, AddCurrentUserFavoritesData, AddCurrentUserFavoritesErrors, AddCurrentUserFavoritesResponses, AddGroupPermissionsData, AddGroupPermissionsErrors, AddGroupPermissionsResponses, AddPlaceBlockingEntriesData, AddPlaceBlockingEntriesErrors, AddPlaceBlockingEntriesResponses, AddPlaceEquipmentData, AddPlaceEquipmentErrors, AddPlaceEquipmentResponses, AddPlaceWhitelistUsersData, AddPlaceWhitelistUsersErrors, AddPlaceWhitelistUsersResponses, AddUserGroupsData, AddUserGroupsErrors, AddUserGroupsResponses, CancelReservationData, CancelReservationErrors, CancelReservationResponses, CheckInReservationData, CheckInReservationErrors, CheckInReservationResponses, CreateApiKeyData, CreateApiKeyErrors, CreateApiKeyResponses, CreateAreaData, CreateAreaErrors, CreateAreaResponses, CreateBuildingData, CreateBuildingErrors, CreateBuildingResponses, CreateEquipmentData, CreateEquipmentErrors, CreateEquipmentResponses, CreateGroupConstraintProfileData, CreateGroupConstraintProfileErrors, CreateGroupConstraintProfileResponses, CreateGroupData, CreateGroupErrors, CreateGroupResponses, CreatePlaceData, CreatePlaceErrors, CreatePlaceResponses, CreateQrTemplateData, CreateQrTemplateErrors, CreateQrTemplateResponses, CreateReservationData, CreateReservationErrors, CreateReservationResponses, DeleteAreaData, DeleteAreaErrors, DeleteAreaResponses, DeleteAreaRoomPlanData, DeleteAreaRoomPlanErrors, DeleteAreaRoomPlanResponses, DeleteBuildingData, DeleteBuildingErrors, DeleteBuildingResponses, DeleteEquipmentData, DeleteEquipmentErrors, DeleteEquipmentResponses, DeleteGroupConstraintProfileData, DeleteGroupConstraintProfileErrors, DeleteGroupConstraintProfileResponses, DeleteGroupData, DeleteGroupErrors, DeleteGroupResponses, DeletePlaceData, DeletePlaceErrors, DeletePlaceResponses, DeleteQrTemplateData, DeleteQrTemplateErrors, DeleteQrTemplateResponses, DisableUserData, DisableUserErrors, DisableUserResponses, EnableUserData, EnableUserErrors, EnableUserResponses, ExportAreaQrCodesData, ExportAreaQrCodesErrors, ExportAreaQrCodesResponses, ExportBuildingQrCodesData, ExportBuildingQrCodesErrors, ExportBuildingQrCodesResponses, ExportReservationsData, ExportReservationsErrors, ExportReservationsResponses, GetAreaAvailabilityData, GetAreaAvailabilityErrors, GetAreaAvailabilityResponses, GetAreaBlockingData, GetAreaBlockingErrors, GetAreaBlockingResponses, GetAreaCalendarData, GetAreaCalendarErrors, GetAreaCalendarResponses, GetAreaConstraintsData, GetAreaConstraintsErrors, GetAreaConstraintsResponses, GetAreaData, GetAreaErrors, GetAreaResponses, GetAreaRoomPlanData, GetAreaRoomPlanErrors, GetAreaRoomPlanResponses, GetAuditLogData, GetAuditLogErrors, GetAuditLogResponses, GetBuildingAvailabilityData, GetBuildingAvailabilityErrors, GetBuildingAvailabilityResponses, GetBuildingBlockingData, GetBuildingBlockingErrors, GetBuildingBlockingResponses, GetBuildingCalendarData, GetBuildingCalendarErrors, GetBuildingCalendarResponses, GetBuildingConstraintsData, GetBuildingConstraintsErrors, GetBuildingConstraintsResponses, GetBuildingData, GetBuildingErrors, GetBuildingResponses, GetCurrentOccupancyData, GetCurrentOccupancyErrors, GetCurrentOccupancyResponses, GetCurrentUserData, GetCurrentUserErrors, GetCurrentUserFavoritesData, GetCurrentUserFavoritesErrors, GetCurrentUserFavoritesResponses, GetCurrentUserNotificationsData, GetCurrentUserNotificationsErrors, GetCurrentUserNotificationsResponses, GetCurrentUserResponses, GetDefaultGroupAssignmentData, GetDefaultGroupAssignmentErrors, GetDefaultGroupAssignmentResponses, GetEffectivePlaceConstraintsData, GetEffectivePlaceConstraintsErrors, GetEffectivePlaceConstraintsResponses, GetEquipmentData, GetEquipmentErrors, GetEquipmentResponses, GetGroupData, GetGroupErrors, GetGroupMembersData, GetGroupMembersErrors, GetGroupMembersResponses, GetGroupPermissionsData, GetGroupPermissionsErrors, GetGroupPermissionsResponses, GetGroupResponses, GetPlaceAvailabilityData, GetPlaceAvailabilityErrors, GetPlaceAvailabilityResponses, GetPlaceBlockingData, GetPlaceBlockingErrors, GetPlaceBlockingResponses, GetPlaceCalendarData, GetPlaceCalendarErrors, GetPlaceCalendarResponses, GetPlaceConstraintsData, GetPlaceConstraintsErrors, GetPlaceConstraintsResponses, GetPlaceData, GetPlaceEquipmentData, GetPlaceEquipmentErrors, GetPlaceEquipmentResponses, GetPlaceErrors, GetPlaceQrCodeData, GetPlaceQrCodeErrors, GetPlaceQrCodeResponses, GetPlaceQrKeyData, GetPlaceQrKeyErrors, GetPlaceQrKeyResponses, GetPlaceResponses, GetPlaceTimeSlotsData, GetPlaceTimeSlotsErrors, GetPlaceTimeSlotsResponses, GetPlaceWhitelistData, GetPlaceWhitelistErrors, GetPlaceWhitelistResponses, GetQrTemplateData, GetQrTemplateErrors, GetQrTemplateResponses, GetReservationData, GetReservationErrors, GetReservationResponses, GetReservationShareLinkData, GetReservationShareLinkErrors, GetReservationShareLinkResponses, GetStatisticsData, GetStatisticsErrors, GetStatisticsResponses, GetUsageStatisticsData, GetUsageStatisticsErrors, GetUsageStatisticsResponses, GetUserData, GetUserErrors, GetUserGroupsData, GetUserGroupsErrors, GetUserGroupsResponses, GetUserResponses, HandleOAuthCallbackData, HandleOAuthCallbackErrors, HandleOAuthCallbackResponses, InitiateOAuthLoginData, ListApiKeysData, ListApiKeysErrors, ListApiKeysResponses, ListAreaPlacesData, ListAreaPlacesErrors, ListAreaPlacesResponses, ListAreasData, ListAreasResponses, ListBuildingAreasData, ListBuildingAreasErrors, ListBuildingAreasResponses, ListBuildingsData, ListBuildingsResponses, ListEquipmentData, ListEquipmentResponses, ListGroupConstraintProfilesData, ListGroupConstraintProfilesErrors, ListGroupConstraintProfilesResponses, ListGroupsData, ListGroupsErrors, ListGroupsResponses, ListPermissionsData, ListPermissionsErrors, ListPermissionsResponses, ListPlacesData, ListPlacesResponses, ListQrTemplatesData, ListQrTemplatesErrors, ListQrTemplatesResponses, ListReservationsData, ListReservationsErrors, ListReservationsResponses, ListUsersData, ListUsersErrors, ListUsersResponses, LogoutData, LogoutResponses, PreviewQrTemplateData, PreviewQrTemplateErrors, PreviewQrTemplateResponses, RefreshTokenData, RefreshTokenErrors, RefreshTokenResponses, RemoveAreaBlockingEntriesData, RemoveAreaBlockingEntriesErrors, RemoveAreaBlockingEntriesResponses, RemoveAreaRoomPlanMarkersData, RemoveAreaRoomPlanMarkersErrors, RemoveAreaRoomPlanMarkersResponses, RemoveBuildingBlockingEntriesData, RemoveBuildingBlockingEntriesErrors, RemoveBuildingBlockingEntriesResponses, RemoveCurrentUserFavoritesData, RemoveCurrentUserFavoritesErrors, RemoveCurrentUserFavoritesResponses, RemoveGroupPermissionsData, RemoveGroupPermissionsErrors, RemoveGroupPermissionsResponses, RemovePlaceBlockingEntriesData, RemovePlaceBlockingEntriesErrors, RemovePlaceBlockingEntriesResponses, RemovePlaceEquipmentData, RemovePlaceEquipmentErrors, RemovePlaceEquipmentResponses, RemovePlaceWhitelistUsersData, RemovePlaceWhitelistUsersErrors, RemovePlaceWhitelistUsersResponses, RemoveUserGroupsData, RemoveUserGroupsErrors, RemoveUserGroupsResponses, ReplaceAreaBlockingData, ReplaceAreaBlockingErrors, ReplaceAreaBlockingResponses, ReplaceBuildingBlockingData, ReplaceBuildingBlockingErrors, ReplaceBuildingBlockingResponses, ReplacePlaceBlockingData, ReplacePlaceBlockingErrors, ReplacePlaceBlockingResponses, RevokeApiKeyData, RevokeApiKeyErrors, RevokeApiKeyResponses, RotatePlaceQrKeyData, RotatePlaceQrKeyErrors, RotatePlaceQrKeyResponses, SetDefaultGroupAssignmentData, SetDefaultGroupAssignmentErrors, SetDefaultGroupAssignmentResponses, UpdateAreaConstraintsData, UpdateAreaConstraintsErrors, UpdateAreaConstraintsResponses, UpdateAreaData, UpdateAreaErrors, UpdateAreaResponses, UpdateAreaRoomPlanData, UpdateAreaRoomPlanErrors, UpdateAreaRoomPlanResponses, UpdateBuildingConstraintsData, UpdateBuildingConstraintsErrors, UpdateBuildingConstraintsResponses, UpdateBuildingData, UpdateBuildingErrors, UpdateBuildingResponses, UpdateCurrentUserNotificationsData, UpdateCurrentUserNotificationsErrors, UpdateCurrentUserNotificationsResponses, UpdateEquipmentData, UpdateEquipmentErrors, UpdateEquipmentResponses, UpdateGroupConstraintProfileData, UpdateGroupConstraintProfileErrors, UpdateGroupConstraintProfileResponses, UpdateGroupData, UpdateGroupErrors, UpdateGroupResponses, UpdatePlaceConstraintsData, UpdatePlaceConstraintsErrors, UpdatePlaceConstraintsResponses, UpdatePlaceData, UpdatePlaceErrors, UpdatePlaceResponses, UpdatePlaceTimeSlotsData, UpdatePlaceTimeSlotsErrors, UpdatePlaceTimeSlotsResponses, UpdateQrTemplateData, UpdateQrTemplateErrors, UpdateQrTemplateResponses, UpdateReservationData, UpdateReservationErrors, UpdateReservationResponses, UpdateUserData, UpdateUserErrors, UpdateUserResponses } from './types.gen';
 
 export type Options<TData extends TDataShape = TDataShape, ThrowOnError extends boolean = boolean> = Options2<TData, ThrowOnError> & {
     /**
@@ -21,7 +21,9 @@ export type Options<TData extends TDataShape = TDataShape, ThrowOnError extends
 /**
  * Initiate GitLab OAuth flow
  *
- * Redirects to GitLab authorization URL
+ * Redirects to GitLab authorization URL. The state is bound to the browser
+ * by a cookie, which the callback requires.
+ *
  */
 export const initiateOAuthLogin = <ThrowOnError extends boolean = false>(options?: Options<InitiateOAuthLoginData, ThrowOnError>) => {
     return (options?.client ?? client).get<unknown, unknown, ThrowOnError>({
@@ -33,7 +35,9 @@ export const initiateOAuthLogin = <ThrowOnError extends boolean = false>(options
 /**
  * OAuth callback handler
  *
- * Handles the OAuth callback from GitLab
+ * Handles the OAuth callback from GitLab. The responses below expire the
+ * state cookie, since a state can only be used once.
+ *
  */
 export const handleOAuthCallback = <ThrowOnError extends boolean = false>(options: Options<HandleOAuthCallbackData, ThrowOnError>) => {
     return (options.client ?? client).get<HandleOAuthCallbackResponses, HandleOAuthCallbackErrors, ThrowOnError>({
@@ -270,6 +274,44 @@ export const addBuildingBlockingEntries = <ThrowOnError extends boolean = false>
     });
 };
 
+/**
+ * Get building constraints
+ *
+ * Returns the default constraints of all places in the building. Places
+ * inherit every field they do not set themselves.
+ *
+ */
+export const getBuildingConstraints = <ThrowOnError extends boolean = false>(options: Options<GetBuildingConstraintsData, ThrowOnError>) => {
+    return (options.client ?? client).get<GetBuildingConstraintsResponses, GetBuildingConstraintsErrors, ThrowOnError>({
+        url: '/buildings/{buildingId}/constraints',
+        ...options
+    });
+};
+
+/**
+ * Update building constraints
+ */
+export const updateBuildingConstraints = <ThrowOnError extends boolean = false>(options: Options<UpdateBuildingConstraintsData, ThrowOnError>) => {
+    return (options.client ?? client).put<UpdateBuildingConstraintsResponses, UpdateBuildingConstraintsErrors, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            },
+            {
+                name: 'X-Api-Key',
+                type: 'apiKey'
+            }
+        ],
+        url: '/buildings/{buildingId}/constraints',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options.headers
+        }
+    });
+};
+
 /**
  * List areas in building
  */
@@ -285,6 +327,10 @@ export const listBuildingAreas = <ThrowOnError extends boolean = false>(options:
  *
  * Returns time slots that are NOT blocked for the building.
  * Useful for seeing when the building is open/operational.
+ * Only building-level blockings are considered, with recurrences expanded.
+ * Besides the merged open ranges, the response summarizes the regular opening
+ * hours per weekday and lists the days that deviate from them.
+ * Ranges longer than 31 days are truncated.
  *
  */
 export const getBuildingAvailability = <ThrowOnError extends boolean = false>(options: Options<GetBuildingAvailabilityData, ThrowOnError>) => {
@@ -297,7 +343,10 @@ export const getBuildingAvailability = <ThrowOnError extends boolean = false>(op
 /**
  * Public iCalendar feed for building
  *
- * Returns .ics file with blocking periods and reservations for all places (next 3 months)
+ * Returns .ics file with blocking periods and reservations for all places (next 3 months).
+ * Blockings of the building and of its areas and places are included. Reservations never
+ * include details about the user holding them; recurring blockings are emitted as RRULE/EXDATE.
+ *
  */
 export const getBuildingCalendar = <ThrowOnError extends boolean = false>(options: Options<GetBuildingCalendarData, ThrowOnError>) => {
     return (options.client ?? client).get<GetBuildingCalendarResponses, GetBuildingCalendarErrors, ThrowOnError>({
@@ -306,6 +355,31 @@ export const getBuildingCalendar = <ThrowOnError extends boolean = false>(option
     });
 };
 
+/**
+ * Export QR code labels of all places in the building
+ *
+ * Renders the labels of all bookable, enabled places in the building, sorted by place name,
+ * either as PDF print sheets with as many labels per sheet as fit on a grid, or as ZIP
+ * archive of PNG images. At most 2000 places are exported at once.
+ *
+ */
+export const exportBuildingQrCodes = <ThrowOnError extends boolean = false>(options: Options<ExportBuildingQrCodesData, ThrowOnError>) => {
+    return (options.client ?? client).get<ExportBuildingQrCodesResponses, ExportBuildingQrCodesErrors, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            },
+            {
+                name: 'X-Api-Key',
+                type: 'apiKey'
+            }
+        ],
+        url: '/buildings/{buildingId}/qrCodes',
+        ...options
+    });
+};
+
 /**
  * List all areas
  */
@@ -583,6 +657,44 @@ export const addAreaBlockingEntries = <ThrowOnError extends boolean = false>(opt
     });
 };
 
+/**
+ * Get area constraints
+ *
+ * Returns the default constraints of all places in the area. Places
+ * inherit every field they do not set themselves, falling back to the building.
+ *
+ */
+export const getAreaConstraints = <ThrowOnError extends boolean = false>(options: Options<GetAreaConstraintsData, ThrowOnError>) => {
+    return (options.client ?? client).get<GetAreaConstraintsResponses, GetAreaConstraintsErrors, ThrowOnError>({
+        url: '/areas/{areaId}/constraints',
+        ...options
+    });
+};
+
+/**
+ * Update area constraints
+ */
+export const updateAreaConstraints = <ThrowOnError extends boolean = false>(options: Options<UpdateAreaConstraintsData, ThrowOnError>) => {
+    return (options.client ?? client).put<UpdateAreaConstraintsResponses, UpdateAreaConstraintsErrors, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            },
+            {
+                name: 'X-Api-Key',
+                type: 'apiKey'
+            }
+        ],
+        url: '/areas/{areaId}/constraints',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options.headers
+        }
+    });
+};
+
 /**
  * List places in area
  */
@@ -599,6 +711,8 @@ export const listAreaPlaces = <ThrowOnError extends boolean = false>(options: Op
  * Returns availability status for each place in the area within the given time range.
  * Availability is calculated as: time slots NOT blocked (from place, area, or building)
  * AND NOT reserved. Optimized for room plan visualization.
+ * The window defaults to the whole day; nextAvailableSlot is the first available
+ * slot on that day starting at or after the window start.
  *
  */
 export const getAreaAvailability = <ThrowOnError extends boolean = false>(options: Options<GetAreaAvailabilityData, ThrowOnError>) => {
@@ -611,7 +725,10 @@ export const getAreaAvailability = <ThrowOnError extends boolean = false>(option
 /**
  * Public iCalendar feed for area
  *
- * Returns .ics file with blocking periods and reservations for all places in area (next 3 months)
+ * Returns .ics file with blocking periods and reservations for all places in area (next 3 months).
+ * Blockings of the area, its places and its building are included. Reservations never include
+ * details about the user holding them; recurring blockings are emitted as RRULE/EXDATE.
+ *
  */
 export const getAreaCalendar = <ThrowOnError extends boolean = false>(options: Options<GetAreaCalendarData, ThrowOnError>) => {
     return (options.client ?? client).get<GetAreaCalendarResponses, GetAreaCalendarErrors, ThrowOnError>({
@@ -620,6 +737,47 @@ export const getAreaCalendar = <ThrowOnError extends boolean = false>(options: O
     });
 };
 
+/**
+ * Export QR code labels of all places in the area
+ *
+ * Renders the labels of all bookable, enabled places in the area, sorted by place name,
+ * either as PDF print sheets with as many labels per sheet as fit on a grid, or as ZIP
+ * archive of PNG images. At most 2000 places are exported at once.
+ *
+ */
+export const exportAreaQrCodes = <ThrowOnError extends boolean = false>(options: Options<ExportAreaQrCodesData, ThrowOnError>) => {
+    return (options.client ?? client).get<ExportAreaQrCodesResponses, ExportAreaQrCodesErrors, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            },
+            {
+                name: 'X-Api-Key',
+                type: 'apiKey'
+            }
+        ],
+        url: '/areas/{areaId}/qrCodes',
+        ...options
+    });
+};
+
+/**
+ * Personal iCalendar feed
+ *
+ * Returns .ics file with the reservations of the user owning the token, from three months
+ * ago until three months ahead, including place, area and building names and whether a
+ * check-in is required. Cancelled reservations are included with STATUS:CANCELLED so that
+ * subscribed calendars remove them.
+ *
+ */
+export const getUserCalendar = <ThrowOnError extends boolean = false>(options: Options<GetUserCalendarData, ThrowOnError>) => {
+    return (options.client ?? client).get<GetUserCalendarResponses, GetUserCalendarErrors, ThrowOnError>({
+        url: '/calendar/users/{token}.ics',
+        ...options
+    });
+};
+
 /**
  * List/search places
  */
@@ -716,6 +874,11 @@ export const updatePlace = <ThrowOnError extends boolean = false>(options: Optio
 
 /**
  * Get place constraints
+ *
+ * Returns the constraints set on the place itself. Unset fields are
+ * inherited from the area and the building of the place; see
+ * getEffectivePlaceConstraints for the constraints that apply.
+ *
  */
 export const getPlaceConstraints = <ThrowOnError extends boolean = false>(options: Options<GetPlaceConstraintsData, ThrowOnError>) => {
     return (options.client ?? client).get<GetPlaceConstraintsResponses, GetPlaceConstraintsErrors, ThrowOnError>({
@@ -748,6 +911,21 @@ export const updatePlaceConstraints = <ThrowOnError extends boolean = false>(opt
     });
 };
 
+/**
+ * Get effective place constraints
+ *
+ * Returns the constraints that apply to bookings of the place. Every
+ * field is taken from the place if set there, otherwise from its area,
+ * otherwise from its building.
+ *
+ */
+export const getEffectivePlaceConstraints = <ThrowOnError extends boolean = false>(options: Options<GetEffectivePlaceConstraintsData, ThrowOnError>) => {
+    return (options.client ?? client).get<GetEffectivePlaceConstraintsResponses, GetEffectivePlaceConstraintsErrors, ThrowOnError>({
+        url: '/places/{placeId}/constraints/effective',
+        ...options
+    });
+};
+
 /**
  * Get configured time slot intervals
  *
@@ -1007,6 +1185,8 @@ export const addPlaceBlockingEntries = <ThrowOnError extends boolean = false>(op
  * Availability is computed as: All valid time slot intervals
  * MINUS blocking periods (own + inherited from area + building)
  * MINUS existing reservations.
+ * Ranges longer than 31 days are truncated. Reserved slots never
+ * include details about the user holding the reservation.
  *
  */
 export const getPlaceAvailability = <ThrowOnError extends boolean = false>(options: Options<GetPlaceAvailabilityData, ThrowOnError>) => {
@@ -1018,6 +1198,12 @@ export const getPlaceAvailability = <ThrowOnError extends boolean = false>(optio
 
 /**
  * Generate QR code
+ *
+ * Renders the printable label of the place at 300 DPI. The QR symbol encodes the check-in
+ * URL of the place; the requested details are printed below it in the order name,
+ * areaName, buildingName. PNG and JPEG images carry their resolution, PDFs consist of a
+ * single page of the size of the label.
+ *
  */
 export const getPlaceQrCode = <ThrowOnError extends boolean = false>(options: Options<GetPlaceQrCodeData, ThrowOnError>) => {
     return (options.client ?? client).get<GetPlaceQrCodeResponses, GetPlaceQrCodeErrors, ThrowOnError>({
@@ -1042,10 +1228,59 @@ export const getPlaceQrCode = <ThrowOnError extends boolean = false>(options: Op
     });
 };
 
+/**
+ * Get the current QR key of a place
+ *
+ * Returns the key version and the check-in URL encoded in the place's QR code.
+ */
+export const getPlaceQrKey = <ThrowOnError extends boolean = false>(options: Options<GetPlaceQrKeyData, ThrowOnError>) => {
+    return (options.client ?? client).get<GetPlaceQrKeyResponses, GetPlaceQrKeyErrors, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            },
+            {
+                name: 'X-Api-Key',
+                type: 'apiKey'
+            }
+        ],
+        url: '/places/{placeId}/qrCode/key',
+        ...options
+    });
+};
+
+/**
+ * Rotate the QR key of a place
+ *
+ * Increments the key version of the place. All QR codes printed before are rejected at
+ * check-in with QR_CODE_REVOKED and have to be replaced by newly generated ones.
+ *
+ */
+export const rotatePlaceQrKey = <ThrowOnError extends boolean = false>(options: Options<RotatePlaceQrKeyData, ThrowOnError>) => {
+    return (options.client ?? client).post<RotatePlaceQrKeyResponses, RotatePlaceQrKeyErrors, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            },
+            {
+                name: 'X-Api-Key',
+                type: 'apiKey'
+            }
+        ],
+        url: '/places/{placeId}/qrCode/key',
+        ...options
+    });
+};
+
 /**
  * Public iCalendar feed for place
  *
- * Returns .ics file with blocking periods and reservations (next 3 months)
+ * Returns .ics file with blocking periods and reservations (next 3 months).
+ * Blockings inherited from the area and building are included. Reservations never include
+ * details about the user holding them; recurring blockings are emitted as RRULE/EXDATE.
+ *
  */
 export const getPlaceCalendar = <ThrowOnError extends boolean = false>(options: Options<GetPlaceCalendarData, ThrowOnError>) => {
     return (options.client ?? client).get<GetPlaceCalendarResponses, GetPlaceCalendarErrors, ThrowOnError>({
@@ -1080,7 +1315,17 @@ export const listReservations = <ThrowOnError extends boolean = false>(options?:
  * Creates a single reservation or multiple recurring reservations.
  * For recurring reservations, provide the recurrence field with pattern details.
  * Start/end times must align with the place's configured time slot intervals.
- * Reservation will fail if any time slot is blocked or already reserved.
+ * A single reservation fails if its time slot is blocked or already reserved,
+ * or if it would exceed a quota of the place's constraints. Constraint profiles
+ * of the user's groups override the place's constraints; rejections name the
+ * profile a violated limit was taken from.
+ * Recurring reservations are created as a series; with mode skipConflicts,
+ * conflicting occurrences are skipped and reported instead.
+ * Holders of manage:reservations for the place may book on behalf of another
+ * user given by userId or userEmail, including places that can only be booked
+ * manually, and may bypass the user's quotas and blockings of the place. Such
+ * bookings are recorded in the audit log and the user is notified who booked
+ * for them.
  *
  */
 export const createReservation = <ThrowOnError extends boolean = false>(options: Options<CreateReservationData, ThrowOnError>) => {
@@ -1106,6 +1351,11 @@ export const createReservation = <ThrowOnError extends boolean = false>(options:
 
 /**
  * Cancel reservation
+ *
+ * Cancels the reservation. Holders of manage:reservations for the place may
+ * cancel reservations of other users; this is recorded in the audit log and
+ * the user is notified who cancelled it.
+ *
  */
 export const cancelReservation = <ThrowOnError extends boolean = false>(options: Options<CancelReservationData, ThrowOnError>) => {
     return (options.client ?? client).delete<CancelReservationResponses, CancelReservationErrors, ThrowOnError>({
@@ -1151,6 +1401,10 @@ export const getReservation = <ThrowOnError extends boolean = false>(options: Op
  * Extending is only allowed if the adjacent time slot is not blocked or reserved.
  * Shortening is always allowed within minimum duration constraints.
  * Times must align with the place's configured time slot intervals.
+ * Holders of manage:reservations for the place may move reservations of other
+ * users, also at places that can only be booked manually, and may bypass quotas
+ * and blockings. Such changes are recorded in the audit log and the user is
+ * notified who made them.
  *
  */
 export const updateReservation = <ThrowOnError extends boolean = false>(options: Options<UpdateReservationData, ThrowOnError>) => {
@@ -1176,6 +1430,15 @@ export const updateReservation = <ThrowOnError extends boolean = false>(options:
 
 /**
  * Check in to reservation
+ *
+ * Checks the holder of the reservation in. Checking in is possible from the configured
+ * time before the start until the configured time after the start, or the end of the
+ * reservation. Every check-in requires the scanned QR code of the place, which must belong
+ * to the reserved place and must not have been replaced by rotating the place's QR key,
+ * also for places that do not require a check-in. Failures carry one of the error codes QR_CODE_REQUIRED, QR_CODE_INVALID,
+ * QR_CODE_REVOKED, QR_CODE_WRONG_PLACE, CHECK_IN_TOO_EARLY, CHECK_IN_TOO_LATE (400),
+ * NOT_RESERVATION_OWNER (403), ALREADY_CHECKED_IN and RESERVATION_NOT_CHECKINABLE (409).
+ *
  */
 export const checkInReservation = <ThrowOnError extends boolean = false>(options: Options<CheckInReservationData, ThrowOnError>) => {
     return (options.client ?? client).post<CheckInReservationResponses, CheckInReservationErrors, ThrowOnError>({
@@ -1488,6 +1751,72 @@ export const addCurrentUserFavorites = <ThrowOnError extends boolean = false>(op
     });
 };
 
+/**
+ * Revoke personal calendar feed token
+ */
+export const revokeCurrentUserCalendarToken = <ThrowOnError extends boolean = false>(options?: Options<RevokeCurrentUserCalendarTokenData, ThrowOnError>) => {
+    return (options?.client ?? client).delete<RevokeCurrentUserCalendarTokenResponses, RevokeCurrentUserCalendarTokenErrors, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            },
+            {
+                name: 'X-Api-Key',
+                type: 'apiKey'
+            }
+        ],
+        url: '/users/me/calendar-token',
+        ...options
+    });
+};
+
+/**
+ * Get personal calendar feed token status
+ *
+ * Returns when the current user's calendar feed token was created and last used. The token itself is only shown once when it is created.
+ */
+export const getCurrentUserCalendarToken = <ThrowOnError extends boolean = false>(options?: Options<GetCurrentUserCalendarTokenData, ThrowOnError>) => {
+    return (options?.client ?? client).get<GetCurrentUserCalendarTokenResponses, GetCurrentUserCalendarTokenErrors, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            },
+            {
+                name: 'X-Api-Key',
+                type: 'apiKey'
+            }
+        ],
+        url: '/users/me/calendar-token',
+        ...options
+    });
+};
+
+/**
+ * Create or rotate personal calendar feed token
+ *
+ * Creates a new secret token for the current user's personal calendar feed.
+ * Any previous token is revoked, so subscriptions using the old feed URL stop working.
+ *
+ */
+export const rotateCurrentUserCalendarToken = <ThrowOnError extends boolean = false>(options?: Options<RotateCurrentUserCalendarTokenData, ThrowOnError>) => {
+    return (options?.client ?? client).post<RotateCurrentUserCalendarTokenResponses, RotateCurrentUserCalendarTokenErrors, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            },
+            {
+                name: 'X-Api-Key',
+                type: 'apiKey'
+            }
+        ],
+        url: '/users/me/calendar-token',
+        ...options
+    });
+};
+
 /**
  * Get notification preferences
  */
@@ -1728,6 +2057,94 @@ export const getGroupMembers = <ThrowOnError extends boolean = false>(options: O
     });
 };
 
+/**
+ * List constraint profiles of group
+ */
+export const listGroupConstraintProfiles = <ThrowOnError extends boolean = false>(options: Options<ListGroupConstraintProfilesData, ThrowOnError>) => {
+    return (options.client ?? client).get<ListGroupConstraintProfilesResponses, ListGroupConstraintProfilesErrors, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            },
+            {
+                name: 'X-Api-Key',
+                type: 'apiKey'
+            }
+        ],
+        url: '/groups/{groupId}/constraintProfiles',
+        ...options
+    });
+};
+
+/**
+ * Create constraint profile for group
+ */
+export const createGroupConstraintProfile = <ThrowOnError extends boolean = false>(options: Options<CreateGroupConstraintProfileData, ThrowOnError>) => {
+    return (options.client ?? client).post<CreateGroupConstraintProfileResponses, CreateGroupConstraintProfileErrors, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            },
+            {
+                name: 'X-Api-Key',
+                type: 'apiKey'
+            }
+        ],
+        url: '/groups/{groupId}/constraintProfiles',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options.headers
+        }
+    });
+};
+
+/**
+ * Delete constraint profile
+ */
+export const deleteGroupConstraintProfile = <ThrowOnError extends boolean = false>(options: Options<DeleteGroupConstraintProfileData, ThrowOnError>) => {
+    return (options.client ?? client).delete<DeleteGroupConstraintProfileResponses, DeleteGroupConstraintProfileErrors, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            },
+            {
+                name: 'X-Api-Key',
+                type: 'apiKey'
+            }
+        ],
+        url: '/groups/{groupId}/constraintProfiles/{profileId}',
+        ...options
+    });
+};
+
+/**
+ * Update constraint profile
+ */
+export const updateGroupConstraintProfile = <ThrowOnError extends boolean = false>(options: Options<UpdateGroupConstraintProfileData, ThrowOnError>) => {
+    return (options.client ?? client).put<UpdateGroupConstraintProfileResponses, UpdateGroupConstraintProfileErrors, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            },
+            {
+                name: 'X-Api-Key',
+                type: 'apiKey'
+            }
+        ],
+        url: '/groups/{groupId}/constraintProfiles/{profileId}',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options.headers
+        }
+    });
+};
+
 /**
  * Get default groups for new users
  */
@@ -2050,6 +2467,10 @@ export const updateQrTemplate = <ThrowOnError extends boolean = false>(options:
 
 /**
  * Preview template with sample data
+ *
+ * Renders the template with the data of a sample place, either as the HTML shown in
+ * browsers or as the label image printed by getPlaceQrCode.
+ *
  */
 export const previewQrTemplate = <ThrowOnError extends boolean = false>(options: Options<PreviewQrTemplateData, ThrowOnError>) => {
     return (options.client ?? client).get<PreviewQrTemplateResponses, PreviewQrTemplateErrors, ThrowOnError>({
@@ -26,6 +26,49 @@ export type TimeRange = {
     endTime: string;
 };
 
+export type BuildingAvailability = {
+    /**
+     * Merged time ranges in which the building is not blocked
+     */
+    ranges: Array<TimeRange>;
+    /**
+     * Regular opening hours of each weekday within the range, starting on Monday
+     */
+    openingHours: Array<WeekdayOpeningHours>;
+    /**
+     * Days whose opening hours differ from the regular hours of their weekday
+     */
+    exceptions: Array<OpeningHoursException>;
+};
+
+export type WeekdayOpeningHours = {
+    weekday: 'monday' | 'tuesday' | 'wednesday' | 'thursday' | 'friday' | 'saturday' | 'sunday';
+    /**
+     * Open ranges of the day; empty if the building is closed
+     */
+    hours: Array<OpeningHoursRange>;
+};
+
+export type OpeningHoursException = {
+    date: string;
+    /**
+     * Open ranges of the day; empty if the building is closed
+     */
+    hours: Array<OpeningHoursRange>;
+    /**
+     * Reason or name of the blocking that causes the deviation
+     */
+    reason?: string | null;
+};
+
+/**
+ * Wall clock times in the booking time zone; an endTime of "24:00" is the end of the day
+ */
+export type OpeningHoursRange = {
+    startTime: string;
+    endTime: string;
+};
+
 export type AuthResponse = {
     accessToken: string;
     refreshToken: string;
@@ -97,7 +140,7 @@ export type CreateBlockingRequest = {
     endTime: string;
     isRecurring?: boolean;
     /**
-     * iCal RRULE format (required if isRecurring=true)
+     * iCal RRULE format (required if isRecurring=true). FREQ must be DAILY or coarser.
      */
     recurrenceRule?: string;
     /**
@@ -220,13 +263,26 @@ export type PaginatedPlaceList = {
     meta: PaginationMeta;
 };
 
+/**
+ * Booking limits of places, set on a place, an area or a building. Places
+ * inherit unset fields from their area and building; limits unset on all
+ * levels do not apply. Quotas count the user's reservations that have not
+ * been cancelled by their start at the places the quota was set for: the
+ * place, all places of the area or building, or those a constraint profile
+ * applies to. They count per calendar day, week (starting on Monday), month
+ * and year of the booking time zone, or with a rolling quotaWindow in every
+ * day, 7 day, month and year long window that contains the start of the
+ * reservation. maxConcurrentReservations counts reservations at all places.
+ * Reservations exceeding a quota are rejected with `QUOTA_EXCEEDED`.
+ *
+ */
 export type PlaceConstraints = {
     /**
-     * ISO 8601 duration
+     * ISO 8601 duration of whole minutes, in days, hours and minutes (e.g. PT4H)
      */
     maxReservationDuration?: string | null;
     /**
-     * ISO 8601 duration
+     * ISO 8601 duration of whole minutes, in days, hours and minutes (e.g. PT30M)
      */
     minReservationDuration?: string | null;
     maxReservationsPerDay?: number | null;
@@ -237,11 +293,62 @@ export type PlaceConstraints = {
     maxHoursPerWeek?: number | null;
     maxHoursPerMonth?: number | null;
     maxHoursPerYear?: number | null;
+    /**
+     * Maximum number of reservations of a user at any place that overlap each other
+     */
     maxConcurrentReservations?: number | null;
+    /**
+     * Number of calendar days after today on which reservations may
+     * start at the latest; 0 allows booking for today only
+     *
+     */
     maxAdvanceBookingDays?: number | null;
+    /**
+     * If the place requires a check-in, reservations that have not been
+     * checked in to within this many minutes after their start are
+     * cancelled
+     *
+     */
     checkInTimeoutMinutes?: number | null;
+    /**
+     * Reservations that have not been checked in to within this many
+     * minutes after their start are cancelled, whether or not the place
+     * requires a check-in
+     *
+     */
     decayTimeoutMinutes?: number | null;
-    whitelistEnabled?: boolean;
+    /**
+     * Only users on the whitelist of the place may book it
+     */
+    whitelistEnabled?: boolean | null;
+    /**
+     * Whether quotas count calendar days, weeks, months and years or
+     * rolling windows of the same length; unset on all levels means
+     * calendar
+     *
+     */
+    quotaWindow?: 'calendar' | 'rolling' | null;
+};
+
+export type EffectivePlaceConstraints = {
+    constraints: PlaceConstraints;
+    /**
+     * Level each set field of constraints is inherited from, by field name
+     */
+    sources: {
+        [key: string]: 'place' | 'area' | 'building';
+    };
+};
+
+export type PlaceQrKey = {
+    /**
+     * Version of the key QR codes of the place are signed for
+     */
+    keyVersion: number;
+    /**
+     * URL encoded in the QR code of the place
+     */
+    checkInUrl: string;
 };
 
 export type TimeSlotConfig = {
@@ -288,18 +395,77 @@ export type CreateReservationRequest = {
     startTime: string;
     endTime: string;
     recurrence?: {
-        pattern?: 'daily' | 'weekly' | 'biweekly' | 'monthly';
-        endDate?: string;
+        pattern: 'daily' | 'weekly' | 'biweekly' | 'monthly';
+        endDate: string;
         /**
          * Days of week (0=Sunday, 6=Saturday) for weekly patterns
          */
         daysOfWeek?: Array<number>;
+        /**
+         * With allOrNothing the whole series is rejected if any occurrence
+         * conflicts, with skipConflicts all free occurrences are booked and
+         * the others are reported as skipped.
+         *
+         */
+        mode?: 'allOrNothing' | 'skipConflicts';
     };
+    /**
+     * Books on behalf of this user instead of the caller. Requires
+     * manage:reservations for the place. Mutually exclusive with userEmail.
+     *
+     */
+    userId?: string;
+    /**
+     * Like userId, but looks the user up by email address.
+     */
+    userEmail?: string;
+    /**
+     * Ignores the user's quotas. Requires manage:reservations for the place
+     * and is recorded in the audit log.
+     *
+     */
+    bypassQuotas?: boolean;
+    /**
+     * Books despite blockings of the place. Requires manage:reservations for
+     * the place and is recorded in the audit log.
+     *
+     */
+    bypassBlockings?: boolean;
+};
+
+export type ReservationSeries = {
+    recurringGroupId: string;
+    occurrences: Array<ReservationOccurrence>;
+};
+
+export type ReservationOccurrence = {
+    startTime: string;
+    endTime: string;
+    status: 'created' | 'skipped';
+    reservation?: Reservation;
+    reason?: OccurrenceRejection;
+};
+
+export type OccurrenceRejection = {
+    code: string;
+    message: string;
 };
 
 export type UpdateReservationRequest = {
     startTime?: string;
     endTime?: string;
+    /**
+     * Ignores the user's quotas. Requires manage:reservations for the place
+     * and is recorded in the audit log.
+     *
+     */
+    bypassQuotas?: boolean;
+    /**
+     * Moves the reservation despite blockings of the place. Requires
+     * manage:reservations for the place and is recorded in the audit log.
+     *
+     */
+    bypassBlockings?: boolean;
 };
 
 export type PaginatedReservationList = {
@@ -327,6 +493,10 @@ export type User = {
     oauthId?: string;
     isActive: boolean;
     groups?: Array<Group>;
+    /**
+     * Effective permissions of the user, including scoped grants. Only set on /auth/me.
+     */
+    permissions?: Array<Permission>;
     createdAt: string;
     updatedAt: string;
 };
@@ -389,6 +559,56 @@ export type UpdateGroupRequest = {
 export type Permission = {
     name: string;
     description: string;
+    scope?: PermissionScope;
+};
+
+/**
+ * Restricts a permission to a single building, area or place.
+ * Grants cascade down the Building → Area → Place hierarchy.
+ *
+ */
+export type PermissionScope = {
+    type: 'building' | 'area' | 'place';
+    id: string;
+};
+
+/**
+ * Constraints that override those of places for the members of a
+ * group, at all places or only within a building or area. Fields left
+ * unset do not override anything; whitelistEnabled, quotaWindow,
+ * checkInTimeoutMinutes and decayTimeoutMinutes cannot be set. The
+ * server's profile strategy decides between the values of the place and
+ * of the profiles of a user's groups: by default the most permissive
+ * value applies, so profiles only relax limits; with the restrictive
+ * strategy the most restrictive one applies. Unset limits are the most
+ * permissive.
+ *
+ */
+export type ConstraintProfile = {
+    id: string;
+    groupId: string;
+    /**
+     * Name shown in rejections of bookings the profile applied to
+     */
+    name: string;
+    scope?: ConstraintProfileScope;
+    constraints: PlaceConstraints;
+    createdAt: string;
+    updatedAt: string;
+};
+
+export type ConstraintProfileRequest = {
+    name: string;
+    scope?: ConstraintProfileScope;
+    constraints: PlaceConstraints;
+};
+
+/**
+ * Restricts a constraint profile to the places of a building or area
+ */
+export type ConstraintProfileScope = {
+    type: 'building' | 'area';
+    id: string;
 };
 
 export type Equipment = {
@@ -443,7 +663,14 @@ export type QrTemplate = {
     id: string;
     name: string;
     /**
-     * HTML template with placeholders
+     * Go html/template source rendered with .Place, .Area and .Building (each with Name,
+     * Description and Location), .QRCode (data URI of the QR symbol, for img src) and
+     * .CheckInURL. range, printf, call and nested templates are not available. The
+     * rendered HTML must not load external resources: scripts, frames, objects and link
+     * elements are rejected, images have to be data URIs, CSS must not use url(), @import
+     * or other functions and at-rules that can load resources, and links may only point to
+     * http, https and mailto URLs.
+     *
      */
     htmlTemplate: string;
     createdAt: string;
@@ -452,11 +679,17 @@ export type QrTemplate = {
 
 export type CreateQrTemplateRequest = {
     name: string;
+    /**
+     * See QRTemplate.htmlTemplate. Templates are validated by rendering them with sample data.
+     */
     htmlTemplate: string;
 };
 
 export type UpdateQrTemplateRequest = {
     name?: string;
+    /**
+     * See QRTemplate.htmlTemplate. Templates are validated by rendering them with sample data.
+     */
     htmlTemplate?: string;
 };
 
@@ -483,6 +716,22 @@ export type ApiKeyWithSecret = ApiKey & {
     key: string;
 };
 
+export type CalendarToken = {
+    createdAt: string;
+    lastUsedAt?: string | null;
+};
+
+export type CalendarTokenWithSecret = CalendarToken & {
+    /**
+     * Secret feed token (only shown once at creation)
+     */
+    token: string;
+    /**
+     * Path of the feed relative to the API base URL
+     */
+    path: string;
+};
+
 export type CreateApiKeyRequest = {
     name: string;
     expiresAt?: string;
@@ -559,13 +808,17 @@ export type HandleOAuthCallbackData = {
 
 export type HandleOAuthCallbackErrors = {
     /**
-     * Bad request - validation error
+     * Bad request - invalid state or state cookie
      */
     400: ErrorResponse;
     /**
-     * Unauthorized - not authenticated
+     * Unauthorized - the code was rejected or the account is disabled
      */
     401: ErrorResponse;
+    /**
+     * The email address of the GitLab account belongs to another user
+     */
+    409: ErrorResponse;
 };
 
 export type HandleOAuthCallbackError = HandleOAuthCallbackErrors[keyof HandleOAuthCallbackErrors];
@@ -945,6 +1198,68 @@ export type AddBuildingBlockingEntriesResponses = {
 
 export type AddBuildingBlockingEntriesResponse = AddBuildingBlockingEntriesResponses[keyof AddBuildingBlockingEntriesResponses];
 
+export type GetBuildingConstraintsData = {
+    body?: never;
+    path: {
+        buildingId: string;
+    };
+    query?: never;
+    url: '/buildings/{buildingId}/constraints';
+};
+
+export type GetBuildingConstraintsErrors = {
+    /**
+     * Resource not found
+     */
+    404: ErrorResponse;
+};
+
+export type GetBuildingConstraintsError = GetBuildingConstraintsErrors[keyof GetBuildingConstraintsErrors];
+
+export type GetBuildingConstraintsResponses = {
+    /**
+     * Building constraints
+     */
+    200: PlaceConstraints;
+};
+
+export type GetBuildingConstraintsResponse = GetBuildingConstraintsResponses[keyof GetBuildingConstraintsResponses];
+
+export type UpdateBuildingConstraintsData = {
+    body: PlaceConstraints;
+    path: {
+        buildingId: string;
+    };
+    query?: never;
+    url: '/buildings/{buildingId}/constraints';
+};
+
+export type UpdateBuildingConstraintsErrors = {
+    /**
+     * Bad request - validation error
+     */
+    400: ErrorResponse;
+    /**
+     * Forbidden - insufficient permissions
+     */
+    403: ErrorResponse;
+    /**
+     * Resource not found
+     */
+    404: ErrorResponse;
+};
+
+export type UpdateBuildingConstraintsError = UpdateBuildingConstraintsErrors[keyof UpdateBuildingConstraintsErrors];
+
+export type UpdateBuildingConstraintsResponses = {
+    /**
+     * Constraints updated
+     */
+    200: PlaceConstraints;
+};
+
+export type UpdateBuildingConstraintsResponse = UpdateBuildingConstraintsResponses[keyof UpdateBuildingConstraintsResponses];
+
 export type ListBuildingAreasData = {
     body?: never;
     path: {
@@ -985,6 +1300,10 @@ export type GetBuildingAvailabilityData = {
 };
 
 export type GetBuildingAvailabilityErrors = {
+    /**
+     * Bad request - validation error
+     */
+    400: ErrorResponse;
     /**
      * Resource not found
      */
@@ -995,15 +1314,21 @@ export type GetBuildingAvailabilityError = GetBuildingAvailabilityErrors[keyof G
 
 export type GetBuildingAvailabilityResponses = {
     /**
-     * Available time ranges
+     * Available time ranges and opening hours
      */
-    200: Array<TimeRange>;
+    200: BuildingAvailability;
 };
 
 export type GetBuildingAvailabilityResponse = GetBuildingAvailabilityResponses[keyof GetBuildingAvailabilityResponses];
 
 export type GetBuildingCalendarData = {
     body?: never;
+    headers?: {
+        /**
+         * Entity tag of a previously fetched response
+         */
+        'If-None-Match'?: string;
+    };
     path: {
         buildingId: string;
     };
@@ -1025,10 +1350,59 @@ export type GetBuildingCalendarResponses = {
      * iCalendar feed
      */
     200: string;
+    /**
+     * Not modified - the feed matches the given If-None-Match entity tag
+     */
+    304: unknown;
 };
 
 export type GetBuildingCalendarResponse = GetBuildingCalendarResponses[keyof GetBuildingCalendarResponses];
 
+export type ExportBuildingQrCodesData = {
+    body?: never;
+    path: {
+        buildingId: string;
+    };
+    query?: {
+        /**
+         * QR template to render the labels with; by default labels show the place, area and building names
+         */
+        templateId?: string;
+        format?: 'pdf' | 'zip';
+        /**
+         * Paper size of PDF print sheets
+         */
+        paper?: 'a4' | 'letter';
+    };
+    url: '/buildings/{buildingId}/qrCodes';
+};
+
+export type ExportBuildingQrCodesErrors = {
+    /**
+     * Bad request - validation error
+     */
+    400: ErrorResponse;
+    /**
+     * Forbidden - insufficient permissions
+     */
+    403: ErrorResponse;
+    /**
+     * Resource not found
+     */
+    404: ErrorResponse;
+};
+
+export type ExportBuildingQrCodesError = ExportBuildingQrCodesErrors[keyof ExportBuildingQrCodesErrors];
+
+export type ExportBuildingQrCodesResponses = {
+    /**
+     * QR code labels
+     */
+    200: Blob | File;
+};
+
+export type ExportBuildingQrCodesResponse = ExportBuildingQrCodesResponses[keyof ExportBuildingQrCodesResponses];
+
 export type ListAreasData = {
     body?: never;
     path?: never;
@@ -1497,6 +1871,68 @@ export type AddAreaBlockingEntriesResponses = {
 
 export type AddAreaBlockingEntriesResponse = AddAreaBlockingEntriesResponses[keyof AddAreaBlockingEntriesResponses];
 
+export type GetAreaConstraintsData = {
+    body?: never;
+    path: {
+        areaId: string;
+    };
+    query?: never;
+    url: '/areas/{areaId}/constraints';
+};
+
+export type GetAreaConstraintsErrors = {
+    /**
+     * Resource not found
+     */
+    404: ErrorResponse;
+};
+
+export type GetAreaConstraintsError = GetAreaConstraintsErrors[keyof GetAreaConstraintsErrors];
+
+export type GetAreaConstraintsResponses = {
+    /**
+     * Area constraints
+     */
+    200: PlaceConstraints;
+};
+
+export type GetAreaConstraintsResponse = GetAreaConstraintsResponses[keyof GetAreaConstraintsResponses];
+
+export type UpdateAreaConstraintsData = {
+    body: PlaceConstraints;
+    path: {
+        areaId: string;
+    };
+    query?: never;
+    url: '/areas/{areaId}/constraints';
+};
+
+export type UpdateAreaConstraintsErrors = {
+    /**
+     * Bad request - validation error
+     */
+    400: ErrorResponse;
+    /**
+     * Forbidden - insufficient permissions
+     */
+    403: ErrorResponse;
+    /**
+     * Resource not found
+     */
+    404: ErrorResponse;
+};
+
+export type UpdateAreaConstraintsError = UpdateAreaConstraintsErrors[keyof UpdateAreaConstraintsErrors];
+
+export type UpdateAreaConstraintsResponses = {
+    /**
+     * Constraints updated
+     */
+    200: PlaceConstraints;
+};
+
+export type UpdateAreaConstraintsResponse = UpdateAreaConstraintsResponses[keyof UpdateAreaConstraintsResponses];
+
 export type ListAreaPlacesData = {
     body?: never;
     path: {
@@ -1538,6 +1974,10 @@ export type GetAreaAvailabilityData = {
 };
 
 export type GetAreaAvailabilityErrors = {
+    /**
+     * Bad request - validation error
+     */
+    400: ErrorResponse;
     /**
      * Resource not found
      */
@@ -1557,6 +1997,12 @@ export type GetAreaAvailabilityResponse = GetAreaAvailabilityResponses[keyof Get
 
 export type GetAreaCalendarData = {
     body?: never;
+    headers?: {
+        /**
+         * Entity tag of a previously fetched response
+         */
+        'If-None-Match'?: string;
+    };
     path: {
         areaId: string;
     };
@@ -1578,10 +2024,99 @@ export type GetAreaCalendarResponses = {
      * iCalendar feed
      */
     200: string;
+    /**
+     * Not modified - the feed matches the given If-None-Match entity tag
+     */
+    304: unknown;
 };
 
 export type GetAreaCalendarResponse = GetAreaCalendarResponses[keyof GetAreaCalendarResponses];
 
+export type ExportAreaQrCodesData = {
+    body?: never;
+    path: {
+        areaId: string;
+    };
+    query?: {
+        /**
+         * QR template to render the labels with; by default labels show the place, area and building names
+         */
+        templateId?: string;
+        format?: 'pdf' | 'zip';
+        /**
+         * Paper size of PDF print sheets
+         */
+        paper?: 'a4' | 'letter';
+    };
+    url: '/areas/{areaId}/qrCodes';
+};
+
+export type ExportAreaQrCodesErrors = {
+    /**
+     * Bad request - validation error
+     */
+    400: ErrorResponse;
+    /**
+     * Forbidden - insufficient permissions
+     */
+    403: ErrorResponse;
+    /**
+     * Resource not found
+     */
+    404: ErrorResponse;
+};
+
+export type ExportAreaQrCodesError = ExportAreaQrCodesErrors[keyof ExportAreaQrCodesErrors];
+
+export type ExportAreaQrCodesResponses = {
+    /**
+     * QR code labels
+     */
+    200: Blob | File;
+};
+
+export type ExportAreaQrCodesResponse = ExportAreaQrCodesResponses[keyof ExportAreaQrCodesResponses];
+
+export type GetUserCalendarData = {
+    body?: never;
+    headers?: {
+        /**
+         * Entity tag of a previously fetched response
+         */
+        'If-None-Match'?: string;
+    };
+    path: {
+        /**
+         * Secret calendar feed token of the user
+         */
+        token: string;
+    };
+    query?: never;
+    url: '/calendar/users/{token}.ics';
+};
+
+export type GetUserCalendarErrors = {
+    /**
+     * Resource not found
+     */
+    404: ErrorResponse;
+};
+
+export type GetUserCalendarError = GetUserCalendarErrors[keyof GetUserCalendarErrors];
+
+export type GetUserCalendarResponses = {
+    /**
+     * iCalendar feed
+     */
+    200: string;
+    /**
+     * Not modified - the feed matches the given If-None-Match entity tag
+     */
+    304: unknown;
+};
+
+export type GetUserCalendarResponse = GetUserCalendarResponses[keyof GetUserCalendarResponses];
+
 export type ListPlacesData = {
     body?: never;
     path?: never;
@@ -1771,40 +2306,67 @@ export type GetPlaceConstraintsResponses = {
 
 export type GetPlaceConstraintsResponse = GetPlaceConstraintsResponses[keyof GetPlaceConstraintsResponses];
 
-export type UpdatePlaceConstraintsData = {
-    body: PlaceConstraints;
+export type UpdatePlaceConstraintsData = {
+    body: PlaceConstraints;
+    path: {
+        placeId: string;
+    };
+    query?: never;
+    url: '/places/{placeId}/constraints';
+};
+
+export type UpdatePlaceConstraintsErrors = {
+    /**
+     * Bad request - validation error
+     */
+    400: ErrorResponse;
+    /**
+     * Forbidden - insufficient permissions
+     */
+    403: ErrorResponse;
+    /**
+     * Resource not found
+     */
+    404: ErrorResponse;
+};
+
+export type UpdatePlaceConstraintsError = UpdatePlaceConstraintsErrors[keyof UpdatePlaceConstraintsErrors];
+
+export type UpdatePlaceConstraintsResponses = {
+    /**
+     * Constraints updated
+     */
+    200: PlaceConstraints;
+};
+
+export type UpdatePlaceConstraintsResponse = UpdatePlaceConstraintsResponses[keyof UpdatePlaceConstraintsResponses];
+
+export type GetEffectivePlaceConstraintsData = {
+    body?: never;
     path: {
         placeId: string;
     };
     query?: never;
-    url: '/places/{placeId}/constraints';
+    url: '/places/{placeId}/constraints/effective';
 };
 
-export type UpdatePlaceConstraintsErrors = {
-    /**
-     * Bad request - validation error
-     */
-    400: ErrorResponse;
-    /**
-     * Forbidden - insufficient permissions
-     */
-    403: ErrorResponse;
+export type GetEffectivePlaceConstraintsErrors = {
     /**
      * Resource not found
      */
     404: ErrorResponse;
 };
 
-export type UpdatePlaceConstraintsError = UpdatePlaceConstraintsErrors[keyof UpdatePlaceConstraintsErrors];
+export type GetEffectivePlaceConstraintsError = GetEffectivePlaceConstraintsErrors[keyof GetEffectivePlaceConstraintsErrors];
 
-export type UpdatePlaceConstraintsResponses = {
+export type GetEffectivePlaceConstraintsResponses = {
     /**
-     * Constraints updated
+     * Effective place constraints
      */
-    200: PlaceConstraints;
+    200: EffectivePlaceConstraints;
 };
 
-export type UpdatePlaceConstraintsResponse = UpdatePlaceConstraintsResponses[keyof UpdatePlaceConstraintsResponses];
+export type GetEffectivePlaceConstraintsResponse = GetEffectivePlaceConstraintsResponses[keyof GetEffectivePlaceConstraintsResponses];
 
 export type GetPlaceTimeSlotsData = {
     body?: never;
@@ -2224,6 +2786,10 @@ export type GetPlaceAvailabilityData = {
 };
 
 export type GetPlaceAvailabilityErrors = {
+    /**
+     * Bad request - validation error
+     */
+    400: ErrorResponse;
     /**
      * Resource not found
      */
@@ -2247,6 +2813,9 @@ export type GetPlaceQrCodeData = {
         placeId: string;
     };
     query?: {
+        /**
+         * QR template to render the label with instead of the default layout. includeDetails is ignored, the template decides which details are shown.
+         */
         templateId?: string;
         format?: 'png' | 'jpg' | 'pdf';
         /**
@@ -2279,8 +2848,76 @@ export type GetPlaceQrCodeResponses = {
 
 export type GetPlaceQrCodeResponse = GetPlaceQrCodeResponses[keyof GetPlaceQrCodeResponses];
 
+export type GetPlaceQrKeyData = {
+    body?: never;
+    path: {
+        placeId: string;
+    };
+    query?: never;
+    url: '/places/{placeId}/qrCode/key';
+};
+
+export type GetPlaceQrKeyErrors = {
+    /**
+     * Forbidden - insufficient permissions
+     */
+    403: ErrorResponse;
+    /**
+     * Resource not found
+     */
+    404: ErrorResponse;
+};
+
+export type GetPlaceQrKeyError = GetPlaceQrKeyErrors[keyof GetPlaceQrKeyErrors];
+
+export type GetPlaceQrKeyResponses = {
+    /**
+     * Current QR key
+     */
+    200: PlaceQrKey;
+};
+
+export type GetPlaceQrKeyResponse = GetPlaceQrKeyResponses[keyof GetPlaceQrKeyResponses];
+
+export type RotatePlaceQrKeyData = {
+    body?: never;
+    path: {
+        placeId: string;
+    };
+    query?: never;
+    url: '/places/{placeId}/qrCode/key';
+};
+
+export type RotatePlaceQrKeyErrors = {
+    /**
+     * Forbidden - insufficient permissions
+     */
+    403: ErrorResponse;
+    /**
+     * Resource not found
+     */
+    404: ErrorResponse;
+};
+
+export type RotatePlaceQrKeyError = RotatePlaceQrKeyErrors[keyof RotatePlaceQrKeyErrors];
+
+export type RotatePlaceQrKeyResponses = {
+    /**
+     * New QR key
+     */
+    200: PlaceQrKey;
+};
+
+export type RotatePlaceQrKeyResponse = RotatePlaceQrKeyResponses[keyof RotatePlaceQrKeyResponses];
+
 export type GetPlaceCalendarData = {
     body?: never;
+    headers?: {
+        /**
+         * Entity tag of a previously fetched response
+         */
+        'If-None-Match'?: string;
+    };
     path: {
         placeId: string;
     };
@@ -2302,6 +2939,10 @@ export type GetPlaceCalendarResponses = {
      * iCalendar feed
      */
     200: string;
+    /**
+     * Not modified - the feed matches the given If-None-Match entity tag
+     */
+    304: unknown;
 };
 
 export type GetPlaceCalendarResponse = GetPlaceCalendarResponses[keyof GetPlaceCalendarResponses];
@@ -2371,7 +3012,11 @@ export type CreateReservationErrors = {
      */
     403: ErrorResponse;
     /**
-     * Conflict - overlapping reservation or blocked time slot
+     * Conflict - overlapping reservation, blocked time slot or exceeded
+     * quota (`QUOTA_EXCEEDED`, e.g. `maxHoursPerWeek (12h used of 10h)`).
+     * When an allOrNothing series is rejected, `error.details.occurrences`
+     * lists every conflicting occurrence.
+     *
      */
     409: ErrorResponse;
 };
@@ -2382,7 +3027,7 @@ export type CreateReservationResponses = {
     /**
      * Reservation(s) created
      */
-    201: Reservation | Array<Reservation>;
+    201: Reservation | ReservationSeries;
 };
 
 export type CreateReservationResponse = CreateReservationResponses[keyof CreateReservationResponses];
@@ -2392,7 +3037,15 @@ export type CancelReservationData = {
     path: {
         reservationId: string;
     };
-    query?: never;
+    query?: {
+        /**
+         * Which occurrences of a recurring series the change applies to. Only
+         * upcoming, not yet cancelled or checked-in occurrences are affected
+         * besides the addressed one. Ignored for single reservations.
+         *
+         */
+        scope?: 'occurrence' | 'following' | 'series';
+    };
     url: '/reservations/{reservationId}';
 };
 
@@ -2405,6 +3058,10 @@ export type CancelReservationErrors = {
      * Resource not found
      */
     404: ErrorResponse;
+    /**
+     * Conflict - overlapping reservation or blocked time slot
+     */
+    409: ErrorResponse;
 };
 
 export type CancelReservationError = CancelReservationErrors[keyof CancelReservationErrors];
@@ -2454,7 +3111,15 @@ export type UpdateReservationData = {
     path: {
         reservationId: string;
     };
-    query?: never;
+    query?: {
+        /**
+         * Which occurrences of a recurring series the change applies to. Only
+         * upcoming, not yet cancelled or checked-in occurrences are affected
+         * besides the addressed one. Ignored for single reservations.
+         *
+         */
+        scope?: 'occurrence' | 'following' | 'series';
+    };
     url: '/reservations/{reservationId}';
 };
 
@@ -2491,7 +3156,7 @@ export type UpdateReservationResponse = UpdateReservationResponses[keyof UpdateR
 export type CheckInReservationData = {
     body?: {
         /**
-         * Optional QR code for verification
+         * Content of the scanned QR code of the place, either the check-in URL or its token
          */
         qrCode?: string;
     };
@@ -2507,6 +3172,10 @@ export type CheckInReservationErrors = {
      * Bad request - validation error
      */
     400: ErrorResponse;
+    /**
+     * Unauthorized - not authenticated
+     */
+    401: ErrorResponse;
     /**
      * Forbidden - insufficient permissions
      */
@@ -2515,6 +3184,10 @@ export type CheckInReservationErrors = {
      * Resource not found
      */
     404: ErrorResponse;
+    /**
+     * Conflict - overlapping reservation or blocked time slot
+     */
+    409: ErrorResponse;
 };
 
 export type CheckInReservationError = CheckInReservationErrors[keyof CheckInReservationErrors];
@@ -2942,6 +3615,85 @@ export type AddCurrentUserFavoritesResponses = {
     201: unknown;
 };
 
+export type RevokeCurrentUserCalendarTokenData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/users/me/calendar-token';
+};
+
+export type RevokeCurrentUserCalendarTokenErrors = {
+    /**
+     * Unauthorized - not authenticated
+     */
+    401: ErrorResponse;
+};
+
+export type RevokeCurrentUserCalendarTokenError = RevokeCurrentUserCalendarTokenErrors[keyof RevokeCurrentUserCalendarTokenErrors];
+
+export type RevokeCurrentUserCalendarTokenResponses = {
+    /**
+     * Calendar feed token revoked
+     */
+    204: void;
+};
+
+export type RevokeCurrentUserCalendarTokenResponse = RevokeCurrentUserCalendarTokenResponses[keyof RevokeCurrentUserCalendarTokenResponses];
+
+export type GetCurrentUserCalendarTokenData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/users/me/calendar-token';
+};
+
+export type GetCurrentUserCalendarTokenErrors = {
+    /**
+     * Unauthorized - not authenticated
+     */
+    401: ErrorResponse;
+    /**
+     * Resource not found
+     */
+    404: ErrorResponse;
+};
+
+export type GetCurrentUserCalendarTokenError = GetCurrentUserCalendarTokenErrors[keyof GetCurrentUserCalendarTokenErrors];
+
+export type GetCurrentUserCalendarTokenResponses = {
+    /**
+     * Calendar feed token status
+     */
+    200: CalendarToken;
+};
+
+export type GetCurrentUserCalendarTokenResponse = GetCurrentUserCalendarTokenResponses[keyof GetCurrentUserCalendarTokenResponses];
+
+export type RotateCurrentUserCalendarTokenData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/users/me/calendar-token';
+};
+
+export type RotateCurrentUserCalendarTokenErrors = {
+    /**
+     * Unauthorized - not authenticated
+     */
+    401: ErrorResponse;
+};
+
+export type RotateCurrentUserCalendarTokenError = RotateCurrentUserCalendarTokenErrors[keyof RotateCurrentUserCalendarTokenErrors];
+
+export type RotateCurrentUserCalendarTokenResponses = {
+    /**
+     * Calendar feed token created
+     */
+    201: CalendarTokenWithSecret;
+};
+
+export type RotateCurrentUserCalendarTokenResponse = RotateCurrentUserCalendarTokenResponses[keyof RotateCurrentUserCalendarTokenResponses];
+
 export type GetCurrentUserNotificationsData = {
     body?: never;
     path?: never;
@@ -3150,6 +3902,7 @@ export type UpdateGroupResponse = UpdateGroupResponses[keyof UpdateGroupResponse
 export type RemoveGroupPermissionsData = {
     body: {
         permissions: Array<string>;
+        scope?: PermissionScope;
     };
     path: {
         groupId: string;
@@ -3214,6 +3967,7 @@ export type GetGroupPermissionsResponse = GetGroupPermissionsResponses[keyof Get
 export type AddGroupPermissionsData = {
     body: {
         permissions: Array<string>;
+        scope?: PermissionScope;
     };
     path: {
         groupId: string;
@@ -3279,6 +4033,140 @@ export type GetGroupMembersResponses = {
 
 export type GetGroupMembersResponse = GetGroupMembersResponses[keyof GetGroupMembersResponses];
 
+export type ListGroupConstraintProfilesData = {
+    body?: never;
+    path: {
+        groupId: string;
+    };
+    query?: never;
+    url: '/groups/{groupId}/constraintProfiles';
+};
+
+export type ListGroupConstraintProfilesErrors = {
+    /**
+     * Forbidden - insufficient permissions
+     */
+    403: ErrorResponse;
+    /**
+     * Resource not found
+     */
+    404: ErrorResponse;
+};
+
+export type ListGroupConstraintProfilesError = ListGroupConstraintProfilesErrors[keyof ListGroupConstraintProfilesErrors];
+
+export type ListGroupConstraintProfilesResponses = {
+    /**
+     * Constraint profiles
+     */
+    200: Array<ConstraintProfile>;
+};
+
+export type ListGroupConstraintProfilesResponse = ListGroupConstraintProfilesResponses[keyof ListGroupConstraintProfilesResponses];
+
+export type CreateGroupConstraintProfileData = {
+    body: ConstraintProfileRequest;
+    path: {
+        groupId: string;
+    };
+    query?: never;
+    url: '/groups/{groupId}/constraintProfiles';
+};
+
+export type CreateGroupConstraintProfileErrors = {
+    /**
+     * Bad request - validation error
+     */
+    400: ErrorResponse;
+    /**
+     * Forbidden - insufficient permissions
+     */
+    403: ErrorResponse;
+    /**
+     * Resource not found
+     */
+    404: ErrorResponse;
+};
+
+export type CreateGroupConstraintProfileError = CreateGroupConstraintProfileErrors[keyof CreateGroupConstraintProfileErrors];
+
+export type CreateGroupConstraintProfileResponses = {
+    /**
+     * Constraint profile created
+     */
+    201: ConstraintProfile;
+};
+
+export type CreateGroupConstraintProfileResponse = CreateGroupConstraintProfileResponses[keyof CreateGroupConstraintProfileResponses];
+
+export type DeleteGroupConstraintProfileData = {
+    body?: never;
+    path: {
+        groupId: string;
+        profileId: string;
+    };
+    query?: never;
+    url: '/groups/{groupId}/constraintProfiles/{profileId}';
+};
+
+export type DeleteGroupConstraintProfileErrors = {
+    /**
+     * Forbidden - insufficient permissions
+     */
+    403: ErrorResponse;
+    /**
+     * Resource not found
+     */
+    404: ErrorResponse;
+};
+
+export type DeleteGroupConstraintProfileError = DeleteGroupConstraintProfileErrors[keyof DeleteGroupConstraintProfileErrors];
+
+export type DeleteGroupConstraintProfileResponses = {
+    /**
+     * Constraint profile deleted
+     */
+    204: void;
+};
+
+export type DeleteGroupConstraintProfileResponse = DeleteGroupConstraintProfileResponses[keyof DeleteGroupConstraintProfileResponses];
+
+export type UpdateGroupConstraintProfileData = {
+    body: ConstraintProfileRequest;
+    path: {
+        groupId: string;
+        profileId: string;
+    };
+    query?: never;
+    url: '/groups/{groupId}/constraintProfiles/{profileId}';
+};
+
+export type UpdateGroupConstraintProfileErrors = {
+    /**
+     * Bad request - validation error
+     */
+    400: ErrorResponse;
+    /**
+     * Forbidden - insufficient permissions
+     */
+    403: ErrorResponse;
+    /**
+     * Resource not found
+     */
+    404: ErrorResponse;
+};
+
+export type UpdateGroupConstraintProfileError = UpdateGroupConstraintProfileErrors[keyof UpdateGroupConstraintProfileErrors];
+
+export type UpdateGroupConstraintProfileResponses = {
+    /**
+     * Constraint profile updated
+     */
+    200: ConstraintProfile;
+};
+
+export type UpdateGroupConstraintProfileResponse = UpdateGroupConstraintProfileResponses[keyof UpdateGroupConstraintProfileResponses];
+
 export type GetDefaultGroupAssignmentData = {
     body?: never;
     path?: never;
@@ -3739,7 +4627,9 @@ export type PreviewQrTemplateData = {
     path: {
         templateId: string;
     };
-    query?: never;
+    query?: {
+        format?: 'html' | 'png';
+    };
     url: '/qrTemplates/{templateId}/preview';
 };
 
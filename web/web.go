@@ -4,6 +4,7 @@ import (
 	"embed"
 	"io/fs"
 	"net/http"
+	"strings"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/filesystem"
@@ -16,6 +17,10 @@ func RegisterRoutes(router fiber.Router) {
 	distFS, _ := fs.Sub(app, "dist")
 
 	router.Use("/", filesystem.New(filesystem.Config{
+		// API routes must not fall back to the SPA index.
+		Next: func(c *fiber.Ctx) bool {
+			return strings.HasPrefix(c.Path(), "/api/")
+		},
 		Root:         http.FS(distFS),
 		Index:        "index.html",
 		NotFoundFile: "index.html",